@@ -0,0 +1,224 @@
+// Package client connects Go programs to the bonding service. Calls are
+// spread across several server endpoints, endpoints failing their gRPC
+// health check are skipped, and calls an instance could not serve are retried
+// on another, so a single instance restarting goes unnoticed by callers.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/consistency"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // Client-side health checking
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Load balancing policies
+const (
+	// PickFirst sends every call to the first reachable endpoint, moving on
+	// to the next when it fails
+	PickFirst = "pick_first"
+	// RoundRobin spreads calls across every healthy endpoint
+	RoundRobin = "round_robin"
+)
+
+// serviceName is the full name of the bonding gRPC service
+const serviceName = "bonding.BondingService"
+
+// Config controls how the client reaches the service
+type Config struct {
+	Endpoints []string // host:port of each server instance
+	Policy    string   // PickFirst or RoundRobin
+	// HealthCheck skips endpoints whose gRPC health service reports them not
+	// serving. It applies to RoundRobin; PickFirst moves on when a
+	// connection fails.
+	HealthCheck bool
+	// MaxAttempts is how many times a call failing with UNAVAILABLE is tried,
+	// including the first; 1 disables retries. gRPC caps it at 5.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// ReadYourWrites sends the consistency token of the client's latest
+	// write with every later call, so reads reflect it on any instance
+	ReadYourWrites bool
+	// DialOptions are added to the client's, e.g. transport credentials. The
+	// connection is insecure when they set none.
+	DialOptions []grpc.DialOption
+}
+
+// DefaultConfig returns a round-robin, health-checked configuration retrying
+// unavailable calls up to three times
+func DefaultConfig(endpoints ...string) Config {
+	return Config{
+		Endpoints:      endpoints,
+		Policy:         RoundRobin,
+		HealthCheck:    true,
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		ReadYourWrites: true,
+	}
+}
+
+// Client is a bonding service client over a load-balanced connection
+type Client struct {
+	pb.BondingServiceClient
+	conn *grpc.ClientConn
+
+	mu    sync.Mutex
+	token consistency.Token // Latest write's consistency token, 0 before any
+}
+
+// resolverCount numbers each client's resolver scheme so clients with
+// different endpoints don't share one
+var resolverCount atomic.Int64
+
+// New creates a client for the configured endpoints. Connections are made
+// lazily, on the first call.
+func New(config Config) (*Client, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, fmt.Errorf("at least one endpoint is required")
+	}
+	serviceConfig, err := serviceConfigJSON(config)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]resolver.Address, len(config.Endpoints))
+	for i, endpoint := range config.Endpoints {
+		addresses[i] = resolver.Address{Addr: strings.TrimSpace(endpoint)}
+	}
+	scheme := fmt.Sprintf("knowton-%d", resolverCount.Add(1))
+	r := manual.NewBuilderWithScheme(scheme)
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	c := &Client{}
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}
+	if config.ReadYourWrites {
+		options = append(options, grpc.WithChainUnaryInterceptor(c.trackConsistency))
+	}
+	conn, err := grpc.NewClient(scheme+":///bonding-service", append(options, config.DialOptions...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bonding service client: %w", err)
+	}
+	c.BondingServiceClient = pb.NewBondingServiceClient(conn)
+	c.conn = conn
+	return c, nil
+}
+
+// ConsistencyToken returns the token of the client's latest write, empty
+// before any or without ReadYourWrites. Other clients, e.g. in a user's next
+// request, can send it in the x-consistency-token header to read the write.
+func (c *Client) ConsistencyToken() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token == 0 {
+		return ""
+	}
+	return c.token.String()
+}
+
+// trackConsistency sends the latest token with each call and keeps the
+// newest one the server returns. A token already set by the caller is kept.
+func (c *Client) trackConsistency(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if token := c.ConsistencyToken(); token != "" {
+		if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(consistency.Header)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, consistency.Header, token)
+		}
+	}
+
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if values := header.Get(consistency.Header); len(values) > 0 {
+		if token, parseErr := consistency.Parse(values[0]); parseErr == nil {
+			c.mu.Lock()
+			if token > c.token {
+				c.token = token
+			}
+			c.mu.Unlock()
+		}
+	}
+	return err
+}
+
+// Conn returns the underlying connection
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the connection to every endpoint
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// serviceConfigJSON builds the gRPC service config applying the policy,
+// health checking and retries
+func serviceConfigJSON(config Config) (string, error) {
+	policy := config.Policy
+	if policy == "" {
+		policy = RoundRobin
+	}
+	if policy != PickFirst && policy != RoundRobin {
+		return "", fmt.Errorf("unknown load balancing policy %q", policy)
+	}
+
+	serviceConfig := map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{{policy: map[string]interface{}{}}},
+	}
+	if config.HealthCheck {
+		// An empty service name checks the server's overall health
+		serviceConfig["healthCheckConfig"] = map[string]string{"serviceName": ""}
+	}
+	if config.MaxAttempts > 1 {
+		defaults := DefaultConfig()
+		initial, max := config.InitialBackoff, config.MaxBackoff
+		if initial <= 0 {
+			initial = defaults.InitialBackoff
+		}
+		if max < initial {
+			max = initial
+		}
+		serviceConfig["methodConfig"] = []map[string]interface{}{{
+			"name": []map[string]string{{"service": serviceName}},
+			"retryPolicy": map[string]interface{}{
+				"maxAttempts":          config.MaxAttempts,
+				"initialBackoff":       durationJSON(initial),
+				"maxBackoff":           durationJSON(max),
+				"backoffMultiplier":    2,
+				"retryableStatusCodes": []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	data, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode service config: %w", err)
+	}
+	return string(data), nil
+}
+
+// durationJSON formats a duration as a protobuf JSON duration, e.g. "0.1s"
+func durationJSON(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
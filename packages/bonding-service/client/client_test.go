@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/consistency"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServiceConfigJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{
+			"default",
+			DefaultConfig("a:1"),
+			`{"healthCheckConfig":{"serviceName":""},"loadBalancingConfig":[{"round_robin":{}}],"methodConfig":[{"name":[{"service":"bonding.BondingService"}],"retryPolicy":{"backoffMultiplier":2,"initialBackoff":"0.1s","maxAttempts":3,"maxBackoff":"1s","retryableStatusCodes":["UNAVAILABLE"]}}]}`,
+		},
+		{
+			"pick first without retries",
+			Config{Policy: PickFirst, MaxAttempts: 1},
+			`{"loadBalancingConfig":[{"pick_first":{}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := serviceConfigJSON(tt.config)
+			if err != nil {
+				t.Fatalf("serviceConfigJSON() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("serviceConfigJSON() = %s, want %s", got, tt.want)
+			}
+			if !json.Valid([]byte(got)) {
+				t.Error("service config is not valid JSON")
+			}
+		})
+	}
+
+	if _, err := serviceConfigJSON(Config{Policy: "random"}); err == nil {
+		t.Error("serviceConfigJSON() accepted an unknown policy")
+	}
+}
+
+// startHealthServer serves only the health service, reporting status
+func startHealthServer(t *testing.T, status healthpb.HealthCheckResponse_ServingStatus) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	server := grpc.NewServer()
+	h := health.NewServer()
+	h.SetServingStatus("", status)
+	healthpb.RegisterHealthServer(server, h)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func TestRoundRobinSkipsUnhealthyEndpoints(t *testing.T) {
+	healthy := startHealthServer(t, healthpb.HealthCheckResponse_SERVING)
+	unhealthy := startHealthServer(t, healthpb.HealthCheckResponse_NOT_SERVING)
+	down := "127.0.0.1:1"
+
+	c, err := New(DefaultConfig(down, unhealthy, healthy))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	// Every call lands on the only healthy endpoint
+	health := healthpb.NewHealthClient(c.Conn())
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+		cancel()
+		if err != nil {
+			t.Fatalf("call %d: Check() error = %v", i, err)
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("call %d reached an endpoint reporting %v", i, resp.Status)
+		}
+	}
+}
+
+func TestReadYourWrites(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	// Writes return a newer token each time; every call records the token
+	// it was sent
+	var received []string
+	var position consistency.Token
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&healthpb.HealthCheckRequest{}); err != nil {
+			return err
+		}
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		received = append(received, strings.Join(md.Get(consistency.Header), ","))
+		if method, _ := grpc.MethodFromServerStream(stream); strings.HasSuffix(method, "/Invest") {
+			position += 0x10
+			stream.SetHeader(metadata.Pairs(consistency.Header, position.String()))
+		}
+		return stream.SendMsg(&healthpb.HealthCheckResponse{})
+	}))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	config := DefaultConfig(listener.Addr().String())
+	config.HealthCheck = false
+	c, err := New(config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	call := func(ctx context.Context, method string) {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		if err := c.Conn().Invoke(ctx, "/bonding.BondingService/"+method,
+			&healthpb.HealthCheckRequest{}, &healthpb.HealthCheckResponse{}); err != nil {
+			t.Fatalf("%s error = %v", method, err)
+		}
+	}
+	call(context.Background(), "GetPortfolio")
+	call(context.Background(), "Invest")
+	call(context.Background(), "GetPortfolio")
+	call(context.Background(), "Invest")
+	call(metadata.AppendToOutgoingContext(context.Background(), consistency.Header, "0/5"), "GetPortfolio")
+
+	want := []string{"", "", "0/10", "0/10", "0/5"}
+	if strings.Join(received, "|") != strings.Join(want, "|") {
+		t.Errorf("tokens sent = %q, want %q", received, want)
+	}
+	if got := c.ConsistencyToken(); got != "0/20" {
+		t.Errorf("ConsistencyToken() = %q, want 0/20", got)
+	}
+}
@@ -0,0 +1,150 @@
+// Command knowtonctl runs administrative tasks against the bonding service's
+// database.
+//
+// Usage:
+//
+//	knowtonctl migrate [flags] <action>
+//	knowtonctl seed [flags]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/knowton/bonding-service/internal/fakedata"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const usage = `Usage: knowtonctl <command> [flags]
+
+Commands:
+  migrate  Apply or revert database schema migrations
+  seed     Generate fake bonds, investments and distribution histories
+
+Run "knowtonctl <command> -h" for the command's flags.
+`
+
+func main() {
+	log.SetFlags(0)
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("Failed to load .env: %v", err)
+	}
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "migrate":
+		err = migrate(os.Args[2:])
+	case "seed":
+		err = seed(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// seed generates fake data into a migrated database
+func seed(args []string) error {
+	defaults := fakedata.DefaultConfig()
+	flags := flag.NewFlagSet("seed", flag.ExitOnError)
+	databaseURL := flags.String("database-url", getEnv("DATABASE_URL", "host=localhost user=postgres password=postgres dbname=knowton port=5432 sslmode=disable"), "database to seed")
+	seed := flags.Int64("seed", 1, "random seed; the same seed and flags generate the same data")
+	bonds := flags.Int("bonds", defaults.Bonds, "bonds to generate, each with three tranches")
+	investors := flags.Int("investors", defaults.Investors, "size of the investor pool")
+	investments := flags.Int("investments-per-bond", defaults.InvestmentsPerBond, "investments per bond")
+	tenantID := flags.String("tenant", defaults.TenantID, "tenant owning the bonds")
+	chain := flags.String("chain", defaults.Chain, "chain the bonds are issued on")
+	firstBondID := flags.Int("first-bond-id", 0, "ID of the first bond; 0 continues after the highest numeric bond ID")
+	asOf := flags.String("as-of", "", "date histories end at, as YYYY-MM-DD; defaults to today (UTC)")
+	dryRun := flags.Bool("dry-run", false, "generate and report without writing")
+	flags.Parse(args)
+
+	config := fakedata.Config{
+		Bonds:              *bonds,
+		Investors:          *investors,
+		InvestmentsPerBond: *investments,
+		TenantID:           *tenantID,
+		Chain:              *chain,
+		FirstBondID:        *firstBondID,
+		Now:                time.Now().UTC().Truncate(24 * time.Hour),
+	}
+	if *asOf != "" {
+		day, err := time.Parse("2006-01-02", *asOf)
+		if err != nil {
+			return fmt.Errorf("invalid -as-of: %w", err)
+		}
+		config.Now = day
+	}
+
+	var db *gorm.DB
+	if !*dryRun || config.FirstBondID == 0 {
+		var err error
+		db, err = gorm.Open(postgres.Open(*databaseURL), &gorm.Config{Logger: logger.Default.LogMode(logger.Warn)})
+		if err != nil {
+			return fmt.Errorf("failed to connect to database: %w", err)
+		}
+	}
+	if config.FirstBondID == 0 {
+		next, err := nextBondID(db)
+		if err != nil {
+			return err
+		}
+		config.FirstBondID = next
+	}
+
+	data := fakedata.New(*seed, config).Generate()
+	if !*dryRun {
+		if err := fakedata.Insert(context.Background(), db, data); err != nil {
+			return err
+		}
+	}
+	verb := "Seeded"
+	if *dryRun {
+		verb = "Would seed"
+	}
+	log.Printf("%s bonds %d to %d: %d tranches, %d investments, %d distributions, %d risk assessments",
+		verb, config.FirstBondID, config.FirstBondID+len(data.Bonds)-1,
+		len(data.Tranches), len(data.Investments), len(data.Distributions), len(data.RiskAssessments))
+	return nil
+}
+
+// nextBondID returns the ID after the highest numeric bond ID, so seeded
+// bonds don't collide with existing ones
+func nextBondID(db *gorm.DB) (int, error) {
+	var ids []string
+	if err := db.Model(&models.Bond{}).Unscoped().Where("bond_id ~ '^[0-9]+$'").Pluck("bond_id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to load bond IDs: %w", err)
+	}
+	next := 1
+	for _, id := range ids {
+		if n, err := strconv.Atoi(id); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	return next, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
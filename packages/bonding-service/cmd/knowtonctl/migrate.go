@@ -0,0 +1,111 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/knowton/bonding-service/internal/migrations"
+	"github.com/knowton/bonding-service/internal/sandbox"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const migrateUsage = `Usage: knowtonctl migrate [flags] <action>
+
+Actions:
+  up           Apply every pending migration
+  down [N]     Revert the last N migrations (default 1)
+  goto V       Migrate up or down to version V
+  force V      Record version V as applied without running it, after
+               repairing a migration that failed partway
+  version      Print the applied version
+
+Flags:
+`
+
+// migrate applies or reverts the embedded schema migrations
+func migrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+	databaseURL := flags.String("database-url", getEnv("DATABASE_URL", "host=localhost user=postgres password=postgres dbname=knowton port=5432 sslmode=disable"), "database to migrate")
+	inSandbox := flags.Bool("sandbox", false, "migrate the sandbox schema instead of the service's")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("missing action")
+	}
+
+	var db *gorm.DB
+	var err error
+	if *inSandbox {
+		db, err = sandbox.OpenDB(*databaseURL)
+	} else {
+		db, err = gorm.Open(postgres.Open(*databaseURL), &gorm.Config{Logger: logger.Default.LogMode(logger.Warn)})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	m, err := migrations.New(sqlDB)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	action, operand := flags.Arg(0), flags.Arg(1)
+	switch action {
+	case "up":
+		err = m.Up()
+	case "down":
+		steps := 1
+		if operand != "" {
+			if steps, err = strconv.Atoi(operand); err != nil {
+				return fmt.Errorf("invalid step count %q", operand)
+			}
+		}
+		err = m.Down(steps)
+	case "goto":
+		var version uint64
+		if version, err = strconv.ParseUint(operand, 10, 32); err != nil {
+			return fmt.Errorf("invalid version %q", operand)
+		}
+		err = m.Goto(uint(version))
+	case "force":
+		var version int
+		if version, err = strconv.Atoi(operand); err != nil {
+			return fmt.Errorf("invalid version %q", operand)
+		}
+		err = m.Force(version)
+	case "version":
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown action %q", action)
+	}
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	latest, err := migrations.Latest()
+	if err != nil {
+		return err
+	}
+	state := ""
+	if dirty {
+		state = " (dirty: the last migration failed partway)"
+	}
+	log.Printf("Schema at version %d of %d%s", version, latest, state)
+	return nil
+}
@@ -1,19 +1,87 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
+	"github.com/knowton/bonding-service/internal/admin"
+	"github.com/knowton/bonding-service/internal/accesslist"
+	"github.com/knowton/bonding-service/internal/accrual"
+	"github.com/knowton/bonding-service/internal/archive"
+	"github.com/knowton/bonding-service/internal/audit"
+	"github.com/knowton/bonding-service/internal/auth"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/chainwatch"
+	"github.com/knowton/bonding-service/internal/commitment"
+	"github.com/knowton/bonding-service/internal/comparables"
+	"github.com/knowton/bonding-service/internal/config"
+	"github.com/knowton/bonding-service/internal/consistency"
+	"github.com/knowton/bonding-service/internal/database"
+	"github.com/knowton/bonding-service/internal/deadline"
+	"github.com/knowton/bonding-service/internal/distribution"
+	"github.com/knowton/bonding-service/internal/documents"
+	"github.com/knowton/bonding-service/internal/eligibility"
+	"github.com/knowton/bonding-service/internal/fakedata"
+	"github.com/knowton/bonding-service/internal/ens"
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/forecast"
+	"github.com/knowton/bonding-service/internal/gateway"
+	"github.com/knowton/bonding-service/internal/gaswallet"
+	"github.com/knowton/bonding-service/internal/hooks"
+	"github.com/knowton/bonding-service/internal/indexer"
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/ipregistry"
+	"github.com/knowton/bonding-service/internal/kyc"
+	"github.com/knowton/bonding-service/internal/maintenance"
+	"github.com/knowton/bonding-service/internal/market"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/migrations"
 	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/notify"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/reassess"
+	"github.com/knowton/bonding-service/internal/reconcile"
+	"github.com/knowton/bonding-service/internal/revenue"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/rules"
+	"github.com/knowton/bonding-service/internal/rpcpool"
+	"github.com/knowton/bonding-service/internal/sandbox"
+	"github.com/knowton/bonding-service/internal/schedule"
 	"github.com/knowton/bonding-service/internal/service"
+	"github.com/knowton/bonding-service/internal/storage"
+	"github.com/knowton/bonding-service/internal/subscribe"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	"github.com/knowton/bonding-service/internal/usage"
+	"github.com/knowton/bonding-service/internal/validate"
+	"github.com/knowton/bonding-service/internal/viewcache"
 	pb "github.com/knowton/bonding-service/proto"
+	"golang.org/x/net/netutil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -23,72 +91,984 @@ func main() {
 		log.Println("No .env file found, using environment variables")
 	}
 
+	// Load settings from CONFIG_FILE, if set, overridden by the environment
+	configPath := os.Getenv("CONFIG_FILE")
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
 	// Initialize database
-	db, err := initDatabase()
+	db, err := initDatabase(cfg.Database)
 	if err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 
-	// Initialize Ethereum client
-	ethClient, err := ethclient.Dial(getEnv("ARBITRUM_RPC_URL", "https://arb1.arbitrum.io/rpc"))
+	// Background jobs run until shutdown, which waits for them to return
+	jobs, stopJobs := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	startJob := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(jobs)
+		}()
+	}
+
+	// Load the chain registry
+	chainRegistry, err := cfg.Chains.NewRegistry()
+	if err != nil {
+		log.Fatalf("Failed to load chain registry: %v", err)
+	}
+	defaultChain := chainRegistry.Default()
+
+	// Initialize Ethereum client for the default chain
+	poolConfig := cfg.Chains.PoolConfig()
+	ethClient, err := dialChain(defaultChain, poolConfig)
 	if err != nil {
 		log.Fatalf("Failed to connect to Ethereum client: %v", err)
 	}
 
-	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	// Handlers run within the client's deadline less a margin
+	deadlineConfig := deadline.DefaultConfig()
+	deadlineConfig.Margin = cfg.Server.DeadlineMargin.Duration
+	deadlineConfig.DefaultTimeout = cfg.Server.DefaultTimeout.Duration
+
+	// Count calls per tenant and API key, and enforce monthly quotas
+	usageConfig, err := cfg.Limits.UsageConfig()
+	if err != nil {
+		log.Fatalf("Failed to load API quotas: %v", err)
+	}
+	if len(usageConfig.Quotas) > 0 {
+		log.Printf("Loaded %d API quotas from %s", len(usageConfig.Quotas), cfg.Limits.QuotasFile)
+	}
+	usageRecorder, err := usage.New(db, usageConfig)
+	if err != nil {
+		log.Fatalf("Failed to create API usage recorder: %v", err)
+	}
+	startJob(usageRecorder.Start)
+
+	// Price oracle calls and cap what each tenant spends on them a day
+	spendConfig, err := cfg.Oracle.SpendConfig()
+	if err != nil {
+		log.Fatalf("Invalid oracle spend settings: %v", err)
+	}
+	oracleSpend, err := usage.NewSpendTracker(db, spendConfig)
+	if err != nil {
+		log.Fatalf("Invalid oracle spend settings: %v", err)
+	}
+
+	// API quotas and oracle prices and budgets follow the configuration on SIGHUP
+	reloader := config.NewReloader(configPath, cfg)
+	reloader.OnReload(func(c *config.Config) error {
+		usageConfig, err := c.Limits.UsageConfig()
+		if err != nil {
+			return err
+		}
+		return usageRecorder.SetQuotas(usageConfig.Quotas)
+	})
+	reloader.OnReload(func(c *config.Config) error {
+		spendConfig, err := c.Oracle.SpendConfig()
+		if err != nil {
+			return err
+		}
+		return oracleSpend.SetConfig(spendConfig)
+	})
+	startJob(reloader.Start)
+
+	// Freeze writes during scheduled maintenance windows, announced ahead to the
+	// webhook if one is configured
+	var maintenanceNotifier maintenance.Notifier
+	if url := cfg.Maintenance.WebhookURL; url != "" {
+		maintenanceNotifier = maintenance.NewWebhook(url)
+	}
+	maintenanceWindows := maintenance.NewStore(db, maintenanceNotifier, cfg.Maintenance.AnnounceBefore.Duration)
+	if err := maintenanceWindows.Load(context.Background()); err != nil {
+		log.Fatalf("Failed to load maintenance windows: %v", err)
+	}
+	startJob(func(ctx context.Context) { maintenanceWindows.Start(ctx, cfg.Maintenance.ReloadInterval.Duration) })
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		deadline.UnaryServerInterceptor(deadlineConfig),
+	}
+
+	// Authenticate callers by bearer token or API key and authorize calls by
+	// their roles; methods without roles are refused
+	authenticator, walletLogin, err := initAuth(cfg.Auth)
+	if err != nil {
+		log.Fatalf("Invalid authentication settings: %v", err)
+	}
+	if authenticator != nil {
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(authenticator))
+	} else {
+		log.Printf("No authentication configured; only the health check can be called")
+	}
+	perms := service.Permissions()
+	perms[healthpb.Health_Check_FullMethodName] = []rbac.Role{rbac.Anyone}
+	interceptors = append(interceptors, rbac.UnaryServerInterceptor(perms, nil))
+	interceptors = append(interceptors, usage.UnaryServerInterceptor(usageRecorder, rbac.PrincipalID))
+
+	// Refuse requests breaking the constraints annotated in bonding.proto
+	validator, err := validate.New()
+	if err != nil {
+		log.Fatalf("Failed to load request constraints: %v", err)
+	}
+	interceptors = append(interceptors, validate.UnaryServerInterceptor(validator))
+
+	// Serve sandbox API keys from their own schema against a simulation chain,
+	// unaffected by maintenance windows
+	if keys := sandbox.NewKeys(cfg.Sandbox.APIKeyIDs); len(keys) > 0 {
+		sandboxService, err := initSandbox(cfg.Database.URL, cfg.Sandbox, poolConfig)
+		if err != nil {
+			log.Fatalf("Failed to initialize sandbox: %v", err)
+		}
+		interceptors = append(interceptors, sandbox.UnaryServerInterceptor(keys, sandboxService))
+		log.Printf("Serving %d sandbox API keys", len(keys))
+	}
+
+	interceptors = append(interceptors, maintenance.UnaryServerInterceptor(maintenanceWindows, service.IsWriteMethod))
+
+	// Address allow and deny lists, managed through the admin RPCs
+	accessLists := accesslist.NewStore(db)
+	if err := accessLists.Load(context.Background()); err != nil {
+		log.Fatalf("Failed to load access lists: %v", err)
+	}
+	startJob(func(ctx context.Context) { accessLists.Start(ctx, cfg.Jobs.AccessListReloadInterval.Duration) })
+
+	// Feature flags gating risky capabilities, flipped through the admin RPCs
+	featureFlags := flags.NewStore(db)
+	if err := featureFlags.Load(context.Background()); err != nil {
+		log.Fatalf("Failed to load feature flags: %v", err)
+	}
+	startJob(func(ctx context.Context) { featureFlags.Start(ctx, cfg.Jobs.FeatureFlagReloadInterval.Duration) })
 
 	// Register bonding service
 	bondingService := service.NewBondingServiceServer(
 		db,
 		ethClient,
-		getEnv("IPBOND_CONTRACT_ADDRESS", "0x0000000000000000000000000000000000000000"),
-		getEnv("PRIVATE_KEY", ""),
+		cfg.Chains.IPBondContract,
+		cfg.Chains.OperatorKey,
 	)
+	bondingService.SetChainRegistry(chainRegistry)
+	bondingService.SetMaintenance(maintenanceWindows)
+	bondingService.SetAccessLists(accessLists)
+	bondingService.SetFeatureFlags(featureFlags)
+	auditLog := audit.NewRecorder(db)
+	bondingService.SetAuditLog(auditLog)
+
+	// Writes involving a listed address are refused before they reach the
+	// service. Writes return consistency tokens and reads presenting one wait
+	// until the database has applied it.
+	interceptors = append(interceptors,
+		accesslist.UnaryServerInterceptor(accessLists, service.IsWriteMethod, bondingService.AccessSubjects),
+		consistency.UnaryServerInterceptor(db, service.IsWriteMethod, cfg.Server.ConsistencyTimeout.Duration),
+	)
+	// Record every mutating call that reaches the service, whatever its outcome
+	interceptors = append(interceptors, audit.UnaryServerInterceptor(auditLog, service.IsAuditedMethod, bondingService.AuditSubject))
+	serverOptions, err := grpcServerOptions(cfg.Server, cfg.Limits)
+	if err != nil {
+		log.Fatalf("Invalid gRPC server configuration: %v", err)
+	}
+	grpcServer := grpc.NewServer(append(serverOptions, grpc.ChainUnaryInterceptor(interceptors...))...)
+
+	// Restrict writes to the chains and contracts this environment may use
+	if cfg.Chains.PolicyFile != "" {
+		policy, err := chains.LoadPolicyFile(cfg.Chains.PolicyFile)
+		if err != nil {
+			log.Fatalf("Invalid CHAIN_POLICY_FILE: %v", err)
+		}
+		bondingService.SetChainPolicy(policy)
+		log.Printf("Writes restricted to chain IDs %v in environment %s", policy.ChainIDs, policy.Environment)
+	} else {
+		log.Printf("CHAIN_POLICY_FILE not set; writes are not restricted to allowed chains")
+	}
+
+	// Require investors to be KYC verified; verifiers report by callback
+	var kycStore *kyc.Store
+	var kycProviders []kyc.Provider
+	if secrets := cfg.Integrations.KYCProviders; len(secrets) > 0 {
+		kycProviders = initKYCProviders(secrets)
+		kycStore = kyc.NewStore(db)
+		bondingService.SetKYC(kycStore)
+	} else {
+		log.Printf("KYC_PROVIDERS not set; investors are not required to be verified")
+	}
+
+	// Restrict tranches by investor accreditation and jurisdiction
+	if path := cfg.Integrations.EligibilityRulesFile; path != "" {
+		policy, err := eligibility.LoadPolicyFile(path)
+		if err != nil {
+			log.Fatalf("Invalid ELIGIBILITY_RULES_FILE: %v", err)
+		}
+		if kycStore == nil {
+			log.Printf("ELIGIBILITY_RULES_FILE set without KYC_PROVIDERS; every investor is treated as unaccredited")
+		}
+		bondingService.SetEligibility(policy)
+		log.Printf("Loaded %d eligibility rules from %s", len(policy.Rules), path)
+	}
+
+	// Load the IP category taxonomy; other instances' admin changes are
+	// picked up on each reload
+	categories := taxonomy.NewStore(db)
+	if err := categories.Load(context.Background()); err != nil {
+		log.Fatalf("Failed to load category taxonomy: %v", err)
+	}
+	bondingService.SetTaxonomy(categories)
+	startJob(func(ctx context.Context) { categories.Start(ctx, cfg.Jobs.TaxonomyReloadInterval.Duration) })
+	bondingService.SetComparables(comparables.NewStore(db))
+
+	// Risk models run side by side; requests name one or are routed by category
+	if len(cfg.Oracle.Providers) > 0 {
+		bondingService.EnableOracleRiskModel(initOracleAggregator(db, cfg.Oracle, oracleSpend))
+	} else if cfg.Oracle.URL != "" {
+		bondingService.EnableOracleRiskModel(newOracle("default", cfg.Oracle.URL, cfg.Oracle, oracleSpend))
+	}
+	bondingService.SetAssessConcurrency(cfg.Oracle.BatchConcurrency)
+	if err := bondingService.SetRiskModelRouting(cfg.Risk.DefaultModel, cfg.Risk.CategoryModels); err != nil {
+		log.Fatalf("Failed to configure risk models: %v", err)
+	}
+	riskTiers, err := risk.ParseTiers(cfg.Risk.Tiers)
+	if err != nil {
+		log.Fatalf("Invalid risk tiers: %v", err)
+	}
+	bondingService.SetRiskTiers(riskTiers)
 	pb.RegisterBondingServiceServer(grpcServer, bondingService)
 
+	// Start batch revenue distribution job
+	batchConfig := distribution.DefaultBatchConfig()
+	batchConfig.Interval = cfg.Distribution.BatchInterval.Duration
+	batchConfig.MaxTxPerWindow = cfg.Limits.DistributionMaxTxPerBlock
+	distributionQueue := distribution.NewBatchProcessor(db, bondingService, ethClient, batchConfig)
+	bondingService.SetDistributionQueue(distributionQueue)
+	startJob(distributionQueue.Start)
+
+	// Start scheduled revenue distributions, which queue onto the batch job
+	scheduleConfig := schedule.DefaultConfig()
+	scheduleConfig.Interval = cfg.Distribution.ScheduleInterval.Duration
+	revenueStore := revenue.NewStore(db)
+	bondingService.SetRevenue(revenueStore)
+	scheduler := schedule.New(db, distributionQueue, revenueStore, scheduleConfig)
+	bondingService.SetScheduler(scheduler)
+	startJob(scheduler.Start)
+
+	// Refuse distributions equivalent to one already in the ledger or on-chain
+	duplicateConfig := distribution.DefaultDuplicateConfig()
+	duplicateConfig.Window = cfg.Distribution.DuplicateWindow.Duration
+	duplicateConfig.ChainBlocks = cfg.Distribution.DuplicateChainBlocks
+	bondingService.SetDuplicateGuard(distribution.NewDuplicateGuard(db, duplicateConfig))
+
+	// Start chain watcher; writes pause while the node is syncing or lagging
+	thresholds := chainwatch.DefaultThresholds()
+	thresholds.MaxHeadAge = cfg.Chains.MaxHeadAge.Duration
+	thresholds.MaxIndexerLag = cfg.Chains.MaxIndexerLag
+	chainWatcher := chainwatch.NewWatcher(thresholds, 15*time.Second)
+	chainClients := make(map[string]*ethclient.Client)
+	for _, name := range chainRegistry.Names() {
+		client := ethClient
+		if name != defaultChain.Name {
+			chain, _ := chainRegistry.Get(name)
+			if client, err = dialChain(chain, poolConfig); err != nil {
+				log.Fatalf("Failed to connect to chain %s: %v", name, err)
+			}
+		}
+		chainClients[name] = client
+		bondingService.AddChainClient(name, client)
+		chainWatcher.AddChain(name, client)
+	}
+	bondingService.SetChainWatcher(chainWatcher, defaultChain.Name)
+
+	// Block writes while a bond contract is paused or its roles changed
+	// unexpectedly; checked at startup, on every poll and before each write
+	var expectedAdmin common.Address
+	if cfg.Chains.ExpectedAdmin != "" {
+		expectedAdmin = common.HexToAddress(cfg.Chains.ExpectedAdmin)
+	}
+	if err := bondingService.WatchContracts(expectedAdmin); err != nil {
+		log.Fatalf("Failed to watch bond contracts: %v", err)
+	}
+	startJob(chainWatcher.Start)
+
+	// Watch submitted transactions and alert on stuck or dropped ones
+	monitorConfig := txmonitor.DefaultConfig()
+	monitorConfig.StuckAfter = cfg.Chains.TxStuckAfter.Duration
+	monitorConfig.DroppedAfter = cfg.Chains.TxDroppedAfterChecks
+	txMonitor := txmonitor.New(monitorConfig)
+	for name, client := range chainClients {
+		txMonitor.AddChain(name, client)
+	}
+	txMonitor.SetJournal(db)
+	if resumed, err := txMonitor.Resume(context.Background()); err != nil {
+		log.Printf("Failed to resume transaction monitoring: %v", err)
+	} else if resumed > 0 {
+		log.Printf("Resumed monitoring of %d submitted transactions", resumed)
+	}
+	bondingService.SetTransactionMonitor(txMonitor)
+	startJob(txMonitor.Start)
+
+	// Watch the operator wallet's gas balance on every chain, proposing
+	// treasury top-ups when it runs low
+	if key := cfg.Chains.OperatorKey; key != "" {
+		walletWatcher, err := initWalletWatcher(key, cfg.Operator, chainRegistry, chainClients)
+		if err != nil {
+			log.Fatalf("Failed to initialize wallet watcher: %v", err)
+		}
+		startJob(walletWatcher.Start)
+	}
+
+	// Reconcile stored bond aggregates against the chain
+	reconcileConfig := reconcile.DefaultConfig()
+	reconcileConfig.Interval = cfg.Jobs.ReconcileInterval.Duration
+	reconcileConfig.AutoCorrect = cfg.Jobs.ReconcileAutoCorrect
+	reconciler := reconcile.New(db, bondingService, reconcileConfig)
+	bondingService.SetReconciler(reconciler)
+	startJob(reconciler.Start)
+
+	// Move the detail rows of long-closed bonds to the archive tables
+	archiveConfig := archive.DefaultConfig()
+	archiveConfig.After = cfg.Jobs.ArchiveAfter.Duration
+	archiveConfig.Interval = cfg.Jobs.ArchiveInterval.Duration
+	startJob(archive.New(db, archiveConfig).Start)
+
+	// Snapshot the interest each investment accrues per day
+	accrualConfig := accrual.DefaultConfig()
+	accrualConfig.Interval = cfg.Jobs.AccrualInterval.Duration
+	startJob(accrual.New(db, accrualConfig).Start)
+
+	// Flag bonds for a rating review when revenue keeps missing its forecast
+	varianceConfig := forecast.DefaultConfig()
+	varianceConfig.Threshold = cfg.Risk.RevenueVarianceThreshold
+	varianceConfig.Periods = cfg.Risk.RevenueVariancePeriods
+	varianceConfig.Interval = cfg.Risk.RevenueVarianceInterval.Duration
+	reviewer := forecast.New(db, varianceConfig)
+	bondingService.SetRevenueReviewer(reviewer)
+	bondingService.SetUsageRecorder(usageRecorder)
+	bondingService.SetOracleSpend(oracleSpend)
+	startJob(reviewer.Start)
+
+	// Re-run the risk assessment of active bonds and alert on sharp downgrades
+	reassessConfig := reassess.DefaultConfig()
+	reassessConfig.Interval = cfg.Risk.ReassessInterval.Duration
+	reassessConfig.AlertNotches = cfg.Risk.DowngradeAlertNotches
+	startJob(reassess.New(db, bondingService, reassessConfig).Start)
+
+	// Deliver investor notices from the outbox to the notification webhook
+	var outbox *notify.Outbox
+	if url := cfg.Integrations.NotificationWebhookURL; url != "" {
+		notifyConfig := notify.DefaultConfig()
+		notifyConfig.Interval = cfg.Jobs.NotificationInterval.Duration
+		outbox = notify.NewOutbox(db, notify.NewWebhook(url), notifyConfig)
+		bondingService.SetNotifications(outbox)
+		startJob(outbox.Start)
+	}
+	bondingService.SetEmergencyConfirmWindow(cfg.Auth.EmergencyConfirmWindow.Duration)
+
+	// Publish Merkle roots of bond positions so investors can verify them
+	if interval := cfg.Jobs.CommitmentInterval.Duration; interval > 0 {
+		commitmentConfig := commitment.DefaultConfig()
+		commitmentConfig.Interval = interval
+		startJob(commitment.New(db, bondingService, commitmentConfig).Start)
+	}
+
+	// Operator-configured business rules
+	if path := cfg.Integrations.BusinessRulesFile; path != "" {
+		engine, err := rules.LoadFile(path)
+		if err != nil {
+			log.Fatalf("Failed to load business rules: %v", err)
+		}
+		bondingService.SetRules(engine)
+		log.Printf("Loaded %d business rules from %s", len(engine.Rules()), path)
+	}
+
+	// Run deployment hooks around issuance, investment and distribution
+	if specs := cfg.Integrations.Hooks; len(specs) > 0 {
+		runner, err := initHooks(specs, cfg.Integrations)
+		if err != nil {
+			log.Fatalf("Failed to load hooks: %v", err)
+		}
+		bondingService.SetHooks(runner)
+		log.Printf("Loaded %d hooks", runner.Len())
+	}
+
+	// Cache contract view calls in Redis when configured
+	var viewCache *viewcache.Cache
+	var marketCache viewcache.Backend = viewcache.NewMemoryBackend()
+	if redisURL := cfg.Integrations.RedisURL; redisURL != "" {
+		backend, err := viewcache.NewRedisBackend(context.Background(), redisURL)
+		if err != nil {
+			log.Printf("View call cache disabled: %v", err)
+		} else {
+			viewCache = viewcache.New(backend, cfg.Integrations.ViewCacheTTL.Duration)
+			bondingService.SetViewCache(viewCache)
+			marketCache = backend
+		}
+	}
+
+	// Market analyses are shared through Redis when configured, else cached per instance
+	marketConfig := market.DefaultConfig()
+	marketConfig.TTL = cfg.Integrations.MarketAnalysisTTL.Duration
+	bondingService.SetMarketAnalyzer(market.New(db, marketCache, marketConfig))
+
+	// Index bond contract events on every chain with a deployed contract
+	indexerConfig := indexer.DefaultConfig()
+	indexerConfig.StartBlock = cfg.Chains.IndexerStartBlock
+	indexerConfig.ReorgWindow = cfg.Chains.IndexerReorgWindow
+	for _, name := range chainRegistry.Names() {
+		chain, _ := chainRegistry.Get(name)
+		contract := common.HexToAddress(chain.Contracts.IPBond)
+		if !common.IsHexAddress(chain.Contracts.IPBond) || contract == (common.Address{}) {
+			continue
+		}
+		eventIndexer, err := indexer.New(db, name, chainClients[name], contract, indexerConfig)
+		if err != nil {
+			log.Fatalf("Failed to create indexer for %s: %v", name, err)
+		}
+		eventIndexer.SetProgress(chainWatcher)
+		eventIndexer.OnLog(viewCache.HandleLog)
+		startJob(eventIndexer.Start)
+
+		// With websocket endpoints, new heads trigger indexing immediately and
+		// contract logs invalidate cached views before they are indexed
+		if len(chain.WSURLs) > 0 {
+			subscriberConfig := subscribe.DefaultConfig()
+			subscriberConfig.Addresses = []common.Address{contract}
+			subscriber := subscribe.New(name, subscribe.Dial(chain.WSURLs), subscriberConfig)
+			subscriber.OnHead(func(*types.Header) { eventIndexer.Notify() })
+			chainName := name
+			subscriber.OnLog(func(l types.Log) { viewCache.HandleLog(context.Background(), chainName, l) })
+			startJob(subscriber.Start)
+		}
+	}
+
+	// Record royalty distributor payments as verified revenue on every chain
+	// with a distributor
+	royaltyConfig := revenue.DefaultRoyaltyConfig()
+	royaltyConfig.StartBlock = cfg.Chains.RoyaltyStartBlock
+	for _, name := range chainRegistry.Names() {
+		chain, _ := chainRegistry.Get(name)
+		contract := common.HexToAddress(chain.Contracts.RoyaltyDistributor)
+		if !common.IsHexAddress(chain.Contracts.RoyaltyDistributor) || contract == (common.Address{}) {
+			continue
+		}
+		chainConfig := royaltyConfig
+		if chain.Confirmations > 0 {
+			chainConfig.Confirmations = chain.Confirmations
+		}
+		startJob(revenue.NewRoyaltyWatcher(db, revenueStore, name, chainClients[name], contract, chainConfig).Start)
+	}
+
+	// Verify patent and trademark registrations when registry gateways are configured
+	if registries := cfg.Integrations.IPRegistryURLs; len(registries) > 0 {
+		bondingService.SetIPRegistry(initIPRegistry(registries, cfg.Integrations.IPRegistryAPIKey))
+	}
+
+	// Read IP-NFT metadata from token URIs when IPFS gateways are configured
+	if gateways := cfg.Integrations.IPFSGateways; len(gateways) > 0 {
+		bondingService.SetMetadataResolver(ipmeta.NewResolver(gateways))
+	}
+
+	// Fingerprint bond content through the oracle to catch content already backing a bond
+	oracleURL := cfg.Oracle.FingerprintURL
+	if oracleURL == "" {
+		oracleURL = cfg.Oracle.URL
+	}
+	if policy := cfg.Oracle.DuplicateContentPolicy; oracleURL != "" && policy != "off" {
+		bondingService.SetFingerprinter(oracle.NewOracleClient(oracleURL), policy == "flag")
+	}
+
+	// Enable ENS names when mainnet resolver endpoints are configured
+	if urls := cfg.Integrations.ENSRPCURLs; len(urls) > 0 {
+		if resolver, err := initENSResolver(urls, cfg.Integrations); err != nil {
+			log.Printf("ENS resolution disabled: %v", err)
+		} else {
+			bondingService.SetENSResolver(resolver)
+		}
+	}
+
+	// Serve Prometheus metrics, the public REST gateway and, for local document
+	// storage, signed downloads
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	publicGateway := gateway.New(db, cfg.Server.GatewayCacheMaxAge.Duration)
+	publicGateway.SetConsistencyTimeout(cfg.Server.ConsistencyTimeout.Duration)
+	mux.Handle("/v1/", publicGateway.Handler())
+	if kycStore != nil {
+		mux.Handle("/kyc/", kyc.Handler(kycStore, kycProviders...))
+	}
+	if walletLogin != nil {
+		mux.Handle("/auth/", walletLogin.Handler())
+	}
+	if secrets := cfg.Integrations.RevenueWebhooks; len(secrets) > 0 {
+		mux.Handle("/revenue/", revenue.Handler(revenueStore, initRevenueWebhooks(secrets)...))
+	}
+
+	// Serve the embedded admin dashboard to operators holding its token
+	if token := cfg.Auth.AdminUIToken; token != "" {
+		dashboard := admin.New(bondingService, db, token)
+		dashboard.SetFlags(func() []admin.Flag {
+			return append(adminFlags(cfg), featureFlagsForAdmin(featureFlags)...)
+		})
+		mux.Handle("/admin/", dashboard.Handler())
+	}
+
+	// Initialize document storage
+	if cfg.Documents.Storage != "" {
+		manager, handler, err := initDocumentManager(db, cfg.Documents)
+		if err != nil {
+			log.Fatalf("Failed to initialize document storage: %v", err)
+		}
+		if handler != nil {
+			mux.Handle("/documents/", handler)
+		}
+		bondingService.SetDocumentManager(manager)
+		startJob(func(ctx context.Context) { manager.Start(ctx, time.Hour) })
+	}
+
+	metricsPort := cfg.Server.MetricsPort
+	httpServer := &http.Server{Addr: fmt.Sprintf(":%s", metricsPort), Handler: mux}
+	go func() {
+		log.Printf("Metrics listening on port %s", metricsPort)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("Metrics server stopped: %v", err)
+		}
+	}()
+
 	// Register reflection service for grpcurl
 	reflection.Register(grpcServer)
 
+	// Report health to load-balancing clients and orchestrators
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
 	// Start server
-	port := getEnv("GRPC_PORT", "50051")
+	port := cfg.Server.Port
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
+	if maxConns := cfg.Limits.MaxConnections; maxConns > 0 {
+		// Connections beyond the limit wait in the accept backlog
+		listener = netutil.LimitListener(listener, maxConns)
+	}
 
+	// Serve until SIGINT or SIGTERM; a second signal exits immediately
+	signals, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	served := make(chan error, 1)
+	go func() {
+		served <- grpcServer.Serve(listener)
+	}()
 	log.Printf("Bonding Service gRPC server listening on port %s", port)
-	if err := grpcServer.Serve(listener); err != nil {
+	select {
+	case err := <-served:
 		log.Fatalf("Failed to serve: %v", err)
+	case <-signals.Done():
 	}
+	stopSignals()
+
+	(&shutdown{
+		health:       healthServer,
+		grpc:         grpcServer,
+		http:         httpServer,
+		stopJobs:     stopJobs,
+		jobs:         &workers,
+		txMonitor:    txMonitor,
+		outbox:       outbox,
+		db:           db,
+		chainClients: chainClients,
+	}).run(cfg.Server.ShutdownTimeout.Duration)
 }
 
-func initDatabase() (*gorm.DB, error) {
-	dsn := getEnv("DATABASE_URL", "host=localhost user=postgres password=postgres dbname=knowton port=5432 sslmode=disable")
-	
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func initDatabase(c config.Database) (*gorm.DB, error) {
+	db, err := database.Open(c.URL, c.PoolConfig())
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %w", err)
+		return nil, err
 	}
-
-	// Auto-migrate models
-	if err := db.AutoMigrate(
-		&models.Bond{},
-		&models.Tranche{},
-		&models.Investment{},
-		&models.RevenueDistribution{},
-		&models.RiskAssessment{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	if err := migrations.Verify(db); err != nil {
+		return nil, err
 	}
 
-	log.Println("Database initialized successfully")
+	log.Printf("Database initialized successfully with %d read replicas", len(c.ReplicaURLs))
 	return db, nil
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// initSandbox creates the service sandbox API keys are served from: its own
+// database schema, seeded with fake data when empty, and a simulation chain
+// such as a local devnet
+func initSandbox(databaseURL string, settings config.Sandbox, poolConfig rpcpool.Config) (*service.BondingServiceServer, error) {
+	db, err := sandbox.OpenDB(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrations.Verify(db); err != nil {
+		return nil, fmt.Errorf("sandbox schema: %w", err)
+	}
+
+	chain := &chains.Chain{
+		Name:          sandbox.ChainName,
+		ChainID:       settings.ChainID,
+		RPCURLs:       []string{settings.RPCURL},
+		Contracts:     chains.Contracts{IPBond: settings.IPBondContract},
+		Confirmations: 1,
+		Gas:           chains.DefaultGasStrategy(),
+	}
+	registry := chains.NewRegistry(sandbox.ChainName)
+	if err := registry.Register(chain); err != nil {
+		return nil, err
+	}
+	client, err := dialChain(chain, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the simulation chain: %w", err)
+	}
+
+	var bonds int64
+	if err := db.Model(&models.Bond{}).Count(&bonds).Error; err != nil {
+		return nil, fmt.Errorf("failed to count sandbox bonds: %w", err)
+	}
+	if bonds == 0 {
+		config := fakedata.DefaultConfig()
+		config.Chain = sandbox.ChainName
+		if err := fakedata.Insert(context.Background(), db, fakedata.New(time.Now().UnixNano(), config).Generate()); err != nil {
+			return nil, fmt.Errorf("failed to seed sandbox: %w", err)
+		}
+	}
+
+	sandboxService := service.NewBondingServiceServer(db, client, chain.Contracts.IPBond, settings.PrivateKey)
+	sandboxService.SetChainRegistry(registry)
+	sandboxService.AddChainClient(chain.Name, client)
+	categories := taxonomy.NewStore(db)
+	if err := categories.Load(context.Background()); err != nil {
+		return nil, err
+	}
+	sandboxService.SetTaxonomy(categories)
+	sandboxService.SetComparables(comparables.NewStore(db))
+	sandboxService.SetMarketAnalyzer(market.New(db, viewcache.NewMemoryBackend(), market.DefaultConfig()))
+	return sandboxService, nil
+}
+
+// initHooks loads hooks from NAME=plugin:PATH and NAME=grpc:TARGET pairs
+func initHooks(specs []string, settings config.Integrations) (*hooks.Runner, error) {
+	hookConfig := hooks.DefaultConfig()
+	hookConfig.Timeout = settings.HookTimeout.Duration
+	hookConfig.FailOpen = settings.HookFailOpen
+
+	runner := hooks.NewRunner(hookConfig)
+	for _, spec := range specs {
+		name, target, ok := strings.Cut(spec, "=")
+		kind, location, hasKind := strings.Cut(target, ":")
+		if !ok || name == "" || !hasKind || location == "" {
+			return nil, fmt.Errorf("expected NAME=plugin:PATH or NAME=grpc:TARGET, got %q", spec)
+		}
+		var hook hooks.Hook
+		var err error
+		switch kind {
+		case "plugin":
+			hook, err = hooks.LoadPlugin(location)
+		case "grpc":
+			hook, err = hooks.DialGRPC(location)
+		default:
+			err = fmt.Errorf("unknown hook kind %q", kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+		runner.Register(name, hook)
+	}
+	return runner, nil
+}
+
+// initIPRegistry routes each jurisdiction to its registry gateway
+func initIPRegistry(registries map[string]string, apiKey string) *ipregistry.Router {
+	router := ipregistry.NewRouter()
+	for jurisdiction, url := range registries {
+		router.Register(jurisdiction, ipregistry.NewHTTPRegistry(url, apiKey))
+	}
+	return router
+}
+
+// initWalletWatcher watches the wallet of operatorKey on every chain.
+// Treasury Safes, with their transaction services, top up each chain's
+// wallet.
+func initWalletWatcher(operatorKey string, settings config.Operator, registry *chains.Registry, clients map[string]*ethclient.Client) (*gaswallet.Watcher, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(operatorKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator key: %w", err)
+	}
+	operator := crypto.PubkeyToAddress(key.PublicKey)
+	minBalance, topUp := settings.Balances()
+
+	watcherConfig := gaswallet.DefaultConfig()
+	watcherConfig.Interval = settings.BalanceInterval.Duration
+	watcherConfig.TopUpCooldown = settings.TopUpCooldown.Duration
+	watcher := gaswallet.NewWatcher(watcherConfig)
+	for name, client := range clients {
+		watcher.AddWallet(gaswallet.Wallet{Chain: name, Address: operator, MinBalance: minBalance, TopUp: topUp}, client)
+	}
+
+	if len(settings.TreasurySafes) == 0 {
+		return watcher, nil
+	}
+	proposerKey, err := crypto.HexToECDSA(strings.TrimPrefix(settings.SafeProposerKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Safe proposer key: %w", err)
+	}
+	for name, target := range settings.TreasurySafes {
+		safe, serviceURL, _ := strings.Cut(target, "@")
+		chain, err := registry.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		watcher.SetProposer(name, gaswallet.NewSafeProposer(serviceURL, common.HexToAddress(safe), chain.ChainID, proposerKey))
+	}
+	return watcher, nil
+}
+
+// initAuth configures the credentials callers may present: API keys granted
+// roles in the roles file, wallet login tokens signed with the JWT secret and
+// the OIDC issuer's tokens. The authenticator is nil when none is configured.
+func initAuth(settings config.Auth) (*auth.Authenticator, *auth.WalletLogin, error) {
+	keyRoles, err := settings.KeyRoles()
+	if err != nil {
+		return nil, nil, err
+	}
+	if keyRoles != nil {
+		log.Printf("Loaded roles for %d API keys from %s", len(keyRoles), settings.RolesFile)
+	}
+
+	var verifiers []auth.TokenVerifier
+	var walletLogin *auth.WalletLogin
+	if secret := settings.JWTSecret; secret != "" {
+		walletLogin = auth.NewWalletLogin([]byte(secret), settings.TokenTTL.Duration, settings.Issuers(), settings.WalletTenant)
+		verifiers = append(verifiers, walletLogin)
+	}
+	if issuer := settings.OIDCIssuer; issuer != "" {
+		verifiers = append(verifiers, auth.NewOIDCVerifier(issuer, settings.OIDCAudience, settings.OIDCRolesClaim, settings.OIDCTenantClaim))
+		log.Printf("Accepting OIDC tokens from %s", issuer)
+	}
+
+	if keyRoles == nil && len(verifiers) == 0 {
+		return nil, nil, nil
+	}
+	return auth.New(keyRoles, verifiers...), walletLogin, nil
+}
+
+// grpcServerOptions builds the gRPC server's transport security, keepalive
+// and per-connection limits. Zero durations and sizes keep grpc's defaults.
+func grpcServerOptions(server config.Server, limits config.Limits) ([]grpc.ServerOption, error) {
+	var options []grpc.ServerOption
+
+	if certFile := server.TLSCertFile; certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, server.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+		if caFile := server.TLSClientCAFile; caFile != "" {
+			pem, err := os.ReadFile(caFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read client CA: %w", err)
+			}
+			tlsConfig.ClientCAs = x509.NewCertPool()
+			if !tlsConfig.ClientCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates in %s", caFile)
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			log.Printf("gRPC clients must present a certificate signed by %s", caFile)
+		}
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		log.Printf("Warning: no TLS certificate configured, serving gRPC without TLS")
+	}
+
+	options = append(options,
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  server.KeepaliveTime.Duration,
+			Timeout:               server.KeepaliveTimeout.Duration,
+			MaxConnectionIdle:     server.MaxConnectionIdle.Duration,
+			MaxConnectionAge:      server.MaxConnectionAge.Duration,
+			MaxConnectionAgeGrace: server.MaxConnectionAgeGrace.Duration,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             server.KeepaliveMinTime.Duration,
+			PermitWithoutStream: server.KeepalivePermitWithoutStream,
+		}),
+	)
+	if timeout := server.ConnectionTimeout.Duration; timeout > 0 {
+		options = append(options, grpc.ConnectionTimeout(timeout))
+	}
+
+	if n := limits.MaxConcurrentStreams; n > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(uint32(n)))
+	}
+	if n := limits.MaxRecvMsgSize; n > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(n))
+	}
+	if n := limits.MaxSendMsgSize; n > 0 {
+		options = append(options, grpc.MaxSendMsgSize(n))
+	}
+	return options, nil
+}
+
+// initKYCProviders creates one webhook provider per verifier, by name
+func initKYCProviders(secrets map[string]string) []kyc.Provider {
+	var providers []kyc.Provider
+	for _, name := range sortedKeys(secrets) {
+		providers = append(providers, kyc.NewWebhookProvider(name, []byte(secrets[name])))
+	}
+	return providers
+}
+
+// initRevenueWebhooks creates one webhook per revenue reporter, by name
+func initRevenueWebhooks(secrets map[string]string) []*revenue.Webhook {
+	var webhooks []*revenue.Webhook
+	for _, name := range sortedKeys(secrets) {
+		webhooks = append(webhooks, revenue.NewWebhook(name, []byte(secrets[name])))
+	}
+	return webhooks
+}
+
+// initOracleAggregator builds an aggregator over the configured providers
+func initOracleAggregator(db *gorm.DB, settings config.Oracle, spend oracle.SpendMeter) *oracle.Aggregator {
+	var providers []oracle.Provider
+	for _, name := range sortedKeys(settings.Providers) {
+		client := newOracle(name, settings.Providers[name], settings, spend)
+		providers = append(providers, oracle.Provider{Name: name, Valuer: client})
+	}
+	return oracle.NewAggregator(db, providers, settings.AggregatorConfig())
+}
+
+// newOracle creates a metered, guarded client of the oracle at url. With a
+// signer address configured only valuations it signed are accepted.
+func newOracle(name, url string, settings config.Oracle, spend oracle.SpendMeter) oracle.Valuer {
+	client := oracle.NewOracleClient(url)
+	if settings.SignerAddress != "" {
+		client.RequireSigner(common.HexToAddress(settings.SignerAddress))
+	}
+	return oracle.NewGuard(name, oracle.NewMetered(name, client, spend), settings.GuardConfig())
+}
+
+// dialChain connects to a chain through a failover pool over its RPC endpoints.
+// Non-HTTP endpoints (e.g. websockets) are dialed directly without failover.
+func dialChain(chain *chains.Chain, config rpcpool.Config) (*ethclient.Client, error) {
+	pool, err := rpcpool.New(chain.Name, chain.RPCURLs, config)
+	if err != nil {
+		log.Printf("RPC failover disabled for %s: %v", chain.Name, err)
+		return ethclient.Dial(chain.RPCURLs[0])
+	}
+	go pool.Start(context.Background())
+	return pool.Dial(context.Background())
+}
+
+// initDocumentManager builds the document store for the configured backend
+// (local, s3 or gcs). For local storage it also returns the handler serving
+// signed downloads.
+func initDocumentManager(db *gorm.DB, settings config.Documents) (*documents.Manager, http.Handler, error) {
+	var store storage.Store
+	var handler http.Handler
+
+	objectConfig := storage.ObjectStoreConfig{
+		Endpoint:  settings.Endpoint,
+		Region:    settings.Region,
+		Bucket:    settings.Bucket,
+		AccessKey: settings.AccessKey,
+		SecretKey: settings.SecretKey,
+		PathStyle: settings.PathStyle,
+	}
+
+	switch settings.Storage {
+	case "local":
+		local, err := storage.NewLocalStore(settings.LocalDir, settings.BaseURL, settings.SigningSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+		store = local
+		handler = local.Handler("/documents")
+	case "s3":
+		s3, err := storage.NewS3Store(objectConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		store = s3
+	case "gcs":
+		gcs, err := storage.NewGCSStore(objectConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		store = gcs
+	default:
+		return nil, nil, fmt.Errorf("unknown document storage backend: %s", settings.Storage)
+	}
+
+	var scanner storage.Scanner
+	if addr := settings.ClamAVAddr; addr != "" {
+		scanner = storage.NewClamAVScanner(addr, 30*time.Second)
+	}
+
+	retention, err := documents.ParseRetention(settings.Retention)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return documents.NewManager(db, store, scanner, retention), handler, nil
+}
+
+func initENSResolver(urls []string, settings config.Integrations) (*ens.Resolver, error) {
+	ensConfig := ens.DefaultConfig()
+	if registry := settings.ENSRegistry; registry != "" {
+		ensConfig.Registry = common.HexToAddress(registry)
+	}
+	ensConfig.CacheTTL = settings.ENSCacheTTL.Duration
+
+	var endpoints []ethereum.ContractCaller
+	for _, url := range urls {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to ENS endpoint %s: %w", url, err)
+		}
+		endpoints = append(endpoints, client)
+	}
+
+	return ens.NewResolver(ensConfig, endpoints...)
+}
+
+// adminFlags lists the optional features this deployment has turned on
+func adminFlags(cfg *config.Config) []admin.Flag {
+	return []admin.Flag{
+		{Name: "reconcile_auto_correct", Enabled: cfg.Jobs.ReconcileAutoCorrect,
+			Description: "Reconciliation overwrites stored bond state with the on-chain state"},
+		{Name: "duplicate_chain_check", Enabled: cfg.Distribution.DuplicateChainBlocks != 0,
+			Description: "Distributions are checked against on-chain payouts missing from the ledger"},
+		{Name: "view_cache", Enabled: cfg.Integrations.RedisURL != "",
+			Description: "Contract views are cached in Redis"},
+		{Name: "document_storage", Enabled: cfg.Documents.Storage != "",
+			Description: "Bond documents are stored and served with signed URLs"},
+		{Name: "sandbox", Enabled: len(cfg.Sandbox.APIKeyIDs) > 0,
+			Description: "Sandbox API keys are served against a simulation chain"},
+		{Name: "ens", Enabled: len(cfg.Integrations.ENSRPCURLs) > 0,
+			Description: "ENS names are accepted wherever an address is"},
+	}
+}
+
+// featureFlagsForAdmin lists the feature flags, on if any tenant has them
+func featureFlagsForAdmin(store *flags.Store) []admin.Flag {
+	var list []admin.Flag
+	for _, f := range store.List() {
+		known, _ := flags.Lookup(f.Name)
+		list = append(list, admin.Flag{
+			Name:        f.Name,
+			Enabled:     f.Enabled && (f.RolloutPercent > 0 || f.Tenants != ""),
+			Description: known.Description,
+		})
+	}
+	return list
+}
+
+// sortedKeys returns a map's keys in order, so providers are created
+// deterministically
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
-	return defaultValue
+	sort.Strings(keys)
+	return keys
 }
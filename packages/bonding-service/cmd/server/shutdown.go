@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/knowton/bonding-service/internal/notify"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"gorm.io/gorm"
+)
+
+// shutdown is what a graceful shutdown stops, in the order it stops them
+type shutdown struct {
+	health       *health.Server
+	grpc         *grpc.Server
+	http         *http.Server
+	stopJobs     context.CancelFunc
+	jobs         *sync.WaitGroup
+	txMonitor    *txmonitor.Monitor
+	outbox       *notify.Outbox // Nil without a notification webhook
+	db           *gorm.DB
+	chainClients map[string]*ethclient.Client
+}
+
+// run stops accepting RPCs and drains in-flight ones, stops background jobs,
+// waits for submitted transactions, flushes the notification outbox and
+// closes connections. Steps still running at the deadline are cut short.
+func (s *shutdown) run(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	log.Printf("Shutting down, waiting up to %s", timeout)
+
+	// Tell load balancers to route elsewhere, then let in-flight RPCs finish
+	s.health.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Printf("In-flight RPCs didn't finish in time; closing their connections")
+		s.grpc.Stop()
+	}
+	if err := s.http.Shutdown(ctx); err != nil {
+		log.Printf("Failed to stop the HTTP server: %v", err)
+	}
+
+	s.stopJobs()
+	jobsDone := make(chan struct{})
+	go func() {
+		s.jobs.Wait()
+		close(jobsDone)
+	}()
+	select {
+	case <-jobsDone:
+	case <-ctx.Done():
+		log.Printf("Background jobs didn't stop in time")
+	}
+
+	if left := s.txMonitor.Drain(ctx); left > 0 {
+		log.Printf("%d submitted transactions are still unmined; monitoring resumes on the next start", left)
+	}
+	if s.outbox != nil {
+		if err := s.outbox.Flush(ctx); err != nil {
+			log.Printf("Failed to flush notifications: %v", err)
+		}
+	}
+
+	for _, client := range s.chainClients {
+		client.Close()
+	}
+	if sqlDB, err := s.db.DB(); err == nil {
+		if err := sqlDB.Close(); err != nil {
+			log.Printf("Failed to close the database: %v", err)
+		}
+	}
+	log.Println("Shutdown complete")
+}
@@ -4,22 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"time"
 
+	bonding "github.com/knowton/bonding-service/client"
 	pb "github.com/knowton/bonding-service/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
-	// Connect to bonding service
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Connect to the bonding service instances, balancing calls across them
+	endpoints := []string{"localhost:50051"}
+	if list := os.Getenv("BONDING_SERVICE_ENDPOINTS"); list != "" {
+		endpoints = strings.Split(list, ",")
+	}
+	client, err := bonding.New(bonding.DefaultConfig(endpoints...))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
-	defer conn.Close()
-
-	client := pb.NewBondingServiceClient(conn)
+	defer client.Close()
 	ctx := context.Background()
 
 	// Example 1: Assess IP Risk
@@ -85,7 +88,7 @@ func main() {
 	fmt.Printf("Status: %s\n", bondResp.Status)
 	fmt.Println("\nTranches:")
 	for _, tranche := range bondResp.Tranches {
-		fmt.Printf("  - %s (Priority %d): Allocation=%s, APY=%.2f%%, Risk=%s\n",
+		fmt.Printf("  - %s (Priority %d): Allocation=%s, APY=%s%%, Risk=%s\n",
 			tranche.Name, tranche.Priority, tranche.Allocation, tranche.Apy, tranche.RiskLevel)
 	}
 	fmt.Println()
@@ -110,7 +113,7 @@ func main() {
 
 	// Example 4: Invest in Bond
 	fmt.Println("=== Investing in Bond ===")
-	investResp, err := client.InvestInBond(ctx, &pb.InvestInBondRequest{
+	investResp, err := client.Invest(ctx, &pb.InvestRequest{
 		BondId:          bondResp.BondId,
 		TrancheId:       0, // Senior tranche
 		Amount:          "10000000000000000000", // 10 ETH
@@ -0,0 +1,190 @@
+// Package accesslist keeps per-tenant allow and deny lists of addresses, for
+// all of a tenant's bonds or for one, and refuses write RPCs involving an
+// address the lists exclude. An address on a deny list is refused; once an
+// allow list has entries, only the addresses on it are accepted.
+package accesslist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Errors returned by the store
+var (
+	ErrDenied       = errors.New("address not permitted")
+	ErrInvalidEntry = errors.New("invalid access list entry")
+)
+
+// scope is a tenant's global lists, or one bond's when bondID is set
+type scope struct {
+	tenantID string
+	bondID   string
+}
+
+// lists holds a scope's entries, keyed by lowercase address, with the reason
+// given for each
+type lists struct {
+	allow map[string]string
+	deny  map[string]string
+}
+
+// Store keeps access lists in the database and checks addresses against an
+// in-memory copy. Entries added through another instance are picked up by
+// Start.
+type Store struct {
+	db     *gorm.DB
+	scopes atomic.Pointer[map[scope]*lists]
+}
+
+// NewStore creates a store
+func NewStore(db *gorm.DB) *Store {
+	s := &Store{db: db}
+	s.scopes.Store(&map[scope]*lists{})
+	return s
+}
+
+// Load reads every entry
+func (s *Store) Load(ctx context.Context) error {
+	var entries []models.AccessListEntry
+	if err := s.db.WithContext(ctx).Find(&entries).Error; err != nil {
+		return fmt.Errorf("failed to load access lists: %w", err)
+	}
+
+	scopes := make(map[scope]*lists)
+	for _, entry := range entries {
+		key := scope{entry.TenantID, entry.BondID}
+		l := scopes[key]
+		if l == nil {
+			l = &lists{allow: make(map[string]string), deny: make(map[string]string)}
+			scopes[key] = l
+		}
+		if entry.List == models.AccessListDeny {
+			l.deny[entry.Address] = entry.Reason
+		} else {
+			l.allow[entry.Address] = entry.Reason
+		}
+	}
+	s.scopes.Store(&scopes)
+	return nil
+}
+
+// Start reloads the lists periodically until the context is cancelled
+func (s *Store) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Failed to reload access lists: %v", err)
+			}
+		}
+	}
+}
+
+// Check returns an error wrapping ErrDenied for the first address the
+// tenant's global lists or the bond's lists exclude. Deny entries take
+// precedence over allow entries.
+func (s *Store) Check(tenantID, bondID string, addresses []string) error {
+	scopes := *s.scopes.Load()
+	global := scopes[scope{tenantID, ""}]
+	var bond *lists
+	if bondID != "" {
+		bond = scopes[scope{tenantID, bondID}]
+	}
+
+	for _, address := range addresses {
+		address = strings.ToLower(address)
+		if global != nil {
+			if reason, denied := global.deny[address]; denied {
+				return fmt.Errorf("%w: %s is on the deny list: %s", ErrDenied, address, reason)
+			}
+		}
+		if bond != nil {
+			if reason, denied := bond.deny[address]; denied {
+				return fmt.Errorf("%w: %s is on the deny list of bond %s: %s", ErrDenied, address, bondID, reason)
+			}
+		}
+		if global != nil && len(global.allow) > 0 {
+			if _, allowed := global.allow[address]; !allowed {
+				return fmt.Errorf("%w: %s is not on the allow list", ErrDenied, address)
+			}
+		}
+		if bond != nil && len(bond.allow) > 0 {
+			if _, allowed := bond.allow[address]; !allowed {
+				return fmt.Errorf("%w: %s is not on the allow list of bond %s", ErrDenied, address, bondID)
+			}
+		}
+	}
+	return nil
+}
+
+// Add puts an address on a list, updating the reason if it is already there
+func (s *Store) Add(ctx context.Context, tenantID, bondID, list, address, reason string) (*models.AccessListEntry, error) {
+	list = strings.ToUpper(list)
+	switch {
+	case list != models.AccessListAllow && list != models.AccessListDeny:
+		return nil, fmt.Errorf("%w: list must be ALLOW or DENY", ErrInvalidEntry)
+	case !common.IsHexAddress(address):
+		return nil, fmt.Errorf("%w: invalid address %q", ErrInvalidEntry, address)
+	}
+
+	entry := &models.AccessListEntry{
+		TenantID: tenantID,
+		BondID:   bondID,
+		List:     list,
+		Address:  strings.ToLower(common.HexToAddress(address).Hex()),
+		Reason:   strings.TrimSpace(reason),
+	}
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "bond_id"}, {Name: "list"}, {Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "updated_at"}),
+	}).Create(entry).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to save access list entry: %w", err)
+	}
+	return entry, s.Load(ctx)
+}
+
+// Remove takes an address off a list, returning gorm.ErrRecordNotFound if it
+// wasn't on it
+func (s *Store) Remove(ctx context.Context, tenantID, bondID, list, address string) error {
+	result := s.db.WithContext(ctx).Unscoped().
+		Where("tenant_id = ? AND bond_id = ? AND list = ? AND address = ?",
+			tenantID, bondID, strings.ToUpper(list), strings.ToLower(address)).
+		Delete(&models.AccessListEntry{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove access list entry: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return s.Load(ctx)
+}
+
+// Entries returns a tenant's global entries, or a bond's, optionally of one
+// list, in address order
+func (s *Store) Entries(ctx context.Context, tenantID, bondID, list string) ([]models.AccessListEntry, error) {
+	query := s.db.WithContext(ctx).Where("tenant_id = ? AND bond_id = ?", tenantID, bondID)
+	if list != "" {
+		query = query.Where("list = ?", strings.ToUpper(list))
+	}
+	var entries []models.AccessListEntry
+	if err := query.Order("list ASC, address ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list access list entries: %w", err)
+	}
+	return entries, nil
+}
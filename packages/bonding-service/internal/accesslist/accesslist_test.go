@@ -0,0 +1,147 @@
+package accesslist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	alice   = "0x1111111111111111111111111111111111111111"
+	bob     = "0x2222222222222222222222222222222222222222"
+	mallory = "0x33333333333333333333333333333333333333aa"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// loadedStore returns a store where acme denies mallory everywhere and
+// restricts bond 7 to alice, and globex allows only bob
+func loadedStore(t *testing.T) *Store {
+	t.Helper()
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT \* FROM "access_list_entries"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "bond_id", "list", "address", "reason"}).
+			AddRow(1, "acme", "", "DENY", mallory, "sanctioned").
+			AddRow(2, "acme", "7", "ALLOW", alice, "").
+			AddRow(3, "acme", "7", "DENY", alice, "").
+			AddRow(4, "acme", "8", "ALLOW", alice, "").
+			AddRow(5, "globex", "", "ALLOW", bob, ""))
+
+	store := NewStore(db)
+	if err := store.Load(context.Background()); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return store
+}
+
+func TestCheck(t *testing.T) {
+	store := loadedStore(t)
+
+	tests := []struct {
+		name      string
+		tenantID  string
+		bondID    string
+		addresses []string
+		wantErr   bool
+	}{
+		{"unlisted address", "acme", "9", []string{bob}, false},
+		{"globally denied", "acme", "9", []string{bob, mallory}, true},
+		{"globally denied without a bond", "acme", "", []string{mallory}, true},
+		{"denied, mixed case", "acme", "", []string{"0x33333333333333333333333333333333333333AA"}, true},
+		{"deny wins over allow", "acme", "7", []string{alice}, true},
+		{"on the bond's allow list", "acme", "8", []string{alice}, false},
+		{"not on the bond's allow list", "acme", "8", []string{bob}, true},
+		{"on the global allow list", "globex", "1", []string{bob}, false},
+		{"not on the global allow list", "globex", "1", []string{alice}, true},
+		{"other tenant's deny list", "globex", "", []string{bob}, false},
+		{"no lists", "initech", "7", []string{mallory}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := store.Check(tt.tenantID, tt.bondID, tt.addresses)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrDenied) {
+				t.Errorf("Check() error = %v, want ErrDenied", err)
+			}
+		})
+	}
+}
+
+func TestAddValidation(t *testing.T) {
+	store := NewStore(nil)
+	tests := []struct {
+		name    string
+		list    string
+		address string
+	}{
+		{"unknown list", "GREY", alice},
+		{"invalid address", "DENY", "0x1234"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.Add(context.Background(), "acme", "", tt.list, tt.address, "")
+			if !errors.Is(err, ErrInvalidEntry) {
+				t.Errorf("Add() error = %v, want ErrInvalidEntry", err)
+			}
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	store := loadedStore(t)
+	interceptor := UnaryServerInterceptor(store,
+		func(method string) bool { return method == "/write" },
+		func(ctx context.Context, req interface{}) (Subjects, error) {
+			return Subjects{BondID: "9", Addresses: []string{req.(string)}}, nil
+		})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenant.MetadataKey, "acme"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name     string
+		method   string
+		address  string
+		wantCode codes.Code
+	}{
+		{"allowed write", "/write", bob, codes.OK},
+		{"denied write", "/write", mallory, codes.PermissionDenied},
+		{"read", "/read", mallory, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interceptor(ctx, tt.address, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("interceptor code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+		})
+	}
+}
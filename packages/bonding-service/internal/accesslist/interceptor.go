@@ -0,0 +1,51 @@
+package accesslist
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subjects are the bond and addresses a request acts on
+type Subjects struct {
+	BondID    string // Empty if the request isn't about one bond
+	Addresses []string
+}
+
+// SubjectsFunc returns the subjects of a request
+type SubjectsFunc func(ctx context.Context, req interface{}) (Subjects, error)
+
+// UnaryServerInterceptor refuses write methods acting on an address the
+// calling tenant's lists exclude with PERMISSION_DENIED
+func UnaryServerInterceptor(s *Store, isWrite func(fullMethod string) bool, subjects SubjectsFunc) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !isWrite(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		subj, err := subjects(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		err = s.Check(tenant.FromContext(ctx), subj.BondID, subj.Addresses)
+		if errors.Is(err, ErrDenied) {
+			metrics.AccessListRefusals.Inc()
+			log.Printf("Refused %s: %v", info.FullMethod, err)
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,263 @@
+// Package accrual accrues the interest investments earn at their tranche's
+// APY under its day-count convention. Every complete UTC day an investment
+// is held before its bond matures is snapshotted with the principal and rate
+// it accrued at and the running total, so a payout can be checked against
+// what had accrued by then. Interest since the latest snapshot is computed
+// when it is read.
+package accrual
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const day = 24 * time.Hour
+
+// Config controls the accrual engine
+type Config struct {
+	Interval  time.Duration // How often complete days are accrued
+	BatchSize int           // Bonds loaded per query
+	MaxDays   int           // Days accrued per investment per run, so a backlog catches up over several runs
+}
+
+// DefaultConfig returns default accrual configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:  time.Hour,
+		BatchSize: 100,
+		MaxDays:   31,
+	}
+}
+
+// Engine periodically snapshots the interest accrued by each investment
+type Engine struct {
+	db     *gorm.DB
+	config Config
+}
+
+// New creates an accrual engine
+func New(db *gorm.DB, config Config) *Engine {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultConfig().BatchSize
+	}
+	if config.MaxDays <= 0 {
+		config.MaxDays = DefaultConfig().MaxDays
+	}
+	return &Engine{db: db, config: config}
+}
+
+// Start accrues complete days on every interval until the context is cancelled
+func (e *Engine) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := e.RunOnce(ctx); err != nil {
+			log.Printf("Interest accrual failed: %v", err)
+		} else if n > 0 {
+			log.Printf("Accrued interest for %d investment days", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce snapshots every complete day not yet accrued by the investments of
+// outstanding bonds and returns how many snapshots it wrote. Bonds that
+// matured recently are included so their last days are accrued.
+func (e *Engine) RunOnce(ctx context.Context) (int, error) {
+	today := time.Now().UTC().Truncate(day)
+	maturedSince := today.Add(-time.Duration(e.config.MaxDays) * day)
+
+	written := 0
+	var lastID uint
+	for {
+		var bonds []models.Bond
+		if err := e.db.WithContext(ctx).Preload("Tranches").
+			Where("id > ? AND archived_at IS NULL", lastID).
+			Where("status IN ? OR (status = ? AND maturity_date > ?)",
+				models.OutstandingBondStatuses, models.BondMatured, maturedSince).
+			Order("id ASC").
+			Limit(e.config.BatchSize).
+			Find(&bonds).Error; err != nil {
+			return written, fmt.Errorf("failed to load bonds: %w", err)
+		}
+
+		for i := range bonds {
+			n, err := e.accrueBond(ctx, &bonds[i], today)
+			if err != nil {
+				log.Printf("Failed to accrue interest for bond %s: %v", bonds[i].BondID, err)
+				continue
+			}
+			written += n
+		}
+		if len(bonds) < e.config.BatchSize {
+			return written, nil
+		}
+		lastID = bonds[len(bonds)-1].ID
+	}
+}
+
+// accrueBond snapshots the days before today each of a bond's investments
+// hasn't accrued yet
+func (e *Engine) accrueBond(ctx context.Context, bond *models.Bond, today time.Time) (int, error) {
+	db := e.db.WithContext(ctx)
+	var investments []models.Investment
+	if err := db.Where("bond_id = ?", bond.BondID).Order("id ASC").Find(&investments).Error; err != nil {
+		return 0, fmt.Errorf("failed to load investments: %w", err)
+	}
+	if len(investments) == 0 {
+		return 0, nil
+	}
+	latest, err := latestSnapshots(db.Where("bond_id = ?", bond.BondID))
+	if err != nil {
+		return 0, err
+	}
+
+	rates := trancheRates(bond)
+	var snapshots []models.AccrualSnapshot
+	for i := range investments {
+		inv := &investments[i]
+		principal, ok := new(big.Int).SetString(inv.Amount, 10)
+		if !ok {
+			continue
+		}
+		from := inv.Timestamp.UTC().Truncate(day)
+		accrued := new(big.Int)
+		if last, ok := latest[inv.ID]; ok {
+			from = last.Day.Add(day)
+			accrued.SetString(last.Accrued, 10)
+		}
+
+		rate := rates[inv.TrancheID]
+		for d, n := from, 0; d.Before(today) && d.Before(bond.MaturityDate) && n < e.config.MaxDays; d, n = d.Add(day), n+1 {
+			start, end := held(inv.Timestamp, bond.MaturityDate, d, d.Add(day))
+			interest := rate.dayCount.Coupon(principal, rate.bps, start, end)
+			accrued.Add(accrued, interest)
+			snapshots = append(snapshots, models.AccrualSnapshot{
+				InvestmentID: inv.ID,
+				Day:          d,
+				BondID:       bond.BondID,
+				TrancheID:    inv.TrancheID,
+				Investor:     inv.Investor,
+				Principal:    principal.String(),
+				APYBps:       rate.bps,
+				DayCount:     string(rate.dayCount),
+				Seconds:      int64(end.Sub(start) / time.Second),
+				Interest:     interest.String(),
+				Accrued:      accrued.String(),
+			})
+		}
+	}
+	if len(snapshots) == 0 {
+		return 0, nil
+	}
+
+	// Another instance may have accrued the same days meanwhile
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&snapshots, 500)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to save accrual snapshots: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// ToDate returns the interest each of a bond's investments has accrued by
+// now, keyed by investment ID: the running total of its latest snapshot plus
+// what its current principal accrued since
+func ToDate(ctx context.Context, db *gorm.DB, bond *models.Bond, investments []models.Investment, now time.Time) (map[uint]*big.Int, error) {
+	accrued := make(map[uint]*big.Int, len(investments))
+	if len(investments) == 0 {
+		return accrued, nil
+	}
+	ids := make([]uint, len(investments))
+	for i := range investments {
+		ids[i] = investments[i].ID
+	}
+	latest, err := latestSnapshots(db.WithContext(ctx).Where("investment_id IN ?", ids))
+	if err != nil {
+		return nil, err
+	}
+
+	rates := trancheRates(bond)
+	for i := range investments {
+		inv := &investments[i]
+		total := new(big.Int)
+		since := inv.Timestamp
+		if last, ok := latest[inv.ID]; ok {
+			total.SetString(last.Accrued, 10)
+			since = last.Day.Add(day)
+		}
+		if principal, ok := new(big.Int).SetString(inv.Amount, 10); ok {
+			rate := rates[inv.TrancheID]
+			start, end := held(inv.Timestamp, bond.MaturityDate, since, now)
+			total.Add(total, rate.dayCount.Coupon(principal, rate.bps, start, end))
+		}
+		accrued[inv.ID] = total
+	}
+	return accrued, nil
+}
+
+// latestSnapshots returns the latest snapshot of each investment the query
+// selects, keyed by investment ID
+func latestSnapshots(query *gorm.DB) (map[uint]models.AccrualSnapshot, error) {
+	var snapshots []models.AccrualSnapshot
+	if err := query.Model(&models.AccrualSnapshot{}).
+		Select("DISTINCT ON (investment_id) *").
+		Order("investment_id, day DESC").
+		Find(&snapshots).Error; err != nil {
+		return nil, fmt.Errorf("failed to load accrual snapshots: %w", err)
+	}
+	latest := make(map[uint]models.AccrualSnapshot, len(snapshots))
+	for _, s := range snapshots {
+		latest[s.InvestmentID] = s
+	}
+	return latest, nil
+}
+
+// held returns the part of [start, end) an investment made at invested was
+// held before maturity; end isn't after start when there is none
+func held(invested, maturity, start, end time.Time) (time.Time, time.Time) {
+	if start.Before(invested) {
+		start = invested
+	}
+	if end.After(maturity) {
+		end = maturity
+	}
+	if end.Before(start) {
+		end = start
+	}
+	return start, end
+}
+
+// rate is what a tranche's coupons accrue at
+type rate struct {
+	bps      int64
+	dayCount waterfall.DayCount
+}
+
+// trancheRates returns each tranche's APY in basis points and day count
+func trancheRates(bond *models.Bond) map[int]rate {
+	rates := make(map[int]rate, len(bond.Tranches))
+	for i := range bond.Tranches {
+		t := &bond.Tranches[i]
+		dayCount, ok := waterfall.ParseDayCount(bond.TrancheDayCount(t))
+		if !ok {
+			dayCount = waterfall.DefaultDayCount
+		}
+		rates[t.TrancheID] = rate{bps: int64(math.Round(t.APY * 100)), dayCount: dayCount}
+	}
+	return rates
+}
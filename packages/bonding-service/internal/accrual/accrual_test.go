@@ -0,0 +1,151 @@
+package accrual
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestRunOnceAccruesCompleteDays(t *testing.T) {
+	db, mock := newMockDB(t)
+	today := time.Now().UTC().Truncate(day)
+	invested := today.Add(-2*day + 12*time.Hour)
+
+	mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE \(id > \$1 AND archived_at IS NULL\) AND \(status IN .* OR \(status = \$\d+ AND maturity_date > \$\d+\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "maturity_date"}).
+			AddRow(1, "7", today.Add(365*day)))
+	mock.ExpectQuery(`SELECT \* FROM "tranches" WHERE "tranches"."bond_id" = \$1`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "apy"}).AddRow(1, "7", 0, 10.0))
+	mock.ExpectQuery(`SELECT \* FROM "investments" WHERE bond_id = \$1`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "investor", "amount", "timestamp"}).
+			AddRow(3, "7", 0, "0xabc", "1000000000000000000", invested))
+	mock.ExpectQuery(`SELECT DISTINCT ON \(investment_id\) \* FROM "accrual_snapshots" WHERE bond_id = \$1 ORDER BY investment_id, day DESC`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	// Half of the first day at 10% on 1e18, then a full day
+	mock.ExpectQuery(`INSERT INTO "accrual_snapshots" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+		WithArgs(
+			sqlmock.AnyArg(), uint(3), today.Add(-2*day), "7", 0, "0xabc", "1000000000000000000", int64(1000), "ACT/365", int64(43200), "136986301369863", "136986301369863",
+			sqlmock.AnyArg(), uint(3), today.Add(-day), "7", 0, "0xabc", "1000000000000000000", int64(1000), "ACT/365", int64(86400), "273972602739726", "410958904109589",
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+
+	written, err := New(db, DefaultConfig()).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if written != 2 {
+		t.Errorf("wrote %d snapshots, want 2", written)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestToDate(t *testing.T) {
+	db, mock := newMockDB(t)
+	last := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	bond := &models.Bond{
+		BondID:       "7",
+		MaturityDate: last.Add(365 * day),
+		Tranches:     []models.Tranche{{TrancheID: 0, APY: 10}},
+	}
+	investments := []models.Investment{
+		{Model: gorm.Model{ID: 3}, TrancheID: 0, Amount: "1000000000000000000", Timestamp: last.Add(-30 * day)},
+		{Model: gorm.Model{ID: 4}, TrancheID: 0, Amount: "1000000000000000000", Timestamp: last.Add(day)},
+	}
+
+	mock.ExpectQuery(`SELECT DISTINCT ON \(investment_id\) \* FROM "accrual_snapshots" WHERE investment_id IN \(\$1,\$2\)`).
+		WithArgs(uint(3), uint(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"investment_id", "day", "accrued"}).AddRow(3, last, "100"))
+
+	accrued, err := ToDate(context.Background(), db, bond, investments, last.Add(day+12*time.Hour))
+	if err != nil {
+		t.Fatalf("ToDate() error = %v", err)
+	}
+	// The snapshotted total plus half a day since its day ended
+	if got := accrued[3].String(); got != "136986301369963" {
+		t.Errorf("accrued[3] = %s, want 136986301369963", got)
+	}
+	// Half a day since it was made, with nothing snapshotted yet
+	if got := accrued[4].String(); got != "136986301369863" {
+		t.Errorf("accrued[4] = %s, want 136986301369863", got)
+	}
+}
+
+func TestRunOnceUsesTheDayCount(t *testing.T) {
+	db, mock := newMockDB(t)
+	today := time.Now().UTC().Truncate(day)
+
+	mock.ExpectQuery(`SELECT \* FROM "bonds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "maturity_date", "day_count"}).
+			AddRow(1, "7", today.Add(365*day), "ACT/365"))
+	mock.ExpectQuery(`SELECT \* FROM "tranches"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "apy", "day_count"}).AddRow(1, "7", 0, 10.0, "ACT/360"))
+	mock.ExpectQuery(`SELECT \* FROM "investments"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "investor", "amount", "timestamp"}).
+			AddRow(3, "7", 0, "0xabc", "1000000000000000000", today.Add(-day)))
+	mock.ExpectQuery(`SELECT DISTINCT ON \(investment_id\) \* FROM "accrual_snapshots"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	// The tranche's ACT/360 overrides the bond's ACT/365: a full day at 10% is 1e17/360
+	mock.ExpectQuery(`INSERT INTO "accrual_snapshots"`).
+		WithArgs(sqlmock.AnyArg(), uint(3), today.Add(-day), "7", 0, "0xabc", "1000000000000000000", int64(1000), "ACT/360", int64(86400), "277777777777777", "277777777777777").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	if _, err := New(db, DefaultConfig()).RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHeld(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		invested time.Time
+		maturity time.Time
+		want     time.Duration
+	}{
+		{"whole day", start.Add(-day), start.Add(30 * day), day},
+		{"invested midday", start.Add(12 * time.Hour), start.Add(30 * day), 12 * time.Hour},
+		{"matures midday", start.Add(-day), start.Add(6 * time.Hour), 6 * time.Hour},
+		{"already matured", start.Add(-2 * day), start.Add(-day), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := held(tt.invested, tt.maturity, start, start.Add(day))
+			if got := to.Sub(from); got != tt.want {
+				t.Errorf("held() spans %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
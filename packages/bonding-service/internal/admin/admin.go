@@ -0,0 +1,459 @@
+// Package admin serves a small operations dashboard embedded in the binary.
+// It shows bonds, the transaction and distribution queues, dead letters,
+// reconciliation results and feature flags, and its actions call the admin
+// RPCs in-process, so small deployments need no separate ops frontend.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+//go:embed static
+var static embed.FS
+
+// listLimit caps the rows returned for the distribution queue and dead letters
+const listLimit = 200
+
+// Server is the subset of the bonding service the dashboard calls
+type Server interface {
+	ListBonds(context.Context, *pb.ListBondsRequest) (*pb.ListBondsResponse, error)
+	ListPendingTransactions(context.Context, *pb.ListPendingTransactionsRequest) (*pb.ListPendingTransactionsResponse, error)
+	SpeedUpTransaction(context.Context, *pb.ReplaceTransactionRequest) (*pb.ReplaceTransactionResponse, error)
+	CancelTransaction(context.Context, *pb.ReplaceTransactionRequest) (*pb.ReplaceTransactionResponse, error)
+	GetReconciliationReport(context.Context, *pb.GetReconciliationReportRequest) (*pb.ReconciliationReport, error)
+	GetMaintenance(context.Context, *pb.GetMaintenanceRequest) (*pb.GetMaintenanceResponse, error)
+	ScheduleMaintenance(context.Context, *pb.ScheduleMaintenanceRequest) (*pb.MaintenanceWindow, error)
+	CancelMaintenance(context.Context, *pb.CancelMaintenanceRequest) (*pb.CancelMaintenanceResponse, error)
+}
+
+// Flag is a feature flag or toggle shown on the dashboard
+type Flag struct {
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description"`
+}
+
+// Dashboard serves the embedded admin UI and the JSON endpoints behind it
+type Dashboard struct {
+	server Server
+	db     *gorm.DB
+	token  string
+	flags  func() []Flag
+}
+
+// New creates a dashboard. Every API request must present token as a bearer
+// token; the static UI itself holds nothing secret.
+func New(server Server, db *gorm.DB, token string) *Dashboard {
+	return &Dashboard{server: server, db: db, token: token}
+}
+
+// SetFlags sets the source of the feature flags shown on the dashboard
+func (d *Dashboard) SetFlags(flags func() []Flag) {
+	d.flags = flags
+}
+
+// Handler returns the dashboard routes under /admin/
+func (d *Dashboard) Handler() http.Handler {
+	assets, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err) // The directory is embedded at build time
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /admin/", http.StripPrefix("/admin/", http.FileServer(http.FS(assets))))
+	mux.HandleFunc("GET /admin/api/bonds", d.authorized(d.listBonds))
+	mux.HandleFunc("GET /admin/api/transactions", d.authorized(d.listTransactions))
+	mux.HandleFunc("POST /admin/api/transactions/{txHash}/speed-up", d.authorized(d.replaceTransaction))
+	mux.HandleFunc("POST /admin/api/transactions/{txHash}/cancel", d.authorized(d.replaceTransaction))
+	mux.HandleFunc("GET /admin/api/distributions", d.authorized(d.listDistributions))
+	mux.HandleFunc("GET /admin/api/dead-letters", d.authorized(d.listDeadLetters))
+	mux.HandleFunc("GET /admin/api/reconciliation", d.authorized(d.getReconciliation))
+	mux.HandleFunc("POST /admin/api/reconciliation", d.authorized(d.getReconciliation))
+	mux.HandleFunc("GET /admin/api/maintenance", d.authorized(d.getMaintenance))
+	mux.HandleFunc("POST /admin/api/maintenance", d.authorized(d.scheduleMaintenance))
+	mux.HandleFunc("DELETE /admin/api/maintenance/{id}", d.authorized(d.cancelMaintenance))
+	mux.HandleFunc("GET /admin/api/flags", d.authorized(d.listFlags))
+	return mux
+}
+
+// authorized rejects requests without the dashboard token and has the RPCs
+// act for the request's tenant. The token is the deployment's, so its holder
+// may pick any tenant.
+func (d *Dashboard) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || d.token == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(d.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		ctx := tenant.NewContext(r.Context(), tenant.FromRequest(r))
+		next(w, r.WithContext(ctx))
+	}
+}
+
+type bondJSON struct {
+	BondID       string `json:"bond_id"`
+	Chain        string `json:"chain"`
+	Issuer       string `json:"issuer"`
+	TotalValue   string `json:"total_value"`
+	TotalRevenue string `json:"total_revenue"`
+	TotalArrears string `json:"total_arrears"`
+	Status       string `json:"status"`
+	MaturityDate int64  `json:"maturity_date"`
+}
+
+// listBonds serves GET /admin/api/bonds?status=ACTIVE&offset=0&archived=true
+func (d *Dashboard) listBonds(w http.ResponseWriter, r *http.Request) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	resp, err := d.server.ListBonds(r.Context(), &pb.ListBondsRequest{
+		Status:          r.URL.Query().Get("status"),
+		PageSize:        listLimit,
+		Offset:          int32(offset),
+		IncludeArchived: r.URL.Query().Get("archived") == "true",
+	})
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	bonds := make([]bondJSON, len(resp.Bonds))
+	for i, b := range resp.Bonds {
+		bonds[i] = bondJSON{
+			BondID:       b.BondId,
+			Chain:        b.Chain,
+			Issuer:       b.Issuer,
+			TotalValue:   b.TotalValue,
+			TotalRevenue: b.TotalRevenue,
+			TotalArrears: b.TotalArrears,
+			Status:       b.Status,
+			MaturityDate: b.MaturityDate,
+		}
+	}
+	writeJSON(w, map[string]interface{}{"bonds": bonds})
+}
+
+type transactionJSON struct {
+	TxHash         string `json:"tx_hash"`
+	Chain          string `json:"chain"`
+	Purpose        string `json:"purpose"`
+	BondID         string `json:"bond_id"`
+	Nonce          uint64 `json:"nonce"`
+	Status         string `json:"status"`
+	SubmittedAt    int64  `json:"submitted_at"`
+	PendingSeconds int64  `json:"pending_seconds"`
+}
+
+// listTransactions serves GET /admin/api/transactions?status=STUCK
+func (d *Dashboard) listTransactions(w http.ResponseWriter, r *http.Request) {
+	resp, err := d.server.ListPendingTransactions(r.Context(), &pb.ListPendingTransactionsRequest{
+		Status: r.URL.Query().Get("status"),
+		Chain:  r.URL.Query().Get("chain"),
+	})
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	txs := make([]transactionJSON, len(resp.Transactions))
+	for i, tx := range resp.Transactions {
+		txs[i] = transactionJSON{
+			TxHash:         tx.TxHash,
+			Chain:          tx.Chain,
+			Purpose:        tx.Purpose,
+			BondID:         tx.BondId,
+			Nonce:          tx.Nonce,
+			Status:         tx.Status,
+			SubmittedAt:    tx.SubmittedAt,
+			PendingSeconds: tx.PendingSeconds,
+		}
+	}
+	writeJSON(w, map[string]interface{}{"transactions": txs})
+}
+
+// replaceTransaction serves POST /admin/api/transactions/{txHash}/speed-up
+// and /cancel with an optional {"chain", "fee_bump_bps"} body
+func (d *Dashboard) replaceTransaction(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Chain      string `json:"chain"`
+		FeeBumpBps int32  `json:"fee_bump_bps"`
+	}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	req := &pb.ReplaceTransactionRequest{TxHash: r.PathValue("txHash"), Chain: body.Chain, FeeBumpBps: body.FeeBumpBps}
+
+	replace := d.server.SpeedUpTransaction
+	if strings.HasSuffix(r.URL.Path, "/cancel") {
+		replace = d.server.CancelTransaction
+	}
+	resp, err := replace(r.Context(), req)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"original_tx_hash":    resp.OriginalTxHash,
+		"replacement_tx_hash": resp.ReplacementTxHash,
+		"nonce":               resp.Nonce,
+	})
+}
+
+type distributionJSON struct {
+	ID          uint   `json:"id"`
+	BondID      string `json:"bond_id"`
+	Amount      string `json:"amount"`
+	DueAt       int64  `json:"due_at"`
+	Status      string `json:"status"`
+	Attempts    int    `json:"attempts"`
+	LastError   string `json:"last_error,omitempty"`
+	TxHash      string `json:"tx_hash,omitempty"`
+	ProcessedAt int64  `json:"processed_at,omitempty"`
+}
+
+// listDistributions serves GET /admin/api/distributions?status=FAILED, the
+// most recently due entries of the distribution queue
+func (d *Dashboard) listDistributions(w http.ResponseWriter, r *http.Request) {
+	query := d.db.WithContext(r.Context()).
+		Where("bond_id IN (?)", d.db.Model(&models.Bond{}).Select("bond_id").Where("tenant_id = ?", tenant.FromRequest(r)))
+	if s := r.URL.Query().Get("status"); s != "" {
+		query = query.Where("status = ?", s)
+	}
+	var rows []models.QueuedDistribution
+	if err := query.Order("due_at DESC").Limit(listLimit).Find(&rows).Error; err != nil {
+		fail(w, err)
+		return
+	}
+	result := make([]distributionJSON, len(rows))
+	for i, q := range rows {
+		result[i] = distributionJSON{
+			ID:        q.ID,
+			BondID:    q.BondID,
+			Amount:    q.Amount,
+			DueAt:     q.DueAt.Unix(),
+			Status:    q.Status,
+			Attempts:  q.Attempts,
+			LastError: q.LastError,
+			TxHash:    q.TxHash,
+		}
+		if q.ProcessedAt != nil {
+			result[i].ProcessedAt = q.ProcessedAt.Unix()
+		}
+	}
+	writeJSON(w, map[string]interface{}{"distributions": result})
+}
+
+type deadLetterJSON struct {
+	ID        uint   `json:"id"`
+	Consumer  string `json:"consumer"`
+	EventID   string `json:"event_id"`
+	Position  uint64 `json:"position"`
+	Error     string `json:"error"`
+	Attempts  int    `json:"attempts"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// listDeadLetters serves GET /admin/api/dead-letters?consumer=indexer. Dead
+// letters belong to the deployment rather than a tenant.
+func (d *Dashboard) listDeadLetters(w http.ResponseWriter, r *http.Request) {
+	query := d.db.WithContext(r.Context())
+	if c := r.URL.Query().Get("consumer"); c != "" {
+		query = query.Where("consumer = ?", c)
+	}
+	var rows []models.DeadLetter
+	if err := query.Order("created_at DESC").Limit(listLimit).Find(&rows).Error; err != nil {
+		fail(w, err)
+		return
+	}
+	result := make([]deadLetterJSON, len(rows))
+	for i, l := range rows {
+		result[i] = deadLetterJSON{
+			ID:        l.ID,
+			Consumer:  l.Consumer,
+			EventID:   l.EventID,
+			Position:  l.Position,
+			Error:     l.Error,
+			Attempts:  l.Attempts,
+			CreatedAt: l.CreatedAt.Unix(),
+		}
+	}
+	writeJSON(w, map[string]interface{}{"dead_letters": result})
+}
+
+type discrepancyJSON struct {
+	BondID    string `json:"bond_id"`
+	Chain     string `json:"chain"`
+	TrancheID int32  `json:"tranche_id"`
+	Field     string `json:"field"`
+	Stored    string `json:"stored"`
+	OnChain   string `json:"on_chain"`
+	Corrected bool   `json:"corrected"`
+}
+
+// getReconciliation serves the last reconciliation report on GET and runs
+// one on POST
+func (d *Dashboard) getReconciliation(w http.ResponseWriter, r *http.Request) {
+	report, err := d.server.GetReconciliationReport(r.Context(), &pb.GetReconciliationReportRequest{
+		Run:    r.Method == http.MethodPost,
+		BondId: r.URL.Query().Get("bond_id"),
+	})
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	discrepancies := make([]discrepancyJSON, len(report.Discrepancies))
+	for i, disc := range report.Discrepancies {
+		discrepancies[i] = discrepancyJSON{
+			BondID:    disc.BondId,
+			Chain:     disc.Chain,
+			TrancheID: disc.TrancheId,
+			Field:     disc.Field,
+			Stored:    disc.Stored,
+			OnChain:   disc.OnChain,
+			Corrected: disc.Corrected,
+		}
+	}
+	writeJSON(w, map[string]interface{}{
+		"started_at":    report.StartedAt,
+		"finished_at":   report.FinishedAt,
+		"bonds_checked": report.BondsChecked,
+		"bonds_failed":  report.BondsFailed,
+		"auto_correct":  report.AutoCorrect,
+		"discrepancies": discrepancies,
+	})
+}
+
+type windowJSON struct {
+	ID          uint64 `json:"id"`
+	StartsAt    int64  `json:"starts_at"`
+	EndsAt      int64  `json:"ends_at"`
+	Reason      string `json:"reason"`
+	AnnouncedAt int64  `json:"announced_at"`
+}
+
+func toWindowJSON(w *pb.MaintenanceWindow) *windowJSON {
+	if w == nil {
+		return nil
+	}
+	return &windowJSON{ID: w.Id, StartsAt: w.StartsAt, EndsAt: w.EndsAt, Reason: w.Reason, AnnouncedAt: w.AnnouncedAt}
+}
+
+// getMaintenance serves GET /admin/api/maintenance
+func (d *Dashboard) getMaintenance(w http.ResponseWriter, r *http.Request) {
+	resp, err := d.server.GetMaintenance(r.Context(), &pb.GetMaintenanceRequest{})
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	upcoming := make([]*windowJSON, len(resp.Upcoming))
+	for i, window := range resp.Upcoming {
+		upcoming[i] = toWindowJSON(window)
+	}
+	writeJSON(w, map[string]interface{}{"active": toWindowJSON(resp.Active), "upcoming": upcoming})
+}
+
+// scheduleMaintenance serves POST /admin/api/maintenance with a
+// {"starts_at", "ends_at", "reason"} body
+func (d *Dashboard) scheduleMaintenance(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		StartsAt int64  `json:"starts_at"`
+		EndsAt   int64  `json:"ends_at"`
+		Reason   string `json:"reason"`
+	}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	window, err := d.server.ScheduleMaintenance(r.Context(), &pb.ScheduleMaintenanceRequest{
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+		Reason:   body.Reason,
+	})
+	if err != nil {
+		fail(w, err)
+		return
+	}
+	writeJSON(w, toWindowJSON(window))
+}
+
+// cancelMaintenance serves DELETE /admin/api/maintenance/{id}
+func (d *Dashboard) cancelMaintenance(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid maintenance window id", http.StatusBadRequest)
+		return
+	}
+	if _, err := d.server.CancelMaintenance(r.Context(), &pb.CancelMaintenanceRequest{Id: id}); err != nil {
+		fail(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listFlags serves GET /admin/api/flags
+func (d *Dashboard) listFlags(w http.ResponseWriter, r *http.Request) {
+	flags := []Flag{}
+	if d.flags != nil {
+		flags = append(flags, d.flags()...)
+	}
+	writeJSON(w, map[string]interface{}{"flags": flags, "generated_at": time.Now().Unix()})
+}
+
+// readJSON decodes an optional request body. It returns false if an error
+// response was written.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(v); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// fail writes an RPC or query error with the matching HTTP status
+func fail(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		code = http.StatusConflict
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.Unimplemented:
+		code = http.StatusNotImplemented
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+	message := status.Convert(err).Message()
+	if code == http.StatusInternalServerError {
+		log.Printf("Admin request failed: %v", err)
+		message = "internal error"
+	}
+	http.Error(w, message, code)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Admin encode failed: %v", err)
+	}
+}
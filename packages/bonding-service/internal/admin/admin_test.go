@@ -0,0 +1,131 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServer records the tenant and requests the dashboard passes on
+type fakeServer struct {
+	Server
+	tenant   string
+	replaced []string
+}
+
+func (f *fakeServer) ListBonds(ctx context.Context, req *pb.ListBondsRequest) (*pb.ListBondsResponse, error) {
+	f.tenant = tenant.FromContext(ctx)
+	return &pb.ListBondsResponse{Bonds: []*pb.GetBondInfoResponse{{BondId: "7", Status: req.Status}}}, nil
+}
+
+func (f *fakeServer) SpeedUpTransaction(ctx context.Context, req *pb.ReplaceTransactionRequest) (*pb.ReplaceTransactionResponse, error) {
+	f.replaced = append(f.replaced, "speed-up "+req.TxHash+" "+req.Chain)
+	return &pb.ReplaceTransactionResponse{OriginalTxHash: req.TxHash, ReplacementTxHash: "0xnew"}, nil
+}
+
+func (f *fakeServer) CancelTransaction(ctx context.Context, req *pb.ReplaceTransactionRequest) (*pb.ReplaceTransactionResponse, error) {
+	f.replaced = append(f.replaced, "cancel "+req.TxHash+" "+req.Chain)
+	return &pb.ReplaceTransactionResponse{OriginalTxHash: req.TxHash, ReplacementTxHash: "0xnew"}, nil
+}
+
+func (f *fakeServer) GetReconciliationReport(ctx context.Context, req *pb.GetReconciliationReportRequest) (*pb.ReconciliationReport, error) {
+	return nil, status.Error(codes.NotFound, "no reconciliation has run yet")
+}
+
+func serve(t *testing.T, handler http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	req.Header.Set(tenant.HeaderName, "acme")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string // Configured on the dashboard
+		sent  string
+		want  int
+	}{
+		{"valid token", "secret", "secret", http.StatusOK},
+		{"wrong token", "secret", "guess", http.StatusUnauthorized},
+		{"no token", "secret", "", http.StatusUnauthorized},
+		{"unconfigured", "", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := New(&fakeServer{}, nil, tt.token).Handler()
+			if rec := serve(t, handler, http.MethodGet, "/admin/api/bonds", tt.sent, ""); rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBonds(t *testing.T) {
+	server := &fakeServer{}
+	rec := serve(t, New(server, nil, "secret").Handler(), http.MethodGet, "/admin/api/bonds?status=ACTIVE", "secret", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+	}
+	if server.tenant != "acme" {
+		t.Errorf("tenant = %q, want acme", server.tenant)
+	}
+	var body struct {
+		Bonds []bondJSON `json:"bonds"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(body.Bonds) != 1 || body.Bonds[0].BondID != "7" || body.Bonds[0].Status != "ACTIVE" {
+		t.Errorf("bonds = %+v", body.Bonds)
+	}
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", rec.Header().Get("Cache-Control"))
+	}
+}
+
+func TestReplaceTransaction(t *testing.T) {
+	server := &fakeServer{}
+	handler := New(server, nil, "secret").Handler()
+
+	serve(t, handler, http.MethodPost, "/admin/api/transactions/0xabc/speed-up", "secret", `{"chain":"arbitrum"}`)
+	serve(t, handler, http.MethodPost, "/admin/api/transactions/0xdef/cancel", "secret", "")
+	if rec := serve(t, handler, http.MethodPost, "/admin/api/transactions/0x1/cancel", "secret", "{"); rec.Code != http.StatusBadRequest {
+		t.Errorf("malformed body status = %d, want 400", rec.Code)
+	}
+
+	want := []string{"speed-up 0xabc arbitrum", "cancel 0xdef "}
+	if strings.Join(server.replaced, "|") != strings.Join(want, "|") {
+		t.Errorf("replaced = %q, want %q", server.replaced, want)
+	}
+}
+
+func TestRPCErrorStatus(t *testing.T) {
+	rec := serve(t, New(&fakeServer{}, nil, "secret").Handler(), http.MethodGet, "/admin/api/reconciliation", "secret", "")
+	if rec.Code != http.StatusNotFound || !strings.Contains(rec.Body.String(), "no reconciliation has run yet") {
+		t.Errorf("got %d %q, want 404 with the RPC message", rec.Code, rec.Body)
+	}
+}
+
+func TestStaticAssets(t *testing.T) {
+	handler := New(&fakeServer{}, nil, "secret").Handler()
+	for _, path := range []string{"/admin/", "/admin/app.js", "/admin/style.css"} {
+		// The page itself is served without a token
+		if rec := serve(t, handler, http.MethodGet, path, "", ""); rec.Code != http.StatusOK {
+			t.Errorf("GET %s status = %d, want 200", path, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Admin dashboard. Every call goes to /admin/api with the operator's bearer
+// token and tenant, both kept in session storage for the tab's lifetime.
+(function () {
+  'use strict';
+
+  const session = {
+    get token() { return sessionStorage.getItem('adminToken') || ''; },
+    get tenant() { return sessionStorage.getItem('adminTenant') || ''; },
+  };
+
+  function setStatus(message, isError) {
+    const el = document.getElementById('status');
+    el.textContent = message || '';
+    el.className = isError ? 'error' : '';
+  }
+
+  async function api(method, path, body) {
+    const headers = { Authorization: 'Bearer ' + session.token };
+    if (session.tenant) {
+      headers['X-Tenant-ID'] = session.tenant;
+    }
+    if (body !== undefined) {
+      headers['Content-Type'] = 'application/json';
+    }
+    const resp = await fetch('api/' + path, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+    if (!resp.ok) {
+      throw new Error(method + ' ' + path + ': ' + (await resp.text()).trim());
+    }
+    return resp.status === 204 ? null : resp.json();
+  }
+
+  function time(unix) {
+    return unix ? new Date(unix * 1000).toLocaleString() : '';
+  }
+
+  function cell(value, wrap) {
+    const td = document.createElement('td');
+    if (value instanceof Node) {
+      td.appendChild(value);
+    } else {
+      td.textContent = value === undefined || value === null ? '' : String(value);
+    }
+    if (wrap) {
+      td.className = 'wrap';
+    }
+    return td;
+  }
+
+  function button(label, onClick) {
+    const b = document.createElement('button');
+    b.textContent = label;
+    b.addEventListener('click', onClick);
+    return b;
+  }
+
+  // fill replaces a section's table rows with one per item
+  function fill(sectionID, items, columns) {
+    const tbody = document.querySelector('#' + sectionID + ' tbody');
+    tbody.replaceChildren();
+    for (const item of items) {
+      const tr = document.createElement('tr');
+      for (const column of columns(item)) {
+        tr.appendChild(column instanceof HTMLElement && column.tagName === 'TD' ? column : cell(column));
+      }
+      tbody.appendChild(tr);
+    }
+  }
+
+  function filter(sectionID) {
+    const select = document.querySelector('#' + sectionID + ' [data-filter]');
+    return select && select.value ? '?' + select.dataset.filter + '=' + encodeURIComponent(select.value) : '';
+  }
+
+  function confirmAction(message, action) {
+    return async function () {
+      if (!window.confirm(message)) {
+        return;
+      }
+      try {
+        await action();
+        setStatus('Done.');
+        await refresh();
+      } catch (err) {
+        setStatus(err.message, true);
+      }
+    };
+  }
+
+  const loaders = {
+    async bonds() {
+      const { bonds } = await api('GET', 'bonds' + filter('bonds'));
+      fill('bonds', bonds, (b) => [b.bond_id, b.chain, b.issuer, b.total_value, b.total_revenue,
+        b.total_arrears, b.status, time(b.maturity_date)]);
+    },
+
+    async transactions() {
+      const { transactions } = await api('GET', 'transactions' + filter('transactions'));
+      fill('transactions', transactions, (tx) => {
+        const actions = document.createElement('span');
+        const path = 'transactions/' + encodeURIComponent(tx.tx_hash);
+        actions.append(
+          button('Speed up', confirmAction('Resend ' + tx.tx_hash + ' with a higher fee?',
+            () => api('POST', path + '/speed-up', { chain: tx.chain }))),
+          ' ',
+          button('Cancel', confirmAction('Replace ' + tx.tx_hash + ' with a no-op transfer?',
+            () => api('POST', path + '/cancel', { chain: tx.chain }))),
+        );
+        return [cell(tx.tx_hash, true), tx.chain, tx.purpose, tx.bond_id, tx.nonce, tx.status,
+          tx.pending_seconds + 's', actions];
+      });
+    },
+
+    async distributions() {
+      const { distributions } = await api('GET', 'distributions' + filter('distributions'));
+      fill('distributions', distributions, (d) => [d.id, d.bond_id, d.amount, time(d.due_at), d.status,
+        d.attempts, cell(d.tx_hash, true), cell(d.last_error, true)]);
+    },
+
+    async deadLetters() {
+      const letters = (await api('GET', 'dead-letters')).dead_letters;
+      fill('dead-letters', letters, (l) => [l.id, l.consumer, l.event_id, l.position, l.attempts,
+        time(l.created_at), cell(l.error, true)]);
+    },
+
+    async reconciliation() {
+      const summary = document.querySelector('#reconciliation .summary');
+      let report;
+      try {
+        report = await api('GET', 'reconciliation');
+      } catch (err) {
+        summary.textContent = err.message;
+        fill('reconciliation', [], () => []);
+        return;
+      }
+      summary.textContent = 'Last run ' + time(report.finished_at) + ': ' + report.bonds_checked +
+        ' bonds checked, ' + report.bonds_failed + ' unreadable, ' + report.discrepancies.length +
+        ' discrepancies' + (report.auto_correct ? ' (auto-correct on)' : '');
+      fill('reconciliation', report.discrepancies, (d) => [d.bond_id, d.chain,
+        d.tranche_id < 0 ? '' : d.tranche_id, d.field, d.stored, d.on_chain, d.corrected ? 'yes' : 'no']);
+    },
+
+    async maintenance() {
+      const { active, upcoming } = await api('GET', 'maintenance');
+      const windows = active ? [active].concat(upcoming) : upcoming;
+      fill('maintenance', windows, (m) => [m.id, time(m.starts_at), time(m.ends_at), m.reason,
+        time(m.announced_at), button('Cancel', confirmAction('Cancel maintenance window ' + m.id + '?',
+          () => api('DELETE', 'maintenance/' + m.id)))]);
+    },
+
+    async flags() {
+      const { flags } = await api('GET', 'flags');
+      fill('flags', flags, (f) => {
+        const state = document.createElement('span');
+        state.textContent = f.enabled ? 'on' : 'off';
+        state.className = f.enabled ? 'badge-on' : 'badge-off';
+        return [f.name, state, cell(f.description, true)];
+      });
+    },
+  };
+
+  async function refresh() {
+    if (!session.token) {
+      setStatus('Enter the admin token to connect.');
+      return;
+    }
+    const failures = [];
+    await Promise.all(Object.entries(loaders).map(async ([name, load]) => {
+      try {
+        await load();
+      } catch (err) {
+        failures.push(name + ': ' + err.message);
+      }
+    }));
+    if (failures.length > 0) {
+      setStatus(failures.join('; '), true);
+    } else {
+      setStatus('Updated ' + new Date().toLocaleTimeString());
+    }
+  }
+
+  document.getElementById('session').addEventListener('submit', (ev) => {
+    ev.preventDefault();
+    sessionStorage.setItem('adminToken', document.getElementById('token').value);
+    sessionStorage.setItem('adminTenant', document.getElementById('tenant').value.trim());
+    refresh();
+  });
+
+  document.getElementById('reconcile').addEventListener('click', confirmAction(
+    'Reconcile every bond against the chain now?', () => api('POST', 'reconciliation')));
+
+  document.getElementById('schedule').addEventListener('submit', async (ev) => {
+    ev.preventDefault();
+    const form = ev.target;
+    const unix = (value) => Math.floor(new Date(value).getTime() / 1000);
+    try {
+      await api('POST', 'maintenance', {
+        starts_at: unix(form.starts_at.value),
+        ends_at: unix(form.ends_at.value),
+        reason: form.reason.value,
+      });
+      form.reset();
+      setStatus('Maintenance scheduled.');
+      await refresh();
+    } catch (err) {
+      setStatus(err.message, true);
+    }
+  });
+
+  for (const select of document.querySelectorAll('[data-filter]')) {
+    select.addEventListener('change', refresh);
+  }
+
+  document.getElementById('tenant').value = session.tenant;
+  refresh();
+  setInterval(refresh, 30000);
+})();
@@ -0,0 +1,165 @@
+// Package archive moves the detail rows of closed bonds into cold tables.
+// Matured and defaulted bonds untouched for long enough have their
+// investments, distributions and chain events moved to archived_ copies of
+// their tables, so the hot tables and their indexes only grow with the live
+// book. Bond and tranche rows stay hot as the summary; the detail is read
+// from the cold tables or restored when a closed bond is written again.
+package archive
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Tables are the per-bond detail tables that are archived
+var Tables = []string{
+	"investments",
+	"revenue_distributions",
+	"tranche_distributions",
+	"chain_events",
+}
+
+// ColdTable returns the name of a table's archive
+func ColdTable(table string) string {
+	return "archived_" + table
+}
+
+// Archive moves a bond's detail rows to the cold tables
+func Archive(ctx context.Context, db *gorm.DB, bondID string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, table := range Tables {
+			if err := move(tx, table, ColdTable(table), bondID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.Bond{}).Where("bond_id = ?", bondID).
+			Update("archived_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to mark bond archived: %w", err)
+		}
+		return nil
+	})
+}
+
+// Restore moves an archived bond's detail rows back to the hot tables, e.g.
+// before a late distribution or redemption is written. The bond stays hot
+// until it is again untouched for the archive period.
+func Restore(ctx context.Context, db *gorm.DB, bondID string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, table := range Tables {
+			if err := move(tx, ColdTable(table), table, bondID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.Bond{}).Where("bond_id = ?", bondID).
+			Update("archived_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to mark bond restored: %w", err)
+		}
+		return nil
+	})
+}
+
+// move copies a bond's rows from one table to another and deletes the originals
+func move(tx *gorm.DB, from, to, bondID string) error {
+	if err := tx.Exec(fmt.Sprintf("INSERT INTO %s SELECT * FROM %s WHERE bond_id = ?", to, from), bondID).Error; err != nil {
+		return fmt.Errorf("failed to copy %s to %s: %w", from, to, err)
+	}
+	if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE bond_id = ?", from), bondID).Error; err != nil {
+		return fmt.Errorf("failed to delete from %s: %w", from, err)
+	}
+	return nil
+}
+
+// Config controls the archiver
+type Config struct {
+	After     time.Duration // How long a closed bond must be untouched before it is archived
+	Interval  time.Duration // How often closed bonds are checked
+	BatchSize int           // Bonds loaded per query
+}
+
+// DefaultConfig returns default archiver configuration
+func DefaultConfig() Config {
+	return Config{
+		After:     180 * 24 * time.Hour,
+		Interval:  24 * time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// Archiver periodically archives closed bonds
+type Archiver struct {
+	db     *gorm.DB
+	config Config
+}
+
+// New creates an archiver
+func New(db *gorm.DB, config Config) *Archiver {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultConfig().BatchSize
+	}
+	return &Archiver{db: db, config: config}
+}
+
+// Start archives closed bonds on every interval until the context is cancelled
+func (a *Archiver) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := a.Run(ctx); err != nil {
+				log.Printf("Archiving failed: %v", err)
+			} else if n > 0 {
+				log.Printf("Archived %d closed bonds", n)
+			}
+		}
+	}
+}
+
+// Run archives every matured or defaulted bond untouched for the archive
+// period and returns how many were archived. A bond that fails is logged and
+// left for the next run.
+func (a *Archiver) Run(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-a.config.After)
+	archived := 0
+	var lastID uint
+	for {
+		var bonds []models.Bond
+		err := a.db.WithContext(ctx).
+			Select("id", "bond_id").
+			Where("status IN ? AND archived_at IS NULL AND updated_at < ? AND id > ?",
+				[]string{"MATURED", "DEFAULTED"}, cutoff, lastID).
+			Order("id ASC").
+			Limit(a.config.BatchSize).
+			Find(&bonds).Error
+		if err != nil {
+			return archived, fmt.Errorf("failed to load closed bonds: %w", err)
+		}
+		if len(bonds) == 0 {
+			break
+		}
+		lastID = bonds[len(bonds)-1].ID
+
+		for _, bond := range bonds {
+			if err := Archive(ctx, a.db, bond.BondID); err != nil {
+				log.Printf("Failed to archive bond %s: %v", bond.BondID, err)
+				continue
+			}
+			metrics.ArchivedBonds.Inc()
+			archived++
+		}
+
+		if len(bonds) < a.config.BatchSize {
+			break
+		}
+	}
+	return archived, nil
+}
@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		run     func(ctx context.Context, db *gorm.DB) error
+		from    func(table string) string
+		to      func(table string) string
+		archive bool
+	}{
+		{
+			name:    "archive",
+			run:     func(ctx context.Context, db *gorm.DB) error { return Archive(ctx, db, "BOND-1") },
+			from:    func(table string) string { return table },
+			to:      ColdTable,
+			archive: true,
+		},
+		{
+			name: "restore",
+			run:  func(ctx context.Context, db *gorm.DB) error { return Restore(ctx, db, "BOND-1") },
+			from: ColdTable,
+			to:   func(table string) string { return table },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			mock.ExpectBegin()
+			for _, table := range Tables {
+				mock.ExpectExec(`INSERT INTO ` + tt.to(table) + ` SELECT \* FROM ` + tt.from(table) + ` WHERE bond_id = \$1`).
+					WithArgs("BOND-1").WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`DELETE FROM ` + tt.from(table) + ` WHERE bond_id = \$1`).
+					WithArgs("BOND-1").WillReturnResult(sqlmock.NewResult(0, 3))
+			}
+			archivedAt := interface{}(nil)
+			if tt.archive {
+				archivedAt = sqlmock.AnyArg()
+			}
+			mock.ExpectExec(`UPDATE "bonds" SET "archived_at"=\$1,"updated_at"=\$2 WHERE bond_id = \$3`).
+				WithArgs(archivedAt, sqlmock.AnyArg(), "BOND-1").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			if err := tt.run(context.Background(), db); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestArchiveRollsBack(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO archived_investments`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM investments`).WillReturnError(errors.New("lock timeout"))
+	mock.ExpectRollback()
+
+	if err := Archive(context.Background(), db, "BOND-1"); err == nil {
+		t.Fatal("Archive() error = nil, want the delete error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	db, mock := newMockDB(t)
+	after := 30 * 24 * time.Hour
+
+	// The first page is full, so a second is loaded; BOND-2 fails and is skipped
+	mock.ExpectQuery(`SELECT "id","bond_id" FROM "bonds" WHERE \(status IN \(\$1,\$2\) AND archived_at IS NULL AND updated_at < \$3 AND id > \$4\)`).
+		WithArgs("MATURED", "DEFAULTED", sqlmock.AnyArg(), 0, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id"}).AddRow(1, "BOND-1").AddRow(2, "BOND-2"))
+	mock.ExpectBegin()
+	for _, table := range Tables {
+		mock.ExpectExec(`INSERT INTO ` + ColdTable(table)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM ` + table).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(`UPDATE "bonds"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO archived_investments`).WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+	mock.ExpectQuery(`SELECT "id","bond_id" FROM "bonds"`).
+		WithArgs("MATURED", "DEFAULTED", sqlmock.AnyArg(), 2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id"}))
+
+	n, err := New(db, Config{After: after, BatchSize: 2}).Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if n != 1 {
+		t.Errorf("Run() archived %d bonds, want 1", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
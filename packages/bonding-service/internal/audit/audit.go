@@ -0,0 +1,152 @@
+// Package audit keeps an append-only trail of mutating calls: who called
+// which RPC, a digest of the request, the transactions it sent, and the
+// bond's status and investors' positions before and after.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Subject is the bond, tranche and investors a call acts on
+type Subject struct {
+	BondID    string
+	TrancheID int // -1 when the call isn't about one tranche
+	Investors []string
+}
+
+// SubjectFunc returns the subject of a request
+type SubjectFunc func(ctx context.Context, req interface{}) (Subject, error)
+
+// Recorder writes and queries the audit log
+type Recorder struct {
+	db *gorm.DB
+}
+
+// NewRecorder creates a recorder
+func NewRecorder(db *gorm.DB) *Recorder {
+	return &Recorder{db: db}
+}
+
+// Digest returns the hex SHA-256 of a request's JSON encoding
+func Digest(req interface{}) string {
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// state is a subject's bond status and investor positions at one moment
+type state struct {
+	bondStatus string
+	positions  string
+}
+
+// snapshot reads a subject's state
+func (r *Recorder) snapshot(ctx context.Context, subj Subject) (state, error) {
+	var st state
+	if subj.BondID == "" {
+		return st, nil
+	}
+	var bonds []models.Bond
+	if err := r.db.WithContext(ctx).Select("status").Where("bond_id = ?", subj.BondID).Limit(1).Find(&bonds).Error; err != nil {
+		return st, fmt.Errorf("failed to load bond status: %w", err)
+	}
+	if len(bonds) > 0 {
+		st.bondStatus = bonds[0].Status
+	}
+	if subj.TrancheID < 0 || len(subj.Investors) == 0 {
+		return st, nil
+	}
+
+	var investments []models.Investment
+	if err := r.db.WithContext(ctx).Select("investor", "amount").
+		Where("bond_id = ? AND tranche_id = ? AND investor IN ?", subj.BondID, subj.TrancheID, subj.Investors).
+		Find(&investments).Error; err != nil {
+		return st, fmt.Errorf("failed to load positions: %w", err)
+	}
+	sums := make(map[string]*big.Int, len(subj.Investors))
+	for _, investor := range subj.Investors {
+		sums[investor] = new(big.Int)
+	}
+	for _, inv := range investments {
+		if amount, ok := new(big.Int).SetString(inv.Amount, 10); ok && sums[inv.Investor] != nil {
+			sums[inv.Investor].Add(sums[inv.Investor], amount)
+		}
+	}
+	positions := make(map[string]string, len(sums))
+	for investor, sum := range sums {
+		positions[investor] = sum.String()
+	}
+	encoded, err := json.Marshal(positions)
+	if err != nil {
+		return st, err
+	}
+	st.positions = string(encoded)
+	return st, nil
+}
+
+// Filter narrows an audit log query; zero fields match everything
+type Filter struct {
+	Principal string
+	Method    string
+	BondID    string
+	Since     time.Time
+	Until     time.Time // Exclusive
+	Limit     int
+	Offset    int
+}
+
+// Query returns a tenant's entries matching the filter, newest first
+func (r *Recorder) Query(ctx context.Context, tenantID string, f Filter) ([]models.AuditLog, error) {
+	limit := f.Limit
+	if limit <= 0 {
+		limit = repository.DefaultPageSize
+	}
+	if limit > repository.MaxPageSize {
+		limit = repository.MaxPageSize
+	}
+
+	query := repository.DB(ctx, r.db).Where("tenant_id = ?", tenantID)
+	if f.Principal != "" {
+		query = query.Where("principal = ?", f.Principal)
+	}
+	if f.Method != "" {
+		query = query.Where("method = ?", f.Method)
+	}
+	if f.BondID != "" {
+		query = query.Where("bond_id = ?", f.BondID)
+	}
+	if !f.Since.IsZero() {
+		query = query.Where("created_at >= ?", f.Since)
+	}
+	if !f.Until.IsZero() {
+		query = query.Where("created_at < ?", f.Until)
+	}
+
+	var entries []models.AuditLog
+	if err := query.Order("id DESC").Limit(limit).Offset(f.Offset).Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// SplitList splits a comma-separated column, e.g. TxHashes
+func SplitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
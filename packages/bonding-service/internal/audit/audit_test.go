@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const alice = "0x1111111111111111111111111111111111111111"
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+type investRequest struct {
+	BondID string
+	Amount string
+}
+
+type investResponse struct {
+	TxHash string
+	Status string
+}
+
+func expectState(mock sqlmock.Sqlmock, status string, amounts ...string) {
+	mock.ExpectQuery(`SELECT "status" FROM "bonds" WHERE bond_id = \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+	rows := sqlmock.NewRows([]string{"investor", "amount"})
+	for _, amount := range amounts {
+		rows.AddRow(alice, amount)
+	}
+	mock.ExpectQuery(`SELECT "investor","amount" FROM "investments" WHERE \(bond_id = \$1 AND tranche_id = \$2 AND investor IN \(\$3\)\)`).
+		WithArgs("7", 1, alice).
+		WillReturnRows(rows)
+}
+
+func TestInterceptorRecordsCall(t *testing.T) {
+	db, mock := newMockDB(t)
+	subjects := func(ctx context.Context, req interface{}) (Subject, error) {
+		return Subject{BondID: "7", TrancheID: 1, Investors: []string{alice}}, nil
+	}
+	intercept := UnaryServerInterceptor(NewRecorder(db), func(string) bool { return true }, subjects)
+	req := &investRequest{BondID: "7", Amount: "25"}
+
+	expectState(mock, "ACTIVE", "100", "50")
+	expectState(mock, "ACTIVE", "100", "50", "25")
+	mock.ExpectQuery(`INSERT INTO "audit_logs"`).
+		WithArgs(sqlmock.AnyArg(), "acme", alice, "wallet", "investor,issuer", "Invest", Digest(req), "OK", "", "0xabc",
+			"7", "ACTIVE", "ACTIVE", 1, `{"`+alice+`":"150"}`, `{"`+alice+`":"175"}`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenant.MetadataKey, "acme"))
+	ctx = rbac.NewContext(ctx, &rbac.Principal{ID: alice, Method: "wallet", Roles: []rbac.Role{rbac.Investor, rbac.Issuer}})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &investResponse{TxHash: "0xabc", Status: "success"}, nil
+	}
+	if _, err := intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/Invest"}, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInterceptorRecordsFailure(t *testing.T) {
+	db, mock := newMockDB(t)
+	subjects := func(ctx context.Context, req interface{}) (Subject, error) {
+		return Subject{}, errors.New("order lookup failed")
+	}
+	intercept := UnaryServerInterceptor(NewRecorder(db), func(string) bool { return true }, subjects)
+
+	mock.ExpectQuery(`INSERT INTO "audit_logs"`).
+		WithArgs(sqlmock.AnyArg(), tenant.Default, "", "", "", "FillOrder", sqlmock.AnyArg(), "FailedPrecondition", "order expired", "",
+			"", "", "", -1, "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	wantErr := status.Error(codes.FailedPrecondition, "order expired")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, wantErr
+	}
+	_, err := intercept(context.Background(), &investRequest{}, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/FillOrder"}, handler)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("interceptor error = %v, want the handler's", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInterceptorSkipsUnauditedMethods(t *testing.T) {
+	db, mock := newMockDB(t)
+	intercept := UnaryServerInterceptor(NewRecorder(db), func(string) bool { return false }, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	if _, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/GetBondInfo"}, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTxHashes(t *testing.T) {
+	type replaceResponse struct {
+		OriginalTxHash    string
+		ReplacementTxHash string
+		Nonce             uint64
+	}
+	tests := []struct {
+		name string
+		resp interface{}
+		want []string
+	}{
+		{"one hash", &investResponse{TxHash: "0x1"}, []string{"0x1"}},
+		{"replacement", &replaceResponse{OriginalTxHash: "0x1", ReplacementTxHash: "0x2"}, []string{"0x1", "0x2"}},
+		{"not sent", &investResponse{}, nil},
+		{"failed call", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := txHashes(tt.resp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("txHashes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Digests must not depend on anything but the request's content
+func TestDigest(t *testing.T) {
+	a := Digest(&investRequest{BondID: "7", Amount: "25"})
+	if a != Digest(&investRequest{BondID: "7", Amount: "25"}) {
+		t.Error("Digest() differs for equal requests")
+	}
+	if a == Digest(&investRequest{BondID: "7", Amount: "26"}) {
+		t.Error("Digest() equal for different requests")
+	}
+}
@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor records every call of an audited method, whatever
+// its outcome. A call is never failed for want of an audit entry: the write
+// it made can't be taken back, so the failure is logged and counted instead.
+func UnaryServerInterceptor(r *Recorder, audited func(fullMethod string) bool, subjects SubjectFunc) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !audited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		entry := &models.AuditLog{
+			TenantID:      tenant.FromContext(ctx),
+			Method:        info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:],
+			RequestDigest: Digest(req),
+		}
+		if p, ok := rbac.FromContext(ctx); ok {
+			entry.Principal = p.ID
+			entry.AuthMethod = p.Method
+			roles := make([]string, len(p.Roles))
+			for i, role := range p.Roles {
+				roles[i] = string(role)
+			}
+			entry.Roles = strings.Join(roles, ",")
+		}
+
+		subj, err := subjects(ctx, req)
+		if err != nil {
+			log.Printf("Failed to resolve audit subject of %s: %v", info.FullMethod, err)
+			subj = Subject{TrancheID: -1}
+		}
+		before, err := r.snapshot(ctx, subj)
+		if err != nil {
+			log.Printf("Failed to audit state before %s: %v", info.FullMethod, err)
+		}
+
+		resp, callErr := handler(ctx, req)
+
+		entry.Code = status.Code(callErr).String()
+		if callErr != nil {
+			entry.Error = status.Convert(callErr).Message()
+		}
+		if subj.BondID == "" {
+			subj.BondID = stringField(resp, "BondId") // Known once issued
+		}
+		entry.TxHashes = strings.Join(txHashes(resp), ",")
+		// The handler's context may be cancelled by now; the entry is still due
+		after, err := r.snapshot(context.WithoutCancel(ctx), subj)
+		if err != nil {
+			log.Printf("Failed to audit state after %s: %v", info.FullMethod, err)
+		}
+		entry.BondID = subj.BondID
+		entry.TrancheID = subj.TrancheID
+		entry.BondStatusBefore, entry.PositionsBefore = before.bondStatus, before.positions
+		entry.BondStatusAfter, entry.PositionsAfter = after.bondStatus, after.positions
+
+		if err := r.db.WithContext(context.WithoutCancel(ctx)).Create(entry).Error; err != nil {
+			metrics.AuditFailures.Inc()
+			log.Printf("Failed to write audit entry for %s by %q: %v", info.FullMethod, entry.Principal, err)
+		}
+		return resp, callErr
+	}
+}
+
+// txHashes returns the transaction hashes a response reports, from its
+// string fields named TxHash or ending in it, e.g. ReplacementTxHash
+func txHashes(resp interface{}) []string {
+	v := reflect.ValueOf(resp)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	var hashes []string
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.IsExported() && strings.HasSuffix(field.Name, "TxHash") && field.Type.Kind() == reflect.String {
+			if hash := v.Field(i).String(); hash != "" {
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+	return hashes
+}
+
+// stringField reads a response's string field by name, empty if it has none
+func stringField(resp interface{}, name string) string {
+	v := reflect.ValueOf(resp)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.Elem().FieldByName(name)
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
@@ -0,0 +1,109 @@
+// Package auth authenticates gRPC callers and attaches their principal to
+// the call context, where authorization and audit logging find it. Callers
+// present either a JWT bearer token in the authorization header, issued by
+// the wallet login or by an OIDC provider, or, for service-to-service calls,
+// a static API key in x-api-key.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizationHeader is the gRPC metadata header carrying a bearer token
+const AuthorizationHeader = "authorization"
+
+// Authentication methods, as recorded on principals
+const (
+	MethodAPIKey = "api_key"
+	MethodWallet = "wallet"
+	MethodOIDC   = "oidc"
+)
+
+// ErrInvalidToken wraps every bearer token rejection
+var ErrInvalidToken = errors.New("invalid bearer token")
+
+// TokenVerifier verifies the bearer tokens of one issuer
+type TokenVerifier interface {
+	Issuer() string
+	Verify(ctx context.Context, token string) (*rbac.Principal, error)
+}
+
+// Authenticator resolves a call's credentials to a principal
+type Authenticator struct {
+	apiKeys   rbac.KeyRoles
+	verifiers map[string]TokenVerifier
+}
+
+// New creates an authenticator accepting apiKeys and the tokens of verifiers
+func New(apiKeys rbac.KeyRoles, verifiers ...TokenVerifier) *Authenticator {
+	a := &Authenticator{apiKeys: apiKeys, verifiers: make(map[string]TokenVerifier, len(verifiers))}
+	for _, v := range verifiers {
+		a.verifiers[v.Issuer()] = v
+	}
+	return a
+}
+
+// Authenticate returns the caller's principal, nil for a call without
+// credentials or with an API key that has no roles. A bearer token that
+// doesn't verify is an error rather than an anonymous call.
+func (a *Authenticator) Authenticate(ctx context.Context) (*rbac.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(AuthorizationHeader); len(values) > 0 {
+		scheme, token, ok := strings.Cut(strings.TrimSpace(values[0]), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			return nil, fmt.Errorf("%w: want a Bearer authorization", ErrInvalidToken)
+		}
+		return a.verify(ctx, strings.TrimSpace(token))
+	}
+	return a.apiKeys.Principal(ctx), nil
+}
+
+// verify hands a token to the verifier of the issuer it names. The issuer is
+// read before the signature is checked, but only to choose the verifier.
+func (a *Authenticator) verify(ctx context.Context, token string) (*rbac.Principal, error) {
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	verifier, ok := a.verifiers[claims.Issuer]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	principal, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return principal, nil
+}
+
+// UnaryServerInterceptor attaches the caller's principal to the context of
+// calls with valid credentials and refuses calls with invalid ones as
+// UNAUTHENTICATED. Calls without credentials proceed anonymously; the
+// authorization interceptor decides whether they may.
+func UnaryServerInterceptor(a *Authenticator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		principal, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if principal != nil {
+			ctx = rbac.NewContext(ctx, principal)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/knowton/bonding-service/internal/rbac"
+)
+
+// keyRefreshInterval limits how often an unknown key ID refetches the
+// provider's keys, so forged key IDs can't hammer it
+const keyRefreshInterval = time.Minute
+
+// OIDCVerifier verifies ID and access tokens of an OpenID Connect provider
+// against the keys it publishes. Roles are read from a claim holding a list
+// or a space-separated string; names that aren't roles are ignored. The
+// tenant is read from a string claim; tokens without one act for the default
+// tenant.
+type OIDCVerifier struct {
+	issuer      string
+	audience    string
+	rolesClaim  string
+	tenantClaim string
+	client      *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]interface{} // By key ID
+	fetchedAt time.Time
+}
+
+// NewOIDCVerifier creates a verifier of issuer's tokens for audience
+func NewOIDCVerifier(issuer, audience, rolesClaim, tenantClaim string) *OIDCVerifier {
+	return &OIDCVerifier{
+		issuer:      issuer,
+		audience:    audience,
+		rolesClaim:  rolesClaim,
+		tenantClaim: tenantClaim,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		keys:        make(map[string]interface{}),
+	}
+}
+
+// Issuer returns the provider's issuer URL
+func (v *OIDCVerifier) Issuer() string {
+	return v.issuer
+}
+
+// Verify checks a token's signature, issuer, audience and expiry
+func (v *OIDCVerifier) Verify(ctx context.Context, token string) (*rbac.Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384"}))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !claims.VerifyIssuer(v.issuer, true):
+		return nil, errors.New("wrong issuer")
+	case !claims.VerifyAudience(v.audience, true):
+		return nil, errors.New("wrong audience")
+	case !claims.VerifyExpiresAt(time.Now().Unix(), true):
+		return nil, errors.New("token has no expiry")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	tenant, _ := claims[v.tenantClaim].(string)
+	return &rbac.Principal{ID: subject, Method: MethodOIDC, Roles: v.roles(claims[v.rolesClaim]), Tenant: strings.TrimSpace(tenant)}, nil
+}
+
+// roles reads the known roles from a roles claim
+func (v *OIDCVerifier) roles(claim interface{}) []rbac.Role {
+	var names []string
+	switch c := claim.(type) {
+	case string:
+		names = strings.Fields(c)
+	case []interface{}:
+		for _, name := range c {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+
+	var roles []rbac.Role
+	for _, name := range names {
+		if role := rbac.Role(name); role.Valid() {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// key returns the provider's key with the ID, refetching the keys if it is
+// unknown, e.g. after the provider rotated them
+func (v *OIDCVerifier) key(ctx context.Context, kid string) (interface{}, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(v.fetchedAt) < keyRefreshInterval {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	keys, err := v.fetchKeys(ctx)
+	v.fetchedAt = time.Now()
+	if err != nil {
+		return nil, err
+	}
+	v.keys = keys
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+// fetchKeys reads the provider's JWKS, found through its discovery document
+func (v *OIDCVerifier) fetchKeys(ctx context.Context) (map[string]interface{}, error) {
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, strings.TrimSuffix(v.issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, errors.New("OIDC provider publishes no jwks_uri")
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := v.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC keys: %w", err)
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func (v *OIDCVerifier) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// jsonWebKey is a public key of a JWKS
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	if k.Use != "" && k.Use != "sig" {
+		return nil, fmt.Errorf("key %s is not a signing key", k.Kid)
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testProvider serves an OIDC discovery document and a JWKS with one RSA key
+func testProvider(t *testing.T) (*httptest.Server, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kid": "k1",
+			"kty": "RSA",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	return server, key
+}
+
+func signOIDC(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	server, key := testProvider(t)
+	verifier := NewOIDCVerifier(server.URL, "bonding-api", "roles", "tenant")
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name       string
+		kid        string
+		claims     jwt.MapClaims
+		wantErr    bool
+		wantRoles  []rbac.Role
+		wantTenant string
+	}{
+		{"valid", "k1", jwt.MapClaims{"iss": server.URL, "aud": "bonding-api", "sub": "svc-1", "exp": exp,
+			"roles": []string{"operator", "admin"}, "tenant": "acme"}, false, []rbac.Role{rbac.Operator}, "acme"},
+		{"space-separated roles", "k1", jwt.MapClaims{"iss": server.URL, "aud": []string{"other", "bonding-api"}, "sub": "u", "exp": exp,
+			"roles": "auditor investor"}, false, []rbac.Role{rbac.Auditor, rbac.Investor}, ""},
+		{"wrong audience", "k1", jwt.MapClaims{"iss": server.URL, "aud": "other", "sub": "u", "exp": exp}, true, nil, ""},
+		{"wrong issuer", "k1", jwt.MapClaims{"iss": "https://evil.example", "aud": "bonding-api", "sub": "u", "exp": exp}, true, nil, ""},
+		{"expired", "k1", jwt.MapClaims{"iss": server.URL, "aud": "bonding-api", "sub": "u", "exp": time.Now().Add(-time.Minute).Unix()}, true, nil, ""},
+		{"no expiry", "k1", jwt.MapClaims{"iss": server.URL, "aud": "bonding-api", "sub": "u"}, true, nil, ""},
+		{"unknown key", "k2", jwt.MapClaims{"iss": server.URL, "aud": "bonding-api", "sub": "u", "exp": exp}, true, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(context.Background(), signOIDC(t, key, tt.kid, tt.claims))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if principal.Method != MethodOIDC || len(principal.Roles) != len(tt.wantRoles) || principal.Tenant != tt.wantTenant {
+				t.Fatalf("Verify() = %+v, want roles %v of tenant %q", principal, tt.wantRoles, tt.wantTenant)
+			}
+			for i, role := range tt.wantRoles {
+				if principal.Roles[i] != role {
+					t.Errorf("Verify() roles = %v, want %v", principal.Roles, tt.wantRoles)
+				}
+			}
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	server, key := testProvider(t)
+	authenticator := New(nil, NewOIDCVerifier(server.URL, "bonding-api", "roles", "tenant"), NewWalletLogin(testSecret, time.Hour, nil, ""))
+	interceptor := UnaryServerInterceptor(authenticator)
+	valid := signOIDC(t, key, "k1", jwt.MapClaims{
+		"iss": server.URL, "aud": "bonding-api", "sub": "svc-1", "exp": time.Now().Add(time.Hour).Unix(), "roles": []string{"operator"},
+	})
+	unknownIssuer := signOIDC(t, key, "k1", jwt.MapClaims{
+		"iss": "https://evil.example", "aud": "bonding-api", "sub": "svc-1", "exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      codes.Code
+		wantPrincipal string
+	}{
+		{"bearer token", "Bearer " + valid, codes.OK, "svc-1"},
+		{"no credentials", "", codes.OK, ""},
+		{"unknown issuer", "Bearer " + unknownIssuer, codes.Unauthenticated, ""},
+		{"malformed token", "Bearer nonsense", codes.Unauthenticated, ""},
+		{"other scheme", "Basic dXNlcjpwYXNz", codes.Unauthenticated, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(AuthorizationHeader, tt.authorization))
+			}
+			var seen string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if p, ok := rbac.FromContext(ctx); ok {
+					seen = p.ID
+				}
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if seen != tt.wantPrincipal {
+				t.Errorf("handler principal = %q, want %q", seen, tt.wantPrincipal)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/knowton/bonding-service/internal/rbac"
+)
+
+// WalletIssuer is the issuer of wallet login tokens
+const WalletIssuer = "knowton-bonding-service"
+
+// ChallengeTTL is how long a wallet has to sign a login challenge
+const ChallengeTTL = 5 * time.Minute
+
+const challengeTitle = "Sign in to the KnowTon bonding service"
+
+// ErrInvalidLogin wraps wallet login rejections
+var ErrInvalidLogin = errors.New("invalid wallet login")
+
+// walletClaims are the claims of a wallet login token
+type walletClaims struct {
+	jwt.RegisteredClaims
+	Roles  []rbac.Role `json:"roles"`
+	Tenant string      `json:"tenant,omitempty"`
+}
+
+// WalletLogin issues tokens to wallets that sign a login challenge with
+// personal_sign. Challenges are stateless: the nonce is a MAC of the address
+// and expiry, so any instance sharing the secret can complete a login.
+type WalletLogin struct {
+	secret   []byte
+	tokenTTL time.Duration
+	issuers  []common.Address // Wallets granted the issuer role
+	tenant   string           // Tenant every wallet acts for
+	now      func() time.Time
+}
+
+// NewWalletLogin creates a wallet login signing tokens valid for tokenTTL
+// with secret. Every wallet is an investor; issuers are issuers too. The
+// tokens act for tenant, the default tenant if empty.
+func NewWalletLogin(secret []byte, tokenTTL time.Duration, issuers []common.Address, tenant string) *WalletLogin {
+	return &WalletLogin{secret: secret, tokenTTL: tokenTTL, issuers: issuers, tenant: tenant, now: time.Now}
+}
+
+// Issuer returns the issuer of wallet login tokens
+func (w *WalletLogin) Issuer() string {
+	return WalletIssuer
+}
+
+// Challenge returns the message address must sign to log in
+func (w *WalletLogin) Challenge(address common.Address) string {
+	expires := w.now().Add(ChallengeTTL).UTC().Truncate(time.Second)
+	return fmt.Sprintf("%s\nAddress: %s\nNonce: %s\nExpires: %s",
+		challengeTitle, address.Hex(), w.nonce(address, expires), expires.Format(time.RFC3339))
+}
+
+func (w *WalletLogin) nonce(address common.Address, expires time.Time) string {
+	mac := hmac.New(sha256.New, w.secret)
+	fmt.Fprintf(mac, "%s\n%d", address.Hex(), expires.Unix())
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// Login checks a signed challenge and returns a token for the signer
+func (w *WalletLogin) Login(message string, signature []byte) (string, time.Time, error) {
+	address, err := w.checkChallenge(message)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return "", time.Time{}, fmt.Errorf("%w: signature must be %d bytes", ErrInvalidLogin, crypto.SignatureLength)
+	}
+
+	// Wallets produce v as 27/28; crypto expects 0/1
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %v", ErrInvalidLogin, err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != address {
+		return "", time.Time{}, fmt.Errorf("%w: signed by %s, not %s", ErrInvalidLogin, signer.Hex(), address.Hex())
+	}
+
+	roles := []rbac.Role{rbac.Investor}
+	if slices.Contains(w.issuers, address) {
+		roles = append(roles, rbac.Issuer)
+	}
+	now := w.now()
+	expiresAt := now.Add(w.tokenTTL).Truncate(time.Second)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, walletClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    WalletIssuer,
+			Subject:   address.Hex(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Roles:  roles,
+		Tenant: w.tenant,
+	}).SignedString(w.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// checkChallenge returns the address of a challenge this login issued and
+// that hasn't expired
+func (w *WalletLogin) checkChallenge(message string) (common.Address, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) != 4 || lines[0] != challengeTitle {
+		return common.Address{}, fmt.Errorf("%w: not a login challenge", ErrInvalidLogin)
+	}
+	fields := make(map[string]string, 3)
+	for _, line := range lines[1:] {
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] = value
+	}
+
+	if !common.IsHexAddress(fields["Address"]) {
+		return common.Address{}, fmt.Errorf("%w: invalid address", ErrInvalidLogin)
+	}
+	address := common.HexToAddress(fields["Address"])
+	expires, err := time.Parse(time.RFC3339, fields["Expires"])
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: invalid expiry", ErrInvalidLogin)
+	}
+	if !hmac.Equal([]byte(fields["Nonce"]), []byte(w.nonce(address, expires))) {
+		return common.Address{}, fmt.Errorf("%w: challenge was not issued here", ErrInvalidLogin)
+	}
+	if !w.now().Before(expires) {
+		return common.Address{}, fmt.Errorf("%w: challenge expired", ErrInvalidLogin)
+	}
+	return address, nil
+}
+
+// Verify checks a wallet login token
+func (w *WalletLogin) Verify(ctx context.Context, token string) (*rbac.Principal, error) {
+	var claims walletClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return w.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyExpiresAt(w.now(), true) {
+		return nil, errors.New("token has no expiry")
+	}
+	return &rbac.Principal{ID: claims.Subject, Method: MethodWallet, Roles: claims.Roles, Tenant: claims.Tenant}, nil
+}
+
+// Handler serves the login endpoints:
+//
+//	POST /auth/challenge {"address": "0x..."} -> {"message": "..."}
+//	POST /auth/login {"message": "...", "signature": "0x..."} -> {"token": "...", "expires_at": 1700000000}
+func (w *WalletLogin) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/challenge", func(rw http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Address string `json:"address"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || !common.IsHexAddress(req.Address) {
+			http.Error(rw, "address must be a valid address", http.StatusBadRequest)
+			return
+		}
+		writeJSON(rw, map[string]string{"message": w.Challenge(common.HexToAddress(req.Address))})
+	})
+	mux.HandleFunc("POST /auth/login", func(rw http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Message   string `json:"message"`
+			Signature string `json:"signature"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(rw, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		signature, err := hexutil.Decode(req.Signature)
+		if err != nil {
+			http.Error(rw, "signature must be 0x-prefixed hex", http.StatusBadRequest)
+			return
+		}
+		token, expiresAt, err := w.Login(req.Message, signature)
+		if errors.Is(err, ErrInvalidLogin) {
+			http.Error(rw, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(rw, "failed to issue token", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(rw, map[string]interface{}{"token": token, "expires_at": expiresAt.Unix()})
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
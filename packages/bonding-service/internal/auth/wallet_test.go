@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/knowton/bonding-service/internal/rbac"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func signText(t *testing.T, message string) ([]byte, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27 // As wallets return it
+	return sig, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestWalletLogin(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet := crypto.PubkeyToAddress(key.PublicKey)
+	login := NewWalletLogin(testSecret, time.Hour, []common.Address{wallet}, "acme")
+
+	message := login.Challenge(wallet)
+	sig, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, expiresAt, err := login.Login(message, sig)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if until := time.Until(expiresAt); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("Login() expires in %v, want an hour", until)
+	}
+
+	principal, err := login.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if principal.ID != wallet.Hex() || principal.Method != MethodWallet || principal.Tenant != "acme" {
+		t.Errorf("Verify() = %+v, want wallet %s", principal, wallet.Hex())
+	}
+	if !principal.Has(rbac.Investor) || !principal.Has(rbac.Issuer) {
+		t.Errorf("Verify() roles = %v, want investor and issuer", principal.Roles)
+	}
+
+	other := NewWalletLogin([]byte("another secret, 32 bytes at least"), time.Hour, nil, "")
+	if _, err := other.Verify(context.Background(), token); err == nil {
+		t.Error("Verify() accepted a token signed with another secret")
+	}
+}
+
+func TestWalletLoginRejections(t *testing.T) {
+	login := NewWalletLogin(testSecret, time.Hour, nil, "")
+	wallet := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	expired := NewWalletLogin(testSecret, time.Hour, nil, "")
+	expired.now = func() time.Time { return time.Now().Add(-ChallengeTTL - time.Second) }
+
+	tests := []struct {
+		name    string
+		message func() string
+	}{
+		{"signed by another wallet", func() string { return login.Challenge(wallet) }},
+		{"expired challenge", func() string { return expired.Challenge(wallet) }},
+		{"forged nonce", func() string {
+			msg := login.Challenge(wallet)
+			i := strings.Index(msg, "Nonce: ") + len("Nonce: ")
+			return msg[:i] + strings.Repeat("0", 32) + msg[i+32:]
+		}},
+		{"not a challenge", func() string { return "hello" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := tt.message()
+			sig, _ := signText(t, message)
+			if _, _, err := login.Login(message, sig); !errors.Is(err, ErrInvalidLogin) {
+				t.Errorf("Login() error = %v, want ErrInvalidLogin", err)
+			}
+		})
+	}
+}
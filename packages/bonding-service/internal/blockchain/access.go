@@ -0,0 +1,106 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Roles defined by the IPBond contract's AccessControl
+var (
+	DefaultAdminRole = common.Hash{}
+	IssuerRole       = crypto.Keccak256Hash([]byte("ISSUER_ROLE"))
+)
+
+// Errors returned by CheckWritable
+var (
+	ErrContractPaused = errors.New("contract is paused")
+	ErrRoleMissing    = errors.New("contract role missing")
+)
+
+// Paused reports whether the contract is paused. It always reads the chain,
+// bypassing any view cache.
+func (c *IPBondContract) Paused(ctx context.Context) (bool, error) {
+	return c.callBool(ctx, "paused")
+}
+
+// HasRole reports whether account holds role on the contract. It always
+// reads the chain, bypassing any view cache.
+func (c *IPBondContract) HasRole(ctx context.Context, role common.Hash, account common.Address) (bool, error) {
+	return c.callBool(ctx, "hasRole", role, account)
+}
+
+// Signer returns the address transactions are sent from, if a key is configured
+func (c *IPBondContract) Signer() (common.Address, bool) {
+	privateKey := c.getPrivateKey()
+	if privateKey == nil {
+		return common.Address{}, false
+	}
+	return crypto.PubkeyToAddress(privateKey.PublicKey), true
+}
+
+// CheckWritable returns an error if transactions from the service would
+// revert: the contract is paused, the signer no longer holds ISSUER_ROLE, or
+// expectedAdmin, if set, no longer holds DEFAULT_ADMIN_ROLE
+func (c *IPBondContract) CheckWritable(ctx context.Context, expectedAdmin common.Address) error {
+	paused, err := c.Paused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return ErrContractPaused
+	}
+
+	if signer, ok := c.Signer(); ok {
+		isIssuer, err := c.HasRole(ctx, IssuerRole, signer)
+		if err != nil {
+			return err
+		}
+		if !isIssuer {
+			return fmt.Errorf("%w: signer %s no longer holds ISSUER_ROLE", ErrRoleMissing, signer.Hex())
+		}
+	}
+
+	if expectedAdmin != (common.Address{}) {
+		isAdmin, err := c.HasRole(ctx, DefaultAdminRole, expectedAdmin)
+		if err != nil {
+			return err
+		}
+		if !isAdmin {
+			return fmt.Errorf("%w: %s no longer holds DEFAULT_ADMIN_ROLE", ErrRoleMissing, expectedAdmin.Hex())
+		}
+	}
+	return nil
+}
+
+func (c *IPBondContract) callBool(ctx context.Context, method string, args ...interface{}) (bool, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	result, err := c.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &c.contractAddr,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+
+	values, err := c.abi.Unpack(method, result)
+	if err != nil {
+		return false, fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+	if len(values) == 0 {
+		return false, fmt.Errorf("empty %s result", method)
+	}
+	value, ok := values[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected %s result type %T", method, values[0])
+	}
+	return value, nil
+}
@@ -0,0 +1,98 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// accessService answers paused() and hasRole() calls from fixed state
+type accessService struct {
+	paused bool
+	roles  map[common.Hash]map[common.Address]bool
+}
+
+func (s *accessService) Call(ctx context.Context, args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	input, err := hexutil.Decode(args["input"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(input, bondABI.Methods["paused"].ID):
+		return bondABI.Methods["paused"].Outputs.Pack(s.paused)
+	case bytes.HasPrefix(input, bondABI.Methods["hasRole"].ID):
+		values, err := bondABI.Methods["hasRole"].Inputs.Unpack(input[4:])
+		if err != nil {
+			return nil, err
+		}
+		role := common.Hash(values[0].([32]byte))
+		return bondABI.Methods["hasRole"].Outputs.Pack(s.roles[role][values[1].(common.Address)])
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func TestCheckWritable(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	admin := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	tests := []struct {
+		name    string
+		service *accessService
+		admin   common.Address
+		wantErr error
+	}{
+		{
+			name:    "writable",
+			service: &accessService{roles: map[common.Hash]map[common.Address]bool{IssuerRole: {signer: true}, DefaultAdminRole: {admin: true}}},
+			admin:   admin,
+		},
+		{
+			name:    "paused",
+			service: &accessService{paused: true, roles: map[common.Hash]map[common.Address]bool{IssuerRole: {signer: true}}},
+			wantErr: ErrContractPaused,
+		},
+		{
+			name:    "issuer role revoked",
+			service: &accessService{roles: map[common.Hash]map[common.Address]bool{}},
+			wantErr: ErrRoleMissing,
+		},
+		{
+			name:    "admin changed",
+			service: &accessService{roles: map[common.Hash]map[common.Address]bool{IssuerRole: {signer: true}}},
+			admin:   admin,
+			wantErr: ErrRoleMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := rpc.NewServer()
+			if err := server.RegisterName("eth", tt.service); err != nil {
+				t.Fatal(err)
+			}
+			defer server.Stop()
+			contract, err := NewIPBondContract(ethclient.NewClient(rpc.DialInProc(server)),
+				"0x00000000000000000000000000000000000000cc", common.Bytes2Hex(crypto.FromECDSA(key)), 42161)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = contract.CheckWritable(context.Background(), tt.admin)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckWritable() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 // IPBondContract wraps the IPBond smart contract
 type IPBondContract struct {
 	client       *ethclient.Client
+	caller       ethereum.ContractCaller // Used for view calls; may be cached
 	contractAddr common.Address
 	abi          abi.ABI
 	privateKey   string
@@ -40,6 +41,7 @@ func NewIPBondContract(
 
 	return &IPBondContract{
 		client:       client,
+		caller:       client,
 		contractAddr: common.HexToAddress(contractAddr),
 		abi:          contractABI,
 		privateKey:   privateKey,
@@ -119,6 +121,67 @@ func (c *IPBondContract) IssueBond(
 	return signedTx, nil
 }
 
+// SimulateIssueBond runs an IssueBond call with eth_call against the pending
+// state and returns the bond ID the contract would assign. Nothing is sent.
+func (c *IPBondContract) SimulateIssueBond(
+	ctx context.Context,
+	ipnftID *big.Int,
+	nftContract common.Address,
+	totalValue *big.Int,
+	seniorAllocation *big.Int,
+	mezzanineAllocation *big.Int,
+	juniorAllocation *big.Int,
+	maturityDate *big.Int,
+	valuationUSD *big.Int,
+	riskRating string,
+) (*big.Int, error) {
+	data, err := packIssueBond(c.abi, ipnftID, nftContract, totalValue, seniorAllocation,
+		mezzanineAllocation, juniorAllocation, maturityDate, valuationUSD, riskRating)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	msg := ethereum.CallMsg{To: &c.contractAddr, Data: data}
+	if key := c.getPrivateKey(); key != nil {
+		msg.From = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	out, err := c.client.PendingCallContract(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("issueBond call failed: %w", err)
+	}
+	values, err := c.abi.Unpack("issueBond", out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode issueBond result: %w", err)
+	}
+	return values[0].(*big.Int), nil
+}
+
+func packIssueBond(
+	contractABI abi.ABI,
+	ipnftID *big.Int,
+	nftContract common.Address,
+	totalValue *big.Int,
+	seniorAllocation *big.Int,
+	mezzanineAllocation *big.Int,
+	juniorAllocation *big.Int,
+	maturityDate *big.Int,
+	valuationUSD *big.Int,
+	riskRating string,
+) ([]byte, error) {
+	return contractABI.Pack(
+		"issueBond",
+		ipnftID,
+		nftContract,
+		totalValue,
+		seniorAllocation,
+		mezzanineAllocation,
+		juniorAllocation,
+		maturityDate,
+		valuationUSD,
+		riskRating,
+	)
+}
+
 // Invest invests in a bond tranche
 func (c *IPBondContract) Invest(
 	ctx context.Context,
@@ -238,6 +301,242 @@ func (c *IPBondContract) DistributeRevenue(
 	return signedTx, nil
 }
 
+// Redeem redeems part or all of an investor's tranche position before maturity.
+// payout is the amount returned to the investor after the early redemption penalty.
+func (c *IPBondContract) Redeem(
+	ctx context.Context,
+	bondID *big.Int,
+	trancheID uint8,
+	investor common.Address,
+	amount *big.Int,
+	payout *big.Int,
+) (*types.Transaction, error) {
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.abi.Pack(
+		"redeem",
+		bondID,
+		trancheID,
+		investor,
+		amount,
+		payout,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 300000)
+}
+
+// TransferPosition moves part or all of a tranche position from one investor to another
+func (c *IPBondContract) TransferPosition(
+	ctx context.Context,
+	bondID *big.Int,
+	trancheID uint8,
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.abi.Pack(
+		"transferPosition",
+		bondID,
+		trancheID,
+		from,
+		to,
+		amount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 200000)
+}
+
+// CommitStateRoot publishes the Merkle root of a bond's positions and
+// distributions for an epoch
+func (c *IPBondContract) CommitStateRoot(
+	ctx context.Context,
+	bondID *big.Int,
+	epoch uint64,
+	root common.Hash,
+) (*types.Transaction, error) {
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.abi.Pack("commitStateRoot", bondID, epoch, [32]byte(root))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 100000)
+}
+
+// EmergencyWithdraw sweeps a bond's escrowed funds to a recovery address.
+// The reason code is emitted with the contract's EmergencyWithdrawal event.
+func (c *IPBondContract) EmergencyWithdraw(
+	ctx context.Context,
+	bondID *big.Int,
+	recipient common.Address,
+	reasonCode string,
+) (*types.Transaction, error) {
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.abi.Pack("emergencyWithdraw", bondID, recipient, reasonCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 150000)
+}
+
+// PermitAndInvest submits an ERC-2612 permit and an ERC-20 investment in a
+// single multicall transaction, so the investor needs no separate approval
+func (c *IPBondContract) PermitAndInvest(
+	ctx context.Context,
+	bondID *big.Int,
+	trancheID uint8,
+	token common.Address,
+	investor common.Address,
+	amount *big.Int,
+	permitValue *big.Int,
+	deadline *big.Int,
+	v uint8,
+	r [32]byte,
+	s [32]byte,
+) (*types.Transaction, error) {
+	data, err := c.PackPermitAndInvest(bondID, trancheID, token, investor, amount, permitValue, deadline, v, r, s)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 350000)
+}
+
+// PackPermitAndInvest builds the multicall calldata for PermitAndInvest
+func (c *IPBondContract) PackPermitAndInvest(
+	bondID *big.Int,
+	trancheID uint8,
+	token common.Address,
+	investor common.Address,
+	amount *big.Int,
+	permitValue *big.Int,
+	deadline *big.Int,
+	v uint8,
+	r [32]byte,
+	s [32]byte,
+) ([]byte, error) {
+	permitCall, err := c.abi.Pack("permitToken", token, investor, permitValue, deadline, v, r, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack permit call: %w", err)
+	}
+
+	investCall, err := c.abi.Pack("investWithToken", bondID, trancheID, token, investor, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack invest call: %w", err)
+	}
+
+	data, err := c.abi.Pack("multicall", [][]byte{permitCall, investCall})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack multicall: %w", err)
+	}
+	return data, nil
+}
+
+// PermitAndTradePosition settles a secondary-market trade in a single
+// multicall transaction: the buyer's ERC-2612 permit, then a tradePosition
+// call that moves the position from seller to buyer and pays the seller
+// price in token from the buyer. Either both legs happen or neither does.
+func (c *IPBondContract) PermitAndTradePosition(
+	ctx context.Context,
+	bondID *big.Int,
+	trancheID uint8,
+	seller common.Address,
+	buyer common.Address,
+	amount *big.Int,
+	token common.Address,
+	price *big.Int,
+	deadline *big.Int,
+	v uint8,
+	r [32]byte,
+	s [32]byte,
+) (*types.Transaction, error) {
+	data, err := c.PackPermitAndTradePosition(bondID, trancheID, seller, buyer, amount, token, price, deadline, v, r, s)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := c.createTransactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendContractCall(ctx, auth, big.NewInt(0), data, 350000)
+}
+
+// PackPermitAndTradePosition builds the multicall calldata for PermitAndTradePosition
+func (c *IPBondContract) PackPermitAndTradePosition(
+	bondID *big.Int,
+	trancheID uint8,
+	seller common.Address,
+	buyer common.Address,
+	amount *big.Int,
+	token common.Address,
+	price *big.Int,
+	deadline *big.Int,
+	v uint8,
+	r [32]byte,
+	s [32]byte,
+) ([]byte, error) {
+	permitCall, err := c.abi.Pack("permitToken", token, buyer, price, deadline, v, r, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack permit call: %w", err)
+	}
+
+	tradeCall, err := c.abi.Pack("tradePosition", bondID, trancheID, seller, buyer, amount, token, price)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack trade call: %w", err)
+	}
+
+	data, err := c.abi.Pack("multicall", [][]byte{permitCall, tradeCall})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack multicall: %w", err)
+	}
+	return data, nil
+}
+
+// PackClaim builds the calldata an investor sends to withdraw what a tranche's
+// distributions owe them
+func PackClaim(bondID *big.Int, trancheID uint8) ([]byte, error) {
+	data, err := bondABI.Pack("claim", bondID, trancheID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack claim call: %w", err)
+	}
+	return data, nil
+}
+
+// SetViewCaller routes view calls through caller, typically a view cache
+func (c *IPBondContract) SetViewCaller(caller ethereum.ContractCaller) {
+	c.caller = caller
+}
+
 // GetBondInfo retrieves bond information from the blockchain
 func (c *IPBondContract) GetBondInfo(
 	ctx context.Context,
@@ -250,7 +549,7 @@ func (c *IPBondContract) GetBondInfo(
 	}
 
 	// Call contract
-	result, err := c.client.CallContract(ctx, ethereum.CallMsg{
+	result, err := c.caller.CallContract(ctx, ethereum.CallMsg{
 		To:   &c.contractAddr,
 		Data: data,
 	}, nil)
@@ -285,6 +584,45 @@ func (c *IPBondContract) GetBondInfo(
 	}, nil
 }
 
+// GetTrancheInfo retrieves a tranche's aggregates from the blockchain
+func (c *IPBondContract) GetTrancheInfo(
+	ctx context.Context,
+	bondID *big.Int,
+	trancheID uint8,
+) (map[string]interface{}, error) {
+	data, err := c.abi.Pack("getTrancheInfo", bondID, trancheID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+
+	result, err := c.caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &c.contractAddr,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+
+	var trancheInfo struct {
+		Allocation    *big.Int
+		Apy           *big.Int
+		TotalInvested *big.Int
+		InvestorCount *big.Int
+	}
+
+	err = c.abi.UnpackIntoInterface(&trancheInfo, "getTrancheInfo", result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack result: %w", err)
+	}
+
+	return map[string]interface{}{
+		"allocation":    trancheInfo.Allocation.String(),
+		"apy":           trancheInfo.Apy.String(),
+		"totalInvested": trancheInfo.TotalInvested.String(),
+		"investorCount": trancheInfo.InvestorCount.Int64(),
+	}, nil
+}
+
 // WaitForTransaction waits for a transaction to be mined
 func (c *IPBondContract) WaitForTransaction(
 	ctx context.Context,
@@ -334,6 +672,45 @@ func (c *IPBondContract) createTransactor(ctx context.Context) (*bind.TransactOp
 	return auth, nil
 }
 
+// sendContractCall estimates gas, signs and sends a call to the bond contract
+func (c *IPBondContract) sendContractCall(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+	value *big.Int,
+	data []byte,
+	fallbackGasLimit uint64,
+) (*types.Transaction, error) {
+	gasLimit, err := c.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  auth.From,
+		To:    &c.contractAddr,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		gasLimit = fallbackGasLimit
+	}
+
+	tx := types.NewTransaction(
+		auth.Nonce.Uint64(),
+		c.contractAddr,
+		value,
+		gasLimit,
+		auth.GasPrice,
+		data,
+	)
+
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(c.chainID), c.getPrivateKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	if err := c.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	return signedTx, nil
+}
+
 func (c *IPBondContract) getPrivateKey() *ecdsa.PrivateKey {
 	privateKey, _ := crypto.HexToECDSA(c.privateKey)
 	return privateKey
@@ -380,6 +757,118 @@ const IPBondABI = `[
 		"stateMutability": "nonpayable",
 		"type": "function"
 	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "trancheId", "type": "uint8"},
+			{"name": "investor", "type": "address"},
+			{"name": "amount", "type": "uint256"},
+			{"name": "payout", "type": "uint256"}
+		],
+		"name": "redeem",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "trancheId", "type": "uint8"},
+			{"name": "from", "type": "address"},
+			{"name": "to", "type": "address"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"name": "transferPosition",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "trancheId", "type": "uint8"},
+			{"name": "seller", "type": "address"},
+			{"name": "buyer", "type": "address"},
+			{"name": "amount", "type": "uint256"},
+			{"name": "token", "type": "address"},
+			{"name": "price", "type": "uint256"}
+		],
+		"name": "tradePosition",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "trancheId", "type": "uint8"}
+		],
+		"name": "claim",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "epoch", "type": "uint64"},
+			{"name": "root", "type": "bytes32"}
+		],
+		"name": "commitStateRoot",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "recipient", "type": "address"},
+			{"name": "reason", "type": "string"}
+		],
+		"name": "emergencyWithdraw",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "token", "type": "address"},
+			{"name": "owner", "type": "address"},
+			{"name": "value", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"},
+			{"name": "v", "type": "uint8"},
+			{"name": "r", "type": "bytes32"},
+			{"name": "s", "type": "bytes32"}
+		],
+		"name": "permitToken",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "bondId", "type": "uint256"},
+			{"name": "trancheId", "type": "uint8"},
+			{"name": "token", "type": "address"},
+			{"name": "investor", "type": "address"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"name": "investWithToken",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "data", "type": "bytes[]"}
+		],
+		"name": "multicall",
+		"outputs": [
+			{"name": "results", "type": "bytes[]"}
+		],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
 	{
 		"inputs": [
 			{"name": "bondId", "type": "uint256"}
@@ -412,6 +901,27 @@ const IPBondABI = `[
 		"stateMutability": "view",
 		"type": "function"
 	},
+	{
+		"inputs": [],
+		"name": "paused",
+		"outputs": [
+			{"name": "", "type": "bool"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "role", "type": "bytes32"},
+			{"name": "account", "type": "address"}
+		],
+		"name": "hasRole",
+		"outputs": [
+			{"name": "", "type": "bool"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	},
 	{
 		"anonymous": false,
 		"inputs": [
@@ -443,5 +953,50 @@ const IPBondABI = `[
 		],
 		"name": "RevenueDistributed",
 		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "bondId", "type": "uint256"},
+			{"indexed": true, "name": "investor", "type": "address"},
+			{"indexed": false, "name": "trancheId", "type": "uint8"},
+			{"indexed": false, "name": "amount", "type": "uint256"},
+			{"indexed": false, "name": "payout", "type": "uint256"}
+		],
+		"name": "Redemption",
+		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "bondId", "type": "uint256"},
+			{"indexed": true, "name": "from", "type": "address"},
+			{"indexed": true, "name": "to", "type": "address"},
+			{"indexed": false, "name": "trancheId", "type": "uint8"},
+			{"indexed": false, "name": "amount", "type": "uint256"}
+		],
+		"name": "PositionTransferred",
+		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "bondId", "type": "uint256"},
+			{"indexed": true, "name": "investor", "type": "address"},
+			{"indexed": false, "name": "trancheId", "type": "uint8"},
+			{"indexed": false, "name": "amount", "type": "uint256"}
+		],
+		"name": "Claimed",
+		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "bondId", "type": "uint256"},
+			{"indexed": false, "name": "epoch", "type": "uint64"},
+			{"indexed": false, "name": "root", "type": "bytes32"}
+		],
+		"name": "StateRootCommitted",
+		"type": "event"
 	}
 ]`
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/knowton/bonding-service/internal/chains"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,7 +45,7 @@ func TestIPBondContractIntegration(t *testing.T) {
 	defer client.Close()
 
 	// Create contract instance
-	contract, err := NewIPBondContract(client, contractAddr, privateKey, 421614) // Arbitrum Sepolia
+	contract, err := NewIPBondContract(client, contractAddr, privateKey, testChainID(t))
 	require.NoError(t, err, "Failed to create contract instance")
 
 	ctx := context.Background()
@@ -220,7 +221,7 @@ func TestErrorHandling(t *testing.T) {
 	require.NoError(t, err)
 	defer client.Close()
 
-	contract, err := NewIPBondContract(client, contractAddr, privateKey, 421614)
+	contract, err := NewIPBondContract(client, contractAddr, privateKey, testChainID(t))
 	require.NoError(t, err)
 
 	ctx := context.Background()
@@ -289,7 +290,7 @@ func TestRetryLogic(t *testing.T) {
 	require.NoError(t, err)
 	defer client.Close()
 
-	contract, err := NewIPBondContract(client, contractAddr, privateKey, 421614)
+	contract, err := NewIPBondContract(client, contractAddr, privateKey, testChainID(t))
 	require.NoError(t, err)
 
 	ctx := context.Background()
@@ -333,7 +334,7 @@ func TestConcurrentInvestments(t *testing.T) {
 	require.NoError(t, err)
 	defer client.Close()
 
-	contract, err := NewIPBondContract(client, contractAddr, privateKey, 421614)
+	contract, err := NewIPBondContract(client, contractAddr, privateKey, testChainID(t))
 	require.NoError(t, err)
 
 	ctx := context.Background()
@@ -366,3 +367,14 @@ func TestConcurrentInvestments(t *testing.T) {
 		}
 	})
 }
+
+// testChainID looks up TEST_CHAIN (default arbitrum-sepolia) in the chain registry
+func testChainID(t *testing.T) int64 {
+	name := os.Getenv("TEST_CHAIN")
+	if name == "" {
+		name = chains.ArbitrumSepolia
+	}
+	chain, err := chains.Builtin().Get(name)
+	require.NoError(t, err, "Unknown test chain")
+	return chain.ChainID
+}
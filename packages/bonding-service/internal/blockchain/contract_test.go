@@ -0,0 +1,70 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// callService answers eth_call with a fixed result or error
+type callService struct {
+	result []byte
+	err    error
+	block  string
+}
+
+func (s *callService) Call(ctx context.Context, args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	s.block = block
+	return s.result, s.err
+}
+
+func TestSimulateIssueBond(t *testing.T) {
+	bondID, err := bondABI.Methods["issueBond"].Outputs.Pack(big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		service *callService
+		want    int64
+		wantErr bool
+	}{
+		{"assigns bond id", &callService{result: bondID}, 42, false},
+		{"reverts", &callService{err: errors.New("execution reverted: IP-NFT already bonded")}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := rpc.NewServer()
+			if err := server.RegisterName("eth", tt.service); err != nil {
+				t.Fatal(err)
+			}
+			defer server.Stop()
+			contract, err := NewIPBondContract(ethclient.NewClient(rpc.DialInProc(server)),
+				"0x00000000000000000000000000000000000000cc", "", 42161)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := contract.SimulateIssueBond(context.Background(), big.NewInt(7),
+				common.HexToAddress("0x00000000000000000000000000000000000000dd"), big.NewInt(1000),
+				big.NewInt(500), big.NewInt(300), big.NewInt(200), big.NewInt(1900000000), big.NewInt(5000), "A")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SimulateIssueBond() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Int64() != tt.want {
+				t.Errorf("SimulateIssueBond() = %s, want %d", got, tt.want)
+			}
+			if tt.service.block != "pending" {
+				t.Errorf("eth_call block = %q, want pending", tt.service.block)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrCustody is returned by CheckCustody when the IP-NFT can't be escrowed
+// to the bond contract
+var ErrCustody = errors.New("IP-NFT custody requirement not met")
+
+// errEmptyResult is returned by calls to an account without the function
+var errEmptyResult = errors.New("empty result")
+
+// NFTStandard is the token standard of an IP-NFT contract
+type NFTStandard string
+
+// Supported IP-NFT standards
+const (
+	ERC721  NFTStandard = "ERC-721"
+	ERC1155 NFTStandard = "ERC-1155"
+)
+
+// erc1155InterfaceID is the ERC-165 interface ID of ERC-1155
+var erc1155InterfaceID = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+
+var nftABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(NFTABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// NFTCustody reads the ownership and approvals of an IP-NFT
+type NFTCustody struct {
+	caller   ethereum.ContractCaller
+	contract common.Address
+}
+
+// NewNFTCustody creates a custody reader for an IP-NFT contract. Reads must
+// not go through a view cache, as ownership and approvals change.
+func NewNFTCustody(caller ethereum.ContractCaller, contract common.Address) *NFTCustody {
+	return &NFTCustody{caller: caller, contract: contract}
+}
+
+// Standard detects the contract's token standard through ERC-165. Contracts
+// that predate ERC-165 are taken to be ERC-721.
+func (n *NFTCustody) Standard(ctx context.Context) (NFTStandard, error) {
+	is1155, err := n.supportsInterface(ctx, erc1155InterfaceID)
+	if err != nil {
+		return "", err
+	}
+	if is1155 {
+		return ERC1155, nil
+	}
+	return ERC721, nil
+}
+
+// CheckCustody returns an error wrapping ErrCustody unless the bond contract
+// already holds the token, or the issuer holds it and has approved the bond
+// contract to take it into escrow
+func (n *NFTCustody) CheckCustody(ctx context.Context, tokenID *big.Int, issuer, bondContract common.Address) error {
+	standard, err := n.Standard(ctx)
+	if err != nil {
+		return err
+	}
+	if standard == ERC1155 {
+		return n.checkERC1155(ctx, tokenID, issuer, bondContract)
+	}
+	return n.checkERC721(ctx, tokenID, issuer, bondContract)
+}
+
+func (n *NFTCustody) checkERC721(ctx context.Context, tokenID *big.Int, issuer, bondContract common.Address) error {
+	var owner common.Address
+	if err := n.call(ctx, &owner, "ownerOf", tokenID); err != nil {
+		if _, reverted := revertReason(err); reverted {
+			return fmt.Errorf("%w: token %s does not exist on ERC-721 contract %s", ErrCustody, tokenID, n.contract.Hex())
+		}
+		if errors.Is(err, errEmptyResult) {
+			return fmt.Errorf("%w: %s is not an ERC-721 or ERC-1155 contract", ErrCustody, n.contract.Hex())
+		}
+		return err
+	}
+	if owner == bondContract {
+		return nil
+	}
+	if owner != issuer {
+		return fmt.Errorf("%w: token %s on %s is owned by %s, not the issuer %s", ErrCustody, tokenID, n.contract.Hex(), owner.Hex(), issuer.Hex())
+	}
+
+	var approved common.Address
+	if err := n.call(ctx, &approved, "getApproved", tokenID); err != nil {
+		return err
+	}
+	if approved == bondContract {
+		return nil
+	}
+	var operator bool
+	if err := n.call(ctx, &operator, "isApprovedForAll", issuer, bondContract); err != nil {
+		return err
+	}
+	if !operator {
+		return fmt.Errorf("%w: issuer %s has not approved bond contract %s for token %s on %s (approve or setApprovalForAll)", ErrCustody, issuer.Hex(), bondContract.Hex(), tokenID, n.contract.Hex())
+	}
+	return nil
+}
+
+func (n *NFTCustody) checkERC1155(ctx context.Context, tokenID *big.Int, issuer, bondContract common.Address) error {
+	var escrowed *big.Int
+	if err := n.call(ctx, &escrowed, "balanceOf", bondContract, tokenID); err != nil {
+		return err
+	}
+	if escrowed.Sign() > 0 {
+		return nil
+	}
+	var held *big.Int
+	if err := n.call(ctx, &held, "balanceOf", issuer, tokenID); err != nil {
+		return err
+	}
+	if held.Sign() == 0 {
+		return fmt.Errorf("%w: issuer %s holds no units of token %s on ERC-1155 contract %s", ErrCustody, issuer.Hex(), tokenID, n.contract.Hex())
+	}
+	var operator bool
+	if err := n.call(ctx, &operator, "isApprovedForAll", issuer, bondContract); err != nil {
+		return err
+	}
+	if !operator {
+		return fmt.Errorf("%w: issuer %s has not approved bond contract %s as operator on %s (setApprovalForAll)", ErrCustody, issuer.Hex(), bondContract.Hex(), n.contract.Hex())
+	}
+	return nil
+}
+
+// supportsInterface reports ERC-165 support, treating a revert or an empty
+// result as no support
+func (n *NFTCustody) supportsInterface(ctx context.Context, id [4]byte) (bool, error) {
+	var supported bool
+	err := n.call(ctx, &supported, "supportsInterface", id)
+	if err == nil {
+		return supported, nil
+	}
+	if _, reverted := revertReason(err); reverted || errors.Is(err, errEmptyResult) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (n *NFTCustody) call(ctx context.Context, out interface{}, method string, args ...interface{}) error {
+	data, err := nftABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	result, err := n.caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &n.contract,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call %s on %s: %w", method, n.contract.Hex(), err)
+	}
+	if len(result) == 0 {
+		// Calls to an account without code, or a missing function without a
+		// fallback that reverts, return nothing
+		return fmt.Errorf("failed to call %s on %s: %w", method, n.contract.Hex(), errEmptyResult)
+	}
+	if err := nftABI.UnpackIntoInterface(out, method, result); err != nil {
+		return fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+	return nil
+}
+
+// NFTABI is the subset of the ERC-165, ERC-721 and ERC-1155 ABIs custody
+// checks use
+const NFTABI = `[
+	{"inputs": [{"name": "interfaceId", "type": "bytes4"}], "name": "supportsInterface", "outputs": [{"name": "", "type": "bool"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "tokenId", "type": "uint256"}], "name": "ownerOf", "outputs": [{"name": "", "type": "address"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "tokenId", "type": "uint256"}], "name": "getApproved", "outputs": [{"name": "", "type": "address"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "owner", "type": "address"}, {"name": "operator", "type": "address"}], "name": "isApprovedForAll", "outputs": [{"name": "", "type": "bool"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "account", "type": "address"}, {"name": "id", "type": "uint256"}], "name": "balanceOf", "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view", "type": "function"}
+]`
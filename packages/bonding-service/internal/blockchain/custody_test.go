@@ -0,0 +1,104 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	testNFT      = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testBond     = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	testIssuer   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	testStranger = common.HexToAddress("0x4000000000000000000000000000000000000004")
+)
+
+// fakeNFT answers custody calls from fixed state. A nil owner makes ownerOf
+// revert as for a token that doesn't exist.
+type fakeNFT struct {
+	is1155   bool
+	no165    bool
+	owner    *common.Address
+	approved common.Address
+	operator bool
+	balances map[common.Address]int64
+}
+
+func (f fakeNFT) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	method, err := nftABI.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "supportsInterface":
+		if f.no165 {
+			return nil, errors.New("execution reverted")
+		}
+		return method.Outputs.Pack(f.is1155 && args[0].([4]byte) == erc1155InterfaceID)
+	case "ownerOf":
+		if f.owner == nil {
+			return nil, errors.New("execution reverted: ERC721: invalid token ID")
+		}
+		return method.Outputs.Pack(*f.owner)
+	case "getApproved":
+		return method.Outputs.Pack(f.approved)
+	case "isApprovedForAll":
+		return method.Outputs.Pack(f.operator)
+	case "balanceOf":
+		return method.Outputs.Pack(big.NewInt(f.balances[args[0].(common.Address)]))
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func TestCheckCustody(t *testing.T) {
+	tests := []struct {
+		name    string
+		nft     fakeNFT
+		wantErr error
+	}{
+		{"ERC-721 escrowed", fakeNFT{owner: &testBond}, nil},
+		{"ERC-721 approved", fakeNFT{owner: &testIssuer, approved: testBond}, nil},
+		{"ERC-721 operator without ERC-165", fakeNFT{no165: true, owner: &testIssuer, operator: true}, nil},
+		{"ERC-721 not approved", fakeNFT{owner: &testIssuer, approved: testStranger}, ErrCustody},
+		{"ERC-721 owned by someone else", fakeNFT{owner: &testStranger, operator: true}, ErrCustody},
+		{"ERC-721 missing token", fakeNFT{}, ErrCustody},
+		{"ERC-1155 escrowed", fakeNFT{is1155: true, balances: map[common.Address]int64{testBond: 1}}, nil},
+		{"ERC-1155 approved", fakeNFT{is1155: true, operator: true, balances: map[common.Address]int64{testIssuer: 1}}, nil},
+		{"ERC-1155 not approved", fakeNFT{is1155: true, balances: map[common.Address]int64{testIssuer: 1}}, ErrCustody},
+		{"ERC-1155 not held", fakeNFT{is1155: true, operator: true}, ErrCustody},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewNFTCustody(tt.nft, testNFT).CheckCustody(context.Background(), big.NewInt(7), testIssuer, testBond)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CheckCustody() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckCustody() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCustodyNotAContract(t *testing.T) {
+	var empty emptyCaller
+	err := NewNFTCustody(empty, testNFT).CheckCustody(context.Background(), big.NewInt(7), testIssuer, testBond)
+	if !errors.Is(err, ErrCustody) {
+		t.Errorf("CheckCustody() error = %v, want ErrCustody", err)
+	}
+}
+
+// emptyCaller answers like an account without code
+type emptyCaller struct{}
+
+func (emptyCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	return nil, nil
+}
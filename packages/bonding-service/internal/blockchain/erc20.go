@@ -0,0 +1,114 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ERC20PermitToken reads the state needed to build ERC-2612 permits
+type ERC20PermitToken struct {
+	client    *ethclient.Client
+	caller    ethereum.ContractCaller // Used for immutable reads; may be cached
+	tokenAddr common.Address
+	abi       abi.ABI
+}
+
+// NewERC20PermitToken creates a new ERC-2612 token reader
+func NewERC20PermitToken(client *ethclient.Client, tokenAddr string) (*ERC20PermitToken, error) {
+	tokenABI, err := abi.JSON(strings.NewReader(ERC20PermitABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token ABI: %w", err)
+	}
+
+	return &ERC20PermitToken{
+		client:    client,
+		caller:    client,
+		tokenAddr: common.HexToAddress(tokenAddr),
+		abi:       tokenABI,
+	}, nil
+}
+
+// SetViewCaller routes reads of immutable token metadata (name, version)
+// through caller, typically a view cache. Nonces always read from the chain.
+func (t *ERC20PermitToken) SetViewCaller(caller ethereum.ContractCaller) {
+	t.caller = caller
+}
+
+// Name returns the token name used in the EIP-712 domain
+func (t *ERC20PermitToken) Name(ctx context.Context) (string, error) {
+	var name string
+	if err := t.call(ctx, t.caller, &name, "name"); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Version returns the EIP-712 domain version, defaulting to "1" for tokens
+// that don't expose version()
+func (t *ERC20PermitToken) Version(ctx context.Context) string {
+	var version string
+	if err := t.call(ctx, t.caller, &version, "version"); err != nil || version == "" {
+		return "1"
+	}
+	return version
+}
+
+// Nonces returns the owner's current permit nonce
+func (t *ERC20PermitToken) Nonces(ctx context.Context, owner common.Address) (*big.Int, error) {
+	var nonce *big.Int
+	if err := t.call(ctx, t.client, &nonce, "nonces", owner); err != nil {
+		return nil, err
+	}
+	return nonce, nil
+}
+
+func (t *ERC20PermitToken) call(
+	ctx context.Context,
+	caller ethereum.ContractCaller,
+	out interface{},
+	method string,
+	args ...interface{},
+) error {
+	data, err := t.abi.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &t.tokenAddr,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", method, err)
+	}
+
+	values, err := t.abi.Unpack(method, result)
+	if err != nil {
+		return fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("empty %s result", method)
+	}
+
+	switch o := out.(type) {
+	case *string:
+		*o = values[0].(string)
+	case **big.Int:
+		*o = values[0].(*big.Int)
+	}
+	return nil
+}
+
+// ERC20PermitABI is the subset of the ERC-20 / ERC-2612 ABI the service uses
+const ERC20PermitABI = `[
+	{"inputs": [], "name": "name", "outputs": [{"name": "", "type": "string"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [], "name": "version", "outputs": [{"name": "", "type": "string"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "owner", "type": "address"}], "name": "nonces", "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view", "type": "function"}
+]`
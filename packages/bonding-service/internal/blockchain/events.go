@@ -0,0 +1,159 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrEventNotFound is returned when a receipt doesn't contain an expected event
+var ErrEventNotFound = errors.New("event not found in receipt")
+
+// bondABI is the parsed contract ABI used to decode event logs
+var bondABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(IPBondABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid IPBond ABI: %v", err))
+	}
+	return parsed
+}()
+
+// BondIssuedEvent is emitted when a bond is issued
+type BondIssuedEvent struct {
+	BondID     *big.Int
+	Issuer     common.Address
+	IPNFTID    *big.Int
+	TotalValue *big.Int
+	Raw        types.Log
+}
+
+// InvestmentEvent is emitted when an investor buys into a tranche
+type InvestmentEvent struct {
+	BondID    *big.Int
+	Investor  common.Address
+	TrancheID uint8
+	Amount    *big.Int
+	Raw       types.Log
+}
+
+// RevenueDistributedEvent is emitted when revenue is distributed to a bond's tranches
+type RevenueDistributedEvent struct {
+	BondID    *big.Int
+	Revenue   *big.Int
+	Timestamp *big.Int
+	Raw       types.Log
+}
+
+// ReceiptEvents are the bond contract events a transaction emitted
+type ReceiptEvents struct {
+	BondIssued         []BondIssuedEvent
+	Investments        []InvestmentEvent
+	RevenueDistributed []RevenueDistributedEvent
+}
+
+// ParseReceipt decodes the events a transaction emitted from the bond
+// contract. Logs from other contracts and other events are skipped.
+func ParseReceipt(receipt *types.Receipt, contractAddr common.Address) (*ReceiptEvents, error) {
+	return ParseLogs(receipt.Logs, contractAddr)
+}
+
+// EventID returns the topic identifying a bond contract event, e.g. to
+// filter logs for it
+func EventID(name string) common.Hash {
+	return bondABI.Events[name].ID
+}
+
+// ParseLogs decodes bond contract events from logs, e.g. the result of a log
+// query. Logs from other contracts and other events are skipped.
+func ParseLogs(logs []*types.Log, contractAddr common.Address) (*ReceiptEvents, error) {
+	events := &ReceiptEvents{}
+	for _, l := range logs {
+		if l.Address != contractAddr || len(l.Topics) == 0 {
+			continue
+		}
+		event, err := bondABI.EventByID(l.Topics[0])
+		if err != nil {
+			continue
+		}
+
+		values, err := unpackLog(event, *l)
+		if err != nil {
+			return nil, err
+		}
+		switch event.Name {
+		case "BondIssued":
+			events.BondIssued = append(events.BondIssued, BondIssuedEvent{
+				BondID:     bigValue(values, "bondId"),
+				Issuer:     addressValue(values, "issuer"),
+				IPNFTID:    bigValue(values, "ipnftID"),
+				TotalValue: bigValue(values, "totalValue"),
+				Raw:        *l,
+			})
+		case "Investment":
+			trancheID, _ := values["trancheId"].(uint8)
+			events.Investments = append(events.Investments, InvestmentEvent{
+				BondID:    bigValue(values, "bondId"),
+				Investor:  addressValue(values, "investor"),
+				TrancheID: trancheID,
+				Amount:    bigValue(values, "amount"),
+				Raw:       *l,
+			})
+		case "RevenueDistributed":
+			events.RevenueDistributed = append(events.RevenueDistributed, RevenueDistributedEvent{
+				BondID:    bigValue(values, "bondId"),
+				Revenue:   bigValue(values, "revenue"),
+				Timestamp: bigValue(values, "timestamp"),
+				Raw:       *l,
+			})
+		}
+	}
+	return events, nil
+}
+
+// BondIDFromReceipt returns the ID the contract assigned in an issuance
+// transaction's BondIssued event
+func BondIDFromReceipt(receipt *types.Receipt, contractAddr common.Address) (*big.Int, error) {
+	events, err := ParseReceipt(receipt, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	if len(events.BondIssued) == 0 {
+		return nil, fmt.Errorf("%w: BondIssued", ErrEventNotFound)
+	}
+	return events.BondIssued[0].BondID, nil
+}
+
+// unpackLog decodes a log's indexed topics and data by argument name
+func unpackLog(event *abi.Event, l types.Log) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to decode %s topics: %w", event.Name, err)
+	}
+	if err := event.Inputs.UnpackIntoMap(values, l.Data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s data: %w", event.Name, err)
+	}
+	return values, nil
+}
+
+func bigValue(values map[string]interface{}, name string) *big.Int {
+	if v, ok := values[name].(*big.Int); ok {
+		return v
+	}
+	return new(big.Int)
+}
+
+func addressValue(values map[string]interface{}, name string) common.Address {
+	address, _ := values[name].(common.Address)
+	return address
+}
@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// eventLog builds a log for a bond contract event from its indexed topics and data values
+func eventLog(t *testing.T, contract common.Address, name string, topics []common.Hash, data ...interface{}) *types.Log {
+	t.Helper()
+	event := bondABI.Events[name]
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatalf("failed to pack %s: %v", name, err)
+	}
+	return &types.Log{
+		Address: contract,
+		Topics:  append([]common.Hash{event.ID}, topics...),
+		Data:    packed,
+	}
+}
+
+func TestParseReceipt(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	issuer := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	bondTopic := common.BigToHash(big.NewInt(42))
+
+	receipt := &types.Receipt{Logs: []*types.Log{
+		// The same event from another contract is ignored
+		eventLog(t, other, "BondIssued", []common.Hash{common.BigToHash(big.NewInt(7)), common.BytesToHash(issuer.Bytes())},
+			big.NewInt(1), big.NewInt(1)),
+		eventLog(t, contract, "BondIssued", []common.Hash{bondTopic, common.BytesToHash(issuer.Bytes())},
+			big.NewInt(99), big.NewInt(1000)),
+		eventLog(t, contract, "Investment", []common.Hash{bondTopic, common.BytesToHash(issuer.Bytes())},
+			uint8(2), big.NewInt(250)),
+		eventLog(t, contract, "RevenueDistributed", []common.Hash{bondTopic},
+			big.NewInt(500), big.NewInt(1700000000)),
+		// Untracked events are skipped
+		eventLog(t, contract, "Redemption", []common.Hash{bondTopic, common.BytesToHash(issuer.Bytes())},
+			uint8(0), big.NewInt(1), big.NewInt(1)),
+	}}
+
+	events, err := ParseReceipt(receipt, contract)
+	if err != nil {
+		t.Fatalf("ParseReceipt() error = %v", err)
+	}
+	if len(events.BondIssued) != 1 || len(events.Investments) != 1 || len(events.RevenueDistributed) != 1 {
+		t.Fatalf("ParseReceipt() = %d issued, %d investments, %d distributions",
+			len(events.BondIssued), len(events.Investments), len(events.RevenueDistributed))
+	}
+
+	issued := events.BondIssued[0]
+	if issued.BondID.Int64() != 42 || issued.Issuer != issuer || issued.IPNFTID.Int64() != 99 || issued.TotalValue.Int64() != 1000 {
+		t.Errorf("BondIssued = %+v", issued)
+	}
+	investment := events.Investments[0]
+	if investment.BondID.Int64() != 42 || investment.Investor != issuer || investment.TrancheID != 2 || investment.Amount.Int64() != 250 {
+		t.Errorf("Investment = %+v", investment)
+	}
+	distributed := events.RevenueDistributed[0]
+	if distributed.Revenue.Int64() != 500 || distributed.Timestamp.Int64() != 1700000000 {
+		t.Errorf("RevenueDistributed = %+v", distributed)
+	}
+
+	bondID, err := BondIDFromReceipt(receipt, contract)
+	if err != nil || bondID.Int64() != 42 {
+		t.Errorf("BondIDFromReceipt() = %v, %v, want 42", bondID, err)
+	}
+	if _, err := BondIDFromReceipt(&types.Receipt{}, contract); !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("BondIDFromReceipt() of an empty receipt error = %v, want ErrEventNotFound", err)
+	}
+}
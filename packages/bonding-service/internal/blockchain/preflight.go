@@ -0,0 +1,159 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// simulationBalance is the balance given to a sender that can't cover the
+// call value, so the simulation reports contract reverts rather than
+// insufficient funds
+var simulationBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// accountOverride replaces an account's state for the duration of a call
+type accountOverride struct {
+	Balance *hexutil.Big `json:"balance,omitempty"`
+}
+
+// Preflight is the predicted outcome of sending a contract call
+type Preflight struct {
+	From         common.Address
+	GasLimit     uint64 // Zero when the call reverts
+	Reverted     bool
+	RevertReason string   // Decoded revert message, if the contract gave one
+	Balance      *big.Int // Sender's pending balance
+	// BalanceOverridden is set when Balance doesn't cover the value and the
+	// call was simulated with a state override raising it
+	BalanceOverridden bool
+}
+
+// PreflightIssueBond simulates an IssueBond call against the pending state
+func (c *IPBondContract) PreflightIssueBond(
+	ctx context.Context,
+	ipnftID *big.Int,
+	nftContract common.Address,
+	totalValue *big.Int,
+	seniorAllocation *big.Int,
+	mezzanineAllocation *big.Int,
+	juniorAllocation *big.Int,
+	maturityDate *big.Int,
+	valuationUSD *big.Int,
+	riskRating string,
+) (*Preflight, error) {
+	data, err := packIssueBond(c.abi, ipnftID, nftContract, totalValue, seniorAllocation,
+		mezzanineAllocation, juniorAllocation, maturityDate, valuationUSD, riskRating)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+	return c.preflight(ctx, nil, data)
+}
+
+// PreflightInvest simulates an Invest call against the pending state
+func (c *IPBondContract) PreflightInvest(ctx context.Context, bondID *big.Int, trancheID uint8, amount *big.Int) (*Preflight, error) {
+	data, err := c.abi.Pack("invest", bondID, trancheID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+	return c.preflight(ctx, amount, data)
+}
+
+// PreflightDistributeRevenue simulates a DistributeRevenue call against the pending state
+func (c *IPBondContract) PreflightDistributeRevenue(ctx context.Context, bondID, revenue *big.Int) (*Preflight, error) {
+	data, err := c.abi.Pack("distributeRevenue", bondID, revenue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack function call: %w", err)
+	}
+	return c.preflight(ctx, nil, data)
+}
+
+// preflight runs the call with eth_call, then estimates its gas if it
+// doesn't revert. A revert is reported in the result; only failures to reach
+// the node are returned as errors.
+func (c *IPBondContract) preflight(ctx context.Context, value *big.Int, data []byte) (*Preflight, error) {
+	p := &Preflight{}
+	p.From, _ = c.Signer()
+	msg := ethereum.CallMsg{From: p.From, To: &c.contractAddr, Value: value, Data: data}
+
+	balance, err := c.client.PendingBalanceAt(ctx, p.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sender balance: %w", err)
+	}
+	p.Balance = balance
+	var overrides map[common.Address]accountOverride
+	if value != nil && balance.Cmp(value) < 0 {
+		overrides = map[common.Address]accountOverride{p.From: {Balance: (*hexutil.Big)(simulationBalance)}}
+		p.BalanceOverridden = true
+	}
+
+	if overrides == nil {
+		_, err = c.client.PendingCallContract(ctx, msg)
+	} else {
+		var out hexutil.Bytes
+		err = c.client.Client().CallContext(ctx, &out, "eth_call", callArg(msg), "pending", overrides)
+	}
+	if err != nil {
+		reason, reverted := revertReason(err)
+		if !reverted {
+			return nil, fmt.Errorf("failed to simulate call: %w", err)
+		}
+		p.Reverted, p.RevertReason = true, reason
+		return p, nil
+	}
+
+	if overrides == nil {
+		p.GasLimit, err = c.client.EstimateGas(ctx, msg)
+	} else {
+		var gas hexutil.Uint64
+		err = c.client.Client().CallContext(ctx, &gas, "eth_estimateGas", callArg(msg), "pending", overrides)
+		p.GasLimit = uint64(gas)
+	}
+	if err != nil {
+		// State can change between the two calls
+		if reason, reverted := revertReason(err); reverted {
+			p.Reverted, p.RevertReason = true, reason
+			return p, nil
+		}
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	return p, nil
+}
+
+// revertReason reports whether err is an execution revert and decodes its
+// message if the contract gave one
+func revertReason(err error) (string, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if reason, err := abi.UnpackRevert(common.FromHex(data)); err == nil {
+				return reason, true
+			}
+		}
+	}
+	msg := err.Error()
+	if i := strings.Index(msg, "execution reverted"); i >= 0 {
+		reason := strings.TrimPrefix(msg[i:], "execution reverted")
+		return strings.TrimSpace(strings.TrimPrefix(reason, ":")), true
+	}
+	return "", false
+}
+
+func callArg(msg ethereum.CallMsg) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from":  msg.From,
+		"to":    msg.To,
+		"input": hexutil.Bytes(msg.Data),
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	return arg
+}
@@ -0,0 +1,94 @@
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// revertError is a node's execution reverted error carrying Error(string) data
+type revertError struct{ reason string }
+
+func (e *revertError) Error() string  { return "execution reverted: " + e.reason }
+func (e *revertError) ErrorCode() int { return 3 }
+func (e *revertError) ErrorData() interface{} {
+	stringType, _ := abi.NewType("string", "", nil)
+	data, _ := abi.Arguments{{Type: stringType}}.Pack(e.reason)
+	return hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], data...))
+}
+
+// simulationService answers the calls made by a preflight
+type simulationService struct {
+	balance   *big.Int
+	revert    string
+	overrides map[string]interface{}
+}
+
+func (s *simulationService) GetBalance(ctx context.Context, account common.Address, block string) (*hexutil.Big, error) {
+	return (*hexutil.Big)(s.balance), nil
+}
+
+func (s *simulationService) Call(ctx context.Context, args map[string]interface{}, block string, overrides *map[string]interface{}) (hexutil.Bytes, error) {
+	if overrides != nil {
+		s.overrides = *overrides
+	}
+	if s.revert != "" {
+		return nil, &revertError{s.revert}
+	}
+	return hexutil.Bytes{}, nil
+}
+
+func (s *simulationService) EstimateGas(ctx context.Context, args map[string]interface{}, block *string, overrides *map[string]interface{}) (hexutil.Uint64, error) {
+	return 120000, nil
+}
+
+func TestPreflightInvest(t *testing.T) {
+	amount := big.NewInt(1e18)
+	tests := []struct {
+		name           string
+		service        *simulationService
+		wantReverted   bool
+		wantReason     string
+		wantOverridden bool
+	}{
+		{"funded", &simulationService{balance: big.NewInt(2e18)}, false, "", false},
+		{"balance raised for the simulation", &simulationService{balance: big.NewInt(0)}, false, "", true},
+		{"reverts", &simulationService{balance: big.NewInt(2e18), revert: "Bond not active"}, true, "Bond not active", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := rpc.NewServer()
+			if err := server.RegisterName("eth", tt.service); err != nil {
+				t.Fatal(err)
+			}
+			defer server.Stop()
+			contract, err := NewIPBondContract(ethclient.NewClient(rpc.DialInProc(server)),
+				"0x00000000000000000000000000000000000000cc", "", 42161)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := contract.PreflightInvest(context.Background(), big.NewInt(7), 1, amount)
+			if err != nil {
+				t.Fatalf("PreflightInvest() error = %v", err)
+			}
+			if got.Reverted != tt.wantReverted || got.RevertReason != tt.wantReason {
+				t.Errorf("reverted = %v (%q), want %v (%q)", got.Reverted, got.RevertReason, tt.wantReverted, tt.wantReason)
+			}
+			if got.BalanceOverridden != tt.wantOverridden || (tt.service.overrides != nil) != tt.wantOverridden {
+				t.Errorf("balance overridden = %v, sent overrides %v, want %v", got.BalanceOverridden, tt.service.overrides, tt.wantOverridden)
+			}
+			if !tt.wantReverted && got.GasLimit != 120000 {
+				t.Errorf("GasLimit = %d, want 120000", got.GasLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MinReplacementBumpBps is the smallest fee increase nodes accept for a
+// transaction replacing another with the same nonce (geth's default 10%)
+const MinReplacementBumpBps = 1000
+
+// cancelGasLimit is the gas used by the zero-value self transfer that cancels a transaction
+const cancelGasLimit = 21000
+
+var (
+	// ErrNotPending is returned when the transaction is unknown or already mined
+	ErrNotPending = errors.New("transaction is not pending")
+	// ErrNotOperatorTransaction is returned for transactions not sent by the operator wallet
+	ErrNotOperatorTransaction = errors.New("transaction was not sent by the operator wallet")
+	// ErrReplacementFeeTooHigh is returned when the bumped fee exceeds the configured cap
+	ErrReplacementFeeTooHigh = errors.New("replacement fee exceeds the maximum gas price")
+)
+
+// ReplacementOptions controls the fee of a replacement transaction
+type ReplacementOptions struct {
+	BumpBps int64    // Fee increase over the original; raised to MinReplacementBumpBps
+	MaxFee  *big.Int // Gas price or fee cap the replacement may not exceed; nil for none
+}
+
+// SpeedUpTransaction resubmits a pending operator transaction with the same
+// nonce and call but a higher fee
+func (c *IPBondContract) SpeedUpTransaction(ctx context.Context, txHash common.Hash, opts ReplacementOptions) (*types.Transaction, error) {
+	return c.replaceTransaction(ctx, txHash, false, opts)
+}
+
+// CancelTransaction replaces a pending operator transaction with a zero-value
+// transfer to the operator wallet at a higher fee, so the original call never runs
+func (c *IPBondContract) CancelTransaction(ctx context.Context, txHash common.Hash, opts ReplacementOptions) (*types.Transaction, error) {
+	return c.replaceTransaction(ctx, txHash, true, opts)
+}
+
+func (c *IPBondContract) replaceTransaction(ctx context.Context, txHash common.Hash, cancel bool, opts ReplacementOptions) (*types.Transaction, error) {
+	original, pending, err := c.client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !pending {
+		return nil, ErrNotPending
+	}
+
+	signer := types.LatestSignerForChainID(c.chainID)
+	privateKey := c.getPrivateKey()
+	if privateKey == nil {
+		return nil, fmt.Errorf("invalid private key")
+	}
+	sender, err := types.Sender(signer, original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover sender: %w", err)
+	}
+	operator := crypto.PubkeyToAddress(privateKey.PublicKey)
+	if sender != operator {
+		return nil, ErrNotOperatorTransaction
+	}
+
+	// The nonce may have been used since the node reported the transaction pending
+	confirmed, err := c.client.NonceAt(ctx, operator, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	if confirmed > original.Nonce() {
+		return nil, ErrNotPending
+	}
+
+	suggested, err := c.client.SuggestGasPrice(ctx)
+	if err != nil {
+		suggested = nil
+	}
+	replacement, err := replacementTx(original, operator, cancel, opts, suggested)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := types.SignTx(replacement, signer, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if err := c.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send replacement transaction: %w", err)
+	}
+	return signedTx, nil
+}
+
+// replacementTx builds an unsigned transaction with the original's nonce and
+// type and fees bumped by at least the node's replacement threshold, never
+// priced below the current suggestion
+func replacementTx(original *types.Transaction, operator common.Address, cancel bool, opts ReplacementOptions, suggested *big.Int) (*types.Transaction, error) {
+	bps := max(opts.BumpBps, MinReplacementBumpBps)
+
+	to, value, data, gas := original.To(), original.Value(), original.Data(), original.Gas()
+	if cancel {
+		to, value, data, gas = &operator, new(big.Int), nil, cancelGasLimit
+	}
+
+	if original.Type() == types.LegacyTxType {
+		price := bumpFee(original.GasPrice(), bps)
+		if suggested != nil && suggested.Cmp(price) > 0 {
+			price = new(big.Int).Set(suggested)
+		}
+		if opts.MaxFee != nil && price.Cmp(opts.MaxFee) > 0 {
+			return nil, ErrReplacementFeeTooHigh
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    original.Nonce(),
+			GasPrice: price,
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}), nil
+	}
+
+	tip := bumpFee(original.GasTipCap(), bps)
+	feeCap := bumpFee(original.GasFeeCap(), bps)
+	if suggested != nil && suggested.Cmp(feeCap) > 0 {
+		feeCap = new(big.Int).Set(suggested)
+	}
+	if opts.MaxFee != nil && feeCap.Cmp(opts.MaxFee) > 0 {
+		return nil, ErrReplacementFeeTooHigh
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   original.ChainId(),
+		Nonce:     original.Nonce(),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	}), nil
+}
+
+// bumpFee raises a fee by bps basis points, rounding up so the increase is
+// never below the threshold
+func bumpFee(fee *big.Int, bps int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(10000+bps))
+	bumped.Add(bumped, big.NewInt(9999))
+	return bumped.Div(bumped, big.NewInt(10000))
+}
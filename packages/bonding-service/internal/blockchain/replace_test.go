@@ -0,0 +1,85 @@
+package blockchain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestBumpFee(t *testing.T) {
+	tests := []struct {
+		fee  int64
+		bps  int64
+		want int64
+	}{
+		{1000000000, 1000, 1100000000},
+		{1000000000, 2500, 1250000000},
+		{15, 1000, 17}, // Rounds up so the bump is never under 10%
+		{0, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		if got := bumpFee(big.NewInt(tt.fee), tt.bps); got.Int64() != tt.want {
+			t.Errorf("bumpFee(%d, %d) = %s, want %d", tt.fee, tt.bps, got, tt.want)
+		}
+	}
+}
+
+func TestReplacementTx(t *testing.T) {
+	operator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	legacy := types.NewTx(&types.LegacyTx{
+		Nonce: 7, GasPrice: big.NewInt(1000), Gas: 200000, To: &contract, Value: big.NewInt(5), Data: []byte{1, 2},
+	})
+	dynamic := types.NewTx(&types.DynamicFeeTx{
+		ChainID: big.NewInt(42161), Nonce: 9, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(2000),
+		Gas: 200000, To: &contract, Data: []byte{1, 2},
+	})
+
+	t.Run("speed up legacy", func(t *testing.T) {
+		tx, err := replacementTx(legacy, operator, false, ReplacementOptions{BumpBps: 500}, big.NewInt(900))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Bump is raised to the 10% minimum
+		if tx.Nonce() != 7 || tx.GasPrice().Int64() != 1100 || *tx.To() != contract || tx.Value().Int64() != 5 || len(tx.Data()) != 2 {
+			t.Errorf("replacement = nonce %d price %s to %s value %s", tx.Nonce(), tx.GasPrice(), tx.To(), tx.Value())
+		}
+	})
+
+	t.Run("speed up follows a higher suggestion", func(t *testing.T) {
+		tx, err := replacementTx(legacy, operator, false, ReplacementOptions{}, big.NewInt(5000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.GasPrice().Int64() != 5000 {
+			t.Errorf("gas price = %s, want 5000", tx.GasPrice())
+		}
+	})
+
+	t.Run("cancel dynamic", func(t *testing.T) {
+		tx, err := replacementTx(dynamic, operator, true, ReplacementOptions{BumpBps: 2000}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.Type() != types.DynamicFeeTxType || tx.Nonce() != 9 {
+			t.Fatalf("replacement type %d nonce %d", tx.Type(), tx.Nonce())
+		}
+		if *tx.To() != operator || tx.Value().Sign() != 0 || len(tx.Data()) != 0 || tx.Gas() != cancelGasLimit {
+			t.Errorf("cancel is not a zero-value self transfer: to %s value %s gas %d", tx.To(), tx.Value(), tx.Gas())
+		}
+		if tx.GasTipCap().Int64() != 120 || tx.GasFeeCap().Int64() != 2400 {
+			t.Errorf("fees = tip %s cap %s, want 120 and 2400", tx.GasTipCap(), tx.GasFeeCap())
+		}
+	})
+
+	t.Run("above max fee", func(t *testing.T) {
+		_, err := replacementTx(dynamic, operator, false, ReplacementOptions{MaxFee: big.NewInt(2100)}, nil)
+		if !errors.Is(err, ErrReplacementFeeTooHigh) {
+			t.Errorf("error = %v, want ErrReplacementFeeTooHigh", err)
+		}
+	})
+}
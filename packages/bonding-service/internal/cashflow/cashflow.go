@@ -0,0 +1,166 @@
+// Package cashflow generates the payments a bond's tranches are expected to
+// make: a coupon on each coupon date and, with the last coupon at maturity,
+// the principal. The schedule is generated at issuance on the tranches' full
+// allocations and stored, so distributions can be compared with it.
+package cashflow
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+)
+
+// Statuses of a scheduled payment
+const (
+	StatusUpcoming = "UPCOMING" // Not yet due
+	StatusPaid     = "PAID"     // Paid in full by its due date's distributions
+	StatusPartial  = "PARTIAL"
+	StatusMissed   = "MISSED" // Due with nothing paid
+)
+
+// Interval spaces coupons for bonds without a revenue forecast
+const Interval = 3 // months
+
+// Coupon is an expected coupon payment
+type Coupon struct {
+	Date   time.Time
+	Amount *big.Int
+}
+
+// Coupons returns the coupons expected on principal from start to maturity.
+// Coupons fall on the given dates, normally the ends of the bond's forecast
+// periods, or every Interval months without them; the last one is paid at
+// maturity. Each accrues under dayCount.
+func Coupons(principal *big.Int, apyBps int64, dayCount waterfall.DayCount, start, maturity time.Time, dates []time.Time) []Coupon {
+	if !maturity.After(start) {
+		return nil
+	}
+
+	var due []time.Time
+	for _, d := range dates {
+		if d.After(start) && d.Before(maturity) {
+			due = append(due, d)
+		}
+	}
+	if len(dates) == 0 {
+		for d := start.AddDate(0, Interval, 0); d.Before(maturity); d = d.AddDate(0, Interval, 0) {
+			due = append(due, d)
+		}
+	}
+	due = append(due, maturity)
+
+	coupons := make([]Coupon, len(due))
+	previous := start
+	for i, d := range due {
+		coupons[i] = Coupon{Date: d, Amount: dayCount.Coupon(principal, apyBps, previous, d)}
+		previous = d
+	}
+	return coupons
+}
+
+// Generate returns the payments each of a bond's tranches is expected to make
+// from start on its full allocation, by tranche and then date. dates are the
+// ends of the bond's forecast periods, if it has any.
+func Generate(bond *models.Bond, start time.Time, dates []time.Time) []models.ScheduledPayment {
+	var payments []models.ScheduledPayment
+	for i := range bond.Tranches {
+		t := &bond.Tranches[i]
+		principal, ok := new(big.Int).SetString(t.Allocation, 10)
+		if !ok {
+			principal = new(big.Int)
+		}
+		dayCount, ok := waterfall.ParseDayCount(bond.TrancheDayCount(t))
+		if !ok {
+			dayCount = waterfall.DefaultDayCount
+		}
+
+		coupons := Coupons(principal, int64(math.Round(t.APY*100)), dayCount, start, bond.MaturityDate, dates)
+		for n, c := range coupons {
+			payment := models.ScheduledPayment{
+				BondID:    bond.BondID,
+				TrancheID: t.TrancheID,
+				Sequence:  n + 1,
+				DueDate:   c.Date,
+				Coupon:    c.Amount.String(),
+				Principal: "0",
+			}
+			if n == len(coupons)-1 {
+				payment.Principal = principal.String()
+			}
+			payments = append(payments, payment)
+		}
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].TrancheID < payments[j].TrancheID
+	})
+	return payments
+}
+
+// Paid returns what the distributions paid each tranche towards each
+// scheduled payment, in the payments' order: the arrears and coupons paid
+// after the tranche's previous due date and up to the payment's. A
+// distribution naming a period is matched by it, others by when they were
+// made; those after a tranche's last due date count towards its last payment.
+func Paid(payments []models.ScheduledPayment, distributions []models.RevenueDistribution) []*big.Int {
+	paid := make([]*big.Int, len(payments))
+	byTranche := make(map[int][]int) // Indexes into payments, by due date
+	for i := range payments {
+		paid[i] = new(big.Int)
+		byTranche[payments[i].TrancheID] = append(byTranche[payments[i].TrancheID], i)
+	}
+	for _, indexes := range byTranche {
+		sort.SliceStable(indexes, func(a, b int) bool {
+			return payments[indexes[a]].DueDate.Before(payments[indexes[b]].DueDate)
+		})
+	}
+
+	for _, d := range distributions {
+		at := d.Timestamp
+		if d.Period != nil {
+			at = *d.Period
+		}
+		for _, split := range d.Tranches {
+			indexes := byTranche[split.TrancheID]
+			if len(indexes) == 0 {
+				continue
+			}
+			n := sort.Search(len(indexes), func(k int) bool {
+				return !payments[indexes[k]].DueDate.Before(at)
+			})
+			if n == len(indexes) {
+				n--
+			}
+			target := paid[indexes[n]]
+			target.Add(target, parseAmount(split.ArrearsPaid))
+			target.Add(target, parseAmount(split.CouponPaid))
+		}
+	}
+	return paid
+}
+
+// Status compares what was paid towards a payment with what was expected
+func Status(payment *models.ScheduledPayment, expected, paid *big.Int, now time.Time) string {
+	switch {
+	case paid.Cmp(expected) >= 0 && paid.Sign() > 0:
+		return StatusPaid
+	case payment.DueDate.After(now):
+		return StatusUpcoming
+	case paid.Sign() > 0:
+		return StatusPartial
+	case expected.Sign() == 0:
+		return StatusPaid
+	}
+	return StatusMissed
+}
+
+func parseAmount(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
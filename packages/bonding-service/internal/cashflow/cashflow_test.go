@@ -0,0 +1,149 @@
+package cashflow
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+)
+
+func TestCoupons(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	maturity := start.AddDate(1, 0, 0)
+	principal := big.NewInt(1_000_000)
+
+	tests := []struct {
+		name      string
+		maturity  time.Time
+		dates     []time.Time
+		wantDates []time.Time
+		wantTotal int64
+	}{
+		{
+			name:      "quarterly without a forecast",
+			maturity:  maturity,
+			wantDates: []time.Time{start.AddDate(0, 3, 0), start.AddDate(0, 6, 0), start.AddDate(0, 9, 0), maturity},
+			wantTotal: 80_000,
+		},
+		{
+			name:     "forecast periods",
+			maturity: maturity,
+			// Periods already over or ending after maturity are ignored
+			dates:     []time.Time{start.AddDate(0, -1, 0), start.AddDate(0, 6, 0), maturity.AddDate(0, 6, 0)},
+			wantDates: []time.Time{start.AddDate(0, 6, 0), maturity},
+			wantTotal: 80_000,
+		},
+		{
+			name:      "matured",
+			maturity:  start,
+			wantDates: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coupons := Coupons(principal, 800, waterfall.Actual365, start, tt.maturity, tt.dates)
+			if len(coupons) != len(tt.wantDates) {
+				t.Fatalf("got %d coupons, want %d", len(coupons), len(tt.wantDates))
+			}
+			total := big.NewInt(0)
+			for i, c := range coupons {
+				if !c.Date.Equal(tt.wantDates[i]) {
+					t.Errorf("coupon %d date = %s, want %s", i, c.Date, tt.wantDates[i])
+				}
+				total.Add(total, c.Amount)
+			}
+			// Accrual is per second on a 365-day year, so rounding may shave a unit per coupon
+			if diff := tt.wantTotal - total.Int64(); diff < 0 || diff > int64(len(coupons)) {
+				t.Errorf("total coupons = %s, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bond := &models.Bond{
+		BondID:       "7",
+		MaturityDate: start.AddDate(1, 0, 0),
+		DayCount:     "30/360",
+		Tranches: []models.Tranche{
+			{TrancheID: 1, Allocation: "2000000", APY: 12},
+			{TrancheID: 0, Allocation: "1000000", APY: 6},
+		},
+	}
+
+	payments := Generate(bond, start, []time.Time{start.AddDate(0, 6, 0)})
+	if len(payments) != 4 {
+		t.Fatalf("got %d payments, want 2 per tranche", len(payments))
+	}
+	want := []models.ScheduledPayment{
+		{BondID: "7", TrancheID: 0, Sequence: 1, DueDate: start.AddDate(0, 6, 0), Coupon: "30000", Principal: "0"},
+		{BondID: "7", TrancheID: 0, Sequence: 2, DueDate: start.AddDate(1, 0, 0), Coupon: "30000", Principal: "1000000"},
+		{BondID: "7", TrancheID: 1, Sequence: 1, DueDate: start.AddDate(0, 6, 0), Coupon: "120000", Principal: "0"},
+		{BondID: "7", TrancheID: 1, Sequence: 2, DueDate: start.AddDate(1, 0, 0), Coupon: "120000", Principal: "2000000"},
+	}
+	for i := range want {
+		if payments[i] != want[i] {
+			t.Errorf("payment %d = %+v, want %+v", i, payments[i], want[i])
+		}
+	}
+}
+
+func TestPaid(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	payments := []models.ScheduledPayment{
+		{TrancheID: 0, Sequence: 1, DueDate: start.AddDate(0, 3, 0)},
+		{TrancheID: 0, Sequence: 2, DueDate: start.AddDate(0, 6, 0)},
+	}
+	period := start.AddDate(0, 3, 0)
+	distributions := []models.RevenueDistribution{
+		// Made after the first due date but naming it
+		{Timestamp: start.AddDate(0, 3, 5), Period: &period, Tranches: []models.TrancheDistribution{
+			{TrancheID: 0, ArrearsPaid: "0", CouponPaid: "100", Residual: "50"},
+			{TrancheID: 2, CouponPaid: "7"},
+		}},
+		{Timestamp: start.AddDate(0, 4, 0), Tranches: []models.TrancheDistribution{
+			{TrancheID: 0, ArrearsPaid: "10", CouponPaid: "20"},
+		}},
+		// Late, so towards the last payment
+		{Timestamp: start.AddDate(0, 8, 0), Tranches: []models.TrancheDistribution{
+			{TrancheID: 0, CouponPaid: "5"},
+		}},
+	}
+
+	paid := Paid(payments, distributions)
+	if paid[0].String() != "100" || paid[1].String() != "35" {
+		t.Errorf("paid = %v, want [100 35]", paid)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := &models.ScheduledPayment{DueDate: now.AddDate(0, -1, 0)}
+	future := &models.ScheduledPayment{DueDate: now.AddDate(0, 1, 0)}
+
+	tests := []struct {
+		name     string
+		payment  *models.ScheduledPayment
+		expected int64
+		paid     int64
+		want     string
+	}{
+		{"paid early", future, 100, 100, StatusPaid},
+		{"not yet due", future, 100, 40, StatusUpcoming},
+		{"paid", past, 100, 120, StatusPaid},
+		{"partly paid", past, 100, 40, StatusPartial},
+		{"missed", past, 100, 0, StatusMissed},
+		{"nothing invested", past, 0, 0, StatusPaid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Status(tt.payment, big.NewInt(tt.expected), big.NewInt(tt.paid), now); got != tt.want {
+				t.Errorf("Status() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
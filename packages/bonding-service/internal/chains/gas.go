@@ -0,0 +1,133 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Gas pricing modes
+const (
+	GasLegacy  = "legacy"
+	GasEIP1559 = "eip1559"
+)
+
+// GasStrategy controls how transactions on a chain are priced
+type GasStrategy struct {
+	Mode          string `json:"mode"`           // legacy or eip1559
+	MultiplierBps int64  `json:"multiplier_bps"` // Applied to the node's suggestion, 10000 = 1x
+	MaxGasPrice   string `json:"max_gas_price"`  // Cap in wei, empty for none
+	FallbackPrice string `json:"fallback_price"` // Used when the node cannot suggest a price
+}
+
+// GasPricer is the subset of a chain client needed to price transactions
+type GasPricer interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// DefaultGasStrategy uses the node's legacy gas price suggestion with a 1 gwei fallback
+func DefaultGasStrategy() GasStrategy {
+	return GasStrategy{
+		Mode:          GasLegacy,
+		MultiplierBps: 10000,
+		FallbackPrice: "1000000000",
+	}
+}
+
+// Validate checks the strategy fields
+func (g GasStrategy) Validate() error {
+	if g.Mode != GasLegacy && g.Mode != GasEIP1559 {
+		return fmt.Errorf("unknown gas mode: %s", g.Mode)
+	}
+	if g.MultiplierBps < 0 {
+		return fmt.Errorf("gas multiplier_bps must not be negative")
+	}
+	for _, v := range []string{g.MaxGasPrice, g.FallbackPrice} {
+		if v == "" {
+			continue
+		}
+		if n, ok := new(big.Int).SetString(v, 10); !ok || n.Sign() < 0 {
+			return fmt.Errorf("invalid gas price: %s", v)
+		}
+	}
+	return nil
+}
+
+// merge fills unset fields of override from g
+func (g GasStrategy) merge(override GasStrategy) GasStrategy {
+	if override.Mode != "" {
+		g.Mode = override.Mode
+	}
+	if override.MultiplierBps != 0 {
+		g.MultiplierBps = override.MultiplierBps
+	}
+	if override.MaxGasPrice != "" {
+		g.MaxGasPrice = override.MaxGasPrice
+	}
+	if override.FallbackPrice != "" {
+		g.FallbackPrice = override.FallbackPrice
+	}
+	return g
+}
+
+// Apply prices a transaction according to the strategy
+func (g GasStrategy) Apply(ctx context.Context, auth *bind.TransactOpts, client GasPricer) {
+	price, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		price = parseWei(g.FallbackPrice)
+	}
+	price = g.cap(g.scale(price))
+
+	if g.Mode != GasEIP1559 {
+		auth.GasPrice = price
+		return
+	}
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		tip = big.NewInt(0)
+	}
+	tip = g.cap(tip)
+
+	// The node's legacy suggestion already includes base fee plus tip; allow the
+	// base fee to double before the transaction stops being includable
+	feeCap := new(big.Int).Mul(price, big.NewInt(2))
+	feeCap = g.cap(feeCap)
+	if feeCap.Cmp(tip) < 0 {
+		feeCap = new(big.Int).Set(tip)
+	}
+
+	auth.GasPrice = nil
+	auth.GasTipCap = tip
+	auth.GasFeeCap = feeCap
+}
+
+func (g GasStrategy) scale(price *big.Int) *big.Int {
+	if g.MultiplierBps == 0 || g.MultiplierBps == 10000 {
+		return price
+	}
+	scaled := new(big.Int).Mul(price, big.NewInt(g.MultiplierBps))
+	return scaled.Div(scaled, big.NewInt(10000))
+}
+
+func (g GasStrategy) cap(price *big.Int) *big.Int {
+	if g.MaxGasPrice == "" {
+		return price
+	}
+	max := parseWei(g.MaxGasPrice)
+	if price.Cmp(max) > 0 {
+		return max
+	}
+	return price
+}
+
+func parseWei(value string) *big.Int {
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return n
+}
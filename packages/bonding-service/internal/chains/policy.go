@@ -0,0 +1,106 @@
+package chains
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNotAllowed is returned by Policy.Check for a write the policy forbids
+var ErrNotAllowed = errors.New("chain not allowed")
+
+// Allowance is a set of chain IDs and the contracts on them that may be
+// written to
+type Allowance struct {
+	ChainIDs  []int64  `json:"chain_ids"`
+	Contracts []string `json:"contracts"` // Empty allows any contract on the chains
+}
+
+func (a Allowance) validate(scope string) error {
+	if len(a.ChainIDs) == 0 {
+		return fmt.Errorf("%s: at least one chain_id is required", scope)
+	}
+	for _, contract := range a.Contracts {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("%s: invalid contract address %q", scope, contract)
+		}
+	}
+	return nil
+}
+
+// allows returns a description of what a write breaks, empty if none
+func (a Allowance) allows(chainID int64, contract common.Address) string {
+	if !slices.Contains(a.ChainIDs, chainID) {
+		return fmt.Sprintf("chain ID %d is not in the allowed chain IDs %v", chainID, a.ChainIDs)
+	}
+	if len(a.Contracts) == 0 {
+		return ""
+	}
+	for _, allowed := range a.Contracts {
+		if common.HexToAddress(allowed) == contract {
+			return ""
+		}
+	}
+	return fmt.Sprintf("contract %s is not an allowed contract", contract.Hex())
+}
+
+// Policy binds an environment, and optionally each tenant within it, to the
+// chain IDs and contracts it may send transactions to, so an instance
+// configured for one network can't write to another, e.g. staging pointed
+// at mainnet
+type Policy struct {
+	Environment string               `json:"environment"`
+	Allowance                        // Applies to every tenant
+	Tenants     map[string]Allowance `json:"tenants"` // Narrows the environment's allowance per tenant
+}
+
+// ParsePolicy reads a JSON policy of the form
+// {"environment": "staging", "chain_ids": [421614], "contracts": ["0x..."],
+// "tenants": {"acme": {"chain_ids": [421614]}}}
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse chain policy: %w", err)
+	}
+	if strings.TrimSpace(policy.Environment) == "" {
+		return nil, fmt.Errorf("chain policy: environment is required")
+	}
+	if err := policy.validate("chain policy"); err != nil {
+		return nil, err
+	}
+	for tenantID, allowance := range policy.Tenants {
+		if err := allowance.validate("chain policy for tenant " + tenantID); err != nil {
+			return nil, err
+		}
+	}
+	return &policy, nil
+}
+
+// LoadPolicyFile reads a JSON policy from path
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain policy: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// Check returns an error wrapping ErrNotAllowed unless the environment,
+// and the tenant's allowance if it has one, allow writing to contract on the
+// chain with chainID
+func (p *Policy) Check(tenantID string, chainID int64, contract common.Address) error {
+	if problem := p.allows(chainID, contract); problem != "" {
+		return fmt.Errorf("%w: %s for environment %s", ErrNotAllowed, problem, p.Environment)
+	}
+	if allowance, ok := p.Tenants[tenantID]; ok {
+		if problem := allowance.allows(chainID, contract); problem != "" {
+			return fmt.Errorf("%w: %s for tenant %s", ErrNotAllowed, problem, tenantID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package chains
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParsePolicyInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{"not JSON", `chain_ids: [1]`},
+		{"no environment", `{"chain_ids": [421614]}`},
+		{"no chains", `{"environment": "staging"}`},
+		{"bad contract", `{"environment": "staging", "chain_ids": [421614], "contracts": ["ipbond"]}`},
+		{"tenant without chains", `{"environment": "staging", "chain_ids": [421614], "tenants": {"acme": {}}}`},
+	}
+	for _, tt := range tests {
+		if _, err := ParsePolicy([]byte(tt.policy)); err == nil {
+			t.Errorf("%s: ParsePolicy() succeeded, want an error", tt.name)
+		}
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{
+		"environment": "staging",
+		"chain_ids": [421614, 84532],
+		"contracts": ["0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"],
+		"tenants": {"acme": {"chain_ids": [84532], "contracts": ["0x2222222222222222222222222222222222222222"]}}
+	}`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	first := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	second := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	other := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	tests := []struct {
+		name     string
+		tenantID string
+		chainID  int64
+		contract common.Address
+		allowed  bool
+	}{
+		{"allowed chain and contract", "default", 421614, first, true},
+		{"mainnet", "default", 42161, first, false},
+		{"unknown contract", "default", 421614, other, false},
+		{"tenant narrows chains", "acme", 421614, second, false},
+		{"tenant narrows contracts", "acme", 84532, first, false},
+		{"tenant allowance", "acme", 84532, second, true},
+	}
+	for _, tt := range tests {
+		err := policy.Check(tt.tenantID, tt.chainID, tt.contract)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: Check() error = %v", tt.name, err)
+		}
+		if !tt.allowed && !errors.Is(err, ErrNotAllowed) {
+			t.Errorf("%s: Check() error = %v, want ErrNotAllowed", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package chains
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Built-in chain names
+const (
+	Arbitrum        = "arbitrum"
+	ArbitrumSepolia = "arbitrum-sepolia"
+)
+
+// Contracts holds the platform contract addresses deployed on a chain
+type Contracts struct {
+	IPBond             string `json:"ipbond"`
+	CopyrightRegistry  string `json:"copyright_registry"`
+	PriceFeed          string `json:"price_feed"`          // Chainlink native token/USD aggregator, optional
+	RoyaltyDistributor string `json:"royalty_distributor"` // Royalty payments become bond revenue, optional
+}
+
+// Chain is the configuration of a single chain
+type Chain struct {
+	Name          string      `json:"name"`
+	ChainID       int64       `json:"chain_id"`
+	RPCURLs       []string    `json:"rpc_urls"`
+	WSURLs        []string    `json:"ws_urls"` // Optional ws:// or wss:// endpoints for eth_subscribe
+	Contracts     Contracts   `json:"contracts"`
+	Confirmations uint64      `json:"confirmations"` // Blocks before a transaction is treated as final
+	Gas           GasStrategy `json:"gas"`
+}
+
+// Validate checks that a chain is usable
+func (c *Chain) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("chain name is required")
+	}
+	if c.ChainID <= 0 {
+		return fmt.Errorf("chain %s: chain_id is required", c.Name)
+	}
+	if len(c.RPCURLs) == 0 {
+		return fmt.Errorf("chain %s: at least one rpc url is required", c.Name)
+	}
+	for _, url := range c.WSURLs {
+		if !strings.HasPrefix(url, "ws://") && !strings.HasPrefix(url, "wss://") {
+			return fmt.Errorf("chain %s: ws url %s must use ws:// or wss://", c.Name, url)
+		}
+	}
+	return c.Gas.Validate()
+}
+
+// Registry maps chain names to their configuration
+type Registry struct {
+	mu           sync.RWMutex
+	chains       map[string]*Chain
+	defaultChain string
+}
+
+// NewRegistry creates an empty registry with the given default chain name
+func NewRegistry(defaultChain string) *Registry {
+	return &Registry{
+		chains:       make(map[string]*Chain),
+		defaultChain: defaultChain,
+	}
+}
+
+// Builtin returns a registry with the public Arbitrum networks, defaulting to Arbitrum One
+func Builtin() *Registry {
+	r := NewRegistry(Arbitrum)
+	r.chains[Arbitrum] = &Chain{
+		Name:          Arbitrum,
+		ChainID:       42161,
+		RPCURLs:       []string{"https://arb1.arbitrum.io/rpc"},
+		Confirmations: 20,
+		Gas:           DefaultGasStrategy(),
+	}
+	r.chains[ArbitrumSepolia] = &Chain{
+		Name:          ArbitrumSepolia,
+		ChainID:       421614,
+		RPCURLs:       []string{"https://sepolia-rollup.arbitrum.io/rpc"},
+		Confirmations: 5,
+		Gas:           DefaultGasStrategy(),
+	}
+	return r
+}
+
+// LoadFile reads a JSON registry of the form
+// {"default": "arbitrum", "chains": [{"name": "arbitrum", "chain_id": 42161, ...}]}.
+// Chains in the file replace built-in chains of the same name.
+func LoadFile(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain registry: %w", err)
+	}
+
+	var file struct {
+		Default string  `json:"default"`
+		Chains  []Chain `json:"chains"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse chain registry: %w", err)
+	}
+
+	r := Builtin()
+	for i := range file.Chains {
+		if err := r.Register(&file.Chains[i]); err != nil {
+			return nil, err
+		}
+	}
+	if file.Default != "" {
+		if err := r.SetDefault(file.Default); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds or replaces a chain
+func (r *Registry) Register(chain *Chain) error {
+	chain.Gas = DefaultGasStrategy().merge(chain.Gas)
+	if err := chain.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chains[chain.Name] = chain
+	return nil
+}
+
+// SetDefault sets the chain used when a request does not name one
+func (r *Registry) SetDefault(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.chains[name]; !ok {
+		return fmt.Errorf("unknown default chain: %s", name)
+	}
+	r.defaultChain = name
+	return nil
+}
+
+// Get returns a chain by name; an empty name returns the default chain
+func (r *Registry) Get(name string) (*Chain, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if name == "" {
+		name = r.defaultChain
+	}
+	chain, ok := r.chains[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain: %s", name)
+	}
+	return chain, nil
+}
+
+// Default returns the default chain
+func (r *Registry) Default() *Chain {
+	chain, _ := r.Get("")
+	return chain
+}
+
+// Names returns the registered chain names in sorted order
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.chains))
+	for name := range r.chains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Subset returns a registry containing only the named chains. The default
+// chain is kept if included, otherwise the first name becomes the default.
+func (r *Registry) Subset(names ...string) (*Registry, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one chain is required")
+	}
+
+	subset := NewRegistry(names[0])
+	for _, name := range names {
+		chain, err := r.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		subset.chains[name] = chain
+		if name == r.defaultChain {
+			subset.defaultChain = name
+		}
+	}
+	return subset, nil
+}
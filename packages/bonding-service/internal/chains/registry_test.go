@@ -0,0 +1,115 @@
+package chains
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	config := `{
+		"default": "base",
+		"chains": [
+			{"name": "base", "chain_id": 8453, "rpc_urls": ["https://mainnet.base.org"],
+			 "contracts": {"ipbond": "0x1111111111111111111111111111111111111111"},
+			 "confirmations": 10, "gas": {"mode": "eip1559", "max_gas_price": "5000000000"}}
+		]
+	}`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+
+	if got := r.Default().Name; got != "base" {
+		t.Errorf("Default() = %s, want base", got)
+	}
+	base, _ := r.Get("base")
+	if base.Gas.Mode != GasEIP1559 || base.Gas.FallbackPrice != "1000000000" {
+		t.Errorf("gas strategy not merged with defaults: %+v", base.Gas)
+	}
+	if sepolia, err := r.Get(ArbitrumSepolia); err != nil || sepolia.ChainID != 421614 {
+		t.Errorf("Get(%s) = %v, %v; want built-in chain", ArbitrumSepolia, sepolia, err)
+	}
+	if _, err := r.Get("unknown"); err == nil {
+		t.Error("Get(unknown) should fail")
+	}
+}
+
+func TestRegisterValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain Chain
+	}{
+		{"missing chain id", Chain{Name: "x", RPCURLs: []string{"http://x"}}},
+		{"missing rpc", Chain{Name: "x", ChainID: 1}},
+		{"bad gas mode", Chain{Name: "x", ChainID: 1, RPCURLs: []string{"http://x"}, Gas: GasStrategy{Mode: "turbo"}}},
+		{"http ws url", Chain{Name: "x", ChainID: 1, RPCURLs: []string{"http://x"}, WSURLs: []string{"https://x"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewRegistry("x").Register(&tt.chain); err == nil {
+				t.Error("Register() should fail")
+			}
+		})
+	}
+}
+
+type fakePricer struct {
+	price *big.Int
+	tip   *big.Int
+	err   error
+}
+
+func (f fakePricer) SuggestGasPrice(ctx context.Context) (*big.Int, error)  { return f.price, f.err }
+func (f fakePricer) SuggestGasTipCap(ctx context.Context) (*big.Int, error) { return f.tip, f.err }
+
+func TestGasStrategyApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy GasStrategy
+		pricer   fakePricer
+		wantGas  int64
+		wantFee  int64
+		wantTip  int64
+	}{
+		{"legacy", DefaultGasStrategy(), fakePricer{price: big.NewInt(100)}, 100, 0, 0},
+		{"legacy fallback", DefaultGasStrategy(), fakePricer{err: errors.New("down")}, 1000000000, 0, 0},
+		{"multiplier and cap", GasStrategy{Mode: GasLegacy, MultiplierBps: 15000, MaxGasPrice: "120"}, fakePricer{price: big.NewInt(100)}, 120, 0, 0},
+		{"eip1559", GasStrategy{Mode: GasEIP1559, MultiplierBps: 10000}, fakePricer{price: big.NewInt(100), tip: big.NewInt(2)}, 0, 200, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			tt.strategy.Apply(context.Background(), auth, tt.pricer)
+
+			if got := int64OrZero(auth.GasPrice); got != tt.wantGas {
+				t.Errorf("GasPrice = %d, want %d", got, tt.wantGas)
+			}
+			if got := int64OrZero(auth.GasFeeCap); got != tt.wantFee {
+				t.Errorf("GasFeeCap = %d, want %d", got, tt.wantFee)
+			}
+			if got := int64OrZero(auth.GasTipCap); got != tt.wantTip {
+				t.Errorf("GasTipCap = %d, want %d", got, tt.wantTip)
+			}
+		})
+	}
+}
+
+func int64OrZero(v *big.Int) int64 {
+	if v == nil {
+		return 0
+	}
+	return v.Int64()
+}
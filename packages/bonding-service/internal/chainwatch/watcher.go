@@ -0,0 +1,276 @@
+package chainwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// ChainClient is the subset of ethclient.Client the watcher needs
+type ChainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+}
+
+// ContractCheck returns an error when writes to a chain's contract would
+// revert, for example because the contract is paused
+type ContractCheck func(ctx context.Context) error
+
+// Thresholds configures when writes are paused
+type Thresholds struct {
+	MaxHeadAge     time.Duration // Pause when the head block is older than this
+	MaxIndexerLag  uint64        // Pause when the indexer is this many blocks behind the head (0 disables)
+	PauseOnSyncing bool          // Pause while the node reports it is syncing
+}
+
+// DefaultThresholds returns default watcher thresholds
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		MaxHeadAge:     2 * time.Minute,
+		MaxIndexerLag:  500,
+		PauseOnSyncing: true,
+	}
+}
+
+// Status is the latest observed state of a chain
+type Status struct {
+	Chain          string
+	HeadBlock      uint64
+	HeadTime       time.Time
+	FinalizedBlock uint64
+	IndexedBlock   uint64
+	IndexerLag     uint64
+	Syncing        bool
+	WritesPaused   bool
+	PauseReason    string
+	UpdatedAt      time.Time
+}
+
+// Watcher tracks head, finalized and indexed blocks for each configured chain
+// and pauses writes when a node is syncing or lagging, or a contract check fails
+type Watcher struct {
+	mu         sync.RWMutex
+	clients    map[string]ChainClient
+	checks     map[string]ContractCheck
+	statuses   map[string]*Status
+	indexed    map[string]uint64
+	thresholds Thresholds
+	interval   time.Duration
+}
+
+// NewWatcher creates a new chain watcher
+func NewWatcher(thresholds Thresholds, interval time.Duration) *Watcher {
+	return &Watcher{
+		clients:    make(map[string]ChainClient),
+		checks:     make(map[string]ContractCheck),
+		statuses:   make(map[string]*Status),
+		indexed:    make(map[string]uint64),
+		thresholds: thresholds,
+		interval:   interval,
+	}
+}
+
+// AddChain registers a chain to watch
+func (w *Watcher) AddChain(name string, client ChainClient) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.clients[name] = client
+}
+
+// SetContractCheck registers a check run on every poll and before each write
+// to the chain
+func (w *Watcher) SetContractCheck(chain string, check ContractCheck) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.checks[chain] = check
+}
+
+// SetIndexedBlock records the last block processed by the event indexer for a chain
+func (w *Watcher) SetIndexedBlock(chain string, block uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.indexed[chain] = block
+}
+
+// Start polls every chain until the context is cancelled
+func (w *Watcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.PollOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PollOnce refreshes the status of every chain
+func (w *Watcher) PollOnce(ctx context.Context) {
+	w.mu.RLock()
+	clients := make(map[string]ChainClient, len(w.clients))
+	for name, client := range w.clients {
+		clients[name] = client
+	}
+	w.mu.RUnlock()
+
+	for name, client := range clients {
+		w.update(w.poll(ctx, name, client))
+	}
+}
+
+// update stores a chain's status, alerting when writes pause or resume
+func (w *Watcher) update(status *Status) {
+	w.mu.Lock()
+	previous := w.statuses[status.Chain]
+	w.statuses[status.Chain] = status
+	w.mu.Unlock()
+
+	if status.WritesPaused && (previous == nil || !previous.WritesPaused) {
+		log.Printf("ALERT: pausing writes on chain %s: %s", status.Chain, status.PauseReason)
+	} else if !status.WritesPaused && previous != nil && previous.WritesPaused {
+		log.Printf("Resuming writes on chain %s", status.Chain)
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context, name string, client ChainClient) *Status {
+	status := &Status{Chain: name, UpdatedAt: time.Now()}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		status.WritesPaused = true
+		status.PauseReason = fmt.Sprintf("failed to read head block: %v", err)
+		w.record(status)
+		return status
+	}
+	status.HeadBlock = head.Number.Uint64()
+	status.HeadTime = time.Unix(int64(head.Time), 0)
+
+	// Not every node supports the finalized tag; fall back to the head
+	finalized, err := client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err == nil {
+		status.FinalizedBlock = finalized.Number.Uint64()
+	} else {
+		status.FinalizedBlock = status.HeadBlock
+	}
+
+	if progress, err := client.SyncProgress(ctx); err == nil && progress != nil {
+		status.Syncing = true
+	}
+
+	w.mu.RLock()
+	indexed, hasIndexer := w.indexed[name]
+	check := w.checks[name]
+	w.mu.RUnlock()
+	if hasIndexer {
+		status.IndexedBlock = indexed
+		if status.HeadBlock > indexed {
+			status.IndexerLag = status.HeadBlock - indexed
+		}
+	}
+
+	headAge := status.UpdatedAt.Sub(status.HeadTime)
+	switch {
+	case status.Syncing && w.thresholds.PauseOnSyncing:
+		status.WritesPaused = true
+		status.PauseReason = "node is syncing"
+	case w.thresholds.MaxHeadAge > 0 && headAge > w.thresholds.MaxHeadAge:
+		status.WritesPaused = true
+		status.PauseReason = fmt.Sprintf("head block is %s old", headAge.Round(time.Second))
+	case hasIndexer && w.thresholds.MaxIndexerLag > 0 && status.IndexerLag > w.thresholds.MaxIndexerLag:
+		status.WritesPaused = true
+		status.PauseReason = fmt.Sprintf("indexer is %d blocks behind", status.IndexerLag)
+	case check != nil:
+		if err := check(ctx); err != nil {
+			status.WritesPaused = true
+			status.PauseReason = contractPauseReason(err)
+		}
+	}
+
+	metrics.ChainHeadAgeSeconds.WithLabelValues(name).Set(headAge.Seconds())
+	w.record(status)
+	return status
+}
+
+func (w *Watcher) record(status *Status) {
+	metrics.ChainHeadBlock.WithLabelValues(status.Chain).Set(float64(status.HeadBlock))
+	metrics.ChainFinalizedBlock.WithLabelValues(status.Chain).Set(float64(status.FinalizedBlock))
+	metrics.ChainIndexerLagBlocks.WithLabelValues(status.Chain).Set(float64(status.IndexerLag))
+	paused := 0.0
+	if status.WritesPaused {
+		paused = 1.0
+	}
+	metrics.ChainWritesPaused.WithLabelValues(status.Chain).Set(paused)
+}
+
+// Statuses returns the latest status of every chain
+func (w *Watcher) Statuses() []Status {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	statuses := make([]Status, 0, len(w.statuses))
+	for _, status := range w.statuses {
+		statuses = append(statuses, *status)
+	}
+	return statuses
+}
+
+// CheckWritable returns an error if writes to the chain are currently paused
+func (w *Watcher) CheckWritable(chain string) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	status, ok := w.statuses[chain]
+	if !ok {
+		return nil // Not polled yet
+	}
+	if status.WritesPaused {
+		return fmt.Errorf("writes to chain %s are paused: %s", chain, status.PauseReason)
+	}
+	return nil
+}
+
+// CheckContract runs the chain's contract check, if any, against the current
+// chain state. A failure pauses writes until the next poll finds the contract
+// writable again.
+func (w *Watcher) CheckContract(ctx context.Context, chain string) error {
+	w.mu.RLock()
+	check := w.checks[chain]
+	w.mu.RUnlock()
+	if check == nil {
+		return nil
+	}
+
+	err := check(ctx)
+	if err == nil {
+		return nil
+	}
+
+	status := &Status{Chain: chain}
+	w.mu.RLock()
+	if previous, ok := w.statuses[chain]; ok {
+		*status = *previous
+	}
+	w.mu.RUnlock()
+	status.WritesPaused = true
+	status.PauseReason = contractPauseReason(err)
+	status.UpdatedAt = time.Now()
+	w.record(status)
+	w.update(status)
+	return fmt.Errorf("writes to chain %s are paused: %s", chain, status.PauseReason)
+}
+
+func contractPauseReason(err error) string {
+	return fmt.Sprintf("contract check failed: %v", err)
+}
@@ -0,0 +1,111 @@
+package chainwatch
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type fakeClient struct {
+	head    uint64
+	headAge time.Duration
+	syncing bool
+}
+
+func (f *fakeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{
+		Number: new(big.Int).SetUint64(f.head),
+		Time:   uint64(time.Now().Add(-f.headAge).Unix()),
+	}, nil
+}
+
+func (f *fakeClient) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	if f.syncing {
+		return &ethereum.SyncProgress{}, nil
+	}
+	return nil, nil
+}
+
+func TestWatcherPausesWrites(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *fakeClient
+		indexed    uint64
+		wantPaused bool
+	}{
+		{
+			name:       "healthy node",
+			client:     &fakeClient{head: 1000, headAge: 5 * time.Second},
+			indexed:    990,
+			wantPaused: false,
+		},
+		{
+			name:       "syncing node",
+			client:     &fakeClient{head: 1000, syncing: true},
+			indexed:    1000,
+			wantPaused: true,
+		},
+		{
+			name:       "stale head",
+			client:     &fakeClient{head: 1000, headAge: 10 * time.Minute},
+			indexed:    1000,
+			wantPaused: true,
+		},
+		{
+			name:       "indexer lagging",
+			client:     &fakeClient{head: 1000},
+			indexed:    100,
+			wantPaused: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWatcher(DefaultThresholds(), time.Second)
+			w.AddChain("test", tt.client)
+			w.SetIndexedBlock("test", tt.indexed)
+			w.PollOnce(context.Background())
+
+			err := w.CheckWritable("test")
+			if (err != nil) != tt.wantPaused {
+				t.Errorf("CheckWritable() error = %v, wantPaused %v", err, tt.wantPaused)
+			}
+		})
+	}
+}
+
+func TestContractCheck(t *testing.T) {
+	w := NewWatcher(DefaultThresholds(), time.Second)
+	w.AddChain("test", &fakeClient{head: 1000})
+	var checkErr error
+	w.SetContractCheck("test", func(ctx context.Context) error { return checkErr })
+
+	w.PollOnce(context.Background())
+	if err := w.CheckWritable("test"); err != nil {
+		t.Fatalf("CheckWritable() with a writable contract = %v", err)
+	}
+
+	// Paused between polls: the check before a write catches it and pauses
+	// writes until the next poll
+	checkErr = errors.New("contract is paused")
+	if err := w.CheckContract(context.Background(), "test"); err == nil {
+		t.Fatal("CheckContract() error = nil, want the paused contract")
+	}
+	if err := w.CheckWritable("test"); err == nil {
+		t.Error("CheckWritable() after a failed contract check = nil, want paused")
+	}
+
+	checkErr = nil
+	w.PollOnce(context.Background())
+	if err := w.CheckWritable("test"); err != nil {
+		t.Errorf("CheckWritable() after unpausing = %v", err)
+	}
+	if err := w.CheckContract(context.Background(), "other"); err != nil {
+		t.Errorf("CheckContract() on a chain without a check = %v", err)
+	}
+}
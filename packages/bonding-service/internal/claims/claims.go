@@ -0,0 +1,176 @@
+// Package claims computes what each investor can withdraw from a bond whose
+// contract pays distributions out on claim rather than pushing them.
+//
+// Positions are replayed from the indexed Investment, Redemption and
+// PositionTransferred events. Each RevenueDistributed event credits the
+// holders of a tranche pro rata to their position at that point, with what
+// the service's waterfall paid the tranche in that transaction; distributions
+// the service didn't record aren't split and credit nobody. Claimed events
+// are what was already withdrawn.
+package claims
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Indexed contract events the balances are replayed from
+const (
+	eventInvestment          = "Investment"
+	eventRedemption          = "Redemption"
+	eventPositionTransferred = "PositionTransferred"
+	eventRevenueDistributed  = "RevenueDistributed"
+	eventClaimed             = "Claimed"
+)
+
+// Balance is what an investor is owed from one tranche
+type Balance struct {
+	Investor  string // Checksummed, as indexed
+	TrancheID int
+	Entitled  *big.Int // Credited by every distribution so far
+	Claimed   *big.Int
+}
+
+// Claimable returns what the investor can still withdraw
+func (b *Balance) Claimable() *big.Int {
+	claimable := new(big.Int).Sub(b.Entitled, b.Claimed)
+	if claimable.Sign() < 0 {
+		return new(big.Int)
+	}
+	return claimable
+}
+
+// Payouts maps a distribution's transaction hash to what it paid each tranche
+type Payouts map[string]map[int]*big.Int
+
+type key struct {
+	investor  string
+	trancheID int
+}
+
+// Compute replays a bond's events, in chain order, into balances sorted by
+// investor and tranche
+func Compute(events []models.ChainEvent, payouts Payouts) []Balance {
+	positions := make(map[int]map[string]*big.Int) // By tranche, then investor
+	balances := make(map[key]*Balance)
+	balance := func(investor string, trancheID int) *Balance {
+		k := key{investor, trancheID}
+		if b, ok := balances[k]; ok {
+			return b
+		}
+		b := &Balance{Investor: investor, TrancheID: trancheID, Entitled: new(big.Int), Claimed: new(big.Int)}
+		balances[k] = b
+		return b
+	}
+	adjust := func(investor string, trancheID int, delta *big.Int) {
+		if positions[trancheID] == nil {
+			positions[trancheID] = make(map[string]*big.Int)
+		}
+		position, ok := positions[trancheID][investor]
+		if !ok {
+			position = new(big.Int)
+			positions[trancheID][investor] = position
+		}
+		position.Add(position, delta)
+	}
+
+	for _, ev := range events {
+		amount, ok := new(big.Int).SetString(ev.Amount, 10)
+		if !ok {
+			continue
+		}
+		switch ev.Event {
+		case eventInvestment:
+			adjust(ev.Account, ev.TrancheID, amount)
+		case eventRedemption:
+			adjust(ev.Account, ev.TrancheID, new(big.Int).Neg(amount))
+		case eventPositionTransferred:
+			adjust(ev.Sender, ev.TrancheID, new(big.Int).Neg(amount))
+			adjust(ev.Account, ev.TrancheID, amount)
+		case eventClaimed:
+			b := balance(ev.Account, ev.TrancheID)
+			b.Claimed.Add(b.Claimed, amount)
+		case eventRevenueDistributed:
+			for trancheID, paid := range payouts[ev.TxHash] {
+				credit(positions[trancheID], paid, func(investor string, share *big.Int) {
+					b := balance(investor, trancheID)
+					b.Entitled.Add(b.Entitled, share)
+				})
+			}
+		}
+	}
+
+	result := make([]Balance, 0, len(balances))
+	for _, b := range balances {
+		result = append(result, *b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Investor != result[j].Investor {
+			return result[i].Investor < result[j].Investor
+		}
+		return result[i].TrancheID < result[j].TrancheID
+	})
+	return result
+}
+
+// credit splits paid between the holders of a tranche pro rata to their
+// positions. Shares round down, so the remainder stays in the contract.
+func credit(positions map[string]*big.Int, paid *big.Int, add func(investor string, share *big.Int)) {
+	total := new(big.Int)
+	for _, position := range positions {
+		if position.Sign() > 0 {
+			total.Add(total, position)
+		}
+	}
+	if total.Sign() == 0 || paid.Sign() <= 0 {
+		return
+	}
+	for investor, position := range positions {
+		if position.Sign() <= 0 {
+			continue
+		}
+		share := new(big.Int).Mul(paid, position)
+		add(investor, share.Quo(share, total))
+	}
+}
+
+// Load computes the balances of every investor in a bond
+func Load(ctx context.Context, db *gorm.DB, bondID string) ([]Balance, error) {
+	db = db.WithContext(ctx)
+
+	var events []models.ChainEvent
+	if err := db.Where("bond_id = ? AND removed = ?", bondID, false).
+		Order("block_number ASC").Order("position ASC").
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to load chain events: %w", err)
+	}
+
+	var distributions []models.RevenueDistribution
+	if err := db.Preload("Tranches").Where("bond_id = ?", bondID).Find(&distributions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load distributions: %w", err)
+	}
+	payouts := make(Payouts, len(distributions))
+	for _, d := range distributions {
+		paid := make(map[int]*big.Int, len(d.Tranches))
+		for _, t := range d.Tranches {
+			paid[t.TrancheID] = sum(t.ArrearsPaid, t.CouponPaid, t.Residual)
+		}
+		payouts[d.TxHash] = paid
+	}
+	return Compute(events, payouts), nil
+}
+
+func sum(amounts ...string) *big.Int {
+	total := new(big.Int)
+	for _, amount := range amounts {
+		if value, ok := new(big.Int).SetString(amount, 10); ok {
+			total.Add(total, value)
+		}
+	}
+	return total
+}
@@ -0,0 +1,56 @@
+package claims
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestCompute(t *testing.T) {
+	const alice, bob, carol = "0xA", "0xB", "0xC"
+	events := []models.ChainEvent{
+		{Event: eventInvestment, Account: alice, TrancheID: 0, Amount: "300"},
+		{Event: eventInvestment, Account: bob, TrancheID: 0, Amount: "100"},
+		{Event: eventInvestment, Account: carol, TrancheID: 2, Amount: "50"},
+		{Event: eventRevenueDistributed, TxHash: "0xd1", Amount: "1000"},
+		{Event: eventClaimed, Account: alice, TrancheID: 0, Amount: "60"},
+		// Bob's position moves to Carol before the second distribution
+		{Event: eventPositionTransferred, Sender: bob, Account: carol, TrancheID: 0, Amount: "100"},
+		{Event: eventRevenueDistributed, TxHash: "0xd2", Amount: "1000"},
+		// Not recorded by the service, so not split
+		{Event: eventRevenueDistributed, TxHash: "0xd3", Amount: "1000"},
+		{Event: eventRedemption, Account: carol, TrancheID: 2, Amount: "50"},
+	}
+	payouts := Payouts{
+		"0xd1": {0: big.NewInt(80), 2: big.NewInt(10)},
+		"0xd2": {0: big.NewInt(40), 2: big.NewInt(7)},
+	}
+
+	want := []struct {
+		investor                     string
+		trancheID                    int
+		entitled, claimed, claimable int64
+	}{
+		{alice, 0, 60 + 30, 60, 30},
+		{bob, 0, 20, 0, 20},
+		{carol, 0, 10, 0, 10},
+		{carol, 2, 17, 0, 17},
+	}
+
+	got := Compute(events, payouts)
+	if len(got) != len(want) {
+		t.Fatalf("Compute() returned %d balances, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		b := &got[i]
+		if b.Investor != w.investor || b.TrancheID != w.trancheID {
+			t.Errorf("balance %d is %s/%d, want %s/%d", i, b.Investor, b.TrancheID, w.investor, w.trancheID)
+			continue
+		}
+		if b.Entitled.Int64() != w.entitled || b.Claimed.Int64() != w.claimed || b.Claimable().Int64() != w.claimable {
+			t.Errorf("%s/%d = entitled %s, claimed %s, claimable %s, want %d, %d, %d",
+				w.investor, w.trancheID, b.Entitled, b.Claimed, b.Claimable(), w.entitled, w.claimed, w.claimable)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// Package commitment periodically publishes a Merkle root over each bond's
+// investor positions and distributions, and serves proofs against it, so an
+// investor can check the position recorded for them against the chain
+// without trusting the service's database.
+package commitment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Leaf kinds as stored
+const (
+	KindPosition     = "POSITION"
+	KindDistribution = "DISTRIBUTION"
+)
+
+// Errors returned when there is no proof to serve
+var (
+	ErrNoCommitment    = errors.New("no commitment has been published for the bond")
+	ErrNotInCommitment = errors.New("position not in the latest commitment")
+)
+
+// Publisher publishes a bond's root, returning the transaction hash
+type Publisher interface {
+	PublishRoot(ctx context.Context, bond *models.Bond, epoch uint64, root common.Hash) (string, error)
+}
+
+// Config controls the commitment job
+type Config struct {
+	Interval  time.Duration // How often every active bond's root is recomputed
+	BatchSize int           // Bonds loaded per query
+}
+
+// DefaultConfig returns default commitment configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:  24 * time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// Report is the result of a commitment run
+type Report struct {
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	BondsPublished int
+	BondsUnchanged int // The published root still matches
+	BondsFailed    int
+}
+
+// Committer computes and publishes bond commitments
+type Committer struct {
+	db        *gorm.DB
+	publisher Publisher
+	config    Config
+
+	runMu sync.Mutex // Serializes runs
+}
+
+// New creates a committer
+func New(db *gorm.DB, publisher Publisher, config Config) *Committer {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultConfig().BatchSize
+	}
+	return &Committer{db: db, publisher: publisher, config: config}
+}
+
+// Start commits on every interval until the context is cancelled
+func (c *Committer) Start(ctx context.Context) {
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.Run(ctx); err != nil {
+				log.Printf("Bond commitment failed: %v", err)
+			}
+		}
+	}
+}
+
+// Run commits every outstanding bond whose positions or distributions changed
+// since its last published root
+func (c *Committer) Run(ctx context.Context) (*Report, error) {
+	c.runMu.Lock()
+	defer c.runMu.Unlock()
+
+	report := &Report{StartedAt: time.Now()}
+	var lastID uint
+	for {
+		var bonds []models.Bond
+		err := c.db.WithContext(ctx).
+			Where("id > ? AND status IN ? AND archived_at IS NULL", lastID, models.OutstandingBondStatuses).
+			Order("id ASC").
+			Limit(c.config.BatchSize).
+			Find(&bonds).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bonds: %w", err)
+		}
+		if len(bonds) == 0 {
+			break
+		}
+		lastID = bonds[len(bonds)-1].ID
+
+		for i := range bonds {
+			published, err := c.CommitBond(ctx, &bonds[i])
+			switch {
+			case err != nil:
+				log.Printf("Failed to commit bond %s: %v", bonds[i].BondID, err)
+				report.BondsFailed++
+			case published:
+				report.BondsPublished++
+			default:
+				report.BondsUnchanged++
+			}
+		}
+
+		if len(bonds) < c.config.BatchSize {
+			break
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	log.Printf("Committed %d bonds (%d unchanged, %d failed)", report.BondsPublished, report.BondsUnchanged, report.BondsFailed)
+	return report, nil
+}
+
+// CommitBond publishes the bond's current root unless it is already
+// published. A new root takes the next epoch; an unpublished one is replaced
+// in place, so published epochs have no gaps.
+func (c *Committer) CommitBond(ctx context.Context, bond *models.Bond) (bool, error) {
+	db := c.db.WithContext(ctx)
+	leaves, err := collectLeaves(db, bond.BondID)
+	if err != nil {
+		return false, err
+	}
+	if len(leaves) == 0 {
+		return false, nil
+	}
+	hashes := make([]common.Hash, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i] = common.HexToHash(leaf.Hash)
+	}
+	root := NewTree(hashes).Root()
+
+	var latest models.BondCommitment
+	err = db.Where("bond_id = ?", bond.BondID).Order("epoch DESC").First(&latest).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, fmt.Errorf("failed to load latest commitment: %w", err)
+	}
+	found := err == nil
+
+	commitment := &latest
+	switch {
+	case found && latest.Root == root.Hex() && latest.Status == models.CommitmentPublished:
+		return false, nil
+	case found && latest.Root == root.Hex():
+		// Retry publishing the same root
+	default:
+		if !found || latest.Status == models.CommitmentPublished {
+			commitment = &models.BondCommitment{BondID: bond.BondID, Epoch: latest.Epoch + 1}
+		}
+		commitment.Root = root.Hex()
+		commitment.LeafCount = len(leaves)
+		commitment.Status = models.CommitmentPending
+		commitment.CommittedAt = time.Now()
+		if err := saveCommitment(db, commitment, leaves); err != nil {
+			return false, err
+		}
+	}
+
+	txHash, err := c.publisher.PublishRoot(ctx, bond, commitment.Epoch, root)
+	if err != nil {
+		metrics.CommitmentsPublished.WithLabelValues("failed").Inc()
+		if updateErr := db.Model(commitment).Updates(map[string]interface{}{
+			"status": models.CommitmentFailed,
+			"error":  err.Error(),
+		}).Error; updateErr != nil {
+			log.Printf("Failed to record commitment failure of bond %s: %v", bond.BondID, updateErr)
+		}
+		return false, fmt.Errorf("failed to publish root: %w", err)
+	}
+	metrics.CommitmentsPublished.WithLabelValues("published").Inc()
+	if err := db.Model(commitment).Updates(map[string]interface{}{
+		"status":       models.CommitmentPublished,
+		"tx_hash":      txHash,
+		"error":        "",
+		"published_at": time.Now(),
+	}).Error; err != nil {
+		return true, fmt.Errorf("failed to record published commitment: %w", err)
+	}
+	return true, nil
+}
+
+// saveCommitment stores a commitment and replaces its leaves
+func saveCommitment(db *gorm.DB, commitment *models.BondCommitment, leaves []models.CommitmentLeaf) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(commitment).Error; err != nil {
+			return fmt.Errorf("failed to save commitment: %w", err)
+		}
+		if err := tx.Unscoped().Where("commitment_id = ?", commitment.ID).Delete(&models.CommitmentLeaf{}).Error; err != nil {
+			return fmt.Errorf("failed to replace commitment leaves: %w", err)
+		}
+		for i := range leaves {
+			leaves[i].CommitmentID = commitment.ID
+		}
+		if err := tx.CreateInBatches(leaves, 500).Error; err != nil {
+			return fmt.Errorf("failed to save commitment leaves: %w", err)
+		}
+		return nil
+	})
+}
+
+// collectLeaves builds a leaf for every non-zero position and every tranche
+// distribution of the bond
+func collectLeaves(db *gorm.DB, bondID string) ([]models.CommitmentLeaf, error) {
+	var investments []models.Investment
+	if err := db.Where("bond_id = ?", bondID).Find(&investments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load investments: %w", err)
+	}
+	type positionKey struct {
+		trancheID int
+		investor  common.Address
+	}
+	positions := make(map[positionKey]*big.Int)
+	for _, inv := range investments {
+		key := positionKey{inv.TrancheID, common.HexToAddress(inv.Investor)}
+		if positions[key] == nil {
+			positions[key] = new(big.Int)
+		}
+		positions[key].Add(positions[key], parseAmount(inv.Amount))
+	}
+
+	var leaves []models.CommitmentLeaf
+	for key, amount := range positions {
+		if amount.Sign() <= 0 {
+			continue
+		}
+		leaves = append(leaves, models.CommitmentLeaf{
+			Kind:      KindPosition,
+			TrancheID: key.trancheID,
+			Investor:  key.investor.Hex(),
+			Amount:    amount.String(),
+			Hash:      PositionLeaf(bondID, key.trancheID, key.investor, amount).Hex(),
+		})
+	}
+
+	var distributions []models.TrancheDistribution
+	if err := db.Where("bond_id = ?", bondID).Find(&distributions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load distributions: %w", err)
+	}
+	for _, d := range distributions {
+		couponPaid, arrearsPaid, residual := parseAmount(d.CouponPaid), parseAmount(d.ArrearsPaid), parseAmount(d.Residual)
+		leaves = append(leaves, models.CommitmentLeaf{
+			Kind:           KindDistribution,
+			TrancheID:      d.TrancheID,
+			DistributionID: d.DistributionID,
+			CouponPaid:     couponPaid.String(),
+			ArrearsPaid:    arrearsPaid.String(),
+			Residual:       residual.String(),
+			Hash:           DistributionLeaf(bondID, d.DistributionID, d.TrancheID, couponPaid, arrearsPaid, residual).Hex(),
+		})
+	}
+
+	// Stored in tree order, so leaves are stable between runs
+	sort.Slice(leaves, func(i, j int) bool { return leaves[i].Hash < leaves[j].Hash })
+	return leaves, nil
+}
+
+// Proof shows a position is included in a published commitment
+type Proof struct {
+	Commitment models.BondCommitment
+	Leaf       models.CommitmentLeaf
+	Hashes     []common.Hash // Siblings from the leaf up to the root
+}
+
+// PositionProof proves an investor's tranche position against the bond's
+// latest published commitment
+func PositionProof(ctx context.Context, db *gorm.DB, bondID string, trancheID int, investor common.Address) (*Proof, error) {
+	db = db.WithContext(ctx)
+	var commitment models.BondCommitment
+	err := db.Where("bond_id = ? AND status = ?", bondID, models.CommitmentPublished).
+		Order("epoch DESC").
+		First(&commitment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoCommitment
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load commitment: %w", err)
+	}
+
+	var leaves []models.CommitmentLeaf
+	if err := db.Where("commitment_id = ?", commitment.ID).Find(&leaves).Error; err != nil {
+		return nil, fmt.Errorf("failed to load commitment leaves: %w", err)
+	}
+	hashes := make([]common.Hash, len(leaves))
+	target := -1
+	for i, leaf := range leaves {
+		hashes[i] = common.HexToHash(leaf.Hash)
+		if leaf.Kind == KindPosition && leaf.TrancheID == trancheID && leaf.Investor == investor.Hex() {
+			target = i
+		}
+	}
+	if target < 0 {
+		return nil, ErrNotInCommitment
+	}
+
+	tree := NewTree(hashes)
+	if tree.Root() != common.HexToHash(commitment.Root) {
+		return nil, fmt.Errorf("stored leaves of commitment %d don't match its root", commitment.Epoch)
+	}
+	proof, _ := tree.Proof(hashes[target])
+	return &Proof{Commitment: commitment, Leaf: leaves[target], Hashes: proof}, nil
+}
+
+func parseAmount(s string) *big.Int {
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return amount
+}
@@ -0,0 +1,151 @@
+package commitment
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var (
+	investorA = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	investorB = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+// fakePublisher records published roots
+type fakePublisher struct {
+	roots []common.Hash
+}
+
+func (f *fakePublisher) PublishRoot(ctx context.Context, bond *models.Bond, epoch uint64, root common.Hash) (string, error) {
+	f.roots = append(f.roots, root)
+	return "0xabc", nil
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// expectLeaves expects the queries of collectLeaves for two investors in the
+// senior tranche, investorA having invested twice
+func expectLeaves(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT \* FROM "investments" WHERE bond_id = \$1`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "investor", "amount"}).
+			AddRow(1, "7", 0, investorA.Hex(), "600").
+			AddRow(2, "7", 0, investorB.Hex(), "250").
+			AddRow(3, "7", 0, investorA.Hex(), "400"))
+	mock.ExpectQuery(`SELECT \* FROM "tranche_distributions" WHERE bond_id = \$1`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id"}))
+}
+
+func expectedRoot() common.Hash {
+	return NewTree([]common.Hash{
+		PositionLeaf("7", 0, investorA, big.NewInt(1000)),
+		PositionLeaf("7", 0, investorB, big.NewInt(250)),
+	}).Root()
+}
+
+func TestCommitBondUnchanged(t *testing.T) {
+	db, mock := newMockDB(t)
+	expectLeaves(mock)
+	mock.ExpectQuery(`SELECT \* FROM "bond_commitments" WHERE bond_id = \$1 .*ORDER BY epoch DESC`).
+		WithArgs("7", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "epoch", "root", "status"}).
+			AddRow(1, "7", 3, expectedRoot().Hex(), models.CommitmentPublished))
+
+	publisher := &fakePublisher{}
+	published, err := New(db, publisher, DefaultConfig()).CommitBond(context.Background(), &models.Bond{BondID: "7"})
+	if err != nil {
+		t.Fatalf("CommitBond() error = %v", err)
+	}
+	if published || len(publisher.roots) != 0 {
+		t.Errorf("CommitBond() published an unchanged root")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPositionProof(t *testing.T) {
+	leafA := PositionLeaf("7", 0, investorA, big.NewInt(1000))
+	leafB := PositionLeaf("7", 0, investorB, big.NewInt(250))
+	root := expectedRoot()
+
+	tests := []struct {
+		name     string
+		investor common.Address
+		root     common.Hash
+		wantErr  bool
+		wantIs   error
+	}{
+		{"in commitment", investorA, root, false, nil},
+		{"not in commitment", common.HexToAddress("0x3333333333333333333333333333333333333333"), root, true, ErrNotInCommitment},
+		{"leaves don't match root", investorA, common.HexToHash("0x01"), true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			mock.ExpectQuery(`SELECT \* FROM "bond_commitments" WHERE \(bond_id = \$1 AND status = \$2\) .*ORDER BY epoch DESC`).
+				WithArgs("7", models.CommitmentPublished, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "epoch", "root", "status", "committed_at"}).
+					AddRow(4, "7", 2, tt.root.Hex(), models.CommitmentPublished, time.Now()))
+			mock.ExpectQuery(`SELECT \* FROM "commitment_leaves" WHERE commitment_id = \$1`).
+				WithArgs(4).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "commitment_id", "kind", "tranche_id", "investor", "amount", "hash"}).
+					AddRow(1, 4, KindPosition, 0, investorA.Hex(), "1000", leafA.Hex()).
+					AddRow(2, 4, KindPosition, 0, investorB.Hex(), "250", leafB.Hex()))
+
+			proof, err := PositionProof(context.Background(), db, "7", 0, tt.investor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PositionProof() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Fatalf("PositionProof() error = %v, want %v", err, tt.wantIs)
+			}
+			if err != nil {
+				return
+			}
+			if proof.Leaf.Amount != "1000" || proof.Commitment.Epoch != 2 {
+				t.Errorf("PositionProof() = amount %s epoch %d, want 1000 and 2", proof.Leaf.Amount, proof.Commitment.Epoch)
+			}
+			if !Verify(root, leafA, proof.Hashes) {
+				t.Error("proof doesn't verify against the root")
+			}
+		})
+	}
+}
+
+func TestPositionProofNoCommitment(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT \* FROM "bond_commitments"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	if _, err := PositionProof(context.Background(), db, "7", 0, investorA); !errors.Is(err, ErrNoCommitment) {
+		t.Errorf("PositionProof() error = %v, want %v", err, ErrNoCommitment)
+	}
+}
@@ -0,0 +1,127 @@
+package commitment
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Leaf kinds, the first field of every encoded leaf
+const (
+	kindPosition     uint8 = 1
+	kindDistribution uint8 = 2
+)
+
+var (
+	uint8Type, _   = abi.NewType("uint8", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	stringType, _  = abi.NewType("string", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+
+	positionArgs = abi.Arguments{
+		{Type: uint8Type}, {Type: stringType}, {Type: uint8Type}, {Type: addressType}, {Type: uint256Type},
+	}
+	distributionArgs = abi.Arguments{
+		{Type: uint8Type}, {Type: stringType}, {Type: uint256Type}, {Type: uint8Type},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type},
+	}
+)
+
+// PositionLeaf hashes an investor's position in a tranche:
+// keccak256(keccak256(abi.encode(1, bondId, trancheId, investor, amount)))
+func PositionLeaf(bondID string, trancheID int, investor common.Address, amount *big.Int) common.Hash {
+	return leaf(positionArgs, kindPosition, bondID, uint8(trancheID), investor, amount)
+}
+
+// DistributionLeaf hashes what a distribution paid a tranche:
+// keccak256(keccak256(abi.encode(2, bondId, distributionId, trancheId,
+// couponPaid, arrearsPaid, residual)))
+func DistributionLeaf(bondID string, distributionID uint, trancheID int, couponPaid, arrearsPaid, residual *big.Int) common.Hash {
+	return leaf(distributionArgs, kindDistribution, bondID, new(big.Int).SetUint64(uint64(distributionID)),
+		uint8(trancheID), couponPaid, arrearsPaid, residual)
+}
+
+// leaf double-hashes the ABI encoding of values, so a leaf can't be passed
+// off as an inner node
+func leaf(args abi.Arguments, values ...interface{}) common.Hash {
+	encoded, err := args.Pack(values...)
+	if err != nil {
+		panic(err) // The argument types are fixed above
+	}
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded))
+}
+
+// hashPair hashes two nodes in sorted order, as OpenZeppelin's MerkleProof
+// does, so proofs need no left/right flags
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
+
+// Tree is a Merkle tree over sorted leaves. An odd node is carried up a
+// level unhashed.
+type Tree struct {
+	layers [][]common.Hash // Leaves first, root last
+}
+
+// NewTree builds a tree over leaves
+func NewTree(leaves []common.Hash) *Tree {
+	layer := slices.Clone(leaves)
+	slices.SortFunc(layer, func(a, b common.Hash) int { return bytes.Compare(a[:], b[:]) })
+	t := &Tree{layers: [][]common.Hash{layer}}
+	for len(layer) > 1 {
+		next := make([]common.Hash, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 == len(layer) {
+				next = append(next, layer[i])
+				continue
+			}
+			next = append(next, hashPair(layer[i], layer[i+1]))
+		}
+		t.layers = append(t.layers, next)
+		layer = next
+	}
+	return t
+}
+
+// Root returns the tree's root, the zero hash for an empty tree
+func (t *Tree) Root() common.Hash {
+	top := t.layers[len(t.layers)-1]
+	if len(top) == 0 {
+		return common.Hash{}
+	}
+	return top[0]
+}
+
+// Proof returns the sibling hashes from leaf up to the root, false if leaf
+// isn't in the tree
+func (t *Tree) Proof(leaf common.Hash) ([]common.Hash, bool) {
+	index := slices.IndexFunc(t.layers[0], func(h common.Hash) bool { return h == leaf })
+	if index < 0 {
+		return nil, false
+	}
+	proof := []common.Hash{}
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		sibling := index ^ 1
+		if sibling < len(layer) {
+			proof = append(proof, layer[sibling])
+		}
+		index /= 2
+	}
+	return proof, true
+}
+
+// Verify checks that proof connects leaf to root
+func Verify(root, leaf common.Hash, proof []common.Hash) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashPair(node, sibling)
+	}
+	return node == root
+}
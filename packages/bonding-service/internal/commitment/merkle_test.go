@@ -0,0 +1,75 @@
+package commitment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestTreeProofs(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 4, 5, 8, 13} {
+		leaves := make([]common.Hash, size)
+		for i := range leaves {
+			leaves[i] = PositionLeaf("7", i%3, common.BigToAddress(big.NewInt(int64(i+1))), big.NewInt(int64(1000*(i+1))))
+		}
+		tree := NewTree(leaves)
+		root := tree.Root()
+		for i, leaf := range leaves {
+			proof, ok := tree.Proof(leaf)
+			if !ok {
+				t.Fatalf("size %d: leaf %d not found", size, i)
+			}
+			if !Verify(root, leaf, proof) {
+				t.Errorf("size %d: proof of leaf %d doesn't verify", size, i)
+			}
+		}
+
+		tampered := PositionLeaf("7", 0, common.BigToAddress(big.NewInt(1)), big.NewInt(1001))
+		proof, _ := tree.Proof(leaves[0])
+		if Verify(root, tampered, proof) {
+			t.Errorf("size %d: tampered leaf verifies", size)
+		}
+		if _, ok := tree.Proof(tampered); ok {
+			t.Errorf("size %d: proof for a leaf not in the tree", size)
+		}
+	}
+}
+
+func TestTreeRoot(t *testing.T) {
+	if root := NewTree(nil).Root(); root != (common.Hash{}) {
+		t.Errorf("empty tree root = %s, want zero", root)
+	}
+
+	a := crypto.Keccak256Hash([]byte("a"))
+	b := crypto.Keccak256Hash([]byte("b"))
+	if NewTree([]common.Hash{a, b}).Root() != NewTree([]common.Hash{b, a}).Root() {
+		t.Error("root depends on leaf order")
+	}
+	if got, want := NewTree([]common.Hash{a, b}).Root(), hashPair(b, a); got != want {
+		t.Errorf("root = %s, want %s", got, want)
+	}
+}
+
+func TestLeavesAreDistinct(t *testing.T) {
+	investor := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	position := PositionLeaf("7", 0, investor, big.NewInt(100))
+	tests := []struct {
+		name string
+		leaf common.Hash
+	}{
+		{"other bond", PositionLeaf("8", 0, investor, big.NewInt(100))},
+		{"other tranche", PositionLeaf("7", 1, investor, big.NewInt(100))},
+		{"other investor", PositionLeaf("7", 0, common.HexToAddress("0x2222222222222222222222222222222222222222"), big.NewInt(100))},
+		{"other amount", PositionLeaf("7", 0, investor, big.NewInt(101))},
+		{"distribution", DistributionLeaf("7", 0, 0, big.NewInt(100), big.NewInt(0), big.NewInt(0))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.leaf == position {
+				t.Error("leaf collides with the position leaf")
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Package comparables stores sales of IP-NFTs, recorded by hand or reported
+// with oracle valuations, so similar IP can be valued against them.
+package comparables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Sources of comparable sales
+const (
+	SourceManual = "manual"
+	SourceOracle = "oracle"
+)
+
+// ErrInvalidSale wraps validation failures for recorded sales
+var ErrInvalidSale = errors.New("invalid comparable sale")
+
+// Store keeps comparable sales in the database
+type Store struct {
+	db *gorm.DB
+}
+
+// NewStore creates a comparable sales store
+func NewStore(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Record stores sales, skipping any already recorded from the same source,
+// and returns how many were new
+func (s *Store) Record(ctx context.Context, sales []models.ComparableSale) (int, error) {
+	for i := range sales {
+		if err := normalize(&sales[i]); err != nil {
+			return 0, fmt.Errorf("sale %d: %w", i, err)
+		}
+	}
+	if len(sales) == 0 {
+		return 0, nil
+	}
+
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&sales)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to record comparable sales: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// RecordOracle stores the comparable sales an oracle reported with a
+// valuation of IP in category. Entries missing a token or price are skipped.
+func (s *Store) RecordOracle(ctx context.Context, category string, reported []map[string]interface{}) error {
+	_, err := s.Record(ctx, FromOracle(category, reported))
+	return err
+}
+
+// Find returns up to limit of the latest sales in a category, preferring
+// those sharing a tag with tags and falling back to the whole category when
+// none do
+func (s *Store) Find(ctx context.Context, category string, tags []string, limit int) ([]models.ComparableSale, error) {
+	category = taxonomy.Normalize(category)
+	if category == "" {
+		return nil, nil
+	}
+
+	query := func(tags []string) ([]models.ComparableSale, error) {
+		q := s.db.WithContext(ctx).Where("category = ?", category)
+		if len(tags) > 0 {
+			conds := make([]string, len(tags))
+			args := make([]interface{}, len(tags))
+			for i, tag := range tags {
+				conds[i] = "',' || tags || ',' LIKE ?"
+				args[i] = "%," + tag + ",%"
+			}
+			q = q.Where(strings.Join(conds, " OR "), args...)
+		}
+		var sales []models.ComparableSale
+		if err := q.Order("sold_at DESC").Limit(limit).Find(&sales).Error; err != nil {
+			return nil, fmt.Errorf("failed to load comparable sales: %w", err)
+		}
+		return sales, nil
+	}
+
+	if tags = normalizeTags(tags); len(tags) > 0 {
+		sales, err := query(tags)
+		if err != nil || len(sales) > 0 {
+			return sales, err
+		}
+	}
+	return query(nil)
+}
+
+// FromOracle converts the comparable sales in an oracle valuation response.
+// Sales without a category are taken to be in the valued IP's category.
+func FromOracle(category string, reported []map[string]interface{}) []models.ComparableSale {
+	var sales []models.ComparableSale
+	for _, r := range reported {
+		sale := models.ComparableSale{
+			TokenID:  stringField(r, "token_id", "tokenId"),
+			Category: stringField(r, "category"),
+			Price:    numberField(r, "price"),
+			Source:   SourceOracle,
+		}
+		if sale.Category == "" {
+			sale.Category = category
+		}
+		if ts := numberField(r, "timestamp"); ts > 0 {
+			sale.SoldAt = time.Unix(int64(ts), 0)
+		}
+		if tags, ok := r["tags"].([]interface{}); ok {
+			for _, tag := range tags {
+				if tag, ok := tag.(string); ok {
+					sale.Tags = joinTags(sale.Tags, tag)
+				}
+			}
+		}
+		if normalize(&sale) == nil {
+			sales = append(sales, sale)
+		}
+	}
+	return sales
+}
+
+// Median returns the median price of sales, or 0 when there are none
+func Median(sales []models.ComparableSale) float64 {
+	if len(sales) == 0 {
+		return 0
+	}
+	prices := make([]float64, len(sales))
+	for i := range sales {
+		prices[i] = sales[i].Price
+	}
+	sort.Float64s(prices)
+	mid := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return (prices[mid-1] + prices[mid]) / 2
+	}
+	return prices[mid]
+}
+
+// SplitTags parses a stored comma-separated tag list
+func SplitTags(tags string) []string {
+	return normalizeTags(strings.Split(tags, ","))
+}
+
+// normalize validates a sale and brings its category, tags and source into
+// their stored form
+func normalize(sale *models.ComparableSale) error {
+	sale.TokenID = strings.TrimSpace(sale.TokenID)
+	sale.Category = taxonomy.Normalize(sale.Category)
+	sale.Tags = strings.Join(SplitTags(sale.Tags), ",")
+	if sale.Source == "" {
+		sale.Source = SourceManual
+	}
+	switch {
+	case sale.TokenID == "":
+		return fmt.Errorf("%w: token_id is required", ErrInvalidSale)
+	case sale.Category == "":
+		return fmt.Errorf("%w: category is required", ErrInvalidSale)
+	case sale.Price <= 0:
+		return fmt.Errorf("%w: price must be positive", ErrInvalidSale)
+	case sale.SoldAt.IsZero():
+		return fmt.Errorf("%w: timestamp is required", ErrInvalidSale)
+	case sale.SoldAt.After(time.Now()):
+		return fmt.Errorf("%w: timestamp is in the future", ErrInvalidSale)
+	}
+	return nil
+}
+
+// normalizeTags lowercases tags and drops empty and repeated ones
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = taxonomy.Normalize(tag)
+		if tag == "" || strings.Contains(tag, ",") || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func joinTags(tags, tag string) string {
+	if tags == "" {
+		return tag
+	}
+	return tags + "," + tag
+}
+
+func stringField(r map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		switch v := r[key].(type) {
+		case string:
+			return v
+		case float64:
+			return fmt.Sprintf("%.0f", v)
+		}
+	}
+	return ""
+}
+
+func numberField(r map[string]interface{}, key string) float64 {
+	v, _ := r[key].(float64)
+	return v
+}
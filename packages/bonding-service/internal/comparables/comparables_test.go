@@ -0,0 +1,81 @@
+package comparables
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestFindFallsBackToCategory(t *testing.T) {
+	db, mock := newMockDB(t)
+	columns := []string{"id", "token_id", "category", "tags", "price", "sold_at", "source"}
+	soldAt := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectQuery(`SELECT \* FROM "comparable_sales" WHERE category = \$1 AND \(',' \|\| tags \|\| ',' LIKE \$2 OR ',' \|\| tags \|\| ',' LIKE \$3\) ORDER BY sold_at DESC LIMIT \$4`).
+		WithArgs("music", "%,jazz,%", "%,live,%", 10).
+		WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(`SELECT \* FROM "comparable_sales" WHERE category = \$1 ORDER BY sold_at DESC LIMIT \$2`).
+		WithArgs("music", 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "7", "music", "rock", 1200.0, soldAt, SourceManual))
+
+	sales, err := NewStore(db).Find(context.Background(), " Music", []string{"Jazz", "live", "jazz", ""}, 10)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(sales) != 1 || sales[0].TokenID != "7" {
+		t.Errorf("Find() = %+v, want the category's sale", sales)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFromOracle(t *testing.T) {
+	soldAt := float64(time.Now().Add(-time.Hour).Unix())
+	reported := []map[string]interface{}{
+		{"token_id": "12", "price": 900.0, "timestamp": soldAt, "tags": []interface{}{"Jazz", 3}},
+		{"tokenId": 13.0, "price": 1100.0, "timestamp": soldAt, "category": "Video"},
+		{"token_id": "14", "timestamp": soldAt},                        // No price
+		{"token_id": "15", "price": 500.0},                             // No timestamp
+		{"price": 700.0, "timestamp": soldAt, "similarity_score": 0.8}, // No token
+	}
+
+	sales := FromOracle("music", reported)
+	if len(sales) != 2 {
+		t.Fatalf("FromOracle() returned %d sales, want 2: %+v", len(sales), sales)
+	}
+	if s := sales[0]; s.TokenID != "12" || s.Category != "music" || s.Tags != "jazz" || s.Source != SourceOracle {
+		t.Errorf("sales[0] = %+v", s)
+	}
+	if s := sales[1]; s.TokenID != "13" || s.Category != "video" {
+		t.Errorf("sales[1] = %+v", s)
+	}
+	if got := Median(sales); got != 1000 {
+		t.Errorf("Median() = %v, want 1000", got)
+	}
+}
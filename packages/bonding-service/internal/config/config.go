@@ -0,0 +1,837 @@
+// Package config loads the service's settings from an optional YAML file and
+// the environment into a typed, validated struct. Environment variables keep
+// their historical names and override the file, so deployments configured
+// only through the environment behave as before.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/database"
+	"github.com/knowton/bonding-service/internal/documents"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/rpcpool"
+	"github.com/knowton/bonding-service/internal/usage"
+	"gopkg.in/yaml.v3"
+)
+
+// Duration is a time.Duration written as a string such as "30s"
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalText parses a duration string
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// MarshalText formats the duration
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Config is the service's configuration. Fields tagged env are overridden by
+// that variable when it is set; fields tagged reload take effect on SIGHUP.
+type Config struct {
+	Server       Server       `json:"server"`
+	Database     Database     `json:"database"`
+	Chains       Chains       `json:"chains"`
+	Oracle       Oracle       `json:"oracle"`
+	Limits       Limits       `json:"limits"`
+	Auth         Auth         `json:"auth"`
+	Maintenance  Maintenance  `json:"maintenance"`
+	Jobs         Jobs         `json:"jobs"`
+	Distribution Distribution `json:"distribution"`
+	Risk         Risk         `json:"risk"`
+	Operator     Operator     `json:"operator"`
+	Integrations Integrations `json:"integrations"`
+	Documents    Documents    `json:"documents"`
+	Sandbox      Sandbox      `json:"sandbox"`
+}
+
+// Server holds the gRPC and HTTP listener settings
+type Server struct {
+	Port            string   `json:"port" env:"GRPC_PORT"`
+	MetricsPort     string   `json:"metrics_port" env:"METRICS_PORT"` // Also serves the gateway and admin UI
+	DeadlineMargin  Duration `json:"deadline_margin" env:"GRPC_DEADLINE_MARGIN"`
+	DefaultTimeout  Duration `json:"default_timeout" env:"GRPC_DEFAULT_TIMEOUT"`
+	ShutdownTimeout Duration `json:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+
+	// gRPC transport security; plaintext without a certificate
+	TLSCertFile     string `json:"tls_cert_file" env:"GRPC_TLS_CERT_FILE"`
+	TLSKeyFile      string `json:"tls_key_file" env:"GRPC_TLS_KEY_FILE"`
+	TLSClientCAFile string `json:"tls_client_ca_file" env:"GRPC_TLS_CLIENT_CA_FILE"` // Requires client certificates it signed
+
+	// gRPC keepalive; zero keeps grpc's defaults
+	KeepaliveTime                Duration `json:"keepalive_time" env:"GRPC_KEEPALIVE_TIME"`
+	KeepaliveTimeout             Duration `json:"keepalive_timeout" env:"GRPC_KEEPALIVE_TIMEOUT"`
+	KeepaliveMinTime             Duration `json:"keepalive_min_time" env:"GRPC_KEEPALIVE_MIN_TIME"`
+	KeepalivePermitWithoutStream bool     `json:"keepalive_permit_without_stream" env:"GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"`
+	MaxConnectionIdle            Duration `json:"max_connection_idle" env:"GRPC_MAX_CONNECTION_IDLE"`
+	MaxConnectionAge             Duration `json:"max_connection_age" env:"GRPC_MAX_CONNECTION_AGE"`
+	MaxConnectionAgeGrace        Duration `json:"max_connection_age_grace" env:"GRPC_MAX_CONNECTION_AGE_GRACE"`
+	ConnectionTimeout            Duration `json:"connection_timeout" env:"GRPC_CONNECTION_TIMEOUT"`
+
+	ConsistencyTimeout Duration `json:"consistency_timeout" env:"CONSISTENCY_WAIT_TIMEOUT"` // How long reads wait for a consistency token
+	GatewayCacheMaxAge Duration `json:"gateway_cache_max_age" env:"GATEWAY_CACHE_MAX_AGE"`
+}
+
+// Database holds the database connection and pool settings
+type Database struct {
+	URL         string   `json:"url" env:"DATABASE_URL"`
+	ReplicaURLs []string `json:"replica_urls" env:"DATABASE_REPLICA_URLS"` // Heavy reads stay on the primary when empty
+
+	// Applied to the primary and each replica
+	MaxOpenConns    int      `json:"max_open_conns" env:"DB_MAX_OPEN_CONNS"` // 0 is unlimited
+	MaxIdleConns    int      `json:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime Duration `json:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"` // 0 keeps connections forever
+	ConnMaxIdleTime Duration `json:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+}
+
+// Chains holds the chains the service connects to and how it writes to them
+type Chains struct {
+	RegistryFile string         `json:"registry_file" env:"CHAIN_REGISTRY_FILE"`
+	Registry     []chains.Chain `json:"registry"` // Replace built-in or file chains of the same name
+	Default      string         `json:"default" env:"DEFAULT_CHAIN"`
+	Enabled      []string       `json:"enabled" env:"ENABLED_CHAINS"` // Only the default chain when empty
+
+	// Applied to the built-in Arbitrum chain when there is no registry file
+	ArbitrumRPCURLs    []string `json:"arbitrum_rpc_urls" env:"ARBITRUM_RPC_URL"`
+	ArbitrumWSURLs     []string `json:"arbitrum_ws_urls" env:"ARBITRUM_WS_URL"`
+	IPBondContract     string   `json:"ipbond_contract" env:"IPBOND_CONTRACT_ADDRESS"`
+	CopyrightRegistry  string   `json:"copyright_registry" env:"COPYRIGHT_REGISTRY_ADDRESS"`
+	PriceFeed          string   `json:"price_feed" env:"ETH_USD_PRICE_FEED_ADDRESS"`
+	RoyaltyDistributor string   `json:"royalty_distributor" env:"ROYALTY_DISTRIBUTOR_ADDRESS"`
+
+	Gas           map[string]chains.GasStrategy `json:"gas"`                                 // Gas policy by chain name, replacing the registry's
+	PolicyFile    string                        `json:"policy_file" env:"CHAIN_POLICY_FILE"` // Chains and contracts writes may go to
+	ExpectedAdmin string                        `json:"expected_admin" env:"CONTRACT_EXPECTED_ADMIN"`
+
+	RPCHealthInterval Duration `json:"rpc_health_interval" env:"RPC_HEALTH_INTERVAL"`
+	RPCMaxBlockLag    uint64   `json:"rpc_max_block_lag" env:"RPC_MAX_BLOCK_LAG"`
+	MaxHeadAge        Duration `json:"max_head_age" env:"CHAIN_MAX_HEAD_AGE"`
+	MaxIndexerLag     uint64   `json:"max_indexer_lag" env:"CHAIN_MAX_INDEXER_LAG"`
+
+	OperatorKey          string   `json:"operator_key" env:"PRIVATE_KEY"` // Hex key of the wallet sending transactions
+	IndexerStartBlock    uint64   `json:"indexer_start_block" env:"INDEXER_START_BLOCK"`
+	IndexerReorgWindow   uint64   `json:"indexer_reorg_window" env:"INDEXER_REORG_WINDOW"`
+	RoyaltyStartBlock    uint64   `json:"royalty_start_block" env:"ROYALTY_START_BLOCK"`
+	TxStuckAfter         Duration `json:"tx_stuck_after" env:"TX_STUCK_AFTER"`
+	TxDroppedAfterChecks int      `json:"tx_dropped_after_checks" env:"TX_DROPPED_AFTER_CHECKS"`
+}
+
+// Oracle holds the valuation oracle providers, their guards and their cost
+type Oracle struct {
+	Providers        map[string]string `json:"providers" env:"ORACLE_PROVIDERS"` // Name to URL, aggregated
+	URL              string            `json:"url" env:"AI_ORACLE_URL"`          // A single oracle, without providers
+	MinAnswers       int               `json:"min_answers" env:"ORACLE_MIN_ANSWERS"`
+	OutlierThreshold float64           `json:"outlier_threshold" env:"ORACLE_OUTLIER_THRESHOLD"`
+	SignerAddress    string            `json:"signer_address" env:"ORACLE_SIGNER_ADDRESS"`
+	Timeout          Duration          `json:"timeout" env:"ORACLE_TIMEOUT"`
+	BreakerFailures  int               `json:"breaker_failures" env:"ORACLE_BREAKER_FAILURES"`
+	BreakerCooldown  Duration          `json:"breaker_cooldown" env:"ORACLE_BREAKER_COOLDOWN"`
+	CacheTTL         Duration          `json:"cache_ttl" env:"ORACLE_CACHE_TTL"`
+	BatchConcurrency int               `json:"batch_concurrency" env:"ORACLE_BATCH_CONCURRENCY"` // Assessments run at once by AssessIPRiskBatch
+
+	// Content fingerprinting against the bonds already issued
+	FingerprintURL         string `json:"fingerprint_url" env:"FINGERPRINT_ORACLE_URL"`            // The url when empty
+	DuplicateContentPolicy string `json:"duplicate_content_policy" env:"DUPLICATE_CONTENT_POLICY"` // reject, flag or off
+
+	// Prices and daily budgets in USD
+	UnitPriceUSD      string            `json:"unit_price_usd" env:"ORACLE_UNIT_PRICE_USD" reload:"true"`
+	ProviderPricesUSD map[string]string `json:"provider_prices_usd" env:"ORACLE_PROVIDER_PRICES_USD" reload:"true"`
+	DailySoftLimitUSD string            `json:"daily_soft_limit_usd" env:"ORACLE_DAILY_SOFT_LIMIT_USD" reload:"true"`
+	DailyHardLimitUSD string            `json:"daily_hard_limit_usd" env:"ORACLE_DAILY_HARD_LIMIT_USD" reload:"true"`
+}
+
+// Limits holds the rate limits applied to callers and to the operator wallet
+type Limits struct {
+	QuotasFile                string   `json:"quotas_file" env:"API_QUOTAS_FILE" reload:"true"` // Monthly API call quotas
+	UsageFlushInterval        Duration `json:"usage_flush_interval" env:"API_USAGE_FLUSH_INTERVAL"`
+	MaxConnections            int      `json:"max_connections" env:"GRPC_MAX_CONNECTIONS"` // 0 is unlimited
+	DistributionMaxTxPerBlock int      `json:"distribution_max_tx_per_block" env:"DISTRIBUTION_MAX_TX_PER_BLOCK"`
+
+	// Per gRPC connection; 0 keeps grpc's defaults
+	MaxConcurrentStreams int `json:"max_concurrent_streams" env:"GRPC_MAX_CONCURRENT_STREAMS"`
+	MaxRecvMsgSize       int `json:"max_recv_msg_size" env:"GRPC_MAX_RECV_MSG_SIZE"`
+	MaxSendMsgSize       int `json:"max_send_msg_size" env:"GRPC_MAX_SEND_MSG_SIZE"`
+}
+
+// Auth holds the credentials callers may present
+type Auth struct {
+	RolesFile     string   `json:"roles_file" env:"RBAC_ROLES_FILE"` // API key roles and tenants
+	JWTSecret     string   `json:"jwt_secret" env:"AUTH_JWT_SECRET"` // Signs wallet login tokens; no wallet login when empty
+	TokenTTL      Duration `json:"token_ttl" env:"AUTH_TOKEN_TTL"`
+	IssuerWallets []string `json:"issuer_wallets" env:"AUTH_ISSUER_WALLETS"` // Wallets granted the issuer role
+	WalletTenant  string   `json:"wallet_tenant" env:"AUTH_WALLET_TENANT"`
+
+	OIDCIssuer      string `json:"oidc_issuer" env:"OIDC_ISSUER"`
+	OIDCAudience    string `json:"oidc_audience" env:"OIDC_AUDIENCE"`
+	OIDCRolesClaim  string `json:"oidc_roles_claim" env:"OIDC_ROLES_CLAIM"`
+	OIDCTenantClaim string `json:"oidc_tenant_claim" env:"OIDC_TENANT_CLAIM"`
+
+	AdminUIToken           string   `json:"admin_ui_token" env:"ADMIN_UI_TOKEN"`                     // No admin dashboard when empty
+	EmergencyConfirmWindow Duration `json:"emergency_confirm_window" env:"EMERGENCY_CONFIRM_WINDOW"` // For a second operator to confirm an emergency withdrawal
+}
+
+// Maintenance holds how maintenance windows are announced and picked up
+type Maintenance struct {
+	WebhookURL     string   `json:"webhook_url" env:"MAINTENANCE_WEBHOOK_URL"`
+	AnnounceBefore Duration `json:"announce_before" env:"MAINTENANCE_ANNOUNCE_BEFORE"`
+	ReloadInterval Duration `json:"reload_interval" env:"MAINTENANCE_RELOAD_INTERVAL"`
+}
+
+// Jobs holds how often the background jobs run
+type Jobs struct {
+	AccessListReloadInterval  Duration `json:"access_list_reload_interval" env:"ACCESS_LIST_RELOAD_INTERVAL"`
+	FeatureFlagReloadInterval Duration `json:"feature_flag_reload_interval" env:"FEATURE_FLAG_RELOAD_INTERVAL"`
+	TaxonomyReloadInterval    Duration `json:"taxonomy_reload_interval" env:"TAXONOMY_RELOAD_INTERVAL"`
+	ReconcileInterval         Duration `json:"reconcile_interval" env:"RECONCILE_INTERVAL"`
+	ReconcileAutoCorrect      bool     `json:"reconcile_auto_correct" env:"RECONCILE_AUTO_CORRECT"` // Overwrite stored bond state with the chain's
+	ArchiveAfter              Duration `json:"archive_after" env:"ARCHIVE_AFTER"`
+	ArchiveInterval           Duration `json:"archive_interval" env:"ARCHIVE_INTERVAL"`
+	AccrualInterval           Duration `json:"accrual_interval" env:"ACCRUAL_INTERVAL"`
+	CommitmentInterval        Duration `json:"commitment_interval" env:"COMMITMENT_INTERVAL"` // 0 publishes no position roots
+	NotificationInterval      Duration `json:"notification_interval" env:"NOTIFICATION_INTERVAL"`
+}
+
+// Distribution holds the revenue distribution jobs and duplicate checks
+type Distribution struct {
+	BatchInterval        Duration `json:"batch_interval" env:"DISTRIBUTION_BATCH_INTERVAL"`
+	ScheduleInterval     Duration `json:"schedule_interval" env:"DISTRIBUTION_SCHEDULE_INTERVAL"`
+	DuplicateWindow      Duration `json:"duplicate_window" env:"DISTRIBUTION_DUPLICATE_WINDOW"`
+	DuplicateChainBlocks uint64   `json:"duplicate_chain_blocks" env:"DISTRIBUTION_DUPLICATE_CHAIN_BLOCKS"` // 0 skips the on-chain check
+}
+
+// Risk holds how bonds are rated and re-rated
+type Risk struct {
+	DefaultModel   string            `json:"default_model" env:"RISK_MODEL_DEFAULT"`
+	CategoryModels map[string]string `json:"category_models" env:"RISK_CATEGORY_MODELS"` // Category to model
+	Tiers          string            `json:"tiers" env:"RISK_TIERS"`                     // e.g. A=0.3,B=0.6,C
+
+	ReassessInterval      Duration `json:"reassess_interval" env:"RISK_REASSESS_INTERVAL"`
+	DowngradeAlertNotches int      `json:"downgrade_alert_notches" env:"RISK_DOWNGRADE_ALERT_NOTCHES"`
+
+	// Revenue missing its forecast by Threshold for Periods periods flags a review
+	RevenueVarianceThreshold float64  `json:"revenue_variance_threshold" env:"REVENUE_VARIANCE_THRESHOLD"`
+	RevenueVariancePeriods   int      `json:"revenue_variance_periods" env:"REVENUE_VARIANCE_PERIODS"`
+	RevenueVarianceInterval  Duration `json:"revenue_variance_interval" env:"REVENUE_VARIANCE_INTERVAL"`
+}
+
+// Operator holds the gas balance watch of the operator wallet and its
+// treasury top-ups. Amounts are in wei.
+type Operator struct {
+	MinBalance      string            `json:"min_balance" env:"OPERATOR_MIN_BALANCE"`
+	TopUpAmount     string            `json:"top_up_amount" env:"OPERATOR_TOP_UP_AMOUNT"`
+	BalanceInterval Duration          `json:"balance_interval" env:"OPERATOR_BALANCE_INTERVAL"`
+	TopUpCooldown   Duration          `json:"top_up_cooldown" env:"TOP_UP_COOLDOWN"`
+	TreasurySafes   map[string]string `json:"treasury_safes" env:"TREASURY_SAFES"` // Chain to SAFE@SERVICE_URL
+	SafeProposerKey string            `json:"safe_proposer_key" env:"SAFE_PROPOSER_KEY"`
+}
+
+// Integrations holds the external services and operator files the service
+// uses when configured
+type Integrations struct {
+	KYCProviders         map[string]string `json:"kyc_providers" env:"KYC_PROVIDERS"` // Verifier to webhook secret
+	EligibilityRulesFile string            `json:"eligibility_rules_file" env:"ELIGIBILITY_RULES_FILE"`
+	BusinessRulesFile    string            `json:"business_rules_file" env:"BUSINESS_RULES_FILE"`
+	RevenueWebhooks      map[string]string `json:"revenue_webhooks" env:"REVENUE_WEBHOOKS"` // Reporter to webhook secret
+
+	Hooks        []string `json:"hooks" env:"HOOKS"` // NAME=plugin:PATH or NAME=grpc:TARGET, run in order
+	HookTimeout  Duration `json:"hook_timeout" env:"HOOK_TIMEOUT"`
+	HookFailOpen bool     `json:"hook_fail_open" env:"HOOK_FAIL_OPEN"`
+
+	NotificationWebhookURL string `json:"notification_webhook_url" env:"NOTIFICATION_WEBHOOK_URL"`
+
+	RedisURL          string   `json:"redis_url" env:"REDIS_URL"` // Caches contract views and market analyses
+	ViewCacheTTL      Duration `json:"view_cache_ttl" env:"VIEW_CACHE_TTL"`
+	MarketAnalysisTTL Duration `json:"market_analysis_ttl" env:"MARKET_ANALYSIS_TTL"`
+
+	IPRegistryURLs   map[string]string `json:"ip_registry_urls" env:"IP_REGISTRY_URLS"` // Jurisdiction to registry gateway
+	IPRegistryAPIKey string            `json:"ip_registry_api_key" env:"IP_REGISTRY_API_KEY"`
+	IPFSGateways     []string          `json:"ipfs_gateways" env:"IPFS_GATEWAYS"`
+
+	ENSRPCURLs  []string `json:"ens_rpc_urls" env:"ENS_RPC_URLS"` // Mainnet endpoints; no ENS names when empty
+	ENSRegistry string   `json:"ens_registry" env:"ENS_REGISTRY_ADDRESS"`
+	ENSCacheTTL Duration `json:"ens_cache_ttl" env:"ENS_CACHE_TTL"`
+}
+
+// Documents holds where bond documents are stored and how long they are kept
+type Documents struct {
+	Storage string `json:"storage" env:"DOCUMENT_STORAGE"` // local, s3 or gcs; no documents when empty
+
+	// s3 and gcs
+	Endpoint  string `json:"endpoint" env:"DOCUMENT_ENDPOINT"`
+	Region    string `json:"region" env:"DOCUMENT_REGION"`
+	Bucket    string `json:"bucket" env:"DOCUMENT_BUCKET"`
+	AccessKey string `json:"access_key" env:"DOCUMENT_ACCESS_KEY"`
+	SecretKey string `json:"secret_key" env:"DOCUMENT_SECRET_KEY"`
+	PathStyle bool   `json:"path_style" env:"DOCUMENT_PATH_STYLE"`
+
+	// local
+	LocalDir      string `json:"local_dir" env:"DOCUMENT_LOCAL_DIR"`
+	BaseURL       string `json:"base_url" env:"DOCUMENT_BASE_URL"`
+	SigningSecret string `json:"signing_secret" env:"DOCUMENT_SIGNING_SECRET"`
+
+	ClamAVAddr string `json:"clamav_addr" env:"CLAMAV_ADDR"`      // Uploads are scanned when set
+	Retention  string `json:"retention" env:"DOCUMENT_RETENTION"` // KIND=DURATION pairs
+}
+
+// Sandbox holds the simulation chain sandbox API keys are served against
+type Sandbox struct {
+	APIKeyIDs      []string `json:"api_key_ids" env:"SANDBOX_API_KEY_IDS"` // No sandbox when empty
+	ChainID        int64    `json:"chain_id" env:"SANDBOX_CHAIN_ID"`
+	RPCURL         string   `json:"rpc_url" env:"SANDBOX_RPC_URL"`
+	IPBondContract string   `json:"ipbond_contract" env:"SANDBOX_IPBOND_CONTRACT_ADDRESS"`
+	PrivateKey     string   `json:"private_key" env:"SANDBOX_PRIVATE_KEY"`
+}
+
+// Default returns the configuration used when nothing is set
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Port:            "50051",
+			MetricsPort:     "9090",
+			DeadlineMargin:  Duration{100 * time.Millisecond},
+			DefaultTimeout:  Duration{30 * time.Second},
+			ShutdownTimeout: Duration{30 * time.Second},
+
+			ConsistencyTimeout: Duration{2 * time.Second},
+		},
+		Database: Database{
+			URL:             "host=localhost user=postgres password=postgres dbname=knowton port=5432 sslmode=disable",
+			MaxOpenConns:    database.DefaultConfig().MaxOpenConns,
+			MaxIdleConns:    database.DefaultConfig().MaxIdleConns,
+			ConnMaxLifetime: Duration{database.DefaultConfig().ConnMaxLifetime},
+			ConnMaxIdleTime: Duration{database.DefaultConfig().ConnMaxIdleTime},
+		},
+		Chains: Chains{
+			RPCHealthInterval: Duration{rpcpool.DefaultConfig().HealthInterval},
+			RPCMaxBlockLag:    rpcpool.DefaultConfig().MaxBlockLag,
+			MaxHeadAge:        Duration{2 * time.Minute},
+			MaxIndexerLag:     500,
+
+			IndexerReorgWindow:   5000,
+			TxStuckAfter:         Duration{5 * time.Minute},
+			TxDroppedAfterChecks: 3,
+		},
+		Oracle: Oracle{
+			MinAnswers:       2,
+			OutlierThreshold: 3,
+			Timeout:          Duration{oracle.DefaultGuardConfig().Timeout},
+			BreakerFailures:  oracle.DefaultGuardConfig().FailureThreshold,
+			BreakerCooldown:  Duration{oracle.DefaultGuardConfig().Cooldown},
+			CacheTTL:         Duration{oracle.DefaultGuardConfig().CacheTTL},
+			BatchConcurrency: 8,
+			UnitPriceUSD:     "0",
+
+			DuplicateContentPolicy: "reject",
+			DailySoftLimitUSD:      "0",
+			DailyHardLimitUSD:      "0",
+		},
+		Limits: Limits{
+			UsageFlushInterval:        Duration{usage.DefaultConfig().FlushInterval},
+			DistributionMaxTxPerBlock: 10,
+		},
+		Auth: Auth{
+			TokenTTL:               Duration{24 * time.Hour},
+			OIDCRolesClaim:         "roles",
+			OIDCTenantClaim:        "tenant",
+			EmergencyConfirmWindow: Duration{time.Hour},
+		},
+		Maintenance: Maintenance{
+			AnnounceBefore: Duration{24 * time.Hour},
+			ReloadInterval: Duration{15 * time.Second},
+		},
+		Jobs: Jobs{
+			AccessListReloadInterval:  Duration{15 * time.Second},
+			FeatureFlagReloadInterval: Duration{15 * time.Second},
+			TaxonomyReloadInterval:    Duration{time.Minute},
+			ReconcileInterval:         Duration{time.Hour},
+			ArchiveAfter:              Duration{180 * 24 * time.Hour},
+			ArchiveInterval:           Duration{24 * time.Hour},
+			AccrualInterval:           Duration{time.Hour},
+			NotificationInterval:      Duration{30 * time.Second},
+		},
+		Distribution: Distribution{
+			BatchInterval:        Duration{5 * time.Minute},
+			ScheduleInterval:     Duration{time.Minute},
+			DuplicateWindow:      Duration{24 * time.Hour},
+			DuplicateChainBlocks: 350000,
+		},
+		Risk: Risk{
+			DefaultModel:             risk.HeuristicModel,
+			ReassessInterval:         Duration{24 * time.Hour},
+			DowngradeAlertNotches:    1,
+			RevenueVarianceThreshold: 0.2,
+			RevenueVariancePeriods:   2,
+			RevenueVarianceInterval:  Duration{time.Hour},
+		},
+		Operator: Operator{
+			MinBalance:      "50000000000000000", // 0.05 ETH
+			BalanceInterval: Duration{time.Minute},
+			TopUpCooldown:   Duration{6 * time.Hour},
+		},
+		Integrations: Integrations{
+			HookTimeout:       Duration{2 * time.Second},
+			ViewCacheTTL:      Duration{15 * time.Second},
+			MarketAnalysisTTL: Duration{10 * time.Minute},
+			ENSCacheTTL:       Duration{10 * time.Minute},
+		},
+		Documents: Documents{
+			LocalDir: "./data/documents",
+			BaseURL:  "http://localhost:9090/documents",
+		},
+		Sandbox: Sandbox{
+			ChainID: 31337,
+			RPCURL:  "http://localhost:8545",
+		},
+	}
+}
+
+// Load reads the YAML file at path, if any, over the defaults, applies the
+// environment and validates the result
+func Load(path string) (*Config, error) {
+	c := Default()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+		if err := c.parse(data); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+		}
+	}
+	if err := applyEnv(c, os.LookupEnv); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// parse decodes YAML over c. The document goes through JSON so the chain and
+// gas types keep their JSON field names, and unknown keys are rejected.
+func (c *Config) parse(data []byte) error {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	if document == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(c)
+}
+
+// Validate checks every setting, reporting all the invalid ones
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	for name, port := range map[string]string{"server.port": c.Server.Port, "server.metrics_port": c.Server.MetricsPort} {
+		n, err := strconv.Atoi(port)
+		check(err == nil && n > 0 && n < 65536, "%s: %q is not a port", name, port)
+	}
+	check(c.Server.DeadlineMargin.Duration >= 0, "server.deadline_margin must not be negative")
+	check(c.Server.DefaultTimeout.Duration >= 0, "server.default_timeout must not be negative")
+	check(c.Server.ShutdownTimeout.Duration > 0, "server.shutdown_timeout must be positive")
+	check(strings.TrimSpace(c.Database.URL) != "", "database.url is required")
+	for _, url := range c.Database.ReplicaURLs {
+		check(strings.TrimSpace(url) != "", "database.replica_urls must not contain empty URLs")
+	}
+	check(c.Database.MaxOpenConns >= 0, "database.max_open_conns must not be negative")
+	check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns must not be negative")
+	check(c.Database.MaxOpenConns == 0 || c.Database.MaxIdleConns <= c.Database.MaxOpenConns,
+		"database.max_idle_conns: %d idle connections exceed max_open_conns %d", c.Database.MaxIdleConns, c.Database.MaxOpenConns)
+	check(c.Database.ConnMaxLifetime.Duration >= 0, "database.conn_max_lifetime must not be negative")
+	check(c.Database.ConnMaxIdleTime.Duration >= 0, "database.conn_max_idle_time must not be negative")
+
+	for name, address := range map[string]string{
+		"chains.ipbond_contract":     c.Chains.IPBondContract,
+		"chains.copyright_registry":  c.Chains.CopyrightRegistry,
+		"chains.price_feed":          c.Chains.PriceFeed,
+		"chains.royalty_distributor": c.Chains.RoyaltyDistributor,
+		"chains.expected_admin":      c.Chains.ExpectedAdmin,
+		"oracle.signer_address":      c.Oracle.SignerAddress,
+	} {
+		check(address == "" || common.IsHexAddress(address), "%s: %q is not an address", name, address)
+	}
+	check(c.Chains.RPCHealthInterval.Duration > 0, "chains.rpc_health_interval must be positive")
+	check(c.Chains.MaxHeadAge.Duration > 0, "chains.max_head_age must be positive")
+	if _, err := c.Chains.NewRegistry(); err != nil {
+		errs = append(errs, fmt.Errorf("chains: %w", err))
+	}
+
+	for name, url := range c.Oracle.Providers {
+		check(name != "" && url != "", "oracle.providers: expected NAME=URL, got %q=%q", name, url)
+	}
+	check(c.Oracle.MinAnswers > 0, "oracle.min_answers must be positive")
+	check(len(c.Oracle.Providers) == 0 || c.Oracle.MinAnswers <= len(c.Oracle.Providers),
+		"oracle.min_answers: %d providers can't give %d answers", len(c.Oracle.Providers), c.Oracle.MinAnswers)
+	check(c.Oracle.OutlierThreshold >= 0, "oracle.outlier_threshold must not be negative")
+	check(c.Oracle.Timeout.Duration >= 0, "oracle.timeout must not be negative")
+	check(c.Oracle.BreakerFailures >= 0, "oracle.breaker_failures must not be negative")
+	check(c.Oracle.CacheTTL.Duration >= 0, "oracle.cache_ttl must not be negative")
+	check(c.Oracle.BatchConcurrency > 0, "oracle.batch_concurrency must be positive")
+	if _, err := c.Oracle.SpendConfig(); err != nil {
+		errs = append(errs, err)
+	}
+
+	check(c.Limits.UsageFlushInterval.Duration > 0, "limits.usage_flush_interval must be positive")
+	check(c.Limits.MaxConnections >= 0, "limits.max_connections must not be negative")
+	check(c.Limits.DistributionMaxTxPerBlock > 0, "limits.distribution_max_tx_per_block must be positive")
+	if _, err := c.Limits.UsageConfig(); err != nil {
+		errs = append(errs, fmt.Errorf("limits.quotas_file: %w", err))
+	}
+	check(c.Limits.MaxConcurrentStreams >= 0, "limits.max_concurrent_streams must not be negative")
+	check(c.Limits.MaxRecvMsgSize >= 0, "limits.max_recv_msg_size must not be negative")
+	check(c.Limits.MaxSendMsgSize >= 0, "limits.max_send_msg_size must not be negative")
+
+	c.validateServer(check)
+	c.validateChains(check)
+	errs = append(errs, c.validateAuth(check)...)
+	c.validateJobs(check)
+	errs = append(errs, c.validateIntegrations(check)...)
+	return errors.Join(errs...)
+}
+
+// checkFunc records an error unless ok
+type checkFunc func(ok bool, format string, args ...interface{})
+
+// validateServer checks the gRPC transport settings
+func (c *Config) validateServer(check checkFunc) {
+	check(c.Server.TLSKeyFile == "" || c.Server.TLSCertFile != "", "server.tls_key_file requires tls_cert_file")
+	check(c.Server.TLSCertFile == "" || c.Server.TLSKeyFile != "", "server.tls_cert_file requires tls_key_file")
+	check(c.Server.TLSClientCAFile == "" || c.Server.TLSCertFile != "", "server.tls_client_ca_file requires tls_cert_file")
+	for name, d := range map[string]Duration{
+		"server.keepalive_time":           c.Server.KeepaliveTime,
+		"server.keepalive_timeout":        c.Server.KeepaliveTimeout,
+		"server.keepalive_min_time":       c.Server.KeepaliveMinTime,
+		"server.max_connection_idle":      c.Server.MaxConnectionIdle,
+		"server.max_connection_age":       c.Server.MaxConnectionAge,
+		"server.max_connection_age_grace": c.Server.MaxConnectionAgeGrace,
+		"server.connection_timeout":       c.Server.ConnectionTimeout,
+		"server.gateway_cache_max_age":    c.Server.GatewayCacheMaxAge,
+	} {
+		check(d.Duration >= 0, "%s must not be negative", name)
+	}
+	check(c.Server.ConsistencyTimeout.Duration > 0, "server.consistency_timeout must be positive")
+}
+
+// validateChains checks the chain jobs and the operator wallet
+func (c *Config) validateChains(check checkFunc) {
+	check(c.Chains.OperatorKey == "" || validKey(c.Chains.OperatorKey), "chains.operator_key is not a private key")
+	check(c.Chains.IndexerReorgWindow > 0, "chains.indexer_reorg_window must be positive")
+	check(c.Chains.TxStuckAfter.Duration > 0, "chains.tx_stuck_after must be positive")
+	check(c.Chains.TxDroppedAfterChecks > 0, "chains.tx_dropped_after_checks must be positive")
+
+	_, minOK := wei(c.Operator.MinBalance)
+	check(minOK, "operator.min_balance: %q is not an amount of wei", c.Operator.MinBalance)
+	topUp, topUpOK := wei(c.Operator.TopUpAmount)
+	check(c.Operator.TopUpAmount == "" || (topUpOK && topUp.Sign() > 0), "operator.top_up_amount: %q is not a positive amount of wei", c.Operator.TopUpAmount)
+	check(c.Operator.BalanceInterval.Duration > 0, "operator.balance_interval must be positive")
+	check(c.Operator.TopUpCooldown.Duration >= 0, "operator.top_up_cooldown must not be negative")
+	if len(c.Operator.TreasurySafes) > 0 {
+		check(c.Operator.TopUpAmount != "", "operator.treasury_safes requires top_up_amount")
+		check(validKey(c.Operator.SafeProposerKey), "operator.safe_proposer_key is not a private key")
+	}
+	for chain, target := range c.Operator.TreasurySafes {
+		safe, serviceURL, ok := strings.Cut(target, "@")
+		check(ok && serviceURL != "" && common.IsHexAddress(safe), "operator.treasury_safes: expected %s=SAFE@SERVICE_URL, got %q", chain, target)
+	}
+}
+
+// validateAuth checks the credentials, loading the API key roles file
+func (c *Config) validateAuth(check checkFunc) []error {
+	check(c.Auth.JWTSecret == "" || len(c.Auth.JWTSecret) >= 32, "auth.jwt_secret must be at least 32 bytes")
+	check(c.Auth.TokenTTL.Duration > 0, "auth.token_ttl must be positive")
+	for _, address := range c.Auth.IssuerWallets {
+		check(address == "" || common.IsHexAddress(address), "auth.issuer_wallets: %q is not an address", address)
+	}
+	check(c.Auth.OIDCIssuer == "" || c.Auth.OIDCAudience != "", "auth.oidc_issuer requires oidc_audience")
+	check(c.Auth.OIDCRolesClaim != "", "auth.oidc_roles_claim is required")
+	check(c.Auth.OIDCTenantClaim != "", "auth.oidc_tenant_claim is required")
+	check(c.Auth.EmergencyConfirmWindow.Duration > 0, "auth.emergency_confirm_window must be positive")
+	if _, err := c.Auth.KeyRoles(); err != nil {
+		return []error{fmt.Errorf("auth.roles_file: %w", err)}
+	}
+	return nil
+}
+
+// validateJobs checks the background jobs and risk ratings
+func (c *Config) validateJobs(check checkFunc) {
+	check(c.Maintenance.AnnounceBefore.Duration >= 0, "maintenance.announce_before must not be negative")
+	check(c.Maintenance.ReloadInterval.Duration > 0, "maintenance.reload_interval must be positive")
+
+	for name, d := range map[string]Duration{
+		"jobs.access_list_reload_interval":  c.Jobs.AccessListReloadInterval,
+		"jobs.feature_flag_reload_interval": c.Jobs.FeatureFlagReloadInterval,
+		"jobs.taxonomy_reload_interval":     c.Jobs.TaxonomyReloadInterval,
+		"jobs.reconcile_interval":           c.Jobs.ReconcileInterval,
+		"jobs.archive_after":                c.Jobs.ArchiveAfter,
+		"jobs.archive_interval":             c.Jobs.ArchiveInterval,
+		"jobs.accrual_interval":             c.Jobs.AccrualInterval,
+		"jobs.notification_interval":        c.Jobs.NotificationInterval,
+		"distribution.batch_interval":       c.Distribution.BatchInterval,
+		"distribution.schedule_interval":    c.Distribution.ScheduleInterval,
+		"risk.reassess_interval":            c.Risk.ReassessInterval,
+		"risk.revenue_variance_interval":    c.Risk.RevenueVarianceInterval,
+	} {
+		check(d.Duration > 0, "%s must be positive", name)
+	}
+	check(c.Jobs.CommitmentInterval.Duration >= 0, "jobs.commitment_interval must not be negative")
+	check(c.Distribution.DuplicateWindow.Duration >= 0, "distribution.duplicate_window must not be negative")
+
+	check(c.Risk.DefaultModel != "", "risk.default_model is required")
+	for category, model := range c.Risk.CategoryModels {
+		check(category != "" && model != "", "risk.category_models: expected CATEGORY=MODEL, got %q=%q", category, model)
+	}
+	if _, err := risk.ParseTiers(c.Risk.Tiers); err != nil {
+		check(false, "risk.tiers: %v", err)
+	}
+	check(c.Risk.DowngradeAlertNotches >= 0, "risk.downgrade_alert_notches must not be negative")
+	check(c.Risk.RevenueVarianceThreshold > 0, "risk.revenue_variance_threshold must be positive")
+	check(c.Risk.RevenueVariancePeriods > 0, "risk.revenue_variance_periods must be positive")
+}
+
+// validateIntegrations checks the optional services, documents and sandbox
+func (c *Config) validateIntegrations(check checkFunc) []error {
+	check(slices.Contains([]string{"reject", "flag", "off"}, c.Oracle.DuplicateContentPolicy),
+		"oracle.duplicate_content_policy: %q is not reject, flag or off", c.Oracle.DuplicateContentPolicy)
+
+	for name, pairs := range map[string]map[string]string{
+		"integrations.kyc_providers":    c.Integrations.KYCProviders,
+		"integrations.revenue_webhooks": c.Integrations.RevenueWebhooks,
+		"integrations.ip_registry_urls": c.Integrations.IPRegistryURLs,
+	} {
+		for key, value := range pairs {
+			check(key != "" && value != "", "%s: %q has no value", name, key)
+		}
+	}
+	for _, spec := range c.Integrations.Hooks {
+		name, target, ok := strings.Cut(spec, "=")
+		kind, location, hasKind := strings.Cut(target, ":")
+		check(ok && name != "" && hasKind && location != "" && (kind == "plugin" || kind == "grpc"),
+			"integrations.hooks: expected NAME=plugin:PATH or NAME=grpc:TARGET, got %q", spec)
+	}
+	check(c.Integrations.HookTimeout.Duration > 0, "integrations.hook_timeout must be positive")
+	check(c.Integrations.ViewCacheTTL.Duration > 0, "integrations.view_cache_ttl must be positive")
+	check(c.Integrations.MarketAnalysisTTL.Duration >= 0, "integrations.market_analysis_ttl must not be negative")
+	check(c.Integrations.ENSRegistry == "" || common.IsHexAddress(c.Integrations.ENSRegistry),
+		"integrations.ens_registry: %q is not an address", c.Integrations.ENSRegistry)
+	check(c.Integrations.ENSCacheTTL.Duration >= 0, "integrations.ens_cache_ttl must not be negative")
+
+	check(slices.Contains([]string{"", "local", "s3", "gcs"}, c.Documents.Storage),
+		"documents.storage: %q is not local, s3 or gcs", c.Documents.Storage)
+	check(c.Sandbox.ChainID > 0, "sandbox.chain_id must be positive")
+	check(c.Sandbox.IPBondContract == "" || common.IsHexAddress(c.Sandbox.IPBondContract),
+		"sandbox.ipbond_contract: %q is not an address", c.Sandbox.IPBondContract)
+	check(c.Sandbox.PrivateKey == "" || validKey(c.Sandbox.PrivateKey), "sandbox.private_key is not a private key")
+
+	if _, err := documents.ParseRetention(c.Documents.Retention); err != nil {
+		return []error{fmt.Errorf("documents.retention: %w", err)}
+	}
+	return nil
+}
+
+// validKey reports whether key is a hex private key, with or without 0x
+func validKey(key string) bool {
+	_, err := crypto.HexToECDSA(strings.TrimPrefix(key, "0x"))
+	return err == nil
+}
+
+// wei parses a non-negative decimal amount of wei
+func wei(amount string) (*big.Int, bool) {
+	n, ok := new(big.Int).SetString(amount, 10)
+	return n, ok && n.Sign() >= 0
+}
+
+// NewRegistry builds the chain registry: the registry file or the built-in
+// chains with the Arbitrum settings applied, then the inline chains and gas
+// policies, restricted to the enabled chains
+func (c Chains) NewRegistry() (*chains.Registry, error) {
+	var registry *chains.Registry
+	if c.RegistryFile != "" {
+		loaded, err := chains.LoadFile(c.RegistryFile)
+		if err != nil {
+			return nil, err
+		}
+		registry = loaded
+	} else {
+		registry = chains.Builtin()
+		arbitrum, _ := registry.Get(chains.Arbitrum)
+		if len(c.ArbitrumRPCURLs) > 0 {
+			arbitrum.RPCURLs = c.ArbitrumRPCURLs
+		}
+		if len(c.ArbitrumWSURLs) > 0 {
+			arbitrum.WSURLs = c.ArbitrumWSURLs
+		}
+		arbitrum.Contracts.IPBond = c.IPBondContract
+		arbitrum.Contracts.CopyrightRegistry = c.CopyrightRegistry
+		arbitrum.Contracts.PriceFeed = c.PriceFeed
+		arbitrum.Contracts.RoyaltyDistributor = c.RoyaltyDistributor
+	}
+	for i := range c.Registry {
+		chain := c.Registry[i]
+		if err := registry.Register(&chain); err != nil {
+			return nil, err
+		}
+	}
+	for name, gas := range c.Gas {
+		chain, err := registry.Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("gas policy for %w", err)
+		}
+		updated := *chain
+		updated.Gas = gas
+		if err := registry.Register(&updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Default != "" {
+		if err := registry.SetDefault(c.Default); err != nil {
+			return nil, err
+		}
+	}
+	enabled := c.Enabled
+	if len(enabled) == 0 {
+		enabled = []string{registry.Default().Name}
+	}
+	return registry.Subset(enabled...)
+}
+
+// PoolConfig returns the connection pool and replica settings
+func (d Database) PoolConfig() database.Config {
+	return database.Config{
+		ReplicaURLs:     d.ReplicaURLs,
+		MaxOpenConns:    d.MaxOpenConns,
+		MaxIdleConns:    d.MaxIdleConns,
+		ConnMaxLifetime: d.ConnMaxLifetime.Duration,
+		ConnMaxIdleTime: d.ConnMaxIdleTime.Duration,
+	}
+}
+
+// PoolConfig returns the RPC health check and failover settings
+func (c Chains) PoolConfig() rpcpool.Config {
+	config := rpcpool.DefaultConfig()
+	config.HealthInterval = c.RPCHealthInterval.Duration
+	config.MaxBlockLag = c.RPCMaxBlockLag
+	return config
+}
+
+// GuardConfig returns the timeout, circuit breaker and cache settings each
+// oracle is called with
+func (o Oracle) GuardConfig() oracle.GuardConfig {
+	return oracle.GuardConfig{
+		Timeout:          o.Timeout.Duration,
+		FailureThreshold: o.BreakerFailures,
+		Cooldown:         o.BreakerCooldown.Duration,
+		CacheTTL:         o.CacheTTL.Duration,
+	}
+}
+
+// AggregatorConfig returns how the providers' answers are combined
+func (o Oracle) AggregatorConfig() oracle.AggregatorConfig {
+	config := oracle.DefaultAggregatorConfig()
+	config.MinAnswers = o.MinAnswers
+	config.OutlierThreshold = o.OutlierThreshold
+	return config
+}
+
+// SpendConfig returns oracle prices and daily limits in millionths of a USD
+func (o Oracle) SpendConfig() (usage.SpendConfig, error) {
+	config := usage.DefaultSpendConfig()
+	var err error
+	if config.UnitPrice, err = usage.ParseUSD(o.UnitPriceUSD); err != nil {
+		return config, fmt.Errorf("oracle.unit_price_usd: %w", err)
+	}
+	if len(o.ProviderPricesUSD) > 0 {
+		config.ProviderPrices = make(map[string]int64, len(o.ProviderPricesUSD))
+		for name, price := range o.ProviderPricesUSD {
+			if config.ProviderPrices[name], err = usage.ParseUSD(price); err != nil {
+				return config, fmt.Errorf("oracle.provider_prices_usd.%s: %w", name, err)
+			}
+		}
+	}
+	if config.DailySoftLimit, err = usage.ParseUSD(o.DailySoftLimitUSD); err != nil {
+		return config, fmt.Errorf("oracle.daily_soft_limit_usd: %w", err)
+	}
+	if config.DailyHardLimit, err = usage.ParseUSD(o.DailyHardLimitUSD); err != nil {
+		return config, fmt.Errorf("oracle.daily_hard_limit_usd: %w", err)
+	}
+	return config, nil
+}
+
+// UsageConfig returns the API usage settings, with the quotas file loaded
+func (l Limits) UsageConfig() (usage.Config, error) {
+	config := usage.DefaultConfig()
+	config.FlushInterval = l.UsageFlushInterval.Duration
+	if l.QuotasFile != "" {
+		quotas, err := usage.LoadQuotas(l.QuotasFile)
+		if err != nil {
+			return config, err
+		}
+		config.Quotas = quotas
+	}
+	return config, nil
+}
+
+// KeyRoles loads the API key roles file, nil when none is set
+func (a Auth) KeyRoles() (rbac.KeyRoles, error) {
+	if a.RolesFile == "" {
+		return nil, nil
+	}
+	return rbac.LoadKeyRoles(a.RolesFile)
+}
+
+// Issuers returns the wallets granted the issuer role
+func (a Auth) Issuers() []common.Address {
+	var issuers []common.Address
+	for _, address := range a.IssuerWallets {
+		if address != "" {
+			issuers = append(issuers, common.HexToAddress(address))
+		}
+	}
+	return issuers
+}
+
+// Balances returns the operator wallet's minimum balance and the amount a
+// top-up proposes, nil for none
+func (o Operator) Balances() (minBalance, topUp *big.Int) {
+	minBalance, _ = wei(o.MinBalance)
+	if o.TopUpAmount != "" {
+		topUp, _ = wei(o.TopUpAmount)
+	}
+	return minBalance, topUp
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/chains"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `
+server:
+  port: "6000"
+  shutdown_timeout: 1m
+chains:
+  registry:
+    - name: devnet
+      chain_id: 31337
+      rpc_urls: [http://localhost:8545]
+  default: devnet
+  gas:
+    devnet: {mode: eip1559, max_gas_price: "5000000000"}
+oracle:
+  providers: {primary: http://oracle-a:8000, backup: http://oracle-b:8000}
+  daily_hard_limit_usd: "25"
+`)
+	t.Setenv("GRPC_PORT", "7000")
+	t.Setenv("ORACLE_TIMEOUT", "2s")
+	t.Setenv("DATABASE_REPLICA_URLS", "host=replica-a, host=replica-b")
+	t.Setenv("KYC_PROVIDERS", "onfido=secret-a,persona=secret-b")
+	t.Setenv("RECONCILE_AUTO_CORRECT", "true")
+	t.Setenv("SANDBOX_CHAIN_ID", "1337")
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if c.Server.Port != "7000" {
+		t.Errorf("port = %s, want the environment's 7000", c.Server.Port)
+	}
+	if c.Server.ShutdownTimeout.Duration != time.Minute {
+		t.Errorf("shutdown timeout = %s, want the file's 1m", c.Server.ShutdownTimeout)
+	}
+	if c.Oracle.Timeout.Duration != 2*time.Second || c.Oracle.MinAnswers != 2 {
+		t.Errorf("oracle timeout = %s, min answers = %d, want 2s and the default 2", c.Oracle.Timeout, c.Oracle.MinAnswers)
+	}
+	if len(c.Integrations.KYCProviders) != 2 || c.Integrations.KYCProviders["persona"] != "secret-b" {
+		t.Errorf("kyc providers = %v, want onfido and persona", c.Integrations.KYCProviders)
+	}
+	if !c.Jobs.ReconcileAutoCorrect || c.Sandbox.ChainID != 1337 || c.Jobs.TaxonomyReloadInterval.Duration != time.Minute {
+		t.Errorf("jobs = %+v, sandbox chain = %d, want auto-correct, chain 1337 and the default reload", c.Jobs, c.Sandbox.ChainID)
+	}
+	if pool := c.Database.PoolConfig(); len(pool.ReplicaURLs) != 2 || pool.MaxOpenConns != 25 || pool.ConnMaxLifetime != 30*time.Minute {
+		t.Errorf("database pool = %+v, want two replicas and the default pool", pool)
+	}
+	if spend, _ := c.Oracle.SpendConfig(); spend.DailyHardLimit != 25_000_000 {
+		t.Errorf("hard limit = %d micros, want $25", spend.DailyHardLimit)
+	}
+
+	registry, err := c.Chains.NewRegistry()
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	devnet := registry.Default()
+	if devnet.Name != "devnet" || devnet.Gas.Mode != chains.GasEIP1559 || devnet.Gas.MultiplierBps != 10000 {
+		t.Errorf("default chain = %s with gas %+v, want devnet on eip1559 with the default multiplier", devnet.Name, devnet.Gas)
+	}
+	if names := registry.Names(); len(names) != 1 {
+		t.Errorf("enabled chains = %v, want only the default", names)
+	}
+}
+
+func TestLoadRejectsInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		want string
+	}{
+		{"unknown key", "server:\n  prot: \"6000\"\n", nil, "unknown field"},
+		{"bad duration", "oracle:\n  timeout: soon\n", nil, "time: invalid duration"},
+		{"bad port env", "", map[string]string{"GRPC_PORT": "grpc"}, "server.port"},
+		{"bad number env", "", map[string]string{"RPC_MAX_BLOCK_LAG": "-1"}, "invalid RPC_MAX_BLOCK_LAG"},
+		{"too few providers", "oracle:\n  providers: {primary: http://a}\n  min_answers: 2\n", nil, "oracle.min_answers"},
+		{"bad address", "", map[string]string{"ORACLE_SIGNER_ADDRESS": "0x1234"}, "oracle.signer_address"},
+		{"bad gas mode", "chains:\n  gas:\n    arbitrum: {mode: turbo}\n", nil, "unknown gas mode"},
+		{"gas for unknown chain", "chains:\n  gas:\n    base: {mode: legacy}\n", nil, "unknown chain: base"},
+		{"bad price", "", map[string]string{"ORACLE_UNIT_PRICE_USD": "cheap"}, "oracle.unit_price_usd"},
+		{"idle over open", "database:\n  max_open_conns: 5\n  max_idle_conns: 10\n", nil, "database.max_idle_conns"},
+		{"bad bool env", "", map[string]string{"HOOK_FAIL_OPEN": "sometimes"}, "invalid HOOK_FAIL_OPEN"},
+		{"bad hook", "integrations:\n  hooks: [audit=wasm:/hooks/audit]\n", nil, "integrations.hooks"},
+		{"safes without top-up", "operator:\n  treasury_safes: {arbitrum: 0x1111111111111111111111111111111111111111@https://safe}\n", nil, "operator.treasury_safes requires top_up_amount"},
+		{"bad duplicate policy", "", map[string]string{"DUPLICATE_CONTENT_POLICY": "warn"}, "oracle.duplicate_content_policy"},
+		{"oidc without audience", "", map[string]string{"OIDC_ISSUER": "https://id.example.com"}, "auth.oidc_issuer requires oidc_audience"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			_, err := Load(writeConfig(t, tt.file))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want one mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	path := writeConfig(t, "server:\n  port: \"6000\"\noracle:\n  daily_hard_limit_usd: \"10\"\n")
+	current, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	r := NewReloader(path, current)
+	var applied *Config
+	r.OnReload(func(c *Config) error {
+		applied = c
+		return nil
+	})
+
+	// The limit is reloadable, the port isn't
+	if err := os.WriteFile(path, []byte("server:\n  port: \"6001\"\noracle:\n  daily_hard_limit_usd: \"20\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	next, err := r.Reload()
+	if err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if applied != next || next.Oracle.DailyHardLimitUSD != "20" || next.Server.Port != "6000" {
+		t.Errorf("Reload() = limit %s, port %s, want the new limit on the old port",
+			next.Oracle.DailyHardLimitUSD, next.Server.Port)
+	}
+
+	// An invalid file leaves the configuration as it was
+	applied = nil
+	if err := os.WriteFile(path, []byte("oracle:\n  daily_hard_limit_usd: lots\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reload(); err == nil || applied != nil {
+		t.Errorf("Reload() of an invalid file error = %v, applied = %v", err, applied != nil)
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// applyEnv overrides each field tagged env with its variable, when set and
+// not empty. Lists are comma separated and maps are NAME=VALUE lists.
+func applyEnv(c *Config, lookup func(string) (string, bool)) error {
+	var errs []error
+	walk(reflect.ValueOf(c).Elem(), func(field reflect.Value, tag reflect.StructTag) {
+		name := tag.Get("env")
+		if name == "" {
+			return
+		}
+		value, ok := lookup(name)
+		if !ok || value == "" {
+			return
+		}
+		if err := setField(field, value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", name, err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// walk calls fn with every field of the config's sections
+func walk(config reflect.Value, fn func(field reflect.Value, tag reflect.StructTag)) {
+	for i := 0; i < config.NumField(); i++ {
+		section := config.Field(i)
+		for j := 0; j < section.NumField(); j++ {
+			fn(section.Field(j), section.Type().Field(j).Tag)
+		}
+	}
+}
+
+func setField(field reflect.Value, value string) error {
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(n)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+		field.Set(reflect.ValueOf(items))
+	case reflect.Map:
+		pairs := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			name, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || name == "" {
+				return fmt.Errorf("expected NAME=VALUE, got %q", pair)
+			}
+			pairs[name] = v
+		}
+		field.Set(reflect.ValueOf(pairs))
+	default:
+		return fmt.Errorf("unsupported setting type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+)
+
+// Reloader re-reads the configuration on SIGHUP. Only fields tagged reload
+// change; changes to the others are logged and wait for a restart.
+type Reloader struct {
+	path string
+
+	mu      sync.Mutex
+	current *Config
+	apply   []func(*Config) error
+}
+
+// NewReloader creates a reloader of the configuration loaded from path
+func NewReloader(path string, current *Config) *Reloader {
+	return &Reloader{path: path, current: current}
+}
+
+// OnReload registers a function applying a reloaded configuration
+func (r *Reloader) OnReload(apply func(*Config) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.apply = append(r.apply, apply)
+}
+
+// Start reloads on every SIGHUP until the context is cancelled
+func (r *Reloader) Start(ctx context.Context) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	defer signal.Stop(hangups)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangups:
+			if _, err := r.Reload(); err != nil {
+				log.Printf("Configuration not reloaded: %v", err)
+			}
+		}
+	}
+}
+
+// Reload loads the configuration again and applies its reloadable fields.
+// The current configuration is kept if the new one is invalid or an apply
+// function fails.
+func (r *Reloader) Reload() (*Config, error) {
+	loaded, err := Load(r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	next := *r.current
+	merge(reflect.ValueOf(&next).Elem(), reflect.ValueOf(loaded).Elem())
+	for _, apply := range r.apply {
+		if err := apply(&next); err != nil {
+			return nil, err
+		}
+	}
+	r.current = &next
+	log.Printf("Configuration reloaded")
+	return &next, nil
+}
+
+// merge copies the reloadable fields of loaded into next, logging changes to
+// the others
+func merge(next, loaded reflect.Value) {
+	for i := 0; i < next.NumField(); i++ {
+		section, loadedSection := next.Field(i), loaded.Field(i)
+		for j := 0; j < section.NumField(); j++ {
+			field := section.Type().Field(j)
+			if reflect.DeepEqual(section.Field(j).Interface(), loadedSection.Field(j).Interface()) {
+				continue
+			}
+			if field.Tag.Get("reload") == "true" {
+				section.Field(j).Set(loadedSection.Field(j))
+			} else {
+				log.Printf("%s.%s changed; restart to apply it", next.Type().Field(i).Tag.Get("json"), field.Tag.Get("json"))
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Package consistency gives clients read-your-writes across replicas and
+// caches. Write RPCs return a token naming the database's write-ahead log
+// position once the write committed; a read presenting the token waits until
+// the database it reads from has applied that position, and skips caches that
+// may predate it.
+package consistency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Header is the gRPC metadata key carrying a token, on write responses and
+// read requests
+const Header = "x-consistency-token"
+
+// HTTPHeader is the gateway request header carrying a token
+const HTTPHeader = "X-Consistency-Token"
+
+// DefaultTimeout is how long a read waits for its token to be applied
+const DefaultTimeout = 2 * time.Second
+
+// pollInterval is how often a waiting read checks the applied position
+const pollInterval = 20 * time.Millisecond
+
+// ErrInvalidToken is returned for tokens that are not a log position
+var ErrInvalidToken = errors.New("invalid consistency token")
+
+// ErrBehind is returned when the database has not applied a token in time
+var ErrBehind = errors.New("database has not caught up with the consistency token")
+
+// Token is a position in the database's write-ahead log. Clients treat it as
+// opaque and only pass it back.
+type Token uint64
+
+// String formats the token like a Postgres LSN, e.g. "16/B374D848"
+func (t Token) String() string {
+	return fmt.Sprintf("%X/%X", uint64(t)>>32, uint32(t))
+}
+
+// Parse parses a token returned by a write
+func Parse(s string) (Token, error) {
+	hi, lo, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return 0, ErrInvalidToken
+	}
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	return Token(h<<32 | l), nil
+}
+
+// Position returns the token for the writes committed so far. It must be
+// read from the primary.
+func Position(ctx context.Context, db *gorm.DB) (Token, error) {
+	var lsn string
+	if err := db.WithContext(ctx).Raw("SELECT pg_current_wal_lsn()::text").Scan(&lsn).Error; err != nil {
+		return 0, fmt.Errorf("failed to read log position: %w", err)
+	}
+	return Parse(lsn)
+}
+
+// Applied returns the position the database has applied: the replayed
+// position on a replica, the current one on the primary
+func Applied(ctx context.Context, db *gorm.DB) (Token, error) {
+	var lsn string
+	if err := db.WithContext(ctx).
+		Raw("SELECT COALESCE(pg_last_wal_replay_lsn(), pg_current_wal_lsn())::text").
+		Scan(&lsn).Error; err != nil {
+		return 0, fmt.Errorf("failed to read applied log position: %w", err)
+	}
+	return Parse(lsn)
+}
+
+// Wait returns once db has applied token, or ErrBehind after timeout
+func Wait(ctx context.Context, db *gorm.DB, token Token, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		applied, err := Applied(ctx, db)
+		if err != nil {
+			return err
+		}
+		if applied >= token {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return ErrBehind
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+type contextKey struct{}
+
+// WithToken marks ctx as serving a read that must reflect token
+func WithToken(ctx context.Context, token Token) context.Context {
+	return context.WithValue(ctx, contextKey{}, token)
+}
+
+// FromContext returns the token a read must reflect, if it presented one.
+// Caches are bypassed for such reads.
+func FromContext(ctx context.Context) (Token, bool) {
+	token, ok := ctx.Value(contextKey{}).(Token)
+	return token, ok
+}
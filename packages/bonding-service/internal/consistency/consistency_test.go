@@ -0,0 +1,154 @@
+package consistency
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+var (
+	positionQuery = regexp.QuoteMeta("SELECT pg_current_wal_lsn()::text")
+	appliedQuery  = regexp.QuoteMeta("SELECT COALESCE(pg_last_wal_replay_lsn(), pg_current_wal_lsn())::text")
+)
+
+func lsnRow(lsn string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"pg_current_wal_lsn"}).AddRow(lsn)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Token
+		wantErr bool
+	}{
+		{"0/16B3748", 0x16B3748, false},
+		{"16/B374D848", 0x16B374D848, false},
+		{" 1/0 ", 1 << 32, false},
+		{"16B3748", 0, true},
+		{"0/xyz", 0, true},
+		{"100000000/0", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+		if !tt.wantErr {
+			if again, _ := Parse(got.String()); again != got {
+				t.Errorf("Parse(%q.String()) = %v, want %v", tt.in, again, got)
+			}
+		}
+	}
+}
+
+func TestWait(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	// The replica catches up on the third check
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/10"))
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/18"))
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/20"))
+	if err := Wait(context.Background(), db, 0x20, time.Second); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	// It stays behind past the timeout
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/10"))
+	if err := Wait(context.Background(), db, 0x20, 0); !errors.Is(err, ErrBehind) {
+		t.Errorf("Wait() error = %v, want ErrBehind", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	db, mock := newMockDB(t)
+	isWrite := func(fullMethod string) bool { return fullMethod == "/bonding.BondingService/Invest" }
+	intercept := UnaryServerInterceptor(db, isWrite, 0)
+	write := &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/Invest"}
+	read := &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/GetPortfolio"}
+
+	var served context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		served = ctx
+		return "ok", nil
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, token))
+	}
+
+	// Writes read the position to return; without a server stream the header
+	// is dropped, but the write succeeds
+	mock.ExpectQuery(positionQuery).WillReturnRows(lsnRow("0/20"))
+	if _, err := intercept(context.Background(), nil, write, handler); err != nil {
+		t.Fatalf("write error = %v", err)
+	}
+
+	// Reads without a token are served as they are
+	if _, err := intercept(context.Background(), nil, read, handler); err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+	if _, ok := FromContext(served); ok {
+		t.Error("read without a token bypasses caches")
+	}
+
+	// A read with an applied token is served with caches bypassed
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/20"))
+	if _, err := intercept(withToken("0/20"), nil, read, handler); err != nil {
+		t.Fatalf("read with token error = %v", err)
+	}
+	if token, ok := FromContext(served); !ok || token != 0x20 {
+		t.Errorf("FromContext() = %v, %v, want 0/20, true", token, ok)
+	}
+
+	// A token the database hasn't applied fails so the client can retry
+	mock.ExpectQuery(appliedQuery).WillReturnRows(lsnRow("0/10"))
+	_, err := intercept(withToken("0/20"), nil, read, handler)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("read ahead of the database error = %v, want Unavailable", err)
+	}
+
+	_, err = intercept(withToken("latest"), nil, read, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("read with invalid token error = %v, want InvalidArgument", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
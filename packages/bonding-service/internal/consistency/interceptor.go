@@ -0,0 +1,63 @@
+package consistency
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// UnaryServerInterceptor returns a token in the response header of every
+// successful write method. Other methods called with a token wait up to
+// timeout for db to apply it, failing with UNAVAILABLE so the client can
+// retry elsewhere, and are served with caches bypassed.
+func UnaryServerInterceptor(db *gorm.DB, isWrite func(fullMethod string) bool, timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if isWrite(info.FullMethod) {
+			resp, err := handler(ctx, req)
+			if err == nil {
+				setHeader(ctx, db)
+			}
+			return resp, err
+		}
+
+		values := metadata.ValueFromIncomingContext(ctx, Header)
+		if len(values) == 0 || values[0] == "" {
+			return handler(ctx, req)
+		}
+		token, err := Parse(values[0])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := Wait(ctx, db, token, timeout); err != nil {
+			if errors.Is(err, ErrBehind) {
+				return nil, status.Error(codes.Unavailable, err.Error())
+			}
+			return nil, err
+		}
+		return handler(WithToken(ctx, token), req)
+	}
+}
+
+// setHeader sends the position after a write. A write that succeeded is not
+// failed for want of a token; the client just can't ask to read it.
+func setHeader(ctx context.Context, db *gorm.DB) {
+	token, err := Position(ctx, db)
+	if err != nil {
+		log.Printf("Failed to issue consistency token: %v", err)
+		return
+	}
+	// Not every caller is a live server stream, e.g. in-process gateways
+	_ = grpc.SetHeader(ctx, metadata.Pairs(Header, token.String()))
+}
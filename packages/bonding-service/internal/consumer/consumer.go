@@ -0,0 +1,253 @@
+// Package consumer delivers events to handlers exactly once as far as the
+// database is concerned. A handler runs in a transaction that also records the
+// event as processed and commits the consumer's position, so a redelivered
+// event is skipped and a crash never leaves half an event applied. Handlers
+// that keep failing are retried with backoff and then parked in the
+// dead-letter table.
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Event is a message delivered to a consumer
+type Event struct {
+	ID       string // Unique within the source; a redelivery carries the same ID
+	Position uint64 // Position in an ordered source, committed with the event; 0 for none
+	Payload  []byte
+}
+
+// Handler applies an event. Its writes through tx commit together with the
+// event's processed marker, or not at all.
+type Handler func(ctx context.Context, tx *gorm.DB, ev Event) error
+
+// Outcome is what became of a processed event
+type Outcome int
+
+const (
+	Handled      Outcome = iota // The handler succeeded
+	Duplicate                   // The event was already processed
+	DeadLettered                // The handler kept failing and the event was parked
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Handled:
+		return "handled"
+	case Duplicate:
+		return "duplicate"
+	default:
+		return "dead_lettered"
+	}
+}
+
+// Config holds consumer configuration
+type Config struct {
+	MaxAttempts int           // Handler attempts before an event is dead-lettered
+	Backoff     time.Duration // Delay before the first retry, doubled after each
+	MaxBackoff  time.Duration
+}
+
+// DefaultConfig returns default consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts: 5,
+		Backoff:     100 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+	}
+}
+
+// permanentError marks a handler error retrying cannot fix
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps a handler error so the event is dead-lettered without retries,
+// e.g. when its payload cannot be decoded
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// handlerError separates handler failures from failures to reach the database
+type handlerError struct{ err error }
+
+func (e handlerError) Error() string { return e.err.Error() }
+func (e handlerError) Unwrap() error { return e.err }
+
+var errDuplicate = errors.New("event already processed")
+
+// Consumer processes events for one named subscriber. Consumers with
+// different names see the same events independently.
+type Consumer struct {
+	db      *gorm.DB
+	name    string
+	handler Handler
+	config  Config
+}
+
+// New creates a consumer
+func New(db *gorm.DB, name string, handler Handler, config Config) *Consumer {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultConfig().MaxAttempts
+	}
+	return &Consumer{db: db, name: name, handler: handler, config: config}
+}
+
+// Name returns the consumer's name
+func (c *Consumer) Name() string {
+	return c.name
+}
+
+// Process delivers an event. An error means the database could not be
+// reached and nothing was recorded, so the source should deliver it again.
+func (c *Consumer) Process(ctx context.Context, ev Event) (Outcome, error) {
+	backoff := c.config.Backoff
+	for attempt := 1; ; attempt++ {
+		err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := c.markProcessed(tx, ev); err != nil {
+				return err
+			}
+			if err := c.handler(ctx, tx, ev); err != nil {
+				return handlerError{err}
+			}
+			return c.commitPosition(tx, ev)
+		})
+
+		var failed handlerError
+		switch {
+		case err == nil:
+			return c.done(Handled), nil
+		case errors.Is(err, errDuplicate):
+			return c.done(Duplicate), nil
+		case !errors.As(err, &failed):
+			return 0, err
+		}
+
+		var permanent permanentError
+		if errors.As(err, &permanent) || attempt >= c.config.MaxAttempts {
+			if err := c.deadLetter(ctx, ev, failed.err, attempt); err != nil {
+				return 0, err
+			}
+			return c.done(DeadLettered), nil
+		}
+		log.Printf("Consumer %s failed on event %s (attempt %d): %v", c.name, ev.ID, attempt, err)
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff = 2 * backoff; c.config.MaxBackoff > 0 && backoff > c.config.MaxBackoff {
+			backoff = c.config.MaxBackoff
+		}
+	}
+}
+
+// Position returns the last position the consumer committed, 0 if none
+func (c *Consumer) Position(ctx context.Context) (uint64, error) {
+	var offset models.ConsumerOffset
+	err := c.db.WithContext(ctx).Where("consumer = ?", c.name).First(&offset).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load position of consumer %s: %w", c.name, err)
+	}
+	return offset.Position, nil
+}
+
+// Redrive runs the handler again for a dead-lettered event, e.g. after a fix
+// is deployed. The dead letter is removed only if the handler succeeds.
+func (c *Consumer) Redrive(ctx context.Context, id uint) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var letter models.DeadLetter
+		if err := tx.Where("id = ? AND consumer = ?", id, c.name).First(&letter).Error; err != nil {
+			return fmt.Errorf("failed to load dead letter %d: %w", id, err)
+		}
+		ev := Event{ID: letter.EventID, Position: letter.Position, Payload: letter.Payload}
+		if err := c.handler(ctx, tx, ev); err != nil {
+			return err
+		}
+		if err := tx.Delete(&letter).Error; err != nil {
+			return fmt.Errorf("failed to delete dead letter: %w", err)
+		}
+		return nil
+	})
+}
+
+// markProcessed claims the event, failing with errDuplicate if it was already
+// processed. The claim is rolled back with the handler's writes.
+func (c *Consumer) markProcessed(tx *gorm.DB, ev Event) error {
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ProcessedEvent{
+		Consumer:    c.name,
+		EventID:     ev.ID,
+		ProcessedAt: time.Now(),
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark event processed: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errDuplicate
+	}
+	return nil
+}
+
+func (c *Consumer) commitPosition(tx *gorm.DB, ev Event) error {
+	if ev.Position == 0 {
+		return nil
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "consumer"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position", "updated_at"}),
+	}).Create(&models.ConsumerOffset{Consumer: c.name, Position: ev.Position}).Error
+	if err != nil {
+		return fmt.Errorf("failed to commit consumer position: %w", err)
+	}
+	return nil
+}
+
+// deadLetter parks an event the handler could not apply. The event is marked
+// processed and the position moves past it so the source is not blocked.
+func (c *Consumer) deadLetter(ctx context.Context, ev Event, cause error, attempts int) error {
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := c.markProcessed(tx, ev); err != nil {
+			return err
+		}
+		letter := &models.DeadLetter{
+			Consumer: c.name,
+			EventID:  ev.ID,
+			Position: ev.Position,
+			Payload:  ev.Payload,
+			Error:    cause.Error(),
+			Attempts: attempts,
+		}
+		if err := tx.Create(letter).Error; err != nil {
+			return fmt.Errorf("failed to save dead letter: %w", err)
+		}
+		return c.commitPosition(tx, ev)
+	})
+	if errors.Is(err, errDuplicate) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("ALERT: consumer %s dead-lettered event %s after %d attempts: %v", c.name, ev.ID, attempts, cause)
+	return nil
+}
+
+func (c *Consumer) done(outcome Outcome) Outcome {
+	metrics.ConsumedEvents.WithLabelValues(c.name, outcome.String()).Inc()
+	return outcome
+}
@@ -0,0 +1,166 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestProcess(t *testing.T) {
+	ev := Event{ID: "arbitrum:0xabc:0", Position: 7, Payload: []byte(`{}`)}
+	claim := `INSERT INTO "processed_events" .* ON CONFLICT DO NOTHING`
+	commit := `INSERT INTO "consumer_offsets" .* ON CONFLICT \("consumer"\) DO UPDATE`
+
+	tests := []struct {
+		name      string
+		handler   func(calls int) error
+		expect    func(mock sqlmock.Sqlmock)
+		want      Outcome
+		wantCalls int
+	}{
+		{
+			name:    "handled",
+			handler: func(int) error { return nil },
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WithArgs("test", ev.ID, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(commit).WithArgs("test", ev.Position, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want:      Handled,
+			wantCalls: 1,
+		},
+		{
+			name:    "duplicate",
+			handler: func(int) error { return nil },
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			want:      Duplicate,
+			wantCalls: 0,
+		},
+		{
+			name: "retried until it succeeds",
+			handler: func(calls int) error {
+				if calls == 1 {
+					return errors.New("deadlock detected")
+				}
+				return nil
+			},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(commit).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want:      Handled,
+			wantCalls: 2,
+		},
+		{
+			name:    "dead-lettered after max attempts",
+			handler: func(int) error { return errors.New("bond not found") },
+			expect: func(mock sqlmock.Sqlmock) {
+				for range 2 {
+					mock.ExpectBegin()
+					mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectRollback()
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`INSERT INTO "dead_letters"`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "test", ev.ID, ev.Position, ev.Payload, "bond not found", 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(commit).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want:      DeadLettered,
+			wantCalls: 2,
+		},
+		{
+			name:    "permanent errors skip retries",
+			handler: func(int) error { return Permanent(errors.New("bad payload")) },
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+				mock.ExpectBegin()
+				mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`INSERT INTO "dead_letters"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(commit).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want:      DeadLettered,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tt.expect(mock)
+
+			calls := 0
+			c := New(db, "test", func(ctx context.Context, tx *gorm.DB, got Event) error {
+				calls++
+				if got.ID != ev.ID {
+					t.Errorf("handler got event %s, want %s", got.ID, ev.ID)
+				}
+				return tt.handler(calls)
+			}, Config{MaxAttempts: 2, Backoff: time.Millisecond})
+
+			outcome, err := c.Process(context.Background(), ev)
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+			if outcome != tt.want || calls != tt.wantCalls {
+				t.Errorf("Process() = %s after %d calls, want %s after %d", outcome, calls, tt.want, tt.wantCalls)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestProcessDatabaseDown(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+
+	c := New(db, "test", func(context.Context, *gorm.DB, Event) error {
+		t.Error("handler called without a transaction")
+		return nil
+	}, DefaultConfig())
+	if _, err := c.Process(context.Background(), Event{ID: "1"}); err == nil {
+		t.Error("Process() error = nil, want the connection error so the source redelivers")
+	}
+}
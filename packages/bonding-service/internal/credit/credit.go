@@ -0,0 +1,119 @@
+package credit
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// GracePeriod is how long after its due date a payment still counts as on time
+const GracePeriod = 3 * 24 * time.Hour
+
+// SeverelyLate is how late a payment can be before it earns no credit
+const SeverelyLate = 30 * 24 * time.Hour
+
+// Payment is a royalty payment received from a licensee
+type Payment struct {
+	Licensee string
+	Amount   *big.Int
+	DueAt    time.Time // Zero when the payment had no agreed due date
+	PaidAt   time.Time
+}
+
+// Score summarizes a licensee's payment punctuality
+type Score struct {
+	Licensee        string
+	Payments        int // Payments with a due date
+	OnTime          int
+	Late            int
+	AverageDaysLate float64
+	Score           int // 0-100, meaningful only when Rated
+	Rated           bool
+}
+
+// Exposure is a licensee's share of a bond's royalty revenue
+type Exposure struct {
+	Licensee string
+	Amount   *big.Int
+	Share    float64
+}
+
+// Assess scores a licensee from its payments. Payments on time earn full
+// credit, late ones half and severely late ones none. Payments without a due
+// date don't count towards punctuality.
+func Assess(licensee string, payments []Payment) Score {
+	score := Score{Licensee: licensee}
+	var credit float64
+	var daysLate float64
+	for _, p := range payments {
+		if p.DueAt.IsZero() {
+			continue
+		}
+		score.Payments++
+
+		late := p.PaidAt.Sub(p.DueAt)
+		switch {
+		case late <= GracePeriod:
+			score.OnTime++
+			credit += 1.0
+		case late <= SeverelyLate:
+			score.Late++
+			credit += 0.5
+		default:
+			score.Late++
+		}
+		if late > 0 {
+			daysLate += late.Hours() / 24
+		}
+	}
+
+	if score.Payments > 0 {
+		score.Rated = true
+		score.Score = int(math.Round(100 * credit / float64(score.Payments)))
+		score.AverageDaysLate = daysLate / float64(score.Payments)
+	}
+	return score
+}
+
+// Exposures splits payments by licensee, largest share first
+func Exposures(payments []Payment) []Exposure {
+	total := new(big.Int)
+	byLicensee := make(map[string]*big.Int)
+	for _, p := range payments {
+		if p.Amount == nil || p.Amount.Sign() <= 0 {
+			continue
+		}
+		amount, ok := byLicensee[p.Licensee]
+		if !ok {
+			amount = new(big.Int)
+			byLicensee[p.Licensee] = amount
+		}
+		amount.Add(amount, p.Amount)
+		total.Add(total, p.Amount)
+	}
+
+	exposures := make([]Exposure, 0, len(byLicensee))
+	totalF, _ := new(big.Float).SetInt(total).Float64()
+	for licensee, amount := range byLicensee {
+		amountF, _ := new(big.Float).SetInt(amount).Float64()
+		exposures = append(exposures, Exposure{Licensee: licensee, Amount: amount, Share: amountF / totalF})
+	}
+	sort.Slice(exposures, func(i, j int) bool {
+		if c := exposures[i].Amount.Cmp(exposures[j].Amount); c != 0 {
+			return c > 0
+		}
+		return exposures[i].Licensee < exposures[j].Licensee
+	})
+	return exposures
+}
+
+// Concentration returns the Herfindahl-Hirschman index of exposures, from
+// near 0 for many small licensees to 1 for a single one
+func Concentration(exposures []Exposure) float64 {
+	var hhi float64
+	for _, e := range exposures {
+		hhi += e.Share * e.Share
+	}
+	return hhi
+}
@@ -0,0 +1,66 @@
+package credit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestAssess(t *testing.T) {
+	due := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	payment := func(late time.Duration) Payment {
+		return Payment{Licensee: "Streaming Co", Amount: big.NewInt(100), DueAt: due, PaidAt: due.Add(late)}
+	}
+
+	tests := []struct {
+		name     string
+		payments []Payment
+		want     Score
+	}{
+		{"no history", nil, Score{Licensee: "Streaming Co"}},
+		{"no due dates", []Payment{{Licensee: "Streaming Co", PaidAt: due}}, Score{Licensee: "Streaming Co"}},
+		{"early and within grace", []Payment{payment(-day), payment(2 * day)},
+			Score{Licensee: "Streaming Co", Payments: 2, OnTime: 2, AverageDaysLate: 1, Score: 100, Rated: true}},
+		{"late", []Payment{payment(0), payment(10 * day)},
+			Score{Licensee: "Streaming Co", Payments: 2, OnTime: 1, Late: 1, AverageDaysLate: 5, Score: 75, Rated: true}},
+		{"severely late", []Payment{payment(60 * day)},
+			Score{Licensee: "Streaming Co", Payments: 1, Late: 1, AverageDaysLate: 60, Score: 0, Rated: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Assess("Streaming Co", tt.payments); got != tt.want {
+				t.Errorf("Assess() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExposures(t *testing.T) {
+	payments := []Payment{
+		{Licensee: "b", Amount: big.NewInt(250)},
+		{Licensee: "a", Amount: big.NewInt(500)},
+		{Licensee: "b", Amount: big.NewInt(250)},
+		{Licensee: "c", Amount: big.NewInt(0)},
+	}
+
+	exposures := Exposures(payments)
+	if len(exposures) != 2 {
+		t.Fatalf("Exposures() returned %d licensees, want 2", len(exposures))
+	}
+	// Ties break by name so the order is stable
+	if exposures[0].Licensee != "a" || exposures[1].Licensee != "b" {
+		t.Errorf("Exposures() order = %s, %s", exposures[0].Licensee, exposures[1].Licensee)
+	}
+	if exposures[1].Amount.Int64() != 500 || exposures[1].Share != 0.5 {
+		t.Errorf("Exposures()[1] = %+v", exposures[1])
+	}
+	if got := Concentration(exposures); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("Concentration() = %v, want 0.5", got)
+	}
+	if got := Concentration(Exposures(payments[:1])); got != 1 {
+		t.Errorf("Concentration() of a single licensee = %v, want 1", got)
+	}
+}
@@ -0,0 +1,97 @@
+// Package database opens the service's Postgres connections: the primary,
+// which takes every write, with a tuned connection pool, and optional read
+// replicas that heavy read RPCs are routed to through Reader. Reads not
+// routed explicitly stay on the primary, so nothing that must see its own
+// writes is sent to a lagging replica by accident.
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/consistency"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// Replicas names the resolver Reader routes to
+const Replicas = "replicas"
+
+// Config holds the connection pool and replica settings
+type Config struct {
+	ReplicaURLs     []string // Reader uses the primary when empty
+	MaxOpenConns    int      // Per database; 0 is unlimited
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration // 0 keeps connections forever
+	ConnMaxIdleTime time.Duration
+}
+
+// DefaultConfig returns pool settings suited to a single service instance
+func DefaultConfig() Config {
+	return Config{
+		MaxOpenConns:    25,
+		MaxIdleConns:    10,
+		ConnMaxLifetime: 30 * time.Minute,
+		ConnMaxIdleTime: 5 * time.Minute,
+	}
+}
+
+// Open connects to the primary at url and to the configured replicas
+func Open(url string, config Config) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(url), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	replicas := make([]gorm.Dialector, len(config.ReplicaURLs))
+	for i, replica := range config.ReplicaURLs {
+		replicas[i] = postgres.Open(replica)
+	}
+	if err := configure(db, config, replicas); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// configure applies the pool settings to db and its replicas, registering
+// the replicas under the Replicas resolver
+func configure(db *gorm.DB, config Config, replicas []gorm.Dialector) error {
+	if len(replicas) == 0 {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+		sqlDB.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+		return nil
+	}
+
+	// Without sources the resolver writes to db's own pool, and it applies
+	// the pool settings to that pool and every replica's
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}, Replicas).
+		SetMaxOpenConns(config.MaxOpenConns).
+		SetMaxIdleConns(config.MaxIdleConns).
+		SetConnMaxLifetime(config.ConnMaxLifetime).
+		SetConnMaxIdleTime(config.ConnMaxIdleTime)
+	if err := db.Use(resolver); err != nil {
+		return fmt.Errorf("failed to connect to read replicas: %w", err)
+	}
+	return nil
+}
+
+// Reader returns db with its reads sent to a replica, including transactions
+// begun on it such as a repository.Snapshot. A read presenting a consistency
+// token stays on the primary, since a replica may not have applied it yet.
+// Without replicas it reads from the primary.
+func Reader(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if _, ok := consistency.FromContext(ctx); ok {
+		return db
+	}
+	return db.Clauses(dbresolver.Use(Replicas), dbresolver.Read)
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/consistency"
+	"github.com/knowton/bonding-service/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMock(t *testing.T) (gorm.Dialector, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return postgres.New(postgres.Config{Conn: sqlDB}), mock
+}
+
+func openMock(t *testing.T, dialector gorm.Dialector) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db
+}
+
+type row struct {
+	ID int
+}
+
+func TestReaderRouting(t *testing.T) {
+	primaryConn, primary := newMock(t)
+	replicaConn, replica := newMock(t)
+	db := openMock(t, primaryConn)
+	if err := configure(db, DefaultConfig(), []gorm.Dialector{replicaConn}); err != nil {
+		t.Fatalf("configure() error = %v", err)
+	}
+	ctx := context.Background()
+
+	// Routed reads and snapshots go to the replica
+	replica.ExpectQuery(`SELECT \* FROM "rows"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	replica.ExpectBegin()
+	replica.ExpectQuery(`SELECT \* FROM "rows"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	replica.ExpectCommit()
+	// Other reads, writes and reads presenting a token stay on the primary
+	primary.ExpectQuery(`SELECT \* FROM "rows"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	primary.ExpectExec(`UPDATE "rows"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	primary.ExpectQuery(`SELECT \* FROM "rows"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	var rows []row
+	if err := Reader(ctx, db).WithContext(ctx).Find(&rows).Error; err != nil {
+		t.Fatalf("replica Find() error = %v", err)
+	}
+	err := repository.Snapshot(ctx, Reader(ctx, db), func(ctx context.Context) error {
+		return repository.DB(ctx, db).Find(&rows).Error
+	})
+	if err != nil {
+		t.Fatalf("replica Snapshot() error = %v", err)
+	}
+	if err := db.WithContext(ctx).Find(&rows).Error; err != nil {
+		t.Fatalf("primary Find() error = %v", err)
+	}
+	if err := db.WithContext(ctx).Table("rows").Where("id = ?", 1).Update("id", 2).Error; err != nil {
+		t.Fatalf("primary Update() error = %v", err)
+	}
+	tokened := consistency.WithToken(ctx, 42)
+	if err := Reader(tokened, db).WithContext(tokened).Find(&rows).Error; err != nil {
+		t.Fatalf("tokened Find() error = %v", err)
+	}
+
+	for name, mock := range map[string]sqlmock.Sqlmock{"primary": primary, "replica": replica} {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestReaderWithoutReplicas(t *testing.T) {
+	primaryConn, primary := newMock(t)
+	db := openMock(t, primaryConn)
+	if err := configure(db, DefaultConfig(), nil); err != nil {
+		t.Fatalf("configure() error = %v", err)
+	}
+	ctx := context.Background()
+
+	primary.ExpectQuery(`SELECT \* FROM "rows"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	var rows []row
+	if err := Reader(ctx, db).WithContext(ctx).Find(&rows).Error; err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if err := primary.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	sqlDB, _ := db.DB()
+	if got := sqlDB.Stats().MaxOpenConnections; got != DefaultConfig().MaxOpenConns {
+		t.Errorf("MaxOpenConnections = %d, want %d", got, DefaultConfig().MaxOpenConns)
+	}
+}
@@ -0,0 +1,81 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Config controls how client deadlines are applied to server-side work
+type Config struct {
+	// Margin is reserved from the client's deadline so the server gives up,
+	// cancels its queries and replies before the client stops listening
+	Margin time.Duration
+	// DefaultTimeout bounds calls that arrive without a deadline (0 disables)
+	DefaultTimeout time.Duration
+}
+
+// DefaultConfig returns default deadline handling
+func DefaultConfig() Config {
+	return Config{
+		Margin:         100 * time.Millisecond,
+		DefaultTimeout: 30 * time.Second,
+	}
+}
+
+// WithMargin returns a context that expires margin before ctx's deadline.
+// Contexts without a deadline are returned unchanged.
+func WithMargin(ctx context.Context, margin time.Duration) (context.Context, context.CancelFunc) {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, d.Add(-margin))
+}
+
+// Clamp returns a context that expires after at most max, or earlier if ctx
+// has a closer deadline. Use it for chain calls, which should not run longer
+// than their own timeout even when the caller allows more.
+func Clamp(ctx context.Context, max time.Duration) (context.Context, context.CancelFunc) {
+	if d, ok := ctx.Deadline(); ok && time.Until(d) < max {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, max)
+}
+
+// UnaryServerInterceptor propagates the client's remaining deadline, less the
+// margin, into the handler context. DB queries and chain calls made with that
+// context are cancelled when the client's budget runs out instead of running on.
+func UnaryServerInterceptor(config Config) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var cancel context.CancelFunc
+		if d, ok := ctx.Deadline(); ok {
+			if time.Until(d) <= config.Margin {
+				return nil, status.Errorf(codes.DeadlineExceeded, "deadline too short to serve %s", info.FullMethod)
+			}
+			ctx, cancel = WithMargin(ctx, config.Margin)
+		} else if config.DefaultTimeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, config.DefaultTimeout)
+		} else {
+			ctx, cancel = context.WithCancel(ctx)
+		}
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		if err != nil && errors.Is(err, context.DeadlineExceeded) {
+			if _, ok := status.FromError(err); !ok {
+				return nil, status.Error(codes.DeadlineExceeded, err.Error())
+			}
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,118 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent time.Duration // 0 means no deadline
+		max    time.Duration
+		want   time.Duration
+	}{
+		{"no parent deadline", 0, time.Second, time.Second},
+		{"parent shorter", 200 * time.Millisecond, time.Second, 200 * time.Millisecond},
+		{"parent longer", time.Minute, time.Second, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent := context.Background()
+			if tt.parent > 0 {
+				var cancel context.CancelFunc
+				parent, cancel = context.WithTimeout(parent, tt.parent)
+				defer cancel()
+			}
+
+			ctx, cancel := Clamp(parent, tt.max)
+			defer cancel()
+
+			d, ok := ctx.Deadline()
+			if !ok {
+				t.Fatalf("Clamp() has no deadline")
+			}
+			if got := time.Until(d); got > tt.want || got < tt.want-50*time.Millisecond {
+				t.Errorf("remaining = %v, want about %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	config := Config{Margin: 100 * time.Millisecond, DefaultTimeout: 5 * time.Second}
+	interceptor := UnaryServerInterceptor(config)
+	info := &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/GetBondInfo"}
+
+	tests := []struct {
+		name      string
+		timeout   time.Duration // 0 means no client deadline
+		handler   grpc.UnaryHandler
+		wantCode  codes.Code
+		wantUntil time.Duration // Expected remaining handler budget
+	}{
+		{
+			name:      "margin reserved",
+			timeout:   2 * time.Second,
+			wantCode:  codes.OK,
+			wantUntil: 1900 * time.Millisecond,
+		},
+		{
+			name:      "default timeout",
+			wantCode:  codes.OK,
+			wantUntil: 5 * time.Second,
+		},
+		{
+			name:     "deadline inside margin",
+			timeout:  50 * time.Millisecond,
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:    "query cancelled",
+			timeout: 2 * time.Second,
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, fmt.Errorf("failed to load bond: %w", context.DeadlineExceeded)
+			},
+			wantCode: codes.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			var remaining time.Duration
+			handler := tt.handler
+			if handler == nil {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					d, ok := ctx.Deadline()
+					if !ok {
+						return nil, fmt.Errorf("handler context has no deadline")
+					}
+					remaining = time.Until(d)
+					return "ok", nil
+				}
+			}
+
+			_, err := interceptor(ctx, nil, info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", got, tt.wantCode, err)
+			}
+			if tt.wantUntil > 0 && (remaining > tt.wantUntil || remaining < tt.wantUntil-50*time.Millisecond) {
+				t.Errorf("handler budget = %v, want about %v", remaining, tt.wantUntil)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package decimal parses decimal strings such as APYs, percentages and USD
+// amounts exactly, without going through float64
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// HundredPercent is 100% in basis points
+const HundredPercent = 10000
+
+// Parse parses a decimal such as "8.5", "33.33" or "1.25e6". Fractions like
+// "1/3" are rejected.
+func Parse(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.Contains(s, "/") {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+// Scaled parses s and returns it multiplied by 10^decimals, e.g. a USD amount
+// in 18-decimal units. It fails rather than round if s is more precise.
+func Scaled(s string, decimals int) (*big.Int, error) {
+	r, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%q has more than %d decimal places", s, decimals)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// BasisPoints converts a percentage such as "8.5" or "33.33" to basis points
+func BasisPoints(percent string) (int64, error) {
+	bps, err := Scaled(percent, 2)
+	if err != nil {
+		return 0, err
+	}
+	if !bps.IsInt64() {
+		return 0, fmt.Errorf("percentage %q is out of range", percent)
+	}
+	return bps.Int64(), nil
+}
+
+// PercentOf returns bps basis points of amount, rounded down
+func PercentOf(amount *big.Int, bps int64) *big.Int {
+	share := new(big.Int).Mul(amount, big.NewInt(bps))
+	return share.Quo(share, big.NewInt(HundredPercent))
+}
@@ -0,0 +1,64 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScaled(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		decimals int
+		want     string
+		wantErr  bool
+	}{
+		{"integer", "8", 2, "800", false},
+		{"fraction", "8.5", 2, "850", false},
+		{"basis point", "33.33", 2, "3333", false},
+		{"exponent", "1.25e6", 18, "1250000000000000000000000", false},
+		{"padded", " 12.5 ", 2, "1250", false},
+		{"too precise", "8.555", 2, "", true},
+		{"ratio", "1/3", 2, "", true},
+		{"empty", "", 2, "", true},
+		{"garbage", "8.5%", 2, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Scaled(tt.value, tt.decimals)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scaled() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Scaled() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent string
+		amount  int64
+		want    int64
+	}{
+		{"whole", "50", 1000, 500},
+		{"fractional", "33.33", 1_000_000, 333_300},
+		{"rounds down", "33.33", 100, 33},
+		{"everything", "100", 7, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bps, err := BasisPoints(tt.percent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := PercentOf(big.NewInt(tt.amount), bps); got.Int64() != tt.want {
+				t.Errorf("PercentOf() = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrSuspended is returned for a bond whose distributions an operator froze.
+// Its queued distributions wait without using up attempts.
+var ErrSuspended = errors.New("distributions to the bond are suspended")
+
+// Distributor executes a single bond's revenue distribution and returns the
+// transaction hash. dueAt names the period paid, so a retry of a distribution
+// that was already executed is refused with ErrDuplicate.
+type Distributor interface {
+	DistributeQueued(ctx context.Context, bondID string, amount string, dueAt time.Time) (string, error)
+}
+
+// BlockSource reports the current chain head
+type BlockSource interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// BatchConfig holds batch distribution configuration
+type BatchConfig struct {
+	Interval       time.Duration // How often the job looks for due distributions
+	MaxPerRun      int           // Maximum distributions processed in one run
+	MaxTxPerWindow int           // Transactions submitted from the operator wallet per block window
+	BlockWindow    uint64        // Number of blocks a window spans
+	PollInterval   time.Duration // How often to poll the chain head while waiting for a window
+	MaxAttempts    int           // Attempts before a distribution is marked FAILED
+}
+
+// DefaultBatchConfig returns default batch configuration
+func DefaultBatchConfig() *BatchConfig {
+	return &BatchConfig{
+		Interval:       5 * time.Minute,
+		MaxPerRun:      200,
+		MaxTxPerWindow: 10,
+		BlockWindow:    1,
+		PollInterval:   2 * time.Second,
+		MaxAttempts:    3,
+	}
+}
+
+// BatchProcessor distributes revenue for all due bonds in one run, pacing
+// transactions per block window so the operator wallet's nonce sequence and
+// the provider's throughput limits are not exceeded
+type BatchProcessor struct {
+	db          *gorm.DB
+	distributor Distributor
+	blocks      BlockSource
+	config      *BatchConfig
+}
+
+// NewBatchProcessor creates a new batch distribution processor
+func NewBatchProcessor(db *gorm.DB, distributor Distributor, blocks BlockSource, config *BatchConfig) *BatchProcessor {
+	if config == nil {
+		config = DefaultBatchConfig()
+	}
+
+	return &BatchProcessor{
+		db:          db,
+		distributor: distributor,
+		blocks:      blocks,
+		config:      config,
+	}
+}
+
+// Enqueue adds a distribution to the queue
+func (p *BatchProcessor) Enqueue(bondID string, amount string, dueAt time.Time) (*models.QueuedDistribution, error) {
+	item := &models.QueuedDistribution{
+		BondID: bondID,
+		Amount: amount,
+		DueAt:  dueAt,
+		Status: models.DistributionQueued,
+	}
+	if err := p.db.Create(item).Error; err != nil {
+		return nil, fmt.Errorf("failed to queue distribution: %w", err)
+	}
+	return item, nil
+}
+
+// EnqueueAll adds several distributions to the queue in one transaction, so
+// either all of them are queued or none is
+func (p *BatchProcessor) EnqueueAll(ctx context.Context, items []*models.QueuedDistribution) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			item.Status = models.DistributionQueued
+			if err := tx.Create(item).Error; err != nil {
+				return fmt.Errorf("failed to queue distribution for bond %s: %w", item.BondID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Start runs the batch job until the context is cancelled
+func (p *BatchProcessor) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.RunOnce(ctx); err != nil {
+			log.Printf("Batch distribution run failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunResult summarizes a batch run
+type RunResult struct {
+	Processed int
+	Succeeded int
+	Failed    int
+}
+
+// RunOnce processes all distributions that are currently due
+func (p *BatchProcessor) RunOnce(ctx context.Context) (*RunResult, error) {
+	var due []models.QueuedDistribution
+	if err := p.db.WithContext(ctx).
+		Where("status = ? AND due_at <= ?", models.DistributionQueued, time.Now()).
+		Order("due_at ASC").
+		Limit(p.config.MaxPerRun).
+		Find(&due).Error; err != nil {
+		return nil, fmt.Errorf("failed to load due distributions: %w", err)
+	}
+
+	result := &RunResult{}
+	for start := 0; start < len(due); start += p.config.MaxTxPerWindow {
+		end := start + p.config.MaxTxPerWindow
+		if end > len(due) {
+			end = len(due)
+		}
+
+		windowStart, err := p.blocks.BlockNumber(ctx)
+		if err != nil {
+			return result, fmt.Errorf("failed to read block number: %w", err)
+		}
+
+		for i := start; i < end; i++ {
+			if p.process(ctx, &due[i]) {
+				result.Succeeded++
+			} else {
+				result.Failed++
+			}
+			result.Processed++
+		}
+
+		// Wait for the block window to pass before sending the next batch
+		if end < len(due) {
+			if err := p.waitForBlock(ctx, windowStart+p.config.BlockWindow); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// process executes one queued distribution and records the outcome
+func (p *BatchProcessor) process(ctx context.Context, item *models.QueuedDistribution) bool {
+	// Claim the row so concurrent runs don't pick it up
+	claim := p.db.WithContext(ctx).Model(&models.QueuedDistribution{}).
+		Where("id = ? AND status = ?", item.ID, models.DistributionQueued).
+		Update("status", models.DistributionProcessing)
+	if claim.Error != nil || claim.RowsAffected == 0 {
+		return false
+	}
+
+	item.Attempts++
+	txHash, err := p.distributor.DistributeQueued(ctx, item.BondID, item.Amount, item.DueAt)
+
+	updates := map[string]interface{}{"attempts": item.Attempts}
+	if err != nil {
+		log.Printf("Distribution for bond %s failed (attempt %d): %v", item.BondID, item.Attempts, err)
+		updates["last_error"] = err.Error()
+		switch {
+		case errors.Is(err, ErrDuplicate):
+			// Retrying would be refused again, or worse pay twice
+			updates["status"] = models.DistributionDuplicate
+		case errors.Is(err, ErrSuspended):
+			updates["attempts"] = item.Attempts - 1
+			updates["status"] = models.DistributionQueued
+		case item.Attempts >= p.config.MaxAttempts:
+			updates["status"] = models.DistributionFailed
+		default:
+			updates["status"] = models.DistributionQueued
+		}
+	} else {
+		now := time.Now()
+		updates["status"] = models.DistributionCompleted
+		updates["tx_hash"] = txHash
+		updates["processed_at"] = &now
+	}
+
+	if dbErr := p.db.WithContext(ctx).Model(item).Updates(updates).Error; dbErr != nil {
+		log.Printf("Failed to record distribution outcome for bond %s: %v", item.BondID, dbErr)
+	}
+
+	return err == nil
+}
+
+// waitForBlock blocks until the chain head reaches target
+func (p *BatchProcessor) waitForBlock(ctx context.Context, target uint64) error {
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		head, err := p.blocks.BlockNumber(ctx)
+		if err == nil && head >= target {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
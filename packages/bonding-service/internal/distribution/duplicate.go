@@ -0,0 +1,194 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrDuplicate is returned for a distribution equivalent to one already executed
+var ErrDuplicate = errors.New("an equivalent distribution was already executed")
+
+// ErrInProgress is returned while another distribution for the bond is being submitted
+var ErrInProgress = errors.New("a distribution for this bond is already in progress")
+
+// failedStatuses are the statuses of transactions that never paid out, so
+// their distributions may be sent again
+var failedStatuses = []string{"DROPPED", "REVERTED", "EXPIRED"}
+
+// DuplicateConfig controls when two distributions are equivalent
+type DuplicateConfig struct {
+	// Window within which distributions of the same amount to the same bond
+	// are equivalent, unless both name different periods
+	Window      time.Duration
+	ChainBlocks uint64 // Recent blocks searched for on-chain distributions
+	MaxRange    uint64 // Maximum blocks per log query
+}
+
+// DefaultDuplicateConfig returns the default duplicate detection
+// configuration. A day of Arbitrum blocks is searched on-chain.
+func DefaultDuplicateConfig() DuplicateConfig {
+	return DuplicateConfig{
+		Window:      24 * time.Hour,
+		ChainBlocks: 350_000,
+		MaxRange:    10_000,
+	}
+}
+
+// Candidate is a distribution about to be submitted
+type Candidate struct {
+	BondID string
+	Amount *big.Int
+	Period *time.Time // Revenue period the distribution pays, nil when unnamed
+	At     time.Time
+}
+
+// ChainClient is the subset of ethclient.Client the on-chain check needs
+type ChainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// DuplicateGuard refuses distributions equivalent to one already recorded in
+// the ledger or executed on-chain. The on-chain check still catches a
+// payout whose ledger entry was lost, e.g. when the service crashed after
+// sending the transaction.
+type DuplicateGuard struct {
+	db     *gorm.DB
+	config DuplicateConfig
+
+	mu       sync.Mutex
+	inFlight map[string]bool
+}
+
+// NewDuplicateGuard creates a duplicate guard
+func NewDuplicateGuard(db *gorm.DB, config DuplicateConfig) *DuplicateGuard {
+	if config.MaxRange == 0 {
+		config.MaxRange = DefaultDuplicateConfig().MaxRange
+	}
+	return &DuplicateGuard{db: db, config: config, inFlight: make(map[string]bool)}
+}
+
+// Begin claims a bond for one distribution at a time on this instance, so
+// two concurrent requests can't both pass the checks. The returned function
+// releases the claim once the distribution is recorded.
+func (g *DuplicateGuard) Begin(bondID string) (func(), error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.inFlight[bondID] {
+		return nil, ErrInProgress
+	}
+	g.inFlight[bondID] = true
+	return func() {
+		g.mu.Lock()
+		delete(g.inFlight, bondID)
+		g.mu.Unlock()
+	}, nil
+}
+
+// CheckLedger refuses a candidate equivalent to a recorded distribution
+// whose transaction didn't fail. A distribution naming the same period is
+// equivalent at any distance; one without a period is equivalent within the
+// window.
+func (g *DuplicateGuard) CheckLedger(ctx context.Context, c Candidate) error {
+	q := g.db.WithContext(ctx).
+		Where("bond_id = ? AND amount = ?", c.BondID, c.Amount.String()).
+		Where("tx_hash NOT IN (?)", g.db.Model(&models.TransactionRecord{}).
+			Select("tx_hash").Where("status IN ?", failedStatuses))
+	if c.Period != nil {
+		q = q.Where("period = ? OR (period IS NULL AND timestamp > ?)", *c.Period, c.At.Add(-g.config.Window))
+	} else {
+		q = q.Where("timestamp > ?", c.At.Add(-g.config.Window))
+	}
+
+	var existing []models.RevenueDistribution
+	if err := q.Order("timestamp DESC").Limit(1).Find(&existing).Error; err != nil {
+		return fmt.Errorf("failed to check for duplicate distributions: %w", err)
+	}
+	if len(existing) > 0 {
+		return g.refuse(c, "ledger", existing[0].TxHash)
+	}
+	return nil
+}
+
+// CheckChain refuses a candidate when the bond contract emitted a
+// distribution of the same amount within the window that the ledger has no
+// record of. Recorded ones were judged by CheckLedger. Bonds without a
+// numeric on-chain ID are skipped.
+func (g *DuplicateGuard) CheckChain(ctx context.Context, client ChainClient, contract common.Address, c Candidate) error {
+	bondID, ok := new(big.Int).SetString(c.BondID, 10)
+	if !ok || g.config.ChainBlocks == 0 {
+		return nil
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read chain head: %w", err)
+	}
+	to := head.Number.Uint64()
+	from := uint64(0)
+	if to > g.config.ChainBlocks {
+		from = to - g.config.ChainBlocks
+	}
+
+	since := c.At.Add(-g.config.Window).Unix()
+	for start := from; start <= to; start += g.config.MaxRange {
+		end := start + g.config.MaxRange - 1
+		if end > to {
+			end = to
+		}
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{contract},
+			Topics: [][]common.Hash{
+				{blockchain.EventID("RevenueDistributed")},
+				{common.BigToHash(bondID)},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query distributions %d-%d: %w", start, end, err)
+		}
+		pointers := make([]*types.Log, len(logs))
+		for i := range logs {
+			pointers[i] = &logs[i]
+		}
+		events, err := blockchain.ParseLogs(pointers, contract)
+		if err != nil {
+			return err
+		}
+
+		for _, ev := range events.RevenueDistributed {
+			if ev.Raw.Removed || ev.Revenue.Cmp(c.Amount) != 0 || ev.Timestamp.Int64() <= since {
+				continue
+			}
+			txHash := ev.Raw.TxHash.Hex()
+			var recorded int64
+			if err := g.db.WithContext(ctx).Model(&models.RevenueDistribution{}).
+				Where("bond_id = ? AND LOWER(tx_hash) = LOWER(?)", c.BondID, txHash).
+				Count(&recorded).Error; err != nil {
+				return fmt.Errorf("failed to look up distribution %s: %w", txHash, err)
+			}
+			if recorded == 0 {
+				return g.refuse(c, "chain", txHash)
+			}
+		}
+	}
+	return nil
+}
+
+func (g *DuplicateGuard) refuse(c Candidate, source, txHash string) error {
+	metrics.DuplicateDistributions.WithLabelValues(source).Inc()
+	return fmt.Errorf("%w: bond %s was paid %s in transaction %s", ErrDuplicate, c.BondID, c.Amount, txHash)
+}
@@ -0,0 +1,198 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// fakeChain serves a fixed head and logs, recording the ranges queried
+type fakeChain struct {
+	head   uint64
+	logs   []types.Log
+	ranges [][2]uint64
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.head)}, nil
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	c.ranges = append(c.ranges, [2]uint64{from, to})
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+// distributedLog builds a RevenueDistributed log for bond 7
+func distributedLog(contract common.Address, block uint64, tx string, revenue int64, at time.Time) types.Log {
+	data := append(common.BigToHash(big.NewInt(revenue)).Bytes(), common.BigToHash(big.NewInt(at.Unix())).Bytes()...)
+	return types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{blockchain.EventID("RevenueDistributed"), common.BigToHash(big.NewInt(7))},
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      common.HexToHash(tx),
+	}
+}
+
+func TestCheckLedger(t *testing.T) {
+	now := time.Now()
+	period := now.Add(-48 * time.Hour)
+
+	tests := []struct {
+		name    string
+		period  *time.Time
+		rows    *sqlmock.Rows
+		wantDup bool
+	}{
+		{"no match", nil, sqlmock.NewRows([]string{"id", "tx_hash"}), false},
+		{"within the window", nil, sqlmock.NewRows([]string{"id", "tx_hash"}).AddRow(1, "0xabc"), true},
+		{"same period", &period, sqlmock.NewRows([]string{"id", "tx_hash"}).AddRow(1, "0xabc"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			guard := NewDuplicateGuard(db, DefaultDuplicateConfig())
+
+			pattern := `WHERE \(bond_id = \$1 AND amount = \$2\) AND tx_hash NOT IN .* AND timestamp > \$6 AND`
+			if tt.period != nil {
+				pattern = `AND \(period = \$\d+ OR \(period IS NULL AND timestamp > \$\d+\)\)`
+			}
+			mock.ExpectQuery(pattern).WillReturnRows(tt.rows)
+
+			err := guard.CheckLedger(context.Background(), Candidate{
+				BondID: "7", Amount: big.NewInt(500), Period: tt.period, At: now,
+			})
+			if errors.Is(err, ErrDuplicate) != tt.wantDup {
+				t.Errorf("CheckLedger() error = %v, want duplicate %v", err, tt.wantDup)
+			}
+			if err != nil && !tt.wantDup {
+				t.Errorf("CheckLedger() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckChain(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	now := time.Now()
+	config := DuplicateConfig{Window: time.Hour, ChainBlocks: 25, MaxRange: 10}
+
+	tests := []struct {
+		name     string
+		logs     []types.Log
+		recorded []int64 // Ledger rows found for each matching log
+		wantDup  bool
+	}{
+		{"no distributions", nil, nil, false},
+		{"different amount", []types.Log{distributedLog(contract, 80, "0x01", 499, now)}, nil, false},
+		{"outside the window", []types.Log{distributedLog(contract, 80, "0x01", 500, now.Add(-2*time.Hour))}, nil, false},
+		{"recorded in the ledger", []types.Log{distributedLog(contract, 90, "0x01", 500, now)}, []int64{1}, false},
+		{"missing from the ledger", []types.Log{distributedLog(contract, 99, "0x01", 500, now)}, []int64{0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			for _, n := range tt.recorded {
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "revenue_distributions" WHERE \(bond_id = \$1 AND LOWER\(tx_hash\) = LOWER\(\$2\)\)`).
+					WithArgs("7", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(n))
+			}
+			chain := &fakeChain{head: 100, logs: tt.logs}
+			guard := NewDuplicateGuard(db, config)
+
+			err := guard.CheckChain(context.Background(), chain, contract, Candidate{BondID: "7", Amount: big.NewInt(500), At: now})
+			if errors.Is(err, ErrDuplicate) != tt.wantDup {
+				t.Errorf("CheckChain() error = %v, want duplicate %v", err, tt.wantDup)
+			}
+			if err != nil && !tt.wantDup {
+				t.Errorf("CheckChain() unexpected error = %v", err)
+			}
+			if !tt.wantDup {
+				want := [][2]uint64{{75, 84}, {85, 94}, {95, 100}}
+				if len(chain.ranges) != len(want) {
+					t.Fatalf("queried %v, want %v", chain.ranges, want)
+				}
+				for i := range want {
+					if chain.ranges[i] != want[i] {
+						t.Errorf("range %d = %v, want %v", i, chain.ranges[i], want[i])
+					}
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckChainSkipsNonNumericBonds(t *testing.T) {
+	chain := &fakeChain{head: 100}
+	guard := NewDuplicateGuard(nil, DefaultDuplicateConfig())
+	if err := guard.CheckChain(context.Background(), chain, common.Address{}, Candidate{BondID: "bond-a", Amount: big.NewInt(1)}); err != nil {
+		t.Errorf("CheckChain() error = %v", err)
+	}
+	if len(chain.ranges) != 0 {
+		t.Errorf("queried %v, want nothing", chain.ranges)
+	}
+}
+
+func TestBegin(t *testing.T) {
+	guard := NewDuplicateGuard(nil, DefaultDuplicateConfig())
+
+	release, err := guard.Begin("7")
+	if err != nil {
+		t.Fatalf("Begin() error = %v", err)
+	}
+	if _, err := guard.Begin("7"); !errors.Is(err, ErrInProgress) {
+		t.Errorf("second Begin() error = %v, want ErrInProgress", err)
+	}
+	if other, err := guard.Begin("8"); err != nil {
+		t.Errorf("Begin() for another bond error = %v", err)
+	} else {
+		other()
+	}
+
+	release()
+	if again, err := guard.Begin("7"); err != nil {
+		t.Errorf("Begin() after release error = %v", err)
+	} else {
+		again()
+	}
+}
@@ -0,0 +1,188 @@
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/storage"
+	"gorm.io/gorm"
+)
+
+// RetentionPolicy maps a document kind to how long it is kept. Kinds without
+// a policy, or with a zero duration, are kept indefinitely.
+type RetentionPolicy map[string]time.Duration
+
+// ParseRetention parses "REPORT=2160h,STATEMENT=61320h" into a policy
+func ParseRetention(spec string) (RetentionPolicy, error) {
+	policy := make(RetentionPolicy)
+	if strings.TrimSpace(spec) == "" {
+		return policy, nil
+	}
+	for _, part := range strings.Split(spec, ",") {
+		kind, ttl, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid retention entry: %q", part)
+		}
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention for %s: %w", kind, err)
+		}
+		policy[strings.ToUpper(kind)] = d
+	}
+	return policy, nil
+}
+
+// Manager scans, stores and tracks generated documents for the prospectus,
+// statement and reporting modules
+type Manager struct {
+	db        *gorm.DB
+	store     storage.Store
+	scanner   storage.Scanner
+	retention RetentionPolicy
+}
+
+// NewManager creates a document manager. A nil scanner accepts every document.
+func NewManager(db *gorm.DB, store storage.Store, scanner storage.Scanner, retention RetentionPolicy) *Manager {
+	if scanner == nil {
+		scanner = storage.NoopScanner{}
+	}
+	if retention == nil {
+		retention = make(RetentionPolicy)
+	}
+	return &Manager{db: db, store: store, scanner: scanner, retention: retention}
+}
+
+// Save scans and stores a document and records it for the tenant
+func (m *Manager) Save(
+	ctx context.Context,
+	tenantID string,
+	kind string,
+	name string,
+	contentType string,
+	content []byte,
+) (*models.Document, error) {
+	if err := m.scanner.Scan(ctx, name, content); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(content)
+	now := time.Now()
+	doc := &models.Document{
+		TenantID:    tenantID,
+		Kind:        kind,
+		Name:        name,
+		Backend:     m.store.Name(),
+		ObjectKey:   objectKey(tenantID, kind, now, hex.EncodeToString(sum[:8]), name),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		SHA256:      hex.EncodeToString(sum[:]),
+	}
+	if ttl := m.retention[kind]; ttl > 0 {
+		expiresAt := now.Add(ttl)
+		doc.ExpiresAt = &expiresAt
+	}
+
+	if err := m.store.Put(ctx, doc.ObjectKey, content, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+	if err := m.db.WithContext(ctx).Create(doc).Error; err != nil {
+		_ = m.store.Delete(ctx, doc.ObjectKey)
+		return nil, fmt.Errorf("failed to save document: %w", err)
+	}
+	return doc, nil
+}
+
+// SignedURL returns a time-limited download URL for a tenant's document
+func (m *Manager) SignedURL(ctx context.Context, tenantID string, id uint, ttl time.Duration) (*models.Document, string, error) {
+	var doc models.Document
+	if err := m.db.WithContext(ctx).Where("id = ? AND tenant_id = ?", id, tenantID).First(&doc).Error; err != nil {
+		return nil, "", fmt.Errorf("document not found: %w", err)
+	}
+	if doc.ExpiresAt != nil && doc.ExpiresAt.Before(time.Now()) {
+		return nil, "", fmt.Errorf("document has expired")
+	}
+	if doc.Backend != m.store.Name() {
+		return nil, "", fmt.Errorf("document is stored in %s, not %s", doc.Backend, m.store.Name())
+	}
+
+	url, err := m.store.SignedURL(ctx, doc.ObjectKey, ttl)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign document URL: %w", err)
+	}
+	return &doc, url, nil
+}
+
+// PurgeExpired deletes documents past their retention period and returns how many were removed
+func (m *Manager) PurgeExpired(ctx context.Context) (int, error) {
+	var docs []models.Document
+	if err := m.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at < ? AND backend = ?", time.Now(), m.store.Name()).
+		Limit(500).
+		Find(&docs).Error; err != nil {
+		return 0, fmt.Errorf("failed to load expired documents: %w", err)
+	}
+
+	purged := 0
+	for _, doc := range docs {
+		if err := m.store.Delete(ctx, doc.ObjectKey); err != nil {
+			return purged, err
+		}
+		if err := m.db.WithContext(ctx).Unscoped().Delete(&doc).Error; err != nil {
+			return purged, fmt.Errorf("failed to delete document record: %w", err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// Start purges expired documents every interval until ctx is cancelled
+func (m *Manager) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := m.PurgeExpired(ctx); err != nil {
+				log.Printf("Document retention purge failed: %v", err)
+			} else if n > 0 {
+				log.Printf("Purged %d expired documents", n)
+			}
+		}
+	}
+}
+
+// objectKey builds tenant/kind/yyyy/mm/<hash>-<name>
+func objectKey(tenantID, kind string, at time.Time, hash, name string) string {
+	return path.Join(
+		sanitize(tenantID),
+		strings.ToLower(kind),
+		at.UTC().Format("2006/01"),
+		hash+"-"+sanitize(name),
+	)
+}
+
+// sanitize keeps key segments to a safe character set
+func sanitize(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, s)
+	s = strings.Trim(s, ".")
+	if s == "" {
+		return "_"
+	}
+	return s
+}
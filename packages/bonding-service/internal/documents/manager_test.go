@@ -0,0 +1,42 @@
+package documents
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRetention(t *testing.T) {
+	policy, err := ParseRetention("report=2160h, STATEMENT=61320h")
+	if err != nil {
+		t.Fatalf("ParseRetention() error = %v", err)
+	}
+	if policy["REPORT"] != 2160*time.Hour || policy["STATEMENT"] != 61320*time.Hour {
+		t.Errorf("ParseRetention() = %v", policy)
+	}
+
+	for _, spec := range []string{"REPORT", "REPORT=forever"} {
+		if _, err := ParseRetention(spec); err == nil {
+			t.Errorf("ParseRetention(%q) should fail", spec)
+		}
+	}
+}
+
+func TestObjectKey(t *testing.T) {
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tenant string
+		name   string
+		want   string
+	}{
+		{"acme", "ledger-2026.csv", "acme/report/2026/03/abcd-ledger-2026.csv"},
+		{"../evil", "../../x.csv", "_evil/report/2026/03/abcd-_.._x.csv"},
+		{"", "a b.pdf", "_/report/2026/03/abcd-a_b.pdf"},
+	}
+
+	for _, tt := range tests {
+		if got := objectKey(tt.tenant, "REPORT", at, "abcd", tt.name); got != tt.want {
+			t.Errorf("objectKey(%q, %q) = %q, want %q", tt.tenant, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Package eligibility decides which tranches an investor may buy from their
+// attributes, e.g. that junior tranches are sold only to accredited
+// investors in some jurisdictions. A rule file looks like
+//
+//	{"rules": [{
+//	  "name": "us-junior-accredited",
+//	  "tranches": ["junior"],
+//	  "jurisdictions": ["US"],
+//	  "require_accredited": true
+//	}]}
+package eligibility
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrIneligible is returned by Check for an investor a rule excludes
+var ErrIneligible = errors.New("investor not eligible")
+
+// Tranche classes by tranche ID
+var trancheClasses = []string{"senior", "mezzanine", "junior"}
+
+// TrancheClass returns the class of a tranche ID: senior, mezzanine or junior
+func TrancheClass(trancheID int) string {
+	if trancheID < 0 || trancheID >= len(trancheClasses) {
+		return fmt.Sprintf("tranche %d", trancheID)
+	}
+	return trancheClasses[trancheID]
+}
+
+// Investor holds the attributes rules are evaluated against
+type Investor struct {
+	Jurisdiction    string // ISO 3166 alpha-2, empty if unknown
+	Accredited      bool
+	AccreditedUntil *time.Time // Nil if accreditation doesn't lapse
+}
+
+// accredited reports whether the investor's accreditation holds at now
+func (i Investor) accredited(now time.Time) bool {
+	return i.Accredited && (i.AccreditedUntil == nil || now.Before(*i.AccreditedUntil))
+}
+
+// Rule restricts tranches to accredited investors in some jurisdictions
+type Rule struct {
+	Name              string   `json:"name"`
+	Tranches          []string `json:"tranches"`      // Tranche classes, empty for all
+	Jurisdictions     []string `json:"jurisdictions"` // Empty for all
+	RequireAccredited bool     `json:"require_accredited"`
+}
+
+// applies reports whether the rule covers an investment in the tranche
+// class. An investor whose jurisdiction is unknown is covered by every rule.
+func (r Rule) applies(class string, investor Investor) bool {
+	if len(r.Tranches) > 0 && !slices.Contains(r.Tranches, class) {
+		return false
+	}
+	return len(r.Jurisdictions) == 0 || investor.Jurisdiction == "" ||
+		slices.Contains(r.Jurisdictions, strings.ToUpper(investor.Jurisdiction))
+}
+
+// Policy is a set of eligibility rules
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+// ParsePolicy reads and validates a JSON rule file
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse eligibility rules: %w", err)
+	}
+	seen := make(map[string]bool, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		if rule.Name == "" {
+			return nil, errors.New("eligibility rule without a name")
+		}
+		if seen[rule.Name] {
+			return nil, fmt.Errorf("duplicate eligibility rule %s", rule.Name)
+		}
+		seen[rule.Name] = true
+		if !rule.RequireAccredited {
+			return nil, fmt.Errorf("eligibility rule %s requires nothing", rule.Name)
+		}
+		for _, class := range rule.Tranches {
+			if !slices.Contains(trancheClasses, class) {
+				return nil, fmt.Errorf("eligibility rule %s has unknown tranche %q", rule.Name, class)
+			}
+		}
+		for j, jurisdiction := range rule.Jurisdictions {
+			policy.Rules[i].Jurisdictions[j] = strings.ToUpper(jurisdiction)
+		}
+	}
+	return &policy, nil
+}
+
+// LoadPolicyFile reads a JSON rule file
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read eligibility rules: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// Check returns an error wrapping ErrIneligible, naming the rule, if the
+// investor may not buy the tranche
+func (p *Policy) Check(investor Investor, trancheID int, now time.Time) error {
+	class := TrancheClass(trancheID)
+	for _, rule := range p.Rules {
+		if !rule.applies(class, investor) {
+			continue
+		}
+		if rule.RequireAccredited && !investor.accredited(now) {
+			where := investor.Jurisdiction
+			if where == "" {
+				where = "an unknown jurisdiction"
+			}
+			return fmt.Errorf("%w: %s: %s tranches are limited to accredited investors in %s",
+				ErrIneligible, rule.Name, class, where)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package eligibility
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePolicyInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{"not JSON", `rules: []`},
+		{"no name", `{"rules": [{"tranches": ["junior"], "require_accredited": true}]}`},
+		{"duplicate name", `{"rules": [{"name": "a", "require_accredited": true}, {"name": "a", "require_accredited": true}]}`},
+		{"requires nothing", `{"rules": [{"name": "a", "tranches": ["junior"]}]}`},
+		{"unknown tranche", `{"rules": [{"name": "a", "tranches": ["equity"], "require_accredited": true}]}`},
+	}
+	for _, tt := range tests {
+		if _, err := ParsePolicy([]byte(tt.policy)); err == nil {
+			t.Errorf("%s: ParsePolicy() succeeded, want an error", tt.name)
+		}
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{"rules": [
+		{"name": "us-junior", "tranches": ["junior"], "jurisdictions": ["us", "CA"], "require_accredited": true},
+		{"name": "mezzanine", "tranches": ["mezzanine"], "require_accredited": true}
+	]}`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	lapsed := now.Add(-time.Hour)
+	valid := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		investor Investor
+		tranche  int
+		eligible bool
+	}{
+		{"senior is open", Investor{Jurisdiction: "US"}, 0, true},
+		{"unaccredited US junior", Investor{Jurisdiction: "US"}, 2, false},
+		{"accredited US junior", Investor{Jurisdiction: "US", Accredited: true, AccreditedUntil: &valid}, 2, true},
+		{"lapsed accreditation", Investor{Jurisdiction: "US", Accredited: true, AccreditedUntil: &lapsed}, 2, false},
+		{"junior outside the jurisdictions", Investor{Jurisdiction: "DE"}, 2, true},
+		{"unknown jurisdiction", Investor{}, 2, false},
+		{"mezzanine everywhere", Investor{Jurisdiction: "DE"}, 1, false},
+		{"accredited mezzanine", Investor{Jurisdiction: "de", Accredited: true}, 1, true},
+	}
+	for _, tt := range tests {
+		err := policy.Check(tt.investor, tt.tranche, now)
+		if tt.eligible && err != nil {
+			t.Errorf("%s: Check() error = %v", tt.name, err)
+		}
+		if !tt.eligible && !errors.Is(err, ErrIneligible) {
+			t.Errorf("%s: Check() error = %v, want ErrIneligible", tt.name, err)
+		}
+	}
+}
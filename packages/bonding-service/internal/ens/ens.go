@@ -0,0 +1,225 @@
+package ens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MainnetRegistry is the ENS registry address on Ethereum mainnet
+const MainnetRegistry = "0x00000000000C2E074eC69A0bFb2997BA6C7d2e1e"
+
+const ensABI = `[
+	{"inputs":[{"name":"node","type":"bytes32"}],"name":"resolver","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"node","type":"bytes32"}],"name":"addr","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"node","type":"bytes32"}],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"}
+]`
+
+// ErrNotFound is returned when a name or address has no ENS record
+var ErrNotFound = errors.New("ens record not found")
+
+// Config configures a Resolver
+type Config struct {
+	Registry common.Address
+	CacheTTL time.Duration
+}
+
+// DefaultConfig returns the mainnet registry with a 10 minute cache
+func DefaultConfig() Config {
+	return Config{
+		Registry: common.HexToAddress(MainnetRegistry),
+		CacheTTL: 10 * time.Minute,
+	}
+}
+
+type cacheEntry struct {
+	value   string
+	err     error
+	expires time.Time
+}
+
+// Resolver resolves ENS names to addresses and addresses to their primary names.
+// Endpoints are tried in order until one answers.
+type Resolver struct {
+	endpoints []ethereum.ContractCaller
+	config    Config
+	abi       abi.ABI
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewResolver creates a resolver over one or more mainnet RPC endpoints
+func NewResolver(config Config, endpoints ...ethereum.ContractCaller) (*Resolver, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one resolver endpoint is required")
+	}
+	parsed, err := abi.JSON(strings.NewReader(ensABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ENS ABI: %w", err)
+	}
+	return &Resolver{
+		endpoints: endpoints,
+		config:    config,
+		abi:       parsed,
+		cache:     make(map[string]cacheEntry),
+	}, nil
+}
+
+// IsName reports whether s looks like an ENS name rather than an address
+func IsName(s string) bool {
+	return strings.Contains(s, ".") && !common.IsHexAddress(s)
+}
+
+// Resolve returns the address an ENS name points to
+func (r *Resolver) Resolve(ctx context.Context, name string) (common.Address, error) {
+	name = Normalize(name)
+	value, err := r.cached("fwd:"+name, func() (string, error) {
+		addr, err := r.resolve(ctx, name)
+		return addr.Hex(), err
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.HexToAddress(value), nil
+}
+
+// Lookup returns the primary ENS name of an address. The name is only
+// returned if it resolves back to the same address.
+func (r *Resolver) Lookup(ctx context.Context, address common.Address) (string, error) {
+	return r.cached("rev:"+address.Hex(), func() (string, error) {
+		reverse := strings.ToLower(address.Hex()[2:]) + ".addr.reverse"
+		name, err := r.callString(ctx, reverse, "name")
+		if err != nil {
+			return "", err
+		}
+		if name == "" {
+			return "", ErrNotFound
+		}
+
+		forward, err := r.resolve(ctx, Normalize(name))
+		if err != nil {
+			return "", err
+		}
+		if forward != address {
+			return "", ErrNotFound
+		}
+		return name, nil
+	})
+}
+
+func (r *Resolver) resolve(ctx context.Context, name string) (common.Address, error) {
+	node := Namehash(name)
+	resolver, err := r.callAddress(ctx, r.config.Registry, "resolver", node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if resolver == (common.Address{}) {
+		return common.Address{}, ErrNotFound
+	}
+
+	addr, err := r.callAddress(ctx, resolver, "addr", node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if addr == (common.Address{}) {
+		return common.Address{}, ErrNotFound
+	}
+	return addr, nil
+}
+
+func (r *Resolver) callString(ctx context.Context, name string, method string) (string, error) {
+	node := Namehash(name)
+	resolver, err := r.callAddress(ctx, r.config.Registry, "resolver", node)
+	if err != nil {
+		return "", err
+	}
+	if resolver == (common.Address{}) {
+		return "", ErrNotFound
+	}
+
+	out, err := r.call(ctx, resolver, method, node)
+	if err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(out[0], new(string)).(*string), nil
+}
+
+func (r *Resolver) callAddress(ctx context.Context, contract common.Address, method string, node [32]byte) (common.Address, error) {
+	out, err := r.call(ctx, contract, method, node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// call packs and executes a view call, falling back through the configured endpoints
+func (r *Resolver) call(ctx context.Context, contract common.Address, method string, node [32]byte) ([]interface{}, error) {
+	data, err := r.abi.Pack(method, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+
+	var lastErr error
+	for _, endpoint := range r.endpoints {
+		result, err := endpoint.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(result) == 0 {
+			return nil, ErrNotFound
+		}
+		return r.abi.Unpack(method, result)
+	}
+	return nil, fmt.Errorf("failed to call ENS %s: %w", method, lastErr)
+}
+
+// cached memoizes lookups, including "not found" answers, for the configured TTL
+func (r *Resolver) cached(key string, fetch func() (string, error)) (string, error) {
+	now := time.Now()
+	r.mu.Lock()
+	entry, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.value, entry.err
+	}
+
+	value, err := fetch()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		// Don't cache transport errors
+		return "", err
+	}
+
+	r.mu.Lock()
+	r.cache[key] = cacheEntry{value: value, err: err, expires: now.Add(r.config.CacheTTL)}
+	r.mu.Unlock()
+	return value, err
+}
+
+// Normalize lowercases and trims a name. Full UTS-46 normalization is not applied.
+func Normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Namehash computes the EIP-137 namehash of a normalized name
+func Namehash(name string) [32]byte {
+	var node [32]byte
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		labelHash := crypto.Keccak256([]byte(labels[i]))
+		copy(node[:], crypto.Keccak256(node[:], labelHash))
+	}
+	return node
+}
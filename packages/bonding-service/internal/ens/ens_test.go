@@ -0,0 +1,138 @@
+package ens
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestNamehash(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", "0x0000000000000000000000000000000000000000000000000000000000000000"},
+		{"eth", "0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae"},
+		{"foo.eth", "0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := Namehash(tt.name)
+			if got := hexutil.Encode(node[:]); got != tt.want {
+				t.Errorf("Namehash(%q) = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"vitalik.eth", true},
+		{"sub.domain.eth", true},
+		{"0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0", false},
+		{"not-a-name", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsName(tt.in); got != tt.want {
+			t.Errorf("IsName(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+// fakeENS answers registry and resolver calls for a single name
+type fakeENS struct {
+	resolver common.Address
+	name     string
+	addr     common.Address
+	calls    int
+	fail     bool
+}
+
+func (f *fakeENS) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	f.calls++
+	if f.fail {
+		return nil, errors.New("endpoint down")
+	}
+	r, _ := NewResolver(DefaultConfig(), f)
+	method, err := r.abi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	node := args[0].([32]byte)
+
+	switch method.Name {
+	case "resolver":
+		if node == Namehash(f.name) || node == Namehash(reverseName(f.addr)) {
+			return method.Outputs.Pack(f.resolver)
+		}
+		return method.Outputs.Pack(common.Address{})
+	case "addr":
+		if node == Namehash(f.name) {
+			return method.Outputs.Pack(f.addr)
+		}
+		return method.Outputs.Pack(common.Address{})
+	case "name":
+		return method.Outputs.Pack(f.name)
+	}
+	return nil, errors.New("unexpected method")
+}
+
+func reverseName(addr common.Address) string {
+	return Normalize(addr.Hex()[2:]) + ".addr.reverse"
+}
+
+func TestResolveAndLookup(t *testing.T) {
+	fake := &fakeENS{
+		resolver: common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41"),
+		name:     "vitalik.eth",
+		addr:     common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"),
+	}
+	down := &fakeENS{fail: true}
+
+	r, err := NewResolver(DefaultConfig(), down, fake)
+	if err != nil {
+		t.Fatalf("NewResolver() error = %v", err)
+	}
+
+	addr, err := r.Resolve(context.Background(), "Vitalik.eth")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if addr != fake.addr {
+		t.Errorf("Resolve() = %s, want %s", addr.Hex(), fake.addr.Hex())
+	}
+
+	name, err := r.Lookup(context.Background(), fake.addr)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if name != "vitalik.eth" {
+		t.Errorf("Lookup() = %q, want vitalik.eth", name)
+	}
+
+	calls := fake.calls
+	if _, err := r.Resolve(context.Background(), "vitalik.eth"); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if fake.calls != calls {
+		t.Errorf("Resolve() made %d calls on a cached name", fake.calls-calls)
+	}
+
+	if _, err := r.Resolve(context.Background(), "unknown.eth"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Resolve(unknown.eth) error = %v, want ErrNotFound", err)
+	}
+}
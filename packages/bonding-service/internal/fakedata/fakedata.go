@@ -0,0 +1,323 @@
+// Package fakedata generates realistic bonds, tranches, investments and
+// revenue histories for sandboxes, demos and load tests. The same seed and
+// configuration always generate the same data.
+package fakedata
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Config controls what is generated
+type Config struct {
+	Bonds              int
+	Investors          int // Size of the investor pool investments are drawn from
+	InvestmentsPerBond int
+	TenantID           string
+	Chain              string
+	FirstBondID        int
+	// Now is the time the generated histories end at; zero uses the current time
+	Now time.Time
+}
+
+// DefaultConfig returns a small data set suitable for a sandbox
+func DefaultConfig() Config {
+	return Config{
+		Bonds:              10,
+		Investors:          25,
+		InvestmentsPerBond: 12,
+		TenantID:           "default",
+		Chain:              "arbitrum",
+		FirstBondID:        1,
+	}
+}
+
+// Dataset is generated data, ready to be inserted
+type Dataset struct {
+	Bonds           []models.Bond
+	Tranches        []models.Tranche
+	Investments     []models.Investment
+	Distributions   []models.RevenueDistribution // With their tranche splits
+	RiskAssessments []models.RiskAssessment
+}
+
+// tokenUnit is one whole token of an 18-decimal payment token
+var tokenUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// Tranche shapes: allocation range in percent, APY range and risk level
+var trancheShapes = [...]struct {
+	name           string
+	minPct, maxPct int
+	minAPY, maxAPY float64
+	riskLevel      string
+}{
+	{"Senior", 45, 60, 4, 7, "LOW"},
+	{"Mezzanine", 25, 35, 8, 12, "MEDIUM"},
+	{"Junior", 0, 0, 14, 25, "HIGH"}, // Takes the rest
+}
+
+// Ratings assigned to generated IP, with their default probability and LTV
+var ratings = [...]struct {
+	rating           string
+	defaultProb, ltv float64
+}{
+	{"AA", 0.02, 0.65},
+	{"A", 0.05, 0.60},
+	{"BBB", 0.10, 0.50},
+	{"BB", 0.20, 0.40},
+	{"B", 0.35, 0.30},
+}
+
+// Generator generates data from a seeded source
+type Generator struct {
+	rand   *rand.Rand
+	config Config
+}
+
+// New creates a generator; the same seed and config generate the same data
+func New(seed int64, config Config) *Generator {
+	if config.Now.IsZero() {
+		config.Now = time.Now()
+	}
+	if config.FirstBondID <= 0 {
+		config.FirstBondID = 1
+	}
+	if config.Investors <= 0 {
+		config.Investors = 1
+	}
+	return &Generator{rand: rand.New(rand.NewSource(seed)), config: config}
+}
+
+// Generate generates the configured number of bonds with their histories
+func (g *Generator) Generate() *Dataset {
+	investors := make([]string, g.config.Investors)
+	for i := range investors {
+		investors[i] = g.address()
+	}
+	categories := taxonomy.Defaults()
+
+	data := &Dataset{}
+	for i := 0; i < g.config.Bonds; i++ {
+		g.generateBond(data, strconv.Itoa(g.config.FirstBondID+i), categories[g.rand.Intn(len(categories))].Slug, investors)
+	}
+	return data
+}
+
+func (g *Generator) generateBond(data *Dataset, bondID, category string, investors []string) {
+	now := g.config.Now
+	issuedAt := now.Add(-time.Duration(30+g.rand.Intn(700)) * 24 * time.Hour)
+	maturity := issuedAt.AddDate(1+g.rand.Intn(5), 0, 0)
+	totalValue := g.tokens(50_000, 2_000_000)
+
+	bond := models.Bond{
+		BondID:       bondID,
+		TenantID:     g.config.TenantID,
+		Chain:        g.config.Chain,
+		IPNFTId:      strconv.Itoa(1000 + g.rand.Intn(9_000_000)),
+		NFTContract:  g.address(),
+		Issuer:       g.address(),
+		TotalValue:   totalValue.String(),
+		MaturityDate: maturity,
+		Status:       "ACTIVE",
+		TxHash:       g.hash(),
+		Category:     category,
+	}
+	bond.CreatedAt = issuedAt
+	if maturity.Before(now) {
+		bond.Status = "MATURED"
+	}
+
+	// Tranches, filled to between 40% and 100% of their allocation
+	tranches := make([]models.Tranche, len(trancheShapes))
+	remainingPct := 100
+	for i, shape := range trancheShapes {
+		pct := remainingPct
+		if i < len(trancheShapes)-1 {
+			pct = shape.minPct + g.rand.Intn(shape.maxPct-shape.minPct+1)
+		}
+		remainingPct -= pct
+		allocation := new(big.Int).Div(new(big.Int).Mul(totalValue, big.NewInt(int64(pct))), big.NewInt(100))
+		tranches[i] = models.Tranche{
+			BondID:        bondID,
+			TrancheID:     i,
+			Name:          shape.name,
+			Priority:      i,
+			Allocation:    allocation.String(),
+			APY:           shape.minAPY + float64(g.rand.Intn(int(shape.maxAPY-shape.minAPY)*4+1))/4,
+			RiskLevel:     shape.riskLevel,
+			TotalInvested: "0",
+			Arrears:       "0",
+		}
+		tranches[i].CreatedAt = issuedAt
+	}
+
+	// Investments over the first month after issuance
+	invested := make([]*big.Int, len(tranches))
+	for i := range invested {
+		invested[i] = new(big.Int)
+	}
+	for i := 0; i < g.config.InvestmentsPerBond; i++ {
+		t := g.rand.Intn(len(tranches))
+		allocation, _ := new(big.Int).SetString(tranches[t].Allocation, 10)
+		room := new(big.Int).Sub(allocation, invested[t])
+		ticket := new(big.Int).Div(allocation, big.NewInt(int64(2+g.rand.Intn(g.config.InvestmentsPerBond+1))))
+		if ticket.Cmp(room) > 0 {
+			ticket = room
+		}
+		if ticket.Sign() == 0 {
+			continue
+		}
+		invested[t].Add(invested[t], ticket)
+		investment := models.Investment{
+			BondID:    bondID,
+			TrancheID: t,
+			Investor:  investors[g.rand.Intn(len(investors))],
+			Amount:    ticket.String(),
+			TxHash:    g.hash(),
+			Timestamp: issuedAt.Add(time.Duration(g.rand.Intn(30*24)) * time.Hour),
+		}
+		investment.CreatedAt = investment.Timestamp
+		data.Investments = append(data.Investments, investment)
+	}
+	for i := range tranches {
+		tranches[i].TotalInvested = invested[i].String()
+	}
+
+	// Monthly revenue, usually covering the coupons and now and then short
+	arrears := make([]*big.Int, len(tranches))
+	for i := range arrears {
+		arrears[i] = new(big.Int)
+	}
+	totalRevenue := new(big.Int)
+	end := now
+	if maturity.Before(end) {
+		end = maturity
+	}
+	const period = 30 * 24 * time.Hour
+	for at := issuedAt.Add(period); !at.After(end); at = at.Add(period) {
+		states := make([]waterfall.TrancheState, len(tranches))
+		due := new(big.Int)
+		for i, t := range tranches {
+			states[i] = waterfall.TrancheState{
+				TrancheID: t.TrancheID,
+				Priority:  t.Priority,
+				CouponDue: waterfall.PeriodCoupon(invested[i], int64(t.APY*100), int64(period.Seconds())),
+				Arrears:   arrears[i],
+			}
+			due.Add(due, states[i].CouponDue)
+		}
+		// Between 70% and 160% of the coupons due
+		revenue := new(big.Int).Div(new(big.Int).Mul(due, big.NewInt(int64(70+g.rand.Intn(91)))), big.NewInt(100))
+		if revenue.Sign() == 0 {
+			continue
+		}
+		result := waterfall.Run(revenue, states)
+		totalRevenue.Add(totalRevenue, result.Distributed)
+
+		distribution := models.RevenueDistribution{
+			BondID:    bondID,
+			Amount:    result.Distributed.String(),
+			Shortfall: result.TotalShortfall.String(),
+			TxHash:    g.hash(),
+			Timestamp: at,
+		}
+		distribution.CreatedAt = at
+		for _, r := range result.Tranches {
+			arrears[r.TrancheID] = r.Arrears
+			distribution.Tranches = append(distribution.Tranches, models.TrancheDistribution{
+				BondID:       bondID,
+				TrancheID:    r.TrancheID,
+				CouponDue:    r.CouponDue.String(),
+				ArrearsPaid:  r.ArrearsPaid.String(),
+				CouponPaid:   r.CouponPaid.String(),
+				Residual:     r.Residual.String(),
+				Shortfall:    r.Shortfall.String(),
+				ArrearsAfter: r.Arrears.String(),
+				Invested:     invested[r.TrancheID].String(),
+			})
+		}
+		data.Distributions = append(data.Distributions, distribution)
+	}
+	for i := range tranches {
+		tranches[i].Arrears = arrears[i].String()
+	}
+	bond.TotalRevenue = totalRevenue.String()
+
+	r := ratings[g.rand.Intn(len(ratings))]
+	data.RiskAssessments = append(data.RiskAssessments, models.RiskAssessment{
+		IPNFTId:            bond.IPNFTId,
+		ValuationUSD:       float64(new(big.Int).Div(totalValue, tokenUnit).Int64()) / r.ltv,
+		ConfidenceScore:    0.5 + float64(g.rand.Intn(41))/100,
+		RiskRating:         r.rating,
+		DefaultProbability: r.defaultProb,
+		RecommendedLTV:     r.ltv,
+		RiskFactors:        "[]",
+		RiskModel:          "heuristic",
+		AssessedAt:         issuedAt.Add(-time.Hour),
+	})
+
+	data.Bonds = append(data.Bonds, bond)
+	data.Tranches = append(data.Tranches, tranches...)
+}
+
+// tokens returns a random whole number of tokens between min and max
+func (g *Generator) tokens(min, max int64) *big.Int {
+	n := big.NewInt(min + g.rand.Int63n(max-min+1))
+	return n.Mul(n, tokenUnit)
+}
+
+func (g *Generator) address() string {
+	var a common.Address
+	g.rand.Read(a[:])
+	return a.Hex()
+}
+
+func (g *Generator) hash() string {
+	var h common.Hash
+	g.rand.Read(h[:])
+	return h.Hex()
+}
+
+// Insert writes a dataset in one transaction
+func Insert(ctx context.Context, db *gorm.DB, data *Dataset) error {
+	const batch = 100
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		steps := []struct {
+			what  string
+			value interface{}
+			empty bool
+		}{
+			{"bonds", &data.Bonds, len(data.Bonds) == 0},
+			{"tranches", &data.Tranches, len(data.Tranches) == 0},
+			{"investments", &data.Investments, len(data.Investments) == 0},
+			{"distributions", &data.Distributions, len(data.Distributions) == 0},
+			{"risk assessments", &data.RiskAssessments, len(data.RiskAssessments) == 0},
+		}
+		for _, step := range steps {
+			if step.empty {
+				continue
+			}
+			// Distributions bring their tranche splits; bonds and tranches are
+			// inserted on their own
+			q := tx
+			if step.what != "distributions" {
+				q = q.Omit(clause.Associations)
+			}
+			if err := q.CreateInBatches(step.value, batch).Error; err != nil {
+				return fmt.Errorf("failed to insert %s: %w", step.what, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,72 @@
+package fakedata
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	config := DefaultConfig()
+	config.Now = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	first := New(42, config).Generate()
+	if !reflect.DeepEqual(first, New(42, config).Generate()) {
+		t.Error("Generate() with the same seed returned different data")
+	}
+	if reflect.DeepEqual(first.Bonds, New(43, config).Generate().Bonds) {
+		t.Error("Generate() with another seed returned the same bonds")
+	}
+}
+
+func TestGenerateIsConsistent(t *testing.T) {
+	config := DefaultConfig()
+	config.Now = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := New(7, config).Generate()
+
+	if len(data.Bonds) != config.Bonds || len(data.Tranches) != 3*config.Bonds || len(data.RiskAssessments) != config.Bonds {
+		t.Fatalf("generated %d bonds, %d tranches and %d assessments", len(data.Bonds), len(data.Tranches), len(data.RiskAssessments))
+	}
+
+	sum := func(values ...string) *big.Int {
+		total := new(big.Int)
+		for _, v := range values {
+			n, ok := new(big.Int).SetString(v, 10)
+			if !ok {
+				t.Fatalf("invalid amount %q", v)
+			}
+			total.Add(total, n)
+		}
+		return total
+	}
+	for _, bond := range data.Bonds {
+		var allocations, invested, distributed []string
+		for _, tranche := range data.Tranches {
+			if tranche.BondID == bond.BondID {
+				allocations = append(allocations, tranche.Allocation)
+				var tickets []string
+				for _, inv := range data.Investments {
+					if inv.BondID == bond.BondID && inv.TrancheID == tranche.TrancheID {
+						tickets = append(tickets, inv.Amount)
+					}
+				}
+				if sum(tickets...).Cmp(sum(tranche.TotalInvested)) != 0 {
+					t.Errorf("bond %s tranche %d: investments don't add up to %s", bond.BondID, tranche.TrancheID, tranche.TotalInvested)
+				}
+				invested = append(invested, tranche.TotalInvested)
+			}
+		}
+		for _, d := range data.Distributions {
+			if d.BondID == bond.BondID {
+				distributed = append(distributed, d.Amount)
+			}
+		}
+		if sum(invested...).Cmp(sum(allocations...)) > 0 || sum(allocations...).Cmp(sum(bond.TotalValue)) > 0 {
+			t.Errorf("bond %s is oversubscribed or overallocated", bond.BondID)
+		}
+		if sum(distributed...).Cmp(sum(bond.TotalRevenue)) != 0 {
+			t.Errorf("bond %s: distributions don't add up to its revenue %s", bond.BondID, bond.TotalRevenue)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+// Package flags rolls risky capabilities out gradually. Each flag is stored
+// in the database so operators can flip it, or widen its rollout, without a
+// redeploy; every instance picks changes up within its reload interval.
+package flags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Flag names
+const (
+	ContractCalls   = "contract_calls"    // Writes send transactions to the bond contracts
+	ERC20Payments   = "erc20_payments"    // Investments can be paid in ERC-20 tokens with a permit
+	OracleRiskModel = "oracle_risk_model" // Risk is assessed with the oracle-backed model where it is routed
+)
+
+// Flag is a capability that can be rolled out gradually
+type Flag struct {
+	Name        string
+	Description string
+	Default     bool // Whether the flag is on before an operator first sets it
+}
+
+// Known are the flags the service consults. They default to on, so a
+// deployment behaves as it did before flags existed until one is turned off.
+var Known = []Flag{
+	{ContractCalls, "Bond writes send transactions to the bond contracts", true},
+	{ERC20Payments, "Investments can be paid in ERC-20 tokens with a signed permit", true},
+	{OracleRiskModel, "Risk is assessed with the oracle-backed model where it is routed", true},
+}
+
+// ErrInvalidFlag wraps rejected flag settings
+var ErrInvalidFlag = errors.New("invalid feature flag")
+
+// Lookup returns the known flag with the given name
+func Lookup(name string) (Flag, bool) {
+	for _, f := range Known {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Flag{}, false
+}
+
+// Names returns the names of the known flags
+func Names() []string {
+	names := make([]string, len(Known))
+	for i, f := range Known {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// Default reports whether a flag is on before it is first set. Unknown
+// flags are off.
+func Default(name string) bool {
+	f, _ := Lookup(name)
+	return f.Default
+}
+
+// Defaults returns every known flag at its default setting, fully rolled out
+func Defaults() []models.FeatureFlag {
+	defaults := make([]models.FeatureFlag, len(Known))
+	for i, f := range Known {
+		defaults[i] = models.FeatureFlag{Name: f.Name, Enabled: f.Default, RolloutPercent: 100}
+	}
+	return defaults
+}
+
+// Store keeps the flag settings in the database and evaluates them from
+// memory. Changes made through another instance are picked up by Start.
+type Store struct {
+	db    *gorm.DB
+	flags atomic.Pointer[map[string]models.FeatureFlag]
+}
+
+// NewStore creates a store. Until Load, every flag has its default.
+func NewStore(db *gorm.DB) *Store {
+	s := &Store{db: db}
+	s.flags.Store(&map[string]models.FeatureFlag{})
+	return s
+}
+
+// Load reads the stored flag settings
+func (s *Store) Load(ctx context.Context) error {
+	var stored []models.FeatureFlag
+	if err := s.db.WithContext(ctx).Find(&stored).Error; err != nil {
+		return fmt.Errorf("failed to load feature flags: %w", err)
+	}
+	flags := make(map[string]models.FeatureFlag, len(stored))
+	for _, f := range stored {
+		flags[f.Name] = f
+	}
+	s.flags.Store(&flags)
+	return nil
+}
+
+// Start reloads the flags periodically until the context is cancelled
+func (s *Store) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Failed to reload feature flags: %v", err)
+			}
+		}
+	}
+}
+
+// Enabled reports whether a flag is on for a tenant
+func (s *Store) Enabled(tenantID, name string) bool {
+	f, ok := (*s.flags.Load())[name]
+	if !ok {
+		return Default(name)
+	}
+	return enabledFor(&f, tenantID)
+}
+
+// List returns every known flag's setting, with defaults for the flags
+// never set
+func (s *Store) List() []models.FeatureFlag {
+	flags := *s.flags.Load()
+	list := Defaults()
+	for i := range list {
+		if f, ok := flags[list[i].Name]; ok {
+			list[i] = f
+		}
+	}
+	return list
+}
+
+// Set stores a flag's setting, replacing the previous one
+func (s *Store) Set(ctx context.Context, name string, enabled bool, rolloutPercent int, tenants []string, updatedBy string) (*models.FeatureFlag, error) {
+	if _, ok := Lookup(name); !ok {
+		return nil, fmt.Errorf("%w: unknown flag %q", ErrInvalidFlag, name)
+	}
+	if rolloutPercent < 0 || rolloutPercent > 100 {
+		return nil, fmt.Errorf("%w: rollout must be between 0 and 100 percent, got %d", ErrInvalidFlag, rolloutPercent)
+	}
+	var listed []string
+	for _, t := range tenants {
+		if t = strings.TrimSpace(t); t != "" && !slices.Contains(listed, t) {
+			listed = append(listed, t)
+		}
+	}
+
+	flag := models.FeatureFlag{
+		Name:           name,
+		Enabled:        enabled,
+		RolloutPercent: rolloutPercent,
+		Tenants:        strings.Join(listed, ","),
+		UpdatedBy:      updatedBy,
+	}
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "rollout_percent", "tenants", "updated_by", "updated_at"}),
+	}).Create(&flag).Error; err != nil {
+		return nil, fmt.Errorf("failed to save feature flag %s: %w", name, err)
+	}
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+	log.Printf("Feature flag %s set to enabled=%t rollout=%d%% tenants=%q by %s", name, enabled, rolloutPercent, flag.Tenants, updatedBy)
+	flag = (*s.flags.Load())[name]
+	return &flag, nil
+}
+
+// TenantList splits a flag's listed tenants
+func TenantList(f *models.FeatureFlag) []string {
+	if f.Tenants == "" {
+		return nil
+	}
+	return strings.Split(f.Tenants, ",")
+}
+
+// enabledFor evaluates a stored flag for a tenant. The rollout bucket hashes
+// the flag name with the tenant, so widening a rollout keeps the tenants
+// already in it and different flags reach different tenants first.
+func enabledFor(f *models.FeatureFlag, tenantID string) bool {
+	if !f.Enabled {
+		return false
+	}
+	if slices.Contains(TenantList(f), tenantID) {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(f.Name + "/" + tenantID))
+	return int(h.Sum32()%100) < f.RolloutPercent
+}
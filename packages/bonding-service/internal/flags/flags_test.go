@@ -0,0 +1,127 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+var flagColumns = []string{"id", "name", "enabled", "rollout_percent", "tenants", "updated_by"}
+
+func TestEnabled(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT \* FROM "feature_flags"`).WillReturnRows(sqlmock.NewRows(flagColumns).
+		AddRow(1, ContractCalls, false, 100, "acme", "ops").
+		AddRow(2, ERC20Payments, true, 0, "acme,globex", "ops"))
+
+	store := NewStore(db)
+	if err := store.Load(context.Background()); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		tenant string
+		flag   string
+		want   bool
+	}{
+		{"disabled for everyone, even listed tenants", "acme", ContractCalls, false},
+		{"listed tenant", "globex", ERC20Payments, true},
+		{"unlisted tenant outside the rollout", "initech", ERC20Payments, false},
+		{"never set", "initech", OracleRiskModel, true},
+		{"unknown flag", "acme", "time_travel", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := store.Enabled(tt.tenant, tt.flag); got != tt.want {
+				t.Errorf("Enabled(%s, %s) = %t, want %t", tt.tenant, tt.flag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRolloutWidens(t *testing.T) {
+	var tenants []string
+	for i := 0; i < 1000; i++ {
+		tenants = append(tenants, fmt.Sprintf("tenant-%d", i))
+	}
+	reached := func(percent int) map[string]bool {
+		f := &models.FeatureFlag{Name: ERC20Payments, Enabled: true, RolloutPercent: percent}
+		on := make(map[string]bool)
+		for _, tenant := range tenants {
+			if enabledFor(f, tenant) {
+				on[tenant] = true
+			}
+		}
+		return on
+	}
+
+	ten, half := reached(10), reached(50)
+	if len(ten) < 50 || len(ten) > 150 {
+		t.Errorf("10%% rollout reached %d of 1000 tenants", len(ten))
+	}
+	for tenant := range ten {
+		if !half[tenant] {
+			t.Errorf("%s dropped out when the rollout widened to 50%%", tenant)
+		}
+	}
+	if len(reached(0)) != 0 || len(reached(100)) != len(tenants) {
+		t.Error("0% and 100% rollouts should reach no tenant and every tenant")
+	}
+}
+
+func TestSet(t *testing.T) {
+	db, mock := newMockDB(t)
+	store := NewStore(db)
+
+	mock.ExpectQuery(`INSERT INTO "feature_flags" .* ON CONFLICT \("name"\) DO UPDATE SET "enabled"="excluded"."enabled"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(`SELECT \* FROM "feature_flags"`).WillReturnRows(sqlmock.NewRows(flagColumns).
+		AddRow(2, ERC20Payments, true, 25, "acme", "ops"))
+
+	flag, err := store.Set(context.Background(), ERC20Payments, true, 25, []string{" acme", "acme", ""}, "ops")
+	if err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if flag.ID != 2 || flag.Tenants != "acme" || !store.Enabled("acme", ERC20Payments) {
+		t.Errorf("Set() = %+v, want the reloaded flag on for acme", flag)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	for _, bad := range []struct {
+		name    string
+		percent int
+	}{{"time_travel", 100}, {ContractCalls, 101}, {ContractCalls, -1}} {
+		if _, err := store.Set(context.Background(), bad.name, true, bad.percent, nil, "ops"); !errors.Is(err, ErrInvalidFlag) {
+			t.Errorf("Set(%s, %d%%) error = %v, want ErrInvalidFlag", bad.name, bad.percent, err)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package forecast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/timeline"
+	"gorm.io/gorm"
+)
+
+// Config controls when revenue variance triggers a rating review
+type Config struct {
+	Threshold float64       // Relative variance, either way, that breaches the forecast
+	Periods   int           // Consecutive breached periods that trigger a review
+	Interval  time.Duration // How often closed periods are checked for every bond
+}
+
+// DefaultConfig returns default variance review configuration
+func DefaultConfig() Config {
+	return Config{
+		Threshold: 0.2,
+		Periods:   2,
+		Interval:  time.Hour,
+	}
+}
+
+// Period is a forecast period compared with its actual revenue
+type Period struct {
+	Start    time.Time
+	End      time.Time
+	Forecast *big.Int
+	Actual   *big.Int
+	Variance float64 // (actual - forecast) / forecast
+	Closed   bool    // Only closed periods can breach
+	Breached bool
+}
+
+// Evaluation is the forecast versus actual revenue of a bond
+type Evaluation struct {
+	Periods     []Period
+	Consecutive int       // Trailing closed periods that breached
+	StreakStart time.Time // End of the first period in the trailing streak
+	Review      bool
+}
+
+// Validate checks forecast periods are positive and don't overlap
+func Validate(forecasts []models.RevenueForecast) error {
+	for i, f := range forecasts {
+		if !f.PeriodEnd.After(f.PeriodStart) {
+			return fmt.Errorf("forecast period %d ends before it starts", i)
+		}
+		amount, ok := new(big.Int).SetString(f.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return fmt.Errorf("forecast period %d has an invalid amount", i)
+		}
+		if i > 0 && f.PeriodStart.Before(forecasts[i-1].PeriodEnd) {
+			return errors.New("forecast periods must be in order and must not overlap")
+		}
+	}
+	return nil
+}
+
+// Variance returns how far actual revenue is from the forecast, relative to
+// the forecast. Revenue against a zero forecast is an unbounded variance.
+func Variance(forecast, actual *big.Int) float64 {
+	if forecast.Sign() == 0 {
+		if actual.Sign() == 0 {
+			return 0
+		}
+		return 1
+	}
+	diff := new(big.Float).SetInt(new(big.Int).Sub(actual, forecast))
+	variance, _ := diff.Quo(diff, new(big.Float).SetInt(forecast)).Float64()
+	return variance
+}
+
+// Evaluate compares forecasts, ordered by period, with their actuals
+func Evaluate(forecasts []models.RevenueForecast, now time.Time, config Config) Evaluation {
+	var e Evaluation
+	for _, f := range forecasts {
+		p := Period{
+			Start:    f.PeriodStart,
+			End:      f.PeriodEnd,
+			Forecast: parseAmount(f.Amount),
+			Actual:   parseAmount(f.Actual),
+			Closed:   !f.PeriodEnd.After(now),
+		}
+		p.Variance = Variance(p.Forecast, p.Actual)
+		p.Breached = p.Closed && (p.Variance > config.Threshold || p.Variance < -config.Threshold)
+		e.Periods = append(e.Periods, p)
+
+		switch {
+		case p.Breached:
+			if e.Consecutive == 0 {
+				e.StreakStart = p.End
+			}
+			e.Consecutive++
+		case p.Closed:
+			e.Consecutive = 0
+			e.StreakStart = time.Time{}
+		}
+	}
+	e.Review = config.Periods > 0 && e.Consecutive >= config.Periods
+	return e
+}
+
+// RecordActual adds distributed revenue to the forecast period it arrived in.
+// Revenue outside every forecast period isn't compared.
+func RecordActual(tx *gorm.DB, bondID string, amount *big.Int, at time.Time) error {
+	var periods []models.RevenueForecast
+	if err := tx.Where("bond_id = ? AND period_start <= ? AND period_end > ?", bondID, at, at).
+		Limit(1).Find(&periods).Error; err != nil {
+		return fmt.Errorf("failed to load forecast period: %w", err)
+	}
+	if len(periods) == 0 {
+		return nil
+	}
+
+	actual := new(big.Int).Add(parseAmount(periods[0].Actual), amount)
+	if err := tx.Model(&periods[0]).Update("actual", actual.String()).Error; err != nil {
+		return fmt.Errorf("failed to record actual revenue: %w", err)
+	}
+	return nil
+}
+
+// Reviewer flags bonds for a rating review when their revenue breaches the
+// forecast for consecutive periods
+type Reviewer struct {
+	db     *gorm.DB
+	config Config
+}
+
+// New creates a reviewer
+func New(db *gorm.DB, config Config) *Reviewer {
+	return &Reviewer{db: db, config: config}
+}
+
+// Config returns the reviewer's configuration
+func (r *Reviewer) Config() Config {
+	return r.config
+}
+
+// Start reviews every bond on every interval until the context is cancelled,
+// so periods that close without any revenue are caught too
+func (r *Reviewer) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.ReviewAll(ctx); err != nil {
+				log.Printf("Revenue variance review failed: %v", err)
+			}
+		}
+	}
+}
+
+// ReviewAll reviews every active bond with a forecast
+func (r *Reviewer) ReviewAll(ctx context.Context) error {
+	var bondIDs []string
+	if err := r.db.WithContext(ctx).Model(&models.RevenueForecast{}).
+		Distinct("bond_id").Pluck("bond_id", &bondIDs).Error; err != nil {
+		return fmt.Errorf("failed to load forecast bonds: %w", err)
+	}
+	for _, bondID := range bondIDs {
+		if _, err := r.Review(ctx, bondID); err != nil {
+			log.Printf("Failed to review revenue variance of bond %s: %v", bondID, err)
+		}
+	}
+	return nil
+}
+
+// Review evaluates a bond's forecast and flags it for a rating review once per
+// breach streak
+func (r *Reviewer) Review(ctx context.Context, bondID string) (*Evaluation, error) {
+	db := r.db.WithContext(ctx)
+
+	var bond models.Bond
+	if err := db.Where("bond_id = ?", bondID).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("failed to load bond: %w", err)
+	}
+	forecasts, err := Load(db, bondID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := Evaluate(forecasts, time.Now(), r.config)
+	if !e.Review || bond.Status != "ACTIVE" {
+		return &e, nil
+	}
+	if bond.RatingReviewAt != nil && !bond.RatingReviewAt.Before(e.StreakStart) {
+		return &e, nil // Already under review for this streak
+	}
+
+	// The latest closed period sets the direction
+	direction := "under"
+	for i := len(e.Periods) - 1; i >= 0; i-- {
+		if e.Periods[i].Closed {
+			if e.Periods[i].Variance > 0 {
+				direction = "over"
+			}
+			break
+		}
+	}
+	reason := fmt.Sprintf("revenue %sperformed its forecast by more than %.0f%% for %d consecutive periods",
+		direction, r.config.Threshold*100, e.Consecutive)
+
+	now := time.Now()
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&bond).Updates(map[string]interface{}{
+			"rating_review_at":     now,
+			"rating_review_reason": reason,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to flag rating review: %w", err)
+		}
+		return timeline.Record(tx, bondID, timeline.Covenant, "rating review: "+reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.RatingReviews.WithLabelValues(bond.Chain, direction).Inc()
+	log.Printf("ALERT: bond %s needs a rating review: %s", bondID, reason)
+	return &e, nil
+}
+
+// Load returns a bond's forecast periods in order
+func Load(db *gorm.DB, bondID string) ([]models.RevenueForecast, error) {
+	var forecasts []models.RevenueForecast
+	if err := db.Where("bond_id = ?", bondID).Order("period_start ASC").Find(&forecasts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load revenue forecast: %w", err)
+	}
+	return forecasts, nil
+}
+
+func parseAmount(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
@@ -0,0 +1,110 @@
+package forecast
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestVariance(t *testing.T) {
+	tests := []struct {
+		forecast, actual int64
+		want             float64
+	}{
+		{1000, 1000, 0},
+		{1000, 700, -0.3},
+		{1000, 1250, 0.25},
+		{0, 0, 0},
+		{0, 10, 1},
+	}
+
+	for _, tt := range tests {
+		if got := Variance(big.NewInt(tt.forecast), big.NewInt(tt.actual)); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Variance(%d, %d) = %v, want %v", tt.forecast, tt.actual, got, tt.want)
+		}
+	}
+}
+
+// quarters returns consecutive 90-day forecast periods of 1000 with the given actuals
+func quarters(start time.Time, actuals ...string) []models.RevenueForecast {
+	forecasts := make([]models.RevenueForecast, len(actuals))
+	for i, actual := range actuals {
+		forecasts[i] = models.RevenueForecast{
+			PeriodStart: start.Add(time.Duration(i) * 90 * 24 * time.Hour),
+			PeriodEnd:   start.Add(time.Duration(i+1) * 90 * 24 * time.Hour),
+			Amount:      "1000",
+			Actual:      actual,
+		}
+	}
+	return forecasts
+}
+
+func TestEvaluate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	config := Config{Threshold: 0.2, Periods: 2}
+	// Three quarters have closed, the fourth is open
+	now := start.Add(300 * 24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		actuals     []string
+		consecutive int
+		review      bool
+	}{
+		{"on forecast", []string{"1000", "900", "1100", "0"}, 0, false},
+		{"one miss", []string{"1000", "1000", "500", "0"}, 1, false},
+		{"two misses", []string{"1000", "500", "600", "0"}, 2, true},
+		{"beats forecast", []string{"1500", "1300", "1400", "0"}, 3, true},
+		{"streak broken", []string{"500", "500", "1000", "0"}, 0, false},
+		{"open period ignored", []string{"1000", "1000", "500", "5000"}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Evaluate(quarters(start, tt.actuals...), now, config)
+			if e.Consecutive != tt.consecutive || e.Review != tt.review {
+				t.Errorf("Evaluate() consecutive = %d, review = %v, want %d, %v",
+					e.Consecutive, e.Review, tt.consecutive, tt.review)
+			}
+			if last := e.Periods[3]; last.Closed || last.Breached {
+				t.Errorf("open period closed = %v, breached = %v", last.Closed, last.Breached)
+			}
+		})
+	}
+
+	// The streak starts at the end of its first breached period
+	e := Evaluate(quarters(start, "1000", "500", "600", "0"), now, config)
+	if want := start.Add(180 * 24 * time.Hour); !e.StreakStart.Equal(want) {
+		t.Errorf("StreakStart = %v, want %v", e.StreakStart, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := quarters(start, "0", "0")
+
+	overlapping := quarters(start, "0", "0")
+	overlapping[1].PeriodStart = overlapping[0].PeriodEnd.Add(-time.Hour)
+
+	reversed := quarters(start, "0")
+	reversed[0].PeriodEnd = reversed[0].PeriodStart
+
+	badAmount := quarters(start, "0")
+	badAmount[0].Amount = "1.5"
+
+	if err := Validate(valid); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	for name, forecasts := range map[string][]models.RevenueForecast{
+		"overlapping": overlapping,
+		"reversed":    reversed,
+		"bad amount":  badAmount,
+	} {
+		if err := Validate(forecasts); err == nil {
+			t.Errorf("Validate() accepted %s periods", name)
+		}
+	}
+}
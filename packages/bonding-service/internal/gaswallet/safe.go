@@ -0,0 +1,214 @@
+package gaswallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// proposalOrigin identifies the service's proposals in the Safe interface
+const proposalOrigin = "knowton-bonding-service"
+
+// SafeProposer proposes ETH transfers from a Safe multisig through the Safe
+// Transaction Service. Its key must belong to an owner or delegate of the
+// Safe; the owners still confirm and execute the transfer.
+type SafeProposer struct {
+	serviceURL string
+	safe       common.Address
+	chainID    *big.Int
+	key        *ecdsa.PrivateKey
+	httpClient *http.Client
+}
+
+// NewSafeProposer creates a proposer for the Safe at safe on chainID,
+// reached through the transaction service at serviceURL
+func NewSafeProposer(serviceURL string, safe common.Address, chainID int64, key *ecdsa.PrivateKey) *SafeProposer {
+	return &SafeProposer{
+		serviceURL: strings.TrimRight(serviceURL, "/"),
+		safe:       safe,
+		chainID:    big.NewInt(chainID),
+		key:        key,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SafeTx is a Safe transaction; gas refund fields are always zero
+type SafeTx struct {
+	To    common.Address
+	Value *big.Int
+	Nonce uint64
+}
+
+// Hash returns the EIP-712 digest of the transaction that owners sign
+func (p *SafeProposer) Hash(tx SafeTx) ([]byte, error) {
+	zero := common.Address{}.Hex()
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           (*math.HexOrDecimal256)(p.chainID),
+			VerifyingContract: p.safe.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          tx.Value.String(),
+			"data":           "0x",
+			"operation":      "0",
+			"safeTxGas":      "0",
+			"baseGas":        "0",
+			"gasPrice":       "0",
+			"gasToken":       zero,
+			"refundReceiver": zero,
+			"nonce":          strconv.FormatUint(tx.Nonce, 10),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash Safe transaction: %w", err)
+	}
+	return hash, nil
+}
+
+// ProposeTransfer proposes sending amount wei from the Safe to to and
+// returns the Safe transaction hash
+func (p *SafeProposer) ProposeTransfer(ctx context.Context, to common.Address, amount *big.Int) (string, error) {
+	nonce, err := p.nextNonce(ctx)
+	if err != nil {
+		return "", err
+	}
+	tx := SafeTx{To: to, Value: amount, Nonce: nonce}
+	hash, err := p.Hash(tx)
+	if err != nil {
+		return "", err
+	}
+	signature, err := crypto.Sign(hash, p.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign Safe transaction: %w", err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	zero := common.Address{}.Hex()
+	body, err := json.Marshal(map[string]any{
+		"to":                      tx.To.Hex(),
+		"value":                   tx.Value.String(),
+		"data":                    nil,
+		"operation":               0,
+		"safeTxGas":               "0",
+		"baseGas":                 "0",
+		"gasPrice":                "0",
+		"gasToken":                zero,
+		"refundReceiver":          zero,
+		"nonce":                   tx.Nonce,
+		"contractTransactionHash": hexutil.Encode(hash),
+		"sender":                  crypto.PubkeyToAddress(p.key.PublicKey).Hex(),
+		"signature":               hexutil.Encode(signature),
+		"origin":                  proposalOrigin,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode proposal: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/safes/%s/multisig-transactions/", p.serviceURL, p.safe.Hex())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to propose Safe transaction: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Safe transaction service returned status %d", resp.StatusCode)
+	}
+	return hexutil.Encode(hash), nil
+}
+
+// nextNonce returns the nonce after the Safe's executed transactions and any
+// already queued, so a proposal doesn't compete with one awaiting signatures
+func (p *SafeProposer) nextNonce(ctx context.Context) (uint64, error) {
+	var safe struct {
+		Nonce flexibleUint `json:"nonce"`
+	}
+	if err := p.get(ctx, fmt.Sprintf("/api/v1/safes/%s/", p.safe.Hex()), &safe); err != nil {
+		return 0, fmt.Errorf("failed to read Safe nonce: %w", err)
+	}
+	nonce := uint64(safe.Nonce)
+
+	var queued struct {
+		Results []struct {
+			Nonce flexibleUint `json:"nonce"`
+		} `json:"results"`
+	}
+	path := fmt.Sprintf("/api/v1/safes/%s/multisig-transactions/?executed=false&nonce__gte=%d&ordering=-nonce&limit=1", p.safe.Hex(), nonce)
+	if err := p.get(ctx, path, &queued); err != nil {
+		return 0, fmt.Errorf("failed to read queued Safe transactions: %w", err)
+	}
+	if len(queued.Results) > 0 && uint64(queued.Results[0].Nonce) >= nonce {
+		nonce = uint64(queued.Results[0].Nonce) + 1
+	}
+	return nonce, nil
+}
+
+func (p *SafeProposer) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.serviceURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Safe transaction service returned status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// flexibleUint decodes a number the transaction service may send as a
+// JSON number or string, depending on its version
+type flexibleUint uint64
+
+func (f *flexibleUint) UnmarshalJSON(data []byte) error {
+	n, err := strconv.ParseUint(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid nonce %s: %w", data, err)
+	}
+	*f = flexibleUint(n)
+	return nil
+}
@@ -0,0 +1,119 @@
+package gaswallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	testSafe   = common.HexToAddress("0x5afe5afe5afe5afe5afe5afe5afe5afe5afe5afe")
+	testWallet = common.HexToAddress("0x1111111111111111111111111111111111111111")
+)
+
+// safeTxHash computes the Safe transaction digest by hand, as the Safe
+// contract's getTransactionHash does
+func safeTxHash(chainID int64, safe, to common.Address, value *big.Int, nonce uint64) []byte {
+	word := func(b *big.Int) []byte { return common.LeftPadBytes(b.Bytes(), 32) }
+	zero := make([]byte, 32)
+
+	domain := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(uint256 chainId,address verifyingContract)")),
+		word(big.NewInt(chainID)),
+		common.LeftPadBytes(safe.Bytes(), 32),
+	)
+	message := crypto.Keccak256(
+		crypto.Keccak256([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)")),
+		common.LeftPadBytes(to.Bytes(), 32),
+		word(value),
+		crypto.Keccak256(nil),
+		zero, zero, zero, zero, zero, zero,
+		word(new(big.Int).SetUint64(nonce)),
+	)
+	return crypto.Keccak256([]byte{0x19, 0x01}, domain, message)
+}
+
+func TestSafeProposerProposeTransfer(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	var proposal map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/safes/"+testSafe.Hex()+"/":
+			io.WriteString(w, `{"address": "`+testSafe.Hex()+`", "nonce": 4, "threshold": 2}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/safes/"+testSafe.Hex()+"/multisig-transactions/":
+			if r.URL.Query().Get("executed") != "false" {
+				t.Errorf("queued transactions requested with %s", r.URL.RawQuery)
+			}
+			io.WriteString(w, `{"count": 2, "results": [{"nonce": "5"}]}`)
+		case r.Method == http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&proposal); err != nil {
+				t.Errorf("failed to decode proposal: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	proposer := NewSafeProposer(server.URL+"/", testSafe, 42161, key)
+	amount := big.NewInt(5e17)
+	id, err := proposer.ProposeTransfer(context.Background(), testWallet, amount)
+	if err != nil {
+		t.Fatalf("ProposeTransfer() error = %v", err)
+	}
+
+	want := safeTxHash(42161, testSafe, testWallet, amount, 6)
+	if id != hexutil.Encode(want) || proposal["contractTransactionHash"] != id {
+		t.Errorf("hash = %s (proposed %v), want %s", id, proposal["contractTransactionHash"], hexutil.Encode(want))
+	}
+	if proposal["nonce"] != float64(6) {
+		t.Errorf("nonce = %v, want 6, after the queued transaction", proposal["nonce"])
+	}
+	if proposal["to"] != testWallet.Hex() || proposal["value"] != amount.String() {
+		t.Errorf("proposal = %v, want a transfer of %s to %s", proposal, amount, testWallet.Hex())
+	}
+
+	signature, err := hexutil.Decode(proposal["signature"].(string))
+	if err != nil || len(signature) != crypto.SignatureLength {
+		t.Fatalf("signature = %v", proposal["signature"])
+	}
+	if v := signature[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+		t.Errorf("v = %d, want 27 or 28", v)
+	}
+	signature[crypto.RecoveryIDOffset] -= 27
+	signer, err := crypto.SigToPub(want, signature)
+	if err != nil {
+		t.Fatalf("SigToPub() error = %v", err)
+	}
+	if !bytes.Equal(crypto.PubkeyToAddress(*signer).Bytes(), crypto.PubkeyToAddress(key.PublicKey).Bytes()) ||
+		proposal["sender"] != crypto.PubkeyToAddress(key.PublicKey).Hex() {
+		t.Errorf("proposal not signed by its sender")
+	}
+}
+
+func TestSafeProposerServiceError(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	proposer := NewSafeProposer(server.URL, testSafe, 42161, key)
+	if _, err := proposer.ProposeTransfer(context.Background(), testWallet, big.NewInt(1)); err == nil {
+		t.Error("ProposeTransfer() succeeded against a failing service")
+	}
+}
@@ -0,0 +1,224 @@
+// Package gaswallet watches the balances of the operator wallets that pay
+// gas for bond transactions, alerting when one runs low and proposing a
+// top-up from a treasury so distributions don't stall on an empty wallet.
+package gaswallet
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// BalanceReader is the subset of ethclient.Client the watcher needs
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Proposer requests a transfer from a treasury to a wallet, returning an ID
+// for the request such as a Safe transaction hash. The transfer still needs
+// the treasury's approval.
+type Proposer interface {
+	ProposeTransfer(ctx context.Context, to common.Address, amount *big.Int) (string, error)
+}
+
+// Wallet is an operator wallet on one chain
+type Wallet struct {
+	Chain      string
+	Address    common.Address
+	MinBalance *big.Int // Alert below this balance, in wei
+	TopUp      *big.Int // Wei to request from the treasury when low; nil disables top-ups
+}
+
+// Config configures the watcher
+type Config struct {
+	Interval      time.Duration
+	TopUpCooldown time.Duration // Wait before proposing another top-up for a wallet still low
+}
+
+// DefaultConfig returns default watcher configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:      time.Minute,
+		TopUpCooldown: 6 * time.Hour,
+	}
+}
+
+// Status is the latest observed balance of a wallet
+type Status struct {
+	Chain        string
+	Address      common.Address
+	Balance      *big.Int // Nil if it couldn't be read
+	Low          bool
+	Error        string
+	TopUpID      string // The last top-up proposed while the wallet was low
+	TopUpAt      time.Time
+	TopUpPending bool // A top-up was proposed and the wallet is still low
+	UpdatedAt    time.Time
+}
+
+type watchedWallet struct {
+	wallet Wallet
+	client BalanceReader
+	status Status
+}
+
+// Watcher polls operator wallet balances
+type Watcher struct {
+	mu        sync.RWMutex
+	wallets   map[string]*watchedWallet
+	proposers map[string]Proposer
+	config    Config
+}
+
+// NewWatcher creates a new wallet watcher
+func NewWatcher(config Config) *Watcher {
+	return &Watcher{
+		wallets:   make(map[string]*watchedWallet),
+		proposers: make(map[string]Proposer),
+		config:    config,
+	}
+}
+
+// AddWallet registers a wallet to watch, read through client
+func (w *Watcher) AddWallet(wallet Wallet, client BalanceReader) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.wallets[wallet.Chain] = &watchedWallet{
+		wallet: wallet,
+		client: client,
+		status: Status{Chain: wallet.Chain, Address: wallet.Address},
+	}
+}
+
+// SetProposer registers the treasury that tops up the chain's wallet
+func (w *Watcher) SetProposer(chain string, proposer Proposer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.proposers[chain] = proposer
+}
+
+// Start polls every wallet until the context is cancelled
+func (w *Watcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.PollOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PollOnce refreshes the balance of every wallet, proposing top-ups for low ones
+func (w *Watcher) PollOnce(ctx context.Context) {
+	w.mu.RLock()
+	wallets := make([]*watchedWallet, 0, len(w.wallets))
+	for _, watched := range w.wallets {
+		wallets = append(wallets, watched)
+	}
+	w.mu.RUnlock()
+
+	for _, watched := range wallets {
+		w.poll(ctx, watched)
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context, watched *watchedWallet) {
+	wallet := watched.wallet
+	balance, err := watched.client.BalanceAt(ctx, wallet.Address, nil)
+
+	w.mu.Lock()
+	previous := watched.status
+	status := Status{
+		Chain:     wallet.Chain,
+		Address:   wallet.Address,
+		TopUpID:   previous.TopUpID,
+		TopUpAt:   previous.TopUpAt,
+		UpdatedAt: time.Now(),
+	}
+	if err != nil {
+		// Keep the last known balance; a flaky RPC isn't an empty wallet
+		status.Balance, status.Low = previous.Balance, previous.Low
+		status.TopUpPending = previous.TopUpPending
+		status.Error = err.Error()
+		watched.status = status
+		w.mu.Unlock()
+		log.Printf("Failed to read balance of operator wallet %s on %s: %v", wallet.Address.Hex(), wallet.Chain, err)
+		return
+	}
+	status.Balance = balance
+	status.Low = wallet.MinBalance != nil && balance.Cmp(wallet.MinBalance) < 0
+	status.TopUpPending = status.Low && previous.TopUpPending
+	watched.status = status
+	proposer := w.proposers[wallet.Chain]
+	w.mu.Unlock()
+
+	w.record(status)
+	switch {
+	case status.Low && !previous.Low:
+		log.Printf("ALERT: operator wallet %s on %s holds %s wei, below the minimum of %s",
+			wallet.Address.Hex(), wallet.Chain, balance, wallet.MinBalance)
+	case !status.Low && previous.Low:
+		log.Printf("Operator wallet %s on %s is funded again with %s wei", wallet.Address.Hex(), wallet.Chain, balance)
+	}
+
+	if status.Low && proposer != nil && wallet.TopUp != nil && w.topUpDue(status) {
+		w.proposeTopUp(ctx, watched, proposer)
+	}
+}
+
+// topUpDue reports whether a low wallet needs a top-up proposed: none is
+// pending, or the last one has gone unexecuted past the cooldown
+func (w *Watcher) topUpDue(status Status) bool {
+	return !status.TopUpPending || time.Since(status.TopUpAt) >= w.config.TopUpCooldown
+}
+
+func (w *Watcher) proposeTopUp(ctx context.Context, watched *watchedWallet, proposer Proposer) {
+	wallet := watched.wallet
+	id, err := proposer.ProposeTransfer(ctx, wallet.Address, wallet.TopUp)
+	if err != nil {
+		metrics.WalletTopUps.WithLabelValues(wallet.Chain, "failed").Inc()
+		log.Printf("ALERT: failed to propose a top-up of operator wallet %s on %s: %v", wallet.Address.Hex(), wallet.Chain, err)
+		return
+	}
+	metrics.WalletTopUps.WithLabelValues(wallet.Chain, "proposed").Inc()
+	log.Printf("Proposed a top-up of %s wei to operator wallet %s on %s: %s", wallet.TopUp, wallet.Address.Hex(), wallet.Chain, id)
+
+	w.mu.Lock()
+	watched.status.TopUpID = id
+	watched.status.TopUpAt = time.Now()
+	watched.status.TopUpPending = true
+	w.mu.Unlock()
+}
+
+func (w *Watcher) record(status Status) {
+	ether, _ := new(big.Float).Quo(new(big.Float).SetInt(status.Balance), big.NewFloat(params.Ether)).Float64()
+	metrics.OperatorWalletBalance.WithLabelValues(status.Chain).Set(ether)
+	low := 0.0
+	if status.Low {
+		low = 1.0
+	}
+	metrics.OperatorWalletLow.WithLabelValues(status.Chain).Set(low)
+}
+
+// Statuses returns the latest status of every wallet
+func (w *Watcher) Statuses() []Status {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	statuses := make([]Status, 0, len(w.wallets))
+	for _, watched := range w.wallets {
+		statuses = append(statuses, watched.status)
+	}
+	return statuses
+}
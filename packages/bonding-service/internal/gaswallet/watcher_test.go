@@ -0,0 +1,89 @@
+package gaswallet
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// fakeBalance serves a settable balance
+type fakeBalance struct {
+	balance *big.Int
+	err     error
+}
+
+func (f *fakeBalance) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return f.balance, f.err
+}
+
+// fakeProposer records proposed transfers
+type fakeProposer struct {
+	proposals []*big.Int
+}
+
+func (f *fakeProposer) ProposeTransfer(ctx context.Context, to common.Address, amount *big.Int) (string, error) {
+	f.proposals = append(f.proposals, amount)
+	return "0xproposal", nil
+}
+
+func TestWatcherTopUp(t *testing.T) {
+	tests := []struct {
+		name          string
+		cooldown      int64 // Seconds
+		balances      []int64
+		wantProposals int
+		wantLow       bool
+	}{
+		{"funded", 3600, []int64{500, 400}, 0, false},
+		{"runs low", 3600, []int64{500, 50}, 1, true},
+		{"stays low during cooldown", 3600, []int64{50, 40, 30}, 1, true},
+		{"stays low past cooldown", 0, []int64{50, 40}, 2, true},
+		{"topped up then low again", 3600, []int64{50, 500, 50}, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.TopUpCooldown = time.Duration(tt.cooldown) * time.Second
+			client := &fakeBalance{}
+			proposer := &fakeProposer{}
+			w := NewWatcher(config)
+			w.AddWallet(Wallet{
+				Chain:      "arbitrum",
+				Address:    common.HexToAddress("0x1111111111111111111111111111111111111111"),
+				MinBalance: big.NewInt(100),
+				TopUp:      big.NewInt(1000),
+			}, client)
+			w.SetProposer("arbitrum", proposer)
+
+			for _, balance := range tt.balances {
+				client.balance = big.NewInt(balance)
+				w.PollOnce(context.Background())
+			}
+			if len(proposer.proposals) != tt.wantProposals {
+				t.Errorf("proposals = %d, want %d", len(proposer.proposals), tt.wantProposals)
+			}
+			if status := w.Statuses()[0]; status.Low != tt.wantLow {
+				t.Errorf("Low = %v, want %v", status.Low, tt.wantLow)
+			}
+		})
+	}
+}
+
+func TestWatcherKeepsBalanceOnRPCError(t *testing.T) {
+	client := &fakeBalance{balance: big.NewInt(50)}
+	w := NewWatcher(DefaultConfig())
+	w.AddWallet(Wallet{Chain: "arbitrum", MinBalance: big.NewInt(100)}, client)
+	w.PollOnce(context.Background())
+
+	client.balance, client.err = nil, errors.New("connection refused")
+	w.PollOnce(context.Background())
+
+	status := w.Statuses()[0]
+	if status.Balance == nil || status.Balance.Int64() != 50 || !status.Low || status.Error == "" {
+		t.Errorf("status = %+v, want the last balance, still low, with the error", status)
+	}
+}
@@ -0,0 +1,70 @@
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/consistency"
+	"github.com/knowton/bonding-service/internal/tenant"
+)
+
+// Version identifies the state of the rows behind a response. Any write to
+// those rows changes UpdatedAt or Count, and so the ETag.
+type Version struct {
+	Scope     string // Distinguishes responses built from the same rows, e.g. tenant and filters
+	Count     int64
+	UpdatedAt time.Time
+}
+
+// ETag returns a strong entity tag for the version
+func (v Version) ETag() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d", v.Scope, v.Count, v.UpdatedAt.UnixNano())))
+	return `"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+// notModified reports whether an If-None-Match header matches etag.
+// Comparison is weak, as RFC 9110 requires for If-None-Match.
+func notModified(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheControl builds the Cache-Control value for public, revalidated responses
+func cacheControl(maxAge time.Duration) string {
+	return fmt.Sprintf("public, max-age=%d, must-revalidate", int(maxAge.Seconds()))
+}
+
+// writeConditional sets caching headers and answers 304 when the client's copy
+// is current. It returns true if the response has been written.
+func writeConditional(w http.ResponseWriter, r *http.Request, version Version, maxAge time.Duration) bool {
+	etag := version.ETag()
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl(maxAge))
+	// Shared caches must not answer a request carrying a consistency token
+	// with a response cached before the write it names
+	w.Header().Set("Vary", tenant.HeaderName+", "+consistency.HTTPHeader)
+	if !version.UpdatedAt.IsZero() {
+		w.Header().Set("Last-Modified", version.UpdatedAt.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
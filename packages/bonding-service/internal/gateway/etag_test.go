@@ -0,0 +1,74 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVersionETag(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	base := Version{Scope: "bonds|default|", Count: 3, UpdatedAt: now}
+
+	if base.ETag() != base.ETag() {
+		t.Errorf("ETag() is not deterministic")
+	}
+
+	changed := []Version{
+		{Scope: "bonds|acme|", Count: 3, UpdatedAt: now},
+		{Scope: "bonds|default|", Count: 2, UpdatedAt: now},
+		{Scope: "bonds|default|", Count: 3, UpdatedAt: now.Add(time.Microsecond)},
+	}
+	for _, v := range changed {
+		if v.ETag() == base.ETag() {
+			t.Errorf("ETag() for %+v matches base version", v)
+		}
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	etag := `"abc"`
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"no header", "", false},
+		{"match", `"abc"`, true},
+		{"weak match", `W/"abc"`, true},
+		{"list match", `"xyz", "abc"`, true},
+		{"wildcard", "*", true},
+		{"mismatch", `"xyz"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notModified(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("notModified(%q) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteConditional(t *testing.T) {
+	version := Version{Scope: "bond|default|b1", Count: 2, UpdatedAt: time.Unix(1700000000, 0)}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/bonds/b1", nil)
+	if writeConditional(rec, req, version, time.Minute) {
+		t.Fatalf("writeConditional() wrote a response without If-None-Match")
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=60, must-revalidate" {
+		t.Errorf("Cache-Control = %q", got)
+	}
+
+	rec = httptest.NewRecorder()
+	req.Header.Set("If-None-Match", version.ETag())
+	if !writeConditional(rec, req, version, time.Minute) {
+		t.Fatalf("writeConditional() did not answer a matching If-None-Match")
+	}
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
@@ -0,0 +1,211 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/consistency"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"gorm.io/gorm"
+)
+
+// Gateway serves read-only public REST endpoints for bond listings with
+// ETag revalidation derived from row versions
+type Gateway struct {
+	db                 *gorm.DB
+	maxAge             time.Duration
+	consistencyTimeout time.Duration
+}
+
+// New creates a gateway. maxAge is how long clients and CDNs may reuse a
+// response before revalidating; 0 revalidates on every request.
+func New(db *gorm.DB, maxAge time.Duration) *Gateway {
+	return &Gateway{db: db, maxAge: maxAge, consistencyTimeout: consistency.DefaultTimeout}
+}
+
+// SetConsistencyTimeout sets how long a request presenting a consistency
+// token waits for the database to apply it
+func (g *Gateway) SetConsistencyTimeout(timeout time.Duration) {
+	g.consistencyTimeout = timeout
+}
+
+// Handler returns the gateway routes
+func (g *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/bonds", g.listBonds)
+	mux.HandleFunc("GET /v1/bonds/{bondID}", g.getBond)
+	return mux
+}
+
+type trancheJSON struct {
+	TrancheID     int     `json:"tranche_id"`
+	Name          string  `json:"name"`
+	Priority      int     `json:"priority"`
+	Allocation    string  `json:"allocation"`
+	APY           float64 `json:"apy"`
+	RiskLevel     string  `json:"risk_level"`
+	TotalInvested string  `json:"total_invested"`
+}
+
+type bondJSON struct {
+	BondID       string        `json:"bond_id"`
+	IPNFTId      string        `json:"ipnft_id"`
+	Issuer       string        `json:"issuer"`
+	Chain        string        `json:"chain"`
+	TotalValue   string        `json:"total_value"`
+	TotalRevenue string        `json:"total_revenue"`
+	Status       string        `json:"status"`
+	MaturityDate int64         `json:"maturity_date"`
+	CreatedAt    int64         `json:"created_at"`
+	Tranches     []trancheJSON `json:"tranches,omitempty"`
+}
+
+// listBonds serves GET /v1/bonds?status=ACTIVE
+func (g *Gateway) listBonds(w http.ResponseWriter, r *http.Request) {
+	if !g.awaitToken(w, r) {
+		return
+	}
+	tenantID := tenant.FromRequest(r)
+	status := r.URL.Query().Get("status")
+
+	bonds := g.db.WithContext(r.Context()).Model(&models.Bond{}).Where("tenant_id = ?", tenantID)
+	if status != "" {
+		bonds = bonds.Where("status = ?", status)
+	}
+
+	// Version the listing by the bond rows it contains
+	var version struct {
+		Count     int64
+		UpdatedAt *time.Time
+	}
+	if err := bonds.Session(&gorm.Session{}).
+		Select("COUNT(*) AS count, MAX(updated_at) AS updated_at").
+		Scan(&version).Error; err != nil {
+		g.fail(w, err)
+		return
+	}
+	v := Version{Scope: "bonds|" + tenantID + "|" + status, Count: version.Count}
+	if version.UpdatedAt != nil {
+		v.UpdatedAt = *version.UpdatedAt
+	}
+	if writeConditional(w, r, v, g.maxAge) {
+		return
+	}
+
+	var rows []models.Bond
+	if err := bonds.Order("created_at DESC").Find(&rows).Error; err != nil {
+		g.fail(w, err)
+		return
+	}
+
+	result := make([]bondJSON, len(rows))
+	for i := range rows {
+		result[i] = toBondJSON(&rows[i])
+	}
+	writeJSON(w, map[string]interface{}{"bonds": result})
+}
+
+// getBond serves GET /v1/bonds/{bondID}
+func (g *Gateway) getBond(w http.ResponseWriter, r *http.Request) {
+	if !g.awaitToken(w, r) {
+		return
+	}
+	tenantID := tenant.FromRequest(r)
+	bondID := r.PathValue("bondID")
+
+	var bond models.Bond
+	err := g.db.WithContext(r.Context()).
+		Preload("Tranches").
+		Where("bond_id = ? AND tenant_id = ?", bondID, tenantID).
+		First(&bond).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		http.Error(w, "bond not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		g.fail(w, err)
+		return
+	}
+
+	// Tranche writes (investments, arrears) change the response too
+	v := Version{Scope: "bond|" + tenantID + "|" + bondID, Count: int64(len(bond.Tranches)), UpdatedAt: bond.UpdatedAt}
+	for _, t := range bond.Tranches {
+		if t.UpdatedAt.After(v.UpdatedAt) {
+			v.UpdatedAt = t.UpdatedAt
+		}
+	}
+	if writeConditional(w, r, v, g.maxAge) {
+		return
+	}
+
+	result := toBondJSON(&bond)
+	result.Tranches = make([]trancheJSON, len(bond.Tranches))
+	for i, t := range bond.Tranches {
+		result.Tranches[i] = trancheJSON{
+			TrancheID:     t.TrancheID,
+			Name:          t.Name,
+			Priority:      t.Priority,
+			Allocation:    t.Allocation,
+			APY:           t.APY,
+			RiskLevel:     t.RiskLevel,
+			TotalInvested: t.TotalInvested,
+		}
+	}
+	writeJSON(w, result)
+}
+
+// awaitToken waits until the database has applied the request's consistency
+// token, if it has one. It returns false if an error response was written.
+func (g *Gateway) awaitToken(w http.ResponseWriter, r *http.Request) bool {
+	value := r.Header.Get(consistency.HTTPHeader)
+	if value == "" {
+		return true
+	}
+	token, err := consistency.Parse(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	err = consistency.Wait(r.Context(), g.db, token, g.consistencyTimeout)
+	if errors.Is(err, consistency.ErrBehind) {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return false
+	}
+	if err != nil {
+		g.fail(w, err)
+		return false
+	}
+	return true
+}
+
+func (g *Gateway) fail(w http.ResponseWriter, err error) {
+	log.Printf("Gateway query failed: %v", err)
+	w.Header().Set("Cache-Control", "no-store")
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
+
+func toBondJSON(b *models.Bond) bondJSON {
+	return bondJSON{
+		BondID:       b.BondID,
+		IPNFTId:      b.IPNFTId,
+		Issuer:       b.Issuer,
+		Chain:        b.Chain,
+		TotalValue:   b.TotalValue,
+		TotalRevenue: b.TotalRevenue,
+		Status:       b.Status,
+		MaturityDate: b.MaturityDate.Unix(),
+		CreatedAt:    b.CreatedAt.Unix(),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Gateway encode failed: %v", err)
+	}
+}
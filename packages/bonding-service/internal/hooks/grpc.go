@@ -0,0 +1,91 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Method is the unary RPC a gRPC callout serves. It takes and returns a
+// google.protobuf.Struct, so callouts need no generated code from this
+// service. The request is
+//
+//	{"point": "pre_investment", "tenant_id": "acme", "facts": {...}}
+//
+// with times in facts as RFC 3339 strings, and the response is
+//
+//	{"allow": false, "reason": "investor is on the sanctions list"}
+//
+// A response without allow set to true refuses the operation at a pre point.
+const Method = "/knowton.bonding.hooks.v1.Hook/Handle"
+
+// GRPCHook calls out to a hook served over gRPC
+type GRPCHook struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewGRPCHook creates a hook calling Method over conn
+func NewGRPCHook(conn grpc.ClientConnInterface) *GRPCHook {
+	return &GRPCHook{conn: conn}
+}
+
+// DialGRPC connects to a hook service without TLS, as callouts run beside
+// the service in its private network
+func DialGRPC(target string) (*GRPCHook, error) {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to hook %s: %w", target, err)
+	}
+	return NewGRPCHook(conn), nil
+}
+
+// Handle sends the event and maps a refusal to a *Rejection
+func (g *GRPCHook) Handle(ctx context.Context, ev Event) error {
+	facts, err := toStructValue(ev.Facts)
+	if err != nil {
+		return err
+	}
+	req := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"point":     structpb.NewStringValue(string(ev.Point)),
+		"tenant_id": structpb.NewStringValue(ev.TenantID),
+		"facts":     facts,
+	}}
+
+	resp := new(structpb.Struct)
+	if err := g.conn.Invoke(ctx, Method, req, resp); err != nil {
+		return fmt.Errorf("hook call failed: %w", err)
+	}
+	if resp.Fields["allow"].GetBoolValue() {
+		return nil
+	}
+	if !ev.Point.Vetoes() {
+		return nil
+	}
+	reason := resp.Fields["reason"].GetStringValue()
+	if reason == "" {
+		reason = "no reason given"
+	}
+	return Reject(reason)
+}
+
+// toStructValue converts facts to a protobuf value through JSON, which also
+// renders times as RFC 3339 strings
+func toStructValue(facts map[string]interface{}) (*structpb.Value, error) {
+	data, err := json.Marshal(facts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode hook facts: %w", err)
+	}
+	var generic map[string]interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed to encode hook facts: %w", err)
+	}
+	s, err := structpb.NewStruct(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode hook facts: %w", err)
+	}
+	return structpb.NewStructValue(s), nil
+}
@@ -0,0 +1,158 @@
+// Package hooks runs deployment-supplied checks around issuance, investment
+// and distribution, so bespoke legal or business checks don't need a fork.
+// A hook is Go code loaded as a plugin or a gRPC service called out to; it
+// sees the same facts business rules do and can refuse the pre-issuance and
+// pre-investment points.
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// Point is where in an operation hooks run
+type Point string
+
+// Hook points and the facts their events carry
+const (
+	PreIssuance      Point = "pre_issuance"      // bond, tranches, risk
+	PreInvestment    Point = "pre_investment"    // bond, tranche, investment
+	PostDistribution Point = "post_distribution" // bond, distribution (with tx_hash)
+)
+
+// Vetoes reports whether hooks at the point can refuse the operation
+func (p Point) Vetoes() bool {
+	return p == PreIssuance || p == PreInvestment
+}
+
+// Event is what a hook is called with
+type Event struct {
+	Point    Point
+	TenantID string
+	Facts    map[string]interface{} // The business rule variables at the point
+}
+
+// Hook handles events. At a pre point a hook refuses the operation by
+// returning a *Rejection; other errors are failures of the hook itself.
+type Hook interface {
+	Handle(ctx context.Context, ev Event) error
+}
+
+// HookFunc adapts a function to a Hook
+type HookFunc func(ctx context.Context, ev Event) error
+
+// Handle calls f
+func (f HookFunc) Handle(ctx context.Context, ev Event) error {
+	return f(ctx, ev)
+}
+
+// Rejection is a hook refusing an operation
+type Rejection struct {
+	Hook   string // Set by the runner
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("refused by hook %s: %s", r.Hook, r.Reason)
+}
+
+// Reject returns a rejection for a hook to refuse an operation with
+func Reject(reason string) error {
+	return &Rejection{Reason: reason}
+}
+
+// Config holds hook runner configuration
+type Config struct {
+	Timeout time.Duration // Per hook call
+	// FailOpen lets an operation proceed when a hook at a pre point fails or
+	// times out. By default it is refused.
+	FailOpen bool
+}
+
+// DefaultConfig returns default hook configuration
+func DefaultConfig() Config {
+	return Config{Timeout: 2 * time.Second}
+}
+
+type registered struct {
+	name string
+	hook Hook
+}
+
+// Runner calls the registered hooks in order
+type Runner struct {
+	config Config
+	hooks  []registered
+}
+
+// NewRunner creates a runner without hooks
+func NewRunner(config Config) *Runner {
+	return &Runner{config: config}
+}
+
+// Register adds a hook. Hooks run in the order they are registered.
+func (r *Runner) Register(name string, hook Hook) {
+	r.hooks = append(r.hooks, registered{name: name, hook: hook})
+}
+
+// Len returns the number of registered hooks
+func (r *Runner) Len() int {
+	return len(r.hooks)
+}
+
+// Run calls every hook with an event. At a pre point the first rejection
+// is returned and the remaining hooks are skipped; a failed hook is returned
+// too unless the runner fails open. At a post point failures are only
+// logged, as the operation already happened.
+func (r *Runner) Run(ctx context.Context, ev Event) error {
+	for _, h := range r.hooks {
+		err := r.call(ctx, h, ev)
+		var rejection *Rejection
+		switch {
+		case err == nil:
+			metrics.HookCalls.WithLabelValues(h.name, string(ev.Point), "allowed").Inc()
+		case errors.As(err, &rejection) && ev.Point.Vetoes():
+			metrics.HookCalls.WithLabelValues(h.name, string(ev.Point), "rejected").Inc()
+			rejection.Hook = h.name
+			return rejection
+		default:
+			metrics.HookCalls.WithLabelValues(h.name, string(ev.Point), "failed").Inc()
+			if ev.Point.Vetoes() && !r.config.FailOpen {
+				return fmt.Errorf("hook %s failed: %w", h.name, err)
+			}
+			log.Printf("Hook %s failed at %s: %v", h.name, ev.Point, err)
+		}
+	}
+	return nil
+}
+
+// call runs a hook within the timeout. A hook that ignores its context is
+// abandoned when the timeout passes.
+func (r *Runner) call(ctx context.Context, h registered, ev Event) error {
+	if r.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.config.Timeout)
+		defer cancel()
+	}
+	done := make(chan error, 1)
+	go func() {
+		// A panicking plugin must not take the service down
+		defer func() {
+			if p := recover(); p != nil {
+				done <- fmt.Errorf("panic: %v", p)
+			}
+		}()
+		done <- h.hook.Handle(ctx, ev)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,135 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRun(t *testing.T) {
+	allow := HookFunc(func(ctx context.Context, ev Event) error { return nil })
+	reject := HookFunc(func(ctx context.Context, ev Event) error { return Reject("not today") })
+	broken := HookFunc(func(ctx context.Context, ev Event) error { return errors.New("database down") })
+	panics := HookFunc(func(ctx context.Context, ev Event) error { panic("oops") })
+	hangs := HookFunc(func(ctx context.Context, ev Event) error { select {} })
+
+	tests := []struct {
+		name         string
+		hooks        []Hook
+		point        Point
+		failOpen     bool
+		wantRejected bool
+		wantErr      bool
+		wantCalls    int
+	}{
+		{"all allow", []Hook{allow, allow}, PreIssuance, false, false, false, 2},
+		{"rejection stops", []Hook{reject, allow}, PreInvestment, false, true, true, 1},
+		{"failure refuses", []Hook{broken, allow}, PreInvestment, false, false, true, 1},
+		{"failure fails open", []Hook{broken, allow}, PreInvestment, true, false, false, 2},
+		{"panic is a failure", []Hook{panics}, PreIssuance, false, false, true, 1},
+		{"timeout is a failure", []Hook{hangs}, PreIssuance, false, false, true, 1},
+		{"post point never refuses", []Hook{reject, broken, allow}, PostDistribution, false, false, false, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewRunner(Config{Timeout: 50 * time.Millisecond, FailOpen: tt.failOpen})
+			var calls atomic.Int32
+			for i, h := range tt.hooks {
+				h := h
+				runner.Register(string(rune('a'+i)), HookFunc(func(ctx context.Context, ev Event) error {
+					calls.Add(1)
+					return h.Handle(ctx, ev)
+				}))
+			}
+
+			err := runner.Run(context.Background(), Event{Point: tt.point})
+			var rejection *Rejection
+			if errors.As(err, &rejection) != tt.wantRejected {
+				t.Errorf("Run() error = %v, want rejection %v", err, tt.wantRejected)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if rejection != nil && rejection.Hook != "a" {
+				t.Errorf("rejection hook = %q, want a", rejection.Hook)
+			}
+			if got := int(calls.Load()); got != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// serveHook serves Method with handle over an in-memory connection
+func serveHook(t *testing.T, handle func(req *structpb.Struct) *structpb.Struct) *GRPCHook {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "knowton.bonding.hooks.v1.Hook",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Handle",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(structpb.Struct)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return handle(req), nil
+			},
+		}},
+	}, struct{}{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewGRPCHook(conn)
+}
+
+func TestGRPCHook(t *testing.T) {
+	var received *structpb.Struct
+	hook := serveHook(t, func(req *structpb.Struct) *structpb.Struct {
+		received = req
+		investor := req.Fields["facts"].GetStructValue().Fields["investment"].GetStructValue().Fields["investor"].GetStringValue()
+		resp, _ := structpb.NewStruct(map[string]interface{}{
+			"allow":  investor != "0xbad",
+			"reason": "investor is on the sanctions list",
+		})
+		return resp
+	})
+
+	ev := Event{Point: PreInvestment, TenantID: "acme", Facts: map[string]interface{}{
+		"investment": map[string]interface{}{"investor": "0xgood", "amount": 100.0},
+		"bond":       map[string]interface{}{"maturity_date": time.Unix(1700000000, 0).UTC()},
+	}}
+	if err := hook.Handle(context.Background(), ev); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if received.Fields["point"].GetStringValue() != "pre_investment" || received.Fields["tenant_id"].GetStringValue() != "acme" {
+		t.Errorf("request = %v", received)
+	}
+	maturity := received.Fields["facts"].GetStructValue().Fields["bond"].GetStructValue().Fields["maturity_date"].GetStringValue()
+	if maturity != "2023-11-14T22:13:20Z" {
+		t.Errorf("maturity_date = %q, want RFC 3339", maturity)
+	}
+
+	ev.Facts["investment"] = map[string]interface{}{"investor": "0xbad"}
+	var rejection *Rejection
+	if err := hook.Handle(context.Background(), ev); !errors.As(err, &rejection) || rejection.Reason != "investor is on the sanctions list" {
+		t.Errorf("Handle() error = %v, want the callout's rejection", err)
+	}
+}
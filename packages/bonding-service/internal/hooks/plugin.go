@@ -0,0 +1,32 @@
+package hooks
+
+import (
+	"fmt"
+	"plugin"
+)
+
+// LoadPlugin opens a Go plugin built with -buildmode=plugin against the same
+// version of this module. The plugin exports its hook as a package-level
+// variable named Hook:
+//
+//	var Hook hooks.Hook = hooks.HookFunc(func(ctx context.Context, ev hooks.Event) error {
+//		if ev.Point == hooks.PreInvestment && blocked(ev.Facts) {
+//			return hooks.Reject("investor is on the sanctions list")
+//		}
+//		return nil
+//	})
+func LoadPlugin(path string) (Hook, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open hook plugin %s: %w", path, err)
+	}
+	symbol, err := p.Lookup("Hook")
+	if err != nil {
+		return nil, fmt.Errorf("hook plugin %s: %w", path, err)
+	}
+	hook, ok := symbol.(*Hook)
+	if !ok || *hook == nil {
+		return nil, fmt.Errorf("hook plugin %s: Hook is a %T, not a hooks.Hook", path, symbol)
+	}
+	return *hook, nil
+}
@@ -0,0 +1,75 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/consumer"
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// Consume delivers the chain's stored events to c in the order they were
+// indexed, resuming from c's committed position. The position is the event's
+// row ID, so a consumer reads one chain and its name should say which.
+// Reorg rollbacks are not redelivered; consumers that must not act on
+// orphaned events should wait for the chain's confirmations.
+func (i *Indexer) Consume(c *consumer.Consumer) {
+	i.consumers = append(i.consumers, c)
+}
+
+// EventID identifies a stored event across redeliveries
+func EventID(ev *models.ChainEvent) string {
+	return fmt.Sprintf("%s:%s:%d", ev.Chain, ev.TxHash, ev.Position)
+}
+
+// DecodeEvent returns the stored event a consumer was given
+func DecodeEvent(ev consumer.Event) (*models.ChainEvent, error) {
+	var stored models.ChainEvent
+	if err := json.Unmarshal(ev.Payload, &stored); err != nil {
+		return nil, consumer.Permanent(fmt.Errorf("failed to decode event %s: %w", ev.ID, err))
+	}
+	return &stored, nil
+}
+
+// deliver catches every consumer up with the stored events
+func (i *Indexer) deliver(ctx context.Context) {
+	for _, c := range i.consumers {
+		if err := i.feed(ctx, c); err != nil {
+			log.Printf("Indexer for %s failed to deliver events to %s: %v", i.chain, c.Name(), err)
+		}
+	}
+}
+
+func (i *Indexer) feed(ctx context.Context, c *consumer.Consumer) error {
+	limit := int(i.config.MaxRange)
+	for {
+		position, err := c.Position(ctx)
+		if err != nil {
+			return err
+		}
+		var events []models.ChainEvent
+		if err := i.db.WithContext(ctx).
+			Where("chain = ? AND id > ?", i.chain, position).
+			Order("id").
+			Limit(limit).
+			Find(&events).Error; err != nil {
+			return fmt.Errorf("failed to load events: %w", err)
+		}
+
+		for n := range events {
+			payload, err := json.Marshal(&events[n])
+			if err != nil {
+				return err
+			}
+			ev := consumer.Event{ID: EventID(&events[n]), Position: uint64(events[n].ID), Payload: payload}
+			if _, err := c.Process(ctx, ev); err != nil {
+				return err
+			}
+		}
+		if len(events) < limit {
+			return nil
+		}
+	}
+}
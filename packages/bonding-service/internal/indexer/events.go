@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Bond contract events
+const (
+	EventBondIssued          = "BondIssued"
+	EventInvestment          = "Investment"
+	EventRevenueDistributed  = "RevenueDistributed"
+	EventRedemption          = "Redemption"
+	EventPositionTransferred = "PositionTransferred"
+	EventClaimed             = "Claimed"
+)
+
+// decodeLog turns a bond contract log into an event row. Logs for events the
+// indexer doesn't track return nil.
+func decodeLog(contractABI *abi.ABI, l types.Log) (*models.ChainEvent, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	event, err := contractABI.EventByID(l.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	values := make(map[string]interface{})
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to decode %s topics: %w", event.Name, err)
+	}
+	if err := event.Inputs.UnpackIntoMap(values, l.Data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s data: %w", event.Name, err)
+	}
+
+	ev := &models.ChainEvent{Event: event.Name, Amount: "0"}
+	if bondID, ok := values["bondId"].(*big.Int); ok {
+		ev.BondID = bondID.String()
+	}
+	if trancheID, ok := values["trancheId"].(uint8); ok {
+		ev.TrancheID = int(trancheID)
+	}
+
+	var account, amount string
+	switch event.Name {
+	case EventBondIssued:
+		account, amount = "issuer", "totalValue"
+	case EventInvestment, EventRedemption, EventClaimed:
+		account, amount = "investor", "amount"
+	case EventRevenueDistributed:
+		amount = "revenue"
+	case EventPositionTransferred:
+		account, amount = "to", "amount"
+		if from, ok := values["from"].(common.Address); ok {
+			ev.Sender = from.Hex()
+		}
+	default:
+		return nil, nil
+	}
+	if address, ok := values[account].(common.Address); ok {
+		ev.Account = address.Hex()
+	}
+	if value, ok := values[amount].(*big.Int); ok {
+		ev.Amount = value.String()
+	}
+	return ev, nil
+}
+
+// applyEvent adds (sign 1) or reverts (sign -1) an event's effect on the
+// bond and tranche totals
+func applyEvent(tx *gorm.DB, ev *models.ChainEvent, sign int64) error {
+	amount, ok := new(big.Int).SetString(ev.Amount, 10)
+	if !ok {
+		return fmt.Errorf("invalid %s amount %q", ev.Event, ev.Amount)
+	}
+	amount.Mul(amount, big.NewInt(sign))
+
+	switch ev.Event {
+	case EventInvestment:
+		return adjustTotalInvested(tx, ev.BondID, ev.TrancheID, amount)
+	case EventRedemption:
+		return adjustTotalInvested(tx, ev.BondID, ev.TrancheID, amount.Neg(amount))
+	case EventRevenueDistributed:
+		return adjustTotalRevenue(tx, ev.BondID, amount)
+	}
+	return nil
+}
+
+// accountedByService reports whether the service already applied the event
+// when it recorded the transaction it sent
+func accountedByService(tx *gorm.DB, ev *models.ChainEvent) (bool, error) {
+	var query *gorm.DB
+	switch ev.Event {
+	case EventInvestment:
+		query = tx.Model(&models.Investment{}).Where("bond_id = ? AND tx_hash = ?", ev.BondID, ev.TxHash)
+	case EventRevenueDistributed:
+		query = tx.Model(&models.RevenueDistribution{}).Where("bond_id = ? AND tx_hash = ?", ev.BondID, ev.TxHash)
+	case EventRedemption:
+		query = tx.Model(&models.Redemption{}).Where("tx_hash = ? AND status = ?", ev.TxHash, models.RedemptionCompleted)
+	default:
+		return false, nil
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check %s transaction: %w", ev.Event, err)
+	}
+	return count > 0, nil
+}
+
+// adjustTotalInvested adds delta to a tranche total. Tranches of bonds this
+// service doesn't track are ignored.
+func adjustTotalInvested(tx *gorm.DB, bondID string, trancheID int, delta *big.Int) error {
+	var tranche models.Tranche
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bond_id = ? AND tranche_id = ?", bondID, trancheID).
+		First(&tranche).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load tranche: %w", err)
+	}
+
+	total := new(big.Int).Add(parseBigInt(tranche.TotalInvested), delta)
+	if err := tx.Model(&tranche).Update("total_invested", total.String()).Error; err != nil {
+		return fmt.Errorf("failed to update tranche: %w", err)
+	}
+	return nil
+}
+
+// adjustTotalRevenue adds delta to a bond's total revenue
+func adjustTotalRevenue(tx *gorm.DB, bondID string, delta *big.Int) error {
+	var bond models.Bond
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bond_id = ?", bondID).
+		First(&bond).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load bond: %w", err)
+	}
+
+	total := new(big.Int).Add(parseBigInt(bond.TotalRevenue), delta)
+	if err := tx.Model(&bond).Update("total_revenue", total.String()).Error; err != nil {
+		return fmt.Errorf("failed to update bond: %w", err)
+	}
+	return nil
+}
+
+func parseBigInt(s string) *big.Int {
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
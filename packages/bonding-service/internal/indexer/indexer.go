@@ -0,0 +1,387 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/consumer"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ChainClient is the subset of ethclient.Client the indexer needs
+type ChainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Progress receives the last indexed block, e.g. the chain watcher
+type Progress interface {
+	SetIndexedBlock(chain string, block uint64)
+}
+
+// LogHandler is called for each indexed log after it is stored, and again
+// with Removed set when a reorg orphans it
+type LogHandler func(ctx context.Context, chain string, l types.Log)
+
+// Config holds indexer configuration
+type Config struct {
+	StartBlock  uint64        // First block to index; 0 starts at the current head
+	MaxRange    uint64        // Maximum blocks per log query
+	ReorgWindow uint64        // Blocks of hashes kept for reorg detection
+	Interval    time.Duration // How often to poll for new blocks
+}
+
+// DefaultConfig returns default indexer configuration. The reorg window covers
+// the time Arbitrum takes to post a batch to L1.
+func DefaultConfig() Config {
+	return Config{
+		MaxRange:    2000,
+		ReorgWindow: 5000,
+		Interval:    5 * time.Second,
+	}
+}
+
+// ErrReorgTooDeep is returned when none of the stored block hashes are on the
+// canonical chain, so the fork point is outside the reorg window
+var ErrReorgTooDeep = errors.New("reorg is deeper than the indexer's reorg window")
+
+// Indexer stores bond contract events for one chain and keeps bond and
+// tranche totals in step with them. Block hashes are tracked so a reorg
+// rolls back orphaned events before the new canonical blocks are applied.
+type Indexer struct {
+	db        *gorm.DB
+	chain     string
+	client    ChainClient
+	contract  common.Address
+	abi       abi.ABI
+	config    Config
+	progress  Progress
+	handlers  []LogHandler
+	consumers []*consumer.Consumer
+	wake      chan struct{}
+}
+
+// New creates an indexer for the bond contract on a chain
+func New(db *gorm.DB, chain string, client ChainClient, contract common.Address, config Config) (*Indexer, error) {
+	contractABI, err := abi.JSON(strings.NewReader(blockchain.IPBondABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract ABI: %w", err)
+	}
+	if config.MaxRange == 0 {
+		config.MaxRange = DefaultConfig().MaxRange
+	}
+
+	return &Indexer{
+		db:       db,
+		chain:    chain,
+		client:   client,
+		contract: contract,
+		abi:      contractABI,
+		config:   config,
+		wake:     make(chan struct{}, 1),
+	}, nil
+}
+
+// SetProgress reports the last indexed block after each poll
+func (i *Indexer) SetProgress(progress Progress) {
+	i.progress = progress
+}
+
+// OnLog registers a handler for indexed and orphaned logs
+func (i *Indexer) OnLog(handler LogHandler) {
+	i.handlers = append(i.handlers, handler)
+}
+
+// Notify makes the indexer poll now instead of waiting for the next
+// interval, e.g. when a subscription reports a new head. It never blocks.
+func (i *Indexer) Notify() {
+	select {
+	case i.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start polls for new blocks on every interval or notification until the
+// context is cancelled
+func (i *Indexer) Start(ctx context.Context) {
+	ticker := time.NewTicker(i.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.PollOnce(ctx); err != nil {
+			log.Printf("Indexer for %s failed: %v", i.chain, err)
+		}
+		i.deliver(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-i.wake:
+		}
+	}
+}
+
+// PollOnce rolls back any reorged blocks and indexes up to MaxRange new blocks
+func (i *Indexer) PollOnce(ctx context.Context) error {
+	head, err := i.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read head block: %w", err)
+	}
+
+	var last models.IndexedBlock
+	err = i.db.WithContext(ctx).Where("chain = ?", i.chain).Order("number DESC").First(&last).Error
+	from := i.config.StartBlock
+	switch {
+	case err == nil:
+		fork, err := i.checkReorg(ctx, last)
+		if err != nil {
+			return err
+		}
+		if fork < last.Number {
+			if err := i.rollback(ctx, fork); err != nil {
+				return err
+			}
+		}
+		from = fork + 1
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if from == 0 {
+			from = head.Number.Uint64()
+		}
+	default:
+		return fmt.Errorf("failed to load indexer cursor: %w", err)
+	}
+
+	headNumber := head.Number.Uint64()
+	if from > headNumber {
+		i.reportProgress(headNumber)
+		return nil
+	}
+	to := min(headNumber, from+i.config.MaxRange-1)
+	return i.indexRange(ctx, from, to)
+}
+
+// checkReorg returns the highest stored block still on the canonical chain,
+// which is the last indexed block unless a reorg has happened
+func (i *Indexer) checkReorg(ctx context.Context, last models.IndexedBlock) (uint64, error) {
+	if fork, err := findForkPoint(ctx, i.client, []models.IndexedBlock{last}); err == nil {
+		return fork, nil
+	} else if !errors.Is(err, ErrReorgTooDeep) {
+		return 0, err
+	}
+
+	var blocks []models.IndexedBlock
+	if err := i.db.WithContext(ctx).
+		Where("chain = ? AND number < ?", i.chain, last.Number).
+		Order("number DESC").
+		Find(&blocks).Error; err != nil {
+		return 0, fmt.Errorf("failed to load indexed blocks: %w", err)
+	}
+	fork, err := findForkPoint(ctx, i.client, blocks)
+	if errors.Is(err, ErrReorgTooDeep) {
+		log.Printf("ALERT: %s reorg at or below block %d is outside the reorg window; manual resync required", i.chain, last.Number)
+	}
+	return fork, err
+}
+
+// findForkPoint walks blocks, newest first, and returns the first whose stored
+// hash matches the canonical chain
+func findForkPoint(ctx context.Context, client ChainClient, blocks []models.IndexedBlock) (uint64, error) {
+	for _, block := range blocks {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(block.Number))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read block %d: %w", block.Number, err)
+		}
+		if header.Hash().Hex() == block.Hash {
+			return block.Number, nil
+		}
+	}
+	return 0, ErrReorgTooDeep
+}
+
+// rollback reverts every event above the fork point and forgets the
+// orphaned block hashes so the new canonical blocks are indexed again
+func (i *Indexer) rollback(ctx context.Context, fork uint64) error {
+	var orphaned []models.ChainEvent
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("chain = ? AND block_number > ? AND removed = ?", i.chain, fork, false).
+			Order("block_number DESC, id DESC").
+			Find(&orphaned).Error; err != nil {
+			return fmt.Errorf("failed to load orphaned events: %w", err)
+		}
+		for n := range orphaned {
+			if err := applyEvent(tx, &orphaned[n], -1); err != nil {
+				return err
+			}
+			if err := tx.Model(&orphaned[n]).Update("removed", true).Error; err != nil {
+				return fmt.Errorf("failed to mark event removed: %w", err)
+			}
+		}
+		if err := tx.Where("chain = ? AND number > ?", i.chain, fork).Delete(&models.IndexedBlock{}).Error; err != nil {
+			return fmt.Errorf("failed to drop orphaned blocks: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to roll back to block %d: %w", fork, err)
+	}
+
+	log.Printf("ALERT: %s reorg detected; rolled back %d events above block %d", i.chain, len(orphaned), fork)
+	metrics.IndexerReorgs.WithLabelValues(i.chain).Inc()
+	metrics.IndexerRolledBackEvents.WithLabelValues(i.chain).Add(float64(len(orphaned)))
+
+	for _, ev := range orphaned {
+		i.notify(ctx, types.Log{
+			Address:     i.contract,
+			BlockNumber: ev.BlockNumber,
+			BlockHash:   common.HexToHash(ev.BlockHash),
+			TxHash:      common.HexToHash(ev.TxHash),
+			Removed:     true,
+		})
+	}
+	return nil
+}
+
+// indexRange stores the contract's events in [from, to]. Blocks with events
+// are re-read after the log query so a reorg mid-scan is not stored.
+func (i *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	logs, err := i.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{i.contract},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query logs %d-%d: %w", from, to, err)
+	}
+
+	hashes := make(map[uint64]common.Hash)
+	blockHash := func(number uint64) (common.Hash, error) {
+		if hash, ok := hashes[number]; ok {
+			return hash, nil
+		}
+		header, err := i.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to read block %d: %w", number, err)
+		}
+		hashes[number] = header.Hash()
+		return hashes[number], nil
+	}
+	if _, err := blockHash(to); err != nil {
+		return err
+	}
+	for _, l := range logs {
+		hash, err := blockHash(l.BlockNumber)
+		if err != nil {
+			return err
+		}
+		if hash != l.BlockHash {
+			return fmt.Errorf("block %d changed while indexing, retrying", l.BlockNumber)
+		}
+	}
+
+	err = i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		positions := make(map[common.Hash]int)
+		for _, l := range logs {
+			position := positions[l.TxHash]
+			positions[l.TxHash]++
+			if err := i.store(tx, l, position); err != nil {
+				return err
+			}
+		}
+
+		for number, hash := range hashes {
+			block := models.IndexedBlock{Chain: i.chain, Number: number, Hash: hash.Hex()}
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&block).Error; err != nil {
+				return fmt.Errorf("failed to store block hash: %w", err)
+			}
+		}
+		if to > i.config.ReorgWindow {
+			if err := tx.Where("chain = ? AND number < ?", i.chain, to-i.config.ReorgWindow).
+				Delete(&models.IndexedBlock{}).Error; err != nil {
+				return fmt.Errorf("failed to prune block hashes: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, l := range logs {
+		i.notify(ctx, l)
+	}
+	i.reportProgress(to)
+	return nil
+}
+
+// store records one log and applies its effect. A transaction re-included
+// after a reorg is re-applied; one the service already recorded is not.
+func (i *Indexer) store(tx *gorm.DB, l types.Log, position int) error {
+	ev, err := decodeLog(&i.abi, l)
+	if err != nil || ev == nil {
+		return err
+	}
+	ev.Chain = i.chain
+	ev.TxHash = l.TxHash.Hex()
+	ev.Position = position
+	ev.BlockNumber = l.BlockNumber
+	ev.BlockHash = l.BlockHash.Hex()
+
+	var existing models.ChainEvent
+	err = tx.Where("chain = ? AND tx_hash = ? AND position = ?", ev.Chain, ev.TxHash, ev.Position).First(&existing).Error
+	switch {
+	case err == nil:
+		if existing.Removed {
+			if err := applyEvent(tx, ev, 1); err != nil {
+				return err
+			}
+		}
+		ev.ID = existing.ID
+		ev.CreatedAt = existing.CreatedAt
+		if err := tx.Save(ev).Error; err != nil {
+			return fmt.Errorf("failed to update event: %w", err)
+		}
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		accounted, err := accountedByService(tx, ev)
+		if err != nil {
+			return err
+		}
+		if !accounted {
+			if err := applyEvent(tx, ev, 1); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(ev).Error; err != nil {
+			return fmt.Errorf("failed to save event: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("failed to look up event: %w", err)
+	}
+}
+
+func (i *Indexer) notify(ctx context.Context, l types.Log) {
+	for _, handler := range i.handlers {
+		handler(ctx, i.chain, l)
+	}
+}
+
+func (i *Indexer) reportProgress(block uint64) {
+	if i.progress != nil {
+		i.progress.SetIndexedBlock(i.chain, block)
+	}
+}
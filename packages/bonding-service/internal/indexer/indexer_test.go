@@ -0,0 +1,167 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// fakeChain serves headers whose hashes are derived from a per-block salt,
+// so changing a block's salt simulates a reorg
+type fakeChain struct {
+	salts map[uint64]uint64
+	reads int
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	c.reads++
+	n := number.Uint64()
+	return &types.Header{Number: new(big.Int).SetUint64(n), Nonce: types.EncodeNonce(c.salts[n])}, nil
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (c *fakeChain) stored(numbers ...uint64) []models.IndexedBlock {
+	blocks := make([]models.IndexedBlock, len(numbers))
+	for i, n := range numbers {
+		header, _ := c.HeaderByNumber(context.Background(), new(big.Int).SetUint64(n))
+		blocks[i] = models.IndexedBlock{Chain: "test", Number: n, Hash: header.Hash().Hex()}
+	}
+	c.reads = 0
+	return blocks
+}
+
+func TestFindForkPoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		reorged   []uint64
+		wantFork  uint64
+		wantReads int
+		wantErr   error
+	}{
+		{"no reorg", nil, 120, 1, nil},
+		{"head block replaced", []uint64{120}, 110, 2, nil},
+		{"several blocks replaced", []uint64{110, 120}, 100, 3, nil},
+		{"deeper than window", []uint64{100, 110, 120}, 0, 3, ErrReorgTooDeep},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &fakeChain{salts: map[uint64]uint64{}}
+			blocks := chain.stored(120, 110, 100)
+			for _, n := range tt.reorged {
+				chain.salts[n] = 1
+			}
+
+			fork, err := findForkPoint(context.Background(), chain, blocks)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("findForkPoint() error = %v, want %v", err, tt.wantErr)
+			}
+			if fork != tt.wantFork {
+				t.Errorf("fork = %d, want %d", fork, tt.wantFork)
+			}
+			if chain.reads != tt.wantReads {
+				t.Errorf("header reads = %d, want %d", chain.reads, tt.wantReads)
+			}
+		})
+	}
+}
+
+func TestDecodeLog(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(blockchain.IPBondABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	investor := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	bondTopic := common.BigToHash(big.NewInt(7))
+
+	pack := func(event string, args ...interface{}) []byte {
+		var inputs abi.Arguments
+		for _, input := range contractABI.Events[event].Inputs {
+			if !input.Indexed {
+				inputs = append(inputs, input)
+			}
+		}
+		data, err := inputs.Pack(args...)
+		if err != nil {
+			t.Fatalf("pack %s: %v", event, err)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name string
+		log  types.Log
+		want *models.ChainEvent
+	}{
+		{
+			name: "investment",
+			log: types.Log{
+				Topics: []common.Hash{contractABI.Events[EventInvestment].ID, bondTopic, common.BytesToHash(investor.Bytes())},
+				Data:   pack(EventInvestment, uint8(2), big.NewInt(5000)),
+			},
+			want: &models.ChainEvent{Event: EventInvestment, BondID: "7", TrancheID: 2, Account: investor.Hex(), Amount: "5000"},
+		},
+		{
+			name: "revenue",
+			log: types.Log{
+				Topics: []common.Hash{contractABI.Events[EventRevenueDistributed].ID, bondTopic},
+				Data:   pack(EventRevenueDistributed, big.NewInt(900), big.NewInt(1700000000)),
+			},
+			want: &models.ChainEvent{Event: EventRevenueDistributed, BondID: "7", Amount: "900"},
+		},
+		{
+			name: "transfer",
+			log: types.Log{
+				Topics: []common.Hash{
+					contractABI.Events[EventPositionTransferred].ID, bondTopic,
+					common.BytesToHash(investor.Bytes()), common.BytesToHash(recipient.Bytes()),
+				},
+				Data: pack(EventPositionTransferred, uint8(1), big.NewInt(250)),
+			},
+			want: &models.ChainEvent{Event: EventPositionTransferred, BondID: "7", TrancheID: 1, Account: recipient.Hex(), Sender: investor.Hex(), Amount: "250"},
+		},
+		{
+			name: "claim",
+			log: types.Log{
+				Topics: []common.Hash{contractABI.Events[EventClaimed].ID, bondTopic, common.BytesToHash(investor.Bytes())},
+				Data:   pack(EventClaimed, uint8(0), big.NewInt(75)),
+			},
+			want: &models.ChainEvent{Event: EventClaimed, BondID: "7", Account: investor.Hex(), Amount: "75"},
+		},
+		{
+			name: "unknown event",
+			log:  types.Log{Topics: []common.Hash{common.HexToHash("0x1234")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeLog(&contractABI, tt.log)
+			if err != nil {
+				t.Fatalf("decodeLog() error = %v", err)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("decodeLog() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("decodeLog() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
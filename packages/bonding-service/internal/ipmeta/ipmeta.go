@@ -0,0 +1,382 @@
+// Package ipmeta resolves an IP-NFT's metadata document. The document's URI is
+// read from the NFT contract's tokenURI and fetched over IPFS gateways, HTTP or
+// a data: URI, then checked against the schema the minting backend writes:
+// ERC-721 metadata with the IP's category, creator, engagement and tags either
+// as top-level fields or as attributes.
+package ipmeta
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxDocumentSize bounds the metadata documents fetched
+const MaxDocumentSize = 1 << 20
+
+var (
+	// ErrNotFound is returned when the token or its document does not exist
+	ErrNotFound = errors.New("metadata not found")
+	// ErrInvalidDocument is returned for a document that doesn't match the schema
+	ErrInvalidDocument = errors.New("invalid metadata document")
+)
+
+const tokenURIABI = `[
+	{"inputs":[{"name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"}
+]`
+
+var parsedABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(tokenURIABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+var contentHashPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+// Document is a validated IP-NFT metadata document
+type Document struct {
+	URI          string
+	Name         string
+	Description  string
+	Image        string
+	AnimationURL string // The IP's media itself, empty when the document names none
+	Category     string
+	Creator      string    // Empty when the document names none
+	CreatedAt    time.Time // Zero when the document names none
+	Views        int32
+	Likes        int32
+	Tags         []string
+	ContentHash  string // 0x-prefixed 32-byte hash, empty when the document names none
+}
+
+// Resolver fetches and validates IP-NFT metadata
+type Resolver struct {
+	gateways   []string
+	httpClient *http.Client
+}
+
+// NewResolver creates a resolver fetching ipfs:// URIs through gateways,
+// tried in order
+func NewResolver(gateways []string) *Resolver {
+	trimmed := make([]string, 0, len(gateways))
+	for _, g := range gateways {
+		if g = strings.TrimRight(strings.TrimSpace(g), "/"); g != "" {
+			trimmed = append(trimmed, g)
+		}
+	}
+	return &Resolver{
+		gateways:   trimmed,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Resolve reads a token's URI from its NFT contract and fetches its document
+func (r *Resolver) Resolve(ctx context.Context, caller ethereum.ContractCaller, contract common.Address, tokenID *big.Int) (*Document, error) {
+	uri, err := TokenURI(ctx, caller, contract, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Fetch(ctx, uri)
+}
+
+// TokenURI calls tokenURI on an ERC-721 contract. A reverted call, which
+// is what nonexistent tokens do, is reported as ErrNotFound.
+func TokenURI(ctx context.Context, caller ethereum.ContractCaller, contract common.Address, tokenID *big.Int) (string, error) {
+	data, err := parsedABI.Pack("tokenURI", tokenID)
+	if err != nil {
+		return "", err
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "revert") {
+			return "", fmt.Errorf("%w: token %s on %s", ErrNotFound, tokenID, contract.Hex())
+		}
+		return "", fmt.Errorf("failed to call tokenURI on %s: %w", contract.Hex(), err)
+	}
+	values, err := parsedABI.Unpack("tokenURI", out)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode tokenURI from %s: %w", contract.Hex(), err)
+	}
+	uri := strings.TrimSpace(values[0].(string))
+	if uri == "" {
+		return "", fmt.Errorf("%w: token %s on %s has no URI", ErrNotFound, tokenID, contract.Hex())
+	}
+	return uri, nil
+}
+
+// Fetch retrieves and validates the document at uri
+func (r *Resolver) Fetch(ctx context.Context, uri string) (*Document, error) {
+	var data []byte
+	var err error
+	switch {
+	case strings.HasPrefix(uri, "data:"):
+		data, err = decodeDataURI(uri)
+	case strings.HasPrefix(uri, "ipfs://"), strings.HasPrefix(uri, "/ipfs/"):
+		data, err = r.fetchIPFS(ctx, ipfsPath(uri))
+	case strings.HasPrefix(uri, "https://"), strings.HasPrefix(uri, "http://"):
+		data, err = r.get(ctx, uri)
+	default:
+		return nil, fmt.Errorf("%w: unsupported URI %q", ErrInvalidDocument, uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	doc.URI = uri
+	return doc, nil
+}
+
+// ipfsPath returns the CID and path of an IPFS URI, accepting the legacy
+// ipfs://ipfs/ form
+func ipfsPath(uri string) string {
+	path := strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "/ipfs/")
+	return strings.TrimPrefix(path, "ipfs/")
+}
+
+// fetchIPFS tries each gateway until one serves the document. A document
+// every gateway reports missing is ErrNotFound.
+func (r *Resolver) fetchIPFS(ctx context.Context, path string) ([]byte, error) {
+	if len(r.gateways) == 0 {
+		return nil, fmt.Errorf("no IPFS gateways configured for ipfs://%s", path)
+	}
+	var errs []error
+	for _, gateway := range r.gateways {
+		data, err := r.get(ctx, gateway+"/ipfs/"+path)
+		if err == nil {
+			return data, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("failed to fetch ipfs://%s: %v", path, errors.Join(errs...))
+		}
+	}
+	return nil, fmt.Errorf("%w: ipfs://%s", ErrNotFound, path)
+}
+
+func (r *Resolver) get(ctx context.Context, endpoint string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, endpoint)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%s returned status %d", endpoint, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", endpoint, err)
+	}
+	if len(data) > MaxDocumentSize {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalidDocument, endpoint, MaxDocumentSize)
+	}
+	return data, nil
+}
+
+// decodeDataURI decodes an RFC 2397 data: URI, as on-chain metadata uses
+func decodeDataURI(uri string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed data URI", ErrInvalidDocument)
+	}
+	if strings.HasSuffix(header, ";base64") {
+		data, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed base64 data URI: %v", ErrInvalidDocument, err)
+		}
+		return data, nil
+	}
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed data URI: %v", ErrInvalidDocument, err)
+	}
+	return []byte(data), nil
+}
+
+// rawDocument is the document as written. Fields may also be given as
+// attributes; top-level fields win.
+type rawDocument struct {
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Image        string          `json:"image"`
+	AnimationURL string          `json:"animation_url"`
+	Category     string          `json:"category"`
+	Creator      string          `json:"creator"`
+	CreatedAt    json.RawMessage `json:"created_at"`
+	Views        json.RawMessage `json:"views"`
+	Likes        json.RawMessage `json:"likes"`
+	Tags         []string        `json:"tags"`
+	ContentHash  string          `json:"content_hash"`
+	Attributes   []struct {
+		TraitType string          `json:"trait_type"`
+		Value     json.RawMessage `json:"value"`
+	} `json:"attributes"`
+}
+
+// Parse validates a metadata document. It requires a name and a category;
+// the other IP fields are optional but must be well-formed.
+func Parse(data []byte) (*Document, error) {
+	var raw rawDocument
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+
+	// Attributes fill the fields not given at the top level
+	values := map[string]json.RawMessage{
+		"created_at": raw.CreatedAt,
+		"views":      raw.Views,
+		"likes":      raw.Likes,
+	}
+	for _, attr := range raw.Attributes {
+		key := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(attr.TraitType)), " ", "_")
+		var text string
+		_ = json.Unmarshal(attr.Value, &text)
+		switch key {
+		case "category":
+			if raw.Category == "" {
+				raw.Category = text
+			}
+		case "creator":
+			if raw.Creator == "" {
+				raw.Creator = text
+			}
+		case "content_hash":
+			if raw.ContentHash == "" {
+				raw.ContentHash = text
+			}
+		case "tag", "tags":
+			var list []string
+			if json.Unmarshal(attr.Value, &list) == nil {
+				raw.Tags = append(raw.Tags, list...)
+			} else {
+				raw.Tags = append(raw.Tags, text)
+			}
+		case "created_at", "views", "likes":
+			if len(values[key]) == 0 {
+				values[key] = attr.Value
+			}
+		}
+	}
+
+	var problems []string
+	doc := &Document{
+		Name:         strings.TrimSpace(raw.Name),
+		Description:  raw.Description,
+		Image:        raw.Image,
+		AnimationURL: strings.TrimSpace(raw.AnimationURL),
+		Category:     strings.TrimSpace(raw.Category),
+		Creator:      strings.TrimSpace(raw.Creator),
+		ContentHash:  strings.TrimSpace(raw.ContentHash),
+	}
+	if doc.Name == "" {
+		problems = append(problems, "name is required")
+	}
+	if doc.Category == "" {
+		problems = append(problems, "category is required")
+	}
+	if doc.Creator != "" && !common.IsHexAddress(doc.Creator) {
+		problems = append(problems, "creator must be an address")
+	}
+	if doc.ContentHash != "" && !contentHashPattern.MatchString(doc.ContentHash) {
+		problems = append(problems, "content_hash must be a 0x-prefixed 32-byte hex hash")
+	}
+	for _, tag := range raw.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			doc.Tags = append(doc.Tags, tag)
+		}
+	}
+
+	var err error
+	if doc.CreatedAt, err = parseTime(values["created_at"]); err != nil {
+		problems = append(problems, "created_at "+err.Error())
+	} else if doc.CreatedAt.After(time.Now().Add(time.Hour)) {
+		problems = append(problems, "created_at is in the future")
+	}
+	if doc.Views, err = parseCount(values["views"]); err != nil {
+		problems = append(problems, "views "+err.Error())
+	}
+	if doc.Likes, err = parseCount(values["likes"]); err != nil {
+		problems = append(problems, "likes "+err.Error())
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDocument, strings.Join(problems, "; "))
+	}
+	return doc, nil
+}
+
+// parseTime reads a Unix timestamp or an RFC 3339 string
+func parseTime(value json.RawMessage) (time.Time, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return time.Time{}, nil
+	}
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		if t, err := time.Parse(time.RFC3339, text); err == nil {
+			return t, nil
+		}
+		value = json.RawMessage(text)
+	}
+	unix, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || unix < 0 {
+		return time.Time{}, fmt.Errorf("must be a Unix timestamp or RFC 3339 time")
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// parseCount reads a non-negative count, from a number or a numeric string
+func parseCount(value json.RawMessage) (int32, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return 0, nil
+	}
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		value = json.RawMessage(text)
+	}
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("must be a non-negative integer")
+	}
+	if n > math.MaxInt32 {
+		n = math.MaxInt32
+	}
+	return int32(n), nil
+}
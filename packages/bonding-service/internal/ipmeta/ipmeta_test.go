@@ -0,0 +1,153 @@
+package ipmeta
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const validDocument = `{
+	"name": "Midnight Sessions",
+	"image": "ipfs://QmImage",
+	"animation_url": "ipfs://QmAudio",
+	"category": "music",
+	"creator": "0x3000000000000000000000000000000000000003",
+	"created_at": 1700000000,
+	"attributes": [
+		{"trait_type": "Views", "value": 1500},
+		{"trait_type": "Likes", "value": "120"},
+		{"trait_type": "Tag", "value": "jazz"},
+		{"trait_type": "Category", "value": "video"}
+	],
+	"tags": ["original"]
+}`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse([]byte(validDocument))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	// Top-level fields win over attributes
+	if doc.Category != "music" || doc.Views != 1500 || doc.Likes != 120 {
+		t.Errorf("category/views/likes = %s/%d/%d, want music/1500/120", doc.Category, doc.Views, doc.Likes)
+	}
+	if doc.AnimationURL != "ipfs://QmAudio" {
+		t.Errorf("AnimationURL = %q, want ipfs://QmAudio", doc.AnimationURL)
+	}
+	if !doc.CreatedAt.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("CreatedAt = %v", doc.CreatedAt)
+	}
+	if len(doc.Tags) != 2 || doc.Tags[0] != "original" || doc.Tags[1] != "jazz" {
+		t.Errorf("Tags = %v, want [original jazz]", doc.Tags)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"not JSON", `<html>`},
+		{"no name", `{"category": "music"}`},
+		{"no category", `{"name": "x"}`},
+		{"bad creator", `{"name": "x", "category": "music", "creator": "alice"}`},
+		{"bad content hash", `{"name": "x", "category": "music", "content_hash": "0x12"}`},
+		{"negative views", `{"name": "x", "category": "music", "views": -1}`},
+		{"future creation", `{"name": "x", "category": "music", "created_at": "2999-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		if _, err := Parse([]byte(tt.doc)); !errors.Is(err, ErrInvalidDocument) {
+			t.Errorf("%s: Parse() error = %v, want ErrInvalidDocument", tt.name, err)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ipfs/QmDoc/meta.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(validDocument))
+	}))
+	defer up.Close()
+
+	resolver := NewResolver([]string{down.URL, up.URL + "/"})
+	tests := []struct {
+		name    string
+		uri     string
+		wantErr error
+	}{
+		{"ipfs through the second gateway", "ipfs://QmDoc/meta.json", nil},
+		{"legacy ipfs form", "ipfs://ipfs/QmDoc/meta.json", nil},
+		{"http", up.URL + "/ipfs/QmDoc/meta.json", nil},
+		{"data URI", "data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(validDocument)), nil},
+		{"unsupported scheme", "ar://abc", ErrInvalidDocument},
+		{"missing over http", up.URL + "/missing", ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := resolver.Fetch(context.Background(), tt.uri)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Fetch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if doc.Name != "Midnight Sessions" || doc.URI != tt.uri {
+				t.Errorf("doc = %+v", doc)
+			}
+		})
+	}
+
+	// Only a document every gateway reports missing is not found
+	if _, err := resolver.Fetch(context.Background(), "ipfs://QmMissing"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Fetch() with a gateway down error = %v, want a fetch failure", err)
+	}
+	if _, err := NewResolver([]string{up.URL}).Fetch(context.Background(), "ipfs://QmMissing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Fetch() error = %v, want ErrNotFound", err)
+	}
+}
+
+// fakeCaller answers tokenURI with a fixed URI, or reverts
+type fakeCaller struct {
+	uri    string
+	revert bool
+}
+
+func (c fakeCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if c.revert {
+		return nil, errors.New("execution reverted: ERC721: invalid token ID")
+	}
+	return parsedABI.Methods["tokenURI"].Outputs.Pack(c.uri)
+}
+
+func TestTokenURI(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	uri, err := TokenURI(context.Background(), fakeCaller{uri: "ipfs://QmDoc"}, contract, big.NewInt(7))
+	if err != nil || uri != "ipfs://QmDoc" {
+		t.Errorf("TokenURI() = %q, %v, want ipfs://QmDoc", uri, err)
+	}
+	if _, err := TokenURI(context.Background(), fakeCaller{revert: true}, contract, big.NewInt(7)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("TokenURI() for a missing token error = %v, want ErrNotFound", err)
+	}
+	if _, err := TokenURI(context.Background(), fakeCaller{}, contract, big.NewInt(7)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("TokenURI() for an empty URI error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,145 @@
+package ipregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Registration kinds
+const (
+	KindPatent    = "PATENT"
+	KindTrademark = "TRADEMARK"
+)
+
+var (
+	// ErrNotFound is returned when the registry has no such registration
+	ErrNotFound = errors.New("registration not found")
+	// ErrUnsupportedJurisdiction is returned when no registry serves a jurisdiction
+	ErrUnsupportedJurisdiction = errors.New("no registry configured for jurisdiction")
+)
+
+// Record is a registration as reported by an official registry
+type Record struct {
+	Kind         string    `json:"kind"`
+	Number       string    `json:"number"`
+	Jurisdiction string    `json:"jurisdiction"`
+	Owner        string    `json:"owner"`
+	Status       string    `json:"status"` // e.g. GRANTED, REGISTERED, LAPSED
+	FiledAt      time.Time `json:"filed_at"`
+	ExpiresAt    time.Time `json:"expires_at"` // Zero when the registry reports no expiry
+}
+
+// Registry looks up patents and trademarks in an official register such as
+// USPTO or the EPO
+type Registry interface {
+	Lookup(ctx context.Context, kind, number string) (*Record, error)
+}
+
+// Router sends lookups to the registry for the registration's jurisdiction
+type Router struct {
+	registries map[string]Registry
+}
+
+// NewRouter creates an empty router
+func NewRouter() *Router {
+	return &Router{registries: make(map[string]Registry)}
+}
+
+// Register serves a jurisdiction (e.g. US, EP) from a registry
+func (r *Router) Register(jurisdiction string, registry Registry) {
+	r.registries[NormalizeJurisdiction(jurisdiction)] = registry
+}
+
+// Lookup finds a registration in its jurisdiction's registry
+func (r *Router) Lookup(ctx context.Context, kind, jurisdiction, number string) (*Record, error) {
+	registry, ok := r.registries[NormalizeJurisdiction(jurisdiction)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedJurisdiction, jurisdiction)
+	}
+	record, err := registry.Lookup(ctx, strings.ToUpper(kind), NormalizeNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	if record.Jurisdiction == "" {
+		record.Jurisdiction = NormalizeJurisdiction(jurisdiction)
+	}
+	return record, nil
+}
+
+// NormalizeJurisdiction converts a jurisdiction to its upper-case code
+func NormalizeJurisdiction(jurisdiction string) string {
+	return strings.ToUpper(strings.TrimSpace(jurisdiction))
+}
+
+// NormalizeNumber strips the separators registries format numbers with
+func NormalizeNumber(number string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', ',', '.', '/', '-':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(number)))
+}
+
+// HTTPRegistry reads registrations from a JSON API that serves
+// GET {baseURL}/{kind}/{number} as a Record. Official registries are reached
+// through a gateway exposing this shape.
+type HTTPRegistry struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewHTTPRegistry creates a registry adapter for a JSON API
+func NewHTTPRegistry(baseURL, apiKey string) *HTTPRegistry {
+	return &HTTPRegistry{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Lookup fetches a registration
+func (h *HTTPRegistry) Lookup(ctx context.Context, kind, number string) (*Record, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s", h.baseURL, url.PathEscape(strings.ToLower(kind)), url.PathEscape(number))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if h.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+h.apiKey)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("registry returned status %d", resp.StatusCode)
+	}
+
+	var record Record
+	if err := json.NewDecoder(resp.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode registry response: %w", err)
+	}
+	if record.Kind == "" {
+		record.Kind = strings.ToUpper(kind)
+	}
+	if record.Number == "" {
+		record.Number = number
+	}
+	return &record, nil
+}
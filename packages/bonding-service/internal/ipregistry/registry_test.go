@@ -0,0 +1,52 @@
+package ipregistry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPRegistryLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/patent/US10123456B2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"owner":"Acme","status":"GRANTED","expires_at":"2035-01-31T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	router := NewRouter()
+	router.Register("us", NewHTTPRegistry(server.URL+"/", "secret"))
+	ctx := context.Background()
+
+	record, err := router.Lookup(ctx, "patent", "US", "us 10,123,456 b2")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	want := Record{
+		Kind:         KindPatent,
+		Number:       "US10123456B2",
+		Jurisdiction: "US",
+		Owner:        "Acme",
+		Status:       "GRANTED",
+		ExpiresAt:    time.Date(2035, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	if *record != want {
+		t.Errorf("Lookup() = %+v, want %+v", *record, want)
+	}
+
+	if _, err := router.Lookup(ctx, "patent", "US", "999"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing registration error = %v, want ErrNotFound", err)
+	}
+	if _, err := router.Lookup(ctx, "patent", "EP", "1"); !errors.Is(err, ErrUnsupportedJurisdiction) {
+		t.Errorf("unknown jurisdiction error = %v, want ErrUnsupportedJurisdiction", err)
+	}
+}
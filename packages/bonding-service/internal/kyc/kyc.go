@@ -0,0 +1,239 @@
+// Package kyc gates investment on wallets being verified. External
+// verifiers run the KYC and AML checks and report their decisions through
+// callbacks; the store keeps the latest decision per tenant and wallet.
+package kyc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrNotVerified is returned by Check for a wallet that may not invest
+	ErrNotVerified = errors.New("investor not verified")
+	// ErrInvalidCallback is returned for a callback that fails authentication
+	// or validation
+	ErrInvalidCallback = errors.New("invalid KYC callback")
+)
+
+// maxCallbackSize bounds callback bodies
+const maxCallbackSize = 64 << 10
+
+// Result is a verifier's decision about a wallet
+type Result struct {
+	TenantID        string    `json:"tenant_id"` // Empty for the default tenant
+	Address         string    `json:"address"`
+	Status          string    `json:"status"` // PENDING, VERIFIED or REJECTED
+	Reference       string    `json:"reference"`
+	Jurisdiction    string    `json:"jurisdiction"`
+	Reason          string    `json:"reason"`
+	Accredited      bool      `json:"accredited"`
+	AccreditedUntil time.Time `json:"accredited_until"` // Zero if accreditation doesn't lapse
+	DecidedAt       time.Time `json:"decided_at"`
+	ExpiresAt       time.Time `json:"expires_at"` // Zero if verification doesn't lapse
+}
+
+func (r *Result) validate() error {
+	switch r.Status {
+	case models.KYCPending, models.KYCVerified, models.KYCRejected:
+	default:
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidCallback, r.Status)
+	}
+	if !common.IsHexAddress(r.Address) {
+		return fmt.Errorf("%w: %q is not an address", ErrInvalidCallback, r.Address)
+	}
+	if r.DecidedAt.IsZero() {
+		return fmt.Errorf("%w: decided_at is required", ErrInvalidCallback)
+	}
+	return nil
+}
+
+// Provider is an external verifier reporting decisions by callback
+type Provider interface {
+	Name() string
+	// ParseCallback authenticates a callback request and decodes its result.
+	// Failures wrap ErrInvalidCallback.
+	ParseCallback(r *http.Request) (*Result, error)
+}
+
+// WebhookProvider accepts a Result as JSON, signed with a shared secret:
+// the X-Signature header carries sha256= and the hex HMAC-SHA256 of the body
+type WebhookProvider struct {
+	name   string
+	secret []byte
+}
+
+// NewWebhookProvider creates a provider verifying callbacks with secret
+func NewWebhookProvider(name string, secret []byte) *WebhookProvider {
+	return &WebhookProvider{name: name, secret: secret}
+}
+
+// Name returns the provider's name
+func (p *WebhookProvider) Name() string {
+	return p.name
+}
+
+// ParseCallback checks the signature and decodes the result
+func (p *WebhookProvider) ParseCallback(r *http.Request) (*Result, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCallbackSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read callback: %w", err)
+	}
+	if len(body) > maxCallbackSize {
+		return nil, fmt.Errorf("%w: body larger than %d bytes", ErrInvalidCallback, maxCallbackSize)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(r.Header.Get("X-Signature"), "sha256="))
+	if err != nil || len(signature) == 0 {
+		return nil, fmt.Errorf("%w: missing or malformed signature", ErrInvalidCallback)
+	}
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidCallback)
+	}
+
+	var result Result
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCallback, err)
+	}
+	return &result, nil
+}
+
+// Store keeps investor profiles
+type Store struct {
+	db *gorm.DB
+}
+
+// NewStore creates a store
+func NewStore(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Apply records a verifier's decision. A decision older than the one
+// recorded is ignored, so callbacks delivered out of order can't revert a
+// wallet to an earlier status.
+func (s *Store) Apply(ctx context.Context, provider string, result *Result) error {
+	if err := result.validate(); err != nil {
+		return err
+	}
+	tenantID := result.TenantID
+	if tenantID == "" {
+		tenantID = tenant.Default
+	}
+	profile := &models.InvestorProfile{
+		TenantID:     tenantID,
+		Address:      common.HexToAddress(result.Address).Hex(),
+		Status:       result.Status,
+		Provider:     provider,
+		Reference:    result.Reference,
+		Jurisdiction: strings.ToUpper(result.Jurisdiction),
+		Reason:       result.Reason,
+		Accredited:   result.Accredited,
+		DecidedAt:    result.DecidedAt.UTC(),
+	}
+	if !result.ExpiresAt.IsZero() {
+		expiresAt := result.ExpiresAt.UTC()
+		profile.ExpiresAt = &expiresAt
+	}
+	if result.Accredited && !result.AccreditedUntil.IsZero() {
+		accreditedUntil := result.AccreditedUntil.UTC()
+		profile.AccreditedUntil = &accreditedUntil
+	}
+
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status", "provider", "reference", "jurisdiction", "reason", "accredited", "accredited_until",
+			"decided_at", "expires_at", "updated_at",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "investor_profiles.decided_at < excluded.decided_at"},
+		}},
+	}).Create(profile).Error
+	if err != nil {
+		return fmt.Errorf("failed to save investor profile: %w", err)
+	}
+	return nil
+}
+
+// Profile returns a wallet's profile, nil if no verifier has reported on it
+func (s *Store) Profile(ctx context.Context, tenantID, address string) (*models.InvestorProfile, error) {
+	var profile models.InvestorProfile
+	err := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND address = ?", tenantID, common.HexToAddress(address).Hex()).
+		First(&profile).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load investor profile: %w", err)
+	}
+	return &profile, nil
+}
+
+// Check returns an error wrapping ErrNotVerified, saying why, unless the
+// wallet is verified with the tenant and its verification hasn't lapsed
+func (s *Store) Check(ctx context.Context, tenantID, address string) error {
+	profile, err := s.Profile(ctx, tenantID, address)
+	if err != nil {
+		return err
+	}
+	return checkProfile(profile, address, time.Now())
+}
+
+func checkProfile(profile *models.InvestorProfile, address string, now time.Time) error {
+	switch {
+	case profile == nil:
+		return fmt.Errorf("%w: %s has not completed KYC", ErrNotVerified, address)
+	case profile.Status == models.KYCPending:
+		return fmt.Errorf("%w: KYC of %s is pending", ErrNotVerified, address)
+	case profile.Status == models.KYCRejected:
+		return fmt.Errorf("%w: KYC of %s was rejected", ErrNotVerified, address)
+	case profile.Status != models.KYCVerified:
+		return fmt.Errorf("%w: %s has KYC status %s", ErrNotVerified, address, profile.Status)
+	case profile.ExpiresAt != nil && !now.Before(*profile.ExpiresAt):
+		return fmt.Errorf("%w: KYC of %s expired on %s", ErrNotVerified, address, profile.ExpiresAt.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// Handler serves verifier callbacks, POSTed to /kyc/{provider}
+func Handler(store *Store, providers ...Provider) http.Handler {
+	mux := http.NewServeMux()
+	for _, provider := range providers {
+		provider := provider
+		mux.HandleFunc("POST /kyc/"+provider.Name(), func(w http.ResponseWriter, r *http.Request) {
+			result, err := provider.ParseCallback(r)
+			if err == nil {
+				err = store.Apply(r.Context(), provider.Name(), result)
+			}
+			switch {
+			case errors.Is(err, ErrInvalidCallback):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			case err != nil:
+				log.Printf("KYC callback from %s failed: %v", provider.Name(), err)
+				http.Error(w, "failed to record result", http.StatusInternalServerError)
+			default:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+	}
+	return mux
+}
@@ -0,0 +1,173 @@
+package kyc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testAddress = "0x1111111111111111111111111111111111111111"
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookProviderParseCallback(t *testing.T) {
+	body := `{"address": "` + testAddress + `", "status": "VERIFIED", "decided_at": "2026-01-05T10:00:00Z"}`
+
+	tests := []struct {
+		name      string
+		signature string
+		body      string
+		wantErr   bool
+	}{
+		{"signed", sign("secret", body), body, false},
+		{"wrong secret", sign("other", body), body, true},
+		{"tampered body", sign("secret", body), strings.Replace(body, "VERIFIED", "REJECTED", 1), true},
+		{"unsigned", "", body, true},
+		{"not JSON", sign("secret", "verified"), "verified", true},
+	}
+	provider := NewWebhookProvider("acme-kyc", []byte("secret"))
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/kyc/acme-kyc", strings.NewReader(tt.body))
+		if tt.signature != "" {
+			req.Header.Set("X-Signature", tt.signature)
+		}
+		result, err := provider.ParseCallback(req)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidCallback) {
+				t.Errorf("%s: ParseCallback() error = %v, want ErrInvalidCallback", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: ParseCallback() error = %v", tt.name, err)
+			continue
+		}
+		if result.Status != models.KYCVerified || result.Address != testAddress {
+			t.Errorf("%s: ParseCallback() = %+v", tt.name, result)
+		}
+	}
+}
+
+func TestCheckProfile(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		profile  *models.InvestorProfile
+		verified bool
+	}{
+		{"no profile", nil, false},
+		{"pending", &models.InvestorProfile{Status: models.KYCPending}, false},
+		{"rejected", &models.InvestorProfile{Status: models.KYCRejected}, false},
+		{"expired", &models.InvestorProfile{Status: models.KYCVerified, ExpiresAt: &past}, false},
+		{"verified", &models.InvestorProfile{Status: models.KYCVerified, ExpiresAt: &future}, true},
+		{"verified without expiry", &models.InvestorProfile{Status: models.KYCVerified}, true},
+	}
+	for _, tt := range tests {
+		err := checkProfile(tt.profile, testAddress, now)
+		if tt.verified && err != nil {
+			t.Errorf("%s: checkProfile() error = %v", tt.name, err)
+		}
+		if !tt.verified && !errors.Is(err, ErrNotVerified) {
+			t.Errorf("%s: checkProfile() error = %v, want ErrNotVerified", tt.name, err)
+		}
+	}
+}
+
+func TestStoreApply(t *testing.T) {
+	db, mock := newMockDB(t)
+	store := NewStore(db)
+
+	mock.ExpectQuery(`INSERT INTO "investor_profiles" .* ON CONFLICT \("tenant_id","address"\) DO UPDATE SET .* WHERE `+
+		regexp.QuoteMeta(`investor_profiles.decided_at < excluded.decided_at`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "default", testAddress, models.KYCVerified,
+			"acme-kyc", "case-81", "DE", "", false, nil, sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	err := store.Apply(context.Background(), "acme-kyc", &Result{
+		Address:      strings.ToLower(testAddress),
+		Status:       models.KYCVerified,
+		Reference:    "case-81",
+		Jurisdiction: "de",
+		DecidedAt:    time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	body := `{"address": "` + testAddress + `", "status": "VERIFIED", "decided_at": "2026-01-05T10:00:00Z"}`
+	invalid := `{"address": "` + testAddress + `", "status": "APPROVED", "decided_at": "2026-01-05T10:00:00Z"}`
+
+	tests := []struct {
+		name      string
+		path      string
+		body      string
+		signature string
+		want      int
+	}{
+		{"recorded", "/kyc/acme-kyc", body, sign("secret", body), http.StatusNoContent},
+		{"bad signature", "/kyc/acme-kyc", body, sign("other", body), http.StatusBadRequest},
+		{"unknown status", "/kyc/acme-kyc", invalid, sign("secret", invalid), http.StatusBadRequest},
+		{"unknown provider", "/kyc/other", body, sign("secret", body), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			mock.ExpectQuery(`INSERT INTO "investor_profiles"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			handler := Handler(NewStore(db), NewWebhookProvider("acme-kyc", []byte("secret")))
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("X-Signature", tt.signature)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
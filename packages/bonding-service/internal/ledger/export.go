@@ -0,0 +1,198 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// Export formats
+const (
+	FormatCSV = "CSV"
+	FormatOFX = "OFX"
+	FormatIIF = "IIF" // QuickBooks Desktop import format
+)
+
+// ExportOptions controls how amounts and statements are rendered
+type ExportOptions struct {
+	Decimals    int    // Token decimals used to render base-unit amounts
+	Currency    string // ISO or token symbol written to OFX statements
+	AccountID   string // Statement account written to OFX
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+// ContentType returns the MIME type and file extension of a format
+func ContentType(format string) (string, string, error) {
+	switch strings.ToUpper(format) {
+	case FormatCSV:
+		return "text/csv", "csv", nil
+	case FormatOFX:
+		return "application/x-ofx", "ofx", nil
+	case FormatIIF:
+		return "application/x-iif", "iif", nil
+	}
+	return "", "", fmt.Errorf("unsupported export format: %s", format)
+}
+
+// Write exports entries in the given format
+func Write(w io.Writer, format string, entries []models.LedgerEntry, opts ExportOptions) error {
+	switch strings.ToUpper(format) {
+	case FormatCSV:
+		return WriteCSV(w, entries, opts)
+	case FormatOFX:
+		return WriteOFX(w, entries, opts)
+	case FormatIIF:
+		return WriteIIF(w, entries, opts)
+	}
+	return fmt.Errorf("unsupported export format: %s", format)
+}
+
+// WriteCSV writes one row per journal line
+func WriteCSV(w io.Writer, entries []models.LedgerEntry, opts ExportOptions) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "entry_id", "kind", "bond_id", "reference", "account", "debit", "credit", "memo"}); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		for _, l := range e.Lines {
+			row := []string{
+				e.PostedAt.UTC().Format("2006-01-02"),
+				strconv.FormatUint(uint64(e.ID), 10),
+				e.Kind,
+				e.BondID,
+				e.Reference,
+				l.Account,
+				FormatUnits(parseAmount(l.Debit), opts.Decimals),
+				FormatUnits(parseAmount(l.Credit), opts.Decimals),
+				e.Memo,
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteIIF writes a QuickBooks IIF general journal. Debits are positive and
+// credits negative; the first line of each entry is the TRNS row.
+func WriteIIF(w io.Writer, entries []models.LedgerEntry, opts ExportOptions) error {
+	header := "!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tAMOUNT\tDOCNUM\tMEMO\n" +
+		"!SPL\tSPLID\tTRNSTYPE\tDATE\tACCNT\tAMOUNT\tDOCNUM\tMEMO\n" +
+		"!ENDTRNS\n"
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		date := e.PostedAt.UTC().Format("01/02/2006")
+		for i, l := range e.Lines {
+			rowType := "SPL"
+			if i == 0 {
+				rowType = "TRNS"
+			}
+			amount := new(big.Int).Sub(parseAmount(l.Debit), parseAmount(l.Credit))
+			_, err := fmt.Fprintf(w, "%s\t\tGENERAL JOURNAL\t%s\t%s\t%s\t%d\t%s\n",
+				rowType, date, iifField(l.Account), FormatUnits(amount, opts.Decimals), e.ID, iifField(e.Memo))
+			if err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "ENDTRNS\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteOFX writes an OFX 2.2 bank statement of the escrow account. Each entry
+// that moves escrow cash becomes one statement transaction.
+func WriteOFX(w io.Writer, entries []models.LedgerEntry, opts ExportOptions) error {
+	currency := opts.Currency
+	if currency == "" {
+		currency = "USD"
+	}
+	accountID := opts.AccountID
+	if accountID == "" {
+		accountID = AccountEscrow
+	}
+
+	now := ofxTime(time.Now())
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n")
+	b.WriteString(`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n")
+	b.WriteString("<OFX>\n")
+	fmt.Fprintf(&b, "<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS><DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>\n", now)
+	b.WriteString("<BANKMSGSRSV1><STMTTRNRS><TRNUID>0</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	fmt.Fprintf(&b, "<STMTRS><CURDEF>%s</CURDEF>\n", xmlEscape(currency))
+	fmt.Fprintf(&b, "<BANKACCTFROM><BANKID>KNOWTON</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n", xmlEscape(accountID))
+	fmt.Fprintf(&b, "<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", ofxTime(opts.PeriodStart), ofxTime(opts.PeriodEnd))
+
+	balance := big.NewInt(0)
+	for _, e := range entries {
+		net := big.NewInt(0)
+		for _, l := range e.Lines {
+			if strings.HasPrefix(l.Account, AccountEscrow) {
+				net.Add(net, parseAmount(l.Debit))
+				net.Sub(net, parseAmount(l.Credit))
+			}
+		}
+		if net.Sign() == 0 {
+			continue
+		}
+		balance.Add(balance, net)
+
+		trnType := "CREDIT"
+		if net.Sign() < 0 {
+			trnType = "DEBIT"
+		}
+		fmt.Fprintf(&b, "<STMTTRN><TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%s</TRNAMT><FITID>%d</FITID><NAME>%s</NAME><MEMO>%s</MEMO></STMTTRN>\n",
+			trnType, ofxTime(e.PostedAt), FormatUnits(net, opts.Decimals), e.ID,
+			xmlEscape(truncate(e.Kind+" "+e.BondID, 32)), xmlEscape(e.Memo))
+	}
+
+	b.WriteString("</BANKTRANLIST>\n")
+	fmt.Fprintf(&b, "<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n", FormatUnits(balance, opts.Decimals), ofxTime(opts.PeriodEnd))
+	b.WriteString("</STMTRS></STMTTRNRS></BANKMSGSRSV1>\n</OFX>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func parseAmount(value string) *big.Int {
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return n
+}
+
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+// iifField strips characters that would break the tab-separated IIF layout
+func iifField(s string) string {
+	return strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(s)
+}
+
+func xmlEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
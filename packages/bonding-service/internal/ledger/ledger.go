@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Chart of accounts used for platform activity
+const (
+	AccountEscrow            = "Assets:Escrow"
+	AccountBondPrincipal     = "Liabilities:Bond Principal"
+	AccountIPRevenue         = "Income:IP Revenue"
+	AccountRedemptionPenalty = "Income:Redemption Penalties"
+	AccountCouponPayments    = "Expenses:Coupon Payments"
+)
+
+// Line is a debit or credit to an account. Exactly one of Debit and Credit is non-zero.
+type Line struct {
+	Account string
+	Debit   *big.Int
+	Credit  *big.Int
+}
+
+// Debit returns a debit line
+func Debit(account string, amount *big.Int) Line {
+	return Line{Account: account, Debit: new(big.Int).Set(amount), Credit: big.NewInt(0)}
+}
+
+// Credit returns a credit line
+func Credit(account string, amount *big.Int) Line {
+	return Line{Account: account, Debit: big.NewInt(0), Credit: new(big.Int).Set(amount)}
+}
+
+// SubAccount appends path segments to an account, e.g. Liabilities:Bond Principal:42:0
+func SubAccount(account string, parts ...interface{}) string {
+	segments := []string{account}
+	for _, p := range parts {
+		segments = append(segments, fmt.Sprint(p))
+	}
+	return strings.Join(segments, ":")
+}
+
+// Validate checks that lines are non-negative, non-empty and balanced
+func Validate(lines []Line) error {
+	if len(lines) < 2 {
+		return fmt.Errorf("journal entry needs at least two lines")
+	}
+
+	debits := big.NewInt(0)
+	credits := big.NewInt(0)
+	for _, l := range lines {
+		if l.Debit.Sign() < 0 || l.Credit.Sign() < 0 {
+			return fmt.Errorf("negative amount on %s", l.Account)
+		}
+		debits.Add(debits, l.Debit)
+		credits.Add(credits, l.Credit)
+	}
+	if debits.Cmp(credits) != 0 {
+		return fmt.Errorf("journal entry is unbalanced: debits %s, credits %s", debits, credits)
+	}
+	return nil
+}
+
+// FormatUnits renders an integer amount of base units as a decimal string
+// with the given number of decimals, e.g. 1500000000000000000 → 1.5
+func FormatUnits(amount *big.Int, decimals int) string {
+	if decimals <= 0 {
+		return amount.String()
+	}
+
+	sign := ""
+	abs := new(big.Int).Set(amount)
+	if abs.Sign() < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(abs, unit, new(big.Int))
+	if frac.Sign() == 0 {
+		return sign + whole.String()
+	}
+
+	fracStr := fmt.Sprintf("%0*s", decimals, frac.String())
+	return sign + whole.String() + "." + strings.TrimRight(fracStr, "0")
+}
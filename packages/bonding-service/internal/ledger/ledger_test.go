@@ -0,0 +1,116 @@
+package ledger
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"1500000000000000000", 18, "1.5"},
+		{"1000000000000000000", 18, "1"},
+		{"1", 18, "0.000000000000000001"},
+		{"-2500000", 6, "-2.5"},
+		{"42", 0, "42"},
+	}
+
+	for _, tt := range tests {
+		amount, _ := new(big.Int).SetString(tt.amount, 10)
+		if got := FormatUnits(amount, tt.decimals); got != tt.want {
+			t.Errorf("FormatUnits(%s, %d) = %s, want %s", tt.amount, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []Line
+		wantErr bool
+	}{
+		{"balanced", []Line{Debit(AccountEscrow, big.NewInt(100)), Credit(AccountIPRevenue, big.NewInt(100))}, false},
+		{"split credit", []Line{
+			Debit(AccountBondPrincipal, big.NewInt(100)),
+			Credit(AccountEscrow, big.NewInt(98)),
+			Credit(AccountRedemptionPenalty, big.NewInt(2)),
+		}, false},
+		{"unbalanced", []Line{Debit(AccountEscrow, big.NewInt(100)), Credit(AccountIPRevenue, big.NewInt(90))}, true},
+		{"single line", []Line{Debit(AccountEscrow, big.NewInt(0))}, true},
+		{"negative", []Line{Debit(AccountEscrow, big.NewInt(-1)), Credit(AccountIPRevenue, big.NewInt(-1))}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.lines); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func sampleEntries() []models.LedgerEntry {
+	entry := models.LedgerEntry{
+		BondID:    "7",
+		Kind:      models.LedgerRevenue,
+		Reference: "0xabc",
+		Memo:      "IP revenue received",
+		PostedAt:  time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Lines: []models.LedgerLine{
+			{Account: AccountEscrow, Debit: "2500000000000000000", Credit: "0"},
+			{Account: "Income:IP Revenue:7", Debit: "0", Credit: "2500000000000000000"},
+		},
+	}
+	entry.ID = 3
+	return []models.LedgerEntry{entry}
+}
+
+func TestExportFormats(t *testing.T) {
+	opts := ExportOptions{
+		Decimals:    18,
+		PeriodStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{FormatCSV, []string{
+			"date,entry_id,kind,bond_id,reference,account,debit,credit,memo",
+			"2026-03-01,3,REVENUE,7,0xabc,Assets:Escrow,2.5,0,IP revenue received",
+			"2026-03-01,3,REVENUE,7,0xabc,Income:IP Revenue:7,0,2.5,IP revenue received",
+		}},
+		{FormatIIF, []string{
+			"TRNS\t\tGENERAL JOURNAL\t03/01/2026\tAssets:Escrow\t2.5\t3\tIP revenue received",
+			"SPL\t\tGENERAL JOURNAL\t03/01/2026\tIncome:IP Revenue:7\t-2.5\t3\tIP revenue received",
+			"ENDTRNS",
+		}},
+		{FormatOFX, []string{
+			"<TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20260301120000[0:GMT]</DTPOSTED><TRNAMT>2.5</TRNAMT><FITID>3</FITID>",
+			"<LEDGERBAL><BALAMT>2.5</BALAMT>",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, strings.ToLower(tt.format), sampleEntries(), opts); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("%s export missing %q:\n%s", tt.format, want, buf.String())
+				}
+			}
+		})
+	}
+}
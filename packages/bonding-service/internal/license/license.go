@@ -0,0 +1,149 @@
+package license
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Worldwide licenses every territory
+const Worldwide = "WORLDWIDE"
+
+// ErrRightsNotHeld is returned when the issuer can't be shown to hold an
+// agreement's revenue rights
+var ErrRightsNotHeld = errors.New("issuer does not hold the revenue rights")
+
+// Agreement is the license or royalty agreement that generates a bond's revenue
+type Agreement struct {
+	Licensor     common.Address // Rights holder granting the license and receiving royalties
+	Licensee     string
+	RoyaltyBps   int64 // Royalty as basis points of the licensee's revenue
+	StartsAt     time.Time
+	EndsAt       time.Time // Zero for a perpetual license
+	Territories  []string  // ISO 3166 country codes, or WORLDWIDE
+	Exclusive    bool
+	DocumentHash string // SHA-256 of the signed agreement, hex encoded
+}
+
+// Normalize trims the licensee and upper-cases, sorts and de-duplicates
+// territories so equal terms hash equally
+func (a *Agreement) Normalize() {
+	a.Licensee = strings.TrimSpace(a.Licensee)
+	a.DocumentHash = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(a.DocumentHash), "0x"))
+
+	seen := make(map[string]bool, len(a.Territories))
+	territories := make([]string, 0, len(a.Territories))
+	for _, t := range a.Territories {
+		t = strings.ToUpper(strings.TrimSpace(t))
+		if t != "" && !seen[t] {
+			seen[t] = true
+			territories = append(territories, t)
+		}
+	}
+	sort.Strings(territories)
+	a.Territories = territories
+}
+
+// Validate checks the agreement's terms are complete and consistent
+func (a *Agreement) Validate() error {
+	switch {
+	case a.Licensor == (common.Address{}):
+		return errors.New("licensor is required")
+	case a.Licensee == "":
+		return errors.New("licensee is required")
+	case a.RoyaltyBps <= 0 || a.RoyaltyBps > 10000:
+		return fmt.Errorf("royalty_bps must be between 1 and 10000, got %d", a.RoyaltyBps)
+	case a.StartsAt.IsZero():
+		return errors.New("license start is required")
+	case !a.EndsAt.IsZero() && !a.EndsAt.After(a.StartsAt):
+		return errors.New("license must end after it starts")
+	case len(a.Territories) == 0:
+		return errors.New("at least one territory is required")
+	}
+	for _, t := range a.Territories {
+		if t != Worldwide && len(t) != 2 {
+			return fmt.Errorf("invalid territory %q: use ISO 3166 alpha-2 codes or %s", t, Worldwide)
+		}
+	}
+	if a.DocumentHash != "" {
+		if digest, err := hex.DecodeString(a.DocumentHash); err != nil || len(digest) != 32 {
+			return errors.New("document_hash must be a hex-encoded SHA-256 digest")
+		}
+	}
+	return nil
+}
+
+// IsWorldwide reports whether the license covers every territory
+func (a *Agreement) IsWorldwide() bool {
+	for _, t := range a.Territories {
+		if t == Worldwide {
+			return true
+		}
+	}
+	return false
+}
+
+// Hash identifies the agreement's terms. Call Normalize first.
+func (a *Agreement) Hash() common.Hash {
+	var ends int64
+	if !a.EndsAt.IsZero() {
+		ends = a.EndsAt.Unix()
+	}
+	terms := strings.Join([]string{
+		a.Licensor.Hex(),
+		a.Licensee,
+		strconv.FormatInt(a.RoyaltyBps, 10),
+		strconv.FormatInt(a.StartsAt.Unix(), 10),
+		strconv.FormatInt(ends, 10),
+		strings.Join(a.Territories, ","),
+		strconv.FormatBool(a.Exclusive),
+		a.DocumentHash,
+	}, "\n")
+	return crypto.Keccak256Hash([]byte(terms))
+}
+
+// AssignmentMessage is the text a licensor signs with personal_sign to
+// assign an agreement's revenue rights to a bond issuer
+func AssignmentMessage(agreement common.Hash, assignee common.Address) []byte {
+	return []byte(fmt.Sprintf("KnowTon revenue rights assignment\nAgreement: %s\nAssignee: %s",
+		agreement.Hex(), assignee.Hex()))
+}
+
+// VerifyRevenueRights checks the issuer holds the agreement's revenue rights:
+// either the issuer is the licensor, or the licensor signed an assignment of
+// the rights to the issuer
+func VerifyRevenueRights(a *Agreement, issuer common.Address, signature []byte) error {
+	if a.Licensor == issuer {
+		return nil
+	}
+	if len(signature) == 0 {
+		return ErrRightsNotHeld
+	}
+	if len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("signature must be %d bytes", crypto.SignatureLength)
+	}
+
+	// Wallets produce v as 27/28; crypto expects 0/1
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(AssignmentMessage(a.Hash(), issuer)), sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover signer: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != a.Licensor {
+		return ErrRightsNotHeld
+	}
+	return nil
+}
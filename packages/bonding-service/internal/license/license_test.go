@@ -0,0 +1,98 @@
+package license
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func validAgreement() Agreement {
+	return Agreement{
+		Licensor:    common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Licensee:    "Streaming Co",
+		RoyaltyBps:  1200,
+		StartsAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:      time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		Territories: []string{"US", "gb"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(a *Agreement)
+		wantErr bool
+	}{
+		{"valid", func(a *Agreement) {}, false},
+		{"perpetual worldwide", func(a *Agreement) { a.EndsAt = time.Time{}; a.Territories = []string{"worldwide"} }, false},
+		{"with document hash", func(a *Agreement) { a.DocumentHash = "0x" + common.Bytes2Hex(make([]byte, 32)) }, false},
+		{"missing licensor", func(a *Agreement) { a.Licensor = common.Address{} }, true},
+		{"missing licensee", func(a *Agreement) { a.Licensee = " " }, true},
+		{"zero royalty", func(a *Agreement) { a.RoyaltyBps = 0 }, true},
+		{"royalty over 100%", func(a *Agreement) { a.RoyaltyBps = 10001 }, true},
+		{"ends before start", func(a *Agreement) { a.EndsAt = a.StartsAt }, true},
+		{"no territory", func(a *Agreement) { a.Territories = []string{" "} }, true},
+		{"bad territory", func(a *Agreement) { a.Territories = []string{"USA"} }, true},
+		{"bad document hash", func(a *Agreement) { a.DocumentHash = "abc" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := validAgreement()
+			tt.modify(&a)
+			a.Normalize()
+			if err := a.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyRevenueRights(t *testing.T) {
+	licensorKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	issuer := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	agreement := validAgreement()
+	agreement.Licensor = crypto.PubkeyToAddress(licensorKey.PublicKey)
+	agreement.Normalize()
+
+	assignment := func(terms *Agreement, key *ecdsa.PrivateKey) []byte {
+		t.Helper()
+		sig, err := crypto.Sign(accounts.TextHash(AssignmentMessage(terms.Hash(), issuer)), key)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		sig[64] += 27 // Wallet-style v
+		return sig
+	}
+
+	altered := agreement
+	altered.RoyaltyBps = 500
+
+	tests := []struct {
+		name      string
+		issuer    common.Address
+		signature []byte
+		wantErr   error
+	}{
+		{"issuer is licensor", agreement.Licensor, nil, nil},
+		{"assigned by licensor", issuer, assignment(&agreement, licensorKey), nil},
+		{"no assignment", issuer, nil, ErrRightsNotHeld},
+		{"assigned by someone else", issuer, assignment(&agreement, otherKey), ErrRightsNotHeld},
+		{"assignment of other terms", issuer, assignment(&altered, licensorKey), ErrRightsNotHeld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyRevenueRights(&agreement, tt.issuer, tt.signature); !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyRevenueRights() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RetryAfterHeader is the response header carrying the seconds until writes resume
+const RetryAfterHeader = "retry-after"
+
+// UnaryServerInterceptor rejects write methods during a maintenance window
+// with UNAVAILABLE. The status carries a RetryInfo detail and the response a
+// retry-after header giving the time until the window ends. Reads are served
+// throughout.
+func UnaryServerInterceptor(s *Store, isWrite func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !isWrite(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if err := s.CheckWritable(ctx, time.Now()); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// CheckWritable returns the error for a write rejected by the window active
+// at now, or nil outside maintenance
+func (s *Store) CheckWritable(ctx context.Context, now time.Time) error {
+	window := s.Active(now)
+	if window == nil {
+		return nil
+	}
+
+	retryAfter := window.EndsAt.Sub(now).Round(time.Second)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	// Not every caller is a live server stream, e.g. in-process gateways
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(int(retryAfter.Seconds()))))
+
+	st := status.New(codes.Unavailable, fmt.Sprintf("writes are paused for maintenance until %s: %s",
+		window.EndsAt.UTC().Format(time.RFC3339), window.Reason))
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
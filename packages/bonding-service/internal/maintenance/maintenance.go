@@ -0,0 +1,181 @@
+// Package maintenance schedules write freezes. During a maintenance window
+// write RPCs are rejected with a retry delay while reads stay available, and
+// subscribers are notified ahead of each window so integrators can plan for it.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidWindow wraps validation failures for scheduled windows
+var ErrInvalidWindow = errors.New("invalid maintenance window")
+
+// Notice events
+const (
+	EventScheduled = "maintenance.scheduled"
+	EventCancelled = "maintenance.cancelled"
+)
+
+// Notice tells subscribers about a maintenance window
+type Notice struct {
+	Event  string
+	Window models.MaintenanceWindow
+}
+
+// Notifier delivers maintenance notices
+type Notifier interface {
+	Notify(ctx context.Context, notice Notice) error
+}
+
+// Store keeps maintenance windows in the database and serves the current and
+// upcoming ones from memory. Windows scheduled through another instance are
+// picked up by Start.
+type Store struct {
+	db             *gorm.DB
+	notifier       Notifier // Optional
+	announceBefore time.Duration
+	windows        atomic.Pointer[[]models.MaintenanceWindow]
+}
+
+// NewStore creates a store. Windows are announced through notifier, if set,
+// announceBefore they start.
+func NewStore(db *gorm.DB, notifier Notifier, announceBefore time.Duration) *Store {
+	s := &Store{db: db, notifier: notifier, announceBefore: announceBefore}
+	s.windows.Store(&[]models.MaintenanceWindow{})
+	return s
+}
+
+// Load reads the windows that haven't ended and announces those starting soon
+func (s *Store) Load(ctx context.Context) error {
+	var windows []models.MaintenanceWindow
+	if err := s.db.WithContext(ctx).
+		Where("ends_at > ?", time.Now()).
+		Order("starts_at ASC").
+		Find(&windows).Error; err != nil {
+		return fmt.Errorf("failed to load maintenance windows: %w", err)
+	}
+	s.windows.Store(&windows)
+
+	for i := range windows {
+		s.announce(ctx, &windows[i])
+	}
+	return nil
+}
+
+// Start reloads the windows periodically until the context is cancelled
+func (s *Store) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Failed to reload maintenance windows: %v", err)
+			}
+		}
+	}
+}
+
+// Windows returns the active and upcoming windows in start order
+func (s *Store) Windows() []models.MaintenanceWindow {
+	return *s.windows.Load()
+}
+
+// Active returns the window in effect at now, or nil. Of overlapping windows
+// the one ending last is returned, so its end is when writes resume.
+func (s *Store) Active(now time.Time) *models.MaintenanceWindow {
+	var active *models.MaintenanceWindow
+	windows := s.Windows()
+	for i := range windows {
+		w := &windows[i]
+		if w.StartsAt.After(now) || !w.EndsAt.After(now) {
+			continue
+		}
+		if active == nil || w.EndsAt.After(active.EndsAt) {
+			active = w
+		}
+	}
+	return active
+}
+
+// Schedule adds a maintenance window
+func (s *Store) Schedule(ctx context.Context, startsAt, endsAt time.Time, reason string) (*models.MaintenanceWindow, error) {
+	reason = strings.TrimSpace(reason)
+	switch {
+	case reason == "":
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidWindow)
+	case !endsAt.After(startsAt):
+		return nil, fmt.Errorf("%w: must end after it starts", ErrInvalidWindow)
+	case !endsAt.After(time.Now()):
+		return nil, fmt.Errorf("%w: already over", ErrInvalidWindow)
+	}
+
+	window := &models.MaintenanceWindow{StartsAt: startsAt, EndsAt: endsAt, Reason: reason}
+	if err := s.db.WithContext(ctx).Create(window).Error; err != nil {
+		return nil, fmt.Errorf("failed to save maintenance window: %w", err)
+	}
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// Cancel removes a window, notifying subscribers if it was announced
+func (s *Store) Cancel(ctx context.Context, id uint) error {
+	var window models.MaintenanceWindow
+	if err := s.db.WithContext(ctx).First(&window, id).Error; err != nil {
+		return fmt.Errorf("failed to load maintenance window %d: %w", id, err)
+	}
+	if err := s.db.WithContext(ctx).Delete(&window).Error; err != nil {
+		return fmt.Errorf("failed to cancel maintenance window: %w", err)
+	}
+
+	if window.AnnouncedAt != nil && s.notifier != nil {
+		if err := s.notifier.Notify(ctx, Notice{Event: EventCancelled, Window: window}); err != nil {
+			log.Printf("Failed to announce cancellation of maintenance window %d: %v", window.ID, err)
+		}
+	}
+	return s.Load(ctx)
+}
+
+// announce notifies subscribers of a window once it is within announceBefore
+// of starting. The window is claimed first so only one instance sends the
+// notice, and released again if sending fails so the next load retries.
+func (s *Store) announce(ctx context.Context, window *models.MaintenanceWindow) {
+	if s.notifier == nil || window.AnnouncedAt != nil || time.Until(window.StartsAt) > s.announceBefore {
+		return
+	}
+
+	now := time.Now()
+	result := s.db.WithContext(ctx).Model(&models.MaintenanceWindow{}).
+		Where("id = ? AND announced_at IS NULL", window.ID).
+		Update("announced_at", now)
+	if result.Error != nil {
+		log.Printf("Failed to claim announcement of maintenance window %d: %v", window.ID, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	if err := s.notifier.Notify(ctx, Notice{Event: EventScheduled, Window: *window}); err != nil {
+		log.Printf("Failed to announce maintenance window %d: %v", window.ID, err)
+		if err := s.db.WithContext(ctx).Model(&models.MaintenanceWindow{}).
+			Where("id = ?", window.ID).
+			Update("announced_at", nil).Error; err != nil {
+			log.Printf("Failed to release announcement of maintenance window %d: %v", window.ID, err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+type recordingNotifier struct {
+	notices []Notice
+	err     error
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, notice Notice) error {
+	n.notices = append(n.notices, notice)
+	return n.err
+}
+
+var windowColumns = []string{"id", "starts_at", "ends_at", "reason", "announced_at"}
+
+func TestInterceptor(t *testing.T) {
+	db, mock := newMockDB(t)
+	now := time.Now()
+	// Two overlapping windows; writes resume when the later one ends
+	mock.ExpectQuery(`SELECT \* FROM "maintenance_windows"`).WillReturnRows(sqlmock.NewRows(windowColumns).
+		AddRow(1, now.Add(-time.Hour), now.Add(10*time.Minute), "database upgrade", now.Add(-2*time.Hour)).
+		AddRow(2, now.Add(-time.Minute), now.Add(30*time.Minute), "contract upgrade", now.Add(-2*time.Hour)).
+		AddRow(3, now.Add(24*time.Hour), now.Add(25*time.Hour), "later", nil))
+
+	store := NewStore(db, nil, time.Hour)
+	if err := store.Load(context.Background()); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	isWrite := func(method string) bool { return method == "/bonding.BondingService/Invest" }
+	intercept := UnaryServerInterceptor(store, isWrite)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	if _, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/GetBondInfo"}, handler); err != nil {
+		t.Errorf("read during maintenance error = %v", err)
+	}
+
+	_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/Invest"}, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("write during maintenance code = %s, want Unavailable", st.Code())
+	}
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	if retry == nil {
+		t.Fatal("status has no RetryInfo detail")
+	}
+	if delay := retry.RetryDelay.AsDuration(); delay < 29*time.Minute || delay > 30*time.Minute {
+		t.Errorf("retry delay = %s, want the 30 minutes until the later window ends", delay)
+	}
+
+	if err := store.CheckWritable(context.Background(), now.Add(time.Hour)); err != nil {
+		t.Errorf("CheckWritable() after the windows = %v, want nil", err)
+	}
+}
+
+func TestAnnounce(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		startsIn  time.Duration
+		claimed   bool
+		notifyErr error
+		wantSent  bool
+	}{
+		{"too early", 48 * time.Hour, false, nil, false},
+		{"due", 12 * time.Hour, true, nil, true},
+		{"claimed by another instance", 12 * time.Hour, false, nil, false},
+		{"released when sending fails", 12 * time.Hour, true, errors.New("webhook down"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			mock.ExpectQuery(`SELECT \* FROM "maintenance_windows"`).WillReturnRows(sqlmock.NewRows(windowColumns).
+				AddRow(7, now.Add(tt.startsIn), now.Add(tt.startsIn+time.Hour), "contract upgrade", nil))
+			if tt.startsIn < 24*time.Hour {
+				affected := int64(0)
+				if tt.claimed {
+					affected = 1
+				}
+				mock.ExpectExec(`UPDATE "maintenance_windows" SET "announced_at"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND announced_at IS NULL\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 7).
+					WillReturnResult(sqlmock.NewResult(0, affected))
+			}
+			if tt.notifyErr != nil {
+				mock.ExpectExec(`UPDATE "maintenance_windows" SET "announced_at"=\$1,"updated_at"=\$2 WHERE id = \$3`).
+					WithArgs(nil, sqlmock.AnyArg(), 7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			notifier := &recordingNotifier{err: tt.notifyErr}
+			if err := NewStore(db, notifier, 24*time.Hour).Load(context.Background()); err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if sent := len(notifier.notices) == 1; sent != tt.wantSent {
+				t.Errorf("sent %d notices, want sent = %v", len(notifier.notices), tt.wantSent)
+			}
+			if tt.wantSent && notifier.notices[0].Event != EventScheduled {
+				t.Errorf("event = %s, want %s", notifier.notices[0].Event, EventScheduled)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestScheduleValidates(t *testing.T) {
+	store := NewStore(nil, nil, time.Hour)
+	now := time.Now()
+	tests := []struct {
+		name   string
+		start  time.Time
+		end    time.Time
+		reason string
+	}{
+		{"no reason", now, now.Add(time.Hour), " "},
+		{"ends before it starts", now.Add(time.Hour), now, "upgrade"},
+		{"already over", now.Add(-2 * time.Hour), now.Add(-time.Hour), "upgrade"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.Schedule(context.Background(), tt.start, tt.end, tt.reason)
+			if !errors.Is(err, ErrInvalidWindow) {
+				t.Errorf("Schedule() error = %v, want ErrInvalidWindow", err)
+			}
+		})
+	}
+}
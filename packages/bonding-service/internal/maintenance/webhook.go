@@ -0,0 +1,59 @@
+package maintenance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Webhook posts notices as JSON to a URL, e.g. a chat or status-page integration
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a webhook notifier
+func NewWebhook(url string) *Webhook {
+	return &Webhook{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// webhookPayload is the JSON body of a notice
+type webhookPayload struct {
+	Event    string `json:"event"`
+	ID       uint   `json:"id"`
+	StartsAt int64  `json:"starts_at"`
+	EndsAt   int64  `json:"ends_at"`
+	Reason   string `json:"reason"`
+}
+
+// Notify posts a notice, failing on any non-2xx response
+func (w *Webhook) Notify(ctx context.Context, notice Notice) error {
+	body, err := json.Marshal(webhookPayload{
+		Event:    notice.Event,
+		ID:       notice.Window.ID,
+		StartsAt: notice.Window.StartsAt.Unix(),
+		EndsAt:   notice.Window.EndsAt.Unix(),
+		Reason:   notice.Window.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post maintenance notice: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("maintenance webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Package market analyzes each IP category's market from recorded comparable
+// sales and the investment history of its bonds.
+package market
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/comparables"
+	"github.com/knowton/bonding-service/internal/consistency"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"github.com/knowton/bonding-service/internal/viewcache"
+	"gorm.io/gorm"
+)
+
+// Activity at which a category counts as fully liquid
+const (
+	liquidSalesPerMonth       = 10.0
+	liquidInvestmentsPerMonth = 20.0 // Per bond in the category
+)
+
+// Config controls the market analysis
+type Config struct {
+	Window      time.Duration // Sales and investments considered
+	TrendWindow time.Duration // Recent period compared with the one before it for the price trend
+	TTL         time.Duration // How long an analysis is served from the cache
+}
+
+// DefaultConfig returns default market analysis configuration
+func DefaultConfig() Config {
+	return Config{
+		Window:      365 * 24 * time.Hour,
+		TrendWindow: 90 * 24 * time.Hour,
+		TTL:         10 * time.Minute,
+	}
+}
+
+// Analysis describes a category's market
+type Analysis struct {
+	Category    string
+	AvgPrice    float64
+	MedianPrice float64
+	// PriceTrend is the relative change of the median price over the last
+	// TrendWindow against the one before it, 0 when either had no sales
+	PriceTrend float64
+	TotalSales int
+	// LiquidityScore from 0 to 1 weighs sales and investment activity equally
+	LiquidityScore float64
+	ComputedAt     time.Time
+}
+
+// Activity is the investment history of a category's bonds over the window
+type Activity struct {
+	Investments int
+	Bonds       int // Bonds invested in
+}
+
+// Analyzer computes market analyses and caches them for the TTL
+type Analyzer struct {
+	db     *gorm.DB
+	cache  viewcache.Backend
+	config Config
+	now    func() time.Time
+}
+
+// New creates an analyzer caching in backend
+func New(db *gorm.DB, backend viewcache.Backend, config Config) *Analyzer {
+	return &Analyzer{db: db, cache: backend, config: config, now: time.Now}
+}
+
+// Analyze returns the analysis of a category, from the cache when fresh
+func (a *Analyzer) Analyze(ctx context.Context, category string) (*Analysis, error) {
+	category = taxonomy.Normalize(category)
+	if analysis := a.cached(ctx, category); analysis != nil {
+		return analysis, nil
+	}
+
+	now := a.now()
+	since := now.Add(-a.config.Window)
+	var sales []models.ComparableSale
+	if err := a.db.WithContext(ctx).
+		Where("category = ? AND sold_at >= ?", category, since).
+		Order("sold_at ASC").
+		Find(&sales).Error; err != nil {
+		return nil, fmt.Errorf("failed to load comparable sales: %w", err)
+	}
+
+	var activity Activity
+	if err := a.db.WithContext(ctx).Table("investments").
+		Select("count(*) AS investments, count(DISTINCT investments.bond_id) AS bonds").
+		Joins("JOIN bonds ON bonds.bond_id = investments.bond_id AND bonds.deleted_at IS NULL").
+		Where("LOWER(bonds.category) = ? AND investments.timestamp >= ? AND investments.deleted_at IS NULL", category, since).
+		Scan(&activity).Error; err != nil {
+		return nil, fmt.Errorf("failed to load investment activity: %w", err)
+	}
+
+	analysis := Compute(category, sales, activity, now, a.config)
+	a.store(ctx, analysis)
+	return analysis, nil
+}
+
+// Invalidate drops the cached analysis of a category
+func (a *Analyzer) Invalidate(ctx context.Context, category string) {
+	if err := a.cache.Delete(ctx, cacheKey(taxonomy.Normalize(category))); err != nil {
+		log.Printf("Failed to invalidate market analysis of %s: %v", category, err)
+	}
+}
+
+// Compute analyzes a category's sales and investment activity over the
+// window ending at now
+func Compute(category string, sales []models.ComparableSale, activity Activity, now time.Time, config Config) *Analysis {
+	analysis := &Analysis{Category: category, TotalSales: len(sales), ComputedAt: now}
+
+	var recent, prior []models.ComparableSale
+	var total float64
+	for i := range sales {
+		total += sales[i].Price
+		switch age := now.Sub(sales[i].SoldAt); {
+		case age <= config.TrendWindow:
+			recent = append(recent, sales[i])
+		case age <= 2*config.TrendWindow:
+			prior = append(prior, sales[i])
+		}
+	}
+	if len(sales) > 0 {
+		analysis.AvgPrice = total / float64(len(sales))
+		analysis.MedianPrice = comparables.Median(sales)
+	}
+	if len(recent) > 0 && len(prior) > 0 {
+		before := comparables.Median(prior)
+		analysis.PriceTrend = (comparables.Median(recent) - before) / before
+	}
+
+	months := config.Window.Hours() / (24 * 30)
+	if months > 0 {
+		salesScore := math.Min(1, float64(len(sales))/months/liquidSalesPerMonth)
+		var investmentScore float64
+		if activity.Bonds > 0 {
+			investmentScore = math.Min(1, float64(activity.Investments)/float64(activity.Bonds)/months/liquidInvestmentsPerMonth)
+		}
+		analysis.LiquidityScore = (salesScore + investmentScore) / 2
+	}
+	return analysis
+}
+
+// cached returns the cached analysis of a category, or nil when there is
+// none younger than the TTL or the read presented a consistency token
+func (a *Analyzer) cached(ctx context.Context, category string) *Analysis {
+	if _, ok := consistency.FromContext(ctx); ok {
+		return nil
+	}
+	value, ok, err := a.cache.Get(ctx, cacheKey(category), cacheField)
+	if err != nil {
+		log.Printf("Failed to read cached market analysis of %s: %v", category, err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	var analysis Analysis
+	if err := json.Unmarshal(value, &analysis); err != nil || a.now().Sub(analysis.ComputedAt) > a.config.TTL {
+		return nil
+	}
+	return &analysis
+}
+
+func (a *Analyzer) store(ctx context.Context, analysis *Analysis) {
+	value, err := json.Marshal(analysis)
+	if err == nil {
+		err = a.cache.Set(ctx, cacheKey(analysis.Category), cacheField, value, a.config.TTL)
+	}
+	if err != nil {
+		log.Printf("Failed to cache market analysis of %s: %v", analysis.Category, err)
+	}
+}
+
+const cacheField = "analysis"
+
+func cacheKey(category string) string {
+	return "market:" + category
+}
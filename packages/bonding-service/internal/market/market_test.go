@@ -0,0 +1,79 @@
+package market
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/viewcache"
+)
+
+func TestCompute(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	config := DefaultConfig()
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	sales := []models.ComparableSale{
+		{Price: 800, SoldAt: daysAgo(300)},
+		{Price: 1000, SoldAt: daysAgo(150)},
+		{Price: 1000, SoldAt: daysAgo(120)},
+		{Price: 1200, SoldAt: daysAgo(60)},
+		{Price: 1500, SoldAt: daysAgo(10)},
+	}
+	// One investment per bond per month is 1/20 of full investment liquidity
+	activity := Activity{Investments: 24, Bonds: 2}
+
+	got := Compute("music", sales, activity, now, config)
+	months := 365.0 / 30
+	want := &Analysis{
+		Category:       "music",
+		AvgPrice:       1100,
+		MedianPrice:    1000,
+		PriceTrend:     0.35, // Median 1350 over the last 90 days against 1000 the 90 before
+		TotalSales:     5,
+		LiquidityScore: (5/months/liquidSalesPerMonth + 12/months/liquidInvestmentsPerMonth) / 2,
+		ComputedAt:     now,
+	}
+	if got.Category != want.Category || got.TotalSales != want.TotalSales || !got.ComputedAt.Equal(want.ComputedAt) {
+		t.Errorf("Compute() = %+v, want %+v", got, want)
+	}
+	for _, f := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"AvgPrice", got.AvgPrice, want.AvgPrice},
+		{"MedianPrice", got.MedianPrice, want.MedianPrice},
+		{"PriceTrend", got.PriceTrend, want.PriceTrend},
+		{"LiquidityScore", got.LiquidityScore, want.LiquidityScore},
+	} {
+		if math.Abs(f.got-f.want) > 1e-9 {
+			t.Errorf("%s = %v, want %v", f.name, f.got, f.want)
+		}
+	}
+
+	if empty := Compute("music", nil, Activity{}, now, config); empty.AvgPrice != 0 || empty.PriceTrend != 0 || empty.LiquidityScore != 0 {
+		t.Errorf("Compute() without activity = %+v, want zeros", empty)
+	}
+}
+
+func TestAnalyzeServesFreshCache(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	// No database: a fresh cached analysis must be served without a query
+	a := New(nil, viewcache.NewMemoryBackend(), DefaultConfig())
+	a.now = func() time.Time { return now }
+	a.store(context.Background(), &Analysis{Category: "music", MedianPrice: 42, ComputedAt: now.Add(-time.Minute)})
+
+	got, err := a.Analyze(context.Background(), " Music ")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if got.MedianPrice != 42 {
+		t.Errorf("Analyze() = %+v, want the cached analysis", got)
+	}
+
+	a.now = func() time.Time { return now.Add(DefaultConfig().TTL) }
+	if a.cached(context.Background(), "music") != nil {
+		t.Error("cached() served an analysis older than the TTL")
+	}
+}
@@ -0,0 +1,378 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "bonding"
+
+// Chain watcher metrics
+var (
+	ChainHeadBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_head_block",
+		Help:      "Latest block number reported by the RPC node",
+	}, []string{"chain"})
+
+	ChainFinalizedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_finalized_block",
+		Help:      "Latest finalized block number reported by the RPC node",
+	}, []string{"chain"})
+
+	ChainHeadAgeSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_head_age_seconds",
+		Help:      "Seconds since the head block's timestamp",
+	}, []string{"chain"})
+
+	ChainIndexerLagBlocks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_indexer_lag_blocks",
+		Help:      "Blocks between the chain head and the last indexed block",
+	}, []string{"chain"})
+
+	ChainWritesPaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_writes_paused",
+		Help:      "1 when write operations are paused for the chain",
+	}, []string{"chain"})
+)
+
+// RPC pool metrics
+var (
+	RPCEndpointHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rpc_endpoint_healthy",
+		Help:      "1 when the RPC endpoint passed its last health check and is not lagging",
+	}, []string{"chain", "endpoint"})
+
+	RPCEndpointBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rpc_endpoint_block",
+		Help:      "Latest block number reported by the RPC endpoint",
+	}, []string{"chain", "endpoint"})
+
+	RPCFailovers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_failovers_total",
+		Help:      "Requests retried on another RPC endpoint after a provider error",
+	}, []string{"chain", "endpoint"})
+)
+
+// Event indexer metrics
+var (
+	IndexerReorgs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "indexer_reorgs_total",
+		Help:      "Chain reorganizations detected by the event indexer",
+	}, []string{"chain"})
+
+	IndexerRolledBackEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "indexer_rolled_back_events_total",
+		Help:      "Indexed events orphaned by a reorg and reverted",
+	}, []string{"chain"})
+)
+
+// Websocket subscription metrics
+var (
+	SubscriptionConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "subscription_connected",
+		Help:      "Whether the eth_subscribe connection to a chain is live (1) or not (0)",
+	}, []string{"chain"})
+
+	SubscriptionReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "subscription_reconnects_total",
+		Help:      "Websocket subscriptions lost and re-established",
+	}, []string{"chain"})
+)
+
+// Transaction monitor metrics
+var (
+	PendingTransactions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "pending_transactions",
+		Help:      "Submitted transactions not yet mined, by status",
+	}, []string{"chain", "status"})
+
+	StuckTransactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stuck_transactions_total",
+		Help:      "Transactions that stayed pending past the stuck threshold",
+	}, []string{"chain"})
+
+	DroppedTransactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dropped_transactions_total",
+		Help:      "Transactions dropped from the mempool without being mined",
+	}, []string{"chain"})
+)
+
+// Reconciliation metrics
+var (
+	ReconciliationDiscrepancies = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "reconciliation_discrepancies",
+		Help:      "Stored values that differed from the chain in the last reconciliation run",
+	}, []string{"chain", "field"})
+
+	ReconciliationCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconciliation_corrections_total",
+		Help:      "Derived fields overwritten with the on-chain value",
+	}, []string{"chain", "field"})
+
+	ReconciliationLastRun = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "reconciliation_last_run_timestamp_seconds",
+		Help:      "Unix time the last reconciliation run finished",
+	})
+)
+
+// Revenue forecast metrics
+var (
+	RatingReviews = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rating_reviews_total",
+		Help:      "Rating reviews triggered by revenue missing or beating its forecast",
+	}, []string{"chain", "direction"})
+)
+
+// Risk reassessment metrics
+var (
+	RatingDowngrades = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rating_downgrade_alerts_total",
+		Help:      "Reassessments that downgraded a bond by more than the alert threshold",
+	}, []string{"chain"})
+)
+
+// Business rule metrics
+var (
+	RuleViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "business_rule_violations_total",
+		Help:      "Operations rejected by a configured business rule",
+	}, []string{"point", "rule"})
+)
+
+// Event consumer metrics
+var (
+	ConsumedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "consumed_events_total",
+		Help:      "Events processed by consumers, by outcome: handled, duplicate or dead_lettered",
+	}, []string{"consumer", "outcome"})
+)
+
+// Archive metrics
+var (
+	ArchivedBonds = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "archived_bonds_total",
+		Help:      "Closed bonds whose detail rows were moved to the archive tables",
+	})
+)
+
+// API usage metrics
+var (
+	APIQuotaRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_quota_rejections_total",
+		Help:      "Calls rejected because the caller's API key used its monthly quota",
+	}, []string{"tenant"})
+)
+
+// Oracle metrics
+var (
+	OracleAnswers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "oracle_answers_total",
+		Help:      "Valuation answers from oracle providers, by outcome: ok, error or outlier",
+	}, []string{"provider", "outcome"})
+	OracleCircuitOpen = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "oracle_circuit_open",
+		Help:      "Whether calls to an oracle are failing fast after repeated failures (1) or not (0)",
+	}, []string{"provider"})
+	OracleSpendLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "oracle_spend_limited_total",
+		Help:      "Tenants passing their daily oracle soft limit, or calls rejected at the hard limit, by limit",
+	}, []string{"tenant", "limit"})
+	OracleSchemaVersions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "oracle_schema_versions_total",
+		Help:      "Oracle responses by endpoint and the schema version they were read as",
+	}, []string{"endpoint", "version"})
+)
+
+// Distribution metrics
+var (
+	DuplicateDistributions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "duplicate_distributions_total",
+		Help:      "Distributions refused as equivalent to one already executed, by where it was found: ledger or chain",
+	}, []string{"source"})
+)
+
+// Issuance metrics
+var (
+	DuplicateContent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "duplicate_content_total",
+		Help:      "Issuances whose content fingerprint already backs another bond, by outcome: rejected or flagged",
+	}, []string{"outcome"})
+)
+
+// Chain policy metrics
+var (
+	ChainPolicyViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chain_policy_violations_total",
+		Help:      "Writes refused because the chain policy doesn't allow the chain, contract or RPC endpoint",
+	}, []string{"chain"})
+)
+
+// Hook metrics
+var (
+	HookCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "hook_calls_total",
+		Help:      "Calls to deployment hooks, by outcome: allowed, rejected or failed",
+	}, []string{"hook", "point", "outcome"})
+)
+
+// Investor access metrics
+var (
+	KYCRefusals = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kyc_refusals_total",
+		Help:      "Investments and transfers refused because the wallet isn't KYC verified",
+	})
+	EligibilityRefusals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "eligibility_refusals_total",
+		Help:      "Investments and transfers refused by tranche eligibility rules, by tranche class",
+	}, []string{"tranche"})
+	AccessListRefusals = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "access_list_refusals_total",
+		Help:      "Write calls refused because an address is denied or not allowed",
+	})
+)
+
+// Authorization metrics
+var (
+	AuthorizationDenials = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "authorization_denials_total",
+		Help:      "Calls refused because the caller lacks a permitted role, by method",
+	}, []string{"method"})
+)
+
+// Audit metrics
+var (
+	AuditFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "audit_failures_total",
+		Help:      "Audited calls whose audit entry could not be written",
+	})
+)
+
+// Validation metrics
+var (
+	InvalidRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "invalid_requests_total",
+		Help:      "Calls refused because a field breaks its constraints, by method",
+	}, []string{"method"})
+)
+
+// Operator wallet metrics
+var (
+	OperatorWalletBalance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "operator_wallet_balance_ether",
+		Help:      "Balance of the operator wallet that pays gas on each chain",
+	}, []string{"chain"})
+
+	OperatorWalletLow = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "operator_wallet_low",
+		Help:      "Whether the operator wallet is below its minimum balance (1) or not (0)",
+	}, []string{"chain"})
+
+	WalletTopUps = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "wallet_top_ups_total",
+		Help:      "Operator wallet top-ups proposed to the treasury, by outcome: proposed or failed",
+	}, []string{"chain", "outcome"})
+)
+
+// Commitment metrics
+var (
+	CommitmentsPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "commitments_published_total",
+		Help:      "Bond state roots sent on-chain, by outcome: published or failed",
+	}, []string{"outcome"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		ChainHeadBlock,
+		ChainFinalizedBlock,
+		ChainHeadAgeSeconds,
+		ChainIndexerLagBlocks,
+		ChainWritesPaused,
+		RPCEndpointHealthy,
+		RPCEndpointBlock,
+		RPCFailovers,
+		IndexerReorgs,
+		IndexerRolledBackEvents,
+		SubscriptionConnected,
+		SubscriptionReconnects,
+		PendingTransactions,
+		StuckTransactions,
+		DroppedTransactions,
+		ReconciliationDiscrepancies,
+		ReconciliationCorrections,
+		ReconciliationLastRun,
+		RatingReviews,
+		RatingDowngrades,
+		RuleViolations,
+		ConsumedEvents,
+		ArchivedBonds,
+		APIQuotaRejections,
+		OracleAnswers,
+		OracleCircuitOpen,
+		OracleSpendLimited,
+		OracleSchemaVersions,
+		DuplicateDistributions,
+		DuplicateContent,
+		ChainPolicyViolations,
+		HookCalls,
+		KYCRefusals,
+		EligibilityRefusals,
+		AccessListRefusals,
+		AuthorizationDenials,
+		AuditFailures,
+		InvalidRequests,
+		OperatorWalletBalance,
+		OperatorWalletLow,
+		WalletTopUps,
+		CommitmentsPublished,
+	)
+}
+
+// Handler returns the HTTP handler that serves metrics in Prometheus format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
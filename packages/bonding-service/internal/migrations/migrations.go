@@ -0,0 +1,158 @@
+// Package migrations versions the service's database schema. Migrations are
+// numbered SQL files embedded in the binaries: knowtonctl migrate applies and
+// reverts them, and the server only checks at startup that the schema is at
+// the version it was built for, so schema changes never run under load.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"gorm.io/gorm"
+)
+
+// Table records the applied version in the connection's current schema
+const Table = "schema_migrations"
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// ErrVersionMismatch is returned by Verify when the schema isn't at the
+// version this build expects
+var ErrVersionMismatch = errors.New("database schema version mismatch")
+
+// Latest returns the version of the newest embedded migration
+func Latest() (uint, error) {
+	source, err := iofs.New(files, "sql")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	defer source.Close()
+
+	version, err := source.First()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	for {
+		next, err := source.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read migrations: %w", err)
+		}
+		version = next
+	}
+}
+
+// Current returns the schema's applied version, 0 if no migration has run,
+// and whether the last migration failed partway
+func Current(db *gorm.DB) (version uint, dirty bool, err error) {
+	if !db.Migrator().HasTable(Table) {
+		return 0, false, nil
+	}
+	row := db.Raw("SELECT version, dirty FROM " + Table + " LIMIT 1").Row()
+	var v int64
+	if err := row.Scan(&v, &dirty); errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return uint(v), dirty, nil
+}
+
+// Verify checks that the schema is at the latest embedded version and that
+// no migration failed partway
+func Verify(db *gorm.DB) error {
+	latest, err := Latest()
+	if err != nil {
+		return err
+	}
+	version, dirty, err := Current(db)
+	if err != nil {
+		return err
+	}
+	switch {
+	case dirty:
+		return fmt.Errorf("%w: migration %d failed partway; fix the schema and run knowtonctl migrate force", ErrVersionMismatch, version)
+	case version < latest:
+		return fmt.Errorf("%w: schema is at version %d, this build needs %d; run knowtonctl migrate up", ErrVersionMismatch, version, latest)
+	case version > latest:
+		return fmt.Errorf("%w: schema is at version %d, newer than this build's %d", ErrVersionMismatch, version, latest)
+	}
+	return nil
+}
+
+// Migrator applies and reverts migrations over a dedicated connection
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// New creates a migrator for the database, taking ownership of it: Close
+// closes the connection
+func New(db *sql.DB) (*Migrator, error) {
+	source, err := iofs.New(files, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	driver, err := pgx.WithInstance(db, &pgx.Config{MigrationsTable: Table})
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare database for migrations: %w", err)
+	}
+	m, err := migrate.NewWithInstance("iofs", source, "pgx", driver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+	return &Migrator{m: m}, nil
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up() error {
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down reverts the last steps migrations
+func (m *Migrator) Down(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("steps must be at least 1, got %d", steps)
+	}
+	return m.m.Steps(-steps)
+}
+
+// Goto migrates up or down to a version
+func (m *Migrator) Goto(version uint) error {
+	if err := m.m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Force records a version as applied and clean without running anything,
+// after a failed migration has been repaired by hand
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Version returns the applied version, 0 if none, and whether it is dirty
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Close releases the database connection
+func (m *Migrator) Close() error {
+	sourceErr, dbErr := m.m.Close()
+	return errors.Join(sourceErr, dbErr)
+}
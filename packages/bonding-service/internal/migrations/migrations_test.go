@@ -0,0 +1,169 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// TestMigrationsArePaired checks that versions run from 1 without gaps and
+// that every migration can be reverted
+func TestMigrationsArePaired(t *testing.T) {
+	names, err := fs.Glob(files, "sql/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	present := make(map[string]bool, len(names))
+	for _, name := range names {
+		present[name] = true
+	}
+
+	latest, err := Latest()
+	if err != nil {
+		t.Fatalf("Latest() error = %v", err)
+	}
+	if len(names) != 2*int(latest) {
+		t.Errorf("%d migration files for %d versions, want an up and a down file for each", len(names), latest)
+	}
+	for v := uint(1); v <= latest; v++ {
+		ups, _ := fs.Glob(files, fmt.Sprintf("sql/%06d_*.up.sql", v))
+		if len(ups) != 1 {
+			t.Errorf("version %d has %d up migrations, want 1", v, len(ups))
+			continue
+		}
+		if down := strings.TrimSuffix(ups[0], ".up.sql") + ".down.sql"; !present[down] {
+			t.Errorf("%s has no %s", ups[0], down)
+		}
+	}
+}
+
+// TestSchemaCoversModels checks that every model column is created by a
+// migration, so a field added to a model without one fails here rather
+// than in production
+func TestSchemaCoversModels(t *testing.T) {
+	var up strings.Builder
+	names, _ := fs.Glob(files, "sql/*.up.sql")
+	for _, name := range names {
+		contents, err := fs.ReadFile(files, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		up.Write(contents)
+	}
+	sql := up.String()
+
+	for _, model := range []interface{}{
+		&models.Bond{}, &models.Tranche{}, &models.Investment{}, &models.RevenueDistribution{},
+		&models.TrancheDistribution{}, &models.Redemption{}, &models.QueuedDistribution{},
+		&models.InvestmentTransfer{}, &models.Order{}, &models.Trade{}, &models.AddressBookEntry{},
+		&models.LedgerEntry{}, &models.LedgerLine{}, &models.Document{}, &models.RiskAssessment{},
+		&models.ChainEvent{}, &models.IndexedBlock{}, &models.Category{}, &models.TransactionRecord{},
+		&models.LicenseAgreement{}, &models.LicenseePayment{}, &models.RevenueForecast{},
+		&models.ProcessedEvent{}, &models.ConsumerOffset{}, &models.DeadLetter{}, &models.APIUsage{},
+		&models.MaintenanceWindow{}, &models.OracleValuation{}, &models.OracleAnswer{},
+		&models.BondEvent{}, &models.OracleSpend{}, &models.ComparableSale{}, &models.InvestorProfile{},
+		&models.BondCommitment{}, &models.CommitmentLeaf{}, &models.AccessListEntry{},
+		&models.Notification{}, &models.EmergencyWithdrawal{}, &models.FeatureFlag{}, &models.AuditLog{},
+		&models.DistributionSchedule{},
+		&models.RevenueEvent{},
+		&models.AccrualSnapshot{},
+		&models.ScheduledPayment{},
+	} {
+		s, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatalf("schema.Parse(%T) error = %v", model, err)
+		}
+		create := regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS ` + s.Table + ` \((.*?)\n\);`).FindStringSubmatch(sql)
+		if create == nil {
+			t.Errorf("no migration creates %s", s.Table)
+			continue
+		}
+		for _, column := range s.DBNames {
+			added := regexp.MustCompile(`ALTER TABLE ` + s.Table + ` ADD COLUMN (IF NOT EXISTS )?` + column + ` `)
+			if !regexp.MustCompile(`\n  `+column+` `).MatchString(create[1]) && !added.MatchString(sql) {
+				t.Errorf("no migration adds %s.%s", s.Table, column)
+			}
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	latest, err := Latest()
+	if err != nil {
+		t.Fatalf("Latest() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		table   bool
+		version uint
+		dirty   bool
+		want    string // Empty when the schema is current
+	}{
+		{"never migrated", false, 0, false, "run knowtonctl migrate up"},
+		{"current", true, latest, false, ""},
+		{"behind", true, latest - 1, false, "run knowtonctl migrate up"},
+		{"ahead", true, latest + 1, false, "newer than this build"},
+		{"failed partway", true, latest, true, "migrate force"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			count := 0
+			if tt.table {
+				count = 1
+			}
+			mock.ExpectQuery(`SELECT count\(\*\) FROM information_schema.tables`).
+				WithArgs(Table, "BASE TABLE").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+			if tt.table {
+				rows := sqlmock.NewRows([]string{"version", "dirty"})
+				if tt.version > 0 {
+					rows.AddRow(tt.version, tt.dirty)
+				}
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(rows)
+			}
+
+			err := Verify(db)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Verify() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrVersionMismatch) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Verify() error = %v, want a mismatch mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+-- Drops the whole schema, archives and audit log included
+DROP TABLE IF EXISTS archived_chain_events;
+DROP TABLE IF EXISTS archived_tranche_distributions;
+DROP TABLE IF EXISTS archived_revenue_distributions;
+DROP TABLE IF EXISTS archived_investments;
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS feature_flags;
+DROP TABLE IF EXISTS emergency_withdrawals;
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS access_list_entries;
+DROP TABLE IF EXISTS commitment_leaves;
+DROP TABLE IF EXISTS bond_commitments;
+DROP TABLE IF EXISTS investor_profiles;
+DROP TABLE IF EXISTS comparable_sales;
+DROP TABLE IF EXISTS oracle_spends;
+DROP TABLE IF EXISTS bond_events;
+DROP TABLE IF EXISTS oracle_answers;
+DROP TABLE IF EXISTS oracle_valuations;
+DROP TABLE IF EXISTS maintenance_windows;
+DROP TABLE IF EXISTS api_usages;
+DROP TABLE IF EXISTS dead_letters;
+DROP TABLE IF EXISTS consumer_offsets;
+DROP TABLE IF EXISTS processed_events;
+DROP TABLE IF EXISTS revenue_forecasts;
+DROP TABLE IF EXISTS licensee_payments;
+DROP TABLE IF EXISTS license_agreements;
+DROP TABLE IF EXISTS transaction_records;
+DROP TABLE IF EXISTS categories;
+DROP TABLE IF EXISTS indexed_blocks;
+DROP TABLE IF EXISTS chain_events;
+DROP TABLE IF EXISTS risk_assessments;
+DROP TABLE IF EXISTS documents;
+DROP TABLE IF EXISTS ledger_lines;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS address_book_entries;
+DROP TABLE IF EXISTS trades;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS investment_transfers;
+DROP TABLE IF EXISTS queued_distributions;
+DROP TABLE IF EXISTS redemptions;
+DROP TABLE IF EXISTS tranche_distributions;
+DROP TABLE IF EXISTS revenue_distributions;
+DROP TABLE IF EXISTS investments;
+DROP TABLE IF EXISTS tranches;
+DROP TABLE IF EXISTS bonds;
+DROP FUNCTION IF EXISTS audit_logs_append_only();
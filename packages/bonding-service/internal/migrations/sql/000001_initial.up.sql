@@ -0,0 +1,745 @@
+-- The schema as AutoMigrate last created it. Every statement is idempotent,
+-- so a database AutoMigrate set up adopts versioned migrations by applying
+-- this one.
+
+CREATE TABLE IF NOT EXISTS bonds (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tenant_id text NOT NULL DEFAULT 'default',
+  chain text NOT NULL DEFAULT 'arbitrum',
+  ip_nft_id text NOT NULL,
+  nft_contract text NOT NULL,
+  issuer text NOT NULL,
+  total_value text NOT NULL,
+  maturity_date timestamptz NOT NULL,
+  status text NOT NULL DEFAULT 'ACTIVE',
+  total_revenue text DEFAULT '0',
+  tx_hash text NOT NULL,
+  registration_kind text,
+  registration_number text,
+  registration_jurisdiction text,
+  registration_expires_at timestamptz,
+  registration_verified boolean,
+  license_expires_at timestamptz,
+  category text,
+  content_fingerprint text,
+  rating_review_at timestamptz,
+  rating_review_reason text,
+  status_reason text,
+  status_changed_at timestamptz,
+  archived_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_bonds_content_fingerprint ON bonds (content_fingerprint);
+CREATE INDEX IF NOT EXISTS idx_bonds_chain ON bonds (chain);
+CREATE INDEX IF NOT EXISTS idx_bonds_tenant_id ON bonds (tenant_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bonds_bond_id ON bonds (bond_id);
+CREATE INDEX IF NOT EXISTS idx_bonds_deleted_at ON bonds (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tranches (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  name text NOT NULL,
+  priority bigint NOT NULL,
+  allocation text NOT NULL,
+  apy decimal NOT NULL,
+  risk_level text NOT NULL,
+  total_invested text DEFAULT '0',
+  arrears text DEFAULT '0',
+  min_investment text DEFAULT '0',
+  max_investment text DEFAULT '0',
+  PRIMARY KEY (id),
+  CONSTRAINT fk_bonds_tranches FOREIGN KEY (bond_id) REFERENCES bonds(bond_id)
+);
+CREATE INDEX IF NOT EXISTS idx_tranches_deleted_at ON tranches (deleted_at);
+-- Investments reference tranches by bond and tranche ID
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tranches_bond_tranche ON tranches (bond_id, tranche_id);
+
+CREATE TABLE IF NOT EXISTS investments (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text NOT NULL,
+  amount text NOT NULL,
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_tranches_investments FOREIGN KEY (bond_id,tranche_id) REFERENCES tranches(bond_id,tranche_id)
+);
+CREATE INDEX IF NOT EXISTS idx_investments_deleted_at ON investments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS revenue_distributions (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  amount text NOT NULL,
+  shortfall text DEFAULT '0',
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  period timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_revenue_distributions_period ON revenue_distributions (period);
+CREATE INDEX IF NOT EXISTS idx_revenue_distributions_deleted_at ON revenue_distributions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tranche_distributions (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  distribution_id bigint NOT NULL,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  coupon_due text NOT NULL,
+  arrears_paid text DEFAULT '0',
+  coupon_paid text DEFAULT '0',
+  residual text DEFAULT '0',
+  shortfall text DEFAULT '0',
+  arrears_after text DEFAULT '0',
+  PRIMARY KEY (id),
+  CONSTRAINT fk_revenue_distributions_tranches FOREIGN KEY (distribution_id) REFERENCES revenue_distributions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_tranche_distributions_bond_id ON tranche_distributions (bond_id);
+CREATE INDEX IF NOT EXISTS idx_tranche_distributions_distribution_id ON tranche_distributions (distribution_id);
+CREATE INDEX IF NOT EXISTS idx_tranche_distributions_deleted_at ON tranche_distributions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS redemptions (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text NOT NULL,
+  amount text NOT NULL,
+  penalty text NOT NULL,
+  payout text NOT NULL,
+  status text NOT NULL,
+  approver text,
+  reason text,
+  tx_hash text,
+  requested_at timestamptz NOT NULL,
+  resolved_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_redemptions_investor ON redemptions (investor);
+CREATE INDEX IF NOT EXISTS idx_redemptions_bond_id ON redemptions (bond_id);
+CREATE INDEX IF NOT EXISTS idx_redemptions_deleted_at ON redemptions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS queued_distributions (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  amount text NOT NULL,
+  due_at timestamptz NOT NULL,
+  status text NOT NULL DEFAULT 'QUEUED',
+  attempts bigint DEFAULT 0,
+  last_error text,
+  tx_hash text,
+  processed_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_queued_distributions_status ON queued_distributions (status);
+CREATE INDEX IF NOT EXISTS idx_queued_distributions_due_at ON queued_distributions (due_at);
+CREATE INDEX IF NOT EXISTS idx_queued_distributions_bond_id ON queued_distributions (bond_id);
+CREATE INDEX IF NOT EXISTS idx_queued_distributions_deleted_at ON queued_distributions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS investment_transfers (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  from_address text NOT NULL,
+  to_address text NOT NULL,
+  amount text NOT NULL,
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_investment_transfers_to_address ON investment_transfers (to_address);
+CREATE INDEX IF NOT EXISTS idx_investment_transfers_from_address ON investment_transfers (from_address);
+CREATE INDEX IF NOT EXISTS idx_investment_transfers_bond_id ON investment_transfers (bond_id);
+CREATE INDEX IF NOT EXISTS idx_investment_transfers_deleted_at ON investment_transfers (deleted_at);
+
+CREATE TABLE IF NOT EXISTS orders (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  seller text NOT NULL,
+  amount text NOT NULL,
+  remaining text NOT NULL,
+  price_bps bigint NOT NULL,
+  status text NOT NULL DEFAULT 'OPEN',
+  expires_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders (status);
+CREATE INDEX IF NOT EXISTS idx_orders_seller ON orders (seller);
+CREATE INDEX IF NOT EXISTS idx_order_book ON orders (bond_id,tranche_id);
+CREATE INDEX IF NOT EXISTS idx_orders_deleted_at ON orders (deleted_at);
+
+CREATE TABLE IF NOT EXISTS trades (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  order_id bigint NOT NULL,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  seller text NOT NULL,
+  buyer text NOT NULL,
+  amount text NOT NULL,
+  price_bps bigint NOT NULL,
+  value text NOT NULL,
+  transfer_id bigint NOT NULL,
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_trades_timestamp ON trades (timestamp);
+CREATE INDEX IF NOT EXISTS idx_trade_tranche ON trades (bond_id,tranche_id);
+CREATE INDEX IF NOT EXISTS idx_trades_order_id ON trades (order_id);
+CREATE INDEX IF NOT EXISTS idx_trades_deleted_at ON trades (deleted_at);
+
+CREATE TABLE IF NOT EXISTS address_book_entries (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL,
+  address text NOT NULL,
+  label text NOT NULL,
+  role text NOT NULL DEFAULT 'OTHER',
+  verification_status text NOT NULL DEFAULT 'UNVERIFIED',
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenant_address ON address_book_entries (tenant_id,address);
+CREATE INDEX IF NOT EXISTS idx_address_book_entries_deleted_at ON address_book_entries (deleted_at);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL,
+  bond_id text NOT NULL,
+  kind text NOT NULL,
+  reference text NOT NULL,
+  memo text,
+  posted_at timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_bond_id ON ledger_entries (bond_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_period ON ledger_entries (tenant_id,posted_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_deleted_at ON ledger_entries (deleted_at);
+
+CREATE TABLE IF NOT EXISTS ledger_lines (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  entry_id bigint NOT NULL,
+  account text NOT NULL,
+  debit text DEFAULT '0',
+  credit text DEFAULT '0',
+  PRIMARY KEY (id),
+  CONSTRAINT fk_ledger_entries_lines FOREIGN KEY (entry_id) REFERENCES ledger_entries(id)
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_lines_entry_id ON ledger_lines (entry_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_lines_deleted_at ON ledger_lines (deleted_at);
+
+CREATE TABLE IF NOT EXISTS documents (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL,
+  kind text NOT NULL,
+  name text NOT NULL,
+  backend text NOT NULL,
+  object_key text NOT NULL,
+  content_type text NOT NULL,
+  size bigint NOT NULL,
+  sha256 text NOT NULL,
+  expires_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_documents_expires_at ON documents (expires_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_documents_object_key ON documents (object_key);
+CREATE INDEX IF NOT EXISTS idx_documents_kind ON documents (kind);
+CREATE INDEX IF NOT EXISTS idx_documents_tenant_id ON documents (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_documents_deleted_at ON documents (deleted_at);
+
+CREATE TABLE IF NOT EXISTS risk_assessments (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  ip_nft_id text NOT NULL,
+  valuation_usd decimal NOT NULL,
+  confidence_score decimal NOT NULL,
+  risk_rating text NOT NULL,
+  default_probability decimal NOT NULL,
+  recommended_ltv decimal NOT NULL,
+  risk_factors text,
+  risk_model text,
+  risk_model_version text,
+  assessed_at timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_risk_assessments_assessed_at ON risk_assessments (assessed_at);
+CREATE INDEX IF NOT EXISTS idx_risk_assessment_history ON risk_assessments (ip_nft_id,assessed_at);
+CREATE INDEX IF NOT EXISTS idx_risk_assessments_deleted_at ON risk_assessments (deleted_at);
+-- Assessments were once unique per IP-NFT; the history needs that gone
+DROP INDEX IF EXISTS idx_risk_assessments_ip_nft_id;
+
+CREATE TABLE IF NOT EXISTS chain_events (
+  id bigserial,
+  chain text NOT NULL,
+  tx_hash text NOT NULL,
+  position bigint NOT NULL,
+  block_number bigint NOT NULL,
+  block_hash text NOT NULL,
+  event text NOT NULL,
+  bond_id text NOT NULL,
+  tranche_id bigint,
+  account text,
+  sender text,
+  amount text DEFAULT '0',
+  removed boolean NOT NULL DEFAULT false,
+  created_at timestamptz,
+  updated_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_chain_events_bond_id ON chain_events (bond_id);
+CREATE INDEX IF NOT EXISTS idx_chain_events_block_number ON chain_events (block_number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chain_event ON chain_events (chain,tx_hash,position);
+
+CREATE TABLE IF NOT EXISTS indexed_blocks (
+  chain text,
+  number bigint,
+  hash text NOT NULL,
+  PRIMARY KEY (chain,number)
+);
+
+CREATE TABLE IF NOT EXISTS categories (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  slug text NOT NULL,
+  parent text,
+  name text NOT NULL,
+  multiplier decimal,
+  obsolescence_risk boolean,
+  aliases text,
+  expiry_policy text,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories (parent);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_slug ON categories (slug);
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON categories (deleted_at);
+
+CREATE TABLE IF NOT EXISTS transaction_records (
+  id bigserial,
+  tx_hash text NOT NULL,
+  chain text NOT NULL,
+  nonce bigint,
+  purpose text NOT NULL,
+  bond_id text,
+  status text NOT NULL,
+  replaced_by text,
+  submitted_at timestamptz NOT NULL,
+  checked_at timestamptz,
+  created_at timestamptz,
+  updated_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_transaction_records_status ON transaction_records (status);
+CREATE INDEX IF NOT EXISTS idx_transaction_records_bond_id ON transaction_records (bond_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transaction_records_tx_hash ON transaction_records (tx_hash);
+
+CREATE TABLE IF NOT EXISTS license_agreements (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  licensor text NOT NULL,
+  licensee text NOT NULL,
+  royalty_bps bigint NOT NULL,
+  starts_at timestamptz NOT NULL,
+  ends_at timestamptz,
+  territories text NOT NULL,
+  exclusive boolean,
+  document_hash text,
+  terms_hash text NOT NULL,
+  rights_assigned boolean,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_license_agreements_bond_id ON license_agreements (bond_id);
+CREATE INDEX IF NOT EXISTS idx_license_agreements_deleted_at ON license_agreements (deleted_at);
+
+CREATE TABLE IF NOT EXISTS licensee_payments (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  licensee text NOT NULL,
+  distribution_id bigint NOT NULL,
+  amount text NOT NULL,
+  due_at timestamptz,
+  paid_at timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_licensee_payments_distribution_id ON licensee_payments (distribution_id);
+CREATE INDEX IF NOT EXISTS idx_licensee_payments_licensee ON licensee_payments (licensee);
+CREATE INDEX IF NOT EXISTS idx_licensee_payments_bond_id ON licensee_payments (bond_id);
+CREATE INDEX IF NOT EXISTS idx_licensee_payments_deleted_at ON licensee_payments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS revenue_forecasts (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  period_start timestamptz NOT NULL,
+  period_end timestamptz NOT NULL,
+  amount text NOT NULL,
+  actual text DEFAULT '0',
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_forecast_period ON revenue_forecasts (bond_id,period_start);
+CREATE INDEX IF NOT EXISTS idx_revenue_forecasts_deleted_at ON revenue_forecasts (deleted_at);
+
+CREATE TABLE IF NOT EXISTS processed_events (
+  consumer text,
+  event_id text,
+  processed_at timestamptz NOT NULL,
+  PRIMARY KEY (consumer,event_id)
+);
+
+CREATE TABLE IF NOT EXISTS consumer_offsets (
+  consumer text,
+  position bigint NOT NULL,
+  updated_at timestamptz,
+  PRIMARY KEY (consumer)
+);
+
+CREATE TABLE IF NOT EXISTS dead_letters (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  consumer text NOT NULL,
+  event_id text NOT NULL,
+  position bigint,
+  payload bytea,
+  error text NOT NULL,
+  attempts bigint NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_dead_letters_consumer ON dead_letters (consumer);
+CREATE INDEX IF NOT EXISTS idx_dead_letters_deleted_at ON dead_letters (deleted_at);
+
+CREATE TABLE IF NOT EXISTS api_usages (
+  tenant_id text,
+  key_id text,
+  month text,
+  method text,
+  calls bigint NOT NULL DEFAULT 0,
+  errors bigint NOT NULL DEFAULT 0,
+  request_bytes bigint NOT NULL DEFAULT 0,
+  response_bytes bigint NOT NULL DEFAULT 0,
+  updated_at timestamptz,
+  PRIMARY KEY (tenant_id,key_id,month,method)
+);
+
+CREATE TABLE IF NOT EXISTS maintenance_windows (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  starts_at timestamptz NOT NULL,
+  ends_at timestamptz NOT NULL,
+  reason text NOT NULL,
+  announced_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_maintenance_windows_ends_at ON maintenance_windows (ends_at);
+CREATE INDEX IF NOT EXISTS idx_maintenance_windows_starts_at ON maintenance_windows (starts_at);
+CREATE INDEX IF NOT EXISTS idx_maintenance_windows_deleted_at ON maintenance_windows (deleted_at);
+
+CREATE TABLE IF NOT EXISTS oracle_valuations (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  token_id text NOT NULL,
+  estimated_value decimal,
+  confidence_low decimal,
+  confidence_high decimal,
+  model_uncertainty decimal,
+  error text,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_oracle_valuations_token_id ON oracle_valuations (token_id);
+CREATE INDEX IF NOT EXISTS idx_oracle_valuations_deleted_at ON oracle_valuations (deleted_at);
+
+CREATE TABLE IF NOT EXISTS oracle_answers (
+  id bigserial,
+  valuation_id bigint NOT NULL,
+  provider text NOT NULL,
+  estimated_value decimal,
+  confidence_low decimal,
+  confidence_high decimal,
+  model_uncertainty decimal,
+  latency_ms bigint,
+  error text,
+  outlier boolean,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_oracle_valuations_answers FOREIGN KEY (valuation_id) REFERENCES oracle_valuations(id)
+);
+CREATE INDEX IF NOT EXISTS idx_oracle_answers_valuation_id ON oracle_answers (valuation_id);
+
+CREATE TABLE IF NOT EXISTS bond_events (
+  id bigserial,
+  bond_id text NOT NULL,
+  type text NOT NULL,
+  detail text,
+  occurred_at timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_bond_events_occurred_at ON bond_events (occurred_at);
+CREATE INDEX IF NOT EXISTS idx_bond_events_bond_id ON bond_events (bond_id);
+
+CREATE TABLE IF NOT EXISTS oracle_spends (
+  tenant_id text,
+  day text,
+  provider text,
+  calls bigint NOT NULL DEFAULT 0,
+  cost_micros bigint NOT NULL DEFAULT 0,
+  updated_at timestamptz,
+  PRIMARY KEY (tenant_id,day,provider)
+);
+
+CREATE TABLE IF NOT EXISTS comparable_sales (
+  id bigserial,
+  token_id text NOT NULL,
+  category text NOT NULL,
+  tags text,
+  price decimal NOT NULL,
+  sold_at timestamptz NOT NULL,
+  source text NOT NULL,
+  created_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_comparable_sales_sold_at ON comparable_sales (sold_at);
+CREATE INDEX IF NOT EXISTS idx_comparable_sales_category ON comparable_sales (category);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comparable_sale ON comparable_sales (token_id,sold_at,source);
+
+CREATE TABLE IF NOT EXISTS investor_profiles (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL DEFAULT 'default',
+  address text NOT NULL,
+  status text NOT NULL,
+  provider text NOT NULL,
+  reference text,
+  jurisdiction text,
+  reason text,
+  accredited boolean NOT NULL DEFAULT false,
+  accredited_until timestamptz,
+  decided_at timestamptz NOT NULL,
+  expires_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_investor_profiles_status ON investor_profiles (status);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_investor_profiles_wallet ON investor_profiles (tenant_id,address);
+CREATE INDEX IF NOT EXISTS idx_investor_profiles_deleted_at ON investor_profiles (deleted_at);
+
+CREATE TABLE IF NOT EXISTS bond_commitments (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  epoch bigint NOT NULL,
+  root text NOT NULL,
+  leaf_count bigint NOT NULL,
+  status text NOT NULL,
+  tx_hash text,
+  error text,
+  committed_at timestamptz NOT NULL,
+  published_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_bond_commitments_status ON bond_commitments (status);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bond_commitments_epoch ON bond_commitments (bond_id,epoch);
+CREATE INDEX IF NOT EXISTS idx_bond_commitments_deleted_at ON bond_commitments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS commitment_leaves (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  commitment_id bigint NOT NULL,
+  kind text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text,
+  amount text,
+  distribution_id bigint,
+  coupon_paid text,
+  arrears_paid text,
+  residual text,
+  hash text NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_commitment_leaves_investor ON commitment_leaves (investor);
+CREATE INDEX IF NOT EXISTS idx_commitment_leaves_commitment_id ON commitment_leaves (commitment_id);
+CREATE INDEX IF NOT EXISTS idx_commitment_leaves_deleted_at ON commitment_leaves (deleted_at);
+
+CREATE TABLE IF NOT EXISTS access_list_entries (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL,
+  bond_id text NOT NULL DEFAULT '',
+  list text NOT NULL,
+  address text NOT NULL,
+  reason text,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_access_list_entry ON access_list_entries (tenant_id,bond_id,list,address);
+CREATE INDEX IF NOT EXISTS idx_access_list_entries_deleted_at ON access_list_entries (deleted_at);
+
+CREATE TABLE IF NOT EXISTS notifications (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL DEFAULT 'default',
+  recipient text NOT NULL,
+  bond_id text,
+  event text NOT NULL,
+  payload text,
+  status text NOT NULL DEFAULT 'PENDING',
+  attempts bigint DEFAULT 0,
+  last_error text,
+  sent_at timestamptz,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_bond_id ON notifications (bond_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient ON notifications (recipient);
+CREATE INDEX IF NOT EXISTS idx_notifications_tenant_id ON notifications (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_deleted_at ON notifications (deleted_at);
+
+CREATE TABLE IF NOT EXISTS emergency_withdrawals (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL DEFAULT 'default',
+  bond_id text NOT NULL,
+  recipient text NOT NULL,
+  reason_code text NOT NULL,
+  reason text NOT NULL,
+  status text NOT NULL DEFAULT 'PENDING',
+  requested_by text NOT NULL,
+  expires_at timestamptz NOT NULL,
+  resolved_by text,
+  resolved_at timestamptz,
+  cancel_reason text,
+  tx_hash text,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_emergency_withdrawals_status ON emergency_withdrawals (status);
+CREATE INDEX IF NOT EXISTS idx_emergency_withdrawals_bond_id ON emergency_withdrawals (bond_id);
+CREATE INDEX IF NOT EXISTS idx_emergency_withdrawals_tenant_id ON emergency_withdrawals (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_emergency_withdrawals_deleted_at ON emergency_withdrawals (deleted_at);
+
+CREATE TABLE IF NOT EXISTS feature_flags (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  name text NOT NULL,
+  enabled boolean NOT NULL,
+  rollout_percent bigint NOT NULL DEFAULT 100,
+  tenants text,
+  updated_by text,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_feature_flags_name ON feature_flags (name);
+CREATE INDEX IF NOT EXISTS idx_feature_flags_deleted_at ON feature_flags (deleted_at);
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id bigserial,
+  created_at timestamptz,
+  tenant_id text NOT NULL DEFAULT 'default',
+  principal text,
+  auth_method text,
+  roles text,
+  method text NOT NULL,
+  request_digest text NOT NULL,
+  code text NOT NULL,
+  error text,
+  tx_hashes text,
+  bond_id text,
+  bond_status_before text,
+  bond_status_after text,
+  tranche_id bigint,
+  positions_before text,
+  positions_after text,
+  PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_bond_id ON audit_logs (bond_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_method ON audit_logs (method);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_principal ON audit_logs (principal);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_id ON audit_logs (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at);
+
+-- Closed bonds' detail rows, moved by the archiver. Rows are copied with
+-- SELECT *, so a column added to a hot table must be added to its archive too.
+CREATE TABLE IF NOT EXISTS archived_investments (LIKE investments INCLUDING DEFAULTS);
+CREATE INDEX IF NOT EXISTS idx_archived_investments_bond_id ON archived_investments (bond_id);
+CREATE TABLE IF NOT EXISTS archived_revenue_distributions (LIKE revenue_distributions INCLUDING DEFAULTS);
+CREATE INDEX IF NOT EXISTS idx_archived_revenue_distributions_bond_id ON archived_revenue_distributions (bond_id);
+CREATE TABLE IF NOT EXISTS archived_tranche_distributions (LIKE tranche_distributions INCLUDING DEFAULTS);
+CREATE INDEX IF NOT EXISTS idx_archived_tranche_distributions_bond_id ON archived_tranche_distributions (bond_id);
+CREATE TABLE IF NOT EXISTS archived_chain_events (LIKE chain_events INCLUDING DEFAULTS);
+CREATE INDEX IF NOT EXISTS idx_archived_chain_events_bond_id ON archived_chain_events (bond_id);
+
+-- The audit log only grows
+CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs;
+CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_logs
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
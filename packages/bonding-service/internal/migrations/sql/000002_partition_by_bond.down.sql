@@ -0,0 +1,56 @@
+-- Moves investments and revenue distributions back to unpartitioned tables
+
+ALTER TABLE tranche_distributions DROP CONSTRAINT fk_revenue_distributions_tranches;
+
+ALTER TABLE investments RENAME TO investments_partitioned;
+ALTER INDEX investments_pkey RENAME TO investments_partitioned_pkey;
+DROP INDEX idx_investments_deleted_at;
+DROP INDEX idx_investments_position;
+DROP INDEX idx_investments_tx_hash;
+
+CREATE TABLE investments (
+  id bigint NOT NULL DEFAULT nextval('investments_id_seq'),
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text NOT NULL,
+  amount text NOT NULL,
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_tranches_investments FOREIGN KEY (bond_id,tranche_id) REFERENCES tranches(bond_id,tranche_id)
+);
+INSERT INTO investments SELECT * FROM investments_partitioned;
+ALTER SEQUENCE investments_id_seq OWNED BY investments.id;
+DROP TABLE investments_partitioned;
+CREATE INDEX idx_investments_deleted_at ON investments (deleted_at);
+
+ALTER TABLE revenue_distributions RENAME TO revenue_distributions_partitioned;
+ALTER INDEX revenue_distributions_pkey RENAME TO revenue_distributions_partitioned_pkey;
+DROP INDEX idx_revenue_distributions_period;
+DROP INDEX idx_revenue_distributions_deleted_at;
+DROP INDEX idx_revenue_distributions_bond_timestamp;
+
+CREATE TABLE revenue_distributions (
+  id bigint NOT NULL DEFAULT nextval('revenue_distributions_id_seq'),
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  amount text NOT NULL,
+  shortfall text DEFAULT '0',
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  period timestamptz,
+  PRIMARY KEY (id)
+);
+INSERT INTO revenue_distributions SELECT * FROM revenue_distributions_partitioned;
+ALTER SEQUENCE revenue_distributions_id_seq OWNED BY revenue_distributions.id;
+DROP TABLE revenue_distributions_partitioned;
+CREATE INDEX idx_revenue_distributions_period ON revenue_distributions (period);
+CREATE INDEX idx_revenue_distributions_deleted_at ON revenue_distributions (deleted_at);
+
+ALTER TABLE tranche_distributions ADD CONSTRAINT fk_revenue_distributions_tranches
+  FOREIGN KEY (distribution_id) REFERENCES revenue_distributions(id);
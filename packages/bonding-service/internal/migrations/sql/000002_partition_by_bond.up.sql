@@ -0,0 +1,86 @@
+-- Hash-partitions investments and revenue distributions by bond. Reads and
+-- writes of them name the bond, so each touches one of 16 partitions and
+-- their indexes stay small as the tables grow. Postgres requires the
+-- partition key in primary keys and in foreign keys to them.
+--
+-- Existing rows are copied in this migration's transaction, which holds
+-- both tables locked: apply it in a maintenance window.
+
+ALTER TABLE tranche_distributions DROP CONSTRAINT fk_revenue_distributions_tranches;
+
+-- Investments
+
+ALTER TABLE investments RENAME TO investments_unpartitioned;
+ALTER INDEX investments_pkey RENAME TO investments_unpartitioned_pkey;
+DROP INDEX idx_investments_deleted_at;
+
+CREATE TABLE investments (
+  id bigint NOT NULL DEFAULT nextval('investments_id_seq'),
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text NOT NULL,
+  amount text NOT NULL,
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  PRIMARY KEY (bond_id, id),
+  CONSTRAINT fk_tranches_investments FOREIGN KEY (bond_id,tranche_id) REFERENCES tranches(bond_id,tranche_id)
+) PARTITION BY HASH (bond_id);
+
+DO $$
+BEGIN
+  FOR i IN 0..15 LOOP
+    EXECUTE format('CREATE TABLE investments_p%s PARTITION OF investments FOR VALUES WITH (MODULUS 16, REMAINDER %s)', lpad(i::text, 2, '0'), i);
+  END LOOP;
+END $$;
+
+INSERT INTO investments SELECT * FROM investments_unpartitioned;
+ALTER SEQUENCE investments_id_seq OWNED BY investments.id;
+DROP TABLE investments_unpartitioned;
+
+CREATE INDEX idx_investments_deleted_at ON investments (deleted_at);
+-- Positions are read by bond, tranche and investor
+CREATE INDEX idx_investments_position ON investments (bond_id, tranche_id, investor);
+CREATE INDEX idx_investments_tx_hash ON investments (bond_id, tx_hash);
+
+-- Revenue distributions
+
+ALTER TABLE revenue_distributions RENAME TO revenue_distributions_unpartitioned;
+ALTER INDEX revenue_distributions_pkey RENAME TO revenue_distributions_unpartitioned_pkey;
+DROP INDEX idx_revenue_distributions_period;
+DROP INDEX idx_revenue_distributions_deleted_at;
+
+CREATE TABLE revenue_distributions (
+  id bigint NOT NULL DEFAULT nextval('revenue_distributions_id_seq'),
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  amount text NOT NULL,
+  shortfall text DEFAULT '0',
+  tx_hash text NOT NULL,
+  timestamp timestamptz NOT NULL,
+  period timestamptz,
+  PRIMARY KEY (bond_id, id)
+) PARTITION BY HASH (bond_id);
+
+DO $$
+BEGIN
+  FOR i IN 0..15 LOOP
+    EXECUTE format('CREATE TABLE revenue_distributions_p%s PARTITION OF revenue_distributions FOR VALUES WITH (MODULUS 16, REMAINDER %s)', lpad(i::text, 2, '0'), i);
+  END LOOP;
+END $$;
+
+INSERT INTO revenue_distributions SELECT * FROM revenue_distributions_unpartitioned;
+ALTER SEQUENCE revenue_distributions_id_seq OWNED BY revenue_distributions.id;
+DROP TABLE revenue_distributions_unpartitioned;
+
+CREATE INDEX idx_revenue_distributions_period ON revenue_distributions (period);
+CREATE INDEX idx_revenue_distributions_deleted_at ON revenue_distributions (deleted_at);
+-- Coupons accrue from a bond's latest distribution
+CREATE INDEX idx_revenue_distributions_bond_timestamp ON revenue_distributions (bond_id, timestamp);
+
+ALTER TABLE tranche_distributions ADD CONSTRAINT fk_revenue_distributions_tranches
+  FOREIGN KEY (bond_id,distribution_id) REFERENCES revenue_distributions(bond_id,id);
@@ -0,0 +1 @@
+DROP INDEX idx_investments_listing;
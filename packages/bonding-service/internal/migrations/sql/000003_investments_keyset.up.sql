@@ -0,0 +1,3 @@
+-- ListInvestments pages through a bond's investments newest first, resuming
+-- after the (timestamp, id) of the previous page's last row
+CREATE INDEX idx_investments_listing ON investments (bond_id, timestamp DESC, id DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS distribution_schedules;
@@ -0,0 +1,23 @@
+-- Recurring revenue distributions set by issuers
+CREATE TABLE IF NOT EXISTS distribution_schedules (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  bond_id text NOT NULL,
+  cadence text NOT NULL,
+  anchor timestamptz NOT NULL,
+  next_run_at timestamptz NOT NULL,
+  amount text,
+  paused boolean NOT NULL DEFAULT false,
+  skip_runs bigint NOT NULL DEFAULT 0,
+  accrued_since timestamptz NOT NULL,
+  last_run_at timestamptz,
+  last_status text,
+  last_error text,
+  last_queued_id bigint,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_distribution_schedules_bond_id ON distribution_schedules (bond_id);
+CREATE INDEX IF NOT EXISTS idx_distribution_schedules_next_run_at ON distribution_schedules (next_run_at);
+CREATE INDEX IF NOT EXISTS idx_distribution_schedules_deleted_at ON distribution_schedules (deleted_at);
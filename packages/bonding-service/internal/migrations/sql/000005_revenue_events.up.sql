@@ -0,0 +1,28 @@
+-- Revenue reported by the ingestion connectors
+CREATE TABLE IF NOT EXISTS revenue_events (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  tenant_id text NOT NULL DEFAULT 'default',
+  bond_id text NOT NULL,
+  source text NOT NULL,
+  connector text NOT NULL,
+  external_id text NOT NULL,
+  amount text NOT NULL,
+  occurred_at timestamptz NOT NULL,
+  reference text,
+  status text NOT NULL DEFAULT 'PENDING',
+  recorded_by text,
+  reviewed_by text,
+  reviewed_at timestamptz,
+  reject_reason text,
+  tx_hash text,
+  block_number bigint,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_revenue_events_external ON revenue_events (source, connector, external_id);
+CREATE INDEX IF NOT EXISTS idx_revenue_events_bond ON revenue_events (bond_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_revenue_events_reviewed_at ON revenue_events (reviewed_at);
+CREATE INDEX IF NOT EXISTS idx_revenue_events_tenant_id ON revenue_events (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_revenue_events_deleted_at ON revenue_events (deleted_at);
@@ -0,0 +1,18 @@
+-- Daily interest accrued by each investment
+CREATE TABLE IF NOT EXISTS accrual_snapshots (
+  id bigserial,
+  created_at timestamptz,
+  investment_id bigint NOT NULL,
+  day timestamptz NOT NULL,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  investor text NOT NULL,
+  principal text NOT NULL,
+  apy_bps bigint NOT NULL,
+  seconds bigint NOT NULL,
+  interest text NOT NULL,
+  accrued text NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accrual_snapshots_day ON accrual_snapshots (investment_id, day);
+CREATE INDEX IF NOT EXISTS idx_accrual_snapshots_bond ON accrual_snapshots (bond_id, day);
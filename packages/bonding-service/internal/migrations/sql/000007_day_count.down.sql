@@ -0,0 +1,3 @@
+ALTER TABLE accrual_snapshots DROP COLUMN IF EXISTS day_count;
+ALTER TABLE tranches DROP COLUMN IF EXISTS day_count;
+ALTER TABLE bonds DROP COLUMN IF EXISTS day_count;
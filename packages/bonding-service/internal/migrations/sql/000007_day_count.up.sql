@@ -0,0 +1,5 @@
+-- Day-count convention coupons accrue under. Bonds issued before it was
+-- configurable accrued on actual days over a 365-day year.
+ALTER TABLE bonds ADD COLUMN IF NOT EXISTS day_count text NOT NULL DEFAULT 'ACT/365';
+ALTER TABLE tranches ADD COLUMN IF NOT EXISTS day_count text DEFAULT '';
+ALTER TABLE accrual_snapshots ADD COLUMN IF NOT EXISTS day_count text NOT NULL DEFAULT 'ACT/365';
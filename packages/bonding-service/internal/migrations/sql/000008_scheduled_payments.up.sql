@@ -0,0 +1,13 @@
+-- Payments each tranche is expected to make, generated at issuance
+CREATE TABLE IF NOT EXISTS scheduled_payments (
+  id bigserial,
+  created_at timestamptz,
+  bond_id text NOT NULL,
+  tranche_id bigint NOT NULL,
+  sequence bigint NOT NULL,
+  due_date timestamptz NOT NULL,
+  coupon text NOT NULL,
+  principal text NOT NULL DEFAULT '0',
+  PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scheduled_payments_sequence ON scheduled_payments (bond_id, tranche_id, sequence);
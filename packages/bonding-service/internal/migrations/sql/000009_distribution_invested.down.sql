@@ -0,0 +1,2 @@
+ALTER TABLE archived_tranche_distributions DROP COLUMN IF EXISTS invested;
+ALTER TABLE tranche_distributions DROP COLUMN IF EXISTS invested;
@@ -0,0 +1,11 @@
+-- Capital each tranche split was paid on, so realized yield can be charted.
+-- Splits recorded before it was kept take the tranche's current total.
+ALTER TABLE tranche_distributions ADD COLUMN IF NOT EXISTS invested text DEFAULT '0';
+ALTER TABLE archived_tranche_distributions ADD COLUMN IF NOT EXISTS invested text DEFAULT '0';
+
+UPDATE tranche_distributions d SET invested = t.total_invested
+FROM tranches t
+WHERE t.bond_id = d.bond_id AND t.tranche_id = d.tranche_id;
+UPDATE archived_tranche_distributions d SET invested = t.total_invested
+FROM tranches t
+WHERE t.bond_id = d.bond_id AND t.tranche_id = d.tranche_id;
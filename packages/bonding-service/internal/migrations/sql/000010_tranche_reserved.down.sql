@@ -0,0 +1 @@
+ALTER TABLE tranches DROP COLUMN IF EXISTS reserved;
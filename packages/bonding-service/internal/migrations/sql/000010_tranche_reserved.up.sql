@@ -0,0 +1,3 @@
+-- Capacity held for investments whose transaction is being sent, so two
+-- investors can't both be admitted into the last of a tranche's allocation.
+ALTER TABLE tranches ADD COLUMN IF NOT EXISTS reserved text DEFAULT '0';
@@ -0,0 +1,4 @@
+-- ERC-2612 token a seller is paid in; the buyer's permit for it settles the
+-- payment in the transaction that moves the position. Orders placed before
+-- it was kept have none and can only be cancelled.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS token text NOT NULL DEFAULT '';
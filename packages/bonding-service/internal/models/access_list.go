@@ -0,0 +1,20 @@
+package models
+
+import "gorm.io/gorm"
+
+// Access lists
+const (
+	AccessListAllow = "ALLOW"
+	AccessListDeny  = "DENY"
+)
+
+// AccessListEntry puts an address on a tenant's allow or deny list, for all
+// of the tenant's bonds or for one bond
+type AccessListEntry struct {
+	gorm.Model
+	TenantID string `gorm:"uniqueIndex:idx_access_list_entry;not null"`
+	BondID   string `gorm:"uniqueIndex:idx_access_list_entry;not null;default:''"` // Empty for every bond
+	List     string `gorm:"uniqueIndex:idx_access_list_entry;not null"`            // ALLOW or DENY
+	Address  string `gorm:"uniqueIndex:idx_access_list_entry;not null"`            // Lowercase hex
+	Reason   string
+}
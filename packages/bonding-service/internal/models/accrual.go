@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// AccrualSnapshot is the interest an investment accrued over one UTC day at
+// its tranche's APY. Each row carries its inputs, so a payout can be checked
+// against the interest accrued before it.
+type AccrualSnapshot struct {
+	ID           uint `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	InvestmentID uint      `gorm:"uniqueIndex:idx_accrual_snapshots_day;not null"`
+	Day          time.Time `gorm:"uniqueIndex:idx_accrual_snapshots_day;index:idx_accrual_snapshots_bond;not null"` // Start of the UTC day accrued
+	BondID       string    `gorm:"index:idx_accrual_snapshots_bond;not null"`
+	TrancheID    int       `gorm:"not null"`
+	Investor     string    `gorm:"not null"`
+	Principal    string    `gorm:"not null"` // The investment's amount when the day was accrued
+	APYBps       int64     `gorm:"not null"`
+	DayCount     string    `gorm:"not null;default:'ACT/365'"`
+	Seconds      int64     `gorm:"not null"` // Part of the day the investment was held before maturity
+	Interest     string    `gorm:"not null"` // Accrued over the day
+	Accrued      string    `gorm:"not null"` // Accrued since the investment was made, through the day
+}
@@ -0,0 +1,27 @@
+package models
+
+import "gorm.io/gorm"
+
+// Address book roles
+const (
+	RoleIssuer   = "ISSUER"
+	RoleInvestor = "INVESTOR"
+	RoleOther    = "OTHER"
+)
+
+// Address book verification statuses
+const (
+	VerificationUnverified = "UNVERIFIED"
+	VerificationVerified   = "VERIFIED"
+	VerificationRejected   = "REJECTED"
+)
+
+// AddressBookEntry names a counterparty address within a tenant
+type AddressBookEntry struct {
+	gorm.Model
+	TenantID           string `gorm:"uniqueIndex:idx_tenant_address;not null"`
+	Address            string `gorm:"uniqueIndex:idx_tenant_address;not null"` // Lowercase hex
+	Label              string `gorm:"not null"`
+	Role               string `gorm:"not null;default:'OTHER'"`
+	VerificationStatus string `gorm:"not null;default:'UNVERIFIED'"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// AuditLog records a call to a mutating RPC. Rows are only ever inserted;
+// the database refuses updates and deletes.
+type AuditLog struct {
+	ID            uint      `gorm:"primarykey"`
+	CreatedAt     time.Time `gorm:"index"`
+	TenantID      string    `gorm:"index;not null;default:'default'"`
+	Principal     string    `gorm:"index"` // Caller's ID, empty for anonymous calls
+	AuthMethod    string
+	Roles         string // Comma-separated
+	Method        string `gorm:"index;not null"` // RPC name, e.g. Invest
+	RequestDigest string `gorm:"not null"`       // Hex SHA-256 of the JSON-encoded request
+	Code          string `gorm:"not null"`       // gRPC status code of the outcome
+	Error         string
+	TxHashes      string // Comma-separated
+
+	// The bond's status and, for calls about one tranche, the positions of
+	// the investors involved, before and after the call
+	BondID           string `gorm:"index"`
+	BondStatusBefore string // Empty while the bond doesn't exist
+	BondStatusAfter  string
+	TrancheID        int    // -1 when the call isn't about one tranche
+	PositionsBefore  string // JSON object of investor address to amount
+	PositionsAfter   string
+}
@@ -6,19 +6,66 @@ import (
 	"gorm.io/gorm"
 )
 
+// Bond statuses. The contract only knows the first three; the others are
+// operator interventions that the contract still reports as ACTIVE.
+const (
+	BondActive    = "ACTIVE"
+	BondMatured   = "MATURED"
+	BondDefaulted = "DEFAULTED"
+	BondPaused    = "PAUSED"    // Closed to new investments
+	BondFrozen    = "FROZEN"    // Distributions suspended pending investigation
+	BondCancelled = "CANCELLED" // Withdrawn before it was funded
+)
+
+// OutstandingBondStatuses are the statuses of bonds whose positions are
+// still held, including while an operator has paused or frozen them
+var OutstandingBondStatuses = []string{BondActive, BondPaused, BondFrozen}
+
 // Bond represents an IP-backed bond
 type Bond struct {
 	gorm.Model
 	BondID       string    `gorm:"uniqueIndex;not null"`
+	TenantID     string    `gorm:"index;not null;default:'default'"`
+	Chain        string    `gorm:"index;not null;default:'arbitrum'"` // Name in the chain registry
 	IPNFTId      string    `gorm:"not null"`
 	NFTContract  string    `gorm:"not null"`
 	Issuer       string    `gorm:"not null"`
 	TotalValue   string    `gorm:"not null"`
 	MaturityDate time.Time `gorm:"not null"`
-	Status       string    `gorm:"not null;default:'ACTIVE'"` // ACTIVE, MATURED, DEFAULTED
+	Status       string    `gorm:"not null;default:'ACTIVE'"` // ACTIVE, MATURED, DEFAULTED, PAUSED, FROZEN or CANCELLED
 	TotalRevenue string    `gorm:"default:'0'"`
 	TxHash       string    `gorm:"not null"`
 	Tranches     []Tranche `gorm:"foreignKey:BondID;references:BondID"`
+
+	// Day-count convention coupons accrue under: ACT/365, ACT/360 or 30/360
+	DayCount string `gorm:"not null;default:'ACT/365'"`
+
+	// Patent or trademark registration backing the bond, if any
+	RegistrationKind         string
+	RegistrationNumber       string
+	RegistrationJurisdiction string
+	RegistrationExpiresAt    *time.Time
+	RegistrationVerified     bool
+
+	// End of the license the bond's revenue depends on, nil if perpetual
+	LicenseExpiresAt *time.Time
+
+	// Taxonomy category the bond's IP was assessed under
+	Category string
+
+	// Oracle fingerprint of the bond's content, empty if it wasn't fingerprinted
+	ContentFingerprint string `gorm:"index"`
+
+	// Set when revenue missed its forecast for long enough to review the rating
+	RatingReviewAt     *time.Time
+	RatingReviewReason string
+
+	// Why and when an operator last changed the status
+	StatusReason    string
+	StatusChangedAt *time.Time
+
+	// Set while the bond's detail rows are in the archived_ tables
+	ArchivedAt *time.Time
 }
 
 // Tranche represents a bond tranche (Senior, Mezzanine, Junior)
@@ -32,10 +79,25 @@ type Tranche struct {
 	APY           float64 `gorm:"not null"`
 	RiskLevel     string `gorm:"not null"`
 	TotalInvested string `gorm:"default:'0'"`
+	Reserved      string `gorm:"default:'0'"` // Held for investments whose transaction is being sent
+	Arrears       string `gorm:"default:'0'"` // Cumulative unpaid coupons carried forward
+	MinInvestment string `gorm:"default:'0'"` // Minimum ticket size, 0 for none
+	MaxInvestment string `gorm:"default:'0'"` // Maximum ticket size, 0 for none
+	DayCount      string `gorm:"default:''"`  // Overrides the bond's day count when set
 	Investments   []Investment `gorm:"foreignKey:BondID,TrancheID;references:BondID,TrancheID"`
 }
 
-// Investment represents an investor's investment in a tranche
+// TrancheDayCount returns the day-count convention a tranche's coupons accrue
+// under: its own, else the bond's
+func (b *Bond) TrancheDayCount(t *Tranche) string {
+	if t.DayCount != "" {
+		return t.DayCount
+	}
+	return b.DayCount
+}
+
+// Investment represents an investor's investment in a tranche. The table is
+// hash-partitioned by BondID, so queries should filter on it.
 type Investment struct {
 	gorm.Model
 	BondID    string    `gorm:"not null"`
@@ -46,24 +108,46 @@ type Investment struct {
 	Timestamp time.Time `gorm:"not null"`
 }
 
-// RevenueDistribution tracks revenue distributions
+// RevenueDistribution tracks revenue distributions. The table is
+// hash-partitioned by BondID, so queries should filter on it.
 type RevenueDistribution struct {
 	gorm.Model
-	BondID    string    `gorm:"not null"`
-	Amount    string    `gorm:"not null"`
-	TxHash    string    `gorm:"not null"`
-	Timestamp time.Time `gorm:"not null"`
+	BondID    string                `gorm:"not null"`
+	Amount    string                `gorm:"not null"`
+	Shortfall string                `gorm:"default:'0'"` // Coupons left unpaid by this distribution
+	TxHash    string                `gorm:"not null"`
+	Timestamp time.Time             `gorm:"not null"`
+	Period    *time.Time            `gorm:"index"` // Due date of the revenue paid out, nil when unnamed
+	Tranches  []TrancheDistribution `gorm:"foreignKey:DistributionID"`
+}
+
+// TrancheDistribution records how a revenue distribution was split for a tranche
+type TrancheDistribution struct {
+	gorm.Model
+	DistributionID uint   `gorm:"index;not null"`
+	BondID         string `gorm:"index;not null"`
+	TrancheID      int    `gorm:"not null"`
+	CouponDue      string `gorm:"not null"`
+	ArrearsPaid    string `gorm:"default:'0'"`
+	CouponPaid     string `gorm:"default:'0'"`
+	Residual       string `gorm:"default:'0'"`
+	Shortfall      string `gorm:"default:'0'"`
+	ArrearsAfter   string `gorm:"default:'0'"`
+	Invested       string `gorm:"default:'0'"` // Tranche's total invested when distributed, which its yield is measured on
 }
 
-// RiskAssessment stores risk assessment results
+// RiskAssessment stores risk assessment results. Assessments are appended,
+// never replaced; an IP-NFT's latest by AssessedAt is its current one.
 type RiskAssessment struct {
 	gorm.Model
-	IPNFTId            string    `gorm:"uniqueIndex;not null"`
+	IPNFTId            string    `gorm:"index:idx_risk_assessment_history,priority:1;not null"`
 	ValuationUSD       float64   `gorm:"not null"`
 	ConfidenceScore    float64   `gorm:"not null"`
 	RiskRating         string    `gorm:"not null"`
 	DefaultProbability float64   `gorm:"not null"`
 	RecommendedLTV     float64   `gorm:"not null"`
 	RiskFactors        string    `gorm:"type:text"` // JSON array
-	AssessedAt         time.Time `gorm:"not null"`
+	RiskModel          string    // Registered name of the model that produced it
+	RiskModelVersion   string
+	AssessedAt         time.Time `gorm:"index:idx_risk_assessment_history,priority:2;index;not null"`
 }
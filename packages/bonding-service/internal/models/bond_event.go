@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// Off-chain bond event types
+const (
+	BondEventRatingChanged       = "RATING_CHANGED"
+	BondEventCovenant            = "COVENANT"
+	BondEventStatusChanged       = "STATUS_CHANGED"
+	BondEventEmergencyWithdrawal = "EMERGENCY_WITHDRAWAL"
+)
+
+// BondEvent is a bond lifecycle event that happens off-chain: a rating
+// change, a covenant event, a status transition or an emergency withdrawal.
+// Contract events are stored as ChainEvent.
+type BondEvent struct {
+	ID         uint      `gorm:"primarykey"`
+	BondID     string    `gorm:"index;not null"`
+	Type       string    `gorm:"not null"`
+	Detail     string    `gorm:"type:text"` // Human-readable description
+	OccurredAt time.Time `gorm:"index;not null"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Commitment statuses
+const (
+	CommitmentPending   = "PENDING"   // Computed, not yet published
+	CommitmentPublished = "PUBLISHED" // The root transaction was sent
+	CommitmentFailed    = "FAILED"    // Publishing failed; retried on the next run
+)
+
+// BondCommitment is a Merkle root over a bond's investor positions and
+// distributions at one point in time, published on-chain
+type BondCommitment struct {
+	gorm.Model
+	BondID      string `gorm:"uniqueIndex:idx_bond_commitments_epoch;not null"`
+	Epoch       uint64 `gorm:"uniqueIndex:idx_bond_commitments_epoch;not null"` // 1 for a bond's first commitment
+	Root        string `gorm:"not null"`
+	LeafCount   int    `gorm:"not null"`
+	Status      string `gorm:"index;not null"`
+	TxHash      string
+	Error       string    // Why publishing last failed
+	CommittedAt time.Time `gorm:"not null"`
+	PublishedAt *time.Time
+}
+
+// CommitmentLeaf is one leaf of a commitment, kept so proofs can be served
+// after the positions it covers have changed
+type CommitmentLeaf struct {
+	gorm.Model
+	CommitmentID   uint   `gorm:"index;not null"`
+	Kind           string `gorm:"not null"` // POSITION or DISTRIBUTION
+	TrancheID      int    `gorm:"not null"`
+	Investor       string `gorm:"index"` // Positions only
+	Amount         string // Positions only
+	DistributionID uint   // Distributions only
+	CouponPaid     string // Distributions only
+	ArrearsPaid    string // Distributions only
+	Residual       string // Distributions only
+	Hash           string `gorm:"not null"`
+}
+
+// TableName overrides gorm's pluralization, commitment_leafs
+func (CommitmentLeaf) TableName() string {
+	return "commitment_leaves"
+}
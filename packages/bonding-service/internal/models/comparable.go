@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// ComparableSale is a recorded sale of an IP-NFT that the risk engine
+// values similar IP against
+type ComparableSale struct {
+	ID       uint      `gorm:"primarykey"`
+	TokenID  string    `gorm:"uniqueIndex:idx_comparable_sale,priority:1;not null"`
+	Category string    `gorm:"index;not null"`
+	Tags     string    `gorm:"type:text"` // Comma-separated
+	Price    float64   `gorm:"not null"`  // USD
+	SoldAt   time.Time `gorm:"uniqueIndex:idx_comparable_sale,priority:2;index;not null"`
+	// Source is "manual" for sales recorded through the API or "oracle" for
+	// sales reported with an oracle valuation
+	Source    string `gorm:"uniqueIndex:idx_comparable_sale,priority:3;not null"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ProcessedEvent marks an event a consumer has handled so redeliveries are skipped
+type ProcessedEvent struct {
+	Consumer    string    `gorm:"primaryKey"`
+	EventID     string    `gorm:"primaryKey"`
+	ProcessedAt time.Time `gorm:"not null"`
+}
+
+// ConsumerOffset is the last position a consumer committed in an ordered source
+type ConsumerOffset struct {
+	Consumer  string `gorm:"primaryKey"`
+	Position  uint64 `gorm:"not null"`
+	UpdatedAt time.Time
+}
+
+// DeadLetter is an event a consumer gave up on after retrying it
+type DeadLetter struct {
+	gorm.Model
+	Consumer string `gorm:"index;not null"`
+	EventID  string `gorm:"not null"`
+	Position uint64
+	Payload  []byte
+	Error    string `gorm:"not null"`
+	Attempts int    `gorm:"not null"`
+}
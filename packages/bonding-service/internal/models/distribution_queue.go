@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Queued distribution statuses
+const (
+	DistributionQueued     = "QUEUED"
+	DistributionProcessing = "PROCESSING"
+	DistributionCompleted  = "COMPLETED"
+	DistributionFailed     = "FAILED"
+	DistributionDuplicate  = "DUPLICATE" // Refused as equivalent to a distribution already executed
+)
+
+// QueuedDistribution is a revenue distribution waiting to be processed by the batch job
+type QueuedDistribution struct {
+	gorm.Model
+	BondID      string    `gorm:"index;not null"`
+	Amount      string    `gorm:"not null"`
+	DueAt       time.Time `gorm:"index;not null"`
+	Status      string    `gorm:"index;not null;default:'QUEUED'"` // QUEUED, PROCESSING, COMPLETED, FAILED, DUPLICATE
+	Attempts    int       `gorm:"default:0"`
+	LastError   string    `gorm:"type:text"`
+	TxHash      string
+	ProcessedAt *time.Time
+}
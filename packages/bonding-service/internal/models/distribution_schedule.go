@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Outcomes of a scheduled distribution run
+const (
+	ScheduleRunQueued    = "QUEUED"     // A distribution was queued for the batch job
+	ScheduleRunSkipped   = "SKIPPED"    // The issuer asked to skip the run
+	ScheduleRunNoRevenue = "NO_REVENUE" // Nothing accrued since the last distribution
+	ScheduleRunFailed    = "FAILED"     // The accrued revenue couldn't be read or queued
+)
+
+// DistributionSchedule is a bond's recurring revenue distribution. Each run
+// queues the revenue accrued since the last one; runs keep the anchor's day
+// of the month and time of day.
+type DistributionSchedule struct {
+	gorm.Model
+	BondID       string    `gorm:"uniqueIndex;not null"`
+	Cadence      string    `gorm:"not null"` // DAILY, WEEKLY, MONTHLY or QUARTERLY
+	Anchor       time.Time `gorm:"not null"` // The first run
+	NextRunAt    time.Time `gorm:"index;not null"`
+	Amount       string    // Fixed amount per run; empty distributes the revenue accrued
+	Paused       bool      `gorm:"not null;default:false"`
+	SkipRuns     int       `gorm:"not null;default:0"` // Upcoming runs to skip
+	AccruedSince time.Time `gorm:"not null"`           // Start of the revenue the next run pays
+	LastRunAt    *time.Time
+	LastStatus   string // QUEUED, SKIPPED, NO_REVENUE or FAILED
+	LastError    string `gorm:"type:text"`
+	LastQueuedID uint   // The distribution queued by the last QUEUED run
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Document kinds
+const (
+	DocumentProspectus = "PROSPECTUS"
+	DocumentStatement  = "STATEMENT"
+	DocumentReport     = "REPORT"
+)
+
+// Document is a generated file held in document storage
+type Document struct {
+	gorm.Model
+	TenantID    string     `gorm:"index;not null"`
+	Kind        string     `gorm:"index;not null"`
+	Name        string     `gorm:"not null"`
+	Backend     string     `gorm:"not null"` // Storage backend the object was written to
+	ObjectKey   string     `gorm:"uniqueIndex;not null"`
+	ContentType string     `gorm:"not null"`
+	Size        int64      `gorm:"not null"`
+	SHA256      string     `gorm:"not null"`
+	ExpiresAt   *time.Time `gorm:"index"` // Nil documents are kept indefinitely
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Emergency withdrawal statuses
+const (
+	WithdrawalPending   = "PENDING"   // Waiting for a second operator to confirm
+	WithdrawalExecuting = "EXECUTING" // Confirmed, being sent
+	WithdrawalExecuted  = "EXECUTED"
+	WithdrawalCancelled = "CANCELLED"
+	WithdrawalExpired   = "EXPIRED" // Not confirmed in time
+)
+
+// EmergencyReasons are the reason codes an emergency withdrawal must cite
+var EmergencyReasons = []string{"COMPROMISED_KEYS", "LITIGATION", "REGULATORY_ORDER", "FRAUD"}
+
+// EmergencyWithdrawal is a sweep of a frozen bond's escrowed funds to a
+// recovery address. One operator requests it and another confirms it.
+type EmergencyWithdrawal struct {
+	gorm.Model
+	TenantID     string    `gorm:"index;not null;default:'default'"`
+	BondID       string    `gorm:"index;not null"`
+	Recipient    string    `gorm:"not null"` // Recovery address, checksummed
+	ReasonCode   string    `gorm:"not null"` // One of EmergencyReasons
+	Reason       string    `gorm:"type:text;not null"`
+	Status       string    `gorm:"index;not null;default:'PENDING'"`
+	RequestedBy  string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"` // Confirmation deadline
+	ResolvedBy   string    // Who confirmed or cancelled it
+	ResolvedAt   *time.Time
+	CancelReason string
+	TxHash       string
+}
@@ -0,0 +1,15 @@
+package models
+
+import "gorm.io/gorm"
+
+// FeatureFlag is the stored setting of a risky capability. An enabled flag
+// is on for RolloutPercent of tenants, picked by a stable hash, and always
+// for those listed in Tenants; a disabled one is off for everyone.
+type FeatureFlag struct {
+	gorm.Model
+	Name           string `gorm:"uniqueIndex;not null"`
+	Enabled        bool   `gorm:"not null"`
+	RolloutPercent int    `gorm:"not null;default:100"`
+	Tenants        string // Comma-separated tenants the enabled flag is always on for
+	UpdatedBy      string // Principal that last changed the flag
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RevenueForecast is the revenue a bond's issuer forecast for one period, and
+// the revenue actually distributed in it
+type RevenueForecast struct {
+	gorm.Model
+	BondID      string    `gorm:"uniqueIndex:idx_forecast_period;not null"`
+	PeriodStart time.Time `gorm:"uniqueIndex:idx_forecast_period;not null"`
+	PeriodEnd   time.Time `gorm:"not null"`
+	Amount      string    `gorm:"not null"`
+	Actual      string    `gorm:"default:'0'"`
+}
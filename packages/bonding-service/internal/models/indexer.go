@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// ChainEvent is a bond contract event seen by the indexer. Rows orphaned by a
+// reorg are kept with Removed set so a re-included transaction is applied once.
+type ChainEvent struct {
+	ID          uint   `gorm:"primarykey"`
+	Chain       string `gorm:"uniqueIndex:idx_chain_event;not null"`
+	TxHash      string `gorm:"uniqueIndex:idx_chain_event;not null"`
+	Position    int    `gorm:"uniqueIndex:idx_chain_event;not null"` // Index among the contract's logs in the transaction
+	BlockNumber uint64 `gorm:"index;not null"`
+	BlockHash   string `gorm:"not null"`
+	Event       string `gorm:"not null"` // BondIssued, Investment, RevenueDistributed, Redemption, PositionTransferred, Claimed
+	BondID      string `gorm:"index;not null"`
+	TrancheID   int
+	Account     string
+	Sender      string // Account a PositionTransferred moved the position from
+	Amount      string `gorm:"default:'0'"`
+	Removed     bool   `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IndexedBlock is the hash of a block the indexer processed, kept for the
+// reorg window so a rewritten chain can be detected
+type IndexedBlock struct {
+	Chain  string `gorm:"primaryKey"`
+	Number uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Hash   string `gorm:"not null"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// KYC statuses of an investor profile
+const (
+	KYCPending  = "PENDING"
+	KYCVerified = "VERIFIED"
+	KYCRejected = "REJECTED" // Failed identity checks or AML screening
+)
+
+// InvestorProfile is a wallet's KYC/AML standing with a tenant, as last
+// reported by an external verifier
+type InvestorProfile struct {
+	gorm.Model
+	TenantID        string     `gorm:"uniqueIndex:idx_investor_profiles_wallet;not null;default:'default'"`
+	Address         string     `gorm:"uniqueIndex:idx_investor_profiles_wallet;not null"` // Checksummed
+	Status          string     `gorm:"index;not null"`
+	Provider        string     `gorm:"not null"`
+	Reference       string     // The verifier's case ID
+	Jurisdiction    string     // ISO 3166 alpha-2 code, if the verifier reports one
+	Reason          string     // Why verification is pending or was rejected
+	Accredited      bool       `gorm:"not null;default:false"` // An accredited or professional investor
+	AccreditedUntil *time.Time // When accreditation lapses, nil if it doesn't
+	DecidedAt       time.Time  `gorm:"not null"` // When the verifier decided; older callbacks are ignored
+	ExpiresAt       *time.Time // When verification lapses, nil if it doesn't
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Ledger entry kinds
+const (
+	LedgerInvestment = "INVESTMENT"
+	LedgerRevenue    = "REVENUE"
+	LedgerCoupon     = "COUPON"
+	LedgerRedemption = "REDEMPTION"
+)
+
+// LedgerEntry is a balanced double-entry journal entry for platform activity
+type LedgerEntry struct {
+	gorm.Model
+	TenantID  string `gorm:"index:idx_ledger_period;not null"`
+	BondID    string `gorm:"index;not null"`
+	Kind      string `gorm:"not null"`
+	Reference string `gorm:"not null"` // Transaction hash
+	Memo      string
+	PostedAt  time.Time    `gorm:"index:idx_ledger_period;not null"`
+	Lines     []LedgerLine `gorm:"foreignKey:EntryID"`
+}
+
+// LedgerLine is a single debit or credit of a journal entry
+type LedgerLine struct {
+	gorm.Model
+	EntryID uint   `gorm:"index;not null"`
+	Account string `gorm:"not null"`
+	Debit   string `gorm:"default:'0'"`
+	Credit  string `gorm:"default:'0'"`
+}
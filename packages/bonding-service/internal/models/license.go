@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LicenseAgreement is the license or royalty agreement generating a bond's revenue
+type LicenseAgreement struct {
+	gorm.Model
+	BondID         string     `gorm:"uniqueIndex;not null"`
+	Licensor       string     `gorm:"not null"` // Rights holder granting the license
+	Licensee       string     `gorm:"not null"`
+	RoyaltyBps     int64      `gorm:"not null"` // Royalty as basis points of the licensee's revenue
+	StartsAt       time.Time  `gorm:"not null"`
+	EndsAt         *time.Time // Nil for a perpetual license
+	Territories    string     `gorm:"not null"` // Comma-separated ISO 3166 codes, or WORLDWIDE
+	Exclusive      bool
+	DocumentHash   string // SHA-256 of the signed agreement
+	TermsHash      string `gorm:"not null"` // Hash of the terms the revenue rights were verified for
+	RightsAssigned bool   // The licensor assigned the revenue rights to the issuer by signature
+}
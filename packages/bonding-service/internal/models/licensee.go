@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LicenseePayment is a royalty payment a licensee made towards a bond's revenue
+type LicenseePayment struct {
+	gorm.Model
+	BondID         string     `gorm:"index;not null"`
+	Licensee       string     `gorm:"index;not null"`
+	DistributionID uint       `gorm:"index;not null"`
+	Amount         string     `gorm:"not null"`
+	DueAt          *time.Time // Nil when the payment had no agreed due date
+	PaidAt         time.Time  `gorm:"not null"`
+}
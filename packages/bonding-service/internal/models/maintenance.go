@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaintenanceWindow is a scheduled period during which write RPCs are
+// rejected. Cancelled windows are soft-deleted.
+type MaintenanceWindow struct {
+	gorm.Model
+	StartsAt    time.Time  `gorm:"index;not null"`
+	EndsAt      time.Time  `gorm:"index;not null"`
+	Reason      string     `gorm:"not null"`
+	AnnouncedAt *time.Time // When subscribers were notified, nil until then
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Notification statuses
+const (
+	NotificationPending = "PENDING"
+	NotificationSent    = "SENT"
+	NotificationFailed  = "FAILED" // Gave up after the configured attempts
+)
+
+// Notification is a notice to an investor waiting in the outbox. It is
+// written in the transaction of the change it announces and delivered later.
+type Notification struct {
+	gorm.Model
+	TenantID  string `gorm:"index;not null;default:'default'"`
+	Recipient string `gorm:"index;not null"` // Investor address
+	BondID    string `gorm:"index"`
+	Event     string `gorm:"not null"`
+	Payload   string `gorm:"type:text"` // JSON
+	Status    string `gorm:"index;not null;default:'PENDING'"`
+	Attempts  int    `gorm:"default:0"`
+	LastError string `gorm:"type:text"`
+	SentAt    *time.Time
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// OracleValuation is a valuation aggregated from several oracle providers.
+// Error is set when too few providers answered to aggregate.
+type OracleValuation struct {
+	gorm.Model
+	TokenID          string  `gorm:"index;not null"`
+	EstimatedValue   float64 // Median of the answers kept
+	ConfidenceLow    float64
+	ConfidenceHigh   float64
+	ModelUncertainty float64
+	Error            string         `gorm:"type:text"`
+	Answers          []OracleAnswer `gorm:"foreignKey:ValuationID"`
+}
+
+// OracleAnswer is one provider's answer to an aggregated valuation, kept for audit
+type OracleAnswer struct {
+	ID               uint   `gorm:"primarykey"`
+	ValuationID      uint   `gorm:"index;not null"`
+	Provider         string `gorm:"not null"`
+	EstimatedValue   float64
+	ConfidenceLow    float64
+	ConfidenceHigh   float64
+	ModelUncertainty float64
+	LatencyMs        int64
+	Error            string `gorm:"type:text"` // Set when the provider failed to answer
+	Outlier          bool   // Discarded from the aggregate
+}
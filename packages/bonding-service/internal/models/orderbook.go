@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Order statuses
+const (
+	OrderOpen            = "OPEN"
+	OrderPartiallyFilled = "PARTIALLY_FILLED"
+	OrderFilled          = "FILLED"
+	OrderCancelled       = "CANCELLED"
+)
+
+// Order is a sell order listing part of a tranche position on the secondary market
+type Order struct {
+	gorm.Model
+	BondID    string `gorm:"index:idx_order_book;not null"`
+	TrancheID int    `gorm:"index:idx_order_book;not null"`
+	Seller    string `gorm:"index;not null"`
+	Amount    string `gorm:"not null"`            // Principal offered
+	Remaining string `gorm:"not null"`            // Principal not yet filled
+	PriceBps  int64  `gorm:"not null"`            // Price as basis points of principal (10000 = par)
+	Token     string `gorm:"not null;default:''"` // ERC-2612 token the seller is paid in
+	Status    string `gorm:"index;not null;default:'OPEN'"`
+	ExpiresAt *time.Time
+}
+
+// Trade is a fill of an order, settled by one transaction that moves the
+// position and pays the seller
+type Trade struct {
+	gorm.Model
+	OrderID    uint      `gorm:"index;not null"`
+	BondID     string    `gorm:"index:idx_trade_tranche;not null"`
+	TrancheID  int       `gorm:"index:idx_trade_tranche;not null"`
+	Seller     string    `gorm:"not null"`
+	Buyer      string    `gorm:"not null"`
+	Amount     string    `gorm:"not null"` // Principal transferred
+	PriceBps   int64     `gorm:"not null"`
+	Value      string    `gorm:"not null"` // Amount paid by the buyer
+	TransferID uint      `gorm:"not null"`
+	TxHash     string    `gorm:"not null"`
+	Timestamp  time.Time `gorm:"index;not null"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Redemption statuses
+const (
+	RedemptionPendingApproval = "PENDING_APPROVAL"
+	RedemptionExecuting       = "EXECUTING" // Approved, being sent
+	RedemptionRejected        = "REJECTED"
+	RedemptionCompleted       = "COMPLETED"
+)
+
+// Redemption represents an investor's request to exit a position before maturity
+type Redemption struct {
+	gorm.Model
+	BondID      string `gorm:"index;not null"`
+	TrancheID   int    `gorm:"not null"`
+	Investor    string `gorm:"index;not null"`
+	Amount      string `gorm:"not null"` // Principal being redeemed
+	Penalty     string `gorm:"not null"` // Early redemption penalty withheld
+	Payout      string `gorm:"not null"` // Amount returned to the investor
+	Status      string `gorm:"not null"` // PENDING_APPROVAL, EXECUTING, REJECTED, COMPLETED
+	Approver    string
+	Reason      string
+	TxHash      string
+	RequestedAt time.Time `gorm:"not null"`
+	ResolvedAt  *time.Time
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Revenue event sources
+const (
+	RevenueSourceWebhook = "WEBHOOK" // Reported by a licensee or platform callback
+	RevenueSourceCSV     = "CSV"     // Imported from a royalty statement
+	RevenueSourceChain   = "CHAIN"   // Paid through the royalty distributor contract
+)
+
+// Revenue event statuses
+const (
+	RevenuePending  = "PENDING"  // Awaiting review before it can be distributed
+	RevenueVerified = "VERIFIED" // Counts towards the bond's distributable revenue
+	RevenueRejected = "REJECTED"
+)
+
+// RevenueEvent is revenue a bond's IP earned, as reported by one of the
+// ingestion connectors. Only verified events feed distributions.
+type RevenueEvent struct {
+	gorm.Model
+	TenantID     string     `gorm:"index;not null;default:'default'"`
+	BondID       string     `gorm:"index:idx_revenue_events_bond;not null"`
+	Source       string     `gorm:"uniqueIndex:idx_revenue_events_external;not null"` // WEBHOOK, CSV or CHAIN
+	Connector    string     `gorm:"uniqueIndex:idx_revenue_events_external;not null"` // Webhook name, import name or chain
+	ExternalID   string     `gorm:"uniqueIndex:idx_revenue_events_external;not null"` // The source's ID, so redeliveries are recorded once
+	Amount       string     `gorm:"not null"`
+	OccurredAt   time.Time  `gorm:"index:idx_revenue_events_bond;not null"`
+	Reference    string     // Free-form, e.g. the statement period or payer
+	Status       string     `gorm:"not null;default:'PENDING'"` // PENDING, VERIFIED or REJECTED
+	RecordedBy   string     // Caller who imported it, empty for callbacks and chain events
+	ReviewedBy   string     // Empty when verified automatically
+	ReviewedAt   *time.Time `gorm:"index"` // When it was verified or rejected
+	RejectReason string
+	TxHash       string // Set for chain events
+	BlockNumber  uint64
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ScheduledPayment is a payment a tranche is expected to make, generated at
+// issuance on the tranche's full allocation
+type ScheduledPayment struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	BondID    string    `gorm:"uniqueIndex:idx_scheduled_payments_sequence;not null"`
+	TrancheID int       `gorm:"uniqueIndex:idx_scheduled_payments_sequence;not null"`
+	Sequence  int       `gorm:"uniqueIndex:idx_scheduled_payments_sequence;not null"` // 1 for the tranche's first payment
+	DueDate   time.Time `gorm:"not null"`
+	Coupon    string    `gorm:"not null"`
+	Principal string    `gorm:"not null;default:'0'"` // Repaid with the last coupon
+}
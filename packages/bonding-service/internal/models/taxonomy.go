@@ -0,0 +1,22 @@
+package models
+
+import "gorm.io/gorm"
+
+// Expiry policies for bonds maturing after their IP's protection or license ends
+const (
+	ExpiryReject = "REJECT"
+	ExpiryWarn   = "WARN"
+	ExpiryIgnore = "IGNORE"
+)
+
+// Category is a node in the IP type taxonomy the risk engine values against
+type Category struct {
+	gorm.Model
+	Slug             string  `gorm:"uniqueIndex;not null"` // Lowercase identifier, e.g. "podcast"
+	Parent           string  `gorm:"index"`                // Parent slug, empty for a root category
+	Name             string  `gorm:"not null"`
+	Multiplier       float64 // Valuation multiplier; 0 inherits the parent's
+	ObsolescenceRisk bool    // Flags technology obsolescence risk for this category and its children
+	Aliases          string  `gorm:"type:text"` // Comma-separated alternative names
+	ExpiryPolicy     string  // REJECT, WARN or IGNORE bonds maturing after the IP expires; empty inherits
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// TransactionRecord journals a submitted transaction so monitoring resumes
+// after a restart. Finished records are kept as a history of submissions.
+type TransactionRecord struct {
+	ID          uint      `gorm:"primarykey"`
+	TxHash      string    `gorm:"uniqueIndex;not null"`
+	Chain       string    `gorm:"not null"`
+	Nonce       uint64    // Filled in once the node reports the transaction
+	Purpose     string    `gorm:"not null"` // ISSUE_BOND, INVEST, DISTRIBUTE_REVENUE, REDEEM, TRANSFER_POSITION
+	BondID      string    `gorm:"index"`
+	Status      string    `gorm:"index;not null"` // PENDING, STUCK, DROPPED, MINED, REVERTED, REPLACED, EXPIRED
+	ReplacedBy  string    // Hash of the speed-up or cancel transaction
+	SubmittedAt time.Time `gorm:"not null"`
+	CheckedAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
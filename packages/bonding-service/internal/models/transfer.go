@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// InvestmentTransfer records a secondary transfer of a tranche position between investors
+type InvestmentTransfer struct {
+	gorm.Model
+	BondID      string    `gorm:"index;not null"`
+	TrancheID   int       `gorm:"not null"`
+	FromAddress string    `gorm:"index;not null"`
+	ToAddress   string    `gorm:"index;not null"`
+	Amount      string    `gorm:"not null"`
+	TxHash      string    `gorm:"not null"`
+	Timestamp   time.Time `gorm:"not null"`
+}
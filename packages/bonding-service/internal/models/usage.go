@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+// APIUsage counts one month of calls to one RPC method by one API key
+type APIUsage struct {
+	TenantID      string `gorm:"primaryKey"`
+	KeyID         string `gorm:"primaryKey"` // Fingerprint of the caller's API key, empty for calls without one
+	Month         string `gorm:"primaryKey"` // YYYY-MM in UTC
+	Method        string `gorm:"primaryKey"`
+	Calls         int64  `gorm:"not null;default:0"`
+	Errors        int64  `gorm:"not null;default:0"`
+	RequestBytes  int64  `gorm:"not null;default:0"`
+	ResponseBytes int64  `gorm:"not null;default:0"`
+	UpdatedAt     time.Time
+}
+
+// OracleSpend totals one day of a tenant's calls to one oracle provider
+type OracleSpend struct {
+	TenantID   string `gorm:"primaryKey"`
+	Day        string `gorm:"primaryKey"` // YYYY-MM-DD in UTC
+	Provider   string `gorm:"primaryKey"`
+	Calls      int64  `gorm:"not null;default:0"`
+	CostMicros int64  `gorm:"not null;default:0"` // In millionths of a USD
+	UpdatedAt  time.Time
+}
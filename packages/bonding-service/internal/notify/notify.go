@@ -0,0 +1,150 @@
+// Package notify delivers notices to investors through an outbox: a notice
+// is stored in the transaction of the change it announces, so it is neither
+// lost nor sent for a change that rolled back, and a dispatcher sends the
+// pending ones, retrying failures.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Notice events
+const (
+	EventEmergencyWithdrawal = "emergency_withdrawal"
+)
+
+// Sender delivers one notification
+type Sender interface {
+	Send(ctx context.Context, n *models.Notification) error
+}
+
+// Config controls the dispatcher
+type Config struct {
+	Interval    time.Duration // How often pending notifications are sent
+	BatchSize   int           // Notifications sent per run
+	MaxAttempts int           // Attempts before a notification is marked FAILED
+}
+
+// DefaultConfig returns default dispatcher configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:    30 * time.Second,
+		BatchSize:   100,
+		MaxAttempts: 5,
+	}
+}
+
+// Outbox stores notifications and dispatches them through a sender
+type Outbox struct {
+	db     *gorm.DB
+	sender Sender
+	config Config
+}
+
+// NewOutbox creates an outbox delivering through sender
+func NewOutbox(db *gorm.DB, sender Sender, config Config) *Outbox {
+	return &Outbox{db: db, sender: sender, config: config}
+}
+
+// Enqueue stores a notice to each recipient, within tx
+func (o *Outbox) Enqueue(tx *gorm.DB, tenantID, bondID, event string, payload interface{}, recipients []string) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s notice: %w", event, err)
+	}
+
+	notifications := make([]models.Notification, len(recipients))
+	for i, recipient := range recipients {
+		notifications[i] = models.Notification{
+			TenantID:  tenantID,
+			Recipient: recipient,
+			BondID:    bondID,
+			Event:     event,
+			Payload:   string(encoded),
+			Status:    models.NotificationPending,
+		}
+	}
+	if err := tx.Create(&notifications).Error; err != nil {
+		return fmt.Errorf("failed to queue %s notices: %w", event, err)
+	}
+	return nil
+}
+
+// Start sends pending notifications on every interval until the context is
+// cancelled
+func (o *Outbox) Start(ctx context.Context) {
+	ticker := time.NewTicker(o.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := o.RunOnce(ctx); err != nil {
+				log.Printf("Notification dispatch failed: %v", err)
+			}
+		}
+	}
+}
+
+// Flush sends pending notifications batch by batch until one isn't full,
+// e.g. before shutting down. Failed ones stay pending for the next start.
+func (o *Outbox) Flush(ctx context.Context) error {
+	for ctx.Err() == nil {
+		sent, err := o.RunOnce(ctx)
+		if err != nil {
+			return err
+		}
+		if sent < o.config.BatchSize {
+			return nil
+		}
+	}
+	return ctx.Err()
+}
+
+// RunOnce sends up to a batch of pending notifications, oldest first, and
+// returns how many were sent
+func (o *Outbox) RunOnce(ctx context.Context) (int, error) {
+	var pending []models.Notification
+	if err := o.db.WithContext(ctx).
+		Where("status = ?", models.NotificationPending).
+		Order("id ASC").
+		Limit(o.config.BatchSize).
+		Find(&pending).Error; err != nil {
+		return 0, fmt.Errorf("failed to load pending notifications: %w", err)
+	}
+
+	sent := 0
+	for i := range pending {
+		n := &pending[i]
+		n.Attempts++
+		updates := map[string]interface{}{"attempts": n.Attempts}
+		if err := o.sender.Send(ctx, n); err != nil {
+			log.Printf("Notification %d to %s failed (attempt %d): %v", n.ID, n.Recipient, n.Attempts, err)
+			updates["last_error"] = err.Error()
+			if n.Attempts >= o.config.MaxAttempts {
+				updates["status"] = models.NotificationFailed
+			}
+		} else {
+			now := time.Now()
+			updates["status"] = models.NotificationSent
+			updates["sent_at"] = &now
+			sent++
+		}
+		if err := o.db.WithContext(ctx).Model(n).Updates(updates).Error; err != nil {
+			return sent, fmt.Errorf("failed to record notification outcome: %w", err)
+		}
+	}
+	return sent, nil
+}
@@ -0,0 +1,151 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// fakeSender fails for the recipients in fail
+type fakeSender struct {
+	fail map[string]bool
+	sent []string
+}
+
+func (f *fakeSender) Send(ctx context.Context, n *models.Notification) error {
+	if f.fail[n.Recipient] {
+		return errors.New("unreachable")
+	}
+	f.sent = append(f.sent, n.Recipient)
+	return nil
+}
+
+func TestRunOnce(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT \* FROM "notifications" WHERE status = \$1 .* ORDER BY id ASC LIMIT \$2`).
+		WithArgs("PENDING", 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recipient", "status", "attempts"}).
+			AddRow(1, "0xaaa", "PENDING", 0).
+			AddRow(2, "0xbbb", "PENDING", 0).
+			AddRow(3, "0xccc", "PENDING", 4))
+	mock.ExpectExec(`UPDATE "notifications" SET "attempts"=\$1,"sent_at"=\$2,"status"=\$3`).
+		WithArgs(1, sqlmock.AnyArg(), "SENT", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Still retried
+	mock.ExpectExec(`UPDATE "notifications" SET "attempts"=\$1,"last_error"=\$2,"updated_at"=\$3`).
+		WithArgs(1, "unreachable", sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Out of attempts
+	mock.ExpectExec(`UPDATE "notifications" SET "attempts"=\$1,"last_error"=\$2,"status"=\$3`).
+		WithArgs(5, "unreachable", "FAILED", sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	sender := &fakeSender{fail: map[string]bool{"0xbbb": true, "0xccc": true}}
+	sent, err := NewOutbox(db, sender, DefaultConfig()).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if sent != 1 || len(sender.sent) != 1 || sender.sent[0] != "0xaaa" {
+		t.Errorf("RunOnce() sent %d (%v), want only 0xaaa", sent, sender.sent)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestFlush(t *testing.T) {
+	db, mock := newMockDB(t)
+	batches := [][]string{{"0xaaa", "0xbbb"}, {"0xccc"}}
+	for i, batch := range batches {
+		rows := sqlmock.NewRows([]string{"id", "recipient", "status"})
+		for j, recipient := range batch {
+			rows.AddRow(i*2+j+1, recipient, "PENDING")
+		}
+		mock.ExpectQuery(`SELECT \* FROM "notifications" WHERE status = \$1`).
+			WithArgs("PENDING", 2).
+			WillReturnRows(rows)
+		for range batch {
+			mock.ExpectExec(`UPDATE "notifications"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+	}
+
+	config := DefaultConfig()
+	config.BatchSize = 2
+	sender := &fakeSender{}
+	if err := NewOutbox(db, sender, config).Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if len(sender.sent) != 3 {
+		t.Errorf("Flush() sent %v, want all three", sender.sent)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"accepted", http.StatusAccepted, false},
+		{"server error", http.StatusBadGateway, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got webhookPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("decoding body: %v", err)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			n := &models.Notification{
+				TenantID:  "acme",
+				Recipient: "0xaaa",
+				BondID:    "7",
+				Event:     EventEmergencyWithdrawal,
+				Payload:   `{"reason_code":"FRAUD"}`,
+			}
+			n.ID = 9
+			err := NewWebhook(server.URL).Send(context.Background(), n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.ID != 9 || got.Recipient != "0xaaa" || got.Event != EventEmergencyWithdrawal || string(got.Data) != n.Payload {
+				t.Errorf("posted %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// Webhook posts notifications as JSON to a URL, e.g. a messaging service
+// that knows how to reach each investor's address
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a webhook sender
+func NewWebhook(url string) *Webhook {
+	return &Webhook{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// webhookPayload is the JSON body of a notification
+type webhookPayload struct {
+	ID        uint            `json:"id"` // Stable across retries, for deduplication
+	TenantID  string          `json:"tenant_id"`
+	Recipient string          `json:"recipient"`
+	BondID    string          `json:"bond_id"`
+	Event     string          `json:"event"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Send posts a notification, failing on any non-2xx response
+func (w *Webhook) Send(ctx context.Context, n *models.Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		ID:        n.ID,
+		TenantID:  n.TenantID,
+		Recipient: n.Recipient,
+		BondID:    n.BondID,
+		Event:     n.Event,
+		Data:      json.RawMessage(n.Payload),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
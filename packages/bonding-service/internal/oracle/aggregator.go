@@ -0,0 +1,301 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrTooFewAnswers is returned when fewer providers answered than an
+// aggregate needs
+var ErrTooFewAnswers = errors.New("too few oracle providers answered")
+
+// Valuer values IP-NFTs. OracleClient and Aggregator are both Valuers.
+type Valuer interface {
+	EstimateValue(
+		ctx context.Context,
+		tokenID string,
+		metadata map[string]interface{},
+		historicalData []map[string]interface{},
+	) (*ValuationResponse, error)
+}
+
+// Provider is a named valuation source queried by an Aggregator
+type Provider struct {
+	Name   string
+	Valuer Valuer
+}
+
+// AggregatorConfig configures how provider answers are combined
+type AggregatorConfig struct {
+	MinAnswers int // Answers left after discarding outliers needed to aggregate
+	// OutlierThreshold is how many median absolute deviations an answer may
+	// lie from the median before it is discarded
+	OutlierThreshold float64
+	Timeout          time.Duration // Per provider
+}
+
+// DefaultAggregatorConfig returns the default aggregation settings
+func DefaultAggregatorConfig() AggregatorConfig {
+	return AggregatorConfig{
+		MinAnswers:       2,
+		OutlierThreshold: 3,
+		Timeout:          30 * time.Second,
+	}
+}
+
+// minDeviation floors the median absolute deviation, as a fraction of the
+// median, so answers that nearly agree aren't discarded over rounding
+const minDeviation = 0.01
+
+// ProviderAnswer is one provider's answer to an aggregated valuation
+type ProviderAnswer struct {
+	Provider string
+	Response *ValuationResponse // Nil when Err is set
+	Err      error
+	Latency  time.Duration
+	Outlier  bool
+}
+
+// Valuation is a valuation aggregated from several providers
+type Valuation struct {
+	ID                 uint // Recorded models.OracleValuation, zero when not recorded
+	EstimatedValue     float64
+	ConfidenceInterval []float64
+	ModelUncertainty   float64
+	Answers            []ProviderAnswer
+}
+
+// Aggregator queries several providers concurrently and combines their
+// answers into a median valuation
+type Aggregator struct {
+	db        *gorm.DB
+	providers []Provider
+	config    AggregatorConfig
+}
+
+// NewAggregator creates an aggregator over providers. Each aggregation and
+// every provider's answer are recorded in db; a nil db records nothing.
+func NewAggregator(db *gorm.DB, providers []Provider, config AggregatorConfig) *Aggregator {
+	return &Aggregator{db: db, providers: providers, config: config}
+}
+
+// Aggregate queries every provider and returns the median of their answers
+// after discarding outliers. The confidence interval is the median of the
+// kept answers' bounds, and the uncertainty the median of theirs.
+func (a *Aggregator) Aggregate(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*Valuation, error) {
+	answers := a.query(ctx, tokenID, metadata, historicalData)
+	markOutliers(answers, a.config.OutlierThreshold)
+
+	var values, lows, highs, uncertainties []float64
+	for _, answer := range answers {
+		if answer.Err != nil || answer.Outlier {
+			continue
+		}
+		low, high := bounds(answer.Response)
+		values = append(values, answer.Response.EstimatedValue)
+		lows = append(lows, low)
+		highs = append(highs, high)
+		uncertainties = append(uncertainties, answer.Response.ModelUncertainty)
+	}
+
+	valuation := &Valuation{Answers: answers}
+	var err error
+	if len(values) < a.config.MinAnswers || len(values) == 0 {
+		err = fmt.Errorf("%w: %d of %d kept, need %d", ErrTooFewAnswers, len(values), len(answers), a.config.MinAnswers)
+		for _, answer := range answers {
+			if errors.Is(answer.Err, ErrBudgetExceeded) {
+				err = answer.Err // The caller's budget, not the providers, is the problem
+				break
+			}
+		}
+	} else {
+		valuation.EstimatedValue = median(values)
+		valuation.ConfidenceInterval = []float64{
+			math.Min(median(lows), valuation.EstimatedValue),
+			math.Max(median(highs), valuation.EstimatedValue),
+		}
+		valuation.ModelUncertainty = median(uncertainties)
+	}
+
+	if a.db != nil {
+		if recordErr := a.record(ctx, tokenID, valuation, err); recordErr != nil {
+			log.Printf("Failed to record oracle valuation for %s: %v", tokenID, recordErr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return valuation, nil
+}
+
+// EstimateValue aggregates the providers' answers, so an Aggregator can
+// stand in for a single OracleClient. Comparable sales and factors are taken
+// from the kept answer closest to the median.
+func (a *Aggregator) EstimateValue(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*ValuationResponse, error) {
+	valuation, err := a.Aggregate(ctx, tokenID, metadata, historicalData)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ValuationResponse{
+		EstimatedValue:     valuation.EstimatedValue,
+		ConfidenceInterval: valuation.ConfidenceInterval,
+		ModelUncertainty:   valuation.ModelUncertainty,
+	}
+	closest := math.Inf(1)
+	for _, answer := range valuation.Answers {
+		if answer.Err != nil || answer.Outlier {
+			continue
+		}
+		if d := math.Abs(answer.Response.EstimatedValue - valuation.EstimatedValue); d < closest {
+			closest = d
+			resp.ComparableSales = answer.Response.ComparableSales
+			resp.Factors = answer.Response.Factors
+		}
+		resp.ProcessingTimeMs = math.Max(resp.ProcessingTimeMs, float64(answer.Latency.Milliseconds()))
+	}
+	return resp, nil
+}
+
+// query asks every provider concurrently, each under the configured timeout
+func (a *Aggregator) query(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) []ProviderAnswer {
+	answers := make([]ProviderAnswer, len(a.providers))
+	var wg sync.WaitGroup
+	for i, provider := range a.providers {
+		wg.Add(1)
+		go func(i int, provider Provider) {
+			defer wg.Done()
+			ctx := ctx
+			if a.config.Timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, a.config.Timeout)
+				defer cancel()
+			}
+			start := time.Now()
+			resp, err := provider.Valuer.EstimateValue(ctx, tokenID, metadata, historicalData)
+			answers[i] = ProviderAnswer{Provider: provider.Name, Response: resp, Err: err, Latency: time.Since(start)}
+		}(i, provider)
+	}
+	wg.Wait()
+
+	for _, answer := range answers {
+		outcome := "ok"
+		if answer.Err != nil {
+			outcome = "error"
+		}
+		metrics.OracleAnswers.WithLabelValues(answer.Provider, outcome).Inc()
+	}
+	return answers
+}
+
+// markOutliers flags answers further than threshold median absolute
+// deviations from the median. It needs at least three answers to tell
+// which side is wrong.
+func markOutliers(answers []ProviderAnswer, threshold float64) {
+	var values []float64
+	for _, answer := range answers {
+		if answer.Err == nil {
+			values = append(values, answer.Response.EstimatedValue)
+		}
+	}
+	if len(values) < 3 || threshold <= 0 {
+		return
+	}
+
+	mid := median(values)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - mid)
+	}
+	mad := math.Max(median(deviations), math.Abs(mid)*minDeviation)
+	for i := range answers {
+		if answers[i].Err != nil {
+			continue
+		}
+		if math.Abs(answers[i].Response.EstimatedValue-mid) > threshold*mad {
+			answers[i].Outlier = true
+			metrics.OracleAnswers.WithLabelValues(answers[i].Provider, "outlier").Inc()
+		}
+	}
+}
+
+// record stores the aggregation and every provider's answer
+func (a *Aggregator) record(ctx context.Context, tokenID string, valuation *Valuation, aggErr error) error {
+	row := models.OracleValuation{
+		TokenID:          tokenID,
+		EstimatedValue:   valuation.EstimatedValue,
+		ModelUncertainty: valuation.ModelUncertainty,
+	}
+	if len(valuation.ConfidenceInterval) == 2 {
+		row.ConfidenceLow, row.ConfidenceHigh = valuation.ConfidenceInterval[0], valuation.ConfidenceInterval[1]
+	}
+	if aggErr != nil {
+		row.Error = aggErr.Error()
+	}
+	for _, answer := range valuation.Answers {
+		recorded := models.OracleAnswer{
+			Provider:  answer.Provider,
+			LatencyMs: answer.Latency.Milliseconds(),
+			Outlier:   answer.Outlier,
+		}
+		if answer.Err != nil {
+			recorded.Error = answer.Err.Error()
+		} else {
+			recorded.EstimatedValue = answer.Response.EstimatedValue
+			recorded.ConfidenceLow, recorded.ConfidenceHigh = bounds(answer.Response)
+			recorded.ModelUncertainty = answer.Response.ModelUncertainty
+		}
+		row.Answers = append(row.Answers, recorded)
+	}
+
+	// Record even when the caller gave up waiting
+	if err := a.db.WithContext(context.WithoutCancel(ctx)).Create(&row).Error; err != nil {
+		return err
+	}
+	valuation.ID = row.ID
+	return nil
+}
+
+// bounds returns a response's confidence interval, or its estimate when it
+// gave none
+func bounds(resp *ValuationResponse) (float64, float64) {
+	if len(resp.ConfidenceInterval) != 2 {
+		return resp.EstimatedValue, resp.EstimatedValue
+	}
+	return resp.ConfidenceInterval[0], resp.ConfidenceInterval[1]
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
@@ -0,0 +1,129 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fixedValuer answers every valuation with value, or err
+type fixedValuer struct {
+	value float64
+	err   error
+}
+
+func (v fixedValuer) EstimateValue(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*ValuationResponse, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	return &ValuationResponse{
+		EstimatedValue:     v.value,
+		ConfidenceInterval: []float64{v.value * 0.9, v.value * 1.1},
+		ModelUncertainty:   0.2,
+	}, nil
+}
+
+func providers(valuers ...fixedValuer) []Provider {
+	names := []string{"a", "b", "c", "d", "e"}
+	var out []Provider
+	for i, v := range valuers {
+		out = append(out, Provider{Name: names[i], Valuer: v})
+	}
+	return out
+}
+
+func TestAggregate(t *testing.T) {
+	down := fixedValuer{err: errors.New("connection refused")}
+	tests := []struct {
+		name         string
+		providers    []Provider
+		wantValue    float64
+		wantOutliers []string
+		wantErr      bool
+	}{
+		{"median of agreeing answers", providers(fixedValuer{value: 100}, fixedValuer{value: 110}, fixedValuer{value: 105}), 105, nil, false},
+		{"outlier discarded", providers(fixedValuer{value: 100}, fixedValuer{value: 104}, fixedValuer{value: 102}, fixedValuer{value: 1000}), 102, []string{"d"}, false},
+		{"failed provider skipped", providers(fixedValuer{value: 100}, down, fixedValuer{value: 110}), 105, nil, false},
+		{"too few answers", providers(fixedValuer{value: 100}, down, down), 0, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregator := NewAggregator(nil, tt.providers, DefaultAggregatorConfig())
+			got, err := aggregator.Aggregate(context.Background(), "token-1", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Aggregate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrTooFewAnswers) {
+					t.Errorf("Aggregate() error = %v, want ErrTooFewAnswers", err)
+				}
+				return
+			}
+			if got.EstimatedValue != tt.wantValue {
+				t.Errorf("EstimatedValue = %v, want %v", got.EstimatedValue, tt.wantValue)
+			}
+			low, high := got.ConfidenceInterval[0], got.ConfidenceInterval[1]
+			if low > got.EstimatedValue || high < got.EstimatedValue {
+				t.Errorf("ConfidenceInterval = [%v, %v] excludes %v", low, high, got.EstimatedValue)
+			}
+			var outliers []string
+			for _, answer := range got.Answers {
+				if answer.Outlier {
+					outliers = append(outliers, answer.Provider)
+				}
+			}
+			if len(outliers) != len(tt.wantOutliers) || (len(outliers) > 0 && outliers[0] != tt.wantOutliers[0]) {
+				t.Errorf("outliers = %v, want %v", outliers, tt.wantOutliers)
+			}
+			if len(got.Answers) != len(tt.providers) {
+				t.Errorf("recorded %d answers, want one per provider (%d)", len(got.Answers), len(tt.providers))
+			}
+		})
+	}
+}
+
+func TestAggregateRecordsAnswers(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	mock.ExpectQuery(`INSERT INTO "oracle_valuations"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectQuery(`INSERT INTO "oracle_answers"`).
+		WithArgs(9, "a", 100.0, sqlmock.AnyArg(), sqlmock.AnyArg(), 0.2, sqlmock.AnyArg(), "", false,
+			9, "b", 0.0, 0.0, 0.0, 0.0, sqlmock.AnyArg(), "connection refused", false,
+			9, "c", 110.0, sqlmock.AnyArg(), sqlmock.AnyArg(), 0.2, sqlmock.AnyArg(), "", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+
+	aggregator := NewAggregator(db, providers(fixedValuer{value: 100}, fixedValuer{err: errors.New("connection refused")}, fixedValuer{value: 110}), DefaultAggregatorConfig())
+	got, err := aggregator.Aggregate(context.Background(), "token-1", nil, nil)
+	if err != nil {
+		t.Fatalf("Aggregate() error = %v", err)
+	}
+	if got.ID != 9 {
+		t.Errorf("ID = %d, want 9", got.ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -7,13 +7,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/metrics"
 )
 
 // OracleClient is a client for the Oracle Adapter service
 type OracleClient struct {
 	baseURL    string
 	httpClient *http.Client
+	signer     *common.Address // Required signer of valuations, nil to accept unsigned ones
 }
 
 // NewOracleClient creates a new Oracle Adapter client
@@ -26,11 +31,18 @@ func NewOracleClient(baseURL string) *OracleClient {
 	}
 }
 
+// RequireSigner makes EstimateValue reject valuations not signed by signer,
+// so unsigned or tampered responses never influence risk ratings
+func (c *OracleClient) RequireSigner(signer common.Address) {
+	c.signer = &signer
+}
+
 // ValuationRequest represents a valuation request
 type ValuationRequest struct {
 	TokenID        string                 `json:"token_id"`
 	Metadata       map[string]interface{} `json:"metadata"`
 	HistoricalData []map[string]interface{} `json:"historical_data,omitempty"`
+	SchemaVersion  int                    `json:"schema_version"`
 }
 
 // ValuationResponse represents a valuation response
@@ -40,7 +52,9 @@ type ValuationResponse struct {
 	ComparableSales    []map[string]interface{} `json:"comparable_sales"`
 	Factors            map[string]interface{}   `json:"factors"`
 	ModelUncertainty   float64                  `json:"model_uncertainty"`
+	ModelVersion       string                   `json:"model_version,omitempty"` // Empty before schema version 2
 	ProcessingTimeMs   float64                  `json:"processing_time_ms"`
+	Signature          string                   `json:"signature,omitempty"` // Hex personal_sign signature of ValuationMessage
 }
 
 // EstimateValue calls the Oracle Adapter to estimate IP value
@@ -50,59 +64,35 @@ func (c *OracleClient) EstimateValue(
 	metadata map[string]interface{},
 	historicalData []map[string]interface{},
 ) (*ValuationResponse, error) {
-	// Prepare request
-	reqBody := ValuationRequest{
+	body, version, err := c.post(ctx, "valuation", ValuationRequest{
 		TokenID:        tokenID,
 		Metadata:       metadata,
 		HistoricalData: historicalData,
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Create HTTP request
-	url := fmt.Sprintf("%s/api/v1/oracle/valuation", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+		SchemaVersion:  SchemaVersion,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Send request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response
-	body, err := io.ReadAll(resp.Body)
+	valuation, err := decodeValuation(version, body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("oracle service returned error: %s (status: %d)", string(body), resp.StatusCode)
-	}
-
-	// Parse response
-	var valuation ValuationResponse
-	if err := json.Unmarshal(body, &valuation); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if c.signer != nil {
+		if err := VerifyValuation(tokenID, valuation, *c.signer); err != nil {
+			return nil, err
+		}
 	}
 
-	return &valuation, nil
+	return valuation, nil
 }
 
 // FingerprintRequest represents a fingerprint generation request
 type FingerprintRequest struct {
-	ContentURL  string                 `json:"content_url"`
-	ContentType string                 `json:"content_type"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	ContentURL    string                 `json:"content_url"`
+	ContentType   string                 `json:"content_type"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	SchemaVersion int                    `json:"schema_version"`
 }
 
 // FingerprintResponse represents a fingerprint response
@@ -113,6 +103,16 @@ type FingerprintResponse struct {
 	ProcessingTimeMs float64                `json:"processing_time_ms"`
 }
 
+// Fingerprinter generates content fingerprints, as OracleClient does
+type Fingerprinter interface {
+	GenerateFingerprint(
+		ctx context.Context,
+		contentURL string,
+		contentType string,
+		metadata map[string]interface{},
+	) (*FingerprintResponse, error)
+}
+
 // GenerateFingerprint calls the Oracle Adapter to generate content fingerprint
 func (c *OracleClient) GenerateFingerprint(
 	ctx context.Context,
@@ -120,52 +120,67 @@ func (c *OracleClient) GenerateFingerprint(
 	contentType string,
 	metadata map[string]interface{},
 ) (*FingerprintResponse, error) {
-	// Prepare request
-	reqBody := FingerprintRequest{
-		ContentURL:  contentURL,
-		ContentType: contentType,
-		Metadata:    metadata,
+	// The fingerprint shape is the same in every schema version
+	body, _, err := c.post(ctx, "fingerprint", FingerprintRequest{
+		ContentURL:    contentURL,
+		ContentType:   contentType,
+		Metadata:      metadata,
+		SchemaVersion: SchemaVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fingerprint FingerprintResponse
+	if err := json.Unmarshal(body, &fingerprint); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	return &fingerprint, nil
+}
+
+// post sends a request to an oracle endpoint, negotiating the schema
+// version, and returns the response body with the version it is in
+func (c *OracleClient) post(ctx context.Context, endpoint string, reqBody interface{}) ([]byte, int, error) {
 	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+		return nil, 0, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
 	// Create HTTP request
-	url := fmt.Sprintf("%s/api/v1/oracle/fingerprint", c.baseURL)
+	url := fmt.Sprintf("%s/api/v1/oracle/%s", c.baseURL, endpoint)
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SchemaVersionHeader, strconv.Itoa(SchemaVersion))
 
 	// Send request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, 0, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read response
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, 0, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("oracle service returned error: %s (status: %d)", string(body), resp.StatusCode)
+		return nil, 0, fmt.Errorf("oracle service returned error: %s (status: %d)", string(body), resp.StatusCode)
 	}
 
-	// Parse response
-	var fingerprint FingerprintResponse
-	if err := json.Unmarshal(body, &fingerprint); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	version, err := responseVersion(resp.Header.Get(SchemaVersionHeader))
+	if err != nil {
+		return nil, 0, err
 	}
-
-	return &fingerprint, nil
+	metrics.OracleSchemaVersions.WithLabelValues(endpoint, strconv.Itoa(version)).Inc()
+	return body, version, nil
 }
 
 // HealthCheck checks if the Oracle Adapter service is healthy
@@ -0,0 +1,164 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// ErrCircuitOpen is returned without calling the oracle while its circuit
+// breaker is open
+var ErrCircuitOpen = errors.New("oracle circuit breaker open")
+
+// GuardConfig configures a Guard
+type GuardConfig struct {
+	Timeout          time.Duration // Per call, well under the client's own 30s
+	FailureThreshold int           // Consecutive failures that open the breaker
+	// Cooldown is how long the breaker stays open before one trial call is
+	// let through to see whether the oracle has recovered
+	Cooldown time.Duration
+	CacheTTL time.Duration // How long a token's valuation is reused; zero disables caching
+}
+
+// DefaultGuardConfig returns a 5s timeout, a breaker opening after 5
+// consecutive failures for 30s, and a 5 minute cache
+func DefaultGuardConfig() GuardConfig {
+	return GuardConfig{
+		Timeout:          5 * time.Second,
+		FailureThreshold: 5,
+		Cooldown:         30 * time.Second,
+		CacheTTL:         5 * time.Minute,
+	}
+}
+
+type cachedValuation struct {
+	valuation *ValuationResponse
+	expires   time.Time
+}
+
+// Guard wraps a Valuer with a circuit breaker and a per-token cache, so a
+// slow or failing oracle fails fast and repeated issuance attempts for the
+// same token don't call it again
+type Guard struct {
+	name   string
+	next   Valuer
+	config GuardConfig
+	now    func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool // A call is probing a breaker whose cooldown has passed
+	cache     map[string]cachedValuation
+}
+
+// NewGuard guards next, labelling its metrics with name
+func NewGuard(name string, next Valuer, config GuardConfig) *Guard {
+	return &Guard{
+		name:   name,
+		next:   next,
+		config: config,
+		now:    time.Now,
+		cache:  make(map[string]cachedValuation),
+	}
+}
+
+// EstimateValue returns the token's cached valuation if it is fresh, fails
+// fast while the breaker is open, and otherwise calls the oracle
+func (g *Guard) EstimateValue(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*ValuationResponse, error) {
+	if valuation, ok := g.cached(tokenID); ok {
+		return valuation, nil
+	}
+	if err := g.acquire(); err != nil {
+		return nil, err
+	}
+
+	if g.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.config.Timeout)
+		defer cancel()
+	}
+	valuation, err := g.next.EstimateValue(ctx, tokenID, metadata, historicalData)
+	g.release(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.config.CacheTTL > 0 {
+		g.mu.Lock()
+		g.cache[tokenID] = cachedValuation{valuation: valuation, expires: g.now().Add(g.config.CacheTTL)}
+		g.mu.Unlock()
+	}
+	return valuation, nil
+}
+
+// Open reports whether the breaker is rejecting calls
+func (g *Guard) Open() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.now().Before(g.openUntil) || g.trial
+}
+
+func (g *Guard) cached(tokenID string) (*ValuationResponse, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	entry, ok := g.cache[tokenID]
+	if !ok {
+		return nil, false
+	}
+	if !g.now().Before(entry.expires) {
+		delete(g.cache, tokenID)
+		return nil, false
+	}
+	return entry.valuation, true
+}
+
+// acquire lets a call through unless the breaker is open. Once the cooldown
+// passes a single trial call is let through; the rest keep failing fast
+// until it returns.
+func (g *Guard) acquire() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failures < g.config.FailureThreshold || g.config.FailureThreshold <= 0 {
+		return nil
+	}
+	if g.trial || g.now().Before(g.openUntil) {
+		return fmt.Errorf("%w: %s", ErrCircuitOpen, g.name)
+	}
+	g.trial = true
+	return nil
+}
+
+// release records a call's outcome, opening the breaker after too many
+// consecutive failures or closing it after a success. A caller over its
+// budget says nothing about the oracle's health.
+func (g *Guard) release(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.trial = false
+	if errors.Is(err, ErrBudgetExceeded) {
+		return
+	}
+	if err == nil {
+		if g.failures >= g.config.FailureThreshold && g.config.FailureThreshold > 0 {
+			metrics.OracleCircuitOpen.WithLabelValues(g.name).Set(0)
+		}
+		g.failures = 0
+		return
+	}
+
+	g.failures++
+	if g.failures >= g.config.FailureThreshold && g.config.FailureThreshold > 0 {
+		g.openUntil = g.now().Add(g.config.Cooldown)
+		metrics.OracleCircuitOpen.WithLabelValues(g.name).Set(1)
+	}
+}
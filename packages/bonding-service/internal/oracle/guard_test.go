@@ -0,0 +1,86 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// countingValuer counts calls and fails while err is set
+type countingValuer struct {
+	calls int
+	err   error
+}
+
+func (v *countingValuer) EstimateValue(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*ValuationResponse, error) {
+	v.calls++
+	if v.err != nil {
+		return nil, v.err
+	}
+	return &ValuationResponse{EstimatedValue: 100}, nil
+}
+
+func TestGuardBreaker(t *testing.T) {
+	next := &countingValuer{err: errors.New("oracle unavailable")}
+	now := time.Unix(1700000000, 0)
+	guard := NewGuard("test", next, GuardConfig{FailureThreshold: 2, Cooldown: 30 * time.Second})
+	guard.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := guard.EstimateValue(ctx, "token-1", nil, nil); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d error = %v, want the oracle's error", i, err)
+		}
+	}
+	if _, err := guard.EstimateValue(ctx, "token-1", nil, nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("error after %d failures = %v, want ErrCircuitOpen", next.calls, err)
+	}
+	if next.calls != 2 || !guard.Open() {
+		t.Fatalf("calls = %d, open = %v; want 2 calls and an open breaker", next.calls, guard.Open())
+	}
+
+	// After the cooldown a trial call goes through and closes the breaker
+	now = now.Add(31 * time.Second)
+	next.err = nil
+	if _, err := guard.EstimateValue(ctx, "token-1", nil, nil); err != nil {
+		t.Fatalf("trial call error = %v", err)
+	}
+	if next.calls != 3 || guard.Open() {
+		t.Errorf("calls = %d, open = %v; want 3 calls and a closed breaker", next.calls, guard.Open())
+	}
+}
+
+func TestGuardCache(t *testing.T) {
+	next := &countingValuer{}
+	now := time.Unix(1700000000, 0)
+	guard := NewGuard("test", next, GuardConfig{FailureThreshold: 5, CacheTTL: time.Minute})
+	guard.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	steps := []struct {
+		name      string
+		token     string
+		advance   time.Duration
+		wantCalls int
+	}{
+		{"first call", "token-1", 0, 1},
+		{"repeat served from cache", "token-1", 30 * time.Second, 1},
+		{"other token", "token-2", 0, 2},
+		{"expired", "token-1", time.Minute, 3},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		if _, err := guard.EstimateValue(ctx, step.token, nil, nil); err != nil {
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+		if next.calls != step.wantCalls {
+			t.Errorf("%s: calls = %d, want %d", step.name, next.calls, step.wantCalls)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBudgetExceeded is returned without calling the oracle once the caller
+// has spent its oracle budget
+var ErrBudgetExceeded = errors.New("oracle budget exceeded")
+
+// SpendMeter prices oracle calls and enforces budgets. The caller being
+// billed is taken from the context.
+type SpendMeter interface {
+	// Allow returns an error wrapping ErrBudgetExceeded once the caller may
+	// not spend more
+	Allow(ctx context.Context) error
+	// Record charges the caller for one call to provider
+	Record(ctx context.Context, provider string)
+}
+
+// Metered charges every call that reaches the provider to a SpendMeter.
+// Wrap it in a Guard so cached valuations aren't charged.
+type Metered struct {
+	provider string
+	next     Valuer
+	meter    SpendMeter
+}
+
+// NewMetered meters calls to next as calls to provider
+func NewMetered(provider string, next Valuer, meter SpendMeter) *Metered {
+	return &Metered{provider: provider, next: next, meter: meter}
+}
+
+// EstimateValue calls the provider if the caller's budget allows. Failed calls
+// are charged too, since the provider may have done the work.
+func (m *Metered) EstimateValue(
+	ctx context.Context,
+	tokenID string,
+	metadata map[string]interface{},
+	historicalData []map[string]interface{},
+) (*ValuationResponse, error) {
+	if err := m.meter.Allow(ctx); err != nil {
+		return nil, err
+	}
+	valuation, err := m.next.EstimateValue(ctx, tokenID, metadata, historicalData)
+	m.meter.Record(ctx, m.provider)
+	return valuation, err
+}
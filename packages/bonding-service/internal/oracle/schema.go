@@ -0,0 +1,96 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Schema versions of oracle requests and responses. The client asks for
+// SchemaVersion in the SchemaVersionHeader; the oracle answers in that
+// version or an older one and names it in the same header. Oracles predating
+// negotiation send no header and answer in version 1.
+const (
+	SchemaVersionHeader = "X-Oracle-Schema-Version"
+	MinSchemaVersion    = 1
+	SchemaVersion       = 2
+)
+
+// ErrUnsupportedSchema is returned for a response in a schema version the
+// client can't read
+var ErrUnsupportedSchema = errors.New("unsupported oracle schema version")
+
+// responseVersion reads the schema version of a response from its header.
+// A version newer than SchemaVersion is read as SchemaVersion: versions only
+// add fields within a shape, and unknown fields are ignored.
+func responseVersion(header string) (int, error) {
+	if header == "" {
+		return MinSchemaVersion, nil
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil || version < MinSchemaVersion {
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedSchema, header)
+	}
+	return min(version, SchemaVersion), nil
+}
+
+// valuationV2 is the version 2 valuation shape: the interval bounds are
+// named and model details are grouped
+type valuationV2 struct {
+	EstimatedValue     float64 `json:"estimated_value"`
+	ConfidenceInterval struct {
+		Lower float64 `json:"lower"`
+		Upper float64 `json:"upper"`
+	} `json:"confidence_interval"`
+	ComparableSales []map[string]interface{} `json:"comparable_sales"`
+	Factors         map[string]interface{}   `json:"factors"`
+	Model           struct {
+		Uncertainty float64 `json:"uncertainty"`
+		Version     string  `json:"version"`
+	} `json:"model"`
+	ProcessingTimeMs float64 `json:"processing_time_ms"`
+	Signature        string  `json:"signature,omitempty"`
+}
+
+// valuationDecoders translate each schema version into a ValuationResponse.
+// Version 1 is the flat shape ValuationResponse itself mirrors.
+var valuationDecoders = map[int]func([]byte) (*ValuationResponse, error){
+	1: func(body []byte) (*ValuationResponse, error) {
+		var valuation ValuationResponse
+		if err := json.Unmarshal(body, &valuation); err != nil {
+			return nil, err
+		}
+		return &valuation, nil
+	},
+	2: func(body []byte) (*ValuationResponse, error) {
+		var v2 valuationV2
+		if err := json.Unmarshal(body, &v2); err != nil {
+			return nil, err
+		}
+		return &ValuationResponse{
+			EstimatedValue:     v2.EstimatedValue,
+			ConfidenceInterval: []float64{v2.ConfidenceInterval.Lower, v2.ConfidenceInterval.Upper},
+			ComparableSales:    v2.ComparableSales,
+			Factors:            v2.Factors,
+			ModelUncertainty:   v2.Model.Uncertainty,
+			ModelVersion:       v2.Model.Version,
+			ProcessingTimeMs:   v2.ProcessingTimeMs,
+			Signature:          v2.Signature,
+		}, nil
+	},
+}
+
+// decodeValuation decodes a valuation response in the given schema version
+func decodeValuation(version int, body []byte) (*ValuationResponse, error) {
+	decode, ok := valuationDecoders[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSchema, version)
+	}
+	valuation, err := decode(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version %d response: %w", version, err)
+	}
+	return valuation, nil
+}
@@ -0,0 +1,66 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestEstimateValueSchemaVersions(t *testing.T) {
+	want := &ValuationResponse{
+		EstimatedValue:     125000.5,
+		ConfidenceInterval: []float64{100000, 150000},
+		Factors:            map[string]interface{}{"views": 0.4},
+		ModelUncertainty:   0.15,
+		ProcessingTimeMs:   12,
+	}
+	withModel := *want
+	withModel.ModelVersion = "gbm-7"
+
+	tests := []struct {
+		name    string
+		version string // Response header; empty for an oracle predating negotiation
+		body    string
+		want    *ValuationResponse
+		wantErr error
+	}{
+		{"version 1 without header", "", `{"estimated_value": 125000.5, "confidence_interval": [100000, 150000],
+			"factors": {"views": 0.4}, "model_uncertainty": 0.15, "processing_time_ms": 12}`, want, nil},
+		{"version 1 with unknown fields", "1", `{"estimated_value": 125000.5, "confidence_interval": [100000, 150000],
+			"factors": {"views": 0.4}, "model_uncertainty": 0.15, "processing_time_ms": 12, "currency": "USD"}`, want, nil},
+		{"version 2", "2", `{"estimated_value": 125000.5, "confidence_interval": {"lower": 100000, "upper": 150000},
+			"factors": {"views": 0.4}, "model": {"uncertainty": 0.15, "version": "gbm-7"}, "processing_time_ms": 12}`, &withModel, nil},
+		{"newer version read as the newest known", "3", `{"estimated_value": 125000.5, "confidence_interval": {"lower": 100000, "upper": 150000},
+			"factors": {"views": 0.4}, "model": {"uncertainty": 0.15, "version": "gbm-7", "features": 42}, "processing_time_ms": 12,
+			"provenance": {"dataset": "sales-2026"}}`, &withModel, nil},
+		{"version 0", "0", `{}`, nil, ErrUnsupportedSchema},
+		{"malformed version", "v2", `{}`, nil, ErrUnsupportedSchema},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get(SchemaVersionHeader); got != "2" {
+					t.Errorf("request %s = %q, want 2", SchemaVersionHeader, got)
+				}
+				if tt.version != "" {
+					w.Header().Set(SchemaVersionHeader, tt.version)
+				}
+				io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			got, err := NewOracleClient(server.URL).EstimateValue(context.Background(), "token-1", nil, nil)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("EstimateValue() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EstimateValue() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Errors returned for valuations that fail signature verification
+var (
+	ErrUnsignedValuation = errors.New("oracle valuation is not signed")
+	ErrInvalidSignature  = errors.New("oracle valuation signature is invalid")
+)
+
+// ValuationMessage is the text the oracle signs with personal_sign (EIP-191)
+// to vouch for a valuation of tokenID. Numbers are written in plain decimal
+// with the fewest digits that round-trip, e.g. 125000.5 and 0.15.
+func ValuationMessage(tokenID string, v *ValuationResponse) []byte {
+	low, high := bounds(v)
+	return []byte(fmt.Sprintf("KnowTon oracle valuation\nToken: %s\nValue: %s\nConfidence: %s-%s\nUncertainty: %s",
+		tokenID, formatFloat(v.EstimatedValue), formatFloat(low), formatFloat(high), formatFloat(v.ModelUncertainty)))
+}
+
+// VerifyValuation checks a valuation of tokenID was signed by signer. A
+// signature over another token's valuation, or over different figures, is
+// rejected.
+func VerifyValuation(tokenID string, v *ValuationResponse, signer common.Address) error {
+	if v.Signature == "" {
+		return ErrUnsignedValuation
+	}
+	sig, err := hexutil.Decode(v.Signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("%w: want %d hex-encoded bytes", ErrInvalidSignature, crypto.SignatureLength)
+	}
+
+	// Wallets produce v as 27/28; crypto expects 0/1
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(ValuationMessage(tokenID, v)), sig)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pubKey); recovered != signer {
+		return fmt.Errorf("%w: signed by %s, not %s", ErrInvalidSignature, recovered.Hex(), signer.Hex())
+	}
+	return nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,66 @@
+package oracle
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestEstimateValueVerifiesSignature(t *testing.T) {
+	oracleKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(oracleKey.PublicKey)
+
+	signed := func(tokenID string, v ValuationResponse, key *ecdsa.PrivateKey) ValuationResponse {
+		t.Helper()
+		sig, err := crypto.Sign(accounts.TextHash(ValuationMessage(tokenID, &v)), key)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		sig[64] += 27 // Wallet-style v
+		v.Signature = hexutil.Encode(sig)
+		return v
+	}
+	valuation := ValuationResponse{EstimatedValue: 125000.5, ConfidenceInterval: []float64{100000, 150000}, ModelUncertainty: 0.15}
+	tampered := signed("token-1", valuation, oracleKey)
+	tampered.EstimatedValue = 900000
+
+	tests := []struct {
+		name     string
+		response ValuationResponse
+		wantErr  error
+	}{
+		{"signed by the oracle", signed("token-1", valuation, oracleKey), nil},
+		{"unsigned", valuation, ErrUnsignedValuation},
+		{"tampered", tampered, ErrInvalidSignature},
+		{"signed for another token", signed("token-2", valuation, oracleKey), ErrInvalidSignature},
+		{"signed by another key", signed("token-1", valuation, otherKey), ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(tt.response)
+			}))
+			defer server.Close()
+
+			client := NewOracleClient(server.URL)
+			client.RequireSigner(signer)
+			got, err := client.EstimateValue(context.Background(), "token-1", nil, nil)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("EstimateValue() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.EstimatedValue != valuation.EstimatedValue {
+				t.Errorf("EstimatedValue = %v, want %v", got.EstimatedValue, valuation.EstimatedValue)
+			}
+		})
+	}
+}
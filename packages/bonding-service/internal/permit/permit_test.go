@@ -0,0 +1,56 @@
+package permit
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestVerify(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	domain := Domain{
+		Name:              "USD Coin",
+		Version:           "2",
+		ChainID:           big.NewInt(42161),
+		VerifyingContract: common.HexToAddress("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+	}
+	p := Permit{
+		Owner:    crypto.PubkeyToAddress(key.PublicKey),
+		Spender:  common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Value:    big.NewInt(1000000),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(time.Now().Add(time.Hour).Unix()),
+	}
+
+	hash, err := Hash(domain, p)
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	signature[64] += 27 // Wallet-style v
+
+	if err := Verify(domain, p, signature); err != nil {
+		t.Errorf("Verify() error = %v, want nil", err)
+	}
+
+	tampered := p
+	tampered.Value = big.NewInt(2000000)
+	if err := Verify(domain, tampered, signature); err == nil {
+		t.Errorf("Verify() with tampered value should fail")
+	}
+
+	v, _, _, err := Split(signature)
+	if err != nil || (v != 27 && v != 28) {
+		t.Errorf("Split() v = %d, error = %v", v, err)
+	}
+}
@@ -0,0 +1,104 @@
+// Package pricefeed reads USD prices from Chainlink aggregator contracts.
+package pricefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultMaxAge covers the one day heartbeat of Chainlink's ETH/USD feeds
+// with some slack for late rounds
+const DefaultMaxAge = 25 * time.Hour
+
+// ErrStale is returned when the feed has not been updated within its max age
+var ErrStale = errors.New("price feed answer is stale")
+
+const aggregatorABI = `[
+	{"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"latestRoundData","outputs":[
+		{"name":"roundId","type":"uint80"},
+		{"name":"answer","type":"int256"},
+		{"name":"startedAt","type":"uint256"},
+		{"name":"updatedAt","type":"uint256"},
+		{"name":"answeredInRound","type":"uint80"}
+	],"stateMutability":"view","type":"function"}
+]`
+
+var parsedABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(aggregatorABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// Price is a feed's latest answer
+type Price struct {
+	USD       float64
+	UpdatedAt time.Time
+}
+
+// Feed reads an AggregatorV3Interface contract
+type Feed struct {
+	caller  ethereum.ContractCaller
+	address common.Address
+	MaxAge  time.Duration // Older answers fail with ErrStale; zero disables the check
+}
+
+// New creates a feed for the aggregator at address
+func New(caller ethereum.ContractCaller, address string) *Feed {
+	return &Feed{
+		caller:  caller,
+		address: common.HexToAddress(address),
+		MaxAge:  DefaultMaxAge,
+	}
+}
+
+// Latest returns the feed's latest answer
+func (f *Feed) Latest(ctx context.Context) (Price, error) {
+	decimals, err := f.call(ctx, "decimals")
+	if err != nil {
+		return Price{}, err
+	}
+	round, err := f.call(ctx, "latestRoundData")
+	if err != nil {
+		return Price{}, err
+	}
+
+	answer := round[1].(*big.Int)
+	if answer.Sign() <= 0 {
+		return Price{}, fmt.Errorf("price feed %s answered %s", f.address.Hex(), answer)
+	}
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals[0].(uint8))), nil))
+	usd, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), scale).Float64()
+
+	price := Price{USD: usd, UpdatedAt: time.Unix(round[3].(*big.Int).Int64(), 0)}
+	if f.MaxAge > 0 && time.Since(price.UpdatedAt) > f.MaxAge {
+		return price, fmt.Errorf("%w: updated %s", ErrStale, price.UpdatedAt.UTC().Format(time.RFC3339))
+	}
+	return price, nil
+}
+
+func (f *Feed) call(ctx context.Context, method string) ([]interface{}, error) {
+	data, err := parsedABI.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+	out, err := f.caller.CallContract(ctx, ethereum.CallMsg{To: &f.address, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s on price feed %s: %w", method, f.address.Hex(), err)
+	}
+	values, err := parsedABI.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s from price feed %s: %w", method, f.address.Hex(), err)
+	}
+	return values, nil
+}
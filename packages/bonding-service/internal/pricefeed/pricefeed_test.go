@@ -0,0 +1,64 @@
+package pricefeed
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// aggregator answers decimals and latestRoundData calls
+type aggregator struct {
+	decimals  uint8
+	answer    int64
+	updatedAt time.Time
+}
+
+func (a *aggregator) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	method, err := parsedABI.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "decimals" {
+		return method.Outputs.Pack(a.decimals)
+	}
+	return method.Outputs.Pack(big.NewInt(1), big.NewInt(a.answer), big.NewInt(0), big.NewInt(a.updatedAt.Unix()), big.NewInt(1))
+}
+
+func (a *aggregator) CodeAt(ctx context.Context, contract common.Address, block *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func TestLatest(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		feed    aggregator
+		wantUSD float64
+		wantErr bool
+	}{
+		{"eth usd", aggregator{8, 312345000000, now}, 3123.45, false},
+		{"stale", aggregator{8, 312345000000, now.Add(-48 * time.Hour)}, 3123.45, true},
+		{"negative", aggregator{8, -1, now}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := New(&tt.feed, "0x639Fe6ab55C921f74e7fac1ee960C0B6293ba612").Latest(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Latest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrStale) != (tt.name == "stale") {
+				t.Errorf("Latest() error = %v, stale %v", err, tt.name == "stale")
+			}
+			if math.Abs(price.USD-tt.wantUSD) > 1e-9 {
+				t.Errorf("USD = %v, want %v", price.USD, tt.wantUSD)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package prospectus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// ContentType is the MIME type of a rendered prospectus
+const ContentType = "text/markdown"
+
+// Prospectus is the data rendered into a bond's offering document
+type Prospectus struct {
+	Bond        *models.Bond // With tranches loaded
+	License     *models.LicenseAgreement
+	Assessment  *models.RiskAssessment // Latest assessment of the IP, if any
+	GeneratedAt time.Time
+}
+
+var funcs = template.FuncMap{
+	"date": func(t time.Time) string { return t.UTC().Format("2006-01-02") },
+	"bps": func(bps int64) string {
+		return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", float64(bps)/100), "0"), ".") + "%"
+	},
+	"pct":     func(f float64) string { return fmt.Sprintf("%.1f%%", f*100) },
+	"list":    func(csv string) string { return strings.ReplaceAll(csv, ",", ", ") },
+	"factors": riskFactors,
+}
+
+var tmpl = template.Must(template.New("prospectus").Funcs(funcs).Parse(`# Bond {{.Bond.BondID}} Prospectus
+
+Generated {{date .GeneratedAt}}
+
+## Bond Terms
+
+| Term | Value |
+|---|---|
+| Bond ID | {{.Bond.BondID}} |
+| Chain | {{.Bond.Chain}} |
+| IP-NFT | {{.Bond.IPNFTId}} ({{.Bond.NFTContract}}) |
+| Issuer | {{.Bond.Issuer}} |
+| Total value | {{.Bond.TotalValue}} |
+| Maturity | {{date .Bond.MaturityDate}} |
+| Status | {{.Bond.Status}} |
+
+## Tranches
+
+| Tranche | Priority | Allocation | APY | Risk |
+|---|---|---|---|---|
+{{range .Bond.Tranches}}| {{.Name}} | {{.Priority}} | {{.Allocation}} | {{.APY}}% | {{.RiskLevel}} |
+{{end}}
+{{- with .Bond}}{{if .RegistrationNumber}}
+## Registration
+
+{{.RegistrationKind}} {{.RegistrationNumber}} ({{.RegistrationJurisdiction}}){{if .RegistrationExpiresAt}}, expiring {{date .RegistrationExpiresAt}}{{end}}.
+{{if .RegistrationVerified}}Verified against the official registry.{{else}}Not verified against the official registry.{{end}}
+{{end}}{{end}}
+{{- with .License}}
+## License Agreement
+
+Revenue is generated by a royalty of {{bps .RoyaltyBps}} of the licensee's revenue under the following agreement.
+
+| Term | Value |
+|---|---|
+| Licensor | {{.Licensor}} |
+| Licensee | {{.Licensee}} |
+| Term | {{date .StartsAt}} to {{if .EndsAt}}{{date .EndsAt}}{{else}}perpetual{{end}} |
+| Territory | {{list .Territories}} |
+| Exclusive | {{if .Exclusive}}Yes{{else}}No{{end}} |
+{{if .DocumentHash}}| Agreement SHA-256 | {{.DocumentHash}} |
+{{end}}| Terms hash | {{.TermsHash}} |
+
+{{if .RightsAssigned}}The licensor assigned the revenue rights to the issuer; the signed assignment was verified at issuance.{{else}}The issuer holds the revenue rights as licensor.{{end}}
+{{end}}
+{{- with .Assessment}}
+## Risk Assessment
+
+Assessed {{date .AssessedAt}}: rating **{{.RiskRating}}**, valuation ${{printf "%.2f" .ValuationUSD}}, default probability {{pct .DefaultProbability}}, recommended LTV {{pct .RecommendedLTV}}.
+{{with factors .RiskFactors}}
+Risk factors:
+{{range .}}
+- {{.}}{{end}}
+{{end}}{{end}}`))
+
+// Write renders the prospectus as Markdown
+func Write(w io.Writer, p *Prospectus) error {
+	if err := tmpl.Execute(w, p); err != nil {
+		return fmt.Errorf("failed to render prospectus: %w", err)
+	}
+	return nil
+}
+
+// riskFactors decodes an assessment's JSON factor list
+func riskFactors(encoded string) []string {
+	var factors []string
+	if err := json.Unmarshal([]byte(encoded), &factors); err != nil {
+		return nil
+	}
+	return factors
+}
@@ -0,0 +1,90 @@
+package prospectus
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestWrite(t *testing.T) {
+	maturity := time.Date(2029, 6, 30, 0, 0, 0, 0, time.UTC)
+	bond := &models.Bond{
+		BondID:       "42",
+		Issuer:       "0x2000000000000000000000000000000000000002",
+		TotalValue:   "1000000",
+		MaturityDate: maturity,
+		Status:       "ACTIVE",
+		Tranches: []models.Tranche{
+			{Name: "Senior", Priority: 0, Allocation: "500000", APY: 5, RiskLevel: "LOW"},
+			{Name: "Junior", Priority: 2, Allocation: "500000", APY: 15, RiskLevel: "HIGH"},
+		},
+	}
+	agreement := &models.LicenseAgreement{
+		Licensor:       "0x1000000000000000000000000000000000000001",
+		Licensee:       "Streaming Co",
+		RoyaltyBps:     1250,
+		StartsAt:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Territories:    "GB,US",
+		Exclusive:      true,
+		TermsHash:      "0xabc",
+		RightsAssigned: true,
+	}
+	assessment := &models.RiskAssessment{
+		RiskRating:  "A",
+		RiskFactors: `["Non-exclusive license"]`,
+		AssessedAt:  maturity,
+	}
+
+	tests := []struct {
+		name       string
+		p          Prospectus
+		want       []string
+		wantAbsent []string
+	}{
+		{
+			name: "licensed",
+			p:    Prospectus{Bond: bond, License: agreement, Assessment: assessment},
+			want: []string{
+				"| Junior | 2 | 500000 | 15% | HIGH |",
+				"royalty of 12.5% of the licensee's revenue",
+				"| Licensee | Streaming Co |",
+				"| Term | 2025-01-01 to perpetual |",
+				"| Territory | GB, US |",
+				"| Exclusive | Yes |",
+				"assigned the revenue rights to the issuer",
+				"rating **A**",
+				"- Non-exclusive license",
+			},
+			wantAbsent: []string{"## Registration", "Agreement SHA-256"},
+		},
+		{
+			name:       "unlicensed and unassessed",
+			p:          Prospectus{Bond: bond},
+			want:       []string{"| Maturity | 2029-06-30 |"},
+			wantAbsent: []string{"## License Agreement", "## Risk Assessment"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, &tt.p); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			out := buf.String()
+			for _, s := range tt.want {
+				if !strings.Contains(out, s) {
+					t.Errorf("prospectus is missing %q:\n%s", s, out)
+				}
+			}
+			for _, s := range tt.wantAbsent {
+				if strings.Contains(out, s) {
+					t.Errorf("prospectus unexpectedly contains %q", s)
+				}
+			}
+		})
+	}
+}
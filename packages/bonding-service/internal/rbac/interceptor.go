@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"context"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor refuses calls the caller's roles don't permit:
+// UNAUTHENTICATED without a principal, PERMISSION_DENIED with one. A
+// principal already in the context is used, otherwise resolve's. The
+// principal and its tenant are attached to the context passed on; a call
+// naming another tenant in x-tenant-id is PERMISSION_DENIED.
+func UnaryServerInterceptor(perms Permissions, resolve func(ctx context.Context) *Principal) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		principal, ok := FromContext(ctx)
+		if !ok && resolve != nil {
+			if principal = resolve(ctx); principal != nil {
+				ctx = NewContext(ctx, principal)
+			}
+		}
+		if !perms.Allowed(info.FullMethod, principal) {
+			metrics.AuthorizationDenials.WithLabelValues(info.FullMethod).Inc()
+			if principal == nil {
+				return nil, status.Errorf(codes.Unauthenticated, "%s requires credentials", info.FullMethod)
+			}
+			log.Printf("Refused %s to %s with roles %v", info.FullMethod, principal.ID, principal.Roles)
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not permitted for roles %v", info.FullMethod, principal.Roles)
+		}
+		if principal == nil {
+			return handler(ctx, req)
+		}
+
+		own := principal.TenantID()
+		if requested, ok := tenant.Requested(ctx); ok && requested != own {
+			metrics.AuthorizationDenials.WithLabelValues(info.FullMethod).Inc()
+			log.Printf("Refused %s to %s of tenant %s acting for %s", info.FullMethod, principal.ID, own, requested)
+			return nil, status.Errorf(codes.PermissionDenied, "credentials belong to tenant %s, not %s", own, requested)
+		}
+		return handler(tenant.NewContext(ctx, own), req)
+	}
+}
@@ -0,0 +1,155 @@
+// Package rbac authorizes gRPC calls by role. Every method is mapped to the
+// roles that may call it and methods without a mapping are refused, so a new
+// RPC can't be called until it is given roles.
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/usage"
+)
+
+// Role is what a caller may do
+type Role string
+
+// Roles
+const (
+	Issuer   Role = "issuer"   // Issues bonds and distributes their revenue
+	Investor Role = "investor" // Invests in, trades and redeems positions
+	Operator Role = "operator" // Runs the service: transactions, maintenance, lists
+	Auditor  Role = "auditor"  // Reads ledgers, reports and history
+)
+
+// Anyone marks a method any caller may use, without credentials
+const Anyone Role = "*"
+
+var roles = []Role{Issuer, Investor, Operator, Auditor}
+
+// Valid reports whether r is one of the roles
+func (r Role) Valid() bool {
+	return slices.Contains(roles, r)
+}
+
+// Principal is an authenticated caller
+type Principal struct {
+	ID     string // API key fingerprint, wallet address or OIDC subject
+	Method string // How the caller authenticated, e.g. api_key
+	Roles  []Role
+	Tenant string // Tenant the credentials belong to; empty for the default
+}
+
+// TenantID returns the tenant the principal acts for
+func (p *Principal) TenantID() string {
+	if p.Tenant == "" {
+		return tenant.Default
+	}
+	return p.Tenant
+}
+
+// Has reports whether the principal holds role
+func (p *Principal) Has(role Role) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the caller's principal
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the caller's principal, if authenticated
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// PrincipalID returns the ID of the caller's principal, or "" for an
+// anonymous call
+func PrincipalID(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.ID
+	}
+	return ""
+}
+
+// Permissions maps full gRPC method names to the roles that may call them
+type Permissions map[string][]Role
+
+// Allowed reports whether principal, nil for an unauthenticated caller, may
+// call method
+func (p Permissions) Allowed(method string, principal *Principal) bool {
+	for _, role := range p[method] {
+		if role == Anyone || (principal != nil && principal.Has(role)) {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyGrant is what an API key may do, and for which tenant
+type KeyGrant struct {
+	Roles  []Role
+	Tenant string
+}
+
+// KeyRoles grants roles to API keys, by their usage.KeyID fingerprint
+type KeyRoles map[string]KeyGrant
+
+// LoadKeyRoles reads a JSON file of the form
+// {"keys": [{"key_id": "...", "roles": ["operator"], "tenant": "acme"}]}
+// Keys without a tenant belong to the default tenant.
+func LoadKeyRoles(path string) (KeyRoles, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API key roles: %w", err)
+	}
+	var file struct {
+		Keys []struct {
+			KeyID  string `json:"key_id"`
+			Roles  []Role `json:"roles"`
+			Tenant string `json:"tenant"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse API key roles: %w", err)
+	}
+
+	keys := make(KeyRoles, len(file.Keys))
+	for _, k := range file.Keys {
+		if k.KeyID == "" {
+			return nil, fmt.Errorf("API key roles entry without a key_id")
+		}
+		if _, dup := keys[k.KeyID]; dup {
+			return nil, fmt.Errorf("duplicate roles for API key %s", k.KeyID)
+		}
+		for _, role := range k.Roles {
+			if !role.Valid() {
+				return nil, fmt.Errorf("API key %s has unknown role %q", k.KeyID, role)
+			}
+		}
+		keys[k.KeyID] = KeyGrant{Roles: k.Roles, Tenant: strings.TrimSpace(k.Tenant)}
+	}
+	return keys, nil
+}
+
+// Principal returns the principal of the caller's API key, or nil if the
+// call has no key or the key has no roles
+func (k KeyRoles) Principal(ctx context.Context) *Principal {
+	key := usage.KeyFromContext(ctx)
+	if key == "" {
+		return nil
+	}
+	id := usage.KeyID(key)
+	grant, ok := k[id]
+	if !ok {
+		return nil
+	}
+	return &Principal{ID: id, Method: "api_key", Roles: grant.Roles, Tenant: grant.Tenant}
+}
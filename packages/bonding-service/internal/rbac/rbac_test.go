@@ -0,0 +1,133 @@
+package rbac
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/usage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var perms = Permissions{
+	"/svc/IssueBond":   {Issuer, Operator},
+	"/svc/GetBondInfo": {Issuer, Investor, Operator, Auditor},
+	"/svc/Health":      {Anyone},
+}
+
+func TestAllowed(t *testing.T) {
+	issuer := &Principal{ID: "a", Roles: []Role{Issuer}}
+	auditor := &Principal{ID: "b", Roles: []Role{Auditor}}
+
+	tests := []struct {
+		name      string
+		method    string
+		principal *Principal
+		want      bool
+	}{
+		{"role permitted", "/svc/IssueBond", issuer, true},
+		{"role not permitted", "/svc/IssueBond", auditor, false},
+		{"unauthenticated", "/svc/GetBondInfo", nil, false},
+		{"anyone", "/svc/Health", nil, true},
+		{"unmapped method", "/svc/Unknown", issuer, false},
+		{"no roles", "/svc/GetBondInfo", &Principal{ID: "c"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := perms.Allowed(tt.method, tt.principal); got != tt.want {
+				t.Errorf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadKeyRoles(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+	}{
+		{"valid", `{"keys": [{"key_id": "k1", "roles": ["issuer", "auditor"], "tenant": "acme"}]}`, false},
+		{"unknown role", `{"keys": [{"key_id": "k1", "roles": ["admin"]}]}`, true},
+		{"missing key_id", `{"keys": [{"roles": ["issuer"]}]}`, true},
+		{"duplicate key", `{"keys": [{"key_id": "k1", "roles": ["issuer"]}, {"key_id": "k1", "roles": []}]}`, true},
+		{"invalid JSON", `{"keys": `, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "roles.json")
+			if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			keys, err := LoadKeyRoles(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadKeyRoles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (len(keys["k1"].Roles) != 2 || keys["k1"].Tenant != "acme") {
+				t.Errorf("LoadKeyRoles() grant = %+v, want 2 roles for acme", keys["k1"])
+			}
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	keys := KeyRoles{
+		usage.KeyID("issuer-key"):  {Roles: []Role{Issuer}, Tenant: "acme"},
+		usage.KeyID("auditor-key"): {Roles: []Role{Auditor}},
+	}
+	interceptor := UnaryServerInterceptor(perms, keys.Principal)
+
+	tests := []struct {
+		name       string
+		key        string
+		tenant     string
+		method     string
+		wantCode   codes.Code
+		wantTenant string
+	}{
+		{"permitted", "issuer-key", "", "/svc/IssueBond", codes.OK, "acme"},
+		{"own tenant named", "issuer-key", "acme", "/svc/IssueBond", codes.OK, "acme"},
+		{"other tenant named", "issuer-key", "globex", "/svc/IssueBond", codes.PermissionDenied, ""},
+		{"default tenant", "auditor-key", "", "/svc/GetBondInfo", codes.OK, tenant.Default},
+		{"default key naming a tenant", "auditor-key", "acme", "/svc/GetBondInfo", codes.PermissionDenied, ""},
+		{"not permitted", "auditor-key", "", "/svc/IssueBond", codes.PermissionDenied, ""},
+		{"unknown key", "other-key", "", "/svc/IssueBond", codes.Unauthenticated, ""},
+		{"no key", "", "", "/svc/GetBondInfo", codes.Unauthenticated, ""},
+		{"anyone without a key", "", "", "/svc/Health", codes.OK, tenant.Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.key != "" {
+				md.Set(usage.MetadataKey, tt.key)
+			}
+			if tt.tenant != "" {
+				md.Set(tenant.MetadataKey, tt.tenant)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			var seen *Principal
+			var seenTenant string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				seen, _ = FromContext(ctx)
+				seenTenant = tenant.FromContext(ctx)
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode == codes.OK && tt.key != "" && (seen == nil || seen.ID != usage.KeyID(tt.key)) {
+				t.Errorf("handler principal = %+v, want key %s", seen, usage.KeyID(tt.key))
+			}
+			if tt.wantCode == codes.OK && seenTenant != tt.wantTenant {
+				t.Errorf("handler tenant = %q, want %q", seenTenant, tt.wantTenant)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package reassess periodically re-runs the risk assessment of active bonds,
+// so a rating reflects the IP's current registration, license and
+// counterparties rather than the day it was issued.
+package reassess
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/timeline"
+	"gorm.io/gorm"
+)
+
+// Assessor assesses a bond's IP-NFT afresh
+type Assessor interface {
+	ReassessBond(ctx context.Context, bond *models.Bond) (*models.RiskAssessment, error)
+}
+
+// Config controls the reassessment job
+type Config struct {
+	Interval  time.Duration // How often every active bond is reassessed
+	BatchSize int           // Bonds loaded per query
+	// AlertNotches is how many notches a rating may drop in one reassessment
+	// before an alert is raised and a covenant event recorded
+	AlertNotches int
+}
+
+// DefaultConfig returns default reassessment configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:     24 * time.Hour,
+		BatchSize:    100,
+		AlertNotches: 1,
+	}
+}
+
+// Change is a rating that moved in a reassessment
+type Change struct {
+	BondID  string
+	From    string
+	To      string
+	Notches int  // Positive for a downgrade, zero when either rating is unknown
+	Alerted bool // The downgrade passed the alert threshold
+}
+
+// Report is the result of a reassessment run
+type Report struct {
+	StartedAt     time.Time
+	FinishedAt    time.Time
+	BondsAssessed int
+	BondsFailed   int
+	Changes       []Change
+}
+
+// Reassessor re-runs the risk assessment of active bonds and adds the result
+// to their assessment history
+type Reassessor struct {
+	db       *gorm.DB
+	assessor Assessor
+	config   Config
+
+	runMu sync.Mutex // Serializes runs
+}
+
+// New creates a reassessor
+func New(db *gorm.DB, assessor Assessor, config Config) *Reassessor {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultConfig().BatchSize
+	}
+	return &Reassessor{db: db, assessor: assessor, config: config}
+}
+
+// Start reassesses on every interval until the context is cancelled
+func (r *Reassessor) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Run(ctx); err != nil {
+				log.Printf("Risk reassessment failed: %v", err)
+			}
+		}
+	}
+}
+
+// Run reassesses every active bond. A bond that can't be assessed keeps its
+// previous assessment.
+func (r *Reassessor) Run(ctx context.Context) (*Report, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	report := &Report{StartedAt: time.Now()}
+	var lastID uint
+	for {
+		var bonds []models.Bond
+		err := r.db.WithContext(ctx).
+			Where("id > ? AND status = ?", lastID, "ACTIVE").
+			Order("id ASC").
+			Limit(r.config.BatchSize).
+			Find(&bonds).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bonds: %w", err)
+		}
+		if len(bonds) == 0 {
+			break
+		}
+		lastID = bonds[len(bonds)-1].ID
+
+		for i := range bonds {
+			change, err := r.reassessBond(ctx, &bonds[i])
+			if err != nil {
+				log.Printf("Failed to reassess bond %s: %v", bonds[i].BondID, err)
+				report.BondsFailed++
+				continue
+			}
+			report.BondsAssessed++
+			if change != nil {
+				report.Changes = append(report.Changes, *change)
+			}
+		}
+
+		if len(bonds) < r.config.BatchSize {
+			break
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	log.Printf("Reassessed %d bonds (%d failed, %d rating changes)", report.BondsAssessed, report.BondsFailed, len(report.Changes))
+	return report, nil
+}
+
+// reassessBond appends a new assessment to the bond's history and records a
+// changed rating on its timeline, returning the change or nil when the rating
+// held
+func (r *Reassessor) reassessBond(ctx context.Context, bond *models.Bond) (*Change, error) {
+	previous, err := repository.LatestAssessment(ctx, r.db, bond.IPNFTId)
+	if err != nil {
+		return nil, err
+	}
+	assessment, err := r.assessor.ReassessBond(ctx, bond)
+	if err != nil {
+		return nil, err
+	}
+
+	var change *Change
+	if previous != nil && previous.RiskRating != assessment.RiskRating {
+		change = &Change{BondID: bond.BondID, From: previous.RiskRating, To: assessment.RiskRating}
+		from, fromOK := risk.ParseRating(change.From)
+		to, toOK := risk.ParseRating(change.To)
+		if fromOK && toOK {
+			change.Notches = int(to) - int(from)
+		}
+		change.Alerted = change.Notches > r.config.AlertNotches
+	}
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(assessment).Error; err != nil {
+			return fmt.Errorf("failed to save risk assessment: %w", err)
+		}
+		if change == nil {
+			return nil
+		}
+		if err := timeline.Record(tx, bond.BondID, timeline.RatingChanged,
+			fmt.Sprintf("%s to %s", change.From, change.To)); err != nil {
+			return err
+		}
+		if change.Alerted {
+			return timeline.Record(tx, bond.BondID, timeline.Covenant,
+				fmt.Sprintf("rating downgraded %d notches, from %s to %s", change.Notches, change.From, change.To))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if change != nil && change.Alerted {
+		metrics.RatingDowngrades.WithLabelValues(bond.Chain).Inc()
+		log.Printf("ALERT: bond %s downgraded %d notches from %s to %s on reassessment",
+			bond.BondID, change.Notches, change.From, change.To)
+	}
+	return change, nil
+}
@@ -0,0 +1,100 @@
+package reassess
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/timeline"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeAssessor rates each bond's IP-NFT as listed
+type fakeAssessor map[string]string
+
+func (f fakeAssessor) ReassessBond(ctx context.Context, bond *models.Bond) (*models.RiskAssessment, error) {
+	return &models.RiskAssessment{IPNFTId: bond.IPNFTId, RiskRating: f[bond.IPNFTId], AssessedAt: time.Now()}, nil
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestRun(t *testing.T) {
+	db, mock := newMockDB(t)
+	now := time.Now()
+	assessor := fakeAssessor{"ipnft-1": "A", "ipnft-2": "BBB", "ipnft-3": "B"}
+
+	mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE \(id > \$1 AND status = \$2\)`).
+		WithArgs(0, "ACTIVE", 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "bond_id", "ip_nft_id", "chain", "status"}).
+			AddRow(1, now, "1", "ipnft-1", "arbitrum", "ACTIVE").
+			AddRow(2, now, "2", "ipnft-2", "arbitrum", "ACTIVE").
+			AddRow(3, now, "3", "ipnft-3", "arbitrum", "ACTIVE"))
+
+	// All rated A: unchanged, a one-notch downgrade, and a three-notch
+	// downgrade past the threshold
+	for id := 1; id <= 3; id++ {
+		ipnftID := "ipnft-" + strconv.Itoa(id)
+		mock.ExpectQuery(`SELECT \* FROM "risk_assessments" WHERE ip_nft_id = \$1 .*ORDER BY assessed_at DESC`).
+			WithArgs(ipnftID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "ip_nft_id", "risk_rating"}).
+				AddRow(id, now, ipnftID, "A"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO "risk_assessments"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id + 10))
+		if id >= 2 {
+			mock.ExpectQuery(`INSERT INTO "bond_events"`).
+				WithArgs(sqlmock.AnyArg(), timeline.RatingChanged, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+		}
+		if id == 3 {
+			mock.ExpectQuery(`INSERT INTO "bond_events"`).
+				WithArgs("3", timeline.Covenant, "rating downgraded 3 notches, from A to B", sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		}
+		mock.ExpectCommit()
+	}
+
+	report, err := New(db, assessor, DefaultConfig()).Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.BondsAssessed != 3 || report.BondsFailed != 0 {
+		t.Errorf("assessed %d, failed %d, want 3 and 0", report.BondsAssessed, report.BondsFailed)
+	}
+	want := []Change{
+		{BondID: "2", From: "A", To: "BBB", Notches: 1},
+		{BondID: "3", From: "A", To: "B", Notches: 3, Alerted: true},
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("Changes = %+v, want %+v", report.Changes, want)
+	}
+	for i := range want {
+		if report.Changes[i] != want[i] {
+			t.Errorf("Changes[%d] = %+v, want %+v", i, report.Changes[i], want[i])
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,325 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Compared fields, named after their database columns
+const (
+	FieldTotalValue    = "total_value"
+	FieldTotalRevenue  = "total_revenue"
+	FieldStatus        = "status"
+	FieldAllocation    = "allocation"
+	FieldTotalInvested = "total_invested"
+	FieldInvestorCount = "investor_count"
+)
+
+// correctable are the derived fields auto-correction may overwrite. The
+// others are issuance inputs or aren't stored, so they are only reported.
+var correctable = map[string]bool{
+	FieldTotalRevenue:  true,
+	FieldTotalInvested: true,
+}
+
+// interventionStatuses are the stored statuses operators set on a bond the
+// contract still reports as active
+var interventionStatuses = map[string]bool{
+	models.BondPaused:    true,
+	models.BondFrozen:    true,
+	models.BondCancelled: true,
+}
+
+// ErrNotOnChain is returned by a Reader for bonds that were never issued on-chain
+var ErrNotOnChain = errors.New("bond is not on-chain")
+
+// BondState is a bond as recorded by the contract
+type BondState struct {
+	TotalValue   *big.Int
+	TotalRevenue *big.Int
+	Status       string // ACTIVE, MATURED or DEFAULTED
+}
+
+// TrancheState is a tranche as recorded by the contract
+type TrancheState struct {
+	Allocation    *big.Int
+	APY           *big.Int // Basis points
+	TotalInvested *big.Int
+	InvestorCount int64
+}
+
+// Reader reads bonds from the chain they were issued on
+type Reader interface {
+	OnChainBond(ctx context.Context, bond *models.Bond) (*BondState, error)
+	OnChainTranche(ctx context.Context, bond *models.Bond, trancheID int) (*TrancheState, error)
+}
+
+// Config controls the reconciliation job
+type Config struct {
+	Interval    time.Duration // How often every bond is reconciled
+	BatchSize   int           // Bonds loaded per query
+	AutoCorrect bool          // Overwrite derived fields with the on-chain value
+}
+
+// DefaultConfig returns default reconciliation configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:  time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// Discrepancy is a stored value that differs from the chain
+type Discrepancy struct {
+	BondID    string
+	Chain     string
+	TrancheID int // -1 for bond fields
+	Field     string
+	Stored    string
+	OnChain   string
+	Corrected bool
+}
+
+// Report is the result of a reconciliation run
+type Report struct {
+	StartedAt     time.Time
+	FinishedAt    time.Time
+	BondsChecked  int
+	BondsFailed   int // Bonds whose on-chain state couldn't be read
+	AutoCorrect   bool
+	Discrepancies []Discrepancy
+}
+
+// Reconciler compares stored bond and tranche aggregates with the contract
+// and reports, or optionally corrects, the differences
+type Reconciler struct {
+	db     *gorm.DB
+	reader Reader
+	config Config
+
+	runMu sync.Mutex // Serializes runs
+	mu    sync.Mutex
+	last  *Report
+}
+
+// New creates a reconciler
+func New(db *gorm.DB, reader Reader, config Config) *Reconciler {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultConfig().BatchSize
+	}
+	return &Reconciler{
+		db:     db,
+		reader: reader,
+		config: config,
+	}
+}
+
+// Start reconciles on every interval until the context is cancelled
+func (r *Reconciler) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Run(ctx); err != nil {
+				log.Printf("Reconciliation failed: %v", err)
+			}
+		}
+	}
+}
+
+// Last returns the report of the last completed run, or nil before the first
+func (r *Reconciler) Last() *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+// Run reconciles every bond and publishes the report
+func (r *Reconciler) Run(ctx context.Context) (*Report, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	report := &Report{StartedAt: time.Now(), AutoCorrect: r.config.AutoCorrect}
+	var lastID uint
+	for {
+		var bonds []models.Bond
+		err := r.db.WithContext(ctx).Preload("Tranches").
+			Where("id > ?", lastID).
+			Order("id ASC").
+			Limit(r.config.BatchSize).
+			Find(&bonds).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bonds: %w", err)
+		}
+		if len(bonds) == 0 {
+			break
+		}
+		lastID = bonds[len(bonds)-1].ID
+
+		stats := repository.NewBondRepository(r.db).StatsLoader(ctx)
+		for i := range bonds {
+			stats.Prime(bonds[i].BondID)
+		}
+		for i := range bonds {
+			err := r.reconcileBond(ctx, &bonds[i], stats, report)
+			switch {
+			case errors.Is(err, ErrNotOnChain):
+			case err != nil:
+				log.Printf("Failed to reconcile bond %s: %v", bonds[i].BondID, err)
+				report.BondsFailed++
+			default:
+				report.BondsChecked++
+			}
+		}
+
+		if len(bonds) < r.config.BatchSize {
+			break
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	r.publish(report)
+	return report, nil
+}
+
+// CompareTranche returns the stored aggregates of a tranche that differ from
+// the contract's. investorCount is the number of distinct investors stored.
+func CompareTranche(bond *models.Bond, tranche *models.Tranche, investorCount int64, onChain *TrancheState) []Discrepancy {
+	var found []Discrepancy
+	add := func(field, stored, onChain string) {
+		found = append(found, Discrepancy{
+			BondID:    bond.BondID,
+			Chain:     bond.Chain,
+			TrancheID: tranche.TrancheID,
+			Field:     field,
+			Stored:    stored,
+			OnChain:   onChain,
+		})
+	}
+	if !equal(tranche.Allocation, onChain.Allocation) {
+		add(FieldAllocation, tranche.Allocation, onChain.Allocation.String())
+	}
+	if !equal(tranche.TotalInvested, onChain.TotalInvested) {
+		add(FieldTotalInvested, tranche.TotalInvested, onChain.TotalInvested.String())
+	}
+	if investorCount != onChain.InvestorCount {
+		add(FieldInvestorCount, strconv.FormatInt(investorCount, 10), strconv.FormatInt(onChain.InvestorCount, 10))
+	}
+	return found
+}
+
+// equal reports whether a stored integer is the on-chain value
+func equal(stored string, onChain *big.Int) bool {
+	value, ok := new(big.Int).SetString(stored, 10)
+	return ok && value.Cmp(onChain) == 0
+}
+
+func (r *Reconciler) reconcileBond(ctx context.Context, bond *models.Bond, stats *repository.StatsLoader, report *Report) error {
+	state, err := r.reader.OnChainBond(ctx, bond)
+	if err != nil {
+		return err
+	}
+
+	// Collect first so a bond whose tranches can't be read reports nothing
+	var found []Discrepancy
+	add := func(trancheID int, field, stored, onChain string) {
+		found = append(found, Discrepancy{
+			BondID:    bond.BondID,
+			Chain:     bond.Chain,
+			TrancheID: trancheID,
+			Field:     field,
+			Stored:    stored,
+			OnChain:   onChain,
+		})
+	}
+	compare := func(trancheID int, field, stored string, onChain *big.Int) {
+		if !equal(stored, onChain) {
+			add(trancheID, field, stored, onChain.String())
+		}
+	}
+
+	compare(-1, FieldTotalValue, bond.TotalValue, state.TotalValue)
+	compare(-1, FieldTotalRevenue, bond.TotalRevenue, state.TotalRevenue)
+	expected := bond.Status
+	if interventionStatuses[expected] {
+		expected = models.BondActive
+	}
+	if expected != state.Status {
+		add(-1, FieldStatus, bond.Status, state.Status)
+	}
+
+	for i := range bond.Tranches {
+		tranche := &bond.Tranches[i]
+		onChain, err := r.reader.OnChainTranche(ctx, bond, tranche.TrancheID)
+		if err != nil {
+			return err
+		}
+		trancheStats, err := stats.Load(repository.TrancheKey{BondID: bond.BondID, TrancheID: tranche.TrancheID})
+		if err != nil {
+			return err
+		}
+		found = append(found, CompareTranche(bond, tranche, trancheStats.InvestorCount, onChain)...)
+	}
+
+	for i := range found {
+		if r.config.AutoCorrect && correctable[found[i].Field] {
+			found[i].Corrected = r.correct(ctx, &found[i])
+		}
+	}
+	report.Discrepancies = append(report.Discrepancies, found...)
+	return nil
+}
+
+// correct overwrites a derived field with the on-chain value. The update only
+// applies if the stored value is unchanged, so a concurrent write isn't lost.
+func (r *Reconciler) correct(ctx context.Context, d *Discrepancy) bool {
+	query := r.db.WithContext(ctx).Model(&models.Bond{}).Where("bond_id = ?", d.BondID)
+	if d.TrancheID >= 0 {
+		query = r.db.WithContext(ctx).Model(&models.Tranche{}).Where("bond_id = ? AND tranche_id = ?", d.BondID, d.TrancheID)
+	}
+
+	result := query.Where(d.Field+" = ?", d.Stored).Update(d.Field, d.OnChain)
+	if result.Error != nil {
+		log.Printf("Failed to correct %s of bond %s: %v", d.Field, d.BondID, result.Error)
+		return false
+	}
+	if result.RowsAffected == 0 {
+		return false // Changed since it was read; the next run rechecks it
+	}
+
+	metrics.ReconciliationCorrections.WithLabelValues(d.Chain, d.Field).Inc()
+	log.Printf("Reconciliation corrected %s of bond %s tranche %d from %s to %s",
+		d.Field, d.BondID, d.TrancheID, d.Stored, d.OnChain)
+	return true
+}
+
+// publish makes a report the latest and updates the discrepancy gauges
+func (r *Reconciler) publish(report *Report) {
+	metrics.ReconciliationDiscrepancies.Reset()
+	for _, d := range report.Discrepancies {
+		metrics.ReconciliationDiscrepancies.WithLabelValues(d.Chain, d.Field).Inc()
+	}
+	metrics.ReconciliationLastRun.Set(float64(report.FinishedAt.Unix()))
+	if n := len(report.Discrepancies); n > 0 {
+		log.Printf("ALERT: reconciliation found %d discrepancies in %d bonds checked", n, report.BondsChecked)
+	}
+
+	r.mu.Lock()
+	r.last = report
+	r.mu.Unlock()
+}
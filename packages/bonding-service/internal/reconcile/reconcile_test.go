@@ -0,0 +1,140 @@
+package reconcile
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeReader serves on-chain state by bond ID; unknown bonds aren't on-chain
+type fakeReader struct {
+	bonds    map[string]*BondState
+	tranches map[string][]TrancheState
+}
+
+func (f *fakeReader) OnChainBond(ctx context.Context, bond *models.Bond) (*BondState, error) {
+	state, ok := f.bonds[bond.BondID]
+	if !ok {
+		return nil, ErrNotOnChain
+	}
+	return state, nil
+}
+
+func (f *fakeReader) OnChainTranche(ctx context.Context, bond *models.Bond, trancheID int) (*TrancheState, error) {
+	return &f.tranches[bond.BondID][trancheID], nil
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestRun(t *testing.T) {
+	db, mock := newMockDB(t)
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE id > \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "bond_id", "chain", "total_value", "total_revenue", "status"}).
+			AddRow(1, now, "1", "arbitrum", "1000", "50", "ACTIVE").
+			AddRow(2, now, "OFFCHAIN-2", "arbitrum", "500", "0", "ACTIVE"))
+	mock.ExpectQuery(`SELECT \* FROM "tranches" WHERE "tranches"."bond_id" IN`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "allocation", "total_invested"}).
+			AddRow(1, "1", 0, "600", "300").
+			AddRow(2, "1", 1, "400", "0"))
+	mock.ExpectQuery(`SELECT bond_id, tranche_id, COUNT\(DISTINCT investor\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"bond_id", "tranche_id", "investor_count", "investment_count"}).
+			AddRow("1", 0, 2, 3))
+	mock.ExpectExec(`UPDATE "bonds" SET "total_revenue"=\$1,"updated_at"=\$2 WHERE bond_id = \$3 AND total_revenue = \$4`).
+		WithArgs("80", sqlmock.AnyArg(), "1", "50").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "tranches" SET "total_invested"=\$1,"updated_at"=\$2 WHERE \(bond_id = \$3 AND tranche_id = \$4\) AND total_invested = \$5`).
+		WithArgs("350", sqlmock.AnyArg(), "1", 0, "300").
+		WillReturnResult(sqlmock.NewResult(0, 0)) // Invested concurrently
+
+	reader := &fakeReader{
+		bonds: map[string]*BondState{
+			"1": {TotalValue: big.NewInt(1000), TotalRevenue: big.NewInt(80), Status: "MATURED"},
+		},
+		tranches: map[string][]TrancheState{
+			"1": {
+				{Allocation: big.NewInt(600), TotalInvested: big.NewInt(350), InvestorCount: 2},
+				{Allocation: big.NewInt(400), TotalInvested: big.NewInt(0), InvestorCount: 1},
+			},
+		},
+	}
+	r := New(db, reader, Config{BatchSize: 10, AutoCorrect: true})
+
+	report, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.BondsChecked != 1 || report.BondsFailed != 0 {
+		t.Errorf("checked %d failed %d, want 1 and 0", report.BondsChecked, report.BondsFailed)
+	}
+
+	want := []Discrepancy{
+		{BondID: "1", Chain: "arbitrum", TrancheID: -1, Field: FieldTotalRevenue, Stored: "50", OnChain: "80", Corrected: true},
+		{BondID: "1", Chain: "arbitrum", TrancheID: -1, Field: FieldStatus, Stored: "ACTIVE", OnChain: "MATURED"},
+		{BondID: "1", Chain: "arbitrum", TrancheID: 0, Field: FieldTotalInvested, Stored: "300", OnChain: "350"},
+		{BondID: "1", Chain: "arbitrum", TrancheID: 1, Field: FieldInvestorCount, Stored: "0", OnChain: "1"},
+	}
+	if len(report.Discrepancies) != len(want) {
+		t.Fatalf("Discrepancies = %+v, want %d", report.Discrepancies, len(want))
+	}
+	for i, d := range report.Discrepancies {
+		if d != want[i] {
+			t.Errorf("discrepancy %d = %+v, want %+v", i, d, want[i])
+		}
+	}
+	if r.Last() != report {
+		t.Errorf("Last() did not return the run's report")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCompareTranche(t *testing.T) {
+	bond := &models.Bond{BondID: "1", Chain: "arbitrum"}
+	tranche := &models.Tranche{TrancheID: 2, Allocation: "100", TotalInvested: "40"}
+	onChain := &TrancheState{Allocation: big.NewInt(100), TotalInvested: big.NewInt(60), InvestorCount: 3}
+
+	got := CompareTranche(bond, tranche, 2, onChain)
+	want := []Discrepancy{
+		{BondID: "1", Chain: "arbitrum", TrancheID: 2, Field: FieldTotalInvested, Stored: "40", OnChain: "60"},
+		{BondID: "1", Chain: "arbitrum", TrancheID: 2, Field: FieldInvestorCount, Stored: "2", OnChain: "3"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CompareTranche() = %+v, want %+v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("discrepancy %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got := CompareTranche(bond, tranche, 3, &TrancheState{Allocation: big.NewInt(100), TotalInvested: big.NewInt(40), InvestorCount: 3}); len(got) != 0 {
+		t.Errorf("CompareTranche() of a matching tranche = %+v, want none", got)
+	}
+}
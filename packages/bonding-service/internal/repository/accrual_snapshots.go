@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// AccrualSnapshotFilter selects accrual snapshots for ListAccrualSnapshots
+type AccrualSnapshotFilter struct {
+	BondID   string
+	Investor string  // Empty for every investor in the bond
+	After    *Cursor // Nil for the first page
+	Limit    int
+}
+
+// ListAccrualSnapshots returns a page of a bond's accrual snapshots, latest
+// day first, and the cursor of the next page, nil on the last one
+func (r *BondRepository) ListAccrualSnapshots(ctx context.Context, filter AccrualSnapshotFilter) ([]models.AccrualSnapshot, *Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, r.db).Where("bond_id = ?", filter.BondID)
+	if filter.Investor != "" {
+		query = query.Where("LOWER(investor) = LOWER(?)", filter.Investor)
+	}
+	if filter.After != nil {
+		query = query.Where("(day, id) < (?, ?)", filter.After.Timestamp, filter.After.ID)
+	}
+
+	// One row past the page tells whether another follows
+	var snapshots []models.AccrualSnapshot
+	if err := query.Order("day DESC").Order("id DESC").
+		Limit(limit + 1).
+		Find(&snapshots).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list accrual snapshots: %w", err)
+	}
+	if len(snapshots) <= limit {
+		return snapshots, nil, nil
+	}
+	snapshots = snapshots[:limit]
+	last := snapshots[limit-1]
+	return snapshots, &Cursor{Timestamp: last.Day, ID: last.ID}, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultPageSize is used when a listing doesn't set a limit
+	DefaultPageSize = 50
+	// MaxPageSize caps a single listing page
+	MaxPageSize = 200
+)
+
+// BondRepository reads bonds and their relations in a fixed number of queries,
+// however many bonds, tranches or investments are involved
+type BondRepository struct {
+	db *gorm.DB
+}
+
+// NewBondRepository creates a bond repository
+func NewBondRepository(db *gorm.DB) *BondRepository {
+	return &BondRepository{db: db}
+}
+
+// BondFilter selects bonds for ListBonds
+type BondFilter struct {
+	TenantID        string
+	Status          string // Empty for any status
+	IncludeArchived bool
+	Limit           int
+	Offset          int
+}
+
+// ListBonds returns a page of bonds with their tranches in two queries:
+// one for the bonds and one for the tranches of every bond on the page
+func (r *BondRepository) ListBonds(ctx context.Context, filter BondFilter) ([]models.Bond, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, r.db).Where("tenant_id = ?", filter.TenantID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if !filter.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+
+	var bonds []models.Bond
+	if err := query.Order("created_at DESC").Order("id DESC").
+		Limit(limit).Offset(filter.Offset).
+		Find(&bonds).Error; err != nil {
+		return nil, fmt.Errorf("failed to list bonds: %w", err)
+	}
+
+	if err := r.attachTranches(ctx, bonds); err != nil {
+		return nil, err
+	}
+	return bonds, nil
+}
+
+// GetBond returns a bond with its tranches
+func (r *BondRepository) GetBond(ctx context.Context, bondID string) (*models.Bond, error) {
+	var bond models.Bond
+	if err := DB(ctx, r.db).Where("bond_id = ?", bondID).First(&bond).Error; err != nil {
+		return nil, err
+	}
+
+	bonds := []models.Bond{bond}
+	if err := r.attachTranches(ctx, bonds); err != nil {
+		return nil, err
+	}
+	return &bonds[0], nil
+}
+
+// attachTranches loads the tranches of all bonds with a single IN query
+func (r *BondRepository) attachTranches(ctx context.Context, bonds []models.Bond) error {
+	if len(bonds) == 0 {
+		return nil
+	}
+
+	index := make(map[string]int, len(bonds))
+	bondIDs := make([]string, len(bonds))
+	for i := range bonds {
+		bondIDs[i] = bonds[i].BondID
+		index[bonds[i].BondID] = i
+		bonds[i].Tranches = nil
+	}
+
+	var tranches []models.Tranche
+	if err := DB(ctx, r.db).
+		Where("bond_id IN ?", bondIDs).
+		Order("bond_id ASC").Order("tranche_id ASC").
+		Find(&tranches).Error; err != nil {
+		return fmt.Errorf("failed to load tranches: %w", err)
+	}
+
+	for _, t := range tranches {
+		i := index[t.BondID]
+		bonds[i].Tranches = append(bonds[i].Tranches, t)
+	}
+	return nil
+}
+
+// StatsLoader returns a loader for tranche investment statistics scoped to one request
+func (r *BondRepository) StatsLoader(ctx context.Context) *StatsLoader {
+	return newStatsLoader(DB(ctx, r.db))
+}
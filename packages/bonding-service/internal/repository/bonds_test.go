@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// queryCounter counts the statements gorm sends to the database
+type queryCounter struct {
+	n atomic.Int64
+}
+
+func (c *queryCounter) register(db *gorm.DB) {
+	count := func(*gorm.DB) { c.n.Add(1) }
+	db.Callback().Query().Before("gorm:query").Register("test:count_query", count)
+	db.Callback().Row().Before("gorm:row").Register("test:count_row", count)
+	db.Callback().Raw().Before("gorm:raw").Register("test:count_raw", count)
+}
+
+// newMockDB returns a gorm DB backed by sqlmock with a query counter attached
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, *queryCounter) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	counter := &queryCounter{}
+	counter.register(db)
+	return db, mock, counter
+}
+
+// expectCatalog sets up responses for n bonds with three tranches each
+func expectCatalog(mock sqlmock.Sqlmock, n int) {
+	now := time.Now()
+
+	bonds := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "bond_id", "tenant_id", "status"})
+	tranches := sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "name"})
+	stats := sqlmock.NewRows([]string{"bond_id", "tranche_id", "investor_count", "investment_count"})
+	for i := 0; i < n; i++ {
+		bondID := fmt.Sprintf("BOND-%d", i)
+		bonds.AddRow(i+1, now, now, bondID, "default", "ACTIVE")
+		for tranche := 0; tranche < 3; tranche++ {
+			tranches.AddRow(i*3+tranche+1, bondID, tranche, fmt.Sprintf("T%d", tranche))
+			stats.AddRow(bondID, tranche, tranche+1, tranche+2)
+		}
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "bonds"`).WillReturnRows(bonds)
+	mock.ExpectQuery(`SELECT \* FROM "tranches" WHERE bond_id IN`).WillReturnRows(tranches)
+	mock.ExpectQuery(`SELECT bond_id, tranche_id, COUNT\(DISTINCT investor\)`).WillReturnRows(stats)
+}
+
+func TestListBondsQueryCountIsFlat(t *testing.T) {
+	for _, n := range []int{1, 10, 100} {
+		t.Run(fmt.Sprintf("%d bonds", n), func(t *testing.T) {
+			db, mock, counter := newMockDB(t)
+			expectCatalog(mock, n)
+
+			repo := NewBondRepository(db)
+			ctx := context.Background()
+			bonds, err := repo.ListBonds(ctx, BondFilter{TenantID: "default"})
+			if err != nil {
+				t.Fatalf("ListBonds() error = %v", err)
+			}
+			if len(bonds) != n {
+				t.Fatalf("ListBonds() returned %d bonds, want %d", len(bonds), n)
+			}
+
+			loader := repo.StatsLoader(ctx)
+			for _, b := range bonds {
+				loader.Prime(b.BondID)
+			}
+			for _, b := range bonds {
+				if len(b.Tranches) != 3 {
+					t.Fatalf("bond %s has %d tranches, want 3", b.BondID, len(b.Tranches))
+				}
+				for _, tr := range b.Tranches {
+					stats, err := loader.Load(TrancheKey{BondID: b.BondID, TrancheID: tr.TrancheID})
+					if err != nil {
+						t.Fatalf("Load() error = %v", err)
+					}
+					if stats.InvestorCount != int64(tr.TrancheID+1) {
+						t.Errorf("InvestorCount for %s/%d = %d", b.BondID, tr.TrancheID, stats.InvestorCount)
+					}
+				}
+			}
+
+			if got := counter.n.Load(); got != 3 {
+				t.Errorf("queries = %d, want 3 regardless of bond count", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestStatsLoaderCaches(t *testing.T) {
+	db, mock, counter := newMockDB(t)
+	mock.ExpectQuery(`SELECT bond_id, tranche_id`).
+		WillReturnRows(sqlmock.NewRows([]string{"bond_id", "tranche_id", "investor_count", "investment_count"}).
+			AddRow("BOND-1", 0, 4, 5))
+
+	loader := NewBondRepository(db).StatsLoader(context.Background())
+	for i := 0; i < 3; i++ {
+		for tranche := 0; tranche < 3; tranche++ {
+			if _, err := loader.Load(TrancheKey{BondID: "BOND-1", TrancheID: tranche}); err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+		}
+	}
+
+	stats, _ := loader.Load(TrancheKey{BondID: "BOND-1", TrancheID: 0})
+	if stats.InvestorCount != 4 || stats.InvestmentCount != 5 {
+		t.Errorf("Load() = %+v, want 4 investors and 5 investments", stats)
+	}
+	if got := counter.n.Load(); got != 1 {
+		t.Errorf("queries = %d, want 1", got)
+	}
+}
+
+func TestListBondsPageSize(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  int
+	}{
+		{0, DefaultPageSize},
+		{10, 10},
+		{MaxPageSize + 1, MaxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("limit %d", tt.limit), func(t *testing.T) {
+			db, mock, _ := newMockDB(t)
+			mock.ExpectQuery(`SELECT \* FROM "bonds" .*LIMIT \$2`).
+				WithArgs("default", tt.want).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			if _, err := NewBondRepository(db).ListBonds(context.Background(), BondFilter{TenantID: "default", Limit: tt.limit}); err != nil {
+				t.Fatalf("ListBonds() error = %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestListBondsHidesArchived(t *testing.T) {
+	for _, include := range []bool{false, true} {
+		t.Run(fmt.Sprintf("include archived %v", include), func(t *testing.T) {
+			db, mock, _ := newMockDB(t)
+			query := `SELECT \* FROM "bonds" WHERE tenant_id = \$1 AND "bonds"."deleted_at" IS NULL ORDER BY`
+			if !include {
+				query = `SELECT \* FROM "bonds" WHERE tenant_id = \$1 AND archived_at IS NULL AND "bonds"."deleted_at" IS NULL ORDER BY`
+			}
+			mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			filter := BondFilter{TenantID: "default", IncludeArchived: include}
+			if _, err := NewBondRepository(db).ListBonds(context.Background(), filter); err != nil {
+				t.Fatalf("ListBonds() error = %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestSnapshotReadsInOneTransaction(t *testing.T) {
+	db, mock, counter := newMockDB(t)
+	mock.ExpectBegin()
+	expectCatalog(mock, 2)
+	mock.ExpectCommit()
+
+	repo := NewBondRepository(db)
+	err := Snapshot(context.Background(), db, func(ctx context.Context) error {
+		// A nested snapshot joins the outer one
+		return Snapshot(ctx, db, func(ctx context.Context) error {
+			bonds, err := repo.ListBonds(ctx, BondFilter{TenantID: "default"})
+			if err != nil {
+				return err
+			}
+			_, err = repo.StatsLoader(ctx).Load(TrancheKey{BondID: bonds[0].BondID})
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if got := counter.n.Load(); got != 3 {
+		t.Errorf("queries = %d, want 3", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestLatestAssessment(t *testing.T) {
+	db, mock, _ := newMockDB(t)
+	now := time.Now()
+	mock.ExpectQuery(`SELECT \* FROM "risk_assessments" WHERE ip_nft_id = \$1 .*ORDER BY assessed_at DESC,id DESC LIMIT \$2`).
+		WithArgs("ipnft-1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "ip_nft_id", "risk_rating", "assessed_at"}).
+			AddRow(2, "ipnft-1", "BBB", now))
+	mock.ExpectQuery(`SELECT \* FROM "risk_assessments" WHERE ip_nft_id = \$1`).
+		WithArgs("ipnft-2", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	latest, err := LatestAssessment(context.Background(), db, "ipnft-1")
+	if err != nil || latest == nil || latest.RiskRating != "BBB" {
+		t.Errorf("LatestAssessment() = %+v, %v, want the BBB assessment", latest, err)
+	}
+	if latest, err := LatestAssessment(context.Background(), db, "ipnft-2"); err != nil || latest != nil {
+		t.Errorf("LatestAssessment() of an unassessed IP-NFT = %+v, %v, want nil", latest, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
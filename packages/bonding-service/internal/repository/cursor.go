@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/archive"
+)
+
+// ErrInvalidCursor is returned for page tokens a listing didn't issue
+var ErrInvalidCursor = errors.New("invalid page token")
+
+// Cursor is the position of the last row on a page of a keyset listing.
+// Pages are ordered newest first by timestamp and then ID, which is unique,
+// so a page resumes exactly where the previous one ended even as rows are
+// added.
+type Cursor struct {
+	Timestamp time.Time
+	ID        uint
+}
+
+// String encodes the cursor as an opaque page token
+func (c Cursor) String() string {
+	raw := strconv.FormatInt(c.Timestamp.UnixNano(), 10) + "." + strconv.FormatUint(uint64(c.ID), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a page token
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &Cursor{Timestamp: time.Unix(0, n), ID: uint(i)}, nil
+}
+
+// tiers names a per-bond detail table for a read, adding its archive when
+// the read includes archived bonds
+func tiers(table string, includeArchived bool) string {
+	if !includeArchived {
+		return table
+	}
+	return fmt.Sprintf("(SELECT * FROM %s UNION ALL SELECT * FROM %s) AS %s", table, archive.ColdTable(table), table)
+}
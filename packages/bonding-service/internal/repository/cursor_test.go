@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	want := Cursor{Timestamp: time.Unix(1704067200, 123456789), ID: 42}
+	got, err := ParseCursor(want.String())
+	if err != nil {
+		t.Fatalf("ParseCursor() error = %v", err)
+	}
+	if !got.Timestamp.Equal(want.Timestamp) || got.ID != want.ID {
+		t.Errorf("ParseCursor() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCursorRejectsForeignTokens(t *testing.T) {
+	for _, token := range []string{"not base64!", "MTIz", "YWJjLjE", "MTIzLnh5eg"} {
+		if _, err := ParseCursor(token); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("ParseCursor(%q) error = %v, want ErrInvalidCursor", token, err)
+		}
+	}
+}
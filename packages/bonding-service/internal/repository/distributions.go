@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// DistributionFilter selects a bond's revenue distributions for
+// ListDistributions
+type DistributionFilter struct {
+	BondID          string
+	IncludeArchived bool
+	After           *Cursor // Nil for the first page
+	Limit           int
+}
+
+// ListDistributions returns a page of a bond's revenue distributions, newest
+// first, with their per-tranche splits, and the cursor of the next page, nil
+// on the last one
+func (r *BondRepository) ListDistributions(ctx context.Context, filter DistributionFilter) ([]models.RevenueDistribution, *Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, r.db).Table(tiers("revenue_distributions", filter.IncludeArchived)).
+		Where("bond_id = ?", filter.BondID)
+	if filter.After != nil {
+		query = query.Where("(timestamp, id) < (?, ?)", filter.After.Timestamp, filter.After.ID)
+	}
+
+	// One row past the page tells whether another follows
+	var distributions []models.RevenueDistribution
+	if err := query.Order("timestamp DESC").Order("id DESC").
+		Limit(limit + 1).
+		Find(&distributions).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list distributions: %w", err)
+	}
+	var next *Cursor
+	if len(distributions) > limit {
+		distributions = distributions[:limit]
+		last := distributions[limit-1]
+		next = &Cursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+	if len(distributions) == 0 {
+		return distributions, next, nil
+	}
+
+	ids := make([]uint, len(distributions))
+	index := make(map[uint]int, len(distributions))
+	for i, d := range distributions {
+		ids[i] = d.ID
+		index[d.ID] = i
+	}
+	var splits []models.TrancheDistribution
+	if err := DB(ctx, r.db).Table(tiers("tranche_distributions", filter.IncludeArchived)).
+		Where("bond_id = ? AND distribution_id IN ?", filter.BondID, ids).
+		Order("tranche_id ASC").
+		Find(&splits).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to load tranche distributions: %w", err)
+	}
+	for _, split := range splits {
+		d := &distributions[index[split.DistributionID]]
+		d.Tranches = append(d.Tranches, split)
+	}
+	return distributions, next, nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestListDistributionsAttachesSplits(t *testing.T) {
+	db, mock, _ := newMockDB(t)
+	newer := time.Unix(1704067300, 0)
+	older := time.Unix(1704067200, 0)
+	mock.ExpectQuery(`SELECT \* FROM "revenue_distributions" WHERE bond_id = \$1 .*ORDER BY timestamp DESC,id DESC LIMIT \$2`).
+		WithArgs("1", DefaultPageSize+1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "amount", "timestamp"}).
+			AddRow(8, "1", "300", newer).
+			AddRow(5, "1", "100", older))
+	mock.ExpectQuery(`SELECT \* FROM "tranche_distributions" WHERE \(bond_id = \$1 AND distribution_id IN \(\$2,\$3\)\) .*ORDER BY tranche_id ASC`).
+		WithArgs("1", 8, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "distribution_id", "bond_id", "tranche_id", "coupon_paid"}).
+			AddRow(1, 5, "1", 0, "100").
+			AddRow(2, 8, "1", 0, "200").
+			AddRow(3, 8, "1", 1, "100"))
+
+	distributions, next, err := NewBondRepository(db).ListDistributions(context.Background(), DistributionFilter{BondID: "1"})
+	if err != nil {
+		t.Fatalf("ListDistributions() error = %v", err)
+	}
+	if next != nil {
+		t.Errorf("next = %+v, want nil", *next)
+	}
+	if len(distributions) != 2 {
+		t.Fatalf("got %d distributions, want 2", len(distributions))
+	}
+	if got := len(distributions[0].Tranches); got != 2 {
+		t.Errorf("distribution 8 has %d splits, want 2", got)
+	}
+	if got := len(distributions[1].Tranches); got != 1 {
+		t.Errorf("distribution 5 has %d splits, want 1", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// InvestmentFilter selects investments for ListInvestments
+type InvestmentFilter struct {
+	TenantID        string
+	BondID          string // Empty for every bond of the tenant
+	TrancheID       int    // -1 for every tranche
+	Investor        string // Empty for every investor
+	Since           time.Time
+	Until           time.Time // Zero for no bound
+	IncludeArchived bool
+	After           *Cursor // Nil for the first page
+	Limit           int
+}
+
+// ListInvestments returns a page of investments, newest first, and the
+// cursor of the next page, nil on the last one. Naming a bond keeps the
+// query to one partition.
+func (r *BondRepository) ListInvestments(ctx context.Context, filter InvestmentFilter) ([]models.Investment, *Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, r.db).Table(tiers("investments", filter.IncludeArchived))
+	if filter.BondID != "" {
+		query = query.Where("bond_id = ?", filter.BondID)
+	} else {
+		query = query.Where("bond_id IN (?)", DB(ctx, r.db).Model(&models.Bond{}).
+			Select("bond_id").Where("tenant_id = ?", filter.TenantID))
+	}
+	if filter.TrancheID >= 0 {
+		query = query.Where("tranche_id = ?", filter.TrancheID)
+	}
+	if filter.Investor != "" {
+		query = query.Where("LOWER(investor) = LOWER(?)", filter.Investor)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("timestamp >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("timestamp < ?", filter.Until)
+	}
+	if filter.After != nil {
+		query = query.Where("(timestamp, id) < (?, ?)", filter.After.Timestamp, filter.After.ID)
+	}
+
+	// One row past the page tells whether another follows
+	var investments []models.Investment
+	if err := query.Order("timestamp DESC").Order("id DESC").
+		Limit(limit + 1).
+		Find(&investments).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list investments: %w", err)
+	}
+	if len(investments) <= limit {
+		return investments, nil, nil
+	}
+	investments = investments[:limit]
+	last := investments[limit-1]
+	return investments, &Cursor{Timestamp: last.Timestamp, ID: last.ID}, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestListInvestmentsNextCursor(t *testing.T) {
+	newer := time.Unix(1704067300, 0)
+	older := time.Unix(1704067200, 0)
+	tests := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		wantLen  int
+		wantNext *Cursor
+	}{
+		{
+			name:    "last page",
+			rows:    sqlmock.NewRows([]string{"id", "bond_id", "timestamp"}).AddRow(3, "1", newer),
+			wantLen: 1,
+		},
+		{
+			name: "more to come",
+			rows: sqlmock.NewRows([]string{"id", "bond_id", "timestamp"}).
+				AddRow(3, "1", newer).AddRow(2, "1", older).AddRow(1, "1", older),
+			wantLen:  2,
+			wantNext: &Cursor{Timestamp: older, ID: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := newMockDB(t)
+			mock.ExpectQuery(`SELECT \* FROM "investments" WHERE bond_id = \$1 .*ORDER BY timestamp DESC,id DESC LIMIT \$2`).
+				WithArgs("1", 3).
+				WillReturnRows(tt.rows)
+
+			investments, next, err := NewBondRepository(db).ListInvestments(context.Background(), InvestmentFilter{
+				TenantID: "default", BondID: "1", TrancheID: -1, Limit: 2,
+			})
+			if err != nil {
+				t.Fatalf("ListInvestments() error = %v", err)
+			}
+			if len(investments) != tt.wantLen {
+				t.Errorf("got %d investments, want %d", len(investments), tt.wantLen)
+			}
+			switch {
+			case tt.wantNext == nil && next != nil:
+				t.Errorf("next = %+v, want nil", *next)
+			case tt.wantNext != nil && (next == nil || next.ID != tt.wantNext.ID || !next.Timestamp.Equal(tt.wantNext.Timestamp)):
+				t.Errorf("next = %v, want %+v", next, *tt.wantNext)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// RevenueEventFilter selects revenue events for ListRevenueEvents
+type RevenueEventFilter struct {
+	TenantID string
+	BondID   string  // Empty for every bond of the tenant
+	Status   string  // Empty for every status
+	Source   string  // Empty for every source
+	After    *Cursor // Nil for the first page
+	Limit    int
+}
+
+// ListRevenueEvents returns a page of revenue events, most recently
+// occurred first, and the cursor of the next page, nil on the last one
+func (r *BondRepository) ListRevenueEvents(ctx context.Context, filter RevenueEventFilter) ([]models.RevenueEvent, *Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, r.db).Where("tenant_id = ?", filter.TenantID)
+	if filter.BondID != "" {
+		query = query.Where("bond_id = ?", filter.BondID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	if filter.After != nil {
+		query = query.Where("(occurred_at, id) < (?, ?)", filter.After.Timestamp, filter.After.ID)
+	}
+
+	// One row past the page tells whether another follows
+	var events []models.RevenueEvent
+	if err := query.Order("occurred_at DESC").Order("id DESC").
+		Limit(limit + 1).
+		Find(&events).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list revenue events: %w", err)
+	}
+	if len(events) <= limit {
+		return events, nil, nil
+	}
+	events = events[:limit]
+	last := events[limit-1]
+	return events, &Cursor{Timestamp: last.OccurredAt, ID: last.ID}, nil
+}
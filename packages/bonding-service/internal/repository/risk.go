@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// LatestAssessment returns the most recent risk assessment of an IP-NFT, or
+// nil when it was never assessed
+func LatestAssessment(ctx context.Context, db *gorm.DB, ipnftID string) (*models.RiskAssessment, error) {
+	history, err := AssessmentHistory(ctx, db, ipnftID, 1, 0)
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+	return &history[0], nil
+}
+
+// AssessmentHistory returns a page of an IP-NFT's risk assessments, newest
+// first
+func AssessmentHistory(ctx context.Context, db *gorm.DB, ipnftID string, limit, offset int) ([]models.RiskAssessment, error) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	var history []models.RiskAssessment
+	if err := DB(ctx, db).Where("ip_nft_id = ?", ipnftID).
+		Order("assessed_at DESC").Order("id DESC").
+		Limit(limit).Offset(offset).
+		Find(&history).Error; err != nil {
+		return nil, fmt.Errorf("failed to load risk assessments: %w", err)
+	}
+	return history, nil
+}
+
+// AssessmentFilter selects an IP-NFT's risk assessments for ListAssessments
+type AssessmentFilter struct {
+	IPNFTId string
+	Since   time.Time
+	Until   time.Time // Zero for no bound
+	After   *Cursor   // Nil for the first page
+	Limit   int
+}
+
+// ListAssessments returns a page of an IP-NFT's risk assessments, newest
+// first, and the cursor of the next page, nil on the last one
+func ListAssessments(ctx context.Context, db *gorm.DB, filter AssessmentFilter) ([]models.RiskAssessment, *Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	query := DB(ctx, db).Where("ip_nft_id = ?", filter.IPNFTId)
+	if !filter.Since.IsZero() {
+		query = query.Where("assessed_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("assessed_at < ?", filter.Until)
+	}
+	if filter.After != nil {
+		query = query.Where("(assessed_at, id) < (?, ?)", filter.After.Timestamp, filter.After.ID)
+	}
+
+	// One row past the page tells whether another follows
+	var assessments []models.RiskAssessment
+	if err := query.Order("assessed_at DESC").Order("id DESC").
+		Limit(limit + 1).
+		Find(&assessments).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list risk assessments: %w", err)
+	}
+	if len(assessments) <= limit {
+		return assessments, nil, nil
+	}
+	assessments = assessments[:limit]
+	last := assessments[limit-1]
+	return assessments, &Cursor{Timestamp: last.AssessedAt, ID: last.ID}, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestListAssessmentsTimeWindow(t *testing.T) {
+	db, mock, _ := newMockDB(t)
+	since := time.Unix(1704067200, 0)
+	until := time.Unix(1706745600, 0)
+	mock.ExpectQuery(`SELECT \* FROM "risk_assessments" WHERE ip_nft_id = \$1 AND assessed_at >= \$2 AND assessed_at < \$3 .*ORDER BY assessed_at DESC,id DESC LIMIT \$4`).
+		WithArgs("QmHash123", since, until, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "ip_nft_id", "assessed_at"}).
+			AddRow(7, "QmHash123", until.Add(-time.Hour)).
+			AddRow(4, "QmHash123", since))
+
+	assessments, next, err := ListAssessments(context.Background(), db, AssessmentFilter{
+		IPNFTId: "QmHash123", Since: since, Until: until, Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("ListAssessments() error = %v", err)
+	}
+	if len(assessments) != 1 || assessments[0].ID != 7 {
+		t.Errorf("ListAssessments() = %+v, want assessment 7 only", assessments)
+	}
+	if next == nil || next.ID != 7 {
+		t.Errorf("next = %v, want the cursor of assessment 7", next)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// ListScheduledPayments returns a bond's expected payments by tranche and
+// then sequence
+func (r *BondRepository) ListScheduledPayments(ctx context.Context, bondID string) ([]models.ScheduledPayment, error) {
+	var payments []models.ScheduledPayment
+	if err := DB(ctx, r.db).Where("bond_id = ?", bondID).
+		Order("tranche_id ASC").Order("sequence ASC").
+		Find(&payments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scheduled payments: %w", err)
+	}
+	return payments, nil
+}
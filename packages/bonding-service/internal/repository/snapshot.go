@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+type snapshotKey struct{}
+
+// Snapshot runs fn in a read-only REPEATABLE READ transaction, so every read
+// it makes sees the data as of its first query and a write committed midway
+// can't leave totals and their detail rows disagreeing. Repository reads and
+// DB in the context fn is given use the transaction.
+func Snapshot(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(snapshotKey{}).(*gorm.DB); ok {
+		return fn(ctx) // Already reading a snapshot
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, snapshotKey{}, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
+// DB returns the snapshot transaction ctx carries, or db, bound to ctx
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(snapshotKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
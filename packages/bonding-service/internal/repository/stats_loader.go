@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/archive"
+	"gorm.io/gorm"
+)
+
+// TrancheKey identifies a tranche
+type TrancheKey struct {
+	BondID    string
+	TrancheID int
+}
+
+// TrancheStats aggregates the investments in a tranche
+type TrancheStats struct {
+	InvestorCount   int64
+	InvestmentCount int64
+}
+
+// StatsLoader batches tranche statistics lookups DataLoader-style. Bonds
+// queued with Prime are fetched together in one grouped query on the first
+// Load, and results are cached for the life of the loader, so a loop over
+// many tranches costs one query rather than one per tranche.
+type StatsLoader struct {
+	db      *gorm.DB
+	pending map[string]bool
+	loaded  map[string]bool
+	stats   map[TrancheKey]TrancheStats
+}
+
+func newStatsLoader(db *gorm.DB) *StatsLoader {
+	return &StatsLoader{
+		db:      db,
+		pending: make(map[string]bool),
+		loaded:  make(map[string]bool),
+		stats:   make(map[TrancheKey]TrancheStats),
+	}
+}
+
+// Prime queues bonds so their tranches are fetched in the next batch
+func (l *StatsLoader) Prime(bondIDs ...string) {
+	for _, id := range bondIDs {
+		if !l.loaded[id] {
+			l.pending[id] = true
+		}
+	}
+}
+
+// Load returns the statistics for a tranche, fetching every queued bond in
+// one query if the tranche's bond hasn't been loaded yet
+func (l *StatsLoader) Load(key TrancheKey) (TrancheStats, error) {
+	if !l.loaded[key.BondID] {
+		l.pending[key.BondID] = true
+		if err := l.flush(); err != nil {
+			return TrancheStats{}, err
+		}
+	}
+	return l.stats[key], nil
+}
+
+func (l *StatsLoader) flush() error {
+	if len(l.pending) == 0 {
+		return nil
+	}
+
+	bondIDs := make([]string, 0, len(l.pending))
+	for id := range l.pending {
+		bondIDs = append(bondIDs, id)
+	}
+
+	var rows []struct {
+		BondID          string
+		TrancheID       int
+		InvestorCount   int64
+		InvestmentCount int64
+	}
+	// Closed bonds may have their investments archived, so both tiers are read
+	if err := l.db.Raw(fmt.Sprintf(`SELECT bond_id, tranche_id, COUNT(DISTINCT investor) AS investor_count, COUNT(*) AS investment_count
+		FROM (
+			SELECT bond_id, tranche_id, investor FROM investments WHERE bond_id IN ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT bond_id, tranche_id, investor FROM %s WHERE bond_id IN ? AND deleted_at IS NULL
+		) AS investments
+		GROUP BY bond_id, tranche_id`, archive.ColdTable("investments")), bondIDs, bondIDs).
+		Scan(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load tranche statistics: %w", err)
+	}
+
+	for _, row := range rows {
+		l.stats[TrancheKey{BondID: row.BondID, TrancheID: row.TrancheID}] = TrancheStats{
+			InvestorCount:   row.InvestorCount,
+			InvestmentCount: row.InvestmentCount,
+		}
+	}
+	for _, id := range bondIDs {
+		l.loaded[id] = true
+		delete(l.pending, id)
+	}
+	return nil
+}
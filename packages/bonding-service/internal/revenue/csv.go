@@ -0,0 +1,113 @@
+package revenue
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// MaxCSVRows caps the events one CSV import may carry
+const MaxCSVRows = 10000
+
+// maxCSVErrors bounds the line errors reported for one import
+const maxCSVErrors = 20
+
+// csvColumns are the columns a statement must have; reference is optional
+// and other columns are ignored
+var csvColumns = []string{"bond_id", "external_id", "amount", "occurred_at"}
+
+// ParseCSV reads a royalty statement with a header row naming its columns.
+// occurred_at is an RFC 3339 time or a YYYY-MM-DD date (UTC). The error of a
+// statement with bad rows wraps ErrInvalidEvent and names each bad line.
+func ParseCSV(r io.Reader) ([]Event, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: statement is empty", ErrInvalidEvent)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range csvColumns {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("%w: missing column %s", ErrInvalidEvent, column)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := index[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var events []Event
+	var problems []string
+	seen := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+		}
+		if len(events) == MaxCSVRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidEvent, MaxCSVRows)
+		}
+
+		ev := Event{
+			BondID:     field(record, "bond_id"),
+			ExternalID: field(record, "external_id"),
+			Amount:     field(record, "amount"),
+			Reference:  field(record, "reference"),
+		}
+		ev.OccurredAt, err = parseTime(field(record, "occurred_at"))
+		if err == nil {
+			err = ev.validate()
+		}
+		if err == nil {
+			if first, ok := seen[ev.ExternalID]; ok {
+				err = fmt.Errorf("external_id %s repeats line %d", ev.ExternalID, first)
+			} else {
+				seen[ev.ExternalID] = line
+			}
+		}
+		if err != nil {
+			if len(problems) < maxCSVErrors {
+				problems = append(problems, fmt.Sprintf("line %d: %s", line, strings.TrimPrefix(err.Error(), ErrInvalidEvent.Error()+": ")))
+			}
+			continue
+		}
+		events = append(events, ev)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEvent, strings.Join(problems, "; "))
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: statement has no rows", ErrInvalidEvent)
+	}
+	return events, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("occurred_at %q is not an RFC 3339 time or YYYY-MM-DD date", value)
+}
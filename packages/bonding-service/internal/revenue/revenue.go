@@ -0,0 +1,212 @@
+// Package revenue records the revenue bonds' IP earns, so distributions pay
+// amounts with provenance instead of whatever a caller supplies. Connectors
+// (signed webhooks, CSV royalty statements and the royalty distributor
+// contract) record RevenueEvent rows; events are verified by review, or on
+// arrival for confirmed on-chain payments, and the verified totals feed the
+// distribution schedules.
+package revenue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrInvalidEvent is returned for an event that fails validation
+	ErrInvalidEvent = errors.New("invalid revenue event")
+	// ErrUnknownBond is returned for an event naming a bond that doesn't exist
+	ErrUnknownBond = errors.New("unknown bond")
+	// ErrSelfReview is returned when a caller reviews events they imported
+	ErrSelfReview = errors.New("revenue events must be reviewed by someone other than their importer")
+)
+
+// Event is revenue as a connector reports it
+type Event struct {
+	BondID     string    `json:"bond_id"`
+	ExternalID string    `json:"external_id"` // Unique within the connector; redeliveries carry the same ID
+	Amount     string    `json:"amount"`      // Base units
+	OccurredAt time.Time `json:"occurred_at"`
+	Reference  string    `json:"reference"`
+
+	// Set by the chain connector
+	TxHash      string `json:"-"`
+	BlockNumber uint64 `json:"-"`
+}
+
+func (e *Event) validate() error {
+	if e.BondID == "" {
+		return fmt.Errorf("%w: bond_id is required", ErrInvalidEvent)
+	}
+	if e.ExternalID == "" {
+		return fmt.Errorf("%w: external_id is required", ErrInvalidEvent)
+	}
+	if amount, ok := new(big.Int).SetString(e.Amount, 10); !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("%w: amount %q is not a positive integer", ErrInvalidEvent, e.Amount)
+	}
+	if e.OccurredAt.IsZero() {
+		return fmt.Errorf("%w: occurred_at is required", ErrInvalidEvent)
+	}
+	if e.OccurredAt.After(time.Now().Add(time.Hour)) {
+		return fmt.Errorf("%w: occurred_at %s is in the future", ErrInvalidEvent, e.OccurredAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Origin describes where recorded events came from
+type Origin struct {
+	Source     string // models.RevenueSource*
+	Connector  string
+	RecordedBy string // Importing caller, if any
+	TenantID   string // Restricts events to the tenant's bonds; empty for any
+	Verified   bool   // Whether the source is trusted without review
+}
+
+// Store keeps revenue events
+type Store struct {
+	db *gorm.DB
+}
+
+// NewStore creates a store
+func NewStore(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Record stores an event and reports whether it is new; an event the
+// connector already delivered is left as it was
+func (s *Store) Record(ctx context.Context, origin Origin, ev Event) (bool, error) {
+	recorded, err := s.RecordAll(ctx, origin, []Event{ev})
+	return recorded == 1, err
+}
+
+// RecordAll stores a batch of events in one transaction, so a batch with an
+// invalid event records none of them, and returns how many were new
+func (s *Store) RecordAll(ctx context.Context, origin Origin, events []Event) (int, error) {
+	recorded := 0
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tenants := make(map[string]string)
+		for i := range events {
+			row, err := newRow(tx, origin, &events[i], tenants)
+			if err != nil {
+				return err
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+			if result.Error != nil {
+				return fmt.Errorf("failed to save revenue event: %w", result.Error)
+			}
+			recorded += int(result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return recorded, nil
+}
+
+// newRow validates an event and builds its row, looking up each bond's
+// tenant once per batch
+func newRow(tx *gorm.DB, origin Origin, ev *Event, tenants map[string]string) (*models.RevenueEvent, error) {
+	if err := ev.validate(); err != nil {
+		return nil, err
+	}
+	tenantID, ok := tenants[ev.BondID]
+	if !ok {
+		var bond models.Bond
+		err := tx.Select("tenant_id").Where("bond_id = ?", ev.BondID).First(&bond).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownBond, ev.BondID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bond: %w", err)
+		}
+		if origin.TenantID != "" && bond.TenantID != origin.TenantID {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownBond, ev.BondID)
+		}
+		tenantID = bond.TenantID
+		tenants[ev.BondID] = tenantID
+	}
+
+	row := &models.RevenueEvent{
+		TenantID:    tenantID,
+		BondID:      ev.BondID,
+		Source:      origin.Source,
+		Connector:   origin.Connector,
+		ExternalID:  ev.ExternalID,
+		Amount:      ev.Amount,
+		OccurredAt:  ev.OccurredAt.UTC(),
+		Reference:   ev.Reference,
+		Status:      models.RevenuePending,
+		RecordedBy:  origin.RecordedBy,
+		TxHash:      ev.TxHash,
+		BlockNumber: ev.BlockNumber,
+	}
+	if origin.Verified {
+		now := time.Now()
+		row.Status = models.RevenueVerified
+		row.ReviewedAt = &now
+	}
+	return row, nil
+}
+
+// Review verifies or rejects a tenant's pending events and returns how many
+// changed. Events the reviewer imported themselves are refused.
+func (s *Store) Review(ctx context.Context, tenantID string, ids []uint, verified bool, reviewer, reason string) (int64, error) {
+	if reviewer != "" {
+		var own int64
+		if err := s.db.WithContext(ctx).Model(&models.RevenueEvent{}).
+			Where("id IN ? AND recorded_by = ?", ids, reviewer).
+			Count(&own).Error; err != nil {
+			return 0, fmt.Errorf("failed to check revenue events: %w", err)
+		}
+		if own > 0 {
+			return 0, ErrSelfReview
+		}
+	}
+
+	updates := map[string]interface{}{
+		"status":      models.RevenueVerified,
+		"reviewed_by": reviewer,
+		"reviewed_at": time.Now(),
+	}
+	if !verified {
+		updates["status"] = models.RevenueRejected
+		updates["reject_reason"] = reason
+	}
+	result := s.db.WithContext(ctx).Model(&models.RevenueEvent{}).
+		Where("id IN ? AND tenant_id = ? AND status = ?", ids, tenantID, models.RevenuePending).
+		Updates(updates)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to review revenue events: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// AccruedRevenue returns the revenue verified for a bond over [from, to).
+// Events count when they are verified rather than when they occurred, so one
+// reviewed after the run covering its date is paid by the next run instead
+// of being missed.
+func (s *Store) AccruedRevenue(ctx context.Context, bondID string, from, to time.Time) (*big.Int, error) {
+	var amounts []string
+	if err := s.db.WithContext(ctx).Model(&models.RevenueEvent{}).
+		Where("bond_id = ? AND status = ? AND reviewed_at >= ? AND reviewed_at < ?", bondID, models.RevenueVerified, from, to).
+		Pluck("amount", &amounts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load verified revenue: %w", err)
+	}
+
+	total := new(big.Int)
+	for _, a := range amounts {
+		amount, ok := new(big.Int).SetString(a, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid stored revenue amount %q", a)
+		}
+		total.Add(total, amount)
+	}
+	return total, nil
+}
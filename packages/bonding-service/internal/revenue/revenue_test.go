@@ -0,0 +1,238 @@
+package revenue
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestParseCSV(t *testing.T) {
+	events, err := ParseCSV(strings.NewReader("bond_id,external_id,amount,occurred_at,reference\n" +
+		"1,st-1,1000,2026-03-01,March streams\n" +
+		"2, st-2 ,250,2026-03-02T10:00:00Z,\n"))
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Reference != "March streams" || !events[0].OccurredAt.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("first event = %+v", events[0])
+	}
+	if events[1].ExternalID != "st-2" {
+		t.Errorf("external_id = %q, want it trimmed", events[1].ExternalID)
+	}
+
+	tests := []struct {
+		name string
+		csv  string
+		want string
+	}{
+		{"missing column", "bond_id,amount,occurred_at\n1,5,2026-03-01\n", "missing column external_id"},
+		{"bad amount", "bond_id,external_id,amount,occurred_at\n1,a,-5,2026-03-01\n", "line 2: amount \"-5\""},
+		{"bad date", "bond_id,external_id,amount,occurred_at\n1,a,5,March\n", "line 2: occurred_at \"March\""},
+		{"repeated id", "bond_id,external_id,amount,occurred_at\n1,a,5,2026-03-01\n1,a,6,2026-03-01\n", "line 3: external_id a repeats line 2"},
+		{"no rows", "bond_id,external_id,amount,occurred_at\n", "no rows"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.csv))
+			if !errors.Is(err, ErrInvalidEvent) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseCSV() error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookParse(t *testing.T) {
+	webhook := NewWebhook("label", []byte("secret"))
+	body := `{"events": [{"bond_id": "1", "external_id": "p-1", "amount": "100", "occurred_at": "2026-03-01T00:00:00Z"}]}`
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest("POST", "/revenue/label", strings.NewReader(body))
+	req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	events, err := webhook.Parse(req)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(events) != 1 || events[0].ExternalID != "p-1" || events[0].Amount != "100" {
+		t.Errorf("events = %+v", events)
+	}
+
+	req = httptest.NewRequest("POST", "/revenue/label", strings.NewReader(body))
+	req.Header.Set("X-Signature", "sha256="+hex.EncodeToString([]byte("forged")))
+	if _, err := webhook.Parse(req); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Parse() with a bad signature error = %v, want ErrInvalidEvent", err)
+	}
+}
+
+func TestRecordAllCountsNewEvents(t *testing.T) {
+	db, mock := newMockDB(t)
+	occurred := time.Now().Add(-time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "tenant_id" FROM "bonds" WHERE bond_id = \$1`).
+		WithArgs("1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).AddRow("acme"))
+	mock.ExpectQuery(`INSERT INTO "revenue_events" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "revenue_events" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	store := NewStore(db)
+	origin := Origin{Source: models.RevenueSourceCSV, Connector: "label", TenantID: "acme"}
+	recorded, err := store.RecordAll(context.Background(), origin, []Event{
+		{BondID: "1", ExternalID: "a", Amount: "10", OccurredAt: occurred},
+		{BondID: "1", ExternalID: "b", Amount: "20", OccurredAt: occurred},
+	})
+	if err != nil {
+		t.Fatalf("RecordAll() error = %v", err)
+	}
+	if recorded != 1 {
+		t.Errorf("recorded %d, want 1 with the other a duplicate", recorded)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordAllRefusesOtherTenantsBonds(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "tenant_id" FROM "bonds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).AddRow("other"))
+	mock.ExpectRollback()
+
+	origin := Origin{Source: models.RevenueSourceCSV, Connector: "label", TenantID: "acme"}
+	_, err := NewStore(db).RecordAll(context.Background(), origin, []Event{
+		{BondID: "1", ExternalID: "a", Amount: "10", OccurredAt: time.Now()},
+	})
+	if !errors.Is(err, ErrUnknownBond) {
+		t.Errorf("RecordAll() error = %v, want ErrUnknownBond", err)
+	}
+}
+
+func TestAccruedRevenue(t *testing.T) {
+	db, mock := newMockDB(t)
+	from := time.Now().Add(-24 * time.Hour)
+	to := time.Now()
+
+	mock.ExpectQuery(`SELECT "amount" FROM "revenue_events" WHERE \(bond_id = \$1 AND status = \$2 AND reviewed_at >= \$3 AND reviewed_at < \$4\)`).
+		WithArgs("1", models.RevenueVerified, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).
+			AddRow("1000000000000000000000").
+			AddRow("5"))
+
+	total, err := NewStore(db).AccruedRevenue(context.Background(), "1", from, to)
+	if err != nil {
+		t.Fatalf("AccruedRevenue() error = %v", err)
+	}
+	if total.String() != "1000000000000000000005" {
+		t.Errorf("total = %s, want 1000000000000000000005", total)
+	}
+}
+
+// fakeChain serves a head and a fixed set of logs
+type fakeChain struct {
+	head  uint64
+	logs  []types.Log
+	query ethereum.FilterQuery
+}
+
+func (f *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return &types.Header{Number: new(big.Int).SetUint64(f.head)}, nil
+	}
+	return &types.Header{Number: number, Time: 1767225600}, nil
+}
+
+func (f *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	f.query = q
+	return f.logs, nil
+}
+
+func TestRoyaltyWatcherRecordsConfirmedPayments(t *testing.T) {
+	db, mock := newMockDB(t)
+	payment := types.Log{
+		Topics: []common.Hash{
+			RoyaltyDistributedTopic,
+			common.BigToHash(big.NewInt(42)),
+			common.BytesToHash(common.HexToAddress("0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0").Bytes()),
+		},
+		Data:        common.BigToHash(big.NewInt(750)).Bytes(),
+		BlockNumber: 80,
+		TxHash:      common.HexToHash("0xabc"),
+		Index:       3,
+	}
+	chain := &fakeChain{head: 100, logs: []types.Log{payment}}
+
+	mock.ExpectQuery(`SELECT \* FROM "consumer_offsets" WHERE consumer = \$1`).
+		WithArgs("royalty:arbitrum", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"consumer", "position"}).AddRow("royalty:arbitrum", 70))
+	mock.ExpectQuery(`SELECT "bond_id" FROM "bonds" WHERE \(chain = \$1 AND ip_nft_id = \$2 AND status IN`).
+		WithArgs("arbitrum", "42", models.BondActive, models.BondPaused, models.BondFrozen).
+		WillReturnRows(sqlmock.NewRows([]string{"bond_id"}).AddRow("7"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "tenant_id" FROM "bonds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).AddRow("default"))
+	mock.ExpectQuery(`INSERT INTO "revenue_events"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectExec(`INSERT INTO "consumer_offsets" .* ON CONFLICT \("consumer"\) DO UPDATE`).
+		WithArgs("royalty:arbitrum", uint64(80), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	config := DefaultRoyaltyConfig()
+	watcher := NewRoyaltyWatcher(db, NewStore(db), "arbitrum", chain, common.HexToAddress("0x01"), config)
+	recorded, err := watcher.PollOnce(context.Background())
+	if err != nil {
+		t.Fatalf("PollOnce() error = %v", err)
+	}
+	if recorded != 1 {
+		t.Errorf("recorded %d payments, want 1", recorded)
+	}
+	if chain.query.FromBlock.Uint64() != 71 || chain.query.ToBlock.Uint64() != 80 {
+		t.Errorf("scanned %d-%d, want 71-80 (head 100 less 20 confirmations)", chain.query.FromBlock, chain.query.ToBlock)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
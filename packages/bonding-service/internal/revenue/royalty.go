@@ -0,0 +1,212 @@
+package revenue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RoyaltyDistributedTopic identifies the royalty distributor's
+// RoyaltyDistributed(uint256 indexed tokenId, uint256 amount, address indexed payer)
+var RoyaltyDistributedTopic = crypto.Keccak256Hash([]byte("RoyaltyDistributed(uint256,uint256,address)"))
+
+// ChainClient is the subset of ethclient.Client the royalty watcher needs
+type ChainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// RoyaltyConfig controls a royalty watcher
+type RoyaltyConfig struct {
+	StartBlock    uint64        // First block to scan; 0 starts at the confirmed head
+	MaxRange      uint64        // Maximum blocks per log query
+	Confirmations uint64        // Blocks before a payment is treated as final
+	Interval      time.Duration // How often to poll for new blocks
+}
+
+// DefaultRoyaltyConfig returns default royalty watcher configuration
+func DefaultRoyaltyConfig() RoyaltyConfig {
+	return RoyaltyConfig{
+		MaxRange:      2000,
+		Confirmations: 20,
+		Interval:      time.Minute,
+	}
+}
+
+// RoyaltyWatcher records the royalty distributor's payments as verified
+// revenue of the bond backed by the paid IP-NFT. Only confirmed blocks are
+// read, so a reorg can't retract a recorded payment.
+type RoyaltyWatcher struct {
+	db       *gorm.DB
+	store    *Store
+	chain    string
+	client   ChainClient
+	contract common.Address
+	config   RoyaltyConfig
+}
+
+// NewRoyaltyWatcher creates a watcher for the royalty distributor on a chain
+func NewRoyaltyWatcher(db *gorm.DB, store *Store, chain string, client ChainClient, contract common.Address, config RoyaltyConfig) *RoyaltyWatcher {
+	if config.MaxRange == 0 {
+		config.MaxRange = DefaultRoyaltyConfig().MaxRange
+	}
+	return &RoyaltyWatcher{
+		db:       db,
+		store:    store,
+		chain:    chain,
+		client:   client,
+		contract: contract,
+		config:   config,
+	}
+}
+
+// Start polls for payments on every interval until the context is cancelled
+func (w *RoyaltyWatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.PollOnce(ctx); err != nil {
+			log.Printf("Royalty watcher for %s failed: %v", w.chain, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cursor names the watcher's committed position among consumer offsets
+func (w *RoyaltyWatcher) cursor() string {
+	return "royalty:" + w.chain
+}
+
+// PollOnce records the payments in up to MaxRange newly confirmed blocks and
+// returns how many were recorded
+func (w *RoyaltyWatcher) PollOnce(ctx context.Context) (int, error) {
+	head, err := w.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read head block: %w", err)
+	}
+	if head.Number.Uint64() < w.config.Confirmations {
+		return 0, nil
+	}
+	confirmed := head.Number.Uint64() - w.config.Confirmations
+
+	var offset models.ConsumerOffset
+	err = w.db.WithContext(ctx).Where("consumer = ?", w.cursor()).First(&offset).Error
+	from := w.config.StartBlock
+	switch {
+	case err == nil:
+		from = offset.Position + 1
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if from == 0 {
+			from = confirmed
+		}
+	default:
+		return 0, fmt.Errorf("failed to load royalty watcher position: %w", err)
+	}
+	if from > confirmed {
+		return 0, nil
+	}
+	to := min(confirmed, from+w.config.MaxRange-1)
+
+	logs, err := w.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{w.contract},
+		Topics:    [][]common.Hash{{RoyaltyDistributedTopic}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query royalty logs %d-%d: %w", from, to, err)
+	}
+
+	events, err := w.events(ctx, logs)
+	if err != nil {
+		return 0, err
+	}
+	recorded := 0
+	if len(events) > 0 {
+		origin := Origin{Source: models.RevenueSourceChain, Connector: w.chain, Verified: true}
+		if recorded, err = w.store.RecordAll(ctx, origin, events); err != nil {
+			return 0, err
+		}
+	}
+
+	// Payments are recorded before the position moves on; a crash in between
+	// rescans blocks whose payments are then recognised as duplicates
+	if err := w.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "consumer"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position", "updated_at"}),
+	}).Create(&models.ConsumerOffset{Consumer: w.cursor(), Position: to}).Error; err != nil {
+		return recorded, fmt.Errorf("failed to commit royalty watcher position: %w", err)
+	}
+	return recorded, nil
+}
+
+// events turns payment logs into revenue of the bonds backed by the paid
+// IP-NFTs. Payments for IP-NFTs without exactly one outstanding bond on the
+// chain are logged and skipped.
+func (w *RoyaltyWatcher) events(ctx context.Context, logs []types.Log) ([]Event, error) {
+	times := make(map[uint64]time.Time)
+	var events []Event
+	for _, l := range logs {
+		if len(l.Topics) < 2 || len(l.Data) < 32 {
+			continue
+		}
+		tokenID := new(big.Int).SetBytes(l.Topics[1].Bytes())
+		amount := new(big.Int).SetBytes(l.Data[:32])
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		var bonds []models.Bond
+		if err := w.db.WithContext(ctx).Select("bond_id").
+			Where("chain = ? AND ip_nft_id = ? AND status IN ?", w.chain, tokenID.String(), models.OutstandingBondStatuses).
+			Find(&bonds).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up bonds of IP-NFT %s: %w", tokenID, err)
+		}
+		if len(bonds) != 1 {
+			log.Printf("Royalty payment %s in tx %s skipped: IP-NFT %s has %d outstanding bonds on %s",
+				amount, l.TxHash.Hex(), tokenID, len(bonds), w.chain)
+			continue
+		}
+
+		occurredAt, ok := times[l.BlockNumber]
+		if !ok {
+			header, err := w.client.HeaderByNumber(ctx, new(big.Int).SetUint64(l.BlockNumber))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read block %d: %w", l.BlockNumber, err)
+			}
+			occurredAt = time.Unix(int64(header.Time), 0)
+			times[l.BlockNumber] = occurredAt
+		}
+
+		ev := Event{
+			BondID:      bonds[0].BondID,
+			ExternalID:  fmt.Sprintf("%s:%d", l.TxHash.Hex(), l.Index),
+			Amount:      amount.String(),
+			OccurredAt:  occurredAt,
+			TxHash:      l.TxHash.Hex(),
+			BlockNumber: l.BlockNumber,
+		}
+		if len(l.Topics) > 2 {
+			ev.Reference = "payer " + common.BytesToAddress(l.Topics[2].Bytes()).Hex()
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
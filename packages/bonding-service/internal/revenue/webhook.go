@@ -0,0 +1,106 @@
+package revenue
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+const (
+	// maxWebhookSize bounds webhook bodies
+	maxWebhookSize = 1 << 20
+	// MaxWebhookEvents caps the events one delivery may carry
+	MaxWebhookEvents = 1000
+)
+
+// Webhook accepts revenue reported by a licensee or platform as
+// {"events": [...]} JSON, signed with a shared secret: the X-Signature header
+// carries sha256= and the hex HMAC-SHA256 of the body
+type Webhook struct {
+	name   string
+	secret []byte
+}
+
+// NewWebhook creates a webhook connector verifying deliveries with secret
+func NewWebhook(name string, secret []byte) *Webhook {
+	return &Webhook{name: name, secret: secret}
+}
+
+// Name returns the connector's name
+func (w *Webhook) Name() string {
+	return w.name
+}
+
+// Parse checks a delivery's signature and decodes its events. Failures
+// wrap ErrInvalidEvent.
+func (w *Webhook) Parse(r *http.Request) ([]Event, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delivery: %w", err)
+	}
+	if len(body) > maxWebhookSize {
+		return nil, fmt.Errorf("%w: body larger than %d bytes", ErrInvalidEvent, maxWebhookSize)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(r.Header.Get("X-Signature"), "sha256="))
+	if err != nil || len(signature) == 0 {
+		return nil, fmt.Errorf("%w: missing or malformed signature", ErrInvalidEvent)
+	}
+	mac := hmac.New(sha256.New, w.secret)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidEvent)
+	}
+
+	var delivery struct {
+		Events []Event `json:"events"`
+	}
+	if err := json.Unmarshal(body, &delivery); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if len(delivery.Events) == 0 || len(delivery.Events) > MaxWebhookEvents {
+		return nil, fmt.Errorf("%w: expected 1 to %d events, got %d", ErrInvalidEvent, MaxWebhookEvents, len(delivery.Events))
+	}
+	return delivery.Events, nil
+}
+
+// Handler serves webhook deliveries, POSTed to /revenue/{name}. Events are
+// recorded pending review; redelivered events are acknowledged without
+// being recorded again.
+func Handler(store *Store, webhooks ...*Webhook) http.Handler {
+	mux := http.NewServeMux()
+	for _, webhook := range webhooks {
+		webhook := webhook
+		mux.HandleFunc("POST /revenue/"+webhook.Name(), func(w http.ResponseWriter, r *http.Request) {
+			events, err := webhook.Parse(r)
+			recorded := 0
+			if err == nil {
+				origin := Origin{Source: models.RevenueSourceWebhook, Connector: webhook.Name()}
+				recorded, err = store.RecordAll(r.Context(), origin, events)
+			}
+			switch {
+			case errors.Is(err, ErrInvalidEvent), errors.Is(err, ErrUnknownBond):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			case err != nil:
+				log.Printf("Revenue webhook %s failed: %v", webhook.Name(), err)
+				http.Error(w, "failed to record revenue", http.StatusInternalServerError)
+			default:
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]int{
+					"recorded":   recorded,
+					"duplicates": len(events) - recorded,
+				})
+			}
+		})
+	}
+	return mux
+}
@@ -0,0 +1,239 @@
+package risk
+
+import (
+	"encoding/json"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+)
+
+// Rating is a credit rating from AAA (best) to CCC
+type Rating uint8
+
+// Ratings in descending order of quality
+const (
+	RatingAAA Rating = iota
+	RatingAA
+	RatingA
+	RatingBBB
+	RatingBB
+	RatingB
+	RatingCCC
+)
+
+var ratingNames = [...]string{"AAA", "AA", "A", "BBB", "BB", "B", "CCC"}
+
+// Rating tables indexed by Rating; these mirror the maps used by AssessIPValue
+var (
+	ratingDefaultProbability = [...]float64{0.01, 0.02, 0.05, 0.10, 0.20, 0.35, 0.50}
+	ratingBaseLTV            = [...]float64{0.70, 0.65, 0.60, 0.50, 0.40, 0.30, 0.20}
+)
+
+func (r Rating) String() string {
+	if int(r) < len(ratingNames) {
+		return ratingNames[r]
+	}
+	return "UNKNOWN"
+}
+
+// ParseRating returns the rating named name, e.g. "BBB"
+func ParseRating(name string) (Rating, bool) {
+	for i, n := range ratingNames {
+		if n == name {
+			return Rating(i), true
+		}
+	}
+	return 0, false
+}
+
+// RiskFactor is a bit set of risk factors found during assessment
+type RiskFactor uint16
+
+// Risk factors, in the order AssessIPValue reports them
+const (
+	FactorLowViews RiskFactor = 1 << iota
+	FactorNewContent
+	FactorLimitedSocialProof
+	FactorObsolescence
+	FactorUnverifiedRegistration
+	FactorRegistrationExpiring
+	FactorRegistrationExpired
+	FactorNonExclusiveLicense
+	FactorTerritoryLimited
+	FactorLicenseEnding
+	FactorLicenseEnded
+	FactorLicenseeConcentration
+	FactorUnreliableLicensee
+)
+
+var riskFactorNames = [...]string{
+	"Low view count",
+	"New content with limited track record",
+	"Limited social validation",
+	"Technology obsolescence risk",
+	"Registration not verified with the registry",
+	"Registration expires within 2 years",
+	"Registration has expired",
+	"Non-exclusive license",
+	"License limited to specific territories",
+	"License term ends within 2 years",
+	"License term has ended",
+	"Royalties concentrated in one licensee",
+	"Licensee has a record of late payments",
+}
+
+// Strings returns the descriptions of the factors in the set
+func (f RiskFactor) Strings() []string {
+	names := make([]string, 0, len(riskFactorNames))
+	for i, name := range riskFactorNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// BatchResult is the rule-based assessment of one item in a batch
+type BatchResult struct {
+	ValuationUSD       float64
+	ConfidenceScore    float64
+	DefaultProbability float64
+	RecommendedLTV     float64
+	Rating             Rating
+	Factors            RiskFactor
+}
+
+// Assessment converts a batch result into a storable assessment
+func (r *BatchResult) Assessment(ipnftID string, assessedAt time.Time) (*models.RiskAssessment, error) {
+	factors, err := json.Marshal(r.Factors.Strings())
+	if err != nil {
+		return nil, err
+	}
+	return &models.RiskAssessment{
+		IPNFTId:            ipnftID,
+		ValuationUSD:       r.ValuationUSD,
+		ConfidenceScore:    r.ConfidenceScore,
+		RiskRating:         r.Rating.String(),
+		DefaultProbability: r.DefaultProbability,
+		RecommendedLTV:     r.RecommendedLTV,
+		RiskFactors:        string(factors),
+		AssessedAt:         assessedAt,
+	}, nil
+}
+
+// AssessBatch scores many items with the rule-based model, for catalog
+// onboarding where calling AssessIPValue per item is too slow. Results are
+// written into dst, which is reused when it has enough capacity, so repeated
+// batches don't allocate. Neither the oracle nor comparable sales are consulted.
+func (re *RiskEngine) AssessBatch(dst []BatchResult, items []IPMetadata) []BatchResult {
+	if cap(dst) < len(items) {
+		dst = make([]BatchResult, len(items))
+	}
+	dst = dst[:len(items)]
+
+	now := time.Now()
+	for i := range items {
+		scoreItem(&dst[i], &items[i], re.categories.Resolve(items[i].Category), now)
+	}
+	return dst
+}
+
+// scoreItem computes the same scores as AssessIPValue's rule-based path,
+// before comparable sales, without allocating slices or JSON
+func scoreItem(r *BatchResult, m *IPMetadata, category taxonomy.Params, now time.Time) {
+	ageInDays := now.Sub(m.CreatedAt).Hours() / 24
+
+	// Valuation
+	engagement := float64(m.Views)*0.1 + float64(m.Likes)*1.0
+	ageFactor := math.Max(0.5, 1.0-(ageInDays/365.0)*0.2)
+	valuation := (engagement + 1000.0) * category.Multiplier * ageFactor *
+		legalLifeFactor(m.Registration, now) * licenseLifeFactor(m.License, now)
+	if valuation < 100 {
+		valuation = 100
+	}
+
+	// Confidence
+	confidence := 0.5
+	if m.Views > 1000 {
+		confidence += 0.1
+	}
+	if m.Likes > 100 {
+		confidence += 0.1
+	}
+	if ageInDays > 180 {
+		confidence += 0.2
+	} else if ageInDays > 90 {
+		confidence += 0.1
+	}
+	if len(m.Tags) > 5 {
+		confidence += 0.1
+	}
+
+	// Risk factors
+	var factors RiskFactor
+	if m.Views < 100 {
+		factors |= FactorLowViews
+	}
+	if ageInDays < 30 {
+		factors |= FactorNewContent
+	}
+	if m.Likes < 10 {
+		factors |= FactorLimitedSocialProof
+	}
+	if category.ObsolescenceRisk {
+		factors |= FactorObsolescence
+	}
+	factors |= registrationFactors(m.Registration, now)
+	factors |= licenseFactors(m.License, now)
+	factors |= counterpartyFactors(m.Counterparties)
+
+	// Rating
+	score := 100.0 - float64(bits.OnesCount16(uint16(factors)))*10.0
+	if m.Views > 10000 {
+		score += 10.0
+	}
+	if m.Likes > 1000 {
+		score += 10.0
+	}
+	if ageInDays > 365 {
+		score += 15.0
+	}
+	rating := ratingForScore(math.Max(0, math.Min(100, score)))
+
+	// Default probability and LTV
+	prob := ratingDefaultProbability[rating]
+	if ageInDays < 30 {
+		prob *= 1.5
+	}
+	prob = math.Min(0.99, prob)
+	ltv := ratingBaseLTV[rating] * (1.0 - prob*0.5)
+
+	r.ValuationUSD = valuation
+	r.ConfidenceScore = math.Min(0.95, confidence)
+	r.DefaultProbability = prob
+	r.RecommendedLTV = math.Max(0.1, math.Min(0.8, ltv))
+	r.Rating = rating
+	r.Factors = factors
+}
+
+func ratingForScore(score float64) Rating {
+	switch {
+	case score >= 90:
+		return RatingAAA
+	case score >= 80:
+		return RatingAA
+	case score >= 70:
+		return RatingA
+	case score >= 60:
+		return RatingBBB
+	case score >= 50:
+		return RatingBB
+	case score >= 40:
+		return RatingB
+	default:
+		return RatingCCC
+	}
+}
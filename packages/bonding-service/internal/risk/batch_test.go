@@ -0,0 +1,157 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+// catalog builds n items spread across categories, engagement levels, ages,
+// registration and license terms. Ages and terms avoid the day thresholds so
+// results don't depend on when each assessment reads the clock.
+func catalog(n int) []IPMetadata {
+	categories := []string{"music", "video", "ebook", "course", "software", "artwork", "research", "other"}
+	ages := []time.Duration{5, 45, 120, 200, 400, 900}
+	expiries := []time.Duration{-365, 400, 1100, 3650}
+	items := make([]IPMetadata, n)
+	for i := range items {
+		items[i] = IPMetadata{
+			Category:       categories[i%len(categories)],
+			CreatorAddress: fmt.Sprintf("0x%040x", i),
+			CreatedAt:      time.Now().Add(-ages[i%len(ages)] * 24 * time.Hour),
+			Views:          int32((i * 997) % 20000),
+			Likes:          int32((i * 131) % 2000),
+			Tags:           make([]string, i%8),
+			ContentHash:    fmt.Sprintf("Qm%d", i),
+		}
+		if i%5 == 0 {
+			items[i].Registration = &RegisteredIP{
+				Kind:      "PATENT",
+				Number:    fmt.Sprintf("US%d", i),
+				ExpiresAt: time.Now().Add(expiries[i%len(expiries)] * 24 * time.Hour),
+				Verified:  i%2 == 0,
+			}
+		}
+		if i%3 == 0 {
+			items[i].License = &LicenseTerms{
+				EndsAt:    time.Now().Add(expiries[(i/3)%len(expiries)] * 24 * time.Hour),
+				Exclusive: i%2 == 0,
+				Worldwide: i%4 == 0,
+			}
+		}
+		if i%7 == 0 {
+			items[i].Counterparties = &Counterparties{
+				TopShare: float64(i%10) / 10,
+				Score:    (i * 37) % 100,
+				Rated:    i%2 == 0,
+			}
+		}
+	}
+	return items
+}
+
+func TestAssessBatchMatchesAssessIPValue(t *testing.T) {
+	engine := NewRiskEngine()
+	items := catalog(200)
+	results := engine.AssessBatch(nil, items)
+
+	for i := range items {
+		want, err := engine.AssessIPValue(items[i].ContentHash, &items[i])
+		if err != nil {
+			t.Fatalf("AssessIPValue() error = %v", err)
+		}
+		got, err := results[i].Assessment(items[i].ContentHash, want.AssessedAt)
+		if err != nil {
+			t.Fatalf("Assessment() error = %v", err)
+		}
+
+		if got.RiskRating != want.RiskRating || got.RiskFactors != want.RiskFactors {
+			t.Errorf("item %d: rating %s %s, want %s %s", i, got.RiskRating, got.RiskFactors, want.RiskRating, want.RiskFactors)
+		}
+		for _, f := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"valuation", got.ValuationUSD, want.ValuationUSD},
+			{"confidence", got.ConfidenceScore, want.ConfidenceScore},
+			{"default probability", got.DefaultProbability, want.DefaultProbability},
+			{"ltv", got.RecommendedLTV, want.RecommendedLTV},
+		} {
+			if math.Abs(f.got-f.want) > 1e-6*math.Max(1, math.Abs(f.want)) {
+				t.Errorf("item %d: %s = %v, want %v", i, f.name, f.got, f.want)
+			}
+		}
+	}
+}
+
+func TestAssessBatchReusesDestination(t *testing.T) {
+	engine := NewRiskEngine()
+	items := catalog(64)
+	dst := make([]BatchResult, 0, len(items))
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = engine.AssessBatch(dst, items)
+	})
+	if allocs != 0 {
+		t.Errorf("AssessBatch() allocated %v times with a large enough destination", allocs)
+	}
+	if len(dst) != len(items) {
+		t.Errorf("len(results) = %d, want %d", len(dst), len(items))
+	}
+}
+
+func TestRiskFactorStrings(t *testing.T) {
+	factors := FactorLowViews | FactorObsolescence
+	data, _ := json.Marshal(factors.Strings())
+	if want := `["Low view count","Technology obsolescence risk"]`; string(data) != want {
+		t.Errorf("Strings() = %s, want %s", data, want)
+	}
+	if got := RiskFactor(0).Strings(); len(got) != 0 {
+		t.Errorf("empty set Strings() = %v", got)
+	}
+}
+
+func TestAssessBatchThroughput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping throughput comparison in short mode")
+	}
+
+	loop := testing.Benchmark(BenchmarkAssessIPValueLoop)
+	batch := testing.Benchmark(BenchmarkAssessBatch)
+	speedup := float64(loop.NsPerOp()) / float64(batch.NsPerOp())
+	t.Logf("loop %d ns/op, batch %d ns/op, speedup %.1fx", loop.NsPerOp(), batch.NsPerOp(), speedup)
+	if speedup < 10 {
+		t.Errorf("batch speedup = %.1fx, want at least 10x", speedup)
+	}
+}
+
+const benchmarkCatalogSize = 1000
+
+func BenchmarkAssessIPValueLoop(b *testing.B) {
+	engine := NewRiskEngine()
+	items := catalog(benchmarkCatalogSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for i := range items {
+			if _, err := engine.AssessIPValue(items[i].ContentHash, &items[i]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkAssessBatch(b *testing.B) {
+	engine := NewRiskEngine()
+	items := catalog(benchmarkCatalogSize)
+	results := make([]BatchResult, len(items))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		results = engine.AssessBatch(results, items)
+	}
+}
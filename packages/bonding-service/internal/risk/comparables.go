@@ -0,0 +1,62 @@
+package risk
+
+import (
+	"context"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/comparables"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/oracle"
+)
+
+// ComparableLimit is how many recent comparable sales inform a valuation
+const ComparableLimit = 10
+
+// comparableWeightPivot is the number of comparable sales at which their
+// median and the rule-based valuation weigh the same
+const comparableWeightPivot = ComparableLimit / 2
+
+// ComparableSales finds recorded sales of similar IP and records those an
+// oracle reports
+type ComparableSales interface {
+	Find(ctx context.Context, category string, tags []string, limit int) ([]models.ComparableSale, error)
+	RecordOracle(ctx context.Context, category string, reported []map[string]interface{}) error
+}
+
+// SetComparables has rule-based valuations weigh in the median price of
+// comparable sales, and records the sales oracles report
+func (re *RiskEngine) SetComparables(sales ComparableSales) {
+	re.comparables = sales
+}
+
+// blendComparables moves a rule-based valuation towards the median price of
+// the IP's comparable sales, more so and with more confidence the more sales
+// there are. Without comparables the valuation is returned unchanged.
+func (re *RiskEngine) blendComparables(ctx context.Context, metadata *IPMetadata, valuation, confidence float64) (float64, float64) {
+	if re.comparables == nil {
+		return valuation, confidence
+	}
+	sales, err := re.comparables.Find(ctx, metadata.Category, metadata.Tags, ComparableLimit)
+	if err != nil {
+		log.Printf("Failed to load comparable sales, valuing without them: %v", err)
+		return valuation, confidence
+	}
+	if len(sales) == 0 {
+		return valuation, confidence
+	}
+
+	median := comparables.Median(sales)
+	weight := float64(len(sales)) / float64(len(sales)+comparableWeightPivot)
+	return valuation*(1-weight) + median*weight, confidence + (1-confidence)*weight/2
+}
+
+// recordOracleComparables keeps the comparable sales an oracle reported with
+// its valuation
+func (re *RiskEngine) recordOracleComparables(ctx context.Context, metadata *IPMetadata, valuation *oracle.ValuationResponse) {
+	if re.comparables == nil || len(valuation.ComparableSales) == 0 {
+		return
+	}
+	if err := re.comparables.RecordOracle(ctx, metadata.Category, valuation.ComparableSales); err != nil {
+		log.Printf("Failed to record oracle comparable sales: %v", err)
+	}
+}
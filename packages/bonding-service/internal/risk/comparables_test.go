@@ -0,0 +1,46 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+type fakeComparables []models.ComparableSale
+
+func (f fakeComparables) Find(ctx context.Context, category string, tags []string, limit int) ([]models.ComparableSale, error) {
+	return f, nil
+}
+
+func (f fakeComparables) RecordOracle(ctx context.Context, category string, reported []map[string]interface{}) error {
+	return nil
+}
+
+func TestComparablesInformValuation(t *testing.T) {
+	metadata := &IPMetadata{Category: "music", CreatedAt: time.Now().AddDate(-1, 0, 0), Views: 500, Likes: 20}
+	engine := NewRiskEngine()
+	base, err := engine.AssessIPValue("1", metadata)
+	if err != nil {
+		t.Fatalf("AssessIPValue() error = %v", err)
+	}
+
+	sales := make(fakeComparables, ComparableLimit/2)
+	for i := range sales {
+		sales[i] = models.ComparableSale{Price: base.ValuationUSD * 3}
+	}
+	engine.SetComparables(sales)
+	got, err := engine.AssessIPValue("1", metadata)
+	if err != nil {
+		t.Fatalf("AssessIPValue() error = %v", err)
+	}
+
+	// As many sales as the pivot weigh the same as the rule-based valuation
+	if want := base.ValuationUSD * 2; got.ValuationUSD < want*0.999 || got.ValuationUSD > want*1.001 {
+		t.Errorf("valuation = %.2f, want %.2f", got.ValuationUSD, want)
+	}
+	if got.ConfidenceScore <= base.ConfidenceScore {
+		t.Errorf("confidence = %.2f, want more than %.2f", got.ConfidenceScore, base.ConfidenceScore)
+	}
+}
@@ -0,0 +1,35 @@
+package risk
+
+// Thresholds for counterparty risk factors
+const (
+	concentratedShare = 0.8 // Largest licensee's share of royalty revenue
+	reliableScore     = 70  // Lowest licensee credit score considered reliable
+)
+
+// Counterparties describes the licensees paying an IP's royalties
+type Counterparties struct {
+	TopShare float64 // Largest licensee's share of royalty revenue, 0-1
+	Score    int     // Revenue-weighted licensee credit score, 0-100
+	Rated    bool    // At least one licensee has a payment history
+}
+
+// counterpartyFactors returns the risk factors of the paying licensees
+func counterpartyFactors(c *Counterparties) RiskFactor {
+	if c == nil {
+		return 0
+	}
+
+	var factors RiskFactor
+	if c.TopShare >= concentratedShare {
+		factors |= FactorLicenseeConcentration
+	}
+	if c.Rated && c.Score < reliableScore {
+		factors |= FactorUnreliableLicensee
+	}
+	return factors
+}
+
+// Factors returns the descriptions of the counterparty risk factors
+func (c *Counterparties) Factors() []string {
+	return counterpartyFactors(c).Strings()
+}
@@ -0,0 +1,26 @@
+package risk
+
+import "testing"
+
+func TestCounterpartyFactors(t *testing.T) {
+	tests := []struct {
+		name           string
+		counterparties *Counterparties
+		want           RiskFactor
+	}{
+		{"none", nil, 0},
+		{"diversified and reliable", &Counterparties{TopShare: 0.4, Score: 95, Rated: true}, 0},
+		{"single licensee", &Counterparties{TopShare: 1, Score: 90, Rated: true}, FactorLicenseeConcentration},
+		{"late payer", &Counterparties{TopShare: 0.5, Score: 60, Rated: true}, FactorUnreliableLicensee},
+		{"unrated", &Counterparties{TopShare: 0.5}, 0},
+		{"concentrated late payer", &Counterparties{TopShare: 0.9, Score: 20, Rated: true}, FactorLicenseeConcentration | FactorUnreliableLicensee},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterpartyFactors(tt.counterparties); got != tt.want {
+				t.Errorf("counterpartyFactors() = %v, want %v", got.Strings(), tt.want.Strings())
+			}
+		})
+	}
+}
@@ -9,29 +9,45 @@ import (
 
 	"github.com/knowton/bonding-service/internal/models"
 	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/taxonomy"
 )
 
+// CategoryResolver maps an IP category to its risk parameters
+type CategoryResolver interface {
+	Resolve(category string) taxonomy.Params
+}
+
 // RiskEngine assesses IP value and risk
 type RiskEngine struct {
-	oracleClient *oracle.OracleClient
+	oracleClient oracle.Valuer
 	useOracle    bool
+	categories   CategoryResolver
+	comparables  ComparableSales
 }
 
 // NewRiskEngine creates a new risk assessment engine
 func NewRiskEngine() *RiskEngine {
 	return &RiskEngine{
-		useOracle: false,
+		useOracle:  false,
+		categories: taxonomy.Default(),
 	}
 }
 
-// NewRiskEngineWithOracle creates a new risk assessment engine with Oracle Adapter integration
+// NewRiskEngineWithOracle creates a new risk assessment engine with Oracle Adapter integration.
+// Oracle calls are guarded so an unavailable oracle falls back quickly.
 func NewRiskEngineWithOracle(oracleURL string) *RiskEngine {
 	return &RiskEngine{
-		oracleClient: oracle.NewOracleClient(oracleURL),
+		oracleClient: oracle.NewGuard("default", oracle.NewOracleClient(oracleURL), oracle.DefaultGuardConfig()),
 		useOracle:    true,
+		categories:   taxonomy.Default(),
 	}
 }
 
+// SetCategories replaces the built-in category taxonomy
+func (re *RiskEngine) SetCategories(categories CategoryResolver) {
+	re.categories = categories
+}
+
 // AssessIPValue estimates the value and risk of an IP-NFT
 func (re *RiskEngine) AssessIPValue(ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error) {
 	var baseValuation float64
@@ -39,43 +55,57 @@ func (re *RiskEngine) AssessIPValue(ipnftID string, metadata *IPMetadata) (*mode
 	
 	// Try to use Oracle Adapter for more accurate valuation
 	if re.useOracle && re.oracleClient != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-		
-		// Prepare metadata for Oracle
-		oracleMetadata := map[string]interface{}{
-			"category":        metadata.Category,
-			"creator":         metadata.CreatorAddress,
-			"views":           metadata.Views,
-			"likes":           metadata.Likes,
-			"tags":            metadata.Tags,
-			"content_hash":    metadata.ContentHash,
-			"created_at":      metadata.CreatedAt.Unix(),
-			"quality_score":   0.7, // Would be calculated from content analysis
-			"rarity":          0.6,
-			"has_license":     1,
-			"is_verified":     1,
-		}
-		
-		// Call Oracle Adapter
-		valuation, err := re.oracleClient.EstimateValue(ctx, ipnftID, oracleMetadata, nil)
+		valuation, err := estimateWithOracle(context.Background(), re.oracleClient, ipnftID, metadata)
 		if err != nil {
 			// Fallback to rule-based valuation
 			fmt.Printf("Oracle valuation failed, using fallback: %v\n", err)
-			baseValuation = re.calculateBaseValuation(metadata)
-			confidence = re.calculateConfidenceScore(metadata)
+			baseValuation, confidence = re.ruleBasedValuation(metadata)
 		} else {
 			// Use Oracle valuation
 			baseValuation = valuation.EstimatedValue
 			confidence = 1.0 - valuation.ModelUncertainty
 			fmt.Printf("Oracle valuation successful: $%.2f (confidence: %.2f)\n", baseValuation, confidence)
+			re.recordOracleComparables(context.Background(), metadata, valuation)
 		}
 	} else {
 		// Use rule-based valuation
-		baseValuation = re.calculateBaseValuation(metadata)
-		confidence = re.calculateConfidenceScore(metadata)
+		baseValuation, confidence = re.ruleBasedValuation(metadata)
+	}
+	
+	return re.assess(ipnftID, metadata, baseValuation, confidence)
+}
+
+// ruleBasedValuation values an IP-NFT from its metadata and comparable sales
+func (re *RiskEngine) ruleBasedValuation(metadata *IPMetadata) (float64, float64) {
+	return re.blendComparables(context.Background(), metadata,
+		re.calculateBaseValuation(metadata), re.calculateConfidenceScore(metadata))
+}
+
+// estimateWithOracle asks the Oracle Adapter to value an IP-NFT
+func estimateWithOracle(ctx context.Context, client oracle.Valuer, ipnftID string, metadata *IPMetadata) (*oracle.ValuationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	
+	// Prepare metadata for Oracle
+	oracleMetadata := map[string]interface{}{
+		"category":        metadata.Category,
+		"creator":         metadata.CreatorAddress,
+		"views":           metadata.Views,
+		"likes":           metadata.Likes,
+		"tags":            metadata.Tags,
+		"content_hash":    metadata.ContentHash,
+		"created_at":      metadata.CreatedAt.Unix(),
+		"quality_score":   0.7, // Would be calculated from content analysis
+		"rarity":          0.6,
+		"has_license":     1,
+		"is_verified":     1,
 	}
 	
+	return client.EstimateValue(ctx, ipnftID, oracleMetadata, nil)
+}
+
+// assess rates the risk of an IP-NFT valued at baseValuation
+func (re *RiskEngine) assess(ipnftID string, metadata *IPMetadata, baseValuation, confidence float64) (*models.RiskAssessment, error) {
 	// 2. Assess risk factors
 	riskFactors := re.identifyRiskFactors(metadata)
 	
@@ -127,6 +157,12 @@ func (re *RiskEngine) calculateBaseValuation(metadata *IPMetadata) float64 {
 	// Calculate base valuation
 	baseValue := (engagementScore + creatorScore) * categoryMultiplier * ageFactor
 	
+	// 5. Remaining legal term of a patent or trademark registration
+	baseValue *= legalLifeFactor(metadata.Registration, time.Now())
+	
+	// 6. Remaining term of the license generating the revenue
+	baseValue *= licenseLifeFactor(metadata.License, time.Now())
+	
 	// Ensure minimum valuation
 	if baseValue < 100 {
 		baseValue = 100
@@ -137,20 +173,7 @@ func (re *RiskEngine) calculateBaseValuation(metadata *IPMetadata) float64 {
 
 // getCategoryMultiplier returns a multiplier based on content category
 func (re *RiskEngine) getCategoryMultiplier(category string) float64 {
-	multipliers := map[string]float64{
-		"music":    1.5,
-		"video":    2.0,
-		"ebook":    1.2,
-		"course":   1.8,
-		"software": 2.5,
-		"artwork":  3.0,
-		"research": 1.3,
-	}
-	
-	if mult, ok := multipliers[category]; ok {
-		return mult
-	}
-	return 1.0
+	return re.categories.Resolve(category).Multiplier
 }
 
 // identifyRiskFactors identifies potential risk factors
@@ -173,10 +196,19 @@ func (re *RiskEngine) identifyRiskFactors(metadata *IPMetadata) []string {
 	}
 	
 	// Category-specific risks
-	if metadata.Category == "software" {
+	if re.categories.Resolve(metadata.Category).ObsolescenceRisk {
 		factors = append(factors, "Technology obsolescence risk")
 	}
 	
+	// Registered IP: verification and remaining legal term
+	factors = append(factors, registrationFactors(metadata.Registration, time.Now()).Strings()...)
+	
+	// License agreement: exclusivity, territory and remaining term
+	factors = append(factors, licenseFactors(metadata.License, time.Now()).Strings()...)
+	
+	// Licensees: revenue concentration and payment reliability
+	factors = append(factors, counterpartyFactors(metadata.Counterparties).Strings()...)
+	
 	return factors
 }
 
@@ -306,4 +338,7 @@ type IPMetadata struct {
 	Likes          int32
 	Tags           []string
 	ContentHash    string
+	Registration   *RegisteredIP   // Set for patents and trademarks
+	License        *LicenseTerms   // Set when a license agreement generates the revenue
+	Counterparties *Counterparties // Set when licensees pay the royalties
 }
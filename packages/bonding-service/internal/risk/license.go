@@ -0,0 +1,45 @@
+package risk
+
+import (
+	"time"
+)
+
+// LicenseTerms are the terms of the license agreement generating the IP's revenue
+type LicenseTerms struct {
+	EndsAt    time.Time // Zero for a perpetual license
+	Exclusive bool
+	Worldwide bool
+}
+
+// licenseLifeFactor scales valuation by the license's remaining term.
+// Unlicensed IP and perpetual licenses are unaffected.
+func licenseLifeFactor(l *LicenseTerms, now time.Time) float64 {
+	if l == nil {
+		return 1.0
+	}
+	return termFactor(l.EndsAt, now)
+}
+
+// licenseFactors returns the risk factors of a license agreement
+func licenseFactors(l *LicenseTerms, now time.Time) RiskFactor {
+	if l == nil {
+		return 0
+	}
+
+	var factors RiskFactor
+	if !l.Exclusive {
+		factors |= FactorNonExclusiveLicense
+	}
+	if !l.Worldwide {
+		factors |= FactorTerritoryLimited
+	}
+	if !l.EndsAt.IsZero() {
+		remaining := l.EndsAt.Sub(now)
+		if remaining <= 0 {
+			factors |= FactorLicenseEnded
+		} else if remaining < registrationExpiryWarning {
+			factors |= FactorLicenseEnding
+		}
+	}
+	return factors
+}
@@ -0,0 +1,37 @@
+package risk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLicenseTerms(t *testing.T) {
+	now := time.Now()
+	year := 365 * 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		license     *LicenseTerms
+		wantFactor  float64
+		wantFactors RiskFactor
+	}{
+		{"unlicensed", nil, 1, 0},
+		{"perpetual exclusive worldwide", &LicenseTerms{Exclusive: true, Worldwide: true}, 1, 0},
+		{"three years left", &LicenseTerms{EndsAt: now.Add(3 * year), Exclusive: true, Worldwide: true}, 0.6, 0},
+		{"ending", &LicenseTerms{EndsAt: now.Add(year), Exclusive: true, Worldwide: true}, 0.2, FactorLicenseEnding},
+		{"ended", &LicenseTerms{EndsAt: now.Add(-year), Exclusive: true, Worldwide: true}, 0, FactorLicenseEnded},
+		{"non-exclusive regional", &LicenseTerms{}, 1, FactorNonExclusiveLicense | FactorTerritoryLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := licenseLifeFactor(tt.license, now); math.Abs(got-tt.wantFactor) > 1e-9 {
+				t.Errorf("licenseLifeFactor() = %v, want %v", got, tt.wantFactor)
+			}
+			if got := licenseFactors(tt.license, now); got != tt.wantFactors {
+				t.Errorf("licenseFactors() = %v, want %v", got.Strings(), tt.wantFactors.Strings())
+			}
+		})
+	}
+}
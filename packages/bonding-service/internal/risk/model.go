@@ -0,0 +1,85 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/oracle"
+)
+
+// Names of the built-in risk models
+const (
+	HeuristicModel = "heuristic"
+	OracleModel    = "oracle"
+)
+
+// RiskModel values an IP-NFT and rates its risk. Models are registered side
+// by side in a Registry and selected per request or per category.
+type RiskModel interface {
+	AssessIPValue(ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error)
+	// SupportedCategories lists the categories the model can assess; nil
+	// means every category
+	SupportedCategories() []string
+	Version() string
+}
+
+// ContextModel is a RiskModel whose assessments take the request context, so
+// they can be cancelled and see request values such as the tenant billed for
+// oracle calls
+type ContextModel interface {
+	RiskModel
+	AssessIPValueContext(ctx context.Context, ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error)
+}
+
+// HeuristicVersion identifies the rule-based scoring; bump it when the rules change
+const HeuristicVersion = "heuristic-2"
+
+// SupportedCategories returns nil; the rule-based model assesses every category
+func (re *RiskEngine) SupportedCategories() []string {
+	return nil
+}
+
+// Version returns HeuristicVersion
+func (re *RiskEngine) Version() string {
+	return HeuristicVersion
+}
+
+// OracleRiskModel values IP-NFTs with the Oracle Adapter, or an Aggregator
+// over several oracles, and rates their risk
+// with the rule-based factors. Unlike NewRiskEngineWithOracle it fails rather
+// than fall back when the oracle is unavailable, leaving fallback to the Registry.
+type OracleRiskModel struct {
+	client oracle.Valuer
+	rules  *RiskEngine
+}
+
+// NewOracleRiskModel creates an oracle-backed model rating risk with rules
+func NewOracleRiskModel(client oracle.Valuer, rules *RiskEngine) *OracleRiskModel {
+	return &OracleRiskModel{client: client, rules: rules}
+}
+
+// AssessIPValue values the IP-NFT with the oracle
+func (m *OracleRiskModel) AssessIPValue(ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error) {
+	return m.AssessIPValueContext(context.Background(), ipnftID, metadata)
+}
+
+// AssessIPValueContext values the IP-NFT with the oracle on behalf of ctx
+func (m *OracleRiskModel) AssessIPValueContext(ctx context.Context, ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error) {
+	valuation, err := estimateWithOracle(ctx, m.client, ipnftID, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("oracle valuation failed: %w", err)
+	}
+	m.rules.recordOracleComparables(ctx, metadata, valuation)
+	return m.rules.assess(ipnftID, metadata, valuation.EstimatedValue, 1.0-valuation.ModelUncertainty)
+}
+
+// SupportedCategories returns nil; the oracle values every category
+func (m *OracleRiskModel) SupportedCategories() []string {
+	return nil
+}
+
+// Version identifies the oracle valuation combined with the rule-based rating
+func (m *OracleRiskModel) Version() string {
+	return "oracle-1+" + HeuristicVersion
+}
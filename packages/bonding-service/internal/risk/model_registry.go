@@ -0,0 +1,177 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+)
+
+// Errors returned when selecting a model
+var (
+	ErrUnknownModel        = errors.New("unknown risk model")
+	ErrUnsupportedCategory = errors.New("risk model does not support category")
+)
+
+// Registry holds the available risk models and picks one for each
+// assessment: the model named in the request, else the one routed for the
+// IP's category, else the default
+type Registry struct {
+	mu          sync.RWMutex
+	models      map[string]RiskModel
+	byCategory  map[string]string
+	defaultName string
+}
+
+// NewRegistry creates a registry whose default is model, registered as name
+func NewRegistry(name string, model RiskModel) *Registry {
+	return &Registry{
+		models:      map[string]RiskModel{name: model},
+		byCategory:  make(map[string]string),
+		defaultName: name,
+	}
+}
+
+// Register adds or replaces a model
+func (r *Registry) Register(name string, model RiskModel) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.models[name] = model
+}
+
+// SetDefault selects the model used when neither the request nor the
+// category names one
+func (r *Registry) SetDefault(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.models[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownModel, name)
+	}
+	r.defaultName = name
+	return nil
+}
+
+// SetCategoryModel routes assessments of a category to the named model
+func (r *Registry) SetCategoryModel(category, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	model, ok := r.models[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownModel, name)
+	}
+	category = taxonomy.Normalize(category)
+	if !supports(model, category) {
+		return fmt.Errorf("%w: %s does not assess %s", ErrUnsupportedCategory, name, category)
+	}
+	r.byCategory[category] = name
+	return nil
+}
+
+// Names returns the registered model names in order
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.models))
+	for name := range r.models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Model returns a registered model
+func (r *Registry) Model(name string) (RiskModel, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	model, ok := r.models[name]
+	return model, ok
+}
+
+// Default returns the name of the default model
+func (r *Registry) Default() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultName
+}
+
+// CategoryModels returns the model each routed category is assessed with
+func (r *Registry) CategoryModels() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	routes := make(map[string]string, len(r.byCategory))
+	for category, name := range r.byCategory {
+		routes[category] = name
+	}
+	return routes
+}
+
+// Select returns the model to assess an IP of category with. An empty name
+// routes by category.
+func (r *Registry) Select(name, category string) (string, RiskModel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	category = taxonomy.Normalize(category)
+
+	if name == "" {
+		if routed, ok := r.byCategory[category]; ok {
+			return routed, r.models[routed], nil
+		}
+		name = r.defaultName
+	}
+	model, ok := r.models[name]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnknownModel, name)
+	}
+	if !supports(model, category) {
+		return "", nil, fmt.Errorf("%w: %s does not assess %s", ErrUnsupportedCategory, name, category)
+	}
+	return name, model, nil
+}
+
+// Assess assesses an IP-NFT with the selected model and records which model
+// and version produced the assessment. If a model routed by category fails,
+// the default model is used instead; a model named in the request never
+// falls back. Models implementing ContextModel are passed ctx.
+func (r *Registry) Assess(ctx context.Context, name, ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error) {
+	selected, model, err := r.Select(name, metadata.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	var assessment *models.RiskAssessment
+	if m, ok := model.(ContextModel); ok {
+		assessment, err = m.AssessIPValueContext(ctx, ipnftID, metadata)
+	} else {
+		assessment, err = model.AssessIPValue(ipnftID, metadata)
+	}
+	if err != nil && name == "" {
+		if defaultName := r.Default(); selected != defaultName {
+			log.Printf("Risk model %s failed, using %s: %v", selected, defaultName, err)
+			return r.Assess(ctx, defaultName, ipnftID, metadata)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	assessment.RiskModel = selected
+	assessment.RiskModelVersion = model.Version()
+	return assessment, nil
+}
+
+func supports(model RiskModel, category string) bool {
+	categories := model.SupportedCategories()
+	if categories == nil {
+		return true
+	}
+	for _, c := range categories {
+		if taxonomy.Normalize(c) == category {
+			return true
+		}
+	}
+	return false
+}
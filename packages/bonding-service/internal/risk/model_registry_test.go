@@ -0,0 +1,80 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// stubModel returns a fixed valuation, or err
+type stubModel struct {
+	valuation  float64
+	categories []string
+	err        error
+}
+
+func (m *stubModel) AssessIPValue(ipnftID string, metadata *IPMetadata) (*models.RiskAssessment, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &models.RiskAssessment{IPNFTId: ipnftID, ValuationUSD: m.valuation}, nil
+}
+
+func (m *stubModel) SupportedCategories() []string { return m.categories }
+
+func (m *stubModel) Version() string { return "stub-1" }
+
+func TestRegistryAssess(t *testing.T) {
+	registry := NewRegistry(HeuristicModel, NewRiskEngine())
+	registry.Register("ml", &stubModel{valuation: 42, categories: []string{"Music"}})
+	registry.Register("down", &stubModel{err: errors.New("oracle unavailable")})
+	if err := registry.SetCategoryModel("music", "ml"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.SetCategoryModel("patent", "down"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.SetCategoryModel("software", "ml"); !errors.Is(err, ErrUnsupportedCategory) {
+		t.Errorf("routing an unsupported category error = %v, want ErrUnsupportedCategory", err)
+	}
+
+	tests := []struct {
+		name      string
+		model     string
+		category  string
+		wantModel string
+		wantErr   error
+		wantFail  bool
+	}{
+		{"routed by category", "", "music", "ml", nil, false},
+		{"default", "", "software", HeuristicModel, nil, false},
+		{"named in the request", HeuristicModel, "music", HeuristicModel, nil, false},
+		{"routed model falls back to the default", "", "patent", HeuristicModel, nil, false},
+		{"named model never falls back", "down", "patent", "", nil, true},
+		{"unknown model", "gpt", "music", "", ErrUnknownModel, true},
+		{"unsupported category", "ml", "software", "", ErrUnsupportedCategory, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := &IPMetadata{Category: tt.category, CreatedAt: time.Now().AddDate(-1, 0, 0), Views: 500, Likes: 50}
+			assessment, err := registry.Assess(context.Background(), tt.model, "ipnft-1", metadata)
+			if (err != nil) != tt.wantFail || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("Assess() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if assessment.RiskModel != tt.wantModel {
+				t.Errorf("RiskModel = %q, want %q", assessment.RiskModel, tt.wantModel)
+			}
+			model, _ := registry.Model(tt.wantModel)
+			if assessment.RiskModelVersion != model.Version() {
+				t.Errorf("RiskModelVersion = %q, want %q", assessment.RiskModelVersion, model.Version())
+			}
+		})
+	}
+}
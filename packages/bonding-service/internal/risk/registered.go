@@ -0,0 +1,62 @@
+package risk
+
+import (
+	"math"
+	"time"
+)
+
+// RegisteredIP is a patent or trademark registration backing an IP-NFT
+type RegisteredIP struct {
+	Kind         string // PATENT or TRADEMARK
+	Number       string
+	Jurisdiction string
+	ExpiresAt    time.Time // Zero when the registration has no fixed term
+	Verified     bool      // Confirmed against the official registry
+}
+
+// registrationFullValueYears is the remaining legal term from which the
+// registration no longer reduces the valuation
+const registrationFullValueYears = 5.0
+
+// registrationExpiryWarning flags registrations expiring within this window
+const registrationExpiryWarning = 2 * 365 * 24 * time.Hour
+
+// legalLifeFactor scales valuation by the registration's remaining term.
+// Unregistered IP and registrations without a term are unaffected.
+func legalLifeFactor(reg *RegisteredIP, now time.Time) float64 {
+	if reg == nil {
+		return 1.0
+	}
+	return termFactor(reg.ExpiresAt, now)
+}
+
+// termFactor is the share of value left to a right ending at expiresAt,
+// falling linearly over the last registrationFullValueYears
+func termFactor(expiresAt time.Time, now time.Time) float64 {
+	if expiresAt.IsZero() {
+		return 1.0
+	}
+	remainingYears := expiresAt.Sub(now).Hours() / 24 / 365
+	return math.Max(0, math.Min(1, remainingYears/registrationFullValueYears))
+}
+
+// registrationFactors returns the risk factors of a registration
+func registrationFactors(reg *RegisteredIP, now time.Time) RiskFactor {
+	if reg == nil {
+		return 0
+	}
+
+	var factors RiskFactor
+	if !reg.Verified {
+		factors |= FactorUnverifiedRegistration
+	}
+	if !reg.ExpiresAt.IsZero() {
+		remaining := reg.ExpiresAt.Sub(now)
+		if remaining <= 0 {
+			factors |= FactorRegistrationExpired
+		} else if remaining < registrationExpiryWarning {
+			factors |= FactorRegistrationExpiring
+		}
+	}
+	return factors
+}
@@ -0,0 +1,38 @@
+package risk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRegisteredIP(t *testing.T) {
+	now := time.Now()
+	year := 365 * 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		reg         *RegisteredIP
+		wantFactor  float64
+		wantFactors RiskFactor
+	}{
+		{"unregistered", nil, 1, 0},
+		{"no fixed term", &RegisteredIP{Kind: "TRADEMARK", Verified: true}, 1, 0},
+		{"long term", &RegisteredIP{ExpiresAt: now.Add(12 * year), Verified: true}, 1, 0},
+		{"three years left", &RegisteredIP{ExpiresAt: now.Add(3 * year), Verified: true}, 0.6, 0},
+		{"expiring", &RegisteredIP{ExpiresAt: now.Add(year), Verified: true}, 0.2, FactorRegistrationExpiring},
+		{"expired", &RegisteredIP{ExpiresAt: now.Add(-year), Verified: true}, 0, FactorRegistrationExpired},
+		{"unverified", &RegisteredIP{ExpiresAt: now.Add(12 * year)}, 1, FactorUnverifiedRegistration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := legalLifeFactor(tt.reg, now); math.Abs(got-tt.wantFactor) > 1e-9 {
+				t.Errorf("legalLifeFactor() = %v, want %v", got, tt.wantFactor)
+			}
+			if got := registrationFactors(tt.reg, now); got != tt.wantFactors {
+				t.Errorf("registrationFactors() = %v, want %v", got.Strings(), tt.wantFactors.Strings())
+			}
+		})
+	}
+}
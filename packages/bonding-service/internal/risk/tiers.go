@@ -0,0 +1,107 @@
+package risk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// Built-in risk tiers, from least to most risky
+const (
+	TierConservative = "CONSERVATIVE"
+	TierBalanced     = "BALANCED"
+	TierAggressive   = "AGGRESSIVE"
+)
+
+// TierRule places an assessment in Tier when it meets every threshold
+type TierRule struct {
+	Tier                  string
+	MinRating             Rating  // Worst rating allowed
+	MaxDefaultProbability float64 // 0 for no limit
+	MinConfidence         float64
+}
+
+// Tiers maps ratings and their metrics to the few risk tiers retail UIs show
+// investors. Rules are tried in order; assessments meeting none, or with an
+// unknown rating, fall in the fallback tier.
+type Tiers struct {
+	rules    []TierRule
+	fallback string
+}
+
+// DefaultTiers returns the built-in mapping: investment grade with a low
+// default probability is conservative, down to BB balanced, the rest aggressive
+func DefaultTiers() *Tiers {
+	return &Tiers{
+		rules: []TierRule{
+			{Tier: TierConservative, MinRating: RatingA, MaxDefaultProbability: 0.05, MinConfidence: 0.6},
+			{Tier: TierBalanced, MinRating: RatingBB, MaxDefaultProbability: 0.20},
+		},
+		fallback: TierAggressive,
+	}
+}
+
+// ParseTiers parses a mapping of the form
+// "CONSERVATIVE=A:0.05:0.6,BALANCED=BB:0.2,AGGRESSIVE": each rule names a tier,
+// its worst rating and optionally its maximum default probability and minimum
+// confidence, and the last entry is the fallback tier. An empty spec returns
+// DefaultTiers.
+func ParseTiers(spec string) (*Tiers, error) {
+	if strings.TrimSpace(spec) == "" {
+		return DefaultTiers(), nil
+	}
+
+	entries := strings.Split(spec, ",")
+	tiers := &Tiers{fallback: strings.ToUpper(strings.TrimSpace(entries[len(entries)-1]))}
+	if tiers.fallback == "" || strings.Contains(tiers.fallback, "=") {
+		return nil, fmt.Errorf("the last entry must name the fallback tier, got %q", entries[len(entries)-1])
+	}
+
+	for _, entry := range entries[:len(entries)-1] {
+		tier, thresholds, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || tier == "" {
+			return nil, fmt.Errorf("expected TIER=RATING[:MAX_DEFAULT_PROBABILITY[:MIN_CONFIDENCE]], got %q", entry)
+		}
+		parts := strings.Split(thresholds, ":")
+		if len(parts) > 3 {
+			return nil, fmt.Errorf("too many thresholds for tier %s", tier)
+		}
+		rule := TierRule{Tier: strings.ToUpper(tier)}
+		if rule.MinRating, ok = ParseRating(strings.ToUpper(parts[0])); !ok {
+			return nil, fmt.Errorf("unknown rating %q for tier %s", parts[0], tier)
+		}
+		limits := []*float64{&rule.MaxDefaultProbability, &rule.MinConfidence}
+		for i, part := range parts[1:] {
+			v, err := strconv.ParseFloat(part, 64)
+			if err != nil || v < 0 || v > 1 {
+				return nil, fmt.Errorf("invalid threshold %q for tier %s: want 0 to 1", part, tier)
+			}
+			*limits[i] = v
+		}
+		tiers.rules = append(tiers.rules, rule)
+	}
+	return tiers, nil
+}
+
+// Tier returns the tier of an assessment. A nil Tiers uses DefaultTiers.
+func (t *Tiers) Tier(a *models.RiskAssessment) string {
+	if t == nil {
+		t = DefaultTiers()
+	}
+	rating, ok := ParseRating(a.RiskRating)
+	if !ok {
+		return t.fallback
+	}
+	for _, rule := range t.rules {
+		if rating > rule.MinRating || a.ConfidenceScore < rule.MinConfidence {
+			continue
+		}
+		if rule.MaxDefaultProbability > 0 && a.DefaultProbability > rule.MaxDefaultProbability {
+			continue
+		}
+		return rule.Tier
+	}
+	return t.fallback
+}
@@ -0,0 +1,51 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestTiers(t *testing.T) {
+	custom, err := ParseTiers("safe=AA:0.02, moderate=bbb, speculative")
+	if err != nil {
+		t.Fatalf("ParseTiers() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		tiers      *Tiers
+		assessment models.RiskAssessment
+		want       string
+	}{
+		{"default investment grade", nil, models.RiskAssessment{RiskRating: "AA", DefaultProbability: 0.02, ConfidenceScore: 0.8}, TierConservative},
+		{"default low confidence", nil, models.RiskAssessment{RiskRating: "AA", DefaultProbability: 0.02, ConfidenceScore: 0.5}, TierBalanced},
+		{"default high default probability", nil, models.RiskAssessment{RiskRating: "A", DefaultProbability: 0.08, ConfidenceScore: 0.8}, TierBalanced},
+		{"default speculative", nil, models.RiskAssessment{RiskRating: "B", DefaultProbability: 0.35}, TierAggressive},
+		{"default unknown rating", nil, models.RiskAssessment{RiskRating: "D"}, TierAggressive},
+		{"custom", custom, models.RiskAssessment{RiskRating: "AAA", DefaultProbability: 0.01}, "SAFE"},
+		{"custom without probability limit", custom, models.RiskAssessment{RiskRating: "BBB", DefaultProbability: 0.4}, "MODERATE"},
+		{"custom fallback", custom, models.RiskAssessment{RiskRating: "BB"}, "SPECULATIVE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tiers.Tier(&tt.assessment); got != tt.want {
+				t.Errorf("Tier() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTiersErrors(t *testing.T) {
+	for _, spec := range []string{
+		"SAFE=AA",             // No fallback
+		"SAFE=Z,OTHER",        // Unknown rating
+		"SAFE=AA:2,OTHER",     // Probability out of range
+		"SAFE=AA:0.1:0.5:1,X", // Too many thresholds
+		"=AA,OTHER",
+	} {
+		if _, err := ParseTiers(spec); err == nil {
+			t.Errorf("ParseTiers(%q) succeeded, want an error", spec)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package rpcpool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// Config configures health checking and failover
+type Config struct {
+	HealthInterval time.Duration // How often endpoints are checked
+	MaxBlockLag    uint64        // Endpoints this many blocks behind the best endpoint are skipped
+	RequestTimeout time.Duration // Timeout for a single attempt against one endpoint
+}
+
+// DefaultConfig returns default pool configuration
+func DefaultConfig() Config {
+	return Config{
+		HealthInterval: 15 * time.Second,
+		MaxBlockLag:    20,
+		RequestTimeout: 10 * time.Second,
+	}
+}
+
+// writeMethods are JSON-RPC methods that submit transactions
+var writeMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// failoverCodes are JSON-RPC error codes that indicate a provider problem
+// rather than a problem with the request, so another endpoint may succeed
+var failoverCodes = map[int]bool{
+	-32603: true, // Internal error
+	-32005: true, // Limit exceeded
+	-32002: true, // Resource unavailable
+	-32001: true, // Resource not found (lagging node)
+	-32097: true, // Rate limited (Alchemy)
+	-32090: true, // Rate limited (Infura)
+}
+
+// EndpointStatus is the last observed state of an endpoint
+type EndpointStatus struct {
+	Host      string
+	Healthy   bool
+	Block     uint64
+	LastError string
+	CheckedAt time.Time
+}
+
+type endpoint struct {
+	url    string
+	host   string // Safe to log; the URL path often carries an API key
+	status EndpointStatus
+}
+
+// Pool spreads JSON-RPC traffic for one chain across several HTTP endpoints.
+// Reads are load-balanced over healthy endpoints; writes go to the most
+// up-to-date endpoint and fail over to the next on provider errors.
+// Endpoints that fail or fall behind on block height are skipped until
+// a health check passes again.
+type Pool struct {
+	mu        sync.RWMutex
+	chain     string
+	endpoints []*endpoint
+	config    Config
+	client    *http.Client
+	next      atomic.Uint64
+}
+
+// New creates a pool for a chain's endpoints. Only HTTP(S) endpoints are supported.
+func New(chain string, urls []string, config Config) (*Pool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("chain %s: at least one rpc url is required", chain)
+	}
+
+	p := &Pool{
+		chain:  chain,
+		config: config,
+		client: &http.Client{Timeout: config.RequestTimeout},
+	}
+	for _, raw := range urls {
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("chain %s: invalid rpc url: %w", chain, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("chain %s: rpc pool only supports http(s) endpoints, got %s", chain, u.Scheme)
+		}
+		p.endpoints = append(p.endpoints, &endpoint{
+			url:    u.String(),
+			host:   u.Host,
+			status: EndpointStatus{Host: u.Host, Healthy: true},
+		})
+	}
+	return p, nil
+}
+
+// Dial returns an ethclient that sends every request through the pool
+func (p *Pool) Dial(ctx context.Context) (*ethclient.Client, error) {
+	rpcClient, err := rpc.DialOptions(ctx, p.endpoints[0].url, rpc.WithHTTPClient(&http.Client{Transport: p}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client for chain %s: %w", p.chain, err)
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// Start runs health checks until the context is cancelled
+func (p *Pool) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.config.HealthInterval)
+	defer ticker.Stop()
+
+	p.CheckHealth(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.CheckHealth(ctx)
+		}
+	}
+}
+
+// CheckHealth queries every endpoint's block number and marks endpoints that
+// fail or lag more than MaxBlockLag blocks behind the best one as unhealthy
+func (p *Pool) CheckHealth(ctx context.Context) {
+	blocks := make([]uint64, len(p.endpoints))
+	errs := make([]error, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for i, ep := range p.endpoints {
+		wg.Add(1)
+		go func(i int, ep *endpoint) {
+			defer wg.Done()
+			blocks[i], errs[i] = p.blockNumber(ctx, ep)
+		}(i, ep)
+	}
+	wg.Wait()
+
+	var best uint64
+	for i := range p.endpoints {
+		if errs[i] == nil && blocks[i] > best {
+			best = blocks[i]
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for i, ep := range p.endpoints {
+		status := EndpointStatus{Host: ep.host, Healthy: true, Block: blocks[i], CheckedAt: now}
+		switch {
+		case errs[i] != nil:
+			status.Healthy = false
+			status.Block = ep.status.Block
+			status.LastError = errs[i].Error()
+		case best-blocks[i] > p.config.MaxBlockLag:
+			status.Healthy = false
+			status.LastError = fmt.Sprintf("behind by %d blocks", best-blocks[i])
+		}
+
+		if status.Healthy != ep.status.Healthy {
+			if status.Healthy {
+				log.Printf("RPC endpoint %s on %s recovered", ep.host, p.chain)
+			} else {
+				log.Printf("RPC endpoint %s on %s unhealthy: %s", ep.host, p.chain, status.LastError)
+			}
+		}
+		ep.status = status
+		p.recordMetrics(ep)
+	}
+}
+
+// Status returns the state of every endpoint
+func (p *Pool) Status() []EndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	statuses := make([]EndpointStatus, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		statuses[i] = ep.status
+	}
+	return statuses
+}
+
+// RoundTrip implements http.RoundTripper for the rpc client created by Dial
+func (p *Pool) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	candidates := p.candidates(isWrite(body))
+
+	var lastErr error
+	for i, ep := range candidates {
+		resp, err := p.send(req, ep, body)
+		if err == nil {
+			return resp, nil
+		}
+
+		lastErr = err
+		p.markFailed(ep, err)
+		if i < len(candidates)-1 {
+			metrics.RPCFailovers.WithLabelValues(p.chain, ep.host).Inc()
+		}
+		if req.Context().Err() != nil {
+			break
+		}
+	}
+	return nil, fmt.Errorf("all rpc endpoints for chain %s failed: %w", p.chain, lastErr)
+}
+
+// candidates orders endpoints for a request. Reads rotate across healthy
+// endpoints; writes prefer the highest block. Unhealthy endpoints come last
+// so a request is still attempted when every endpoint is marked down.
+func (p *Pool) candidates(write bool) []*endpoint {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var healthy, unhealthy []*endpoint
+	for _, ep := range p.endpoints {
+		if ep.status.Healthy {
+			healthy = append(healthy, ep)
+		} else {
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+
+	if write {
+		sort.SliceStable(healthy, func(i, j int) bool {
+			return healthy[i].status.Block > healthy[j].status.Block
+		})
+	} else if len(healthy) > 1 {
+		offset := int(p.next.Add(1) % uint64(len(healthy)))
+		rotated := make([]*endpoint, 0, len(healthy))
+		rotated = append(rotated, healthy[offset:]...)
+		healthy = append(rotated, healthy[:offset]...)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// send forwards a request to one endpoint. Provider failures are returned as
+// errors so the caller can fail over; other responses are returned as-is.
+func (p *Pool) send(req *http.Request, ep *endpoint, body []byte) (*http.Response, error) {
+	out, err := http.NewRequestWithContext(req.Context(), req.Method, ep.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range req.Header {
+		out.Header[key] = values
+	}
+
+	resp, err := p.client.Do(out)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s returned HTTP %d", ep.host, resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if code, message, ok := providerError(respBody); ok {
+		return nil, fmt.Errorf("%s returned rpc error %d: %s", ep.host, code, message)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+func (p *Pool) markFailed(ep *endpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ep.status.Healthy {
+		log.Printf("RPC endpoint %s on %s failed, failing over: %v", ep.host, p.chain, err)
+	}
+	ep.status.Healthy = false
+	ep.status.LastError = err.Error()
+	p.recordMetrics(ep)
+}
+
+func (p *Pool) recordMetrics(ep *endpoint) {
+	healthy := 0.0
+	if ep.status.Healthy {
+		healthy = 1
+	}
+	metrics.RPCEndpointHealthy.WithLabelValues(p.chain, ep.host).Set(healthy)
+	metrics.RPCEndpointBlock.WithLabelValues(p.chain, ep.host).Set(float64(ep.status.Block))
+}
+
+// blockNumber calls eth_blockNumber on a single endpoint
+func (p *Pool) blockNumber(ctx context.Context, ep *endpoint) (uint64, error) {
+	body := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Result string `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode eth_blockNumber response: %w", err)
+	}
+	if result.Error != nil {
+		return 0, fmt.Errorf("eth_blockNumber: %s", result.Error.Message)
+	}
+	return hexutil.DecodeUint64(result.Result)
+}
+
+type rpcMessage struct {
+	Method string `json:"method"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// decodeMessages decodes a single JSON-RPC message or a batch
+func decodeMessages(data []byte) []rpcMessage {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var batch []rpcMessage
+		if json.Unmarshal(data, &batch) == nil {
+			return batch
+		}
+		return nil
+	}
+	var msg rpcMessage
+	if json.Unmarshal(data, &msg) != nil {
+		return nil
+	}
+	return []rpcMessage{msg}
+}
+
+// isWrite reports whether a request body submits a transaction
+func isWrite(body []byte) bool {
+	for _, msg := range decodeMessages(body) {
+		if writeMethods[msg.Method] {
+			return true
+		}
+	}
+	return false
+}
+
+// providerError returns the first error in a response body that another endpoint may not hit
+func providerError(body []byte) (int, string, bool) {
+	for _, msg := range decodeMessages(body) {
+		if msg.Error != nil && failoverCodes[msg.Error.Code] {
+			return msg.Error.Code, msg.Error.Message, true
+		}
+	}
+	return 0, "", false
+}
@@ -0,0 +1,186 @@
+package rpcpool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeNode is a minimal JSON-RPC endpoint
+type fakeNode struct {
+	block     uint64
+	httpError int    // Status returned for every request when set
+	sendError string // JSON error object returned for eth_sendRawTransaction when set
+	reads     atomic.Int64
+	writes    atomic.Int64
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if n.httpError != 0 {
+		w.WriteHeader(n.httpError)
+		return
+	}
+
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch req.Method {
+	case "eth_blockNumber":
+		n.reads.Add(1)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, n.block)
+	case "eth_sendRawTransaction":
+		n.writes.Add(1)
+		if n.sendError != "" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":%s}`, req.ID, n.sendError)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x01"}`, req.ID)
+	default:
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+	}
+}
+
+func newTestPool(t *testing.T, nodes ...*fakeNode) *Pool {
+	t.Helper()
+
+	var urls []string
+	for _, node := range nodes {
+		server := httptest.NewServer(node)
+		t.Cleanup(server.Close)
+		urls = append(urls, server.URL)
+	}
+
+	config := DefaultConfig()
+	config.MaxBlockLag = 5
+	config.RequestTimeout = time.Second
+	pool, err := New("test", urls, config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return pool
+}
+
+func sendRaw(t *testing.T, pool *Pool) error {
+	t.Helper()
+	client, err := pool.Dial(context.Background())
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	var hash string
+	return client.Client().CallContext(context.Background(), &hash, "eth_sendRawTransaction", "0x00")
+}
+
+func TestNewRejectsNonHTTP(t *testing.T) {
+	if _, err := New("test", []string{"wss://node.example"}, DefaultConfig()); err == nil {
+		t.Errorf("New() accepted a websocket endpoint")
+	}
+	if _, err := New("test", nil, DefaultConfig()); err == nil {
+		t.Errorf("New() accepted no endpoints")
+	}
+}
+
+func TestReadsAreLoadBalanced(t *testing.T) {
+	a, b := &fakeNode{block: 100}, &fakeNode{block: 100}
+	pool := newTestPool(t, a, b)
+
+	client, err := pool.Dial(context.Background())
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := client.BlockNumber(context.Background()); err != nil {
+			t.Fatalf("BlockNumber() error = %v", err)
+		}
+	}
+
+	if a.reads.Load() != 5 || b.reads.Load() != 5 {
+		t.Errorf("reads = %d/%d, want 5/5", a.reads.Load(), b.reads.Load())
+	}
+}
+
+func TestLaggingEndpointIsSkipped(t *testing.T) {
+	fresh, stale := &fakeNode{block: 100}, &fakeNode{block: 90}
+	pool := newTestPool(t, fresh, stale)
+	pool.CheckHealth(context.Background())
+
+	status := pool.Status()
+	if !status[0].Healthy || status[1].Healthy {
+		t.Fatalf("Status() = %+v, want only the first endpoint healthy", status)
+	}
+
+	stale.reads.Store(0)
+	client, _ := pool.Dial(context.Background())
+	for i := 0; i < 4; i++ {
+		if _, err := client.BlockNumber(context.Background()); err != nil {
+			t.Fatalf("BlockNumber() error = %v", err)
+		}
+	}
+	if stale.reads.Load() != 0 {
+		t.Errorf("lagging endpoint served %d reads", stale.reads.Load())
+	}
+}
+
+func TestWriteFailover(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpError  int
+		sendError  string
+		wantErr    bool
+		wantBackup int64
+	}{
+		{"primary ok", 0, "", false, 0},
+		{"http error", http.StatusBadGateway, "", false, 1},
+		{"rate limited", http.StatusTooManyRequests, "", false, 1},
+		{"internal error", 0, `{"code":-32603,"message":"internal error"}`, false, 1},
+		{"rejected transaction", 0, `{"code":-32000,"message":"nonce too low"}`, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &fakeNode{block: 100, httpError: tt.httpError, sendError: tt.sendError}
+			backup := &fakeNode{block: 99}
+			pool := newTestPool(t, primary, backup)
+			if primary.httpError == 0 {
+				pool.CheckHealth(context.Background())
+			}
+
+			err := sendRaw(t, pool)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("send error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := backup.writes.Load(); got != tt.wantBackup {
+				t.Errorf("backup writes = %d, want %d", got, tt.wantBackup)
+			}
+		})
+	}
+}
+
+func TestFailedEndpointRecovers(t *testing.T) {
+	node := &fakeNode{block: 100}
+	backup := &fakeNode{block: 100}
+	pool := newTestPool(t, node, backup)
+
+	node.httpError = http.StatusServiceUnavailable
+	pool.CheckHealth(context.Background())
+	if pool.Status()[0].Healthy {
+		t.Fatalf("endpoint returning 503 is healthy")
+	}
+
+	node.httpError = 0
+	pool.CheckHealth(context.Background())
+	if !pool.Status()[0].Healthy {
+		t.Errorf("endpoint did not recover: %+v", pool.Status()[0])
+	}
+}
@@ -0,0 +1,168 @@
+// Package rules evaluates operator-configured business rules, written as CEL
+// expressions, at issuance, investment and distribution. A rule file looks like
+//
+//	{"rules": [{
+//	  "name": "junior-premium",
+//	  "point": "issuance",
+//	  "expression": "tranches.junior.apy >= tranches.senior.apy + 3.0",
+//	  "message": "junior APY must exceed senior APY by at least 3%"
+//	}]}
+//
+// Amounts are exposed as doubles so they compare without overflowing int64.
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// Evaluation points and the variables their rules can use
+const (
+	Issuance     = "issuance"     // bond, tranches (senior, mezzanine, junior), risk
+	Investment   = "investment"   // bond, tranche, investment
+	Distribution = "distribution" // bond, distribution
+)
+
+var pointVariables = map[string][]string{
+	Issuance:     {"bond", "tranches", "risk"},
+	Investment:   {"bond", "tranche", "investment"},
+	Distribution: {"bond", "distribution"},
+}
+
+// Rule is a CEL expression that must evaluate to true at its point
+type Rule struct {
+	Name       string `json:"name"`
+	Point      string `json:"point"`
+	Expression string `json:"expression"`
+	Message    string `json:"message"` // Returned when the rule fails, defaults to the expression
+}
+
+// Violation is a rule that failed
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// ViolationError is returned when one or more rules fail
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%s: %s", v.Rule, v.Message)
+	}
+	return "business rule violated: " + strings.Join(messages, "; ")
+}
+
+type compiled struct {
+	Rule
+	program cel.Program
+}
+
+// Engine holds compiled rules by evaluation point
+type Engine struct {
+	rules  []Rule
+	points map[string][]compiled
+}
+
+// LoadFile reads and compiles a JSON rule file
+func LoadFile(path string) (*Engine, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read business rules: %w", err)
+	}
+
+	var file struct {
+		Rules []Rule `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse business rules: %w", err)
+	}
+	return New(file.Rules)
+}
+
+// New compiles rules. Every rule needs a unique name, a known point and a
+// boolean expression over that point's variables.
+func New(rules []Rule) (*Engine, error) {
+	envs := make(map[string]*cel.Env, len(pointVariables))
+	for point, names := range pointVariables {
+		options := make([]cel.EnvOption, len(names))
+		for i, name := range names {
+			options[i] = cel.Variable(name, cel.MapType(cel.StringType, cel.DynType))
+		}
+		env, err := cel.NewEnv(options...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s rule environment: %w", point, err)
+		}
+		envs[point] = env
+	}
+
+	e := &Engine{rules: rules, points: make(map[string][]compiled)}
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if r.Name == "" {
+			return nil, errors.New("business rule without a name")
+		}
+		if seen[r.Name] {
+			return nil, fmt.Errorf("duplicate business rule %s", r.Name)
+		}
+		seen[r.Name] = true
+
+		env, ok := envs[r.Point]
+		if !ok {
+			return nil, fmt.Errorf("business rule %s has unknown point %q", r.Name, r.Point)
+		}
+		ast, issues := env.Compile(r.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("business rule %s does not compile: %w", r.Name, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("business rule %s must evaluate to a bool, not %s", r.Name, ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("business rule %s: %w", r.Name, err)
+		}
+		if r.Message == "" {
+			r.Message = r.Expression
+		}
+		e.points[r.Point] = append(e.points[r.Point], compiled{Rule: r, program: program})
+	}
+	return e, nil
+}
+
+// Rules returns the configured rules
+func (e *Engine) Rules() []Rule {
+	return e.rules
+}
+
+// Check evaluates every rule at a point and returns a *ViolationError listing
+// the ones that failed. Rules that can't be evaluated, for example because
+// they read a missing field, fail closed.
+func (e *Engine) Check(point string, vars map[string]interface{}) error {
+	var violations []Violation
+	for _, r := range e.points[point] {
+		out, _, err := r.program.Eval(vars)
+		if err != nil {
+			violations = append(violations, Violation{Rule: r.Name, Message: fmt.Sprintf("could not be evaluated: %v", err)})
+			metrics.RuleViolations.WithLabelValues(point, r.Name).Inc()
+			continue
+		}
+		if passed, ok := out.Value().(bool); !ok || !passed {
+			violations = append(violations, Violation{Rule: r.Name, Message: r.Message})
+			metrics.RuleViolations.WithLabelValues(point, r.Name).Inc()
+		}
+	}
+	if len(violations) > 0 {
+		return &ViolationError{Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	engine, err := New([]Rule{
+		{
+			Name:       "junior-premium",
+			Point:      Issuance,
+			Expression: "tranches.junior.apy >= tranches.senior.apy + 3.0",
+			Message:    "junior APY must exceed senior APY by at least 3%",
+		},
+		{Name: "rated", Point: Issuance, Expression: `risk.rating != "D"`},
+		{Name: "max-ticket", Point: Investment, Expression: "investment.amount <= tranche.allocation * 0.25"},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	issuance := func(senior, junior float64, rating string) map[string]interface{} {
+		return map[string]interface{}{
+			"bond": map[string]interface{}{},
+			"tranches": map[string]interface{}{
+				"senior": map[string]interface{}{"apy": senior},
+				"junior": map[string]interface{}{"apy": junior},
+			},
+			"risk": map[string]interface{}{"rating": rating},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		point string
+		vars  map[string]interface{}
+		want  []string
+	}{
+		{"passes", Issuance, issuance(5, 8, "A"), nil},
+		{"premium too small", Issuance, issuance(5, 7.5, "A"), []string{"junior-premium"}},
+		{"two violations", Issuance, issuance(5, 6, "D"), []string{"junior-premium", "rated"}},
+		{"missing field fails closed", Issuance, map[string]interface{}{
+			"bond": map[string]interface{}{}, "tranches": map[string]interface{}{}, "risk": map[string]interface{}{"rating": "A"},
+		}, []string{"junior-premium"}},
+		{"other point unaffected", Distribution, map[string]interface{}{"bond": map[string]interface{}{}}, nil},
+		{"ticket too large", Investment, map[string]interface{}{
+			"bond":       map[string]interface{}{},
+			"tranche":    map[string]interface{}{"allocation": 1000.0},
+			"investment": map[string]interface{}{"amount": 300.0},
+		}, []string{"max-ticket"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := engine.Check(tt.point, tt.vars)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Check() error = %v", err)
+				}
+				return
+			}
+
+			var violation *ViolationError
+			if !errors.As(err, &violation) {
+				t.Fatalf("Check() error = %v, want a ViolationError", err)
+			}
+			if len(violation.Violations) != len(tt.want) {
+				t.Fatalf("Check() violations = %+v, want %v", violation.Violations, tt.want)
+			}
+			for i, v := range violation.Violations {
+				if v.Rule != tt.want[i] {
+					t.Errorf("violation %d = %s, want %s", i, v.Rule, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{"no name", Rule{Point: Issuance, Expression: "true"}},
+		{"unknown point", Rule{Name: "r", Point: "redemption", Expression: "true"}},
+		{"syntax error", Rule{Name: "r", Point: Issuance, Expression: "tranches.junior.apy >="}},
+		{"unknown variable", Rule{Name: "r", Point: Distribution, Expression: "tranche.apy > 1.0"}},
+		{"not a bool", Rule{Name: "r", Point: Issuance, Expression: "1 + 2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New([]Rule{tt.rule}); err == nil {
+				t.Errorf("New() accepted an invalid rule")
+			}
+		})
+	}
+
+	if _, err := New([]Rule{{Name: "r", Point: Issuance, Expression: "true"}, {Name: "r", Point: Investment, Expression: "true"}}); err == nil {
+		t.Errorf("New() accepted duplicate rule names")
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	data := `{"rules": [{"name": "positive", "point": "distribution", "expression": "distribution.revenue > 0.0"}]}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	engine, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if rules := engine.Rules(); len(rules) != 1 || rules[0].Name != "positive" {
+		t.Errorf("Rules() = %+v", rules)
+	}
+	if err := engine.Check(Distribution, map[string]interface{}{
+		"bond": map[string]interface{}{}, "distribution": map[string]interface{}{"revenue": 0.0},
+	}); err == nil {
+		t.Errorf("Check() accepted a zero distribution")
+	}
+}
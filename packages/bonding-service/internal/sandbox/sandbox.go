@@ -0,0 +1,112 @@
+// Package sandbox serves integrators' sandbox API keys from a separate
+// service instance, backed by a simulation chain and its own database schema
+// seeded with fake data, so they can build against the API without touching
+// real assets.
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/usage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Schema is the database schema sandbox data lives in
+const Schema = "sandbox"
+
+// ChainName is the name of the simulation chain in the sandbox's registry
+const ChainName = "sandbox"
+
+// Keys is the set of API keys, by their usage.KeyID fingerprint, served by
+// the sandbox
+type Keys map[string]bool
+
+// ParseKeys parses a comma-separated list of API key fingerprints
+func ParseKeys(ids string) Keys {
+	return NewKeys(strings.Split(ids, ","))
+}
+
+// NewKeys returns the set of API key fingerprints ids, ignoring blank ones
+func NewKeys(ids []string) Keys {
+	keys := make(Keys)
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			keys[id] = true
+		}
+	}
+	return keys
+}
+
+// Contains reports whether the caller's API key is a sandbox key
+func (k Keys) Contains(ctx context.Context) bool {
+	key := usage.KeyFromContext(ctx)
+	return key != "" && k[usage.KeyID(key)]
+}
+
+// OpenDB opens the database at dsn with the sandbox schema, created if
+// missing, first on the search path. Tables are resolved in the sandbox
+// schema whether queries name them through models or in SQL.
+func OpenDB(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	if err := db.Exec("CREATE SCHEMA IF NOT EXISTS " + Schema).Error; err != nil {
+		return nil, fmt.Errorf("failed to create sandbox schema: %w", err)
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+
+	db, err = gorm.Open(postgres.Open(withSearchPath(dsn)), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sandbox schema: %w", err)
+	}
+	return db, nil
+}
+
+// withSearchPath adds the sandbox schema as the search path to a URL or
+// key/value connection string
+func withSearchPath(dsn string) string {
+	if strings.Contains(dsn, "://") {
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		return dsn + separator + "search_path=" + Schema
+	}
+	return dsn + " search_path=" + Schema
+}
+
+// UnaryServerInterceptor serves calls made with a sandbox API key from
+// sandbox, a service implementing the same RPCs as the registered one.
+// Other calls go to the registered service.
+func UnaryServerInterceptor(keys Keys, sandbox interface{}) grpc.UnaryServerInterceptor {
+	target := reflect.ValueOf(sandbox)
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !keys.Contains(ctx) {
+			return handler(ctx, req)
+		}
+
+		method := target.MethodByName(path.Base(info.FullMethod))
+		if !method.IsValid() {
+			return nil, status.Errorf(codes.Unimplemented, "%s is not available in the sandbox", info.FullMethod)
+		}
+		out := method.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+		err, _ := out[1].Interface().(error)
+		return out[0].Interface(), err
+	}
+}
@@ -0,0 +1,67 @@
+package sandbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/usage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type echo struct{ name string }
+
+func (e *echo) GetBondInfo(ctx context.Context, req *string) (*string, error) {
+	reply := e.name + ":" + *req
+	return &reply, nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	keys := ParseKeys(" " + usage.KeyID("sk_sandbox") + ",,")
+	interceptor := UnaryServerInterceptor(keys, &echo{name: "sandbox"})
+	live := &echo{name: "live"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return live.GetBondInfo(ctx, req.(*string))
+	}
+	call := func(key, method string) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(usage.MetadataKey, key))
+		req := "1"
+		return interceptor(ctx, &req, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/" + method}, handler)
+	}
+
+	tests := []struct {
+		name, key, method, want string
+		code                    codes.Code
+	}{
+		{"sandbox key", "sk_sandbox", "GetBondInfo", "sandbox:1", codes.OK},
+		{"live key", "sk_live", "GetBondInfo", "live:1", codes.OK},
+		{"no key", "", "GetBondInfo", "live:1", codes.OK},
+		{"sandbox key, unknown method", "sk_sandbox", "Missing", "", codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := call(tt.key, tt.method)
+			if status.Code(err) != tt.code {
+				t.Fatalf("error = %v, want code %v", err, tt.code)
+			}
+			if err == nil && *resp.(*string) != tt.want {
+				t.Errorf("response = %q, want %q", *resp.(*string), tt.want)
+			}
+		})
+	}
+}
+
+func TestWithSearchPath(t *testing.T) {
+	tests := []struct{ dsn, want string }{
+		{"host=db dbname=knowton", "host=db dbname=knowton search_path=sandbox"},
+		{"postgres://u:p@db/knowton", "postgres://u:p@db/knowton?search_path=sandbox"},
+		{"postgres://u:p@db/knowton?sslmode=disable", "postgres://u:p@db/knowton?sslmode=disable&search_path=sandbox"},
+	}
+	for _, tt := range tests {
+		if got := withSearchPath(tt.dsn); got != tt.want {
+			t.Errorf("withSearchPath(%q) = %q, want %q", tt.dsn, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Package schedule runs issuers' recurring revenue distributions. A
+// schedule names a bond's cadence; on each run the revenue accrued since the
+// previous one is queued for the batch distribution job, which paces and
+// retries it like any queued distribution.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Cadence is how often a schedule runs
+type Cadence string
+
+// Cadences
+const (
+	Daily     Cadence = "DAILY"
+	Weekly    Cadence = "WEEKLY"
+	Monthly   Cadence = "MONTHLY"
+	Quarterly Cadence = "QUARTERLY"
+)
+
+// Cadences lists the supported cadences
+var Cadences = []string{string(Daily), string(Weekly), string(Monthly), string(Quarterly)}
+
+var (
+	// ErrNotFound is returned for a bond without a schedule
+	ErrNotFound = errors.New("bond has no distribution schedule")
+	// ErrNoSource is returned by runs without a fixed amount when no
+	// revenue source is configured
+	ErrNoSource = errors.New("no revenue source is configured; set a fixed amount")
+)
+
+// at returns the anchor advanced by n periods. Monthly and quarterly runs
+// fall on the anchor's day, or the month's last day when it is shorter.
+func (c Cadence) at(anchor time.Time, n int) time.Time {
+	switch c {
+	case Daily:
+		return anchor.AddDate(0, 0, n)
+	case Weekly:
+		return anchor.AddDate(0, 0, 7*n)
+	case Quarterly:
+		n *= 3
+	}
+	y, m, d := anchor.Date()
+	last := time.Date(y, m+time.Month(n)+1, 0, 0, 0, 0, 0, anchor.Location()).Day()
+	return time.Date(y, m+time.Month(n), min(d, last),
+		anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), anchor.Location())
+}
+
+// period is the longest a cadence's period can be, so dividing by it never
+// skips past a run
+var period = map[Cadence]time.Duration{
+	Daily:     25 * time.Hour,
+	Weekly:    7*24*time.Hour + time.Hour,
+	Monthly:   31 * 24 * time.Hour,
+	Quarterly: 92 * 24 * time.Hour,
+}
+
+// Next returns the first run of the cadence from anchor that is after t
+func (c Cadence) Next(anchor, t time.Time) time.Time {
+	if t.Before(anchor) {
+		return anchor
+	}
+	n := int(t.Sub(anchor) / period[c])
+	for !c.at(anchor, n).After(t) {
+		n++
+	}
+	return c.at(anchor, n)
+}
+
+// ParseCadence returns the cadence named name, e.g. MONTHLY
+func ParseCadence(name string) (Cadence, bool) {
+	c := Cadence(name)
+	_, ok := period[c]
+	return c, ok
+}
+
+// RevenueSource reports the revenue a bond accrued over [from, to)
+type RevenueSource interface {
+	AccruedRevenue(ctx context.Context, bondID string, from, to time.Time) (*big.Int, error)
+}
+
+// Queue accepts the distributions runs produce
+type Queue interface {
+	Enqueue(bondID string, amount string, dueAt time.Time) (*models.QueuedDistribution, error)
+}
+
+// Config controls the scheduler
+type Config struct {
+	Interval  time.Duration // How often due schedules are looked for
+	MaxPerRun int           // Schedules run per interval
+}
+
+// DefaultConfig returns default scheduler configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:  time.Minute,
+		MaxPerRun: 100,
+	}
+}
+
+// Scheduler stores distribution schedules and runs the due ones
+type Scheduler struct {
+	db     *gorm.DB
+	queue  Queue
+	source RevenueSource // Nil when only fixed amounts can be scheduled
+	config Config
+}
+
+// New creates a scheduler. source may be nil.
+func New(db *gorm.DB, queue Queue, source RevenueSource, config Config) *Scheduler {
+	if config.MaxPerRun <= 0 {
+		config.MaxPerRun = DefaultConfig().MaxPerRun
+	}
+	return &Scheduler{
+		db:     db,
+		queue:  queue,
+		source: source,
+		config: config,
+	}
+}
+
+// Set creates or replaces a bond's schedule. A new schedule's first run
+// pays the revenue accrued since the bond's last distribution, or since
+// issuance; a replaced one keeps its accrual window, pause and skips.
+func (s *Scheduler) Set(ctx context.Context, bond *models.Bond, cadence Cadence, anchor time.Time, amount string) (*models.DistributionSchedule, error) {
+	var schedule models.DistributionSchedule
+	err := s.db.WithContext(ctx).Where("bond_id = ?", bond.BondID).First(&schedule).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		schedule = models.DistributionSchedule{BondID: bond.BondID, AccruedSince: bond.CreatedAt}
+		var last models.RevenueDistribution
+		if err := s.db.WithContext(ctx).Where("bond_id = ?", bond.BondID).Order("timestamp DESC").First(&last).Error; err == nil {
+			schedule.AccruedSince = last.Timestamp
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to load schedule: %w", err)
+	}
+
+	schedule.Cadence = string(cadence)
+	schedule.Anchor = anchor
+	schedule.NextRunAt = cadence.Next(anchor, time.Now())
+	schedule.Amount = amount
+	if err := s.db.WithContext(ctx).Save(&schedule).Error; err != nil {
+		return nil, fmt.Errorf("failed to save schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// Get returns a bond's schedule
+func (s *Scheduler) Get(ctx context.Context, bondID string) (*models.DistributionSchedule, error) {
+	var schedule models.DistributionSchedule
+	err := s.db.WithContext(ctx).Where("bond_id = ?", bondID).First(&schedule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// Pause stops a bond's runs until Resume. Revenue keeps accruing and is paid
+// by the first run after it resumes.
+func (s *Scheduler) Pause(ctx context.Context, bondID string) (*models.DistributionSchedule, error) {
+	return s.update(ctx, bondID, map[string]interface{}{"paused": true})
+}
+
+// Resume restarts a paused schedule from its next run after now
+func (s *Scheduler) Resume(ctx context.Context, bondID string) (*models.DistributionSchedule, error) {
+	schedule, err := s.Get(ctx, bondID)
+	if err != nil {
+		return nil, err
+	}
+	next := Cadence(schedule.Cadence).Next(schedule.Anchor, time.Now())
+	return s.update(ctx, bondID, map[string]interface{}{"paused": false, "next_run_at": next})
+}
+
+// Skip skips a bond's next runs; their revenue is paid by the run after
+func (s *Scheduler) Skip(ctx context.Context, bondID string, runs int) (*models.DistributionSchedule, error) {
+	return s.update(ctx, bondID, map[string]interface{}{"skip_runs": runs})
+}
+
+// Delete removes a bond's schedule
+func (s *Scheduler) Delete(ctx context.Context, bondID string) error {
+	result := s.db.WithContext(ctx).Unscoped().Where("bond_id = ?", bondID).Delete(&models.DistributionSchedule{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete schedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Scheduler) update(ctx context.Context, bondID string, updates map[string]interface{}) (*models.DistributionSchedule, error) {
+	result := s.db.WithContext(ctx).Model(&models.DistributionSchedule{}).Where("bond_id = ?", bondID).Updates(updates)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+	return s.Get(ctx, bondID)
+}
+
+// Start runs due schedules on every interval until the context is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RunOnce(ctx); err != nil {
+			log.Printf("Scheduled distribution run failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs every schedule that is due and returns how many ran
+func (s *Scheduler) RunOnce(ctx context.Context) (int, error) {
+	now := time.Now()
+	var due []models.DistributionSchedule
+	if err := s.db.WithContext(ctx).
+		Where("paused = ? AND next_run_at <= ?", false, now).
+		Order("next_run_at ASC").
+		Limit(s.config.MaxPerRun).
+		Find(&due).Error; err != nil {
+		return 0, fmt.Errorf("failed to load due schedules: %w", err)
+	}
+
+	ran := 0
+	for i := range due {
+		if s.run(ctx, &due[i], now) {
+			ran++
+		}
+	}
+	return ran, nil
+}
+
+// run executes one due schedule and reports whether this instance ran it.
+// Runs missed while the service was down collapse into one, which pays
+// everything accrued meanwhile.
+func (s *Scheduler) run(ctx context.Context, schedule *models.DistributionSchedule, now time.Time) bool {
+	runAt := schedule.NextRunAt
+	next := Cadence(schedule.Cadence).Next(schedule.Anchor, now)
+
+	// Claim the run by moving the schedule on, so concurrent instances don't
+	// also run it
+	claim := s.db.WithContext(ctx).Model(&models.DistributionSchedule{}).
+		Where("id = ? AND next_run_at = ?", schedule.ID, runAt).
+		Update("next_run_at", next)
+	if claim.Error != nil || claim.RowsAffected == 0 {
+		return false
+	}
+
+	updates := map[string]interface{}{"last_run_at": runAt, "last_error": ""}
+	if schedule.SkipRuns > 0 {
+		updates["skip_runs"] = schedule.SkipRuns - 1
+		updates["last_status"] = models.ScheduleRunSkipped
+	} else if amount, err := s.amount(ctx, schedule, runAt); err != nil {
+		log.Printf("Scheduled distribution for bond %s failed: %v", schedule.BondID, err)
+		updates["last_status"] = models.ScheduleRunFailed
+		updates["last_error"] = err.Error()
+	} else if amount.Sign() == 0 {
+		updates["last_status"] = models.ScheduleRunNoRevenue
+		updates["accrued_since"] = runAt
+	} else if item, err := s.queue.Enqueue(schedule.BondID, amount.String(), runAt); err != nil {
+		log.Printf("Failed to queue scheduled distribution for bond %s: %v", schedule.BondID, err)
+		updates["last_status"] = models.ScheduleRunFailed
+		updates["last_error"] = err.Error()
+	} else {
+		updates["last_status"] = models.ScheduleRunQueued
+		updates["last_queued_id"] = item.ID
+		updates["accrued_since"] = runAt
+	}
+
+	if err := s.db.WithContext(ctx).Model(schedule).Updates(updates).Error; err != nil {
+		log.Printf("Failed to record scheduled run for bond %s: %v", schedule.BondID, err)
+	}
+	return true
+}
+
+// amount returns what a run distributes: the schedule's fixed amount, else
+// the revenue accrued since the last run
+func (s *Scheduler) amount(ctx context.Context, schedule *models.DistributionSchedule, runAt time.Time) (*big.Int, error) {
+	if schedule.Amount != "" {
+		amount, ok := new(big.Int).SetString(schedule.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid fixed amount %q", schedule.Amount)
+		}
+		return amount, nil
+	}
+	if s.source == nil {
+		return nil, ErrNoSource
+	}
+	return s.source.AccruedRevenue(ctx, schedule.BondID, schedule.AccruedSince, runAt)
+}
@@ -0,0 +1,147 @@
+package schedule
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// fakeSource reports a fixed accrual and remembers the window asked for
+type fakeSource struct {
+	amount   int64
+	from, to time.Time
+}
+
+func (f *fakeSource) AccruedRevenue(ctx context.Context, bondID string, from, to time.Time) (*big.Int, error) {
+	f.from, f.to = from, to
+	return big.NewInt(f.amount), nil
+}
+
+// fakeQueue records what it was asked to queue
+type fakeQueue struct {
+	queued []models.QueuedDistribution
+}
+
+func (f *fakeQueue) Enqueue(bondID string, amount string, dueAt time.Time) (*models.QueuedDistribution, error) {
+	item := models.QueuedDistribution{BondID: bondID, Amount: amount, DueAt: dueAt}
+	item.ID = uint(len(f.queued) + 1)
+	f.queued = append(f.queued, item)
+	return &item, nil
+}
+
+func TestCadenceNext(t *testing.T) {
+	jan31 := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		cadence Cadence
+		anchor  time.Time
+		after   time.Time
+		want    time.Time
+	}{
+		{"before the anchor", Monthly, jan31, jan31.Add(-time.Hour), jan31},
+		{"at the anchor", Monthly, jan31, jan31, time.Date(2026, time.February, 28, 9, 0, 0, 0, time.UTC)},
+		{"short month keeps the anchor's day after", Monthly, jan31,
+			time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.March, 31, 9, 0, 0, 0, time.UTC)},
+		{"quarterly", Quarterly, jan31, jan31.AddDate(0, 1, 0), time.Date(2026, time.April, 30, 9, 0, 0, 0, time.UTC)},
+		{"weekly years later", Weekly, jan31, jan31.AddDate(3, 0, 0), time.Date(2029, time.February, 3, 9, 0, 0, 0, time.UTC)},
+		{"daily", Daily, jan31, jan31.Add(36 * time.Hour), time.Date(2026, time.February, 2, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cadence.Next(tt.anchor, tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunOnceQueuesAccruedRevenue(t *testing.T) {
+	db, mock := newMockDB(t)
+	accruedSince := time.Now().Add(-40 * 24 * time.Hour)
+	anchor := accruedSince.Add(time.Hour)
+	runAt := anchor.AddDate(0, 1, 0)
+	if !runAt.Before(time.Now()) {
+		t.Skip("month longer than the test window")
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "distribution_schedules" WHERE \(paused = \$1 AND next_run_at <= \$2\)`).
+		WithArgs(false, sqlmock.AnyArg(), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "cadence", "anchor", "next_run_at", "accrued_since", "skip_runs"}).
+			AddRow(1, "1", "MONTHLY", anchor, runAt, accruedSince, 0))
+	mock.ExpectExec(`UPDATE "distribution_schedules" SET "next_run_at"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND next_run_at = \$4\)`).
+		WithArgs(Monthly.Next(anchor, time.Now()), sqlmock.AnyArg(), 1, runAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "distribution_schedules" SET "accrued_since"=\$1,"last_error"=\$2,"last_queued_id"=\$3,"last_run_at"=\$4,"last_status"=\$5`).
+		WithArgs(runAt, "", 1, runAt, models.ScheduleRunQueued, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	source := &fakeSource{amount: 500}
+	queue := &fakeQueue{}
+	ran, err := New(db, queue, source, DefaultConfig()).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if ran != 1 {
+		t.Errorf("ran %d schedules, want 1", ran)
+	}
+	if !source.from.Equal(accruedSince) || !source.to.Equal(runAt) {
+		t.Errorf("accrual window = [%s, %s), want [%s, %s)", source.from, source.to, accruedSince, runAt)
+	}
+	if len(queue.queued) != 1 || queue.queued[0].Amount != "500" || !queue.queued[0].DueAt.Equal(runAt) {
+		t.Errorf("queued %+v, want 500 due at the run", queue.queued)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRunOnceSkips(t *testing.T) {
+	db, mock := newMockDB(t)
+	anchor := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(`SELECT \* FROM "distribution_schedules"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "cadence", "anchor", "next_run_at", "accrued_since", "skip_runs"}).
+			AddRow(1, "1", "DAILY", anchor, anchor, anchor.Add(-24*time.Hour), 2))
+	mock.ExpectExec(`UPDATE "distribution_schedules" SET "next_run_at"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "distribution_schedules" SET "last_error"=\$1,"last_run_at"=\$2,"last_status"=\$3,"skip_runs"=\$4`).
+		WithArgs("", anchor, models.ScheduleRunSkipped, 1, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	queue := &fakeQueue{}
+	if _, err := New(db, queue, &fakeSource{amount: 500}, DefaultConfig()).RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if len(queue.queued) != 0 {
+		t.Errorf("queued %+v for a skipped run", queue.queued)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/accesslist"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SetAccessLists makes address allow and deny lists manageable through the
+// admin RPCs
+func (s *BondingServiceServer) SetAccessLists(store *accesslist.Store) {
+	s.accessLists = store
+}
+
+// AddAccessListEntry puts an address on the tenant's allow or deny list, or
+// on a bond's
+func (s *BondingServiceServer) AddAccessListEntry(
+	ctx context.Context,
+	req *pb.AddAccessListEntryRequest,
+) (*pb.AccessListEntry, error) {
+	if s.accessLists == nil {
+		return nil, status.Error(codes.Unimplemented, "access lists are not configured")
+	}
+	if err := s.checkAccessListBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	if err := s.resolveAddresses(ctx, &req.Address); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.accessLists.Add(ctx, tenant.FromContext(ctx), req.BondId, req.List, req.Address, req.Reason)
+	if errors.Is(err, accesslist.ErrInvalidEntry) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return accessListEntryInfo(entry), nil
+}
+
+// RemoveAccessListEntry takes an address off a list
+func (s *BondingServiceServer) RemoveAccessListEntry(
+	ctx context.Context,
+	req *pb.RemoveAccessListEntryRequest,
+) (*pb.RemoveAccessListEntryResponse, error) {
+	if s.accessLists == nil {
+		return nil, status.Error(codes.Unimplemented, "access lists are not configured")
+	}
+	if err := s.checkAccessListBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	if err := s.resolveAddresses(ctx, &req.Address); err != nil {
+		return nil, err
+	}
+
+	err := s.accessLists.Remove(ctx, tenant.FromContext(ctx), req.BondId, req.List, req.Address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%s is not on the %s list", req.Address, req.List)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveAccessListEntryResponse{}, nil
+}
+
+// ListAccessListEntries returns the tenant's global entries, or a bond's
+func (s *BondingServiceServer) ListAccessListEntries(
+	ctx context.Context,
+	req *pb.ListAccessListEntriesRequest,
+) (*pb.ListAccessListEntriesResponse, error) {
+	resp := &pb.ListAccessListEntriesResponse{}
+	if s.accessLists == nil {
+		return resp, nil
+	}
+	if err := s.checkAccessListBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.accessLists.Entries(ctx, tenant.FromContext(ctx), req.BondId, req.List)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		resp.Entries = append(resp.Entries, accessListEntryInfo(&entries[i]))
+	}
+	return resp, nil
+}
+
+// checkAccessListBond refuses lists of another tenant's bond
+func (s *BondingServiceServer) checkAccessListBond(ctx context.Context, bondID string) error {
+	if bondID == "" {
+		return nil
+	}
+	bond, err := s.bonds.GetBond(ctx, bondID)
+	if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+		return status.Errorf(codes.NotFound, "bond %s not found", bondID)
+	}
+	return nil
+}
+
+// AccessSubjects returns the bond and addresses a write request acts on, for
+// the access list interceptor. ENS names are resolved as the handler would.
+func (s *BondingServiceServer) AccessSubjects(ctx context.Context, req interface{}) (accesslist.Subjects, error) {
+	var subj accesslist.Subjects
+	switch r := req.(type) {
+	case *pb.InvestRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{r.InvestorAddress}}
+	case *pb.InvestWithPermitRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{r.InvestorAddress}}
+	case *pb.TransferInvestmentRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{r.FromAddress, r.ToAddress}}
+	case *pb.RequestEarlyRedemptionRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{r.InvestorAddress}}
+	case *pb.PlaceOrderRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{r.SellerAddress}}
+	case *pb.SetTrancheLimitsRequest:
+		subj = accesslist.Subjects{BondID: r.BondId, Addresses: []string{signedInWallet(ctx, r.IssuerAddress)}}
+	case *pb.FillOrderRequest:
+		// The seller too, who may have been denied since placing the order
+		var order models.Order
+		err := s.db.WithContext(ctx).Select("bond_id", "seller").First(&order, r.OrderId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil // The handler reports the missing order
+		}
+		if err != nil {
+			return subj, status.Errorf(codes.Internal, "failed to load order: %v", err)
+		}
+		subj = accesslist.Subjects{BondID: order.BondID, Addresses: []string{r.BuyerAddress, order.Seller}}
+	case *pb.ApproveRedemptionRequest:
+		var redemption models.Redemption
+		err := s.db.WithContext(ctx).Select("bond_id", "investor").First(&redemption, r.RedemptionId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil
+		}
+		if err != nil {
+			return subj, status.Errorf(codes.Internal, "failed to load redemption: %v", err)
+		}
+		subj = accesslist.Subjects{BondID: redemption.BondID, Addresses: []string{signedInWallet(ctx, r.ApproverAddress), redemption.Investor}}
+	default:
+		return subj, nil
+	}
+
+	for i := range subj.Addresses {
+		resolved, err := s.resolveAddress(ctx, subj.Addresses[i])
+		if err != nil {
+			return subj, err
+		}
+		subj.Addresses[i] = resolved
+	}
+	return subj, nil
+}
+
+func accessListEntryInfo(e *models.AccessListEntry) *pb.AccessListEntry {
+	return &pb.AccessListEntry{
+		Id:        uint64(e.ID),
+		BondId:    e.BondID,
+		List:      e.List,
+		Address:   e.Address,
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt.Unix(),
+	}
+}
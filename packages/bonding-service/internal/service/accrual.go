@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAccrualSnapshots pages through the interest a bond's investments
+// accrued each day, latest day first, optionally for one investor. Each
+// snapshot carries the principal and rate it accrued at, so payouts can be
+// checked against it.
+func (s *BondingServiceServer) ListAccrualSnapshots(
+	ctx context.Context,
+	req *pb.ListAccrualSnapshotsRequest,
+) (*pb.ListAccrualSnapshotsResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	filter := repository.AccrualSnapshotFilter{
+		BondID:   req.BondId,
+		Investor: req.InvestorAddress,
+		Limit:    int(req.PageSize),
+	}
+	if req.PageToken != "" {
+		after, err := repository.ParseCursor(req.PageToken)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	var snapshots []models.AccrualSnapshot
+	var next *repository.Cursor
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		bond, err := s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		snapshots, next, err = s.bonds.ListAccrualSnapshots(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListAccrualSnapshotsResponse{}
+	for i := range snapshots {
+		response.Snapshots = append(response.Snapshots, accrualSnapshotInfo(&snapshots[i]))
+	}
+	if next != nil {
+		response.NextPageToken = next.String()
+	}
+	return response, nil
+}
+
+func accrualSnapshotInfo(snapshot *models.AccrualSnapshot) *pb.AccrualSnapshot {
+	return &pb.AccrualSnapshot{
+		InvestmentId:    uint64(snapshot.InvestmentID),
+		Day:             snapshot.Day.Unix(),
+		TrancheId:       int32(snapshot.TrancheID),
+		InvestorAddress: snapshot.Investor,
+		Principal:       snapshot.Principal,
+		ApyBps:          snapshot.APYBps,
+		DayCount:        snapshot.DayCount,
+		Seconds:         snapshot.Seconds,
+		Interest:        snapshot.Interest,
+		Accrued:         snapshot.Accrued,
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertAddressBookEntry creates or updates a named address in the caller's address book
+func (s *BondingServiceServer) UpsertAddressBookEntry(
+	ctx context.Context,
+	req *pb.UpsertAddressBookEntryRequest,
+) (*pb.AddressBookEntry, error) {
+	if err := s.resolveAddresses(ctx, &req.Address); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.Address) {
+		return nil, fmt.Errorf("address must be a valid address")
+	}
+	label := strings.TrimSpace(req.Label)
+	if label == "" {
+		return nil, fmt.Errorf("label is required")
+	}
+
+	role := strings.ToUpper(req.Role)
+	if role == "" {
+		role = models.RoleOther
+	}
+	switch role {
+	case models.RoleIssuer, models.RoleInvestor, models.RoleOther:
+	default:
+		return nil, fmt.Errorf("invalid role: %s", req.Role)
+	}
+
+	verification := strings.ToUpper(req.VerificationStatus)
+	if verification == "" {
+		verification = models.VerificationUnverified
+	}
+	switch verification {
+	case models.VerificationUnverified, models.VerificationVerified, models.VerificationRejected:
+	default:
+		return nil, fmt.Errorf("invalid verification_status: %s", req.VerificationStatus)
+	}
+
+	entry := &models.AddressBookEntry{
+		TenantID:           tenant.FromContext(ctx),
+		Address:            normalizeAddress(req.Address),
+		Label:              label,
+		Role:               role,
+		VerificationStatus: verification,
+	}
+
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"label", "role", "verification_status", "updated_at"}),
+	}).Create(entry).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to save address book entry: %w", err)
+	}
+
+	return addressBookEntryInfo(entry), nil
+}
+
+// ListAddressBookEntries lists the caller's address book, optionally filtered by role
+func (s *BondingServiceServer) ListAddressBookEntries(
+	ctx context.Context,
+	req *pb.ListAddressBookEntriesRequest,
+) (*pb.ListAddressBookEntriesResponse, error) {
+	query := s.db.WithContext(ctx).Where("tenant_id = ?", tenant.FromContext(ctx))
+	if req.Role != "" {
+		query = query.Where("role = ?", strings.ToUpper(req.Role))
+	}
+
+	var entries []models.AddressBookEntry
+	if err := query.Order("label ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list address book: %w", err)
+	}
+
+	result := make([]*pb.AddressBookEntry, len(entries))
+	for i := range entries {
+		result[i] = addressBookEntryInfo(&entries[i])
+	}
+
+	return &pb.ListAddressBookEntriesResponse{Entries: result}, nil
+}
+
+// DeleteAddressBookEntry removes an address from the caller's address book
+func (s *BondingServiceServer) DeleteAddressBookEntry(
+	ctx context.Context,
+	req *pb.DeleteAddressBookEntryRequest,
+) (*pb.DeleteAddressBookEntryResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.Address); err != nil {
+		return nil, err
+	}
+
+	result := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND address = ?", tenant.FromContext(ctx), normalizeAddress(req.Address)).
+		Delete(&models.AddressBookEntry{})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to delete address book entry: %w", result.Error)
+	}
+
+	return &pb.DeleteAddressBookEntryResponse{Deleted: result.RowsAffected > 0}, nil
+}
+
+// addressLabels holds the caller's address book entries and ENS names for a set of addresses
+type addressLabels struct {
+	entries  map[string]*models.AddressBookEntry
+	ensNames map[string]string
+}
+
+// lookupAddresses loads the address book entries for the given addresses in one
+// query and reverse-resolves them through ENS when a resolver is configured
+func (s *BondingServiceServer) lookupAddresses(ctx context.Context, addresses ...string) addressLabels {
+	labels := addressLabels{
+		entries:  make(map[string]*models.AddressBookEntry),
+		ensNames: s.reverseResolve(ctx, addresses),
+	}
+	if len(addresses) == 0 {
+		return labels
+	}
+
+	normalized := make([]string, len(addresses))
+	for i, addr := range addresses {
+		normalized[i] = normalizeAddress(addr)
+	}
+
+	var entries []models.AddressBookEntry
+	err := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND address IN ?", tenant.FromContext(ctx), normalized).
+		Find(&entries).Error
+	if err != nil {
+		// Labels are annotations only; a lookup failure must not fail the request
+		log.Printf("Failed to load address book: %v", err)
+		return labels
+	}
+
+	for i := range entries {
+		labels.entries[entries[i].Address] = &entries[i]
+	}
+	return labels
+}
+
+// counterparty annotates an address with its address book entry and ENS name, if any
+func (l addressLabels) counterparty(address string) *pb.Counterparty {
+	c := &pb.Counterparty{
+		Address: address,
+		EnsName: l.ensNames[normalizeAddress(address)],
+	}
+	if entry, ok := l.entries[normalizeAddress(address)]; ok {
+		c.Label = entry.Label
+		c.Role = entry.Role
+		c.VerificationStatus = entry.VerificationStatus
+	}
+	return c
+}
+
+func normalizeAddress(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
+
+func addressBookEntryInfo(e *models.AddressBookEntry) *pb.AddressBookEntry {
+	return &pb.AddressBookEntry{
+		Address:            e.Address,
+		Label:              e.Label,
+		Role:               e.Role,
+		VerificationStatus: e.VerificationStatus,
+		UpdatedAt:          e.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/archive"
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkArchived hides an archived bond from a read that didn't ask for
+// archived bonds, as if it had been deleted
+func checkArchived(bond *models.Bond, includeArchived bool) error {
+	if bond.ArchivedAt != nil && !includeArchived {
+		return status.Errorf(codes.NotFound, "bond %s is archived; set include_archived to read it", bond.BondID)
+	}
+	return nil
+}
+
+// restoreArchived brings an archived bond's detail rows back to the hot tables
+// before the bond is written again, e.g. by a late distribution
+func (s *BondingServiceServer) restoreArchived(ctx context.Context, bond *models.Bond) error {
+	if bond.ArchivedAt == nil {
+		return nil
+	}
+	if err := archive.Restore(ctx, s.db, bond.BondID); err != nil {
+		return err
+	}
+	log.Printf("Restored archived bond %s", bond.BondID)
+	bond.ArchivedAt = nil
+	return nil
+}
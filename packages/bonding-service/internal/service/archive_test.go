@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/repository"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestArchivedBondsNeedTheQueryFlag(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE bond_id = \$1`).
+		WithArgs("7", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tenant_id", "status", "archived_at"}).
+			AddRow(1, "7", "acme", "MATURED", time.Now()))
+	mock.ExpectQuery(`SELECT \* FROM "tranches" WHERE bond_id IN`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id"}))
+	mock.ExpectRollback()
+
+	s := &BondingServiceServer{db: db, bonds: repository.NewBondRepository(db)}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "acme"))
+	_, err := s.GetBondTimeline(ctx, &pb.GetBondTimelineRequest{BondId: "7"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetBondTimeline() of an archived bond error = %v, want NotFound", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
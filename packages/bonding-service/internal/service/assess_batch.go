@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxAssessBatch caps the IP-NFTs one AssessIPRiskBatch call assesses
+	MaxAssessBatch = 500
+	// DefaultAssessConcurrency is how many assessments of a batch run at once
+	// when none is configured
+	DefaultAssessConcurrency = 8
+)
+
+// SetAssessConcurrency sets how many assessments of a batch run at once,
+// bounding the load a catalog import puts on the oracle
+func (s *BondingServiceServer) SetAssessConcurrency(n int) {
+	s.assessConcurrency = n
+}
+
+// AssessIPRiskBatch assesses many IP-NFTs, e.g. a catalog being onboarded,
+// as AssessIPRisk would one at a time. Items run concurrently up to the
+// configured limit; one failing doesn't fail the others, and results come
+// back in request order.
+func (s *BondingServiceServer) AssessIPRiskBatch(
+	ctx context.Context,
+	req *pb.AssessIPRiskBatchRequest,
+) (*pb.AssessIPRiskBatchResponse, error) {
+	workers := s.assessConcurrency
+	if workers <= 0 {
+		workers = DefaultAssessConcurrency
+	}
+	if req.Concurrency > 0 && int(req.Concurrency) < workers {
+		workers = int(req.Concurrency)
+	}
+	workers = min(workers, len(req.Items))
+
+	results := make([]*pb.AssessIPRiskBatchResult, len(req.Items))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = s.assessBatchItem(ctx, req.Items[i])
+			}
+		}()
+	}
+	for i := range req.Items {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	response := &pb.AssessIPRiskBatchResponse{Results: results}
+	for _, r := range results {
+		if r.Error == "" {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+// assessBatchItem assesses one item of a batch, reporting its failure in
+// the result rather than as an error
+func (s *BondingServiceServer) assessBatchItem(ctx context.Context, item *pb.AssessIPRiskRequest) *pb.AssessIPRiskBatchResult {
+	result := &pb.AssessIPRiskBatchResult{IpnftId: item.IpnftId}
+	// Items still queued when the call is cancelled aren't started
+	if err := ctx.Err(); err != nil {
+		st := status.FromContextError(err)
+		result.Code, result.Error = st.Code().String(), st.Message()
+		return result
+	}
+	resp, err := s.AssessIPRisk(ctx, item)
+	if err != nil {
+		st := status.Convert(err)
+		result.Code, result.Error = st.Code().String(), st.Message()
+		return result
+	}
+	result.Result = resp
+	return result
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/audit"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// auditedMethods are the mutating RPCs that stay available during
+// maintenance; with the write methods, every call to them is audited
+var auditedMethods = map[string]bool{
+	"ScheduleMaintenance":   true,
+	"CancelMaintenance":     true,
+	"RecordComparableSales": true,
+	"SetFeatureFlag":        true,
+}
+
+// IsAuditedMethod reports whether a full gRPC method name is a mutating RPC
+// of the bonding service
+func IsAuditedMethod(fullMethod string) bool {
+	if IsWriteMethod(fullMethod) {
+		return true
+	}
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return ok && service == "bonding.BondingService" && auditedMethods[method]
+}
+
+// SetAuditLog makes the audit trail queryable through QueryAuditLog
+func (s *BondingServiceServer) SetAuditLog(recorder *audit.Recorder) {
+	s.auditLog = recorder
+}
+
+// QueryAuditLog returns the calling tenant's audit entries, newest first
+func (s *BondingServiceServer) QueryAuditLog(
+	ctx context.Context,
+	req *pb.QueryAuditLogRequest,
+) (*pb.QueryAuditLogResponse, error) {
+	if s.auditLog == nil {
+		return nil, status.Error(codes.Unimplemented, "the audit log is not configured")
+	}
+
+	filter := audit.Filter{
+		Principal: req.Principal,
+		Method:    req.Method,
+		BondID:    req.BondId,
+		Limit:     int(req.PageSize),
+		Offset:    int(req.Offset),
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	entries, err := s.auditLog.Query(ctx, tenant.FromContext(ctx), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.QueryAuditLogResponse{}
+	for i := range entries {
+		response.Entries = append(response.Entries, auditLogEntryInfo(&entries[i]))
+	}
+	return response, nil
+}
+
+// AuditSubject returns the bond, tranche and investors a request acts on,
+// for the audit interceptor. ENS names are resolved, as the handler will.
+func (s *BondingServiceServer) AuditSubject(ctx context.Context, req interface{}) (audit.Subject, error) {
+	subj := audit.Subject{TrancheID: -1}
+	switch r := req.(type) {
+	case *pb.InvestRequest:
+		subj = audit.Subject{BondID: r.BondId, TrancheID: int(r.TrancheId), Investors: []string{r.InvestorAddress}}
+	case *pb.InvestWithPermitRequest:
+		subj = audit.Subject{BondID: r.BondId, TrancheID: int(r.TrancheId), Investors: []string{r.InvestorAddress}}
+	case *pb.TransferInvestmentRequest:
+		subj = audit.Subject{BondID: r.BondId, TrancheID: int(r.TrancheId), Investors: []string{r.FromAddress, r.ToAddress}}
+	case *pb.RequestEarlyRedemptionRequest:
+		subj = audit.Subject{BondID: r.BondId, TrancheID: int(r.TrancheId), Investors: []string{r.InvestorAddress}}
+	case *pb.PlaceOrderRequest:
+		subj = audit.Subject{BondID: r.BondId, TrancheID: int(r.TrancheId), Investors: []string{r.SellerAddress}}
+	case *pb.FillOrderRequest:
+		var order models.Order
+		err := s.db.WithContext(ctx).Select("bond_id", "tranche_id", "seller").First(&order, r.OrderId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil // The handler reports the missing order
+		}
+		if err != nil {
+			return subj, err
+		}
+		subj = audit.Subject{BondID: order.BondID, TrancheID: order.TrancheID, Investors: []string{r.BuyerAddress, order.Seller}}
+	case *pb.CancelOrderRequest:
+		var order models.Order
+		err := s.db.WithContext(ctx).Select("bond_id", "tranche_id", "seller").First(&order, r.OrderId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil
+		}
+		if err != nil {
+			return subj, err
+		}
+		subj = audit.Subject{BondID: order.BondID, TrancheID: order.TrancheID, Investors: []string{order.Seller}}
+	case *pb.ApproveRedemptionRequest:
+		var redemption models.Redemption
+		err := s.db.WithContext(ctx).Select("bond_id", "tranche_id", "investor").First(&redemption, r.RedemptionId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil
+		}
+		if err != nil {
+			return subj, err
+		}
+		subj = audit.Subject{BondID: redemption.BondID, TrancheID: redemption.TrancheID, Investors: []string{redemption.Investor}}
+	case *pb.DistributeRevenueRequest:
+		subj.BondID = r.BondId
+	case *pb.SetTrancheLimitsRequest:
+		subj.BondID = r.BondId
+	case *pb.ChangeBondStatusRequest:
+		subj.BondID = r.BondId
+	case *pb.RequestEmergencyWithdrawalRequest:
+		subj.BondID = r.BondId
+	case *pb.ConfirmEmergencyWithdrawalRequest:
+		subj.BondID = r.BondId
+	case *pb.CancelEmergencyWithdrawalRequest:
+		var withdrawal models.EmergencyWithdrawal
+		err := s.db.WithContext(ctx).Select("bond_id").First(&withdrawal, r.WithdrawalId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return subj, nil
+		}
+		if err != nil {
+			return subj, err
+		}
+		subj.BondID = withdrawal.BondID
+	}
+
+	for i := range subj.Investors {
+		resolved, err := s.resolveAddress(ctx, subj.Investors[i])
+		if err != nil {
+			return subj, err
+		}
+		subj.Investors[i] = resolved
+	}
+	return subj, nil
+}
+
+func auditLogEntryInfo(e *models.AuditLog) *pb.AuditLogEntry {
+	info := &pb.AuditLogEntry{
+		Id:               uint64(e.ID),
+		CreatedAt:        e.CreatedAt.Unix(),
+		Principal:        e.Principal,
+		AuthMethod:       e.AuthMethod,
+		Roles:            audit.SplitList(e.Roles),
+		Method:           e.Method,
+		RequestDigest:    e.RequestDigest,
+		Code:             e.Code,
+		Error:            e.Error,
+		TxHashes:         audit.SplitList(e.TxHashes),
+		BondId:           e.BondID,
+		BondStatusBefore: e.BondStatusBefore,
+		BondStatusAfter:  e.BondStatusAfter,
+		TrancheId:        int32(e.TrancheID),
+	}
+	// Written by the recorder, so only an empty column fails to parse
+	_ = json.Unmarshal([]byte(e.PositionsBefore), &info.PositionsBefore)
+	_ = json.Unmarshal([]byte(e.PositionsAfter), &info.PositionsAfter)
+	return info
+}
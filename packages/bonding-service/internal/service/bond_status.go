@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/timeline"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// statusChange is an operator intervention on a bond: the statuses it
+// applies to and the status it leaves the bond in. The bond contract has no
+// per-bond controls, so the service enforces the statuses it sets.
+type statusChange struct {
+	action string
+	from   []string
+	to     string
+}
+
+var (
+	pauseBond  = statusChange{"pause", []string{models.BondActive}, models.BondPaused}
+	freezeBond = statusChange{"freeze", []string{models.BondActive, models.BondPaused}, models.BondFrozen}
+	cancelBond = statusChange{"cancel", []string{models.BondActive, models.BondPaused}, models.BondCancelled}
+	resumeBond = statusChange{"resume", []string{models.BondPaused, models.BondFrozen}, models.BondActive}
+)
+
+// PauseBond closes a bond to new investments. Positions can still be
+// transferred, traded and redeemed, and revenue distributed.
+func (s *BondingServiceServer) PauseBond(
+	ctx context.Context,
+	req *pb.ChangeBondStatusRequest,
+) (*pb.ChangeBondStatusResponse, error) {
+	return s.changeBondStatus(ctx, req, pauseBond)
+}
+
+// FreezeBond suspends a bond's distributions pending an investigation.
+// Queued distributions wait, and positions can't change hands.
+func (s *BondingServiceServer) FreezeBond(
+	ctx context.Context,
+	req *pb.ChangeBondStatusRequest,
+) (*pb.ChangeBondStatusResponse, error) {
+	return s.changeBondStatus(ctx, req, freezeBond)
+}
+
+// CancelBond withdraws a bond nobody has invested in yet
+func (s *BondingServiceServer) CancelBond(
+	ctx context.Context,
+	req *pb.ChangeBondStatusRequest,
+) (*pb.ChangeBondStatusResponse, error) {
+	return s.changeBondStatus(ctx, req, cancelBond)
+}
+
+// ResumeBond returns a paused or frozen bond to ACTIVE
+func (s *BondingServiceServer) ResumeBond(
+	ctx context.Context,
+	req *pb.ChangeBondStatusRequest,
+) (*pb.ChangeBondStatusResponse, error) {
+	return s.changeBondStatus(ctx, req, resumeBond)
+}
+
+// changeBondStatus applies an intervention to one of the tenant's bonds and
+// records it on the bond's timeline
+func (s *BondingServiceServer) changeBondStatus(
+	ctx context.Context,
+	req *pb.ChangeBondStatusRequest,
+	change statusChange,
+) (*pb.ChangeBondStatusResponse, error) {
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a reason to %s the bond is required", change.action)
+	}
+
+	var previous string
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var bond models.Bond
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bond_id = ?", req.BondId).First(&bond).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && bond.TenantID != tenant.FromContext(ctx)) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load bond: %w", err)
+		}
+		if !slices.Contains(change.from, bond.Status) {
+			return status.Errorf(codes.FailedPrecondition, "cannot %s a bond that is %s", change.action, bond.Status)
+		}
+		if change.to == models.BondCancelled {
+			if err := checkUnfunded(tx, bond.BondID); err != nil {
+				return err
+			}
+		}
+
+		previous = bond.Status
+		now := time.Now()
+		if err := tx.Model(&bond).Updates(map[string]interface{}{
+			"status":            change.to,
+			"status_reason":     req.Reason,
+			"status_changed_at": &now,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update bond status: %w", err)
+		}
+		return timeline.Record(tx, bond.BondID, timeline.StatusChanged,
+			fmt.Sprintf("%s -> %s: %s", previous, change.to, req.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangeBondStatusResponse{
+		BondId:         req.BondId,
+		PreviousStatus: previous,
+		Status:         change.to,
+	}, nil
+}
+
+// checkUnfunded refuses to cancel a bond with investments. The tranches are
+// locked so an investment being recorded can't slip in.
+func checkUnfunded(tx *gorm.DB, bondID string) error {
+	var tranches []models.Tranche
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bond_id = ?", bondID).
+		Find(&tranches).Error; err != nil {
+		return fmt.Errorf("failed to load tranches: %w", err)
+	}
+	invested := new(big.Int)
+	for _, t := range tranches {
+		invested.Add(invested, parseBigInt(t.TotalInvested))
+	}
+
+	var investments int64
+	if err := tx.Model(&models.Investment{}).Where("bond_id = ?", bondID).Count(&investments).Error; err != nil {
+		return fmt.Errorf("failed to count investments: %w", err)
+	}
+	if invested.Sign() > 0 || investments > 0 {
+		return status.Errorf(codes.FailedPrecondition, "bond %s is already funded (%s invested)", bondID, invested)
+	}
+	return nil
+}
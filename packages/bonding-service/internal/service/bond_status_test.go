@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangeBondStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   statusChange
+		reason   string
+		tenant   string // The bond's tenant
+		status   string // The bond's status
+		invested string // Invested in its tranche
+		wantCode codes.Code
+	}{
+		{"pause", pauseBond, "KYC provider outage", "acme", "ACTIVE", "0", codes.OK},
+		{"freeze paused", freezeBond, "revenue under investigation", "acme", "PAUSED", "0", codes.OK},
+		{"resume frozen", resumeBond, "investigation closed", "acme", "FROZEN", "0", codes.OK},
+		{"cancel unfunded", cancelBond, "issued with the wrong NFT", "acme", "ACTIVE", "0", codes.OK},
+		{"cancel funded", cancelBond, "issued with the wrong NFT", "acme", "ACTIVE", "500", codes.FailedPrecondition},
+		{"pause matured", pauseBond, "late", "acme", "MATURED", "0", codes.FailedPrecondition},
+		{"resume active", resumeBond, "nothing to resume", "acme", "ACTIVE", "0", codes.FailedPrecondition},
+		{"another tenant's bond", freezeBond, "probing", "other", "ACTIVE", "0", codes.NotFound},
+		{"no reason", pauseBond, "", "acme", "ACTIVE", "0", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			if tt.reason != "" {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE bond_id = \$1 .* FOR UPDATE`).
+					WithArgs("7", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tenant_id", "status"}).
+						AddRow(1, "7", tt.tenant, tt.status))
+				if tt.change.action == "cancel" {
+					mock.ExpectQuery(`SELECT \* FROM "tranches" WHERE bond_id = \$1 .* FOR UPDATE`).
+						WithArgs("7").
+						WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tranche_id", "total_invested"}).
+							AddRow(1, "7", 0, tt.invested))
+					mock.ExpectQuery(`SELECT count\(\*\) FROM "investments" WHERE bond_id = \$1`).
+						WithArgs("7").
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				}
+				if tt.wantCode == codes.OK {
+					mock.ExpectExec(`UPDATE "bonds" SET "status"=\$1,"status_changed_at"=\$2,"status_reason"=\$3`).
+						WithArgs(tt.change.to, sqlmock.AnyArg(), tt.reason, sqlmock.AnyArg(), 1).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectQuery(`INSERT INTO "bond_events"`).
+						WithArgs("7", "STATUS_CHANGED", tt.status+" -> "+tt.change.to+": "+tt.reason, sqlmock.AnyArg()).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+					mock.ExpectCommit()
+				} else {
+					mock.ExpectRollback()
+				}
+			}
+
+			s := &BondingServiceServer{db: db}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "acme"))
+			resp, err := s.changeBondStatus(ctx, &pb.ChangeBondStatusRequest{BondId: "7", Reason: tt.reason}, tt.change)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("changeBondStatus() code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+			if err == nil && (resp.PreviousStatus != tt.status || resp.Status != tt.change.to) {
+				t.Errorf("changeBondStatus() = %+v, want %s -> %s", resp, tt.status, tt.change.to)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
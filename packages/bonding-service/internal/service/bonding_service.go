@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -12,8 +14,43 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	pb "github.com/knowton/bonding-service/proto"
+	"github.com/knowton/bonding-service/internal/accesslist"
+	"github.com/knowton/bonding-service/internal/audit"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/cashflow"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/chainwatch"
+	"github.com/knowton/bonding-service/internal/comparables"
+	"github.com/knowton/bonding-service/internal/decimal"
+	"github.com/knowton/bonding-service/internal/distribution"
+	"github.com/knowton/bonding-service/internal/documents"
+	"github.com/knowton/bonding-service/internal/eligibility"
+	"github.com/knowton/bonding-service/internal/ens"
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/forecast"
+	"github.com/knowton/bonding-service/internal/hooks"
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/ipregistry"
+	"github.com/knowton/bonding-service/internal/kyc"
+	"github.com/knowton/bonding-service/internal/maintenance"
+	"github.com/knowton/bonding-service/internal/market"
 	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/notify"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/reconcile"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/revenue"
 	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/rules"
+	"github.com/knowton/bonding-service/internal/schedule"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	"github.com/knowton/bonding-service/internal/usage"
+	"github.com/knowton/bonding-service/internal/viewcache"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -23,8 +60,48 @@ type BondingServiceServer struct {
 	db         *gorm.DB
 	ethClient  *ethclient.Client
 	riskEngine *risk.RiskEngine
+	riskModels *risk.Registry
+	riskTiers  *risk.Tiers
 	contractAddr common.Address
 	privateKey  string
+
+	distributionQueue *distribution.BatchProcessor
+	scheduler         *schedule.Scheduler
+	revenue           *revenue.Store
+	chainWatcher      *chainwatch.Watcher
+	chainName         string
+	ensResolver       *ens.Resolver
+	chains            *chains.Registry
+	chainClients      map[string]*ethclient.Client
+	documents         *documents.Manager
+	bonds             *repository.BondRepository
+	viewCache         *viewcache.Cache
+	taxonomy          *taxonomy.Store
+	ipRegistry        *ipregistry.Router
+	txMonitor         *txmonitor.Monitor
+	reconciler        *reconcile.Reconciler
+	forecasts         *forecast.Reviewer
+	rules             *rules.Engine
+	usage             *usage.Recorder
+	oracleSpend       *usage.SpendTracker
+	maintenance       *maintenance.Store
+	comparables       *comparables.Store
+	market            *market.Analyzer
+	duplicates        *distribution.DuplicateGuard
+	hooks             *hooks.Runner
+	metadataResolver  *ipmeta.Resolver
+	fingerprinter     oracle.Fingerprinter
+	flagDuplicates    bool
+	chainPolicy       *chains.Policy
+	kyc               *kyc.Store
+	eligibility       *eligibility.Policy
+	accessLists       *accesslist.Store
+	auditLog          *audit.Recorder
+	notifications     *notify.Outbox
+	featureFlags      *flags.Store
+	assessConcurrency int
+	// How long a requested emergency withdrawal can be confirmed
+	emergencyConfirmWindow time.Duration
 }
 
 // NewBondingServiceServer creates a new bonding service server
@@ -34,12 +111,15 @@ func NewBondingServiceServer(
 	contractAddr string,
 	privateKey string,
 ) *BondingServiceServer {
+	riskEngine := risk.NewRiskEngine()
 	return &BondingServiceServer{
 		db:           db,
 		ethClient:    ethClient,
-		riskEngine:   risk.NewRiskEngine(),
+		riskEngine:   riskEngine,
+		riskModels:   risk.NewRegistry(risk.HeuristicModel, riskEngine),
 		contractAddr: common.HexToAddress(contractAddr),
 		privateKey:   privateKey,
+		bonds:        repository.NewBondRepository(db),
 	}
 }
 
@@ -48,40 +128,41 @@ func (s *BondingServiceServer) IssueBond(
 	ctx context.Context,
 	req *pb.IssueBondRequest,
 ) (*pb.IssueBondResponse, error) {
-	// 1. Validate request
-	if err := s.validateIssueBondRequest(req); err != nil {
-		return nil, fmt.Errorf("invalid request: %w", err)
-	}
-
-	// 2. Assess IP risk
-	metadata := &risk.IPMetadata{
-		Category:       "music", // Would be extracted from request
-		CreatorAddress: req.IssuerAddress,
-		CreatedAt:      time.Now(),
-		Views:          1000,
-		Likes:          100,
-		Tags:           []string{"original", "popular"},
-		ContentHash:    req.IpnftId,
-	}
-	
-	riskAssessment, err := s.riskEngine.AssessIPValue(req.IpnftId, metadata)
-	if err != nil {
-		return nil, fmt.Errorf("risk assessment failed: %w", err)
+	if err := s.resolveAddresses(ctx, &req.IssuerAddress); err != nil {
+		return nil, err
 	}
 
-	// 3. Save risk assessment to database
-	if err := s.db.Create(riskAssessment).Error; err != nil {
-		return nil, fmt.Errorf("failed to save risk assessment: %w", err)
+	// 1. Validate request and assess IP risk
+	plan, errs := s.prepareIssuance(ctx, req)
+	if len(errs) > 0 {
+		return nil, ruleError(errs[0])
+	}
+	chain, registration, agreement := plan.chain, plan.registration, plan.agreement
+	licenseExpiresAt, warnings, forecasts := plan.licenseExpiresAt, plan.warnings, plan.forecasts
+	riskAssessment, totalValue := plan.assessment, plan.totalValue
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return s.simulateIssueBond(ctx, req, plan)
 	}
 
-	// 4. Calculate tranche allocations
-	totalValue, ok := new(big.Int).SetString(req.TotalValue, 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid total value")
+	// 2. Simulate the issuance so a revert is reported before any gas is spent
+	preflight, err := s.preflightIssueBond(ctx, req, plan)
+	if err := checkPreflight("issueBond", preflight, err); err != nil {
+		return nil, err
+	}
+
+	// 3. Save risk assessment to database
+	if err := s.db.WithContext(ctx).Create(riskAssessment).Error; err != nil {
+		return nil, fmt.Errorf("failed to save risk assessment: %w", err)
 	}
 
 	// 5. Call smart contract to issue bond
-	txHash, bondID, err := s.issueBondOnChain(req, totalValue, riskAssessment)
+	txHash, bondID, err := s.issueBondOnChain(ctx, chain, req, totalValue, riskAssessment)
+	if txHash != "" {
+		s.transactionSent(ctx, chain.Name, txHash, txmonitor.PurposeIssueBond, bondID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to issue bond on-chain: %w", err)
 	}
@@ -89,6 +170,8 @@ func (s *BondingServiceServer) IssueBond(
 	// 6. Save bond to database
 	bond := &models.Bond{
 		BondID:       bondID,
+		TenantID:     tenant.FromContext(ctx),
+		Chain:        chain.Name,
 		IPNFTId:      req.IpnftId,
 		NFTContract:  s.contractAddr.Hex(), // Would get from config
 		Issuer:       req.IssuerAddress,
@@ -97,14 +180,62 @@ func (s *BondingServiceServer) IssueBond(
 		Status:       "ACTIVE",
 		TotalRevenue: "0",
 		TxHash:       txHash,
+		DayCount:     string(waterfall.DefaultDayCount),
+	}
+	if req.DayCount != "" {
+		bond.DayCount = req.DayCount
+	}
+	applyRegistration(bond, registration)
+	bond.Category = plan.category
+	bond.ContentFingerprint = plan.fingerprint
+	if !licenseExpiresAt.IsZero() {
+		bond.LicenseExpiresAt = &licenseExpiresAt
 	}
 
-	if err := s.db.Create(bond).Error; err != nil {
+	if err := s.db.WithContext(ctx).Create(bond).Error; err != nil {
 		return nil, fmt.Errorf("failed to save bond: %w", err)
 	}
+	if agreement != nil {
+		if err := s.db.WithContext(ctx).Create(licenseRecord(bondID, agreement, req.IssuerAddress)).Error; err != nil {
+			return nil, fmt.Errorf("failed to save license agreement: %w", err)
+		}
+	}
+	if len(forecasts) > 0 {
+		for i := range forecasts {
+			forecasts[i].BondID = bondID
+		}
+		if err := s.db.WithContext(ctx).Create(&forecasts).Error; err != nil {
+			return nil, fmt.Errorf("failed to save revenue forecast: %w", err)
+		}
+	}
 
 	// 7. Save tranches
-	tranches := []*models.Tranche{
+	tranches := s.issuanceTranches(bondID, req, totalValue)
+	for _, tranche := range tranches {
+		if err := s.db.WithContext(ctx).Create(tranche).Error; err != nil {
+			return nil, fmt.Errorf("failed to save tranche: %w", err)
+		}
+		bond.Tranches = append(bond.Tranches, *tranche)
+	}
+
+	// 8. Save the payments the tranches are expected to make
+	if payments := cashflow.Generate(bond, bond.CreatedAt, forecastDates(forecasts)); len(payments) > 0 {
+		if err := s.db.WithContext(ctx).Create(&payments).Error; err != nil {
+			return nil, fmt.Errorf("failed to save payment schedule: %w", err)
+		}
+	}
+
+	// 9. Build response
+	response := s.issueBondResponse(req, tranches, riskAssessment, warnings)
+	response.BondId = bondID
+	response.TxHash = txHash
+	response.Status = "success"
+	return response, nil
+}
+
+// issuanceTranches returns the senior, mezzanine and junior tranches of a new bond
+func (s *BondingServiceServer) issuanceTranches(bondID string, req *pb.IssueBondRequest, totalValue *big.Int) []*models.Tranche {
+	return []*models.Tranche{
 		{
 			BondID:        bondID,
 			TrancheID:     0,
@@ -114,6 +245,7 @@ func (s *BondingServiceServer) IssueBond(
 			APY:           req.Senior.Apy,
 			RiskLevel:     req.Senior.RiskLevel,
 			TotalInvested: "0",
+			DayCount:      req.Senior.DayCount,
 		},
 		{
 			BondID:        bondID,
@@ -124,6 +256,7 @@ func (s *BondingServiceServer) IssueBond(
 			APY:           req.Mezzanine.Apy,
 			RiskLevel:     req.Mezzanine.RiskLevel,
 			TotalInvested: "0",
+			DayCount:      req.Mezzanine.DayCount,
 		},
 		{
 			BondID:        bondID,
@@ -134,27 +267,28 @@ func (s *BondingServiceServer) IssueBond(
 			APY:           req.Junior.Apy,
 			RiskLevel:     req.Junior.RiskLevel,
 			TotalInvested: "0",
+			DayCount:      req.Junior.DayCount,
 		},
 	}
+}
 
-	for _, tranche := range tranches {
-		if err := s.db.Create(tranche).Error; err != nil {
-			return nil, fmt.Errorf("failed to save tranche: %w", err)
-		}
-	}
-
-	// 8. Build response
-	response := &pb.IssueBondResponse{
-		BondId: bondID,
-		TxHash: txHash,
-		Status: "success",
+// issueBondResponse describes an issuance's tranches and risk; the caller
+// sets the bond ID, transaction and status
+func (s *BondingServiceServer) issueBondResponse(
+	req *pb.IssueBondRequest,
+	tranches []*models.Tranche,
+	riskAssessment *models.RiskAssessment,
+	warnings []string,
+) *pb.IssueBondResponse {
+	return &pb.IssueBondResponse{
+		Warnings: warnings,
 		Tranches: []*pb.TrancheInfo{
 			{
 				TrancheId:     0,
 				Name:          req.Senior.Name,
 				Priority:      req.Senior.Priority,
 				Allocation:    tranches[0].Allocation,
-				Apy:           req.Senior.Apy,
+				Apy:           formatAPY(req.Senior.Apy),
 				RiskLevel:     req.Senior.RiskLevel,
 				TotalInvested: "0",
 			},
@@ -163,7 +297,7 @@ func (s *BondingServiceServer) IssueBond(
 				Name:          req.Mezzanine.Name,
 				Priority:      req.Mezzanine.Priority,
 				Allocation:    tranches[1].Allocation,
-				Apy:           req.Mezzanine.Apy,
+				Apy:           formatAPY(req.Mezzanine.Apy),
 				RiskLevel:     req.Mezzanine.RiskLevel,
 				TotalInvested: "0",
 			},
@@ -172,22 +306,13 @@ func (s *BondingServiceServer) IssueBond(
 				Name:          req.Junior.Name,
 				Priority:      req.Junior.Priority,
 				Allocation:    tranches[2].Allocation,
-				Apy:           req.Junior.Apy,
+				Apy:           formatAPY(req.Junior.Apy),
 				RiskLevel:     req.Junior.RiskLevel,
 				TotalInvested: "0",
 			},
 		},
-		RiskAssessment: &pb.RiskAssessment{
-			ValuationUsd:       riskAssessment.ValuationUSD,
-			ConfidenceScore:    riskAssessment.ConfidenceScore,
-			RiskRating:         riskAssessment.RiskRating,
-			DefaultProbability: riskAssessment.DefaultProbability,
-			RecommendedLtv:     riskAssessment.RecommendedLTV,
-			RiskFactors:        s.parseRiskFactors(riskAssessment.RiskFactors),
-		},
+		RiskAssessment: s.riskAssessmentInfo(riskAssessment),
 	}
-
-	return response, nil
 }
 
 // GetBondInfo retrieves bond information
@@ -195,73 +320,308 @@ func (s *BondingServiceServer) GetBondInfo(
 	ctx context.Context,
 	req *pb.GetBondInfoRequest,
 ) (*pb.GetBondInfoResponse, error) {
+	var info *pb.GetBondInfoResponse
+	err := s.readSnapshot(ctx, func(ctx context.Context) error {
+		bond, err := s.bonds.GetBond(ctx, req.BondId)
+		if err != nil {
+			return fmt.Errorf("bond not found: %w", err)
+		}
+		if err := checkArchived(bond, req.IncludeArchived); err != nil {
+			return err
+		}
+		if info, err = s.bondInfo(bond, s.bonds.StatsLoader(ctx)); err != nil {
+			return err
+		}
+
+		agreement, err := s.bondLicense(ctx, bond.BondID)
+		if err != nil {
+			return fmt.Errorf("failed to load license agreement: %w", err)
+		}
+		info.License = licenseInfo(agreement)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info.IssuerInfo = s.lookupAddresses(ctx, info.Issuer).counterparty(info.Issuer)
+	return info, nil
+}
+
+// Invest processes an investment in a bond tranche
+func (s *BondingServiceServer) Invest(
+	ctx context.Context,
+	req *pb.InvestRequest,
+) (*pb.InvestResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid investment amount")
+	}
+	if err := s.checkInvestorVerified(ctx, req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
 	var bond models.Bond
-	if err := s.db.Preload("Tranches").Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
 		return nil, fmt.Errorf("bond not found: %w", err)
 	}
+	if bond.Status != "ACTIVE" {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond is not active (status: %s)", bond.Status)
+	}
 
-	tranches := make([]*pb.TrancheInfo, len(bond.Tranches))
-	for i, t := range bond.Tranches {
-		tranches[i] = &pb.TrancheInfo{
-			TrancheId:     int32(t.TrancheID),
-			Name:          t.Name,
-			Priority:      int32(t.Priority),
-			Allocation:    t.Allocation,
-			Apy:           t.APY,
-			RiskLevel:     t.RiskLevel,
-			TotalInvested: t.TotalInvested,
-		}
+	var tranche models.Tranche
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", req.BondId, req.TrancheId).First(&tranche).Error; err != nil {
+		return nil, fmt.Errorf("tranche not found: %w", err)
+	}
+	if err := s.checkEligibility(ctx, req.InvestorAddress, tranche.TrancheID); err != nil {
+		return nil, err
 	}
 
-	response := &pb.GetBondInfoResponse{
-		BondId:       bond.BondID,
-		IpnftId:      bond.IPNFTId,
-		NftContract:  bond.NFTContract,
-		Issuer:       bond.Issuer,
-		TotalValue:   bond.TotalValue,
-		MaturityDate: bond.MaturityDate.Unix(),
-		Status:       bond.Status,
-		Tranches:     tranches,
-		TotalRevenue: bond.TotalRevenue,
-		CreatedAt:    bond.CreatedAt.Unix(),
+	// 1. Enforce ticket size and allocation before touching the chain
+	if err := checkInvestmentLimits(&tranche, amount); err != nil {
+		return nil, err
+	}
+	facts := investmentFacts(&bond, &tranche, req.InvestorAddress, amount)
+	if err := s.checkRules(rules.Investment, facts); err != nil {
+		return nil, err
+	}
+	if err := s.runHooks(ctx, hooks.PreInvestment, facts); err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	if err := s.checkWritable(ctx, bond.Chain); err != nil {
+		return nil, err
+	}
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return nil, err
+	}
+	preflight, err := s.preflightInvest(ctx, chain, req.BondId, req.TrancheId, amount)
+	if err := checkPreflight("invest", preflight, err); err != nil {
+		return nil, err
+	}
 
-// InvestInBond processes an investment in a bond tranche
-func (s *BondingServiceServer) InvestInBond(
-	ctx context.Context,
-	req *pb.InvestInBondRequest,
-) (*pb.InvestInBondResponse, error) {
-	// This would call the smart contract invest function
-	// For now, return a placeholder response
-	return &pb.InvestInBondResponse{
-		TxHash:         "0x" + fmt.Sprintf("%064x", time.Now().Unix()),
+	// 2. Hold the capacity, then invest on-chain
+	if err := reserveCapacity(s.db.WithContext(ctx), req.BondId, int(req.TrancheId), amount); err != nil {
+		return nil, err
+	}
+	txHash, err := s.investInBondOnChain(ctx, req.BondId, req.TrancheId, amount.String(), req.InvestorAddress)
+	if err != nil {
+		releaseCapacity(s.db.WithContext(context.WithoutCancel(ctx)), req.BondId, int(req.TrancheId), amount)
+		return nil, fmt.Errorf("failed to invest on-chain: %w", err)
+	}
+	s.transactionSent(ctx, bond.Chain, txHash, txmonitor.PurposeInvest, req.BondId)
+
+	// 3. Record the investment
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := recordInvestment(tx, req.BondId, int(req.TrancheId), req.InvestorAddress, amount, txHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InvestResponse{
+		TxHash:         txHash,
 		Status:         "pending",
-		InvestedAmount: req.Amount,
-		ExpectedReturn: 1.15, // 15% return
+		InvestedAmount: amount.String(),
+		ExpectedReturn: 1 + tranche.APY/100,
 	}, nil
 }
 
-// DistributeRevenue distributes revenue to bond holders
+// DistributeRevenue distributes revenue to bond holders.
+// When revenue cannot cover every coupon, the available amount is paid out in
+// priority order and the unpaid part is carried forward as tranche arrears.
 func (s *BondingServiceServer) DistributeRevenue(
 	ctx context.Context,
 	req *pb.DistributeRevenueRequest,
 ) (*pb.DistributeRevenueResponse, error) {
-	// This would call the smart contract distributeRevenue function
-	// For now, return a placeholder response
+	var period *time.Time
+	if req.DueAt > 0 {
+		dueAt := time.Unix(req.DueAt, 0)
+		period = &dueAt
+	}
+	return s.distributeRevenue(ctx, req, period)
+}
+
+// distributeRevenue distributes revenue paying period, nil when unnamed
+func (s *BondingServiceServer) distributeRevenue(
+	ctx context.Context,
+	req *pb.DistributeRevenueRequest,
+	period *time.Time,
+) (*pb.DistributeRevenueResponse, error) {
+	revenue, ok := new(big.Int).SetString(req.Revenue, 10)
+	if !ok || revenue.Sign() < 0 {
+		return nil, fmt.Errorf("invalid revenue amount")
+	}
+
+	bond, err := s.bonds.GetBond(ctx, req.BondId)
+	if err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	switch bond.Status {
+	case models.BondFrozen:
+		return nil, status.Errorf(codes.FailedPrecondition, "%v: bond %s is frozen", distribution.ErrSuspended, bond.BondID)
+	case models.BondCancelled:
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s was cancelled", bond.BondID)
+	}
+	if err := s.restoreArchived(ctx, bond); err != nil {
+		return nil, err
+	}
+
+	// 1. Coupons accrue from the previous distribution (or issuance)
+	periodStart := bond.CreatedAt
+	var last models.RevenueDistribution
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", bond.BondID).Order("timestamp DESC").First(&last).Error; err == nil {
+		periodStart = last.Timestamp
+	}
+	now := time.Now()
+	receivedAt := now
+	if req.ReceivedAt > 0 {
+		receivedAt = time.Unix(req.ReceivedAt, 0)
+	}
+
+	// 2. Run the waterfall over current coupons and carried-forward arrears
+	states := make([]waterfall.TrancheState, len(bond.Tranches))
+	trancheByID := make(map[int]models.Tranche, len(bond.Tranches))
+	for i, t := range bond.Tranches {
+		states[i] = waterfall.TrancheState{
+			TrancheID: t.TrancheID,
+			Priority:  t.Priority,
+			CouponDue: trancheDayCount(bond, &bond.Tranches[i]).Coupon(parseBigInt(t.TotalInvested), apyToBasisPoints(t.APY), periodStart, now),
+			Arrears:   parseBigInt(t.Arrears),
+		}
+		trancheByID[t.TrancheID] = t
+	}
+	result := waterfall.Run(revenue, states)
+	payment, err := s.licenseePayment(ctx, req, bond.BondID, revenue, receivedAt)
+	if err != nil {
+		return nil, err
+	}
+	licensee := ""
+	if payment != nil {
+		licensee = payment.Licensee
+	}
+	if err := s.checkRules(rules.Distribution, distributionFacts(bond, revenue, licensee)); err != nil {
+		return nil, err
+	}
+
+	// 3. Distribute on-chain
+	if err := s.checkWritable(ctx, bond.Chain); err != nil {
+		return nil, err
+	}
+	release, err := s.guardDistribution(ctx, bond, distribution.Candidate{
+		BondID: bond.BondID,
+		Amount: result.Distributed,
+		Period: period,
+		At:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	txHash, err := s.distributeRevenueOnChain(ctx, bond.BondID, result.Distributed.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to distribute revenue on-chain: %w", err)
+	}
+	s.transactionSent(ctx, bond.Chain, txHash, txmonitor.PurposeDistributeRevenue, bond.BondID)
+
+	// 4. Record the distribution and roll arrears forward
+	distribution := &models.RevenueDistribution{
+		BondID:    bond.BondID,
+		Amount:    result.Distributed.String(),
+		Shortfall: result.TotalShortfall.String(),
+		TxHash:    txHash,
+		Timestamp: now,
+		Period:    period,
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(distribution).Error; err != nil {
+			return fmt.Errorf("failed to save distribution: %w", err)
+		}
+
+		for _, r := range result.Tranches {
+			split := &models.TrancheDistribution{
+				DistributionID: distribution.ID,
+				BondID:         bond.BondID,
+				TrancheID:      r.TrancheID,
+				CouponDue:      r.CouponDue.String(),
+				ArrearsPaid:    r.ArrearsPaid.String(),
+				CouponPaid:     r.CouponPaid.String(),
+				Residual:       r.Residual.String(),
+				Shortfall:      r.Shortfall.String(),
+				ArrearsAfter:   r.Arrears.String(),
+				Invested:       trancheByID[r.TrancheID].TotalInvested,
+			}
+			if err := tx.Create(split).Error; err != nil {
+				return fmt.Errorf("failed to save tranche distribution: %w", err)
+			}
+
+			if err := tx.Model(&models.Tranche{}).
+				Where("bond_id = ? AND tranche_id = ?", bond.BondID, r.TrancheID).
+				Update("arrears", r.Arrears.String()).Error; err != nil {
+				return fmt.Errorf("failed to update tranche arrears: %w", err)
+			}
+		}
+
+		if payment != nil {
+			payment.DistributionID = distribution.ID
+			if err := tx.Create(payment).Error; err != nil {
+				return fmt.Errorf("failed to save licensee payment: %w", err)
+			}
+		}
+		if err := forecast.RecordActual(tx, bond.BondID, revenue, receivedAt); err != nil {
+			return err
+		}
+
+		if err := postDistribution(tx, bond.BondID, txHash, now, result); err != nil {
+			return err
+		}
+
+		totalRevenue := new(big.Int).Add(parseBigInt(bond.TotalRevenue), result.Distributed)
+		return tx.Model(bond).Update("total_revenue", totalRevenue.String()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reviewRevenueVariance(ctx, bond.BondID)
+	s.distributed(ctx, bond, revenue, licensee, distribution)
+
+	// 5. Build response
+	stats := s.bonds.StatsLoader(ctx)
+	distributions := make([]*pb.TrancheDistribution, len(result.Tranches))
+	for i, r := range result.Tranches {
+		trancheStats, err := stats.Load(repository.TrancheKey{BondID: bond.BondID, TrancheID: r.TrancheID})
+		if err != nil {
+			return nil, err
+		}
+
+		distributions[i] = &pb.TrancheDistribution{
+			TrancheId:         int32(r.TrancheID),
+			Name:              trancheByID[r.TrancheID].Name,
+			AmountDistributed: r.Paid().String(),
+			InvestorCount:     int32(trancheStats.InvestorCount),
+			ArrearsPaid:       r.ArrearsPaid.String(),
+			Shortfall:         r.Shortfall.String(),
+			Arrears:           r.Arrears.String(),
+		}
+	}
+
+	status := "success"
+	if result.Partial() {
+		status = "partial"
+	}
+
 	return &pb.DistributeRevenueResponse{
-		TxHash: "0x" + fmt.Sprintf("%064x", time.Now().Unix()),
-		Status: "success",
-		Distributions: []*pb.TrancheDistribution{
-			{
-				TrancheId:         0,
-				Name:              "Senior",
-				AmountDistributed: req.Amount,
-				InvestorCount:     5,
-			},
-		},
+		TxHash:         txHash,
+		Status:         status,
+		Distributions:  distributions,
+		TotalShortfall: result.TotalShortfall.String(),
+		TotalArrears:   result.TotalArrears.String(),
 	}, nil
 }
 
@@ -270,40 +630,30 @@ func (s *BondingServiceServer) AssessIPRisk(
 	ctx context.Context,
 	req *pb.AssessIPRiskRequest,
 ) (*pb.AssessIPRiskResponse, error) {
-	metadata := &risk.IPMetadata{
-		Category:       req.Metadata.Category,
-		CreatorAddress: req.Metadata.CreatorAddress,
-		CreatedAt:      time.Unix(req.Metadata.CreatedAt, 0),
-		Views:          req.Metadata.Views,
-		Likes:          req.Metadata.Likes,
-		Tags:           req.Metadata.Tags,
-		ContentHash:    req.Metadata.ContentHash,
+	metadata, err := s.assessmentMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.assessRisk(ctx, req.Model, req.IpnftId, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := s.comparableSales(ctx, metadata)
+	if err != nil {
+		return nil, err
 	}
 
-	assessment, err := s.riskEngine.AssessIPValue(req.IpnftId, metadata)
+	analysis, err := s.marketAnalysis(ctx, metadata.Category)
 	if err != nil {
-		return nil, fmt.Errorf("risk assessment failed: %w", err)
+		return nil, err
 	}
 
 	response := &pb.AssessIPRiskResponse{
-		Assessment: &pb.RiskAssessment{
-			ValuationUsd:       assessment.ValuationUSD,
-			ConfidenceScore:    assessment.ConfidenceScore,
-			RiskRating:         assessment.RiskRating,
-			DefaultProbability: assessment.DefaultProbability,
-			RecommendedLtv:     assessment.RecommendedLTV,
-			RiskFactors:        s.parseRiskFactors(assessment.RiskFactors),
-		},
-		ComparableSales: []*pb.ComparableSale{
-			// Would fetch from database
-		},
-		MarketAnalysis: &pb.MarketAnalysis{
-			AvgPrice:       5000.0,
-			MedianPrice:    4500.0,
-			PriceTrend:     0.15,
-			TotalSales:     150,
-			LiquidityScore: 0.75,
-		},
+		Assessment:      s.riskAssessmentInfo(assessment),
+		ComparableSales: sales,
+		MarketAnalysis:  analysis,
 	}
 
 	return response, nil
@@ -312,59 +662,139 @@ func (s *BondingServiceServer) AssessIPRisk(
 // Helper functions
 
 func (s *BondingServiceServer) validateIssueBondRequest(req *pb.IssueBondRequest) error {
+	if errs := issueBondRequestErrors(req); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// issueBondRequestErrors returns every problem with the request's own fields
+func issueBondRequestErrors(req *pb.IssueBondRequest) []error {
+	var errs []error
 	if req.IpnftId == "" {
-		return fmt.Errorf("ipnft_id is required")
+		errs = append(errs, fmt.Errorf("ipnft_id is required"))
 	}
 	if req.TotalValue == "" {
-		return fmt.Errorf("total_value is required")
+		errs = append(errs, fmt.Errorf("total_value is required"))
+	} else if _, ok := new(big.Int).SetString(req.TotalValue, 10); !ok {
+		errs = append(errs, fmt.Errorf("invalid total value"))
 	}
 	if req.MaturityDate <= time.Now().Unix() {
-		return fmt.Errorf("maturity_date must be in the future")
+		errs = append(errs, fmt.Errorf("maturity_date must be in the future"))
 	}
 	if req.Senior == nil || req.Mezzanine == nil || req.Junior == nil {
-		return fmt.Errorf("all tranches must be configured")
+		errs = append(errs, fmt.Errorf("all tranches must be configured"))
+		return errs
 	}
-	return nil
+
+	var total int64
+	allocationsValid := true
+	for _, t := range []*pb.TrancheConfig{req.Senior, req.Mezzanine, req.Junior} {
+		bps, err := decimal.BasisPoints(t.AllocationPercentage)
+		if err != nil || bps < 0 || bps > decimal.HundredPercent {
+			errs = append(errs, fmt.Errorf("invalid allocation percentage %q for tranche %s: want 0 to 100 with at most two decimal places", t.AllocationPercentage, t.Name))
+			allocationsValid = false
+			continue
+		}
+		total += bps
+	}
+	if allocationsValid && total != decimal.HundredPercent {
+		errs = append(errs, fmt.Errorf("tranche allocation percentages must add up to 100, got %s", new(big.Rat).SetFrac64(total, 100).FloatString(2)))
+	}
+	return errs
 }
 
 func (s *BondingServiceServer) issueBondOnChain(
+	ctx context.Context,
+	chain *chains.Chain,
 	req *pb.IssueBondRequest,
 	totalValue *big.Int,
-	riskAssessment *risk.RiskAssessment,
+	riskAssessment *models.RiskAssessment,
 ) (string, string, error) {
-	// Parse private key
-	privateKey, err := crypto.HexToECDSA(s.privateKey)
+	call, err := s.newIssueBondCall(req, totalValue, riskAssessment)
+	if err != nil {
+		return "", "", err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
 	if err != nil {
-		return "", "", fmt.Errorf("invalid private key: %w", err)
+		return "", "", err
 	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
 
-	// Create transactor
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(42161)) // Arbitrum
+	// The IP-NFT must be held by, or approved to, the bond contract
+	if err := s.checkCustody(ctx, chain, call, req.IssuerAddress); err != nil {
+		return "", "", err
+	}
+
+	// Log the transaction details
+	fmt.Printf("Preparing bond issuance transaction:\n")
+	fmt.Printf("  IP-NFT ID: %s\n", req.IpnftId)
+	fmt.Printf("  Total Value: %s\n", totalValue.String())
+	fmt.Printf("  Senior Allocation: %s\n", call.seniorAllocation.String())
+	fmt.Printf("  Mezzanine Allocation: %s\n", call.mezzanineAllocation.String())
+	fmt.Printf("  Junior Allocation: %s\n", call.juniorAllocation.String())
+	fmt.Printf("  Maturity Date: %d\n", req.MaturityDate)
+	fmt.Printf("  Risk Rating: %s\n", call.riskRating)
+
+	tx, err := contract.IssueBond(
+		ctx,
+		call.ipnftID,
+		call.nftContract,
+		call.totalValue,
+		call.seniorAllocation,
+		call.mezzanineAllocation,
+		call.juniorAllocation,
+		call.maturityDate,
+		call.valuationUSD,
+		call.riskRating,
+	)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create transactor: %w", err)
+		return "", "", err
 	}
+	txHash := tx.Hash().Hex()
 
-	// Set gas parameters
-	auth.GasLimit = 500000
-	gasPrice, err := s.ethClient.SuggestGasPrice(context.Background())
+	// The contract assigns the bond ID; read it from the BondIssued event
+	receipt, err := contract.WaitForTransaction(ctx, tx)
 	if err != nil {
-		gasPrice = big.NewInt(1000000000) // 1 Gwei fallback
+		return txHash, "", err
+	}
+	bondID, err := blockchain.BondIDFromReceipt(receipt, s.bondContract(chain))
+	if err != nil {
+		return txHash, "", err
 	}
-	auth.GasPrice = gasPrice
 
-	// In production, this would call the actual IPBond smart contract
-	// For now, we'll create a more realistic simulation that includes:
-	// 1. Contract interaction preparation
-	// 2. Transaction building
-	// 3. Actual transaction sending (commented out for safety)
+	return txHash, bondID.String(), nil
+}
 
-	// Prepare contract call parameters
-	bondID := fmt.Sprintf("BOND-%d", time.Now().Unix())
-	
-	// Convert string values to big.Int for contract calls
-	seniorAllocation := s.calculateAllocationBigInt(totalValue, req.Senior.AllocationPercentage)
-	mezzanineAllocation := s.calculateAllocationBigInt(totalValue, req.Mezzanine.AllocationPercentage)
-	juniorAllocation := s.calculateAllocationBigInt(totalValue, req.Junior.AllocationPercentage)
+// issueBondCall holds the arguments of the contract's issueBond function
+type issueBondCall struct {
+	ipnftID             *big.Int
+	nftContract         common.Address
+	totalValue          *big.Int
+	seniorAllocation    *big.Int
+	mezzanineAllocation *big.Int
+	juniorAllocation    *big.Int
+	maturityDate        *big.Int
+	valuationUSD        *big.Int
+	riskRating          string
+}
+
+// newIssueBondCall converts an issuance request to issueBond arguments
+func (s *BondingServiceServer) newIssueBondCall(
+	req *pb.IssueBondRequest,
+	totalValue *big.Int,
+	riskAssessment *models.RiskAssessment,
+) (*issueBondCall, error) {
+	// The contract identifies IP-NFTs by token ID
+	ipnftID, ok := new(big.Int).SetString(req.IpnftId, 10)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "ipnft_id must be a token ID to issue on-chain")
+	}
+	nftContract := s.contractAddr
+	if common.IsHexAddress(req.NftContract) {
+		nftContract = common.HexToAddress(req.NftContract)
+	}
 
 	// Prepare tranche data for contract
 	trancheData := struct {
@@ -375,71 +805,55 @@ func (s *BondingServiceServer) issueBondOnChain(
 		ValuationUSD *big.Int
 		RiskRating   string
 	}{
-		SeniorAPY:    s.parseAPYToBigInt(req.Senior.Apy),
-		MezzanineAPY: s.parseAPYToBigInt(req.Mezzanine.Apy),
-		JuniorAPY:    s.parseAPYToBigInt(req.Junior.Apy),
+		SeniorAPY:    s.parseAPYToBigInt(formatAPY(req.Senior.Apy)),
+		MezzanineAPY: s.parseAPYToBigInt(formatAPY(req.Mezzanine.Apy)),
+		JuniorAPY:    s.parseAPYToBigInt(formatAPY(req.Junior.Apy)),
 		MaturityDate: big.NewInt(req.MaturityDate),
 		ValuationUSD: s.parseUSDToBigInt(riskAssessment.ValuationUSD),
 		RiskRating:   riskAssessment.RiskRating,
 	}
 
-	// Log the transaction details
-	fmt.Printf("Preparing bond issuance transaction:\n")
-	fmt.Printf("  Bond ID: %s\n", bondID)
-	fmt.Printf("  IP-NFT ID: %s\n", req.IpnftId)
-	fmt.Printf("  Total Value: %s\n", totalValue.String())
-	fmt.Printf("  Senior Allocation: %s\n", seniorAllocation.String())
-	fmt.Printf("  Mezzanine Allocation: %s\n", mezzanineAllocation.String())
-	fmt.Printf("  Junior Allocation: %s\n", juniorAllocation.String())
-	fmt.Printf("  Maturity Date: %d\n", req.MaturityDate)
-	fmt.Printf("  Risk Rating: %s\n", trancheData.RiskRating)
+	return &issueBondCall{
+		ipnftID:             ipnftID,
+		nftContract:         nftContract,
+		totalValue:          totalValue,
+		seniorAllocation:    s.calculateAllocationBigInt(totalValue, req.Senior.AllocationPercentage),
+		mezzanineAllocation: s.calculateAllocationBigInt(totalValue, req.Mezzanine.AllocationPercentage),
+		juniorAllocation:    s.calculateAllocationBigInt(totalValue, req.Junior.AllocationPercentage),
+		maturityDate:        trancheData.MaturityDate,
+		valuationUSD:        trancheData.ValuationUSD,
+		riskRating:          trancheData.RiskRating,
+	}, nil
+}
 
-	// TODO: Uncomment when IPBond contract is deployed and ABI is available
-	/*
-	// Load contract ABI and create contract instance
-	contractABI := s.getIPBondABI()
-	contract := bind.NewBoundContract(s.contractAddr, contractABI, s.ethClient, s.ethClient, s.ethClient)
+// calculateAllocation returns a tranche's share of the total value for a
+// percentage with up to basis-point precision, e.g. "33.33"
+func (s *BondingServiceServer) calculateAllocation(totalValue *big.Int, percentage string) string {
+	return s.calculateAllocationBigInt(totalValue, percentage).String()
+}
 
-	// Call issueBond function
-	tx, err := contract.Transact(auth, "issueBond",
-		req.IpnftId,                    // IP-NFT token ID
-		totalValue,                     // Total bond value
-		seniorAllocation,               // Senior tranche allocation
-		mezzanineAllocation,            // Mezzanine tranche allocation
-		juniorAllocation,               // Junior tranche allocation
-		big.NewInt(req.MaturityDate),   // Maturity timestamp
-		trancheData.ValuationUSD,       // IP valuation
-		trancheData.RiskRating,         // Risk rating
-	)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to send transaction: %w", err)
+// parseBigInt parses a stored decimal amount, treating empty or invalid values as zero
+func parseBigInt(value string) *big.Int {
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
 	}
+	return n
+}
 
-	txHash := tx.Hash().Hex()
-	*/
-
-	// For now, simulate successful transaction
-	txHash := fmt.Sprintf("0x%064x", time.Now().Unix())
-	
-	// In production, wait for transaction confirmation
-	// receipt, err := bind.WaitMined(context.Background(), s.ethClient, tx)
-	// if err != nil {
-	//     return "", "", fmt.Errorf("transaction failed: %w", err)
-	// }
-
-	return txHash, bondID, nil
+// trancheDayCount returns the day-count convention a tranche's coupons accrue
+// under
+func trancheDayCount(bond *models.Bond, t *models.Tranche) waterfall.DayCount {
+	dayCount, ok := waterfall.ParseDayCount(bond.TrancheDayCount(t))
+	if !ok {
+		return waterfall.DefaultDayCount
+	}
+	return dayCount
 }
 
-func (s *BondingServiceServer) calculateAllocation(totalValue *big.Int, percentage string) string {
-	// Parse percentage
-	pct := new(big.Int)
-	pct.SetString(percentage, 10)
-	
-	// Calculate allocation
-	allocation := new(big.Int).Mul(totalValue, pct)
-	allocation.Div(allocation, big.NewInt(100))
-	
-	return allocation.String()
+// apyToBasisPoints converts a percentage APY (e.g. 8.5) to basis points (850)
+func apyToBasisPoints(apy float64) int64 {
+	return int64(math.Round(apy * 100))
 }
 
 func (s *BondingServiceServer) parseRiskFactors(riskFactorsJSON string) []string {
@@ -452,35 +866,31 @@ func (s *BondingServiceServer) parseRiskFactors(riskFactorsJSON string) []string
 
 // Helper functions for contract interaction
 
+// calculateAllocationBigInt is calculateAllocation as a big.Int, rounded down.
+// Invalid percentages are rejected at validation and allocate nothing here.
 func (s *BondingServiceServer) calculateAllocationBigInt(totalValue *big.Int, percentage string) *big.Int {
-	// Parse percentage
-	pct := new(big.Int)
-	pct.SetString(percentage, 10)
-	
-	// Calculate allocation
-	allocation := new(big.Int).Mul(totalValue, pct)
-	allocation.Div(allocation, big.NewInt(100))
-	
-	return allocation
+	bps, err := decimal.BasisPoints(percentage)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	return decimal.PercentOf(totalValue, bps)
 }
 
+// parseAPYToBigInt converts a percentage APY (e.g. "8.5") to basis points (850)
 func (s *BondingServiceServer) parseAPYToBigInt(apyStr string) *big.Int {
-	// Parse APY string (e.g., "8.5") to basis points (850)
-	// This is a simplified implementation
-	apy := new(big.Int)
-	apy.SetString(apyStr, 10)
-	// Convert to basis points (multiply by 100)
-	apy.Mul(apy, big.NewInt(100))
-	return apy
+	bps, err := decimal.Scaled(apyStr, 2)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	return bps
 }
 
-func (s *BondingServiceServer) parseUSDToBigInt(usdStr string) *big.Int {
-	// Parse USD string to wei (18 decimals)
-	usd := new(big.Int)
-	usd.SetString(usdStr, 10)
-	// Convert to wei (multiply by 10^18)
-	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
-	usd.Mul(usd, multiplier)
+// parseUSDToBigInt converts a USD amount to 18-decimal units
+func (s *BondingServiceServer) parseUSDToBigInt(amount float64) *big.Int {
+	usd, err := decimal.Scaled(strconv.FormatFloat(amount, 'f', -1, 64), 18)
+	if err != nil {
+		return big.NewInt(0)
+	}
 	return usd
 }
 
@@ -532,8 +942,9 @@ func (s *BondingServiceServer) getIPBondABI() string {
 
 // Enhanced investment function with real contract interaction
 func (s *BondingServiceServer) investInBondOnChain(
+	ctx context.Context,
 	bondID string,
-	trancheID int32,
+	trancheID uint32,
 	amount string,
 	investorAddress string,
 ) (string, error) {
@@ -543,8 +954,13 @@ func (s *BondingServiceServer) investInBondOnChain(
 		return "", fmt.Errorf("invalid private key: %w", err)
 	}
 
+	chain, err := s.bondChain(ctx, bondID)
+	if err != nil {
+		return "", err
+	}
+
 	// Create transactor
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(42161))
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(chain.ChainID))
 	if err != nil {
 		return "", fmt.Errorf("failed to create transactor: %w", err)
 	}
@@ -558,13 +974,10 @@ func (s *BondingServiceServer) investInBondOnChain(
 	// Set transaction value (for payable function)
 	auth.Value = investAmount
 	auth.GasLimit = 300000
-
-	// Get gas price
-	gasPrice, err := s.ethClient.SuggestGasPrice(context.Background())
-	if err != nil {
-		gasPrice = big.NewInt(1000000000) // 1 Gwei fallback
-	}
-	auth.GasPrice = gasPrice
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	auth.Context = ctx
+	chain.Gas.Apply(ctx, auth, s.chainClient(chain))
 
 	// TODO: Uncomment when contract is deployed
 	/*
@@ -597,6 +1010,7 @@ func (s *BondingServiceServer) investInBondOnChain(
 
 // Enhanced revenue distribution with real contract interaction
 func (s *BondingServiceServer) distributeRevenueOnChain(
+	ctx context.Context,
 	bondID string,
 	revenue string,
 ) (string, error) {
@@ -606,24 +1020,27 @@ func (s *BondingServiceServer) distributeRevenueOnChain(
 		return "", fmt.Errorf("invalid private key: %w", err)
 	}
 
+	chain, err := s.bondChain(ctx, bondID)
+	if err != nil {
+		return "", err
+	}
+
 	// Create transactor
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(42161))
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(chain.ChainID))
 	if err != nil {
 		return "", fmt.Errorf("failed to create transactor: %w", err)
 	}
 
 	// Parse revenue amount
-	revenueAmount, ok := new(big.Int).SetString(revenue, 10)
-	if !ok {
+	if _, ok := new(big.Int).SetString(revenue, 10); !ok {
 		return "", fmt.Errorf("invalid revenue amount")
 	}
 
 	auth.GasLimit = 400000
-	gasPrice, err := s.ethClient.SuggestGasPrice(context.Background())
-	if err != nil {
-		gasPrice = big.NewInt(1000000000)
-	}
-	auth.GasPrice = gasPrice
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	auth.Context = ctx
+	chain.Gas.Apply(ctx, auth, s.chainClient(chain))
 
 	// TODO: Uncomment when contract is deployed
 	/*
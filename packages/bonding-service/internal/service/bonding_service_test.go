@@ -113,6 +113,24 @@ func TestCalculateAllocation(t *testing.T) {
 			percentage: "17",
 			want:       "17000000000000000000",
 		},
+		{
+			name:       "33.33% of 100 ETH",
+			totalValue: "100000000000000000000",
+			percentage: "33.33",
+			want:       "33330000000000000000",
+		},
+		{
+			name:       "more precise than a basis point",
+			totalValue: "1000",
+			percentage: "12.345",
+			want:       "0",
+		},
+		{
+			name:       "12.34% of 1000 wei",
+			totalValue: "1000",
+			percentage: "12.34",
+			want:       "123",
+		},
 	}
 
 	for _, tt := range tests {
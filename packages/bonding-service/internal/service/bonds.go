@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+)
+
+// ListBonds lists the caller's bonds with tranches and investor counts,
+// leaving out archived bonds unless asked for them. The page is read in a
+// fixed number of queries however many bonds it holds, all from one snapshot.
+func (s *BondingServiceServer) ListBonds(
+	ctx context.Context,
+	req *pb.ListBondsRequest,
+) (*pb.ListBondsResponse, error) {
+	var bonds []models.Bond
+	var result []*pb.GetBondInfoResponse
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bonds, err = s.bonds.ListBonds(ctx, repository.BondFilter{
+			TenantID:        tenant.FromContext(ctx),
+			Status:          req.Status,
+			IncludeArchived: req.IncludeArchived,
+			Limit:           int(req.PageSize),
+			Offset:          int(req.Offset),
+		})
+		if err != nil {
+			return err
+		}
+
+		stats := s.bonds.StatsLoader(ctx)
+		for i := range bonds {
+			stats.Prime(bonds[i].BondID)
+		}
+		result = make([]*pb.GetBondInfoResponse, len(bonds))
+		for i := range bonds {
+			if result[i], err = s.bondInfo(&bonds[i], stats); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	issuers := make([]string, len(bonds))
+	for i := range bonds {
+		issuers[i] = bonds[i].Issuer
+	}
+	labels := s.lookupAddresses(ctx, issuers...)
+	for i := range result {
+		result[i].IssuerInfo = labels.counterparty(bonds[i].Issuer)
+	}
+
+	return &pb.ListBondsResponse{Bonds: result}, nil
+}
+
+// bondInfo builds the API view of a bond whose tranches are loaded, without
+// its issuer's labels
+func (s *BondingServiceServer) bondInfo(
+	bond *models.Bond,
+	stats *repository.StatsLoader,
+) (*pb.GetBondInfoResponse, error) {
+	tranches := make([]*pb.TrancheInfo, len(bond.Tranches))
+	totalArrears := big.NewInt(0)
+	for i, t := range bond.Tranches {
+		trancheStats, err := stats.Load(repository.TrancheKey{BondID: bond.BondID, TrancheID: t.TrancheID})
+		if err != nil {
+			return nil, err
+		}
+
+		tranches[i] = trancheInfo(bond, &bond.Tranches[i], trancheStats.InvestorCount)
+		totalArrears.Add(totalArrears, parseBigInt(t.Arrears))
+	}
+
+	info := &pb.GetBondInfoResponse{
+		BondId:       bond.BondID,
+		IpnftId:      bond.IPNFTId,
+		NftContract:  bond.NFTContract,
+		Issuer:       bond.Issuer,
+		TotalValue:   bond.TotalValue,
+		MaturityDate: bond.MaturityDate.Unix(),
+		Status:       bond.Status,
+		Tranches:     tranches,
+		TotalRevenue: bond.TotalRevenue,
+		CreatedAt:    bond.CreatedAt.Unix(),
+		TotalArrears: totalArrears.String(),
+		Chain:        bond.Chain,
+		Registration: registrationInfo(bond),
+		DayCount:     bond.DayCount,
+	}
+	if bond.LicenseExpiresAt != nil {
+		info.LicenseExpiresAt = bond.LicenseExpiresAt.Unix()
+	}
+	if bond.RatingReviewAt != nil {
+		info.RatingReviewAt = bond.RatingReviewAt.Unix()
+		info.RatingReviewReason = bond.RatingReviewReason
+	}
+	if bond.StatusChangedAt != nil {
+		info.StatusChangedAt = bond.StatusChangedAt.Unix()
+		info.StatusReason = bond.StatusReason
+	}
+	if bond.ArchivedAt != nil {
+		info.ArchivedAt = bond.ArchivedAt.Unix()
+	}
+	return info, nil
+}
+
+// trancheInfo returns the API view of a stored tranche of bond
+func trancheInfo(bond *models.Bond, t *models.Tranche, investorCount int64) *pb.TrancheInfo {
+	return &pb.TrancheInfo{
+		TrancheId:     uint32(t.TrancheID),
+		Name:          t.Name,
+		Priority:      int32(t.Priority),
+		Allocation:    t.Allocation,
+		Apy:           formatAPY(t.APY),
+		RiskLevel:     t.RiskLevel,
+		TotalInvested: t.TotalInvested,
+		Arrears:       t.Arrears,
+		MinInvestment: t.MinInvestment,
+		MaxInvestment: t.MaxInvestment,
+		InvestorCount: int32(investorCount),
+		DayCount:      string(trancheDayCount(bond, t)),
+	}
+}
+
+// formatAPY renders a percentage APY for the API, e.g. 5.25
+func formatAPY(apy float64) string {
+	return strconv.FormatFloat(apy, 'f', -1, 64)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetChainPolicy restricts writes to the chains and contracts policy allows
+// for the environment and the calling tenant
+func (s *BondingServiceServer) SetChainPolicy(policy *chains.Policy) {
+	s.chainPolicy = policy
+}
+
+// checkChainPolicy refuses a write the chain policy doesn't allow. Besides
+// the configured chain ID it checks the one the RPC endpoint reports, so an
+// RPC URL pointing at another network is caught before anything is signed.
+func (s *BondingServiceServer) checkChainPolicy(ctx context.Context, name string) error {
+	if s.chainPolicy == nil {
+		return nil
+	}
+	chain, err := s.chainConfig(name)
+	if err != nil {
+		return err
+	}
+	if err := s.chainPolicy.Check(tenant.FromContext(ctx), chain.ChainID, s.bondContract(chain)); err != nil {
+		metrics.ChainPolicyViolations.WithLabelValues(chain.Name).Inc()
+		log.Printf("Refused write to %s: %v", chain.Name, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	client := s.chainClient(chain)
+	if client == nil {
+		return status.Errorf(codes.FailedPrecondition, "no client for chain %s", chain.Name)
+	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	rpcChainID, err := client.ChainID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to verify the chain ID of %s: %v", chain.Name, err)
+	}
+	if !rpcChainID.IsInt64() || rpcChainID.Int64() != chain.ChainID {
+		metrics.ChainPolicyViolations.WithLabelValues(chain.Name).Inc()
+		log.Printf("Refused write to %s: RPC endpoint reports chain ID %s, configured %d", chain.Name, rpcChainID, chain.ChainID)
+		return status.Errorf(codes.FailedPrecondition, "RPC endpoint of %s reports chain ID %s, not the configured %d", chain.Name, rpcChainID, chain.ChainID)
+	}
+	return nil
+}
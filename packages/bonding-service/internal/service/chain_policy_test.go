@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/knowton/bonding-service/internal/chains"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEth serves eth_chainId
+type fakeEth struct {
+	chainID int64
+}
+
+func (f *fakeEth) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(f.chainID))
+}
+
+func TestCheckChainPolicy(t *testing.T) {
+	policy, err := chains.ParsePolicy([]byte(`{"environment": "staging", "chain_ids": [421614], "contracts": ["0x1111111111111111111111111111111111111111"]}`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		rpcChainID int64
+		contract   string
+		wantCode   codes.Code
+	}{
+		{"allowed", 421614, "0x1111111111111111111111111111111111111111", codes.OK},
+		{"contract not allowed", 421614, "0x2222222222222222222222222222222222222222", codes.PermissionDenied},
+		{"RPC on another network", 42161, "0x1111111111111111111111111111111111111111", codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := rpc.NewServer()
+			if err := server.RegisterName("eth", &fakeEth{chainID: tt.rpcChainID}); err != nil {
+				t.Fatalf("RegisterName() error = %v", err)
+			}
+			defer server.Stop()
+			client := ethclient.NewClient(rpc.DialInProc(server))
+			defer client.Close()
+
+			registry := chains.NewRegistry("staging")
+			if err := registry.Register(&chains.Chain{
+				Name:      "staging",
+				ChainID:   421614,
+				RPCURLs:   []string{"http://localhost:8545"},
+				Contracts: chains.Contracts{IPBond: tt.contract},
+			}); err != nil {
+				t.Fatalf("Register() error = %v", err)
+			}
+
+			s := &BondingServiceServer{}
+			s.SetChainRegistry(registry)
+			s.AddChainClient("staging", client)
+			s.SetChainPolicy(policy)
+
+			if got := status.Code(s.checkWritable(context.Background(), "")); got != tt.wantCode {
+				t.Errorf("checkWritable() code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
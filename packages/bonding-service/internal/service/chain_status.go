@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/chainwatch"
+	"github.com/knowton/bonding-service/internal/flags"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetChainWatcher attaches the chain watcher used to gate write operations
+func (s *BondingServiceServer) SetChainWatcher(watcher *chainwatch.Watcher, chain string) {
+	s.chainWatcher = watcher
+	s.chainName = chain
+}
+
+// GetChainStatus reports head, finalized and indexed blocks for each watched chain
+func (s *BondingServiceServer) GetChainStatus(
+	ctx context.Context,
+	req *pb.GetChainStatusRequest,
+) (*pb.GetChainStatusResponse, error) {
+	if s.chainWatcher == nil {
+		return &pb.GetChainStatusResponse{}, nil
+	}
+
+	statuses := s.chainWatcher.Statuses()
+	chains := make([]*pb.ChainStatus, 0, len(statuses))
+	for _, st := range statuses {
+		if req.Chain != "" && st.Chain != req.Chain {
+			continue
+		}
+		info := &pb.ChainStatus{
+			Chain:            st.Chain,
+			HeadBlock:        st.HeadBlock,
+			HeadTimestamp:    st.HeadTime.Unix(),
+			FinalizedBlock:   st.FinalizedBlock,
+			IndexedBlock:     st.IndexedBlock,
+			IndexerLagBlocks: st.IndexerLag,
+			Syncing:          st.Syncing,
+			WritesPaused:     st.WritesPaused,
+			PauseReason:      st.PauseReason,
+			UpdatedAt:        st.UpdatedAt.Unix(),
+		}
+		if chain, err := s.chainConfig(st.Chain); err == nil {
+			info.ChainId = chain.ChainID
+			info.Confirmations = chain.Confirmations
+		}
+		chains = append(chains, info)
+	}
+
+	return &pb.GetChainStatusResponse{Chains: chains}, nil
+}
+
+// checkWritable rejects write operations while contract calls are flagged
+// off for the tenant, on a chain the chain policy doesn't allow, or while the
+// chain watcher has paused writes or the chain's contract would reject them.
+// An empty chain name checks the default chain.
+func (s *BondingServiceServer) checkWritable(ctx context.Context, chain string) error {
+	if err := s.checkFeature(ctx, flags.ContractCalls); err != nil {
+		return err
+	}
+	if err := s.checkChainPolicy(ctx, chain); err != nil {
+		return err
+	}
+	if s.chainWatcher == nil {
+		return nil
+	}
+	if chain == "" {
+		chain = s.chainName
+	}
+	if err := s.chainWatcher.CheckWritable(chain); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if err := s.chainWatcher.CheckContract(ctx, chain); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+// WatchContracts has the chain watcher check each chain's bond contract, so
+// writes are blocked while the contract is paused, the service's signer has
+// lost ISSUER_ROLE or expectedAdmin, if set, no longer holds DEFAULT_ADMIN_ROLE
+func (s *BondingServiceServer) WatchContracts(expectedAdmin common.Address) error {
+	if s.chainWatcher == nil {
+		return nil
+	}
+	registry := s.chains
+	if registry == nil {
+		registry = chains.Builtin()
+	}
+
+	for _, name := range registry.Names() {
+		chain, _ := registry.Get(name)
+		address := s.bondContract(chain)
+		if address == (common.Address{}) {
+			continue
+		}
+		contract, err := blockchain.NewIPBondContract(s.chainClient(chain), address.Hex(), s.privateKey, chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("failed to create contract for %s: %w", name, err)
+		}
+		s.chainWatcher.SetContractCheck(name, func(ctx context.Context) error {
+			ctx, cancel := chainContext(ctx)
+			defer cancel()
+			return contract.CheckWritable(ctx, expectedAdmin)
+		})
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/deadline"
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chainCallTimeout bounds a single chain call when the caller's deadline allows more
+const chainCallTimeout = 30 * time.Second
+
+// SetChainRegistry sets the chains bonds can be issued on
+func (s *BondingServiceServer) SetChainRegistry(registry *chains.Registry) {
+	s.chains = registry
+}
+
+// AddChainClient sets the client used for transactions on a chain
+func (s *BondingServiceServer) AddChainClient(name string, client *ethclient.Client) {
+	if s.chainClients == nil {
+		s.chainClients = make(map[string]*ethclient.Client)
+	}
+	s.chainClients[name] = client
+}
+
+// chainClient returns the client for a chain, falling back to the default client
+func (s *BondingServiceServer) chainClient(chain *chains.Chain) *ethclient.Client {
+	if client, ok := s.chainClients[chain.Name]; ok {
+		return client
+	}
+	return s.ethClient
+}
+
+// chainConfig returns a registered chain; an empty name selects the default chain
+func (s *BondingServiceServer) chainConfig(name string) (*chains.Chain, error) {
+	registry := s.chains
+	if registry == nil {
+		registry = chains.Builtin()
+	}
+	chain, err := registry.Get(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return chain, nil
+}
+
+// bondChain returns the chain a bond lives on
+func (s *BondingServiceServer) bondChain(ctx context.Context, bondID string) (*chains.Chain, error) {
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Select("chain").Where("bond_id = ?", bondID).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	return s.chainConfig(bond.Chain)
+}
+
+// bondContract returns the IPBond contract on a chain, falling back to the configured address
+func (s *BondingServiceServer) bondContract(chain *chains.Chain) common.Address {
+	if common.IsHexAddress(chain.Contracts.IPBond) {
+		return common.HexToAddress(chain.Contracts.IPBond)
+	}
+	return s.contractAddr
+}
+
+// chainContext bounds a chain call by chainCallTimeout and the RPC's remaining deadline
+func chainContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return deadline.Clamp(ctx, chainCallTimeout)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/knowton/bonding-service/internal/accrual"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/claims"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetClaimableAmounts reports what each investor in a bond can claim from
+// each tranche, or only what the named investor can
+func (s *BondingServiceServer) GetClaimableAmounts(
+	ctx context.Context,
+	req *pb.GetClaimableAmountsRequest,
+) (*pb.GetClaimableAmountsResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+	if req.InvestorAddress != "" && !common.IsHexAddress(req.InvestorAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid investor address %q", req.InvestorAddress)
+	}
+
+	var balances []claims.Balance
+	var accrued map[holding]*big.Int
+	err := s.readSnapshot(ctx, func(ctx context.Context) error {
+		bond, loaded, err := s.claimBalances(ctx, req.BondId)
+		if err != nil {
+			return err
+		}
+		balances = loaded
+		accrued, err = s.accruedByHolding(ctx, bond)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetClaimableAmountsResponse{BondId: req.BondId}
+	total := new(big.Int)
+	for i := range balances {
+		b := &balances[i]
+		if req.InvestorAddress != "" && !sameAddress(b.Investor, req.InvestorAddress) {
+			continue
+		}
+		claimable := b.Claimable()
+		total.Add(total, claimable)
+		interest := accrued[holding{common.HexToAddress(b.Investor), b.TrancheID}]
+		if interest == nil {
+			interest = new(big.Int)
+		}
+		response.Amounts = append(response.Amounts, &pb.ClaimableAmount{
+			InvestorAddress: b.Investor,
+			TrancheId:       int32(b.TrancheID),
+			Entitled:        b.Entitled.String(),
+			Claimed:         b.Claimed.String(),
+			Claimable:       claimable.String(),
+			AccruedInterest: interest.String(),
+		})
+	}
+	response.TotalClaimable = total.String()
+	return response, nil
+}
+
+// PrepareClaim builds the unsigned transaction an investor sends to claim a
+// tranche's distributions. Nothing is sent; the contract pays the caller.
+func (s *BondingServiceServer) PrepareClaim(
+	ctx context.Context,
+	req *pb.PrepareClaimRequest,
+) (*pb.PrepareClaimResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.InvestorAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid investor address %q", req.InvestorAddress)
+	}
+
+	bond, balances, err := s.claimBalances(ctx, req.BondId)
+	if err != nil {
+		return nil, err
+	}
+	claimable := new(big.Int)
+	for i := range balances {
+		if balances[i].TrancheID == int(req.TrancheId) && sameAddress(balances[i].Investor, req.InvestorAddress) {
+			claimable = balances[i].Claimable()
+		}
+	}
+	if claimable.Sign() == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s has nothing to claim from tranche %d of bond %s",
+			req.InvestorAddress, req.TrancheId, bond.BondID)
+	}
+
+	bondID, ok := new(big.Int).SetString(bond.BondID, 10)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s was not issued on-chain", bond.BondID)
+	}
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return nil, err
+	}
+	data, err := blockchain.PackClaim(bondID, uint8(req.TrancheId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PrepareClaimResponse{
+		To:      s.bondContract(chain).Hex(),
+		Data:    hexutil.Encode(data),
+		Amount:  claimable.String(),
+		ChainId: chain.ChainID,
+	}, nil
+}
+
+// claimBalances loads a bond of the caller's tenant and its investors'
+// balances from one snapshot
+func (s *BondingServiceServer) claimBalances(ctx context.Context, bondID string) (*models.Bond, []claims.Balance, error) {
+	var bond *models.Bond
+	var balances []claims.Balance
+	err := s.readSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, bondID)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", bondID)
+		}
+		balances, err = claims.Load(ctx, s.conn(ctx), bond.BondID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return bond, balances, nil
+}
+
+// holding is an investor's position in a tranche
+type holding struct {
+	investor  common.Address
+	trancheID int
+}
+
+// accruedByHolding sums the interest each holding in a bond has accrued to
+// date
+func (s *BondingServiceServer) accruedByHolding(ctx context.Context, bond *models.Bond) (map[holding]*big.Int, error) {
+	var investments []models.Investment
+	if err := s.conn(ctx).Where("bond_id = ?", bond.BondID).Find(&investments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load investments: %w", err)
+	}
+	amounts, err := accrual.ToDate(ctx, s.conn(ctx), bond, investments, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	accrued := make(map[holding]*big.Int)
+	for _, inv := range investments {
+		key := holding{common.HexToAddress(inv.Investor), inv.TrancheID}
+		if accrued[key] == nil {
+			accrued[key] = new(big.Int)
+		}
+		accrued[key].Add(accrued[key], amounts[inv.ID])
+	}
+	return accrued, nil
+}
+
+// sameAddress compares hex addresses whatever their case
+func sameAddress(a, b string) bool {
+	return common.HexToAddress(a) == common.HexToAddress(b)
+}
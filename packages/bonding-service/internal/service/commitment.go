@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/commitment"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublishRoot sends a bond's commitment root to the bond contract, for the
+// commitment job. The write is checked as the bond's tenant.
+func (s *BondingServiceServer) PublishRoot(ctx context.Context, bond *models.Bond, epoch uint64, root common.Hash) (string, error) {
+	ctx = tenant.NewContext(ctx, bond.TenantID)
+
+	bondID, ok := new(big.Int).SetString(bond.BondID, 10)
+	if !ok {
+		return "", fmt.Errorf("bond %s has no on-chain ID", bond.BondID)
+	}
+	if err := s.checkWritable(ctx, bond.Chain); err != nil {
+		return "", err
+	}
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return "", err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	tx, err := contract.CommitStateRoot(ctx, bondID, epoch, root)
+	if err != nil {
+		return "", err
+	}
+	s.transactionSent(ctx, chain.Name, tx.Hash().Hex(), txmonitor.PurposeCommitStateRoot, bond.BondID)
+	return tx.Hash().Hex(), nil
+}
+
+// GetPositionProof returns a Merkle proof of an investor's tranche position
+// against the bond's latest published commitment
+func (s *BondingServiceServer) GetPositionProof(
+	ctx context.Context,
+	req *pb.GetPositionProofRequest,
+) (*pb.PositionProof, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.InvestorAddress) {
+		return nil, status.Error(codes.InvalidArgument, "investor_address must be a valid address")
+	}
+
+	proof, err := commitment.PositionProof(ctx, s.db, req.BondId, int(req.TrancheId), common.HexToAddress(req.InvestorAddress))
+	if errors.Is(err, commitment.ErrNoCommitment) || errors.Is(err, commitment.ErrNotInCommitment) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(proof.Hashes))
+	for i, hash := range proof.Hashes {
+		hashes[i] = hash.Hex()
+	}
+	return &pb.PositionProof{
+		BondId:          proof.Commitment.BondID,
+		Epoch:           proof.Commitment.Epoch,
+		Root:            proof.Commitment.Root,
+		TxHash:          proof.Commitment.TxHash,
+		CommittedAt:     proof.Commitment.CommittedAt.Unix(),
+		TrancheId:       int32(proof.Leaf.TrancheID),
+		InvestorAddress: proof.Leaf.Investor,
+		Amount:          proof.Leaf.Amount,
+		Leaf:            proof.Leaf.Hash,
+		Proof:           hashes,
+	}, nil
+}
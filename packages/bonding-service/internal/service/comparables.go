@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/comparables"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetComparables stores comparable sales, recorded through the API or
+// reported by oracles, and has the risk engine value against them
+func (s *BondingServiceServer) SetComparables(store *comparables.Store) {
+	s.comparables = store
+	s.riskEngine.SetComparables(store)
+}
+
+// RecordComparableSales records sales of IP-NFTs to value similar IP against
+func (s *BondingServiceServer) RecordComparableSales(
+	ctx context.Context,
+	req *pb.RecordComparableSalesRequest,
+) (*pb.RecordComparableSalesResponse, error) {
+	if s.comparables == nil {
+		return nil, status.Error(codes.Unimplemented, "comparable sales are not configured")
+	}
+	if len(req.Sales) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sales are required")
+	}
+
+	sales := make([]models.ComparableSale, len(req.Sales))
+	for i, sale := range req.Sales {
+		sales[i] = models.ComparableSale{
+			TokenID:  sale.TokenId,
+			Category: sale.Category,
+			Tags:     strings.Join(sale.Tags, ","),
+			Price:    sale.Price,
+			Source:   comparables.SourceManual,
+		}
+		if sale.Timestamp > 0 {
+			sales[i].SoldAt = time.Unix(sale.Timestamp, 0)
+		}
+	}
+
+	recorded, err := s.comparables.Record(ctx, sales)
+	if errors.Is(err, comparables.ErrInvalidSale) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.market != nil {
+		invalidated := make(map[string]bool)
+		for i := range sales {
+			if category := sales[i].Category; !invalidated[category] {
+				s.market.Invalidate(ctx, category)
+				invalidated[category] = true
+			}
+		}
+	}
+
+	return &pb.RecordComparableSalesResponse{
+		Recorded:   int32(recorded),
+		Duplicates: int32(len(sales) - recorded),
+	}, nil
+}
+
+// comparableSales returns the recorded sales of IP similar to metadata,
+// or none when comparable sales are not configured
+func (s *BondingServiceServer) comparableSales(ctx context.Context, metadata *risk.IPMetadata) ([]*pb.ComparableSale, error) {
+	if s.comparables == nil {
+		return nil, nil
+	}
+	sales, err := s.comparables.Find(ctx, metadata.Category, metadata.Tags, risk.ComparableLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.ComparableSale, len(sales))
+	for i := range sales {
+		result[i] = &pb.ComparableSale{
+			TokenId:   sales[i].TokenID,
+			Price:     sales[i].Price,
+			Timestamp: sales[i].SoldAt.Unix(),
+			Category:  sales[i].Category,
+			Tags:      comparables.SplitTags(sales[i].Tags),
+			Source:    sales[i].Source,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/pricefeed"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// costQuoteValidity is how long a cost quote is offered for. Gas prices move
+// block to block, so callers should re-quote once it lapses.
+const costQuoteValidity = time.Minute
+
+// EstimateIssuanceCost simulates issuing a bond, investing or distributing
+// revenue and quotes the gas and fee, priced in USD when the chain has a price
+// feed. A call that would revert is reported with its reason instead.
+func (s *BondingServiceServer) EstimateIssuanceCost(
+	ctx context.Context,
+	req *pb.EstimateIssuanceCostRequest,
+) (*pb.EstimateIssuanceCostResponse, error) {
+	var (
+		chain    *chains.Chain
+		method   string
+		value    = new(big.Int)
+		simulate func(ctx context.Context) (*blockchain.Preflight, error)
+	)
+	set := 0
+	for _, call := range []bool{req.Issuance != nil, req.Investment != nil, req.Distribution != nil} {
+		if call {
+			set++
+		}
+	}
+	switch {
+	case set != 1:
+		return nil, status.Error(codes.InvalidArgument, "set exactly one of issuance, investment or distribution")
+
+	case req.Issuance != nil:
+		if err := s.resolveAddresses(ctx, &req.Issuance.IssuerAddress); err != nil {
+			return nil, err
+		}
+		plan, errs := s.prepareIssuance(ctx, req.Issuance)
+		if len(errs) > 0 {
+			return nil, ruleError(errs[0])
+		}
+		chain, method = plan.chain, "issueBond"
+		simulate = func(ctx context.Context) (*blockchain.Preflight, error) {
+			return s.preflightIssueBond(ctx, req.Issuance, plan)
+		}
+
+	case req.Investment != nil:
+		bond, err := s.bonds.GetBond(ctx, req.Investment.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return nil, status.Errorf(codes.NotFound, "bond %s not found", req.Investment.BondId)
+		}
+		amount, ok := new(big.Int).SetString(req.Investment.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid investment amount")
+		}
+		if chain, err = s.chainConfig(bond.Chain); err != nil {
+			return nil, err
+		}
+		method, value = "invest", amount
+		simulate = func(ctx context.Context) (*blockchain.Preflight, error) {
+			return s.preflightInvest(ctx, chain, bond.BondID, req.Investment.TrancheId, amount)
+		}
+
+	default:
+		bond, err := s.bonds.GetBond(ctx, req.Distribution.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return nil, status.Errorf(codes.NotFound, "bond %s not found", req.Distribution.BondId)
+		}
+		bondID, ok := new(big.Int).SetString(bond.BondID, 10)
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", bond.BondID)
+		}
+		revenue, ok := new(big.Int).SetString(req.Distribution.Revenue, 10)
+		if !ok || revenue.Sign() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid revenue amount")
+		}
+		if chain, err = s.chainConfig(bond.Chain); err != nil {
+			return nil, err
+		}
+		method = "distributeRevenue"
+		simulate = func(ctx context.Context) (*blockchain.Preflight, error) {
+			contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+			if err != nil {
+				return nil, err
+			}
+			return contract.PreflightDistributeRevenue(ctx, bondID, revenue)
+		}
+	}
+
+	client := s.chainClient(chain)
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+
+	preflight, err := simulate(ctx)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to simulate %s: %v", method, err)
+	}
+	quotedAt := time.Now()
+	resp := &pb.EstimateIssuanceCostResponse{
+		Chain:      chain.Name,
+		Method:     method,
+		Sender:     preflight.From.Hex(),
+		QuotedAt:   quotedAt.Unix(),
+		ValidUntil: quotedAt.Add(costQuoteValidity).Unix(),
+	}
+	if preflight.Reverted {
+		resp.Reverted = true
+		resp.RevertReason = revertMessage(preflight)
+		return resp, nil
+	}
+
+	auth := &bind.TransactOpts{}
+	chain.Gas.Apply(ctx, auth, client)
+	gasPrice := auth.GasPrice
+	if gasPrice == nil {
+		gasPrice = auth.GasFeeCap
+	}
+	resp.GasLimit = preflight.GasLimit
+	resp.GasPrice = gasPrice.String()
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(preflight.GasLimit), gasPrice)
+	resp.Fee = fee.String()
+
+	// The sender pays the value sent plus the fee
+	shortfall := new(big.Int).Add(value, fee)
+	shortfall.Sub(shortfall, preflight.Balance)
+	if shortfall.Sign() < 0 {
+		shortfall.SetInt64(0)
+	}
+	resp.BalanceShortfall = shortfall.String()
+
+	if common.IsHexAddress(chain.Contracts.PriceFeed) {
+		price, err := pricefeed.New(client, chain.Contracts.PriceFeed).Latest(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to price the fee in USD: %v", err)
+		}
+		resp.NativeUsd = price.USD
+		resp.FeeUsd = weiToUSD(fee, price.USD)
+		resp.PriceUpdatedAt = price.UpdatedAt.Unix()
+	}
+	return resp, nil
+}
+
+// weiToUSD converts an amount of the native token in wei to USD
+func weiToUSD(wei *big.Int, nativeUSD float64) float64 {
+	ether := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e18))
+	usd, _ := new(big.Float).Mul(ether, big.NewFloat(nativeUSD)).Float64()
+	return usd
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWeiToUSD(t *testing.T) {
+	tests := []struct {
+		name      string
+		wei       string
+		nativeUSD float64
+		want      float64
+	}{
+		{"one ether", "1000000000000000000", 3000, 3000},
+		{"issuance on arbitrum", "350000000000000", 3123.45, 1.0932075},
+		{"nothing", "0", 3000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wei, _ := new(big.Int).SetString(tt.wei, 10)
+			if got := weiToUSD(wei, tt.nativeUSD); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("weiToUSD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimateIssuanceCostRequiresOneCall(t *testing.T) {
+	s := &BondingServiceServer{}
+	for _, req := range []*pb.EstimateIssuanceCostRequest{
+		{},
+		{Issuance: &pb.IssueBondRequest{}, Distribution: &pb.DistributeRevenueRequest{}},
+	} {
+		if _, err := s.EstimateIssuanceCost(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("EstimateIssuanceCost(%+v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
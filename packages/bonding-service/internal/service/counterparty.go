@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/credit"
+	"github.com/knowton/bonding-service/internal/license"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// licenseePayment returns the payment a distribution records for its
+// licensee, defaulting to the bond's license agreement. Distributions without
+// a known licensee return nil.
+func (s *BondingServiceServer) licenseePayment(
+	ctx context.Context,
+	req *pb.DistributeRevenueRequest,
+	bondID string,
+	amount *big.Int,
+	receivedAt time.Time,
+) (*models.LicenseePayment, error) {
+	licensee := strings.TrimSpace(req.Licensee)
+	if licensee == "" {
+		agreement, err := s.bondLicense(ctx, bondID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load license agreement: %w", err)
+		}
+		if agreement == nil {
+			if req.DueAt > 0 {
+				return nil, status.Error(codes.InvalidArgument, "due_at requires a licensee")
+			}
+			return nil, nil
+		}
+		licensee = agreement.Licensee
+	}
+
+	payment := &models.LicenseePayment{
+		BondID:   bondID,
+		Licensee: licensee,
+		Amount:   amount.String(),
+		PaidAt:   receivedAt,
+	}
+	if req.DueAt > 0 {
+		dueAt := time.Unix(req.DueAt, 0)
+		payment.DueAt = &dueAt
+	}
+	return payment, nil
+}
+
+// licenseePayments loads payments matching a condition
+func licenseePayments(db *gorm.DB, query string, args ...interface{}) ([]credit.Payment, error) {
+	var records []models.LicenseePayment
+	if err := db.Where(query, args...).Order("paid_at").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to load licensee payments: %w", err)
+	}
+
+	payments := make([]credit.Payment, len(records))
+	for i, r := range records {
+		payments[i] = credit.Payment{Licensee: r.Licensee, Amount: parseBigInt(r.Amount), PaidAt: r.PaidAt}
+		if r.DueAt != nil {
+			payments[i].DueAt = *r.DueAt
+		}
+	}
+	return payments, nil
+}
+
+// licenseeScore scores a licensee from its payments across all bonds
+func (s *BondingServiceServer) licenseeScore(ctx context.Context, licensee string) (credit.Score, error) {
+	payments, err := licenseePayments(s.conn(ctx), "licensee = ?", licensee)
+	if err != nil {
+		return credit.Score{}, err
+	}
+	return credit.Assess(licensee, payments), nil
+}
+
+// issuanceCounterparties returns the counterparty profile of a new bond, whose
+// only licensee is the one in its agreement
+func (s *BondingServiceServer) issuanceCounterparties(ctx context.Context, agreement *license.Agreement) (*risk.Counterparties, error) {
+	if agreement == nil {
+		return nil, nil
+	}
+	score, err := s.licenseeScore(ctx, agreement.Licensee)
+	if err != nil {
+		return nil, err
+	}
+	return &risk.Counterparties{TopShare: 1, Score: score.Score, Rated: score.Rated}, nil
+}
+
+// GetCounterpartyRisk reports the licensees paying a bond's royalties, their
+// share of its revenue and their payment reliability
+func (s *BondingServiceServer) GetCounterpartyRisk(
+	ctx context.Context,
+	req *pb.GetCounterpartyRiskRequest,
+) (*pb.GetCounterpartyRiskResponse, error) {
+	var bond *models.Bond
+	var exposures []credit.Exposure
+	var scores []credit.Score
+	var counterparties *risk.Counterparties
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		exposures, scores, counterparties, err = s.bondCounterparties(ctx, bond.BondID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetCounterpartyRiskResponse{
+		BondId:        bond.BondID,
+		Concentration: credit.Concentration(exposures),
+	}
+	for i, e := range exposures {
+		score := scores[i]
+		response.Licensees = append(response.Licensees, &pb.LicenseeCredit{
+			Licensee:        e.Licensee,
+			AmountPaid:      e.Amount.String(),
+			Share:           e.Share,
+			Score:           int32(score.Score),
+			Rated:           score.Rated,
+			Payments:        int32(score.Payments),
+			OnTime:          int32(score.OnTime),
+			Late:            int32(score.Late),
+			AverageDaysLate: score.AverageDaysLate,
+		})
+	}
+
+	response.TopShare = counterparties.TopShare
+	response.Score = int32(counterparties.Score)
+	response.Rated = counterparties.Rated
+	response.RiskFactors = counterparties.Factors()
+	return response, nil
+}
+
+// bondCounterparties returns the licensees paying a bond's royalties, their
+// scores and the bond's counterparty profile
+func (s *BondingServiceServer) bondCounterparties(
+	ctx context.Context,
+	bondID string,
+) ([]credit.Exposure, []credit.Score, *risk.Counterparties, error) {
+	payments, err := licenseePayments(s.conn(ctx), "bond_id = ?", bondID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	exposures := credit.Exposures(payments)
+
+	// Until royalties arrive the agreement's licensee carries all the exposure
+	if len(exposures) == 0 {
+		agreement, err := s.bondLicense(ctx, bondID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to load license agreement: %w", err)
+		}
+		if agreement != nil {
+			exposures = []credit.Exposure{{Licensee: agreement.Licensee, Amount: new(big.Int), Share: 1}}
+		}
+	}
+
+	counterparties := &risk.Counterparties{}
+	scores := make([]credit.Score, len(exposures))
+	var ratedShare, weighted float64
+	for i, e := range exposures {
+		if scores[i], err = s.licenseeScore(ctx, e.Licensee); err != nil {
+			return nil, nil, nil, err
+		}
+		if scores[i].Rated {
+			ratedShare += e.Share
+			weighted += e.Share * float64(scores[i].Score)
+		}
+	}
+	if len(exposures) > 0 {
+		counterparties.TopShare = exposures[0].Share
+	}
+	if ratedShare > 0 {
+		counterparties.Rated = true
+		counterparties.Score = int(weighted/ratedShare + 0.5)
+	}
+	return exposures, scores, counterparties, nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	pb "github.com/knowton/bonding-service/proto"
+)
+
+func TestLicenseePayment(t *testing.T) {
+	s := &BondingServiceServer{}
+	due := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	req := &pb.DistributeRevenueRequest{
+		BondId:   "7",
+		Licensee: "  Streaming Co ",
+		DueAt:    due.Unix(),
+	}
+
+	payment, err := s.licenseePayment(context.Background(), req, "7", big.NewInt(1500), due.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("licenseePayment() error = %v", err)
+	}
+	if payment.Licensee != "Streaming Co" || payment.Amount != "1500" || payment.BondID != "7" {
+		t.Errorf("licenseePayment() = %+v", payment)
+	}
+	if payment.DueAt == nil || !payment.DueAt.Equal(due) || !payment.PaidAt.Equal(due.Add(48*time.Hour)) {
+		t.Errorf("licenseePayment() dates = %v, %v", payment.DueAt, payment.PaidAt)
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkCustody verifies the issuer can escrow the IP-NFT to the bond
+// contract before the issuance transaction is sent: the bond contract
+// already holds it, or the issuer holds it and has approved the bond
+// contract. Custody is read from the chain, never from the view cache.
+func (s *BondingServiceServer) checkCustody(ctx context.Context, chain *chains.Chain, call *issueBondCall, issuer string) error {
+	if !common.IsHexAddress(issuer) {
+		return status.Errorf(codes.InvalidArgument, "issuer_address %q is not an address", issuer)
+	}
+	client := s.chainClient(chain)
+	if client == nil {
+		return status.Errorf(codes.FailedPrecondition, "no client for chain %s", chain.Name)
+	}
+
+	custody := blockchain.NewNFTCustody(client, call.nftContract)
+	err := custody.CheckCustody(ctx, call.ipnftID, common.HexToAddress(issuer), s.bondContract(chain))
+	switch {
+	case errors.Is(err, blockchain.ErrCustody):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Unavailable, "failed to verify custody of IP-NFT %s: %v", call.ipnftID, err)
+	}
+	return nil
+}
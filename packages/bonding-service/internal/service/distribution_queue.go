@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/distribution"
+	"github.com/knowton/bonding-service/internal/models"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetDistributionQueue attaches the batch distribution processor
+func (s *BondingServiceServer) SetDistributionQueue(queue *distribution.BatchProcessor) {
+	s.distributionQueue = queue
+}
+
+// QueueDistributions schedules revenue distributions for one or more bonds.
+// The batch job picks them up once due and paces the resulting transactions.
+func (s *BondingServiceServer) QueueDistributions(
+	ctx context.Context,
+	req *pb.QueueDistributionsRequest,
+) (*pb.QueueDistributionsResponse, error) {
+	if s.distributionQueue == nil {
+		return nil, fmt.Errorf("batch distribution is not enabled")
+	}
+	if len(req.Distributions) == 0 {
+		return nil, fmt.Errorf("at least one distribution is required")
+	}
+
+	// Validate every distribution before queueing any of them
+	items := make([]*models.QueuedDistribution, 0, len(req.Distributions))
+	for _, d := range req.Distributions {
+		amount, ok := new(big.Int).SetString(d.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid revenue amount for bond %s", d.BondId)
+		}
+
+		var count int64
+		if err := s.db.WithContext(ctx).Model(&models.Bond{}).Where("bond_id = ?", d.BondId).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up bond: %w", err)
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("bond not found: %s", d.BondId)
+		}
+
+		dueAt := time.Now()
+		if d.DueAt > 0 {
+			dueAt = time.Unix(d.DueAt, 0)
+		}
+		items = append(items, &models.QueuedDistribution{BondID: d.BondId, Amount: amount.String(), DueAt: dueAt})
+	}
+
+	if err := s.distributionQueue.EnqueueAll(ctx, items); err != nil {
+		return nil, err
+	}
+
+	queued := make([]*pb.QueuedDistribution, 0, len(items))
+	for _, item := range items {
+		queued = append(queued, &pb.QueuedDistribution{
+			Id:     uint64(item.ID),
+			BondId: item.BondID,
+			Amount: item.Amount,
+			DueAt:  item.DueAt.Unix(),
+			Status: item.Status,
+		})
+	}
+
+	return &pb.QueueDistributionsResponse{Distributions: queued}, nil
+}
+
+// DistributeQueued runs a queued distribution through the same path as
+// DistributeRevenue, paying the period it was due for
+func (s *BondingServiceServer) DistributeQueued(ctx context.Context, bondID string, amount string, dueAt time.Time) (string, error) {
+	resp, err := s.distributeRevenue(ctx, &pb.DistributeRevenueRequest{
+		BondId:  bondID,
+		Revenue: amount,
+	}, &dueAt)
+	if status.Code(err) == codes.AlreadyExists {
+		// The status carries ErrDuplicate's message; make it matchable again
+		message := strings.TrimPrefix(status.Convert(err).Message(), distribution.ErrDuplicate.Error())
+		return "", fmt.Errorf("%w%s", distribution.ErrDuplicate, message)
+	}
+	if message := status.Convert(err).Message(); status.Code(err) == codes.FailedPrecondition &&
+		strings.HasPrefix(message, distribution.ErrSuspended.Error()) {
+		return "", fmt.Errorf("%w%s", distribution.ErrSuspended, strings.TrimPrefix(message, distribution.ErrSuspended.Error()))
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.TxHash, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/schedule"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxSkippedRuns caps the runs one SkipDistributionRuns call can skip
+const MaxSkippedRuns = 52
+
+// SetScheduler makes distribution schedules manageable through the RPCs
+func (s *BondingServiceServer) SetScheduler(scheduler *schedule.Scheduler) {
+	s.scheduler = scheduler
+}
+
+// SetDistributionSchedule creates or replaces the cadence on which a bond's
+// accrued revenue is distributed
+func (s *BondingServiceServer) SetDistributionSchedule(
+	ctx context.Context,
+	req *pb.SetDistributionScheduleRequest,
+) (*pb.DistributionSchedule, error) {
+	cadence, ok := schedule.ParseCadence(req.Cadence)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "cadence must be one of %s", strings.Join(schedule.Cadences, ", "))
+	}
+	bond, err := s.scheduledBond(ctx, req.BondId)
+	if err != nil {
+		return nil, err
+	}
+	switch bond.Status {
+	case models.BondActive, models.BondPaused:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s is %s", bond.BondID, bond.Status)
+	}
+
+	anchor := time.Now()
+	if req.FirstRunAt > 0 {
+		anchor = time.Unix(req.FirstRunAt, 0)
+	}
+	sched, err := s.scheduler.Set(ctx, bond, cadence, anchor, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return distributionScheduleInfo(sched), nil
+}
+
+// GetDistributionSchedule returns a bond's schedule and its last run
+func (s *BondingServiceServer) GetDistributionSchedule(
+	ctx context.Context,
+	req *pb.DistributionScheduleRequest,
+) (*pb.DistributionSchedule, error) {
+	if _, err := s.scheduledBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	return scheduleResult(s.scheduler.Get(ctx, req.BondId))
+}
+
+// PauseDistributionSchedule stops a bond's scheduled runs. Revenue keeps
+// accruing and is paid by the first run after it resumes.
+func (s *BondingServiceServer) PauseDistributionSchedule(
+	ctx context.Context,
+	req *pb.DistributionScheduleRequest,
+) (*pb.DistributionSchedule, error) {
+	if _, err := s.scheduledBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	return scheduleResult(s.scheduler.Pause(ctx, req.BondId))
+}
+
+// ResumeDistributionSchedule restarts a paused schedule at its next run
+func (s *BondingServiceServer) ResumeDistributionSchedule(
+	ctx context.Context,
+	req *pb.DistributionScheduleRequest,
+) (*pb.DistributionSchedule, error) {
+	if _, err := s.scheduledBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	return scheduleResult(s.scheduler.Resume(ctx, req.BondId))
+}
+
+// SkipDistributionRuns skips a bond's next scheduled runs; the revenue they
+// would have paid rolls into the run after
+func (s *BondingServiceServer) SkipDistributionRuns(
+	ctx context.Context,
+	req *pb.SkipDistributionRunsRequest,
+) (*pb.DistributionSchedule, error) {
+	if _, err := s.scheduledBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	return scheduleResult(s.scheduler.Skip(ctx, req.BondId, int(req.Runs)))
+}
+
+// DeleteDistributionSchedule stops scheduling a bond's distributions.
+// Distributions already queued are left to the batch job.
+func (s *BondingServiceServer) DeleteDistributionSchedule(
+	ctx context.Context,
+	req *pb.DistributionScheduleRequest,
+) (*pb.DeleteDistributionScheduleResponse, error) {
+	if _, err := s.scheduledBond(ctx, req.BondId); err != nil {
+		return nil, err
+	}
+	if _, err := scheduleResult(nil, s.scheduler.Delete(ctx, req.BondId)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteDistributionScheduleResponse{Deleted: true}, nil
+}
+
+// scheduledBond returns the caller's bond once scheduling is available
+func (s *BondingServiceServer) scheduledBond(ctx context.Context, bondID string) (*models.Bond, error) {
+	if s.scheduler == nil {
+		return nil, status.Error(codes.Unimplemented, "distribution scheduling is not enabled")
+	}
+	bond, err := s.bonds.GetBond(ctx, bondID)
+	if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+		return nil, status.Errorf(codes.NotFound, "bond %s not found", bondID)
+	}
+	return bond, nil
+}
+
+// scheduleResult maps a scheduler result to the API
+func scheduleResult(sched *models.DistributionSchedule, err error) (*pb.DistributionSchedule, error) {
+	if errors.Is(err, schedule.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sched == nil {
+		return nil, nil
+	}
+	return distributionScheduleInfo(sched), nil
+}
+
+func distributionScheduleInfo(sched *models.DistributionSchedule) *pb.DistributionSchedule {
+	info := &pb.DistributionSchedule{
+		BondId:       sched.BondID,
+		Cadence:      sched.Cadence,
+		FirstRunAt:   sched.Anchor.Unix(),
+		NextRunAt:    sched.NextRunAt.Unix(),
+		Amount:       sched.Amount,
+		Paused:       sched.Paused,
+		SkipRuns:     int32(sched.SkipRuns),
+		AccruedSince: sched.AccruedSince.Unix(),
+		LastStatus:   sched.LastStatus,
+		LastError:    sched.LastError,
+		LastQueuedId: uint64(sched.LastQueuedID),
+	}
+	if sched.LastRunAt != nil {
+		info.LastRunAt = sched.LastRunAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/documents"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/storage"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultDocumentURLTTL = 15 * time.Minute
+	maxDocumentURLTTL     = 7 * 24 * time.Hour
+)
+
+// SetDocumentManager enables storing generated documents
+func (s *BondingServiceServer) SetDocumentManager(manager *documents.Manager) {
+	s.documents = manager
+}
+
+// GetDocumentURL returns a time-limited download link for a stored document
+func (s *BondingServiceServer) GetDocumentURL(
+	ctx context.Context,
+	req *pb.GetDocumentURLRequest,
+) (*pb.GetDocumentURLResponse, error) {
+	if s.documents == nil {
+		return nil, status.Error(codes.Unimplemented, "document storage is not configured")
+	}
+
+	ttl := defaultDocumentURLTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	if ttl > maxDocumentURLTTL {
+		ttl = maxDocumentURLTTL
+	}
+
+	doc, url, err := s.documents.SignedURL(ctx, tenant.FromContext(ctx), uint(req.DocumentId), ttl)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &pb.GetDocumentURLResponse{
+		DocumentId:   uint64(doc.ID),
+		Name:         doc.Name,
+		Kind:         doc.Kind,
+		ContentType:  doc.ContentType,
+		Size:         doc.Size,
+		Sha256:       doc.SHA256,
+		Url:          url,
+		UrlExpiresAt: time.Now().Add(ttl).Unix(),
+	}, nil
+}
+
+// storeDocument saves a generated document for the caller's tenant and returns a download link
+func (s *BondingServiceServer) storeDocument(
+	ctx context.Context,
+	kind string,
+	name string,
+	contentType string,
+	content []byte,
+) (*models.Document, string, error) {
+	if s.documents == nil {
+		return nil, "", status.Error(codes.FailedPrecondition, "document storage is not configured")
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	doc, err := s.documents.Save(ctx, tenantID, kind, name, contentType, content)
+	if errors.Is(err, storage.ErrInfected) {
+		return nil, "", status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, url, err := s.documents.SignedURL(ctx, tenantID, doc.ID, defaultDocumentURLTTL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign document URL: %w", err)
+	}
+	return doc, url, nil
+}
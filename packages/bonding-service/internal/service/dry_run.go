@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/blockchain"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// simulateIssueBond answers a dry-run IssueBond. The contract call is run with
+// eth_call so the response carries the bond ID the contract would assign;
+// nothing is saved and no transaction is sent.
+func (s *BondingServiceServer) simulateIssueBond(
+	ctx context.Context,
+	req *pb.IssueBondRequest,
+	plan *issuance,
+) (*pb.IssueBondResponse, error) {
+	call, err := s.newIssueBondCall(req, plan.totalValue, plan.assessment)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(plan.chain), s.bondContract(plan.chain).Hex(), s.privateKey, plan.chain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+
+	bondID, err := contract.SimulateIssueBond(ctx, call.ipnftID, call.nftContract, call.totalValue,
+		call.seniorAllocation, call.mezzanineAllocation, call.juniorAllocation,
+		call.maturityDate, call.valuationUSD, call.riskRating)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "issuance would fail on-chain: %v", err)
+	}
+
+	tranches := s.issuanceTranches(bondID.String(), req, plan.totalValue)
+	response := s.issueBondResponse(req, tranches, plan.assessment, plan.warnings)
+	response.BondId = bondID.String()
+	response.Status = "dry_run"
+	return response, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/distribution"
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetDuplicateGuard refuses distributions equivalent to one already executed
+func (s *BondingServiceServer) SetDuplicateGuard(guard *distribution.DuplicateGuard) {
+	s.duplicates = guard
+}
+
+// guardDistribution checks the ledger and the chain for an equivalent
+// distribution before one is submitted, and holds the bond until the
+// returned release is called. When the checks can't run the distribution
+// is refused: paying twice is worse than paying late.
+func (s *BondingServiceServer) guardDistribution(
+	ctx context.Context,
+	bond *models.Bond,
+	candidate distribution.Candidate,
+) (func(), error) {
+	if s.duplicates == nil {
+		return func() {}, nil
+	}
+	release, err := s.duplicates.Begin(bond.BondID)
+	if err != nil {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	err = s.duplicates.CheckLedger(ctx, candidate)
+	if err == nil {
+		err = s.checkChainDuplicates(ctx, bond, candidate)
+	}
+	if errors.Is(err, distribution.ErrDuplicate) {
+		release()
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		release()
+		return nil, status.Errorf(codes.Unavailable, "could not rule out a duplicate distribution: %v", err)
+	}
+	return release, nil
+}
+
+func (s *BondingServiceServer) checkChainDuplicates(ctx context.Context, bond *models.Bond, candidate distribution.Candidate) error {
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return err
+	}
+	client := s.chainClient(chain)
+	if client == nil {
+		return nil
+	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	return s.duplicates.CheckChain(ctx, client, s.bondContract(chain), candidate)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/eligibility"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetEligibility restricts tranches to the investors policy allows. Investor
+// attributes come from their KYC profiles; without KYC every investor is
+// treated as unaccredited in an unknown jurisdiction.
+func (s *BondingServiceServer) SetEligibility(policy *eligibility.Policy) {
+	s.eligibility = policy
+}
+
+// checkEligibility refuses an investor the eligibility rules exclude from a
+// tranche
+func (s *BondingServiceServer) checkEligibility(ctx context.Context, address string, trancheID int) error {
+	if s.eligibility == nil {
+		return nil
+	}
+	var investor eligibility.Investor
+	if s.kyc != nil {
+		profile, err := s.kyc.Profile(ctx, tenant.FromContext(ctx), address)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check investor eligibility: %v", err)
+		}
+		if profile != nil {
+			investor = eligibility.Investor{
+				Jurisdiction:    profile.Jurisdiction,
+				Accredited:      profile.Accredited,
+				AccreditedUntil: profile.AccreditedUntil,
+			}
+		}
+	}
+
+	err := s.eligibility.Check(investor, trancheID, time.Now())
+	if errors.Is(err, eligibility.ErrIneligible) {
+		metrics.EligibilityRefusals.WithLabelValues(eligibility.TrancheClass(trancheID)).Inc()
+		log.Printf("Refused ineligible investor %s: %v", address, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/notify"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/timeline"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultEmergencyConfirmWindow is how long a requested emergency withdrawal
+// waits for a second operator's confirmation
+const DefaultEmergencyConfirmWindow = time.Hour
+
+// emergencyNotice is what investors are told about an executed withdrawal
+type emergencyNotice struct {
+	WithdrawalID uint   `json:"withdrawal_id"`
+	Recipient    string `json:"recipient"`
+	ReasonCode   string `json:"reason_code"`
+	Reason       string `json:"reason"`
+	TxHash       string `json:"tx_hash"`
+}
+
+// SetNotifications queues investor notices in the outbox
+func (s *BondingServiceServer) SetNotifications(outbox *notify.Outbox) {
+	s.notifications = outbox
+}
+
+// SetEmergencyConfirmWindow sets how long a requested emergency withdrawal
+// can be confirmed
+func (s *BondingServiceServer) SetEmergencyConfirmWindow(window time.Duration) {
+	s.emergencyConfirmWindow = window
+}
+
+// RequestEmergencyWithdrawal asks to sweep a frozen bond's escrowed funds to
+// a recovery address. Nothing is sent until another operator confirms.
+func (s *BondingServiceServer) RequestEmergencyWithdrawal(
+	ctx context.Context,
+	req *pb.RequestEmergencyWithdrawalRequest,
+) (*pb.EmergencyWithdrawal, error) {
+	operator, err := emergencyOperator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveAddresses(ctx, &req.RecoveryAddress); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.RecoveryAddress) || common.HexToAddress(req.RecoveryAddress) == (common.Address{}) {
+		return nil, status.Error(codes.InvalidArgument, "recovery_address must be a valid address")
+	}
+	if !slices.Contains(models.EmergencyReasons, req.ReasonCode) {
+		return nil, status.Errorf(codes.InvalidArgument, "reason_code must be one of %s", strings.Join(models.EmergencyReasons, ", "))
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required")
+	}
+
+	window := s.emergencyConfirmWindow
+	if window <= 0 {
+		window = DefaultEmergencyConfirmWindow
+	}
+	withdrawal := &models.EmergencyWithdrawal{
+		TenantID:    tenant.FromContext(ctx),
+		BondID:      req.BondId,
+		Recipient:   common.HexToAddress(req.RecoveryAddress).Hex(),
+		ReasonCode:  req.ReasonCode,
+		Reason:      req.Reason,
+		Status:      models.WithdrawalPending,
+		RequestedBy: operator,
+		ExpiresAt:   time.Now().Add(window),
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The bond row is locked so concurrent requests are serialized
+		if _, err := frozenBond(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), req.BondId); err != nil {
+			return err
+		}
+		var pending int64
+		if err := tx.Model(&models.EmergencyWithdrawal{}).
+			Where("bond_id = ? AND (status = ? AND expires_at > ? OR status = ?)", req.BondId,
+				models.WithdrawalPending, time.Now(), models.WithdrawalExecuting).
+			Count(&pending).Error; err != nil {
+			return fmt.Errorf("failed to look up withdrawals: %w", err)
+		}
+		if pending > 0 {
+			return status.Errorf(codes.AlreadyExists, "bond %s already has an emergency withdrawal awaiting confirmation", req.BondId)
+		}
+		if err := tx.Create(withdrawal).Error; err != nil {
+			return fmt.Errorf("failed to save withdrawal: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("ALERT: %s requested an emergency withdrawal of bond %s to %s (%s)",
+		operator, withdrawal.BondID, withdrawal.Recipient, withdrawal.ReasonCode)
+	return emergencyWithdrawalInfo(withdrawal), nil
+}
+
+// ConfirmEmergencyWithdrawal executes a requested withdrawal. The confirming
+// operator must differ from the requesting one and repeat the bond and
+// recovery address. The bond's investors are then notified.
+func (s *BondingServiceServer) ConfirmEmergencyWithdrawal(
+	ctx context.Context,
+	req *pb.ConfirmEmergencyWithdrawalRequest,
+) (*pb.EmergencyWithdrawal, error) {
+	operator, err := emergencyOperator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	withdrawal, err := s.pendingWithdrawal(ctx, req.WithdrawalId)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawal.RequestedBy == operator {
+		return nil, status.Error(codes.PermissionDenied, "an emergency withdrawal must be confirmed by another operator")
+	}
+	if req.BondId != withdrawal.BondID || !strings.EqualFold(req.RecoveryAddress, withdrawal.Recipient) {
+		return nil, status.Error(codes.InvalidArgument, "bond_id and recovery_address don't match the request")
+	}
+
+	bond, err := frozenBond(ctx, s.db.WithContext(ctx), withdrawal.BondID)
+	if err != nil {
+		return nil, err
+	}
+	bondID, ok := new(big.Int).SetString(bond.BondID, 10)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", bond.BondID)
+	}
+	if err := s.checkWritable(ctx, bond.Chain); err != nil {
+		return nil, err
+	}
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim the withdrawal so a concurrent confirmation can't send it twice
+	claim := s.db.WithContext(ctx).Model(withdrawal).
+		Where("status = ?", models.WithdrawalPending).
+		Update("status", models.WithdrawalExecuting)
+	if claim.Error != nil {
+		return nil, fmt.Errorf("failed to claim withdrawal: %w", claim.Error)
+	}
+	if claim.RowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "emergency withdrawal %d is no longer pending", withdrawal.ID)
+	}
+
+	chainCtx, cancel := chainContext(ctx)
+	defer cancel()
+	sent, err := contract.EmergencyWithdraw(chainCtx, bondID, common.HexToAddress(withdrawal.Recipient), withdrawal.ReasonCode)
+	if err != nil {
+		if dbErr := s.db.WithContext(ctx).Model(withdrawal).Update("status", models.WithdrawalPending).Error; dbErr != nil {
+			log.Printf("Failed to release emergency withdrawal %d: %v", withdrawal.ID, dbErr)
+		}
+		return nil, fmt.Errorf("failed to withdraw on-chain: %w", err)
+	}
+	txHash := sent.Hash().Hex()
+	s.transactionSent(ctx, chain.Name, txHash, txmonitor.PurposeEmergencyWithdrawal, bond.BondID)
+
+	now := time.Now()
+	withdrawal.Status = models.WithdrawalExecuted
+	withdrawal.TxHash = txHash
+	withdrawal.ResolvedBy = operator
+	withdrawal.ResolvedAt = &now
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(withdrawal).Updates(map[string]interface{}{
+			"status":      withdrawal.Status,
+			"tx_hash":     txHash,
+			"resolved_by": operator,
+			"resolved_at": &now,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update withdrawal: %w", err)
+		}
+		if err := timeline.Record(tx, bond.BondID, timeline.EmergencyWithdrawal,
+			fmt.Sprintf("escrow swept to %s (%s): %s", withdrawal.Recipient, withdrawal.ReasonCode, withdrawal.Reason)); err != nil {
+			return err
+		}
+		return s.notifyInvestors(tx, bond, withdrawal)
+	})
+	if err != nil {
+		log.Printf("ALERT: emergency withdrawal %d of bond %s was sent in %s but not recorded: %v",
+			withdrawal.ID, bond.BondID, txHash, err)
+		return nil, err
+	}
+
+	log.Printf("ALERT: emergency withdrawal %d of bond %s to %s sent in %s, confirmed by %s",
+		withdrawal.ID, bond.BondID, withdrawal.Recipient, txHash, operator)
+	return emergencyWithdrawalInfo(withdrawal), nil
+}
+
+// CancelEmergencyWithdrawal withdraws a request before it is confirmed
+func (s *BondingServiceServer) CancelEmergencyWithdrawal(
+	ctx context.Context,
+	req *pb.CancelEmergencyWithdrawalRequest,
+) (*pb.EmergencyWithdrawal, error) {
+	operator, err := emergencyOperator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required")
+	}
+	withdrawal, err := s.pendingWithdrawal(ctx, req.WithdrawalId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := s.db.WithContext(ctx).Model(withdrawal).
+		Where("status = ?", models.WithdrawalPending).
+		Updates(map[string]interface{}{
+			"status":        models.WithdrawalCancelled,
+			"resolved_by":   operator,
+			"resolved_at":   &now,
+			"cancel_reason": req.Reason,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to cancel withdrawal: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "emergency withdrawal %d is no longer pending", withdrawal.ID)
+	}
+
+	withdrawal.Status = models.WithdrawalCancelled
+	withdrawal.ResolvedBy = operator
+	withdrawal.ResolvedAt = &now
+	withdrawal.CancelReason = req.Reason
+	return emergencyWithdrawalInfo(withdrawal), nil
+}
+
+// ListEmergencyWithdrawals returns the tenant's emergency withdrawals,
+// newest first
+func (s *BondingServiceServer) ListEmergencyWithdrawals(
+	ctx context.Context,
+	req *pb.ListEmergencyWithdrawalsRequest,
+) (*pb.ListEmergencyWithdrawalsResponse, error) {
+	query := s.db.WithContext(ctx).Where("tenant_id = ?", tenant.FromContext(ctx))
+	if req.BondId != "" {
+		query = query.Where("bond_id = ?", req.BondId)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	var withdrawals []models.EmergencyWithdrawal
+	if err := query.Order("id DESC").Limit(repository.MaxPageSize).Find(&withdrawals).Error; err != nil {
+		return nil, fmt.Errorf("failed to list withdrawals: %w", err)
+	}
+
+	response := &pb.ListEmergencyWithdrawalsResponse{}
+	for i := range withdrawals {
+		response.Withdrawals = append(response.Withdrawals, emergencyWithdrawalInfo(&withdrawals[i]))
+	}
+	return response, nil
+}
+
+// emergencyOperator returns the authenticated caller, whom the four-eyes
+// check compares
+func emergencyOperator(ctx context.Context) (string, error) {
+	principal, ok := rbac.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.FailedPrecondition, "emergency withdrawals need an authenticated operator")
+	}
+	return principal.ID, nil
+}
+
+// frozenBond loads one of the tenant's bonds, refusing it unless an operator
+// froze it first
+func frozenBond(ctx context.Context, db *gorm.DB, bondID string) (*models.Bond, error) {
+	var bond models.Bond
+	err := db.Where("bond_id = ?", bondID).First(&bond).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && bond.TenantID != tenant.FromContext(ctx)) {
+		return nil, status.Errorf(codes.NotFound, "bond %s not found", bondID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bond: %w", err)
+	}
+	if bond.Status != models.BondFrozen {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s must be frozen first (status: %s)", bondID, bond.Status)
+	}
+	return &bond, nil
+}
+
+// pendingWithdrawal loads one of the tenant's withdrawals awaiting
+// confirmation, expiring it if its window has passed
+func (s *BondingServiceServer) pendingWithdrawal(ctx context.Context, id uint64) (*models.EmergencyWithdrawal, error) {
+	var withdrawal models.EmergencyWithdrawal
+	err := s.db.WithContext(ctx).First(&withdrawal, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && withdrawal.TenantID != tenant.FromContext(ctx)) {
+		return nil, status.Errorf(codes.NotFound, "emergency withdrawal %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load withdrawal: %w", err)
+	}
+	if withdrawal.Status != models.WithdrawalPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "emergency withdrawal %d is %s", id, withdrawal.Status)
+	}
+	if time.Now().After(withdrawal.ExpiresAt) {
+		now := time.Now()
+		if err := s.db.WithContext(ctx).Model(&withdrawal).
+			Where("status = ?", models.WithdrawalPending).
+			Updates(map[string]interface{}{"status": models.WithdrawalExpired, "resolved_at": &now}).Error; err != nil {
+			return nil, fmt.Errorf("failed to expire withdrawal: %w", err)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "emergency withdrawal %d expired unconfirmed", id)
+	}
+	return &withdrawal, nil
+}
+
+// notifyInvestors queues a notice of an executed withdrawal to everyone
+// holding a position in the bond
+func (s *BondingServiceServer) notifyInvestors(tx *gorm.DB, bond *models.Bond, w *models.EmergencyWithdrawal) error {
+	if s.notifications == nil {
+		log.Printf("No notification channel is configured; investors of bond %s were not notified", bond.BondID)
+		return nil
+	}
+	var investors []string
+	if err := tx.Model(&models.Investment{}).
+		Where("bond_id = ?", bond.BondID).
+		Distinct().Order("investor").
+		Pluck("investor", &investors).Error; err != nil {
+		return fmt.Errorf("failed to load investors: %w", err)
+	}
+	return s.notifications.Enqueue(tx, bond.TenantID, bond.BondID, notify.EventEmergencyWithdrawal, emergencyNotice{
+		WithdrawalID: w.ID,
+		Recipient:    w.Recipient,
+		ReasonCode:   w.ReasonCode,
+		Reason:       w.Reason,
+		TxHash:       w.TxHash,
+	}, investors)
+}
+
+func emergencyWithdrawalInfo(w *models.EmergencyWithdrawal) *pb.EmergencyWithdrawal {
+	info := &pb.EmergencyWithdrawal{
+		Id:              uint64(w.ID),
+		BondId:          w.BondID,
+		RecoveryAddress: w.Recipient,
+		ReasonCode:      w.ReasonCode,
+		Reason:          w.Reason,
+		Status:          w.Status,
+		RequestedBy:     w.RequestedBy,
+		RequestedAt:     w.CreatedAt.Unix(),
+		ExpiresAt:       w.ExpiresAt.Unix(),
+		ResolvedBy:      w.ResolvedBy,
+		CancelReason:    w.CancelReason,
+		TxHash:          w.TxHash,
+	}
+	if w.ResolvedAt != nil {
+		info.ResolvedAt = w.ResolvedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/rbac"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfirmEmergencyWithdrawalRefusals(t *testing.T) {
+	const recipient = "0x000000000000000000000000000000000000dEaD"
+	tests := []struct {
+		name       string
+		operator   string // Empty for an unauthenticated caller
+		tenant     string // The withdrawal's tenant
+		status     string
+		expiresIn  time.Duration
+		bondID     string // Echoed by the confirmer
+		recipient  string // Echoed by the confirmer
+		bondStatus string // Empty if the bond isn't loaded
+		wantCode   codes.Code
+	}{
+		{"unauthenticated", "", "acme", "PENDING", time.Hour, "7", recipient, "", codes.FailedPrecondition},
+		{"requester confirms", "alice", "acme", "PENDING", time.Hour, "7", recipient, "", codes.PermissionDenied},
+		{"wrong bond", "bob", "acme", "PENDING", time.Hour, "8", recipient, "", codes.InvalidArgument},
+		{"wrong recipient", "bob", "acme", "PENDING", time.Hour, "7", "0x000000000000000000000000000000000000bEEF", "", codes.InvalidArgument},
+		{"already executed", "bob", "acme", "EXECUTED", time.Hour, "7", recipient, "", codes.FailedPrecondition},
+		{"expired", "bob", "acme", "PENDING", -time.Minute, "7", recipient, "", codes.FailedPrecondition},
+		{"another tenant's withdrawal", "bob", "other", "PENDING", time.Hour, "7", recipient, "", codes.NotFound},
+		{"bond resumed", "bob", "acme", "PENDING", time.Hour, "7", recipient, "ACTIVE", codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			if tt.operator != "" {
+				mock.ExpectQuery(`SELECT \* FROM "emergency_withdrawals" WHERE "emergency_withdrawals"."id" = \$1`).
+					WithArgs(3, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "bond_id", "recipient", "status", "requested_by", "expires_at"}).
+						AddRow(3, tt.tenant, "7", recipient, tt.status, "alice", time.Now().Add(tt.expiresIn)))
+			}
+			if tt.expiresIn < 0 {
+				mock.ExpectExec(`UPDATE "emergency_withdrawals" SET "resolved_at"=\$1,"status"=\$2,"updated_at"=\$3 WHERE status = \$4`).
+					WithArgs(sqlmock.AnyArg(), "EXPIRED", sqlmock.AnyArg(), "PENDING", 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			if tt.bondStatus != "" {
+				mock.ExpectQuery(`SELECT \* FROM "bonds" WHERE bond_id = \$1`).
+					WithArgs("7", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "tenant_id", "status"}).
+						AddRow(1, "7", "acme", tt.bondStatus))
+			}
+
+			s := &BondingServiceServer{db: db}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "acme"))
+			if tt.operator != "" {
+				ctx = rbac.NewContext(ctx, &rbac.Principal{ID: tt.operator, Roles: []rbac.Role{rbac.Operator}})
+			}
+			_, err := s.ConfirmEmergencyWithdrawal(ctx, &pb.ConfirmEmergencyWithdrawalRequest{
+				WithdrawalId:    3,
+				BondId:          tt.bondID,
+				RecoveryAddress: tt.recipient,
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("ConfirmEmergencyWithdrawal() code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/ens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ensLookupTimeout bounds the reverse lookups made while building a response
+const ensLookupTimeout = 2 * time.Second
+
+// SetENSResolver enables ENS names in requests and reverse lookups in responses
+func (s *BondingServiceServer) SetENSResolver(resolver *ens.Resolver) {
+	s.ensResolver = resolver
+}
+
+// resolveAddress accepts either a hex address or an ENS name and returns the hex address
+func (s *BondingServiceServer) resolveAddress(ctx context.Context, value string) (string, error) {
+	if value == "" || !ens.IsName(value) {
+		return value, nil
+	}
+	if s.ensResolver == nil {
+		return "", status.Errorf(codes.InvalidArgument, "ENS names are not supported: %s", value)
+	}
+
+	addr, err := s.ensResolver.Resolve(ctx, value)
+	if errors.Is(err, ens.ErrNotFound) {
+		return "", status.Errorf(codes.InvalidArgument, "ENS name %s does not resolve to an address", value)
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "failed to resolve ENS name %s: %v", value, err)
+	}
+	return addr.Hex(), nil
+}
+
+// resolveAddresses resolves each field in place
+func (s *BondingServiceServer) resolveAddresses(ctx context.Context, fields ...*string) error {
+	for _, field := range fields {
+		resolved, err := s.resolveAddress(ctx, *field)
+		if err != nil {
+			return err
+		}
+		*field = resolved
+	}
+	return nil
+}
+
+// reverseResolve returns the primary ENS names of addresses, keyed by normalized address.
+// Lookup failures are skipped; ENS names are annotations only.
+func (s *BondingServiceServer) reverseResolve(ctx context.Context, addresses []string) map[string]string {
+	names := make(map[string]string)
+	if s.ensResolver == nil || len(addresses) == 0 {
+		return names
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ensLookupTimeout)
+	defer cancel()
+
+	for _, addr := range addresses {
+		key := normalizeAddress(addr)
+		if _, done := names[key]; done || !common.IsHexAddress(addr) {
+			continue
+		}
+		name, err := s.ensResolver.Lookup(ctx, common.HexToAddress(addr))
+		if err != nil {
+			if !errors.Is(err, ens.ErrNotFound) {
+				log.Printf("ENS reverse lookup failed for %s: %v", addr, err)
+			}
+			names[key] = ""
+			continue
+		}
+		names[key] = name
+	}
+	return names
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetFeatureFlags gates risky capabilities on the flags in store, and makes
+// them manageable through the admin RPCs. Without it every flag has its
+// default.
+func (s *BondingServiceServer) SetFeatureFlags(store *flags.Store) {
+	s.featureFlags = store
+}
+
+// featureEnabled reports whether a flag is on for the calling tenant
+func (s *BondingServiceServer) featureEnabled(ctx context.Context, name string) bool {
+	if s.featureFlags == nil {
+		return flags.Default(name)
+	}
+	return s.featureFlags.Enabled(tenant.FromContext(ctx), name)
+}
+
+// checkFeature refuses a call needing a flag that is off for the calling tenant
+func (s *BondingServiceServer) checkFeature(ctx context.Context, name string) error {
+	if !s.featureEnabled(ctx, name) {
+		return status.Errorf(codes.FailedPrecondition, "%s is not enabled for this tenant", name)
+	}
+	return nil
+}
+
+// SetFeatureFlag replaces a flag's setting for every tenant
+func (s *BondingServiceServer) SetFeatureFlag(
+	ctx context.Context,
+	req *pb.SetFeatureFlagRequest,
+) (*pb.FeatureFlag, error) {
+	if s.featureFlags == nil {
+		return nil, status.Error(codes.Unimplemented, "feature flags are not configured")
+	}
+
+	var updatedBy string
+	if principal, ok := rbac.FromContext(ctx); ok {
+		updatedBy = principal.ID
+	}
+	flag, err := s.featureFlags.Set(ctx, req.Name, req.Enabled, int(req.RolloutPercent), req.Tenants, updatedBy)
+	if errors.Is(err, flags.ErrInvalidFlag) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.featureFlagInfo(ctx, flag), nil
+}
+
+// ListFeatureFlags returns every flag's setting and whether it is on for the
+// calling tenant
+func (s *BondingServiceServer) ListFeatureFlags(
+	ctx context.Context,
+	req *pb.ListFeatureFlagsRequest,
+) (*pb.ListFeatureFlagsResponse, error) {
+	settings := flags.Defaults()
+	if s.featureFlags != nil {
+		settings = s.featureFlags.List()
+	}
+
+	resp := &pb.ListFeatureFlagsResponse{}
+	for i := range settings {
+		resp.Flags = append(resp.Flags, s.featureFlagInfo(ctx, &settings[i]))
+	}
+	return resp, nil
+}
+
+func (s *BondingServiceServer) featureFlagInfo(ctx context.Context, f *models.FeatureFlag) *pb.FeatureFlag {
+	known, _ := flags.Lookup(f.Name)
+	info := &pb.FeatureFlag{
+		Name:             f.Name,
+		Description:      known.Description,
+		Enabled:          f.Enabled,
+		RolloutPercent:   uint32(f.RolloutPercent),
+		Tenants:          flags.TenantList(f),
+		EnabledForCaller: s.featureEnabled(ctx, f.Name),
+		UpdatedBy:        f.UpdatedBy,
+	}
+	if !f.UpdatedAt.IsZero() {
+		info.UpdatedAt = f.UpdatedAt.Unix()
+	}
+	return info
+}
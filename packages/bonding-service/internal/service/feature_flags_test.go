@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestFeatureFlagsMatchProto keeps the flag names in step with the ones
+// bonding.proto lists
+func TestFeatureFlagsMatchProto(t *testing.T) {
+	spec, err := os.ReadFile("../../proto/bonding.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed := `.string = {in: ["` + strings.Join(flags.Names(), `", "`) + `"]}`
+	if !strings.Contains(string(spec), listed) {
+		t.Errorf("bonding.proto doesn't list feature flags %v", flags.Names())
+	}
+}
+
+type fixedModel struct{}
+
+func (fixedModel) AssessIPValue(ipnftID string, metadata *risk.IPMetadata) (*models.RiskAssessment, error) {
+	return &models.RiskAssessment{IPNFTId: ipnftID, RiskRating: "A"}, nil
+}
+func (fixedModel) SupportedCategories() []string { return nil }
+func (fixedModel) Version() string               { return "fixed-1" }
+
+func TestFeatureFlagGates(t *testing.T) {
+	db, mock := newMockDB(t)
+	// Contract calls are off for everyone; the oracle model and ERC-20
+	// payments are only on for globex
+	mock.ExpectQuery(`SELECT \* FROM "feature_flags"`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "enabled", "rollout_percent", "tenants"}).
+			AddRow(1, flags.ContractCalls, false, 100, "").
+			AddRow(2, flags.OracleRiskModel, true, 0, "globex").
+			AddRow(3, flags.ERC20Payments, true, 0, "globex"))
+	store := flags.NewStore(db)
+	if err := store.Load(context.Background()); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	s := &BondingServiceServer{riskEngine: risk.NewRiskEngine()}
+	s.riskModels = risk.NewRegistry(risk.HeuristicModel, s.riskEngine)
+	s.RegisterRiskModel(risk.OracleModel, fixedModel{})
+	if err := s.SetRiskModelRouting(risk.OracleModel, nil); err != nil {
+		t.Fatal(err)
+	}
+	s.SetFeatureFlags(store)
+
+	acme := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "acme"))
+	globex := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "globex"))
+
+	if err := s.checkWritable(globex, ""); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("checkWritable() with contract calls off error = %v, want FailedPrecondition", err)
+	}
+	if _, err := s.PreparePermitInvestment(acme, &pb.PreparePermitInvestmentRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("PreparePermitInvestment() with ERC-20 payments off error = %v, want FailedPrecondition", err)
+	}
+
+	ip := &risk.IPMetadata{Category: "music", CreatedAt: time.Now().AddDate(-1, 0, 0)}
+	if a, err := s.assessRisk(globex, "", "1", ip); err != nil || a.RiskModel != risk.OracleModel {
+		t.Errorf("assessRisk() for a tenant in the rollout = %+v, %v, want the oracle model", a, err)
+	}
+	if a, err := s.assessRisk(acme, "", "1", ip); err != nil || a.RiskModel != risk.HeuristicModel {
+		t.Errorf("assessRisk() for a tenant outside the rollout = %+v, %v, want the heuristic fallback", a, err)
+	}
+	if _, err := s.assessRisk(acme, risk.OracleModel, "1", ip); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("assessRisk() naming the oracle model outside the rollout error = %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SetFingerprinter fingerprints each bond's content at issuance so the same
+// content can't back two bonds. With flagOnly set a duplicate is reported as
+// a warning rather than refusing the issuance.
+func (s *BondingServiceServer) SetFingerprinter(fingerprinter oracle.Fingerprinter, flagOnly bool) {
+	s.fingerprinter = fingerprinter
+	s.flagDuplicates = flagOnly
+}
+
+// fingerprintContentTypes maps the built-in categories to the content types
+// the oracle fingerprints; other categories are fingerprinted as text
+var fingerprintContentTypes = map[string]string{
+	"music":   "audio",
+	"video":   "video",
+	"course":  "video",
+	"artwork": "image",
+}
+
+// contentFingerprint fingerprints the content of an issuance and checks no
+// live bond is backed by the same content. The content is the request's
+// content_url, else the media the IP-NFT's metadata names. Content that
+// can't be fingerprinted is a warning, unless duplicates are refused and the
+// oracle failed, as the duplicate check can't then be made.
+func (s *BondingServiceServer) contentFingerprint(
+	ctx context.Context,
+	req *pb.IssueBondRequest,
+	document *ipmeta.Document,
+	category string,
+) (string, []string, error) {
+	if s.fingerprinter == nil {
+		return "", nil, nil
+	}
+	contentType, ok := fingerprintContentTypes[s.categoryParams(category).Slug]
+	if !ok {
+		contentType = "text"
+	}
+	contentURL := strings.TrimSpace(req.ContentUrl)
+	if contentURL == "" && document != nil {
+		contentURL = document.AnimationURL
+		if contentURL == "" && contentType == "image" {
+			contentURL = document.Image
+		}
+	}
+	if contentURL == "" {
+		return "", []string{"content not fingerprinted: set content_url or animation_url in the IP-NFT metadata"}, nil
+	}
+
+	result, err := s.fingerprinter.GenerateFingerprint(ctx, contentURL, contentType, map[string]interface{}{
+		"ipnft_id": req.IpnftId,
+		"category": category,
+	})
+	if err == nil && result.Fingerprint == "" {
+		err = errors.New("oracle returned an empty fingerprint")
+	}
+	if err != nil {
+		if s.flagDuplicates {
+			return "", []string{fmt.Sprintf("content not fingerprinted: %v", err)}, nil
+		}
+		return "", nil, status.Errorf(codes.Unavailable, "failed to fingerprint content for the duplicate check: %v", err)
+	}
+
+	// Matured and cancelled bonds no longer hold their content as collateral
+	var existing models.Bond
+	err = s.db.WithContext(ctx).
+		Select("bond_id", "tenant_id").
+		Where("content_fingerprint = ? AND status NOT IN ?", result.Fingerprint, []string{models.BondMatured, models.BondCancelled}).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return result.Fingerprint, nil, nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to look up content fingerprint: %w", err)
+	}
+
+	// Bonds of other tenants aren't named
+	backing := "another bond"
+	if existing.TenantID == tenant.FromContext(ctx) {
+		backing = "bond " + existing.BondID
+	}
+	if s.flagDuplicates {
+		metrics.DuplicateContent.WithLabelValues("flagged").Inc()
+		return result.Fingerprint, []string{fmt.Sprintf("the same content already backs %s", backing)}, nil
+	}
+	metrics.DuplicateContent.WithLabelValues("rejected").Inc()
+	return "", nil, status.Errorf(codes.AlreadyExists, "the same content already backs %s", backing)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/oracle"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+// fakeFingerprinter fingerprints content as its URL, recording the last call
+type fakeFingerprinter struct {
+	err         error
+	url         string
+	contentType string
+}
+
+func (f *fakeFingerprinter) GenerateFingerprint(ctx context.Context, contentURL, contentType string, metadata map[string]interface{}) (*oracle.FingerprintResponse, error) {
+	f.url, f.contentType = contentURL, contentType
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &oracle.FingerprintResponse{Fingerprint: "fp:" + contentURL}, nil
+}
+
+func TestContentFingerprint(t *testing.T) {
+	const lookup = `SELECT "bond_id","tenant_id" FROM "bonds" WHERE (content_fingerprint = $1 AND status NOT IN ($2,$3))`
+	document := &ipmeta.Document{Image: "ipfs://QmCover", AnimationURL: "ipfs://QmTrack"}
+
+	tests := []struct {
+		name            string
+		req             *pb.IssueBondRequest
+		document        *ipmeta.Document
+		category        string
+		flagOnly        bool
+		oracleErr       error
+		existing        []string // Tenant and bond ID of a bond with the same content
+		wantURL         string
+		wantContentType string
+		wantFingerprint string
+		wantWarnings    int
+		wantCode        codes.Code
+	}{
+		{"request URL wins", &pb.IssueBondRequest{ContentUrl: "ipfs://QmMaster"}, document, "music", false, nil, nil, "ipfs://QmMaster", "audio", "fp:ipfs://QmMaster", 0, codes.OK},
+		{"metadata media", &pb.IssueBondRequest{}, document, "ebook", false, nil, nil, "ipfs://QmTrack", "text", "fp:ipfs://QmTrack", 0, codes.OK},
+		{"image for artwork", &pb.IssueBondRequest{}, &ipmeta.Document{Image: "ipfs://QmPainting"}, "artwork", false, nil, nil, "ipfs://QmPainting", "image", "fp:ipfs://QmPainting", 0, codes.OK},
+		{"nothing to fingerprint", &pb.IssueBondRequest{}, &ipmeta.Document{Image: "ipfs://QmCover"}, "music", false, nil, nil, "", "", "", 1, codes.OK},
+		{"duplicate refused", &pb.IssueBondRequest{}, document, "music", false, nil, []string{"acme", "7"}, "ipfs://QmTrack", "audio", "", 0, codes.AlreadyExists},
+		{"duplicate flagged", &pb.IssueBondRequest{}, document, "music", true, nil, []string{"other", "7"}, "ipfs://QmTrack", "audio", "fp:ipfs://QmTrack", 1, codes.OK},
+		{"oracle down refuses", &pb.IssueBondRequest{}, document, "music", false, errors.New("timeout"), nil, "ipfs://QmTrack", "audio", "", 0, codes.Unavailable},
+		{"oracle down flags", &pb.IssueBondRequest{}, document, "music", true, errors.New("timeout"), nil, "ipfs://QmTrack", "audio", "", 1, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			fingerprinter := &fakeFingerprinter{err: tt.oracleErr}
+			s := &BondingServiceServer{db: db}
+			s.SetFingerprinter(fingerprinter, tt.flagOnly)
+
+			if tt.wantURL != "" && tt.oracleErr == nil {
+				rows := sqlmock.NewRows([]string{"bond_id", "tenant_id"})
+				if tt.existing != nil {
+					rows.AddRow(tt.existing[1], tt.existing[0])
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(lookup)).WithArgs("fp:"+tt.wantURL, "MATURED", "CANCELLED", 1).WillReturnRows(rows)
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "acme"))
+			fingerprint, warnings, err := s.contentFingerprint(ctx, tt.req, tt.document, tt.category)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("contentFingerprint() code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+			if fingerprint != tt.wantFingerprint || len(warnings) != tt.wantWarnings {
+				t.Errorf("contentFingerprint() = %q, %v, want %q with %d warnings", fingerprint, warnings, tt.wantFingerprint, tt.wantWarnings)
+			}
+			if fingerprinter.url != tt.wantURL || fingerprinter.contentType != tt.wantContentType {
+				t.Errorf("fingerprinted %q as %q, want %q as %q", fingerprinter.url, fingerprinter.contentType, tt.wantURL, tt.wantContentType)
+			}
+			if tt.wantCode == codes.AlreadyExists && !strings.Contains(err.Error(), "bond 7") {
+				t.Errorf("error %v should name the tenant's own bond", err)
+			}
+			if tt.flagOnly && tt.existing != nil && strings.Contains(warnings[0], "7") {
+				t.Errorf("warning %q names another tenant's bond", warnings[0])
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/forecast"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetRevenueReviewer enables rating reviews when revenue misses its forecast
+func (s *BondingServiceServer) SetRevenueReviewer(reviewer *forecast.Reviewer) {
+	s.forecasts = reviewer
+}
+
+// revenueForecasts validates the forecast periods of an issuance
+func revenueForecasts(periods []*pb.RevenueForecastPeriod) ([]models.RevenueForecast, error) {
+	forecasts := make([]models.RevenueForecast, len(periods))
+	for i, p := range periods {
+		forecasts[i] = models.RevenueForecast{
+			PeriodStart: time.Unix(p.PeriodStart, 0),
+			PeriodEnd:   time.Unix(p.PeriodEnd, 0),
+			Amount:      p.Amount,
+			Actual:      "0",
+		}
+	}
+	if err := forecast.Validate(forecasts); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid revenue_forecast: %v", err)
+	}
+	return forecasts, nil
+}
+
+// forecastDates returns the ends of a bond's forecast periods, on which its
+// coupons fall
+func forecastDates(forecasts []models.RevenueForecast) []time.Time {
+	dates := make([]time.Time, len(forecasts))
+	for i, f := range forecasts {
+		dates[i] = f.PeriodEnd
+	}
+	return dates
+}
+
+// reviewRevenueVariance checks a bond's forecast after new revenue arrives.
+// Failures are logged since the periodic review retries them.
+func (s *BondingServiceServer) reviewRevenueVariance(ctx context.Context, bondID string) {
+	if s.forecasts == nil {
+		return
+	}
+	if _, err := s.forecasts.Review(ctx, bondID); err != nil {
+		log.Printf("Failed to review revenue variance of bond %s: %v", bondID, err)
+	}
+}
+
+// GetRevenueVariance compares a bond's forecast revenue with the revenue
+// actually distributed in each period
+func (s *BondingServiceServer) GetRevenueVariance(
+	ctx context.Context,
+	req *pb.GetRevenueVarianceRequest,
+) (*pb.GetRevenueVarianceResponse, error) {
+	var bond *models.Bond
+	var forecasts []models.RevenueForecast
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		forecasts, err = forecast.Load(s.conn(ctx), bond.BondID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	config := forecast.DefaultConfig()
+	if s.forecasts != nil {
+		config = s.forecasts.Config()
+	}
+	e := forecast.Evaluate(forecasts, time.Now(), config)
+
+	response := &pb.GetRevenueVarianceResponse{
+		BondId:              bond.BondID,
+		Threshold:           config.Threshold,
+		ConsecutiveBreaches: int32(e.Consecutive),
+		ReviewRequired:      e.Review,
+		RatingReviewReason:  bond.RatingReviewReason,
+	}
+	if bond.RatingReviewAt != nil {
+		response.RatingReviewAt = bond.RatingReviewAt.Unix()
+	}
+	for _, p := range e.Periods {
+		response.Periods = append(response.Periods, &pb.RevenueVariancePeriod{
+			PeriodStart: p.Start.Unix(),
+			PeriodEnd:   p.End.Unix(),
+			Forecast:    p.Forecast.String(),
+			Actual:      p.Actual.String(),
+			Variance:    p.Variance,
+			Closed:      p.Closed,
+			Breached:    p.Breached,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/knowton/bonding-service/internal/hooks"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetHooks runs deployment hooks before issuances and investments and after
+// distributions
+func (s *BondingServiceServer) SetHooks(runner *hooks.Runner) {
+	s.hooks = runner
+}
+
+// runHooks calls the hooks at a pre point. A rejection is FailedPrecondition,
+// like a rule violation; a hook that failed is Unavailable.
+func (s *BondingServiceServer) runHooks(ctx context.Context, point hooks.Point, facts map[string]interface{}) error {
+	if s.hooks == nil {
+		return nil
+	}
+	err := s.hooks.Run(ctx, hooks.Event{Point: point, TenantID: tenant.FromContext(ctx), Facts: facts})
+	var rejection *hooks.Rejection
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &rejection):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Unavailable, err.Error())
+	}
+}
+
+// distributed tells the post-distribution hooks about a recorded
+// distribution without holding up the response
+func (s *BondingServiceServer) distributed(ctx context.Context, bond *models.Bond, revenue *big.Int, licensee string, record *models.RevenueDistribution) {
+	if s.hooks == nil {
+		return
+	}
+	facts := distributionFacts(bond, revenue, licensee)
+	details := facts["distribution"].(map[string]interface{})
+	details["tx_hash"] = record.TxHash
+	details["distributed"] = amountFact(record.Amount)
+	details["shortfall"] = amountFact(record.Shortfall)
+
+	ev := hooks.Event{Point: hooks.PostDistribution, TenantID: tenant.FromContext(ctx), Facts: facts}
+	go s.hooks.Run(context.WithoutCancel(ctx), ev)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/knowton/bonding-service/internal/models"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SetTrancheLimits sets the minimum and maximum ticket size of a tranche.
+// Only the bond issuer's signed-in wallet can change limits; "0" or empty
+// removes a limit.
+func (s *BondingServiceServer) SetTrancheLimits(
+	ctx context.Context,
+	req *pb.SetTrancheLimitsRequest,
+) (*pb.TrancheInfo, error) {
+	if err := s.resolveAddresses(ctx, &req.IssuerAddress); err != nil {
+		return nil, err
+	}
+
+	minInvestment, err := parseLimit(req.MinInvestment)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_investment: %v", err)
+	}
+	maxInvestment, err := parseLimit(req.MaxInvestment)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max_investment: %v", err)
+	}
+	if maxInvestment.Sign() > 0 && minInvestment.Cmp(maxInvestment) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "min_investment exceeds max_investment")
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	if _, err := checkBondIssuer(ctx, &bond, req.IssuerAddress); err != nil {
+		return nil, err
+	}
+
+	var tranche models.Tranche
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", req.BondId, req.TrancheId).First(&tranche).Error; err != nil {
+		return nil, fmt.Errorf("tranche not found: %w", err)
+	}
+
+	tranche.MinInvestment = minInvestment.String()
+	tranche.MaxInvestment = maxInvestment.String()
+	if err := s.db.WithContext(ctx).Model(&tranche).Updates(map[string]interface{}{
+		"min_investment": tranche.MinInvestment,
+		"max_investment": tranche.MaxInvestment,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update tranche: %w", err)
+	}
+
+	return &pb.TrancheInfo{
+		TrancheId:     uint32(tranche.TrancheID),
+		Name:          tranche.Name,
+		Priority:      int32(tranche.Priority),
+		Allocation:    tranche.Allocation,
+		Apy:           formatAPY(tranche.APY),
+		RiskLevel:     tranche.RiskLevel,
+		TotalInvested: tranche.TotalInvested,
+		Arrears:       tranche.Arrears,
+		MinInvestment: tranche.MinInvestment,
+		MaxInvestment: tranche.MaxInvestment,
+		DayCount:      string(trancheDayCount(&bond, &tranche)),
+	}, nil
+}
+
+// checkInvestmentLimits validates an investment against the tranche's ticket
+// limits and remaining allocation. A full tranche is FAILED_PRECONDITION.
+func checkInvestmentLimits(tranche *models.Tranche, amount *big.Int) error {
+	if err := checkTrancheCapacity(tranche, amount); err != nil {
+		return err
+	}
+
+	minInvestment := parseBigInt(tranche.MinInvestment)
+	if minInvestment.Sign() > 0 && amount.Cmp(minInvestment) < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"investment %s is below the tranche minimum of %s", amount, minInvestment)
+	}
+
+	maxInvestment := parseBigInt(tranche.MaxInvestment)
+	if maxInvestment.Sign() > 0 && amount.Cmp(maxInvestment) > 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"investment %s exceeds the tranche maximum of %s", amount, maxInvestment)
+	}
+
+	return nil
+}
+
+// checkTrancheCapacity rejects investments that would take a tranche past its
+// allocation, counting capacity reserved for investments still being sent
+func checkTrancheCapacity(tranche *models.Tranche, amount *big.Int) error {
+	allocation := parseBigInt(tranche.Allocation)
+	remaining := new(big.Int).Sub(allocation, parseBigInt(tranche.TotalInvested))
+	remaining.Sub(remaining, parseBigInt(tranche.Reserved))
+	if remaining.Sign() <= 0 {
+		return status.Errorf(codes.FailedPrecondition, "tranche %d is full", tranche.TrancheID)
+	}
+	if amount.Cmp(remaining) > 0 {
+		return status.Errorf(codes.FailedPrecondition,
+			"investment %s exceeds remaining tranche capacity of %s", amount, remaining)
+	}
+	return nil
+}
+
+// reserveCapacity holds amount of a tranche's allocation for an investment
+// about to be sent on-chain. The tranche row is locked so concurrent
+// investments cannot both claim the last of the allocation.
+func reserveCapacity(db *gorm.DB, bondID string, trancheID int, amount *big.Int) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		tranche, err := lockTranche(tx, bondID, trancheID)
+		if err != nil {
+			return err
+		}
+		if err := checkTrancheCapacity(tranche, amount); err != nil {
+			return err
+		}
+		reserved := new(big.Int).Add(parseBigInt(tranche.Reserved), amount)
+		if err := tx.Model(tranche).Update("reserved", reserved.String()).Error; err != nil {
+			return fmt.Errorf("failed to reserve tranche capacity: %w", err)
+		}
+		return nil
+	})
+}
+
+// releaseCapacity returns a reservation whose investment was never sent
+func releaseCapacity(db *gorm.DB, bondID string, trancheID int, amount *big.Int) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		tranche, err := lockTranche(tx, bondID, trancheID)
+		if err != nil {
+			return err
+		}
+		return tx.Model(tranche).Update("reserved", subFloorZero(parseBigInt(tranche.Reserved), amount).String()).Error
+	})
+	if err != nil {
+		log.Printf("Failed to release %s of tranche %d capacity in bond %s: %v", amount, trancheID, bondID, err)
+	}
+}
+
+// lockTranche loads a tranche row FOR UPDATE
+func lockTranche(tx *gorm.DB, bondID string, trancheID int) (*models.Tranche, error) {
+	var tranche models.Tranche
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bond_id = ? AND tranche_id = ?", bondID, trancheID).
+		First(&tranche).Error; err != nil {
+		return nil, fmt.Errorf("tranche not found: %w", err)
+	}
+	return &tranche, nil
+}
+
+// subFloorZero returns a - b, or zero when b exceeds a
+func subFloorZero(a, b *big.Int) *big.Int {
+	d := new(big.Int).Sub(a, b)
+	if d.Sign() < 0 {
+		d.SetInt64(0)
+	}
+	return d
+}
+
+func parseLimit(value string) (*big.Int, error) {
+	if value == "" {
+		return big.NewInt(0), nil
+	}
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("must be a non-negative integer")
+	}
+	return n, nil
+}
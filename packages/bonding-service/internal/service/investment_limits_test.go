@@ -0,0 +1,45 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckInvestmentLimits(t *testing.T) {
+	tranche := &models.Tranche{
+		TrancheID:     0,
+		Allocation:    "1000",
+		TotalInvested: "600",
+		MinInvestment: "50",
+		MaxInvestment: "300",
+	}
+
+	tests := []struct {
+		name     string
+		tranche  *models.Tranche
+		amount   int64
+		wantCode codes.Code
+	}{
+		{"within limits", tranche, 100, codes.OK},
+		{"below minimum", tranche, 10, codes.InvalidArgument},
+		{"above maximum", &models.Tranche{Allocation: "10000", TotalInvested: "0", MaxInvestment: "300"}, 400, codes.InvalidArgument},
+		{"exceeds remaining capacity", tranche, 450, codes.FailedPrecondition},
+		{"tranche full", &models.Tranche{Allocation: "1000", TotalInvested: "1000"}, 1, codes.FailedPrecondition},
+		{"capacity reserved by pending investments", &models.Tranche{Allocation: "1000", TotalInvested: "600", Reserved: "300"}, 200, codes.FailedPrecondition},
+		{"fits beside reservations", &models.Tranche{Allocation: "1000", TotalInvested: "600", Reserved: "300"}, 100, codes.OK},
+		{"no limits set", &models.Tranche{Allocation: "1000", TotalInvested: "0"}, 1000, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkInvestmentLimits(tt.tranche, big.NewInt(tt.amount))
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("checkInvestmentLimits() code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
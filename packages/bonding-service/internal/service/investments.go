@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/accrual"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListInvestments pages through investments, newest first, filtered by the
+// bond, tranche, investor address and time range given.
+// Pages follow next_page_token rather than an offset, so deep pages cost the
+// same as the first and rows added meanwhile don't shift them.
+func (s *BondingServiceServer) ListInvestments(
+	ctx context.Context,
+	req *pb.ListInvestmentsRequest,
+) (*pb.ListInvestmentsResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+	if req.InvestorAddress != "" && !common.IsHexAddress(req.InvestorAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid investor address %q", req.InvestorAddress)
+	}
+	if req.Since > 0 && req.Until > 0 && req.Until <= req.Since {
+		return nil, status.Error(codes.InvalidArgument, "until must be after since")
+	}
+
+	filter := repository.InvestmentFilter{
+		TenantID:        tenant.FromContext(ctx),
+		BondID:          req.BondId,
+		TrancheID:       -1,
+		Investor:        req.InvestorAddress,
+		IncludeArchived: req.IncludeArchived,
+		Limit:           int(req.PageSize),
+	}
+	if req.TrancheId != nil {
+		filter.TrancheID = int(req.GetTrancheId())
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		after, err := repository.ParseCursor(req.PageToken)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	var investments []models.Investment
+	var next *repository.Cursor
+	var accrued map[uint]*big.Int
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		if req.BondId != "" {
+			bond, err := s.bonds.GetBond(ctx, req.BondId)
+			if err != nil || bond.TenantID != filter.TenantID {
+				return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+			}
+			if err := checkArchived(bond, req.IncludeArchived); err != nil {
+				return err
+			}
+		}
+		var err error
+		investments, next, err = s.bonds.ListInvestments(ctx, filter)
+		if err != nil {
+			return err
+		}
+		accrued, err = s.accruedInterest(ctx, investments)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListInvestmentsResponse{}
+	for i := range investments {
+		response.Investments = append(response.Investments, investmentInfo(&investments[i], accrued[investments[i].ID]))
+	}
+	if next != nil {
+		response.NextPageToken = next.String()
+	}
+	return response, nil
+}
+
+// accruedInterest returns the interest each investment has accrued to date,
+// keyed by investment ID
+func (s *BondingServiceServer) accruedInterest(ctx context.Context, investments []models.Investment) (map[uint]*big.Int, error) {
+	byBond := make(map[string][]models.Investment)
+	for _, inv := range investments {
+		byBond[inv.BondID] = append(byBond[inv.BondID], inv)
+	}
+
+	accrued := make(map[uint]*big.Int, len(investments))
+	now := time.Now()
+	for bondID, bondInvestments := range byBond {
+		bond, err := s.bonds.GetBond(ctx, bondID)
+		if err != nil {
+			return nil, err
+		}
+		amounts, err := accrual.ToDate(ctx, s.conn(ctx), bond, bondInvestments, now)
+		if err != nil {
+			return nil, err
+		}
+		for id, amount := range amounts {
+			accrued[id] = amount
+		}
+	}
+	return accrued, nil
+}
+
+func investmentInfo(inv *models.Investment, accrued *big.Int) *pb.InvestmentInfo {
+	if accrued == nil {
+		accrued = new(big.Int)
+	}
+	return &pb.InvestmentInfo{
+		Id:              uint64(inv.ID),
+		BondId:          inv.BondID,
+		TrancheId:       int32(inv.TrancheID),
+		InvestorAddress: inv.Investor,
+		Amount:          inv.Amount,
+		TxHash:          inv.TxHash,
+		Timestamp:       inv.Timestamp.Unix(),
+		AccruedInterest: accrued.String(),
+	}
+}
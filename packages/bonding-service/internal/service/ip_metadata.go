@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetMetadataResolver reads IP-NFT metadata from each token's URI. Without
+// one, issuance assesses the IP on placeholder engagement figures and
+// AssessIPRisk requires the metadata in the request.
+func (s *BondingServiceServer) SetMetadataResolver(resolver *ipmeta.Resolver) {
+	s.metadataResolver = resolver
+}
+
+// ipMetadata resolves an IP-NFT's metadata document on chain. The NFT
+// contract defaults to the chain's copyright registry. It returns nil when
+// no resolver is configured.
+func (s *BondingServiceServer) ipMetadata(ctx context.Context, chain *chains.Chain, nftContract, ipnftID string) (*ipmeta.Document, error) {
+	if s.metadataResolver == nil {
+		return nil, nil
+	}
+	tokenID, ok := new(big.Int).SetString(ipnftID, 10)
+	if !ok || tokenID.Sign() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ipnft_id %q is not a token ID", ipnftID)
+	}
+	if nftContract == "" {
+		nftContract = chain.Contracts.CopyrightRegistry
+	}
+	if !common.IsHexAddress(nftContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "no IP-NFT contract to resolve metadata from on %s", chain.Name)
+	}
+	client := s.chainClient(chain)
+	if client == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no client for chain %s", chain.Name)
+	}
+
+	doc, err := s.metadataResolver.Resolve(ctx, client, common.HexToAddress(nftContract), tokenID)
+	switch {
+	case errors.Is(err, ipmeta.ErrNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ipmeta.ErrInvalidDocument):
+		return nil, status.Errorf(codes.InvalidArgument, "IP-NFT %s: %v", ipnftID, err)
+	case err != nil:
+		return nil, status.Errorf(codes.Unavailable, "failed to resolve metadata of IP-NFT %s: %v", ipnftID, err)
+	}
+	return doc, nil
+}
+
+// applyDocument overrides metadata with the fields a document names
+func applyDocument(metadata *risk.IPMetadata, doc *ipmeta.Document) {
+	metadata.Views = doc.Views
+	metadata.Likes = doc.Likes
+	metadata.Tags = doc.Tags
+	if doc.Creator != "" {
+		metadata.CreatorAddress = doc.Creator
+	}
+	if !doc.CreatedAt.IsZero() {
+		metadata.CreatedAt = doc.CreatedAt
+	}
+	if doc.ContentHash != "" {
+		metadata.ContentHash = doc.ContentHash
+	}
+}
+
+// assessmentMetadata returns the metadata an AssessIPRisk request carries,
+// or resolves it from the IP-NFT on the default chain when it carries none
+func (s *BondingServiceServer) assessmentMetadata(ctx context.Context, req *pb.AssessIPRiskRequest) (*risk.IPMetadata, error) {
+	if req.Metadata != nil {
+		return &risk.IPMetadata{
+			Category:       req.Metadata.Category,
+			CreatorAddress: req.Metadata.CreatorAddress,
+			CreatedAt:      time.Unix(req.Metadata.CreatedAt, 0),
+			Views:          req.Metadata.Views,
+			Likes:          req.Metadata.Likes,
+			Tags:           req.Metadata.Tags,
+			ContentHash:    req.Metadata.ContentHash,
+		}, nil
+	}
+	if s.metadataResolver == nil {
+		return nil, status.Error(codes.InvalidArgument, "metadata is required")
+	}
+	chain, err := s.chainConfig("")
+	if err != nil {
+		return nil, err
+	}
+	doc, err := s.ipMetadata(ctx, chain, "", req.IpnftId)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &risk.IPMetadata{Category: doc.Category}
+	applyDocument(metadata, doc)
+	return metadata, nil
+}
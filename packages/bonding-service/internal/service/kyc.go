@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/knowton/bonding-service/internal/kyc"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetKYC requires investors to be verified in store before they invest or
+// receive a transferred position
+func (s *BondingServiceServer) SetKYC(store *kyc.Store) {
+	s.kyc = store
+}
+
+// checkInvestorVerified refuses a wallet that isn't KYC verified with the
+// calling tenant
+func (s *BondingServiceServer) checkInvestorVerified(ctx context.Context, address string) error {
+	if s.kyc == nil {
+		return nil
+	}
+	err := s.kyc.Check(ctx, tenant.FromContext(ctx), address)
+	if errors.Is(err, kyc.ErrNotVerified) {
+		metrics.KYCRefusals.Inc()
+		log.Printf("Refused unverified investor: %v", err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check investor verification: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/kyc"
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckInvestorVerified(t *testing.T) {
+	const investor = "0x1111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name     string
+		status   string // Empty for no profile
+		wantCode codes.Code
+	}{
+		{"verified", models.KYCVerified, codes.OK},
+		{"pending", models.KYCPending, codes.PermissionDenied},
+		{"rejected", models.KYCRejected, codes.PermissionDenied},
+		{"never verified", "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			rows := sqlmock.NewRows([]string{"id", "tenant_id", "address", "status"})
+			if tt.status != "" {
+				rows.AddRow(1, "default", investor, tt.status)
+			}
+			mock.ExpectQuery(`SELECT \* FROM "investor_profiles"`).
+				WithArgs("default", investor, 1).
+				WillReturnRows(rows)
+
+			s := &BondingServiceServer{}
+			s.SetKYC(kyc.NewStore(db))
+			if got := status.Code(s.checkInvestorVerified(context.Background(), investor)); got != tt.wantCode {
+				t.Errorf("checkInvestorVerified() code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
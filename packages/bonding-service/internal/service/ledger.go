@@ -0,0 +1,189 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/database"
+	"github.com/knowton/bonding-service/internal/ledger"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// defaultLedgerDecimals is used to render base-unit amounts when a request does not specify decimals
+const defaultLedgerDecimals = 18
+
+// ExportLedger exports the caller's journal for a period in CSV, OFX or QuickBooks IIF format
+func (s *BondingServiceServer) ExportLedger(
+	ctx context.Context,
+	req *pb.ExportLedgerRequest,
+) (*pb.ExportLedgerResponse, error) {
+	contentType, ext, err := ledger.ContentType(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.PeriodEnd <= req.PeriodStart {
+		return nil, status.Error(codes.InvalidArgument, "period_end must be after period_start")
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	start := time.Unix(req.PeriodStart, 0)
+	end := time.Unix(req.PeriodEnd, 0)
+
+	query := database.Reader(ctx, s.db).WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Where("tenant_id = ? AND posted_at >= ? AND posted_at < ?", tenantID, start, end)
+	if req.BondId != "" {
+		query = query.Where("bond_id = ?", req.BondId)
+	}
+
+	var entries []models.LedgerEntry
+	if err := query.Order("posted_at ASC, id ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to load ledger: %w", err)
+	}
+
+	decimals := int(req.Decimals)
+	if decimals == 0 {
+		decimals = defaultLedgerDecimals
+	}
+
+	var buf bytes.Buffer
+	err = ledger.Write(&buf, req.Format, entries, ledger.ExportOptions{
+		Decimals:    decimals,
+		Currency:    req.Currency,
+		AccountID:   tenantID + ":" + ledger.AccountEscrow,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export ledger: %w", err)
+	}
+
+	response := &pb.ExportLedgerResponse{
+		Content:     buf.Bytes(),
+		ContentType: contentType,
+		Filename: fmt.Sprintf("ledger-%s-%s-%s.%s", tenantID,
+			start.UTC().Format("20060102"), end.UTC().Format("20060102"), ext),
+		EntryCount: int32(len(entries)),
+	}
+
+	// Optionally keep the export in document storage and return a download link instead
+	if req.Store {
+		doc, url, err := s.storeDocument(ctx, models.DocumentReport, response.Filename, contentType, response.Content)
+		if err != nil {
+			return nil, err
+		}
+		response.Content = nil
+		response.DocumentId = uint64(doc.ID)
+		response.DownloadUrl = url
+	}
+
+	return response, nil
+}
+
+// postLedgerEntry records a balanced journal entry under the bond's tenant
+func postLedgerEntry(
+	tx *gorm.DB,
+	bondID string,
+	kind string,
+	reference string,
+	memo string,
+	postedAt time.Time,
+	lines []ledger.Line,
+) error {
+	if err := ledger.Validate(lines); err != nil {
+		return fmt.Errorf("invalid %s journal entry: %w", kind, err)
+	}
+
+	var bond models.Bond
+	if err := tx.Select("tenant_id").Where("bond_id = ?", bondID).First(&bond).Error; err != nil {
+		return fmt.Errorf("bond not found: %w", err)
+	}
+
+	entry := &models.LedgerEntry{
+		TenantID:  bond.TenantID,
+		BondID:    bondID,
+		Kind:      kind,
+		Reference: reference,
+		Memo:      memo,
+		PostedAt:  postedAt,
+		Lines:     make([]models.LedgerLine, len(lines)),
+	}
+	for i, l := range lines {
+		entry.Lines[i] = models.LedgerLine{
+			Account: l.Account,
+			Debit:   l.Debit.String(),
+			Credit:  l.Credit.String(),
+		}
+	}
+
+	if err := tx.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to save ledger entry: %w", err)
+	}
+	return nil
+}
+
+// postInvestment records escrow received against tranche principal owed
+func postInvestment(tx *gorm.DB, inv *models.Investment, amount *big.Int) error {
+	return postLedgerEntry(tx, inv.BondID, models.LedgerInvestment, inv.TxHash,
+		fmt.Sprintf("Investment by %s in tranche %d", inv.Investor, inv.TrancheID),
+		inv.Timestamp,
+		[]ledger.Line{
+			ledger.Debit(ledger.AccountEscrow, amount),
+			ledger.Credit(ledger.SubAccount(ledger.AccountBondPrincipal, inv.BondID, inv.TrancheID), amount),
+		})
+}
+
+// postDistribution records revenue received and the coupons paid out of it
+func postDistribution(tx *gorm.DB, bondID string, txHash string, postedAt time.Time, result *waterfall.Result) error {
+	if result.Distributed.Sign() == 0 {
+		return nil
+	}
+
+	err := postLedgerEntry(tx, bondID, models.LedgerRevenue, txHash, "IP revenue received", postedAt,
+		[]ledger.Line{
+			ledger.Debit(ledger.AccountEscrow, result.Distributed),
+			ledger.Credit(ledger.SubAccount(ledger.AccountIPRevenue, bondID), result.Distributed),
+		})
+	if err != nil {
+		return err
+	}
+
+	lines := make([]ledger.Line, 0, len(result.Tranches)+1)
+	for _, r := range result.Tranches {
+		if paid := r.Paid(); paid.Sign() > 0 {
+			lines = append(lines, ledger.Debit(ledger.SubAccount(ledger.AccountCouponPayments, bondID, r.TrancheID), paid))
+		}
+	}
+	lines = append(lines, ledger.Credit(ledger.AccountEscrow, result.Distributed))
+
+	return postLedgerEntry(tx, bondID, models.LedgerCoupon, txHash, "Coupons paid to tranche holders", postedAt, lines)
+}
+
+// postRedemption releases principal owed, paying the investor and keeping the penalty
+func postRedemption(tx *gorm.DB, r *models.Redemption) error {
+	amount := parseBigInt(r.Amount)
+	lines := []ledger.Line{
+		ledger.Debit(ledger.SubAccount(ledger.AccountBondPrincipal, r.BondID, r.TrancheID), amount),
+		ledger.Credit(ledger.AccountEscrow, parseBigInt(r.Payout)),
+	}
+	if penalty := parseBigInt(r.Penalty); penalty.Sign() > 0 {
+		lines = append(lines, ledger.Credit(ledger.SubAccount(ledger.AccountRedemptionPenalty, r.BondID), penalty))
+	}
+
+	postedAt := time.Now()
+	if r.ResolvedAt != nil {
+		postedAt = *r.ResolvedAt
+	}
+	return postLedgerEntry(tx, r.BondID, models.LedgerRedemption, r.TxHash,
+		fmt.Sprintf("Early redemption by %s from tranche %d", r.Investor, r.TrancheID),
+		postedAt, lines)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/knowton/bonding-service/internal/license"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// licenseAgreement validates a request's license agreement and verifies the
+// issuer holds its revenue rights. Requests without one return nil.
+func licenseAgreement(req *pb.LicenseAgreement, issuer string) (*license.Agreement, error) {
+	if req == nil {
+		return nil, nil
+	}
+	if !common.IsHexAddress(req.Licensor) {
+		return nil, status.Error(codes.InvalidArgument, "license licensor must be a valid address")
+	}
+	if !common.IsHexAddress(issuer) {
+		return nil, status.Error(codes.InvalidArgument, "issuer_address must be a valid address to verify revenue rights")
+	}
+
+	agreement := &license.Agreement{
+		Licensor:     common.HexToAddress(req.Licensor),
+		Licensee:     req.Licensee,
+		RoyaltyBps:   req.RoyaltyBps,
+		Territories:  req.Territories,
+		Exclusive:    req.Exclusive,
+		DocumentHash: req.DocumentHash,
+	}
+	if req.StartsAt > 0 {
+		agreement.StartsAt = time.Unix(req.StartsAt, 0)
+	}
+	if req.EndsAt > 0 {
+		agreement.EndsAt = time.Unix(req.EndsAt, 0)
+	}
+	agreement.Normalize()
+	if err := agreement.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid license agreement: %v", err)
+	}
+
+	var signature []byte
+	if req.RightsAssignmentSignature != "" {
+		var err error
+		if signature, err = hexutil.Decode(req.RightsAssignmentSignature); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid rights_assignment_signature encoding: %v", err)
+		}
+	}
+	err := license.VerifyRevenueRights(agreement, common.HexToAddress(issuer), signature)
+	if errors.Is(err, license.ErrRightsNotHeld) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"%v: the licensor must be the issuer or sign an assignment of agreement %s", err, agreement.Hash().Hex())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rights assignment: %v", err)
+	}
+	return agreement, nil
+}
+
+// licenseTerms returns the terms the risk engine assesses
+func licenseTerms(a *license.Agreement) *risk.LicenseTerms {
+	if a == nil {
+		return nil
+	}
+	return &risk.LicenseTerms{
+		EndsAt:    a.EndsAt,
+		Exclusive: a.Exclusive,
+		Worldwide: a.IsWorldwide(),
+	}
+}
+
+// licenseRecord returns the stored form of a bond's agreement
+func licenseRecord(bondID string, a *license.Agreement, issuer string) *models.LicenseAgreement {
+	record := &models.LicenseAgreement{
+		BondID:         bondID,
+		Licensor:       a.Licensor.Hex(),
+		Licensee:       a.Licensee,
+		RoyaltyBps:     a.RoyaltyBps,
+		StartsAt:       a.StartsAt,
+		Territories:    strings.Join(a.Territories, ","),
+		Exclusive:      a.Exclusive,
+		DocumentHash:   a.DocumentHash,
+		TermsHash:      a.Hash().Hex(),
+		RightsAssigned: a.Licensor != common.HexToAddress(issuer),
+	}
+	if !a.EndsAt.IsZero() {
+		endsAt := a.EndsAt
+		record.EndsAt = &endsAt
+	}
+	return record
+}
+
+// bondLicense loads a bond's license agreement, or nil when it has none
+func (s *BondingServiceServer) bondLicense(ctx context.Context, bondID string) (*models.LicenseAgreement, error) {
+	var agreements []models.LicenseAgreement
+	if err := s.conn(ctx).Where("bond_id = ?", bondID).Limit(1).Find(&agreements).Error; err != nil {
+		return nil, err
+	}
+	if len(agreements) == 0 {
+		return nil, nil
+	}
+	return &agreements[0], nil
+}
+
+// licenseInfo returns the API view of a stored agreement
+func licenseInfo(record *models.LicenseAgreement) *pb.LicenseAgreement {
+	if record == nil {
+		return nil
+	}
+	info := &pb.LicenseAgreement{
+		Licensor:       record.Licensor,
+		Licensee:       record.Licensee,
+		RoyaltyBps:     record.RoyaltyBps,
+		StartsAt:       record.StartsAt.Unix(),
+		Territories:    strings.Split(record.Territories, ","),
+		Exclusive:      record.Exclusive,
+		DocumentHash:   record.DocumentHash,
+		TermsHash:      record.TermsHash,
+		RightsAssigned: record.RightsAssigned,
+	}
+	if record.EndsAt != nil {
+		info.EndsAt = record.EndsAt.Unix()
+	}
+	return info
+}
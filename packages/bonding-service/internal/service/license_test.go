@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLicenseAgreement(t *testing.T) {
+	licensor := "0x1000000000000000000000000000000000000001"
+	issuer := "0x2000000000000000000000000000000000000002"
+	valid := func() *pb.LicenseAgreement {
+		return &pb.LicenseAgreement{
+			Licensor:    licensor,
+			Licensee:    "Streaming Co",
+			RoyaltyBps:  1000,
+			StartsAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			Territories: []string{"WORLDWIDE"},
+		}
+	}
+	noStart := valid()
+	noStart.StartsAt = 0
+	badSignature := valid()
+	badSignature.RightsAssignmentSignature = "0x1234"
+
+	tests := []struct {
+		name     string
+		req      *pb.LicenseAgreement
+		issuer   string
+		wantCode codes.Code
+	}{
+		{"none", nil, issuer, codes.OK},
+		{"issuer is licensor", valid(), licensor, codes.OK},
+		{"rights not held", valid(), issuer, codes.FailedPrecondition},
+		{"malformed signature", badSignature, issuer, codes.InvalidArgument},
+		{"missing start", noStart, licensor, codes.InvalidArgument},
+		{"issuer not an address", valid(), "alice.eth", codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := licenseAgreement(tt.req, tt.issuer)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("licenseAgreement() error = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && (got == nil) != (tt.req == nil) {
+				t.Errorf("licenseAgreement() = %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/maintenance"
+	"github.com/knowton/bonding-service/internal/models"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// writeMethods are the RPCs frozen during maintenance: everything that
+// changes stored state or sends a transaction. The maintenance RPCs stay
+// available so a window can be cut short.
+var writeMethods = map[string]bool{
+	"IssueBond":                  true,
+	"Invest":                     true,
+	"DistributeRevenue":          true,
+	"RequestEarlyRedemption":     true,
+	"ApproveRedemption":          true,
+	"QueueDistributions":         true,
+	"SetDistributionSchedule":    true,
+	"PauseDistributionSchedule":  true,
+	"ResumeDistributionSchedule": true,
+	"SkipDistributionRuns":       true,
+	"DeleteDistributionSchedule": true,
+	"ImportRevenueCSV":           true,
+	"ReviewRevenueEvents":        true,
+	"TransferInvestment":         true,
+	"InvestWithPermit":           true,
+	"PlaceOrder":                 true,
+	"FillOrder":                  true,
+	"CancelOrder":                true,
+	"UpsertAddressBookEntry":     true,
+	"DeleteAddressBookEntry":     true,
+	"SetTrancheLimits":           true,
+	"UpsertCategory":             true,
+	"DeleteCategory":             true,
+	"SpeedUpTransaction":         true,
+	"CancelTransaction":          true,
+	"GenerateProspectus":         true,
+	"AddAccessListEntry":         true,
+	"RemoveAccessListEntry":      true,
+	"PauseBond":                  true,
+	"FreezeBond":                 true,
+	"CancelBond":                 true,
+	"ResumeBond":                 true,
+	"RequestEmergencyWithdrawal": true,
+	"ConfirmEmergencyWithdrawal": true,
+	"CancelEmergencyWithdrawal":  true,
+}
+
+// IsWriteMethod reports whether a full gRPC method name is a write RPC of
+// the bonding service
+func IsWriteMethod(fullMethod string) bool {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return ok && service == "bonding.BondingService" && writeMethods[method]
+}
+
+// SetMaintenance makes maintenance windows manageable through the admin RPCs
+func (s *BondingServiceServer) SetMaintenance(store *maintenance.Store) {
+	s.maintenance = store
+}
+
+// ScheduleMaintenance schedules a write freeze
+func (s *BondingServiceServer) ScheduleMaintenance(
+	ctx context.Context,
+	req *pb.ScheduleMaintenanceRequest,
+) (*pb.MaintenanceWindow, error) {
+	if s.maintenance == nil {
+		return nil, status.Error(codes.Unimplemented, "maintenance windows are not configured")
+	}
+
+	window, err := s.maintenance.Schedule(ctx, time.Unix(req.StartsAt, 0), time.Unix(req.EndsAt, 0), req.Reason)
+	if errors.Is(err, maintenance.ErrInvalidWindow) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceWindowInfo(window), nil
+}
+
+// CancelMaintenance cancels a scheduled or active window; writes resume at once
+func (s *BondingServiceServer) CancelMaintenance(
+	ctx context.Context,
+	req *pb.CancelMaintenanceRequest,
+) (*pb.CancelMaintenanceResponse, error) {
+	if s.maintenance == nil {
+		return nil, status.Error(codes.Unimplemented, "maintenance windows are not configured")
+	}
+
+	err := s.maintenance.Cancel(ctx, uint(req.Id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "maintenance window %d not found", req.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelMaintenanceResponse{}, nil
+}
+
+// GetMaintenance returns the active window, if any, and the upcoming ones
+func (s *BondingServiceServer) GetMaintenance(
+	ctx context.Context,
+	req *pb.GetMaintenanceRequest,
+) (*pb.GetMaintenanceResponse, error) {
+	resp := &pb.GetMaintenanceResponse{}
+	if s.maintenance == nil {
+		return resp, nil
+	}
+
+	now := time.Now()
+	if active := s.maintenance.Active(now); active != nil {
+		resp.Active = maintenanceWindowInfo(active)
+	}
+	for _, w := range s.maintenance.Windows() {
+		if w.StartsAt.After(now) {
+			resp.Upcoming = append(resp.Upcoming, maintenanceWindowInfo(&w))
+		}
+	}
+	return resp, nil
+}
+
+func maintenanceWindowInfo(w *models.MaintenanceWindow) *pb.MaintenanceWindow {
+	info := &pb.MaintenanceWindow{
+		Id:       uint64(w.ID),
+		StartsAt: w.StartsAt.Unix(),
+		EndsAt:   w.EndsAt.Unix(),
+		Reason:   w.Reason,
+	}
+	if w.AnnouncedAt != nil {
+		info.AnnouncedAt = w.AnnouncedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/market"
+	pb "github.com/knowton/bonding-service/proto"
+)
+
+// SetMarketAnalyzer has risk assessments report their category's market
+func (s *BondingServiceServer) SetMarketAnalyzer(analyzer *market.Analyzer) {
+	s.market = analyzer
+}
+
+// marketAnalysis returns the API view of a category's market, or nil when
+// market analysis is not configured
+func (s *BondingServiceServer) marketAnalysis(ctx context.Context, category string) (*pb.MarketAnalysis, error) {
+	if s.market == nil {
+		return nil, nil
+	}
+	analysis, err := s.market.Analyze(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarketAnalysis{
+		AvgPrice:       analysis.AvgPrice,
+		MedianPrice:    analysis.MedianPrice,
+		PriceTrend:     analysis.PriceTrend,
+		TotalSales:     int32(analysis.TotalSales),
+		LiquidityScore: analysis.LiquidityScore,
+	}, nil
+}
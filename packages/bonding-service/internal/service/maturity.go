@@ -0,0 +1,50 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// categoryParams resolves a category against the managed taxonomy, or the
+// built-in categories when none is configured
+func (s *BondingServiceServer) categoryParams(category string) taxonomy.Params {
+	if s.taxonomy != nil {
+		return s.taxonomy.Resolve(category)
+	}
+	return taxonomy.Default().Resolve(category)
+}
+
+// checkMaturity applies a category's expiry policy to a bond maturing after
+// its registration expires or its license ends. Under WARN the problems are
+// returned as warnings instead of an error.
+func checkMaturity(policy string, maturity time.Time, reg *risk.RegisteredIP, licenseExpiresAt time.Time) ([]string, error) {
+	if policy == models.ExpiryIgnore {
+		return nil, nil
+	}
+
+	var problems []string
+	if reg != nil && !reg.ExpiresAt.IsZero() && maturity.After(reg.ExpiresAt) {
+		problems = append(problems, fmt.Sprintf("maturity_date %s is after the registration expires on %s",
+			formatDate(maturity), formatDate(reg.ExpiresAt)))
+	}
+	if !licenseExpiresAt.IsZero() && maturity.After(licenseExpiresAt) {
+		problems = append(problems, fmt.Sprintf("maturity_date %s is after the license ends on %s",
+			formatDate(maturity), formatDate(licenseExpiresAt)))
+	}
+
+	if len(problems) == 0 || policy == models.ExpiryWarn {
+		return problems, nil
+	}
+	return nil, status.Error(codes.InvalidArgument, strings.Join(problems, "; "))
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckMaturity(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := expiry.AddDate(0, 0, 1)
+	tests := []struct {
+		name         string
+		policy       string
+		reg          *risk.RegisteredIP
+		license      time.Time
+		maturity     time.Time
+		wantCode     codes.Code
+		wantWarnings int
+	}{
+		{"unregistered", models.ExpiryReject, nil, time.Time{}, expiry.AddDate(10, 0, 0), codes.OK, 0},
+		{"no fixed term", models.ExpiryReject, &risk.RegisteredIP{}, time.Time{}, expiry.AddDate(10, 0, 0), codes.OK, 0},
+		{"matures at expiry", models.ExpiryReject, &risk.RegisteredIP{ExpiresAt: expiry}, expiry, expiry, codes.OK, 0},
+		{"after registration", models.ExpiryReject, &risk.RegisteredIP{ExpiresAt: expiry}, time.Time{}, late, codes.InvalidArgument, 0},
+		{"after license", models.ExpiryReject, nil, expiry, late, codes.InvalidArgument, 0},
+		{"warn", models.ExpiryWarn, &risk.RegisteredIP{ExpiresAt: expiry}, expiry, late, codes.OK, 2},
+		{"warn within term", models.ExpiryWarn, nil, expiry, expiry, codes.OK, 0},
+		{"ignore", models.ExpiryIgnore, &risk.RegisteredIP{ExpiresAt: expiry}, expiry, late, codes.OK, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := checkMaturity(tt.policy, tt.maturity, tt.reg, tt.license)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("checkMaturity() error = %v, want %v", err, tt.wantCode)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("checkMaturity() warnings = %q, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
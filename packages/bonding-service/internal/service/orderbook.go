@@ -0,0 +1,501 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/permit"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlaceOrder lists part of a tranche position for sale. Only the seller's
+// signed-in wallet can list its position.
+func (s *BondingServiceServer) PlaceOrder(
+	ctx context.Context,
+	req *pb.PlaceOrderRequest,
+) (*pb.OrderInfo, error) {
+	if err := s.resolveAddresses(ctx, &req.SellerAddress); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.SellerAddress) {
+		return nil, fmt.Errorf("seller_address must be a valid address")
+	}
+	if !common.IsHexAddress(req.TokenAddress) {
+		return nil, fmt.Errorf("token_address must be a valid address")
+	}
+	if req.PriceBps <= 0 {
+		return nil, fmt.Errorf("price_bps must be positive")
+	}
+	if err := checkPositionOwner(ctx, req.SellerAddress); err != nil {
+		return nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid order amount")
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	if bond.Status != models.BondActive && bond.Status != models.BondPaused {
+		return nil, fmt.Errorf("bond is not active (status: %s)", bond.Status)
+	}
+
+	// The seller can only list what isn't already listed in other open orders
+	position, err := s.investorPosition(s.db.WithContext(ctx), req.BondId, int(req.TrancheId), req.SellerAddress)
+	if err != nil {
+		return nil, err
+	}
+	listed, err := s.listedAmount(s.db.WithContext(ctx), req.BondId, int(req.TrancheId), req.SellerAddress)
+	if err != nil {
+		return nil, err
+	}
+	available := new(big.Int).Sub(position, listed)
+	if amount.Cmp(available) > 0 {
+		return nil, fmt.Errorf("order amount %s exceeds unlisted position %s", amount, available)
+	}
+
+	order := &models.Order{
+		BondID:    req.BondId,
+		TrancheID: int(req.TrancheId),
+		Seller:    common.HexToAddress(req.SellerAddress).Hex(),
+		Amount:    amount.String(),
+		Remaining: amount.String(),
+		PriceBps:  req.PriceBps,
+		Token:     common.HexToAddress(req.TokenAddress).Hex(),
+		Status:    models.OrderOpen,
+	}
+	if req.ExpiresAt > 0 {
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		order.ExpiresAt = &expiresAt
+	}
+
+	if err := s.db.WithContext(ctx).Create(order).Error; err != nil {
+		return nil, fmt.Errorf("failed to save order: %w", err)
+	}
+
+	return orderInfo(order, s.lookupAddresses(ctx, order.Seller)), nil
+}
+
+// ListOrders lists orders for a tranche, best price first, with price discovery data
+func (s *BondingServiceServer) ListOrders(
+	ctx context.Context,
+	req *pb.ListOrdersRequest,
+) (*pb.ListOrdersResponse, error) {
+	query := s.db.WithContext(ctx).Model(&models.Order{}).Where("bond_id = ?", req.BondId)
+	if req.TrancheId != nil {
+		query = query.Where("tranche_id = ?", req.GetTrancheId())
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	} else {
+		query = query.Where("status IN ?", []string{models.OrderOpen, models.OrderPartiallyFilled})
+	}
+
+	var orders []models.Order
+	if err := query.Order("price_bps ASC, created_at ASC").Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	sellers := make([]string, len(orders))
+	for i := range orders {
+		sellers[i] = orders[i].Seller
+	}
+	labels := s.lookupAddresses(ctx, sellers...)
+
+	now := time.Now()
+	result := make([]*pb.OrderInfo, 0, len(orders))
+	for i := range orders {
+		if orders[i].ExpiresAt != nil && orders[i].ExpiresAt.Before(now) {
+			continue
+		}
+		result = append(result, orderInfo(&orders[i], labels))
+	}
+
+	response := &pb.ListOrdersResponse{Orders: result}
+	if req.TrancheId != nil {
+		market, err := s.trancheMarket(ctx, req.BondId, int(req.GetTrancheId()))
+		if err != nil {
+			return nil, err
+		}
+		response.Market = market
+	}
+
+	return response, nil
+}
+
+// PrepareFillOrder returns the EIP-712 typed data the buyer signs to approve
+// the bond contract to pull the fill's value in the order's token
+func (s *BondingServiceServer) PrepareFillOrder(
+	ctx context.Context,
+	req *pb.PrepareFillOrderRequest,
+) (*pb.PrepareFillOrderResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.BuyerAddress); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.BuyerAddress) {
+		return nil, fmt.Errorf("buyer_address must be a valid address")
+	}
+
+	var order models.Order
+	if err := s.db.WithContext(ctx).First(&order, req.OrderId).Error; err != nil {
+		return nil, fmt.Errorf("order not found: %w", err)
+	}
+	_, value, err := fillTerms(&order, req.BuyerAddress, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := s.bondChain(ctx, order.BondID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(defaultPermitValidity).Unix()
+	if req.Deadline > 0 {
+		deadline = req.Deadline
+	}
+
+	domain, p, err := s.buildPermit(ctx, chain, order.Token, req.BuyerAddress, value, big.NewInt(deadline))
+	if err != nil {
+		return nil, err
+	}
+	typedData, err := json.Marshal(permit.TypedData(domain, p))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode typed data: %w", err)
+	}
+
+	return &pb.PrepareFillOrderResponse{
+		TypedData: string(typedData),
+		Spender:   p.Spender.Hex(),
+		Value:     p.Value.String(),
+		Nonce:     p.Nonce.String(),
+		Deadline:  deadline,
+	}, nil
+}
+
+// FillOrder buys part or all of an open order. The buyer's permit and the
+// position transfer go on-chain in one transaction, so the seller is paid
+// exactly when the position moves. Only the buyer's signed-in wallet can fill.
+func (s *BondingServiceServer) FillOrder(
+	ctx context.Context,
+	req *pb.FillOrderRequest,
+) (*pb.FillOrderResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.BuyerAddress); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.BuyerAddress) {
+		return nil, fmt.Errorf("buyer_address must be a valid address")
+	}
+	if err := checkBuyer(ctx, req.BuyerAddress); err != nil {
+		return nil, err
+	}
+	if req.Deadline <= time.Now().Unix() {
+		return nil, fmt.Errorf("permit deadline has passed")
+	}
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	v, r, sigS, err := permit.Split(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var order models.Order
+	if err := s.db.WithContext(ctx).First(&order, req.OrderId).Error; err != nil {
+		return nil, fmt.Errorf("order not found: %w", err)
+	}
+	amount, value, err := fillTerms(&order, req.BuyerAddress, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPositionRecipient(ctx, req.BuyerAddress, order.TrancheID); err != nil {
+		return nil, err
+	}
+
+	chain, err := s.bondChain(ctx, order.BondID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Check the buyer's permit against chain state before relaying
+	domain, p, err := s.buildPermit(ctx, chain, order.Token, req.BuyerAddress, value, big.NewInt(req.Deadline))
+	if err != nil {
+		return nil, err
+	}
+	if err := permit.Verify(domain, p, signature); err != nil {
+		return nil, fmt.Errorf("invalid permit signature: %w", err)
+	}
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return nil, err
+	}
+
+	move := &positionMove{
+		bondID:    order.BondID,
+		trancheID: order.TrancheID,
+		from:      order.Seller,
+		to:        req.BuyerAddress,
+		amount:    amount,
+		send: func(ctx context.Context, amount *big.Int) (string, error) {
+			return s.permitAndTradeOnChain(ctx, chain, &order, req.BuyerAddress, amount, p, v, r, sigS)
+		},
+	}
+
+	// 2. With the order locked, move the position and pay the seller, then
+	// record the trade
+	var trade *models.Trade
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, order.ID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+		// The order may have been filled or cancelled since it was read
+		if _, _, err := fillTerms(&order, req.BuyerAddress, amount.String()); err != nil {
+			return err
+		}
+
+		transfer, _, err := s.movePosition(ctx, tx, chain, move)
+		if err != nil {
+			return fmt.Errorf("failed to settle order: %w", err)
+		}
+
+		trade = &models.Trade{
+			OrderID:    order.ID,
+			BondID:     order.BondID,
+			TrancheID:  order.TrancheID,
+			Seller:     order.Seller,
+			Buyer:      transfer.ToAddress,
+			Amount:     amount.String(),
+			PriceBps:   order.PriceBps,
+			Value:      value.String(),
+			TransferID: transfer.ID,
+			TxHash:     transfer.TxHash,
+			Timestamp:  transfer.Timestamp,
+		}
+		if err := tx.Create(trade).Error; err != nil {
+			return fmt.Errorf("failed to save trade: %w", err)
+		}
+
+		remaining := new(big.Int).Sub(parseBigInt(order.Remaining), amount)
+		order.Remaining = remaining.String()
+		if remaining.Sign() == 0 {
+			order.Status = models.OrderFilled
+		} else {
+			order.Status = models.OrderPartiallyFilled
+		}
+		if err := tx.Model(&order).Updates(map[string]interface{}{
+			"remaining": order.Remaining,
+			"status":    order.Status,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		move.alertUnrecorded(err)
+		return nil, err
+	}
+
+	return &pb.FillOrderResponse{
+		TradeId: uint64(trade.ID),
+		TxHash:  trade.TxHash,
+		Amount:  trade.Amount,
+		Value:   trade.Value,
+		Order:   orderInfo(&order, s.lookupAddresses(ctx, order.Seller)),
+	}, nil
+}
+
+// CancelOrder withdraws the unfilled part of an order. Only the seller's
+// signed-in wallet can cancel it.
+func (s *BondingServiceServer) CancelOrder(
+	ctx context.Context,
+	req *pb.CancelOrderRequest,
+) (*pb.OrderInfo, error) {
+	var order models.Order
+	if err := s.db.WithContext(ctx).First(&order, req.OrderId).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+	}
+	if err := checkPositionOwner(ctx, order.Seller); err != nil {
+		return nil, err
+	}
+
+	// Conditional on the status so a fill in flight either lands first or not at all
+	cancel := s.db.WithContext(ctx).Model(&order).
+		Where("status IN ?", []string{models.OrderOpen, models.OrderPartiallyFilled}).
+		Update("status", models.OrderCancelled)
+	if cancel.Error != nil {
+		return nil, fmt.Errorf("failed to cancel order: %w", cancel.Error)
+	}
+	if cancel.RowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %d is not open", order.ID)
+	}
+	order.Status = models.OrderCancelled
+
+	return orderInfo(&order, s.lookupAddresses(ctx, order.Seller)), nil
+}
+
+// fillTerms checks that order can be filled by buyer and returns the amount
+// filled and the value the buyer pays for it. An empty amount fills the
+// remaining amount.
+func fillTerms(order *models.Order, buyer string, amountStr string) (*big.Int, *big.Int, error) {
+	if order.Status != models.OrderOpen && order.Status != models.OrderPartiallyFilled {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "order is not open (status: %s)", order.Status)
+	}
+	if order.ExpiresAt != nil && order.ExpiresAt.Before(time.Now()) {
+		return nil, nil, status.Error(codes.FailedPrecondition, "order has expired")
+	}
+	if order.Token == "" {
+		return nil, nil, status.Error(codes.FailedPrecondition, "order has no payment token; the seller must cancel and relist it")
+	}
+	if strings.EqualFold(order.Seller, buyer) {
+		return nil, nil, fmt.Errorf("cannot fill your own order")
+	}
+
+	remaining := parseBigInt(order.Remaining)
+	amount := remaining
+	if amountStr != "" {
+		var ok bool
+		amount, ok = new(big.Int).SetString(amountStr, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, nil, fmt.Errorf("invalid fill amount")
+		}
+		if amount.Cmp(remaining) > 0 {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "fill amount %s exceeds remaining %s", amount, remaining)
+		}
+	}
+
+	value := new(big.Int).Mul(amount, big.NewInt(order.PriceBps))
+	value.Div(value, big.NewInt(10000))
+	if value.Sign() == 0 {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "fill amount %s is worth nothing at %d bps", amount, order.PriceBps)
+	}
+	return amount, value, nil
+}
+
+// permitAndTradeOnChain relays the buyer's permit and the trade in one
+// multicall and returns the transaction hash
+func (s *BondingServiceServer) permitAndTradeOnChain(
+	ctx context.Context,
+	chain *chains.Chain,
+	order *models.Order,
+	buyer string,
+	amount *big.Int,
+	p permit.Permit,
+	v uint8,
+	r [32]byte,
+	sigS [32]byte,
+) (string, error) {
+	bondID, ok := new(big.Int).SetString(order.BondID, 10)
+	if !ok {
+		return "", status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", order.BondID)
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	sent, err := contract.PermitAndTradePosition(ctx, bondID, uint8(order.TrancheID),
+		common.HexToAddress(order.Seller), common.HexToAddress(buyer), amount,
+		common.HexToAddress(order.Token), p.Value, p.Deadline, v, r, sigS)
+	if err != nil {
+		return "", err
+	}
+	return sent.Hash().Hex(), nil
+}
+
+// listedAmount sums the unfilled amount of a seller's open orders in a tranche
+func (s *BondingServiceServer) listedAmount(db *gorm.DB, bondID string, trancheID int, seller string) (*big.Int, error) {
+	var orders []models.Order
+	if err := db.Where("bond_id = ? AND tranche_id = ? AND LOWER(seller) = LOWER(?) AND status IN ?",
+		bondID, trancheID, seller, []string{models.OrderOpen, models.OrderPartiallyFilled}).
+		Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to load orders: %w", err)
+	}
+
+	listed := big.NewInt(0)
+	for _, o := range orders {
+		listed.Add(listed, parseBigInt(o.Remaining))
+	}
+	return listed, nil
+}
+
+// trancheMarket computes price discovery data for a tranche from open orders and trades
+func (s *BondingServiceServer) trancheMarket(ctx context.Context, bondID string, trancheID int) (*pb.TrancheMarket, error) {
+	market := &pb.TrancheMarket{TrancheId: int32(trancheID)}
+
+	var bestAsk models.Order
+	err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ? AND status IN ?",
+		bondID, trancheID, []string{models.OrderOpen, models.OrderPartiallyFilled}).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("price_bps ASC").
+		First(&bestAsk).Error
+	if err == nil {
+		market.BestAskBps = bestAsk.PriceBps
+	}
+
+	var trades []models.Trade
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", bondID, trancheID).
+		Order("timestamp ASC").
+		Find(&trades).Error; err != nil {
+		return nil, fmt.Errorf("failed to load trades: %w", err)
+	}
+
+	volume := big.NewInt(0)
+	weighted := big.NewInt(0)
+	for _, t := range trades {
+		amount := parseBigInt(t.Amount)
+		volume.Add(volume, amount)
+		weighted.Add(weighted, new(big.Int).Mul(amount, big.NewInt(t.PriceBps)))
+	}
+
+	market.TradeCount = int32(len(trades))
+	market.Volume = volume.String()
+	if len(trades) > 0 {
+		last := trades[len(trades)-1]
+		market.LastPriceBps = last.PriceBps
+		market.LastTradeAt = last.Timestamp.Unix()
+	}
+	if volume.Sign() > 0 {
+		market.VwapBps = new(big.Int).Div(weighted, volume).Int64()
+	}
+
+	return market, nil
+}
+
+func orderInfo(o *models.Order, labels addressLabels) *pb.OrderInfo {
+	info := &pb.OrderInfo{
+		OrderId:       uint64(o.ID),
+		BondId:        o.BondID,
+		TrancheId:     int32(o.TrancheID),
+		SellerAddress: o.Seller,
+		Amount:        o.Amount,
+		Remaining:     o.Remaining,
+		PriceBps:      o.PriceBps,
+		Status:        o.Status,
+		CreatedAt:     o.CreatedAt.Unix(),
+		Seller:        labels.counterparty(o.Seller),
+		TokenAddress:  o.Token,
+	}
+	if o.ExpiresAt != nil {
+		info.ExpiresAt = o.ExpiresAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestFillTerms(t *testing.T) {
+	seller := "0x1111111111111111111111111111111111111111"
+	buyer := "0x2222222222222222222222222222222222222222"
+	token := "0x3333333333333333333333333333333333333333"
+	past := time.Now().Add(-time.Hour)
+
+	open := func(mutate func(o *models.Order)) *models.Order {
+		o := &models.Order{Seller: seller, Remaining: "1000", PriceBps: 9500, Token: token, Status: models.OrderOpen}
+		if mutate != nil {
+			mutate(o)
+		}
+		return o
+	}
+
+	tests := []struct {
+		name      string
+		order     *models.Order
+		buyer     string
+		amount    string
+		wantAmt   string
+		wantValue string
+		wantErr   bool
+	}{
+		{"fills the remaining amount", open(nil), buyer, "", "1000", "950", false},
+		{"partial fill", open(nil), buyer, "400", "400", "380", false},
+		{"exceeds remaining", open(nil), buyer, "1001", "", "", true},
+		{"own order", open(nil), "0x1111111111111111111111111111111111111111", "", "", "", true},
+		{"filled", open(func(o *models.Order) { o.Status = models.OrderFilled }), buyer, "", "", "", true},
+		{"cancelled", open(func(o *models.Order) { o.Status = models.OrderCancelled }), buyer, "", "", "", true},
+		{"expired", open(func(o *models.Order) { o.ExpiresAt = &past }), buyer, "", "", "", true},
+		{"no payment token", open(func(o *models.Order) { o.Token = "" }), buyer, "", "", "", true},
+		{"worth nothing", open(nil), buyer, "1", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, value, err := fillTerms(tt.order, tt.buyer, tt.amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fillTerms() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if amount.String() != tt.wantAmt || value.String() != tt.wantValue {
+				t.Errorf("fillTerms() = %s, %s, want %s, %s", amount, value, tt.wantAmt, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/cashflow"
+	"github.com/knowton/bonding-service/internal/forecast"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPaymentSchedule returns the coupons and principal a bond's tranches are
+// expected to pay, scaled to what was invested in them, next to what its
+// distributions actually paid. Bonds issued before schedules were stored get
+// one generated from their issuance.
+func (s *BondingServiceServer) GetPaymentSchedule(
+	ctx context.Context,
+	req *pb.GetPaymentScheduleRequest,
+) (*pb.GetPaymentScheduleResponse, error) {
+	var bond *models.Bond
+	var payments []models.ScheduledPayment
+	var distributions []models.RevenueDistribution
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		if err := checkArchived(bond, req.IncludeArchived); err != nil {
+			return err
+		}
+
+		if payments, err = s.bonds.ListScheduledPayments(ctx, bond.BondID); err != nil {
+			return err
+		}
+		if len(payments) == 0 {
+			forecasts, err := forecast.Load(s.conn(ctx), bond.BondID)
+			if err != nil {
+				return err
+			}
+			payments = cashflow.Generate(bond, bond.CreatedAt, forecastDates(forecasts))
+		}
+		distributions, err = s.allDistributions(ctx, bond.BondID, req.IncludeArchived)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paid := cashflow.Paid(payments, distributions)
+	now := time.Now()
+	response := &pb.GetPaymentScheduleResponse{BondId: bond.BondID}
+	for i := range bond.Tranches {
+		t := &bond.Tranches[i]
+		if req.TrancheId >= 0 && int32(t.TrancheID) != req.TrancheId {
+			continue
+		}
+		allocation, invested := parseBigInt(t.Allocation), parseBigInt(t.TotalInvested)
+		schedule := &pb.TranchePaymentSchedule{
+			TrancheId:     int32(t.TrancheID),
+			Allocation:    t.Allocation,
+			TotalInvested: t.TotalInvested,
+		}
+		expectedTotal, paidTotal := new(big.Int), new(big.Int)
+		for n := range payments {
+			p := &payments[n]
+			if p.TrancheID != t.TrancheID {
+				continue
+			}
+			// The schedule is on the full allocation; only what was invested
+			// earns coupons
+			expected := new(big.Int)
+			if allocation.Sign() > 0 {
+				expected.Mul(parseBigInt(p.Coupon), invested)
+				expected.Div(expected, allocation)
+			}
+			expectedTotal.Add(expectedTotal, expected)
+			paidTotal.Add(paidTotal, paid[n])
+			schedule.Payments = append(schedule.Payments, &pb.ScheduledPayment{
+				Sequence:       int32(p.Sequence),
+				DueDate:        p.DueDate.Unix(),
+				Coupon:         p.Coupon,
+				Principal:      p.Principal,
+				ExpectedCoupon: expected.String(),
+				Paid:           paid[n].String(),
+				Status:         cashflow.Status(p, expected, paid[n], now),
+			})
+		}
+		schedule.ExpectedCoupons = expectedTotal.String()
+		schedule.Paid = paidTotal.String()
+		response.Tranches = append(response.Tranches, schedule)
+	}
+	return response, nil
+}
+
+// allDistributions returns every revenue distribution of a bond with its
+// per-tranche splits, newest first
+func (s *BondingServiceServer) allDistributions(ctx context.Context, bondID string, includeArchived bool) ([]models.RevenueDistribution, error) {
+	filter := repository.DistributionFilter{
+		BondID:          bondID,
+		IncludeArchived: includeArchived,
+		Limit:           repository.MaxPageSize,
+	}
+	var all []models.RevenueDistribution
+	for {
+		page, next, err := s.bonds.ListDistributions(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if next == nil {
+			return all, nil
+		}
+		filter.After = next
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import "github.com/knowton/bonding-service/internal/rbac"
+
+var (
+	everyone = []rbac.Role{rbac.Issuer, rbac.Investor, rbac.Operator, rbac.Auditor}
+	issuers  = []rbac.Role{rbac.Issuer, rbac.Operator}
+	reviews  = []rbac.Role{rbac.Operator, rbac.Auditor}
+)
+
+// methodRoles are the roles that may call each RPC. An RPC missing here is
+// refused to everyone.
+var methodRoles = map[string][]rbac.Role{
+	// Issuance and revenue
+	"IssueBond":                  issuers,
+	"ValidateIssueBond":          issuers,
+	"EstimateIssuanceCost":       issuers,
+	"AssessIPRisk":               issuers,
+	"AssessIPRiskBatch":          issuers,
+	"DistributeRevenue":          issuers,
+	"QueueDistributions":         issuers,
+	"SetDistributionSchedule":    issuers,
+	"PauseDistributionSchedule":  issuers,
+	"ResumeDistributionSchedule": issuers,
+	"SkipDistributionRuns":       issuers,
+	"DeleteDistributionSchedule": issuers,
+	"ImportRevenueCSV":           issuers,
+	"ApproveRedemption":          issuers,
+	"GenerateProspectus":         issuers,
+	"SetTrancheLimits":           {rbac.Issuer},
+	"GetRevenueVariance":         {rbac.Issuer, rbac.Operator, rbac.Auditor},
+	"ListRevenueEvents":          {rbac.Issuer, rbac.Operator, rbac.Auditor},
+	"ListInvestments":            {rbac.Issuer, rbac.Operator, rbac.Auditor},
+
+	// Investing and trading
+	"Invest":                  {rbac.Investor},
+	"GetInvestmentQuote":      {rbac.Investor},
+	"PreparePermitInvestment": {rbac.Investor},
+	"InvestWithPermit":        {rbac.Investor},
+	"TransferInvestment":      {rbac.Investor},
+	"RequestEarlyRedemption":  {rbac.Investor},
+	"PlaceOrder":              {rbac.Investor},
+	"PrepareFillOrder":        {rbac.Investor},
+	"FillOrder":               {rbac.Investor},
+	"CancelOrder":             {rbac.Investor},
+	"GetClaimableAmounts":     {rbac.Investor},
+	"PrepareClaim":            {rbac.Investor},
+	"GetPositionProof":        {rbac.Investor, rbac.Auditor},
+
+	// Bond information
+	"GetBondInfo":              everyone,
+	"GetTrancheInfo":           everyone,
+	"ListBonds":                everyone,
+	"GetBondTimeline":          everyone,
+	"GetRevenueHistory":        everyone,
+	"GetPaymentSchedule":       everyone,
+	"GetYieldHistory":          everyone,
+	"GetDistributionSchedule":  everyone,
+	"ListAccrualSnapshots":     everyone,
+	"GetRiskAssessmentHistory": everyone,
+	"ListRiskAssessments":      everyone,
+	"GetCounterpartyRisk":      everyone,
+	"StressTest":               everyone,
+	"ListOrders":               everyone,
+	"GetDocumentURL":           everyone,
+	"GetChainStatus":           everyone,
+	"ListCategories":           everyone,
+	"ListRiskModels":           everyone,
+	"GetMaintenance":           everyone,
+
+	// Address book
+	"UpsertAddressBookEntry": {rbac.Issuer, rbac.Investor, rbac.Operator},
+	"DeleteAddressBookEntry": {rbac.Issuer, rbac.Investor, rbac.Operator},
+	"ListAddressBookEntries": everyone,
+
+	// Operations
+	"SpeedUpTransaction":    {rbac.Operator},
+	"CancelTransaction":     {rbac.Operator},
+	"ScheduleMaintenance":   {rbac.Operator},
+	"CancelMaintenance":     {rbac.Operator},
+	"UpsertCategory":        {rbac.Operator},
+	"DeleteCategory":        {rbac.Operator},
+	"RecordComparableSales": {rbac.Operator},
+	"AddAccessListEntry":    {rbac.Operator},
+	"RemoveAccessListEntry": {rbac.Operator},
+	"PauseBond":             {rbac.Operator},
+	"FreezeBond":            {rbac.Operator},
+	"CancelBond":            {rbac.Operator},
+	"ResumeBond":            {rbac.Operator},
+	"ReviewRevenueEvents":   {rbac.Operator},
+
+	// Emergency withdrawals, requested and confirmed by different operators
+	"RequestEmergencyWithdrawal": {rbac.Operator},
+	"ConfirmEmergencyWithdrawal": {rbac.Operator},
+	"CancelEmergencyWithdrawal":  {rbac.Operator},
+	"ListEmergencyWithdrawals":   reviews,
+
+	// Feature flags
+	"SetFeatureFlag":   {rbac.Operator},
+	"ListFeatureFlags": reviews,
+
+	// Review
+	"ExportLedger":            reviews,
+	"ListPendingTransactions": reviews,
+	"GetReconciliationReport": reviews,
+	"GetUsage":                reviews,
+	"ListAccessListEntries":   reviews,
+
+	// Audit
+	"QueryAuditLog": {rbac.Auditor},
+}
+
+// Permissions returns the roles that may call each bonding service method,
+// by full gRPC method name
+func Permissions() rbac.Permissions {
+	perms := make(rbac.Permissions, len(methodRoles))
+	for method, roles := range methodRoles {
+		perms["/bonding.BondingService/"+method] = roles
+	}
+	return perms
+}
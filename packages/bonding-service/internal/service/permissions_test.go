@@ -0,0 +1,30 @@
+package service
+
+import (
+	"os"
+	"regexp"
+	"testing"
+)
+
+// TestPermissionsCoverEveryRPC guards against RPCs added without roles,
+// which deny-by-default would make uncallable
+func TestPermissionsCoverEveryRPC(t *testing.T) {
+	spec, err := os.ReadFile("../../proto/bonding.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpcs := regexp.MustCompile(`(?m)^\s*rpc (\w+)\(`).FindAllStringSubmatch(string(spec), -1)
+	if len(rpcs) == 0 {
+		t.Fatal("no RPCs found in bonding.proto")
+	}
+
+	perms := Permissions()
+	for _, rpc := range rpcs {
+		if len(perms["/bonding.BondingService/"+rpc[1]]) == 0 {
+			t.Errorf("%s has no roles", rpc[1])
+		}
+	}
+	if len(perms) != len(rpcs) {
+		t.Errorf("%d methods have roles, bonding.proto has %d RPCs", len(perms), len(rpcs))
+	}
+}
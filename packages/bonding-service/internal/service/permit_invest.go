@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/hooks"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/permit"
+	"github.com/knowton/bonding-service/internal/rules"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// defaultPermitValidity is how long a prepared permit stays valid when the caller doesn't set a deadline
+const defaultPermitValidity = 30 * time.Minute
+
+// PreparePermitInvestment returns the EIP-712 typed data the investor signs to
+// approve the bond contract to pull an ERC-20 investment
+func (s *BondingServiceServer) PreparePermitInvestment(
+	ctx context.Context,
+	req *pb.PreparePermitInvestmentRequest,
+) (*pb.PreparePermitInvestmentResponse, error) {
+	if err := s.checkFeature(ctx, flags.ERC20Payments); err != nil {
+		return nil, err
+	}
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	amount, err := s.validatePermitInvestment(ctx, req.BondId, req.TrancheId, req.InvestorAddress, req.TokenAddress, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := s.bondChain(ctx, req.BondId)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(defaultPermitValidity).Unix()
+	if req.Deadline > 0 {
+		deadline = req.Deadline
+	}
+
+	domain, p, err := s.buildPermit(ctx, chain, req.TokenAddress, req.InvestorAddress, amount, big.NewInt(deadline))
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := json.Marshal(permit.TypedData(domain, p))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode typed data: %w", err)
+	}
+
+	return &pb.PreparePermitInvestmentResponse{
+		TypedData: string(typedData),
+		Spender:   p.Spender.Hex(),
+		Value:     p.Value.String(),
+		Nonce:     p.Nonce.String(),
+		Deadline:  deadline,
+	}, nil
+}
+
+// InvestWithPermit validates a signed ERC-2612 permit and relays permit + invest
+// as a single multicall transaction
+func (s *BondingServiceServer) InvestWithPermit(
+	ctx context.Context,
+	req *pb.InvestWithPermitRequest,
+) (*pb.InvestWithPermitResponse, error) {
+	if err := s.checkFeature(ctx, flags.ERC20Payments); err != nil {
+		return nil, err
+	}
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	amount, err := s.validatePermitInvestment(ctx, req.BondId, req.TrancheId, req.InvestorAddress, req.TokenAddress, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := s.bondChain(ctx, req.BondId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Deadline <= time.Now().Unix() {
+		return nil, fmt.Errorf("permit deadline has passed")
+	}
+
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	// 1. Rebuild the permit from chain state and check the signature before relaying
+	domain, p, err := s.buildPermit(ctx, chain, req.TokenAddress, req.InvestorAddress, amount, big.NewInt(req.Deadline))
+	if err != nil {
+		return nil, err
+	}
+	if err := permit.Verify(domain, p, signature); err != nil {
+		return nil, fmt.Errorf("invalid permit signature: %w", err)
+	}
+
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return nil, err
+	}
+
+	// 2. Hold the capacity, then relay permit + invest
+	v, r, sigS, err := permit.Split(signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := reserveCapacity(s.db.WithContext(ctx), req.BondId, int(req.TrancheId), amount); err != nil {
+		return nil, err
+	}
+	txHash, err := s.permitAndInvestOnChain(ctx, chain, req.BondId, req.TrancheId, domain.VerifyingContract, p, amount, v, r, sigS)
+	if err != nil {
+		releaseCapacity(s.db.WithContext(context.WithoutCancel(ctx)), req.BondId, int(req.TrancheId), amount)
+		return nil, fmt.Errorf("failed to relay permit investment: %w", err)
+	}
+	s.transactionSent(ctx, chain.Name, txHash, txmonitor.PurposeInvest, req.BondId)
+
+	// 3. Record the investment
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := recordInvestment(tx, req.BondId, int(req.TrancheId), req.InvestorAddress, amount, txHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InvestWithPermitResponse{
+		TxHash:         txHash,
+		Status:         "pending",
+		InvestedAmount: amount.String(),
+	}, nil
+}
+
+func (s *BondingServiceServer) validatePermitInvestment(
+	ctx context.Context,
+	bondID string,
+	trancheID int32,
+	investor string,
+	token string,
+	amountStr string,
+) (*big.Int, error) {
+	if !common.IsHexAddress(investor) || !common.IsHexAddress(token) {
+		return nil, fmt.Errorf("investor_address and token_address must be valid addresses")
+	}
+	if err := s.checkInvestorVerified(ctx, investor); err != nil {
+		return nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(amountStr, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid investment amount")
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", bondID).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	if bond.Status != "ACTIVE" {
+		return nil, fmt.Errorf("bond is not active (status: %s)", bond.Status)
+	}
+
+	var tranche models.Tranche
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", bondID, trancheID).First(&tranche).Error; err != nil {
+		return nil, fmt.Errorf("tranche not found: %w", err)
+	}
+	if err := s.checkEligibility(ctx, investor, tranche.TrancheID); err != nil {
+		return nil, err
+	}
+	if err := checkInvestmentLimits(&tranche, amount); err != nil {
+		return nil, err
+	}
+	facts := investmentFacts(&bond, &tranche, investor, amount)
+	if err := s.checkRules(rules.Investment, facts); err != nil {
+		return nil, err
+	}
+	if err := s.runHooks(ctx, hooks.PreInvestment, facts); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// buildPermit reads the token's domain and the owner's nonce to construct the permit
+func (s *BondingServiceServer) buildPermit(
+	ctx context.Context,
+	chain *chains.Chain,
+	tokenAddress string,
+	owner string,
+	value *big.Int,
+	deadline *big.Int,
+) (permit.Domain, permit.Permit, error) {
+	token, err := blockchain.NewERC20PermitToken(s.chainClient(chain), tokenAddress)
+	if err != nil {
+		return permit.Domain{}, permit.Permit{}, err
+	}
+	token.SetViewCaller(s.viewCache.Caller(chain.Name, s.chainClient(chain)))
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+
+	name, err := token.Name(ctx)
+	if err != nil {
+		return permit.Domain{}, permit.Permit{}, fmt.Errorf("failed to read token name: %w", err)
+	}
+
+	ownerAddr := common.HexToAddress(owner)
+	nonce, err := token.Nonces(ctx, ownerAddr)
+	if err != nil {
+		return permit.Domain{}, permit.Permit{}, fmt.Errorf("failed to read permit nonce: %w", err)
+	}
+
+	domain := permit.Domain{
+		Name:              name,
+		Version:           token.Version(ctx),
+		ChainID:           big.NewInt(chain.ChainID),
+		VerifyingContract: common.HexToAddress(tokenAddress),
+	}
+	p := permit.Permit{
+		Owner:    ownerAddr,
+		Spender:  s.bondContract(chain),
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: deadline,
+	}
+	return domain, p, nil
+}
+
+// permitAndInvestOnChain relays the permit and investment in one multicall
+// and returns the transaction hash
+func (s *BondingServiceServer) permitAndInvestOnChain(
+	ctx context.Context,
+	chain *chains.Chain,
+	bondID string,
+	trancheID int32,
+	token common.Address,
+	p permit.Permit,
+	amount *big.Int,
+	v uint8,
+	r [32]byte,
+	sigS [32]byte,
+) (string, error) {
+	bondIDInt, ok := new(big.Int).SetString(bondID, 10)
+	if !ok {
+		return "", status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", bondID)
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	sent, err := contract.PermitAndInvest(ctx, bondIDInt, uint8(trancheID), token, p.Owner, amount, p.Value, p.Deadline, v, r, sigS)
+	if err != nil {
+		return "", err
+	}
+	return sent.Hash().Hex(), nil
+}
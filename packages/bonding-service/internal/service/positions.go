@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/auth"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// checkPositionOwner refuses the call unless the caller signed in with the
+// wallet that holds the position
+func checkPositionOwner(ctx context.Context, owner string) error {
+	principal, ok := rbac.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "moving a position needs the owner's wallet")
+	}
+	if principal.Method != auth.MethodWallet || !strings.EqualFold(principal.ID, owner) {
+		return status.Errorf(codes.PermissionDenied, "only %s's wallet can move its position", owner)
+	}
+	return nil
+}
+
+// checkBuyer refuses the call unless the caller signed in with the wallet
+// that receives and pays for the position
+func checkBuyer(ctx context.Context, buyer string) error {
+	principal, ok := rbac.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "buying a position needs the buyer's wallet")
+	}
+	if principal.Method != auth.MethodWallet || !strings.EqualFold(principal.ID, buyer) {
+		return status.Errorf(codes.PermissionDenied, "only %s's wallet can buy for it", buyer)
+	}
+	return nil
+}
+
+// checkBondIssuer refuses the call unless the caller signed in with the
+// wallet that issued the bond. claimed is the address the request names,
+// which must be that same wallet. It returns the issuer's wallet address.
+func checkBondIssuer(ctx context.Context, bond *models.Bond, claimed string) (string, error) {
+	principal, ok := rbac.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "this needs the bond issuer's wallet")
+	}
+	if principal.Method != auth.MethodWallet || !strings.EqualFold(principal.ID, bond.Issuer) {
+		return "", status.Errorf(codes.PermissionDenied, "only the issuer of bond %s can do this", bond.BondID)
+	}
+	if claimed != "" && !strings.EqualFold(claimed, principal.ID) {
+		return "", status.Errorf(codes.PermissionDenied, "%s is not the signed-in wallet", claimed)
+	}
+	return common.HexToAddress(principal.ID).Hex(), nil
+}
+
+// signedInWallet returns the caller's wallet address, or claimed when the
+// caller didn't sign in with a wallet
+func signedInWallet(ctx context.Context, claimed string) string {
+	if principal, ok := rbac.FromContext(ctx); ok && principal.Method == auth.MethodWallet {
+		return principal.ID
+	}
+	return claimed
+}
+
+// recordInvestment stores a confirmed investment and moves its amount from the
+// tranche's reserved capacity to its total. The capacity was checked when it
+// was reserved; the tranche row is locked so concurrent investments update
+// the totals one at a time.
+func recordInvestment(tx *gorm.DB, bondID string, trancheID int, investor string, amount *big.Int, txHash string) (*models.Investment, error) {
+	tranche, err := lockTranche(tx, bondID, trancheID)
+	if err != nil {
+		return nil, err
+	}
+
+	investment := &models.Investment{
+		BondID:    bondID,
+		TrancheID: trancheID,
+		Investor:  common.HexToAddress(investor).Hex(),
+		Amount:    amount.String(),
+		TxHash:    txHash,
+		Timestamp: time.Now(),
+	}
+	if err := tx.Create(investment).Error; err != nil {
+		return nil, fmt.Errorf("failed to save investment: %w", err)
+	}
+	if err := postInvestment(tx, investment, amount); err != nil {
+		return nil, err
+	}
+
+	totalInvested := new(big.Int).Add(parseBigInt(tranche.TotalInvested), amount)
+	if err := tx.Model(tranche).Updates(map[string]interface{}{
+		"total_invested": totalInvested.String(),
+		"reserved":       subFloorZero(parseBigInt(tranche.Reserved), amount).String(),
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update tranche: %w", err)
+	}
+
+	return investment, nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preflightIssueBond simulates the issueBond call an issuance would send
+func (s *BondingServiceServer) preflightIssueBond(
+	ctx context.Context,
+	req *pb.IssueBondRequest,
+	plan *issuance,
+) (*blockchain.Preflight, error) {
+	call, err := s.newIssueBondCall(req, plan.totalValue, plan.assessment)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(plan.chain), s.bondContract(plan.chain).Hex(), s.privateKey, plan.chain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+
+	return contract.PreflightIssueBond(ctx, call.ipnftID, call.nftContract, call.totalValue,
+		call.seniorAllocation, call.mezzanineAllocation, call.juniorAllocation,
+		call.maturityDate, call.valuationUSD, call.riskRating)
+}
+
+// preflightInvest simulates the invest call an investment would send
+func (s *BondingServiceServer) preflightInvest(
+	ctx context.Context,
+	chain *chains.Chain,
+	bondID string,
+	trancheID uint32,
+	amount *big.Int,
+) (*blockchain.Preflight, error) {
+	onChainID, ok := new(big.Int).SetString(bondID, 10)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", bondID)
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+
+	return contract.PreflightInvest(ctx, onChainID, uint8(trancheID), amount)
+}
+
+// checkPreflight rejects a write whose simulation reverted, so no gas is
+// spent on a transaction that would fail on-chain
+func checkPreflight(method string, preflight *blockchain.Preflight, err error) error {
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Unavailable, "failed to simulate %s: %v", method, err)
+	}
+	if preflight.Reverted {
+		return status.Errorf(codes.FailedPrecondition, "%s would revert: %s", method, revertMessage(preflight))
+	}
+	return nil
+}
+
+func revertMessage(preflight *blockchain.Preflight) string {
+	if preflight.RevertReason == "" {
+		return "execution reverted"
+	}
+	return preflight.RevertReason
+}
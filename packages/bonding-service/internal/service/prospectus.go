@@ -0,0 +1,69 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/prospectus"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GenerateProspectus renders a bond's offering document with its tranches,
+// registration, license terms and latest risk assessment
+func (s *BondingServiceServer) GenerateProspectus(
+	ctx context.Context,
+	req *pb.GenerateProspectusRequest,
+) (*pb.GenerateProspectusResponse, error) {
+	bond, err := s.bonds.GetBond(ctx, req.BondId)
+	if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+		return nil, status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+	}
+
+	agreement, err := s.bondLicense(ctx, bond.BondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load license agreement: %w", err)
+	}
+
+	assessment, err := repository.LatestAssessment(ctx, s.db, bond.IPNFTId)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &prospectus.Prospectus{
+		Bond:        bond,
+		License:     agreement,
+		Assessment:  assessment,
+		GeneratedAt: time.Now(),
+	}
+
+	var buf bytes.Buffer
+	if err := prospectus.Write(&buf, p); err != nil {
+		return nil, err
+	}
+
+	response := &pb.GenerateProspectusResponse{
+		Content:     buf.Bytes(),
+		ContentType: prospectus.ContentType,
+		Filename:    fmt.Sprintf("prospectus-%s-%s.md", bond.BondID, p.GeneratedAt.UTC().Format("20060102")),
+	}
+
+	// Optionally keep the prospectus in document storage and return a download link instead
+	if req.Store {
+		doc, url, err := s.storeDocument(ctx, models.DocumentProspectus, response.Filename, response.ContentType, response.Content)
+		if err != nil {
+			return nil, err
+		}
+		response.Content = nil
+		response.DocumentId = uint64(doc.ID)
+		response.DownloadUrl = url
+	}
+
+	return response, nil
+}
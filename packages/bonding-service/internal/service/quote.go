@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/accrual"
+	"github.com/knowton/bonding-service/internal/cashflow"
+	"github.com/knowton/bonding-service/internal/forecast"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// investmentQuoteValidity is how long a quote holds. Capacity is not
+// reserved, so an investment after it lapses may see different terms.
+const investmentQuoteValidity = 5 * time.Minute
+
+// GetInvestmentQuote quotes an investment in a tranche: its capacity, yield,
+// expected coupons and return at maturity. Nothing is reserved or written.
+func (s *BondingServiceServer) GetInvestmentQuote(
+	ctx context.Context,
+	req *pb.GetInvestmentQuoteRequest,
+) (*pb.GetInvestmentQuoteResponse, error) {
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid investment amount")
+	}
+
+	bond, err := s.bonds.GetBond(ctx, req.BondId)
+	if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+		return nil, status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+	}
+	if bond.Status != "ACTIVE" {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond is not active (status: %s)", bond.Status)
+	}
+	var tranche *models.Tranche
+	for i := range bond.Tranches {
+		if bond.Tranches[i].TrancheID == int(req.TrancheId) {
+			tranche = &bond.Tranches[i]
+		}
+	}
+	if tranche == nil {
+		return nil, status.Errorf(codes.NotFound, "tranche %d not found", req.TrancheId)
+	}
+	if err := checkInvestmentLimits(tranche, amount); err != nil {
+		return nil, err
+	}
+
+	forecasts, err := forecast.Load(s.db.WithContext(ctx), bond.BondID)
+	if err != nil {
+		return nil, err
+	}
+	accrued, err := s.trancheAccrued(ctx, bond, tranche.TrancheID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	schedule := cashflow.Coupons(amount, apyToBasisPoints(tranche.APY), trancheDayCount(bond, tranche), now, bond.MaturityDate, forecastDates(forecasts))
+	coupons := big.NewInt(0)
+	remaining := new(big.Int).Sub(parseBigInt(tranche.Allocation), parseBigInt(tranche.TotalInvested))
+	remaining.Sub(remaining, parseBigInt(tranche.Reserved))
+	resp := &pb.GetInvestmentQuoteResponse{
+		BondId:            bond.BondID,
+		TrancheId:         req.TrancheId,
+		Amount:            amount.String(),
+		RemainingCapacity: remaining.String(),
+		Apy:               tranche.APY,
+		EffectiveApy:      effectiveAPY(tranche.APY, len(schedule), now, bond.MaturityDate),
+		Fees:              "0",
+		Arrears:           parseBigInt(tranche.Arrears).String(),
+		AccruedInterest:   accrued.String(),
+		MaturityDate:      bond.MaturityDate.Unix(),
+		QuotedAt:          now.Unix(),
+		ValidUntil:        now.Add(investmentQuoteValidity).Unix(),
+	}
+	for _, c := range schedule {
+		resp.CouponSchedule = append(resp.CouponSchedule, &pb.CouponPayment{Date: c.Date.Unix(), Amount: c.Amount.String()})
+		coupons.Add(coupons, c.Amount)
+	}
+	resp.ProjectedCoupons = coupons.String()
+	resp.ProjectedReturn = new(big.Int).Add(amount, coupons).String()
+	return resp, nil
+}
+
+// trancheAccrued sums the interest a tranche's investments have accrued to
+// date
+func (s *BondingServiceServer) trancheAccrued(ctx context.Context, bond *models.Bond, trancheID int) (*big.Int, error) {
+	var investments []models.Investment
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", bond.BondID, trancheID).
+		Find(&investments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load investments: %w", err)
+	}
+	amounts, err := accrual.ToDate(ctx, s.db, bond, investments, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	for _, amount := range amounts {
+		total.Add(total, amount)
+	}
+	return total, nil
+}
+
+// effectiveAPY is the annual yield of coupons paid at the schedule's average
+// frequency and reinvested at the same rate
+func effectiveAPY(apy float64, coupons int, start, maturity time.Time) float64 {
+	years := maturity.Sub(start).Hours() / (365 * 24)
+	if coupons == 0 || years <= 0 {
+		return apy
+	}
+	perYear := float64(coupons) / years
+	return (math.Pow(1+apy/100/perYear, perYear) - 1) * 100
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEffectiveAPY(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		coupons int
+		years   int
+		want    float64
+	}{
+		{"annual", 1, 1, 8},
+		{"quarterly", 4, 1, 8.243216},
+		{"quarterly over two years", 8, 2, 8.243216},
+		{"no coupons", 0, 1, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maturity := start.Add(time.Duration(tt.years) * 365 * 24 * time.Hour)
+			if got := effectiveAPY(8, tt.coupons, start, maturity); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("effectiveAPY() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/license"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/tenant"
+)
+
+// ReassessBond assesses a bond's IP-NFT again with its stored registration
+// and license and its current counterparties, for the reassessment job.
+// Oracle calls are charged to the bond's tenant.
+func (s *BondingServiceServer) ReassessBond(ctx context.Context, bond *models.Bond) (*models.RiskAssessment, error) {
+	ctx = tenant.NewContext(ctx, bond.TenantID)
+
+	agreement, err := s.bondLicense(ctx, bond.BondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load license agreement: %w", err)
+	}
+	_, _, counterparties, err := s.bondCounterparties(ctx, bond.BondID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to score counterparties: %w", err)
+	}
+
+	category := bond.Category
+	if category == "" {
+		category = strings.ToLower(bond.RegistrationKind)
+	}
+	if category == "" {
+		category = "music"
+	}
+	ip := &risk.IPMetadata{
+		Category:       category,
+		CreatorAddress: bond.Issuer,
+		CreatedAt:      bond.CreatedAt,
+		Views:          1000,
+		Likes:          100,
+		Tags:           []string{"original", "popular"},
+		ContentHash:    bond.IPNFTId,
+		Registration:   bondRegistration(bond),
+		License:        storedLicenseTerms(agreement),
+		Counterparties: counterparties,
+	}
+	return s.assessRisk(ctx, "", bond.IPNFTId, ip)
+}
+
+// bondRegistration returns the registration recorded on a bond, or nil
+func bondRegistration(bond *models.Bond) *risk.RegisteredIP {
+	if bond.RegistrationNumber == "" {
+		return nil
+	}
+	reg := &risk.RegisteredIP{
+		Kind:         bond.RegistrationKind,
+		Number:       bond.RegistrationNumber,
+		Jurisdiction: bond.RegistrationJurisdiction,
+		Verified:     bond.RegistrationVerified,
+	}
+	if bond.RegistrationExpiresAt != nil {
+		reg.ExpiresAt = *bond.RegistrationExpiresAt
+	}
+	return reg
+}
+
+// storedLicenseTerms returns the assessed terms of a stored agreement
+func storedLicenseTerms(record *models.LicenseAgreement) *risk.LicenseTerms {
+	if record == nil {
+		return nil
+	}
+	terms := &risk.LicenseTerms{Exclusive: record.Exclusive}
+	if record.EndsAt != nil {
+		terms.EndsAt = *record.EndsAt
+	}
+	for _, t := range strings.Split(record.Territories, ",") {
+		if t == license.Worldwide {
+			terms.Worldwide = true
+		}
+	}
+	return terms
+}
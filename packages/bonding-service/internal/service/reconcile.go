@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/reconcile"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// onChainStatuses maps the contract's BondStatus enum to stored statuses
+var onChainStatuses = []string{"ACTIVE", "MATURED", "DEFAULTED"}
+
+// SetReconciler exposes the reconciliation job through the admin RPC
+func (s *BondingServiceServer) SetReconciler(reconciler *reconcile.Reconciler) {
+	s.reconciler = reconciler
+}
+
+// OnChainBond reads a bond from its contract for reconciliation
+func (s *BondingServiceServer) OnChainBond(ctx context.Context, bond *models.Bond) (*reconcile.BondState, error) {
+	contract, bondID, err := s.reconcileContract(bond)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	info, err := contract.GetBondInfo(ctx, bondID)
+	if err != nil {
+		return nil, err
+	}
+
+	code := info["status"].(uint8)
+	state := &reconcile.BondState{
+		TotalValue:   parseBigInt(info["totalValue"].(string)),
+		TotalRevenue: parseBigInt(info["totalRevenue"].(string)),
+		Status:       fmt.Sprintf("UNKNOWN(%d)", code),
+	}
+	if int(code) < len(onChainStatuses) {
+		state.Status = onChainStatuses[code]
+	}
+	return state, nil
+}
+
+// OnChainTranche reads a tranche from its contract for reconciliation
+func (s *BondingServiceServer) OnChainTranche(ctx context.Context, bond *models.Bond, trancheID int) (*reconcile.TrancheState, error) {
+	contract, bondID, err := s.reconcileContract(bond)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := chainContext(ctx)
+	defer cancel()
+	info, err := contract.GetTrancheInfo(ctx, bondID, uint8(trancheID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &reconcile.TrancheState{
+		Allocation:    parseBigInt(info["allocation"].(string)),
+		APY:           parseBigInt(info["apy"].(string)),
+		TotalInvested: parseBigInt(info["totalInvested"].(string)),
+		InvestorCount: info["investorCount"].(int64),
+	}, nil
+}
+
+// reconcileContract returns the contract a bond was issued on. Reads bypass
+// the view cache so reconciliation always sees current chain state.
+func (s *BondingServiceServer) reconcileContract(bond *models.Bond) (*blockchain.IPBondContract, *big.Int, error) {
+	bondID, ok := new(big.Int).SetString(bond.BondID, 10)
+	if !ok {
+		return nil, nil, reconcile.ErrNotOnChain
+	}
+	chain, err := s.chainConfig(bond.Chain)
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contract, bondID, nil
+}
+
+// GetReconciliationReport returns the last reconciliation of stored bond
+// aggregates against the chain, or runs one now
+func (s *BondingServiceServer) GetReconciliationReport(
+	ctx context.Context,
+	req *pb.GetReconciliationReportRequest,
+) (*pb.ReconciliationReport, error) {
+	if s.reconciler == nil {
+		return nil, status.Error(codes.Unimplemented, "reconciliation is not configured")
+	}
+
+	report := s.reconciler.Last()
+	if req.Run {
+		var err error
+		if report, err = s.reconciler.Run(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if report == nil {
+		return nil, status.Error(codes.NotFound, "no reconciliation has run yet")
+	}
+
+	discrepancies := make([]*pb.Discrepancy, 0, len(report.Discrepancies))
+	for _, d := range report.Discrepancies {
+		if req.BondId != "" && d.BondID != req.BondId {
+			continue
+		}
+		discrepancies = append(discrepancies, &pb.Discrepancy{
+			BondId:    d.BondID,
+			Chain:     d.Chain,
+			TrancheId: int32(d.TrancheID),
+			Field:     d.Field,
+			Stored:    d.Stored,
+			OnChain:   d.OnChain,
+			Corrected: d.Corrected,
+		})
+	}
+
+	return &pb.ReconciliationReport{
+		StartedAt:     report.StartedAt.Unix(),
+		FinishedAt:    report.FinishedAt.Unix(),
+		BondsChecked:  int32(report.BondsChecked),
+		BondsFailed:   int32(report.BondsFailed),
+		AutoCorrect:   report.AutoCorrect,
+		Discrepancies: discrepancies,
+	}, nil
+}
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// earlyRedemptionPenaltyBps is the penalty charged on a redemption made right
+	// after issuance. It decays linearly to zero at maturity.
+	earlyRedemptionPenaltyBps = 200
+
+	// redemptionApprovalThresholdBps is the share of a tranche's invested total
+	// above which a redemption needs approval from the issuer
+	redemptionApprovalThresholdBps = 1000
+)
+
+// RequestEarlyRedemption redeems part or all of an investor's position before maturity.
+// Small redemptions execute immediately; large ones wait for issuer approval.
+func (s *BondingServiceServer) RequestEarlyRedemption(
+	ctx context.Context,
+	req *pb.RequestEarlyRedemptionRequest,
+) (*pb.RedemptionResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	if req.BondId == "" || req.InvestorAddress == "" {
+		return nil, fmt.Errorf("bond_id and investor_address are required")
+	}
+	if err := checkPositionOwner(ctx, req.InvestorAddress); err != nil {
+		return nil, err
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	if bond.Status != models.BondActive && bond.Status != models.BondPaused {
+		return nil, fmt.Errorf("bond is not active (status: %s)", bond.Status)
+	}
+
+	now := time.Now()
+	if !now.Before(bond.MaturityDate) {
+		return nil, fmt.Errorf("bond has matured, early redemption is not available")
+	}
+
+	var tranche models.Tranche
+	if err := s.db.WithContext(ctx).Where("bond_id = ? AND tranche_id = ?", req.BondId, req.TrancheId).First(&tranche).Error; err != nil {
+		return nil, fmt.Errorf("tranche not found: %w", err)
+	}
+
+	// 1. Determine the position being redeemed
+	position, err := s.investorPosition(s.db.WithContext(ctx), req.BondId, int(req.TrancheId), req.InvestorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if position.Sign() == 0 {
+		return nil, fmt.Errorf("investor has no position in this tranche")
+	}
+
+	amount := position
+	if req.Amount != "" {
+		var ok bool
+		amount, ok = new(big.Int).SetString(req.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid redemption amount")
+		}
+		if amount.Cmp(position) > 0 {
+			return nil, fmt.Errorf("redemption amount %s exceeds position %s", amount, position)
+		}
+	}
+
+	// 2. Apply the early redemption penalty
+	penalty := calculateRedemptionPenalty(amount, bond.CreatedAt, bond.MaturityDate, now)
+	payout := new(big.Int).Sub(amount, penalty)
+
+	redemption := &models.Redemption{
+		BondID:      req.BondId,
+		TrancheID:   int(req.TrancheId),
+		Investor:    common.HexToAddress(req.InvestorAddress).Hex(),
+		Amount:      amount.String(),
+		Penalty:     penalty.String(),
+		Payout:      payout.String(),
+		Status:      models.RedemptionPendingApproval,
+		RequestedAt: now,
+	}
+
+	// 3. Large redemptions wait for the issuer
+	if requiresRedemptionApproval(amount, parseBigInt(tranche.TotalInvested)) {
+		if err := s.db.WithContext(ctx).Create(redemption).Error; err != nil {
+			return nil, fmt.Errorf("failed to save redemption: %w", err)
+		}
+		return s.redemptionResponse(ctx, redemption, true), nil
+	}
+
+	if err := s.executeRedemption(ctx, redemption); err != nil {
+		return nil, err
+	}
+
+	return s.redemptionResponse(ctx, redemption, false), nil
+}
+
+// ApproveRedemption lets the bond issuer approve or reject a pending redemption
+func (s *BondingServiceServer) ApproveRedemption(
+	ctx context.Context,
+	req *pb.ApproveRedemptionRequest,
+) (*pb.RedemptionResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.ApproverAddress); err != nil {
+		return nil, err
+	}
+
+	var redemption models.Redemption
+	if err := s.db.WithContext(ctx).First(&redemption, req.RedemptionId).Error; err != nil {
+		return nil, fmt.Errorf("redemption not found: %w", err)
+	}
+	if redemption.Status != models.RedemptionPendingApproval {
+		return nil, fmt.Errorf("redemption is not pending approval (status: %s)", redemption.Status)
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", redemption.BondID).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	approver, err := checkBondIssuer(ctx, &bond, req.ApproverAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	redemption.Approver = approver
+	redemption.Reason = req.Reason
+
+	if !req.Approve {
+		now := time.Now()
+		redemption.Status = models.RedemptionRejected
+		redemption.ResolvedAt = &now
+		if err := s.db.WithContext(ctx).Save(&redemption).Error; err != nil {
+			return nil, fmt.Errorf("failed to update redemption: %w", err)
+		}
+		return s.redemptionResponse(ctx, &redemption, true), nil
+	}
+
+	if err := s.restoreArchived(ctx, &bond); err != nil {
+		return nil, err
+	}
+	if err := s.executeRedemption(ctx, &redemption); err != nil {
+		return nil, err
+	}
+
+	return s.redemptionResponse(ctx, &redemption, true), nil
+}
+
+// executeRedemption submits the redemption on-chain and reduces the investor's
+// Investment rows and the tranche total accordingly. An approved redemption is
+// claimed first so a concurrent approval can't send it twice. The position and
+// tranche rows are locked and the position re-checked before anything is sent,
+// and nothing is marked completed until the transaction has been sent.
+func (s *BondingServiceServer) executeRedemption(ctx context.Context, redemption *models.Redemption) error {
+	chain, err := s.bondChain(ctx, redemption.BondID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return err
+	}
+	bondID, ok := new(big.Int).SetString(redemption.BondID, 10)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", redemption.BondID)
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return err
+	}
+
+	claimed := redemption.ID != 0
+	if claimed {
+		claim := s.db.WithContext(ctx).Model(redemption).
+			Where("status = ?", models.RedemptionPendingApproval).
+			Update("status", models.RedemptionExecuting)
+		if claim.Error != nil {
+			return fmt.Errorf("failed to claim redemption: %w", claim.Error)
+		}
+		if claim.RowsAffected == 0 {
+			return status.Errorf(codes.FailedPrecondition, "redemption %d is no longer pending approval", redemption.ID)
+		}
+	}
+
+	amount := parseBigInt(redemption.Amount)
+	var txHash string
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Re-check the position under lock; it may have changed since the request
+		position, err := s.investorPosition(tx.Clauses(clause.Locking{Strength: "UPDATE"}),
+			redemption.BondID, redemption.TrancheID, redemption.Investor)
+		if err != nil {
+			return err
+		}
+		if amount.Cmp(position) > 0 {
+			return status.Errorf(codes.FailedPrecondition, "redemption amount %s exceeds position %s", amount, position)
+		}
+
+		var tranche models.Tranche
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("bond_id = ? AND tranche_id = ?", redemption.BondID, redemption.TrancheID).
+			First(&tranche).Error; err != nil {
+			return fmt.Errorf("tranche not found: %w", err)
+		}
+
+		chainCtx, cancel := chainContext(ctx)
+		defer cancel()
+		sent, err := contract.Redeem(chainCtx, bondID, uint8(redemption.TrancheID),
+			common.HexToAddress(redemption.Investor), amount, parseBigInt(redemption.Payout))
+		if err != nil {
+			return fmt.Errorf("failed to redeem on-chain: %w", err)
+		}
+		txHash = sent.Hash().Hex()
+		s.transactionSent(ctx, chain.Name, txHash, txmonitor.PurposeRedeem, redemption.BondID)
+
+		if err := reducePosition(tx, redemption.BondID, redemption.TrancheID, redemption.Investor, amount); err != nil {
+			return err
+		}
+
+		totalInvested := new(big.Int).Sub(parseBigInt(tranche.TotalInvested), amount)
+		if totalInvested.Sign() < 0 {
+			totalInvested.SetInt64(0)
+		}
+		if err := tx.Model(&tranche).Update("total_invested", totalInvested.String()).Error; err != nil {
+			return fmt.Errorf("failed to update tranche: %w", err)
+		}
+
+		now := time.Now()
+		redemption.Status = models.RedemptionCompleted
+		redemption.TxHash = txHash
+		redemption.ResolvedAt = &now
+		if err := tx.Save(redemption).Error; err != nil {
+			return fmt.Errorf("failed to save redemption: %w", err)
+		}
+		return postRedemption(tx, redemption)
+	})
+	if err != nil && txHash != "" {
+		log.Printf("ALERT: redemption of %s from bond %s was sent in %s but not recorded: %v",
+			redemption.Amount, redemption.BondID, txHash, err)
+		return err
+	}
+	if err != nil {
+		if claimed {
+			redemption.Status = models.RedemptionPendingApproval
+			if dbErr := s.db.WithContext(ctx).Model(redemption).Update("status", models.RedemptionPendingApproval).Error; dbErr != nil {
+				log.Printf("Failed to release redemption %d: %v", redemption.ID, dbErr)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// investorPosition sums an investor's outstanding investments in a tranche.
+// Rows written before addresses were stored checksummed may be lowercase, so
+// the investor is matched case-insensitively.
+func (s *BondingServiceServer) investorPosition(db *gorm.DB, bondID string, trancheID int, investor string) (*big.Int, error) {
+	var investments []models.Investment
+	if err := db.Where("bond_id = ? AND tranche_id = ? AND LOWER(investor) = LOWER(?)", bondID, trancheID, investor).
+		Find(&investments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load investments: %w", err)
+	}
+
+	position := big.NewInt(0)
+	for _, inv := range investments {
+		position.Add(position, parseBigInt(inv.Amount))
+	}
+	return position, nil
+}
+
+// reducePosition removes amount from an investor's Investment rows, oldest first.
+// Rows that are fully consumed are deleted; the last one is reduced in place.
+func reducePosition(tx *gorm.DB, bondID string, trancheID int, investor string, amount *big.Int) error {
+	var investments []models.Investment
+	if err := tx.Where("bond_id = ? AND tranche_id = ? AND LOWER(investor) = LOWER(?)", bondID, trancheID, investor).
+		Order("timestamp ASC").
+		Find(&investments).Error; err != nil {
+		return fmt.Errorf("failed to load investments: %w", err)
+	}
+
+	remaining := new(big.Int).Set(amount)
+	for _, inv := range investments {
+		if remaining.Sign() == 0 {
+			break
+		}
+
+		invAmount := parseBigInt(inv.Amount)
+		if invAmount.Cmp(remaining) <= 0 {
+			remaining.Sub(remaining, invAmount)
+			if err := tx.Where("bond_id = ?", bondID).Delete(&inv).Error; err != nil {
+				return fmt.Errorf("failed to remove investment: %w", err)
+			}
+			continue
+		}
+
+		invAmount.Sub(invAmount, remaining)
+		remaining.SetInt64(0)
+		if err := tx.Model(&inv).Where("bond_id = ?", bondID).Update("amount", invAmount.String()).Error; err != nil {
+			return fmt.Errorf("failed to update investment: %w", err)
+		}
+	}
+
+	if remaining.Sign() > 0 {
+		return fmt.Errorf("position is short by %s", remaining)
+	}
+	return nil
+}
+
+// calculateRedemptionPenalty scales the early redemption penalty by the share
+// of the bond term that is still remaining
+func calculateRedemptionPenalty(amount *big.Int, issuedAt, maturity, now time.Time) *big.Int {
+	term := int64(maturity.Sub(issuedAt).Seconds())
+	remaining := int64(maturity.Sub(now).Seconds())
+	if term <= 0 || remaining <= 0 {
+		return big.NewInt(0)
+	}
+	if remaining > term {
+		remaining = term
+	}
+
+	penalty := new(big.Int).Mul(amount, big.NewInt(earlyRedemptionPenaltyBps))
+	penalty.Mul(penalty, big.NewInt(remaining))
+	penalty.Div(penalty, new(big.Int).Mul(big.NewInt(10000), big.NewInt(term)))
+	return penalty
+}
+
+// requiresRedemptionApproval reports whether a redemption is large enough to need issuer sign-off
+func requiresRedemptionApproval(amount, trancheInvested *big.Int) bool {
+	if trancheInvested.Sign() == 0 {
+		return true
+	}
+	lhs := new(big.Int).Mul(amount, big.NewInt(10000))
+	rhs := new(big.Int).Mul(trancheInvested, big.NewInt(redemptionApprovalThresholdBps))
+	return lhs.Cmp(rhs) > 0
+}
+
+func (s *BondingServiceServer) redemptionResponse(ctx context.Context, r *models.Redemption, requiresApproval bool) *pb.RedemptionResponse {
+	labels := s.lookupAddresses(ctx, r.Investor)
+	return &pb.RedemptionResponse{
+		RedemptionId:     uint64(r.ID),
+		BondId:           r.BondID,
+		TrancheId:        int32(r.TrancheID),
+		InvestorAddress:  r.Investor,
+		Amount:           r.Amount,
+		Penalty:          r.Penalty,
+		Payout:           r.Payout,
+		Status:           r.Status,
+		TxHash:           r.TxHash,
+		RequiresApproval: requiresApproval,
+		Investor:         labels.counterparty(r.Investor),
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/ipregistry"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lapsedStatuses are registry statuses of registrations that no longer protect the IP
+var lapsedStatuses = map[string]bool{
+	"LAPSED":    true,
+	"EXPIRED":   true,
+	"ABANDONED": true,
+	"CANCELLED": true,
+	"REVOKED":   true,
+}
+
+// SetIPRegistry verifies patent and trademark registrations against official registries
+func (s *BondingServiceServer) SetIPRegistry(router *ipregistry.Router) {
+	s.ipRegistry = router
+}
+
+// registeredIP validates a request's registration and, when a registry
+// serves its jurisdiction, replaces the claimed expiry with the registry's.
+// Requests without a registration return nil.
+func (s *BondingServiceServer) registeredIP(ctx context.Context, reg *pb.RegisteredIP) (*risk.RegisteredIP, error) {
+	if reg == nil || strings.TrimSpace(reg.Number) == "" {
+		return nil, nil
+	}
+
+	kind := strings.ToUpper(strings.TrimSpace(reg.Kind))
+	if kind != ipregistry.KindPatent && kind != ipregistry.KindTrademark {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid registration kind: %s", reg.Kind)
+	}
+	jurisdiction := ipregistry.NormalizeJurisdiction(reg.Jurisdiction)
+	if jurisdiction == "" {
+		return nil, status.Error(codes.InvalidArgument, "registration jurisdiction is required")
+	}
+
+	result := &risk.RegisteredIP{
+		Kind:         kind,
+		Number:       ipregistry.NormalizeNumber(reg.Number),
+		Jurisdiction: jurisdiction,
+	}
+	if reg.ExpiresAt > 0 {
+		result.ExpiresAt = time.Unix(reg.ExpiresAt, 0)
+	}
+	if s.ipRegistry == nil {
+		return result, nil
+	}
+
+	record, err := s.ipRegistry.Lookup(ctx, kind, jurisdiction, reg.Number)
+	switch {
+	case errors.Is(err, ipregistry.ErrUnsupportedJurisdiction):
+		return result, nil // Kept as an unverified claim
+	case errors.Is(err, ipregistry.ErrNotFound):
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s not found in the %s registry", strings.ToLower(kind), result.Number, jurisdiction)
+	case err != nil:
+		return nil, status.Errorf(codes.Unavailable, "registry lookup failed: %v", err)
+	}
+	if lapsedStatuses[strings.ToUpper(record.Status)] {
+		return nil, status.Errorf(codes.FailedPrecondition, "registration %s is %s", result.Number, strings.ToLower(record.Status))
+	}
+
+	result.ExpiresAt = record.ExpiresAt
+	result.Verified = true
+	return result, nil
+}
+
+// applyRegistration records a registration on a bond
+func applyRegistration(bond *models.Bond, reg *risk.RegisteredIP) {
+	if reg == nil {
+		return
+	}
+	bond.RegistrationKind = reg.Kind
+	bond.RegistrationNumber = reg.Number
+	bond.RegistrationJurisdiction = reg.Jurisdiction
+	bond.RegistrationVerified = reg.Verified
+	if !reg.ExpiresAt.IsZero() {
+		expiresAt := reg.ExpiresAt
+		bond.RegistrationExpiresAt = &expiresAt
+	}
+}
+
+// registrationInfo returns a bond's registration, or nil for unregistered IP
+func registrationInfo(bond *models.Bond) *pb.RegisteredIP {
+	if bond.RegistrationNumber == "" {
+		return nil
+	}
+	info := &pb.RegisteredIP{
+		Kind:         bond.RegistrationKind,
+		Number:       bond.RegistrationNumber,
+		Jurisdiction: bond.RegistrationJurisdiction,
+		Verified:     bond.RegistrationVerified,
+	}
+	if bond.RegistrationExpiresAt != nil {
+		info.ExpiresAt = bond.RegistrationExpiresAt.Unix()
+	}
+	return info
+}
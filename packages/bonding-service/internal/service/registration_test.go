@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/ipregistry"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegisteredIP(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/patent/US1":
+			w.Write([]byte(`{"status":"GRANTED","expires_at":"2040-06-01T00:00:00Z"}`))
+		case "/patent/US2":
+			w.Write([]byte(`{"status":"LAPSED"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer registry.Close()
+
+	router := ipregistry.NewRouter()
+	router.Register("US", ipregistry.NewHTTPRegistry(registry.URL, ""))
+	s := &BondingServiceServer{ipRegistry: router}
+	claimed := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		reg          *pb.RegisteredIP
+		wantCode     codes.Code
+		wantVerified bool
+		wantExpiry   time.Time
+	}{
+		{"none", nil, codes.OK, false, time.Time{}},
+		{"verified", &pb.RegisteredIP{Kind: "patent", Number: "US-1", Jurisdiction: "us", ExpiresAt: claimed.Unix()}, codes.OK, true, time.Date(2040, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"no registry for jurisdiction", &pb.RegisteredIP{Kind: "TRADEMARK", Number: "EU1", Jurisdiction: "EU", ExpiresAt: claimed.Unix()}, codes.OK, false, claimed},
+		{"lapsed", &pb.RegisteredIP{Kind: "PATENT", Number: "US2", Jurisdiction: "US"}, codes.FailedPrecondition, false, time.Time{}},
+		{"not found", &pb.RegisteredIP{Kind: "PATENT", Number: "US3", Jurisdiction: "US"}, codes.InvalidArgument, false, time.Time{}},
+		{"bad kind", &pb.RegisteredIP{Kind: "COPYRIGHT", Number: "1", Jurisdiction: "US"}, codes.InvalidArgument, false, time.Time{}},
+		{"missing jurisdiction", &pb.RegisteredIP{Kind: "PATENT", Number: "1"}, codes.InvalidArgument, false, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.registeredIP(context.Background(), tt.reg)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("registeredIP() error = %v, want %v", err, tt.wantCode)
+			}
+			if err != nil || tt.reg == nil {
+				return
+			}
+			if got.Verified != tt.wantVerified || !got.ExpiresAt.Equal(tt.wantExpiry) {
+				t.Errorf("registeredIP() = verified %v expiry %s, want %v %s", got.Verified, got.ExpiresAt, tt.wantVerified, tt.wantExpiry)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/models"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// txHashColumns lists the records that reference an operator transaction by
+// hash, and whether their table is partitioned by bond
+var txHashColumns = []struct {
+	model  interface{}
+	column string
+	byBond bool
+}{
+	{&models.Bond{}, "tx_hash", false},
+	{&models.Investment{}, "tx_hash", true},
+	{&models.RevenueDistribution{}, "tx_hash", true},
+	{&models.Redemption{}, "tx_hash", false},
+	{&models.InvestmentTransfer{}, "tx_hash", false},
+	{&models.QueuedDistribution{}, "tx_hash", false},
+	{&models.Trade{}, "tx_hash", false},
+	{&models.LedgerEntry{}, "reference", false},
+}
+
+// SpeedUpTransaction resubmits a stuck operator transaction with the same
+// nonce and a higher fee. Records referencing the original hash are moved to
+// the replacement.
+func (s *BondingServiceServer) SpeedUpTransaction(
+	ctx context.Context,
+	req *pb.ReplaceTransactionRequest,
+) (*pb.ReplaceTransactionResponse, error) {
+	return s.replaceTransaction(ctx, req, false)
+}
+
+// CancelTransaction replaces a stuck operator transaction with a zero-value
+// self transfer at a higher fee. Records of the cancelled call are left for
+// the operator to reconcile.
+func (s *BondingServiceServer) CancelTransaction(
+	ctx context.Context,
+	req *pb.ReplaceTransactionRequest,
+) (*pb.ReplaceTransactionResponse, error) {
+	return s.replaceTransaction(ctx, req, true)
+}
+
+func (s *BondingServiceServer) replaceTransaction(
+	ctx context.Context,
+	req *pb.ReplaceTransactionRequest,
+	cancel bool,
+) (*pb.ReplaceTransactionResponse, error) {
+	if hash, err := hexutil.Decode(req.TxHash); err != nil || len(hash) != common.HashLength {
+		return nil, status.Error(codes.InvalidArgument, "tx_hash must be a 32-byte hex hash")
+	}
+	if req.FeeBumpBps < 0 {
+		return nil, status.Error(codes.InvalidArgument, "fee_bump_bps must not be negative")
+	}
+
+	chain, err := s.chainConfig(req.Chain)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return nil, err
+	}
+
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract instance: %w", err)
+	}
+	opts := blockchain.ReplacementOptions{BumpBps: int64(req.FeeBumpBps)}
+	if chain.Gas.MaxGasPrice != "" {
+		opts.MaxFee = parseBigInt(chain.Gas.MaxGasPrice)
+	}
+
+	callCtx, cancelCall := chainContext(ctx)
+	defer cancelCall()
+
+	original := common.HexToHash(req.TxHash)
+	var replacement *types.Transaction
+	if cancel {
+		replacement, err = contract.CancelTransaction(callCtx, original, opts)
+	} else {
+		replacement, err = contract.SpeedUpTransaction(callCtx, original, opts)
+	}
+	switch {
+	case errors.Is(err, blockchain.ErrNotPending), errors.Is(err, blockchain.ErrReplacementFeeTooHigh):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, blockchain.ErrNotOperatorTransaction):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return nil, err
+	}
+	s.contractWritten(ctx, chain.Name)
+	if s.txMonitor != nil {
+		s.txMonitor.Replace(ctx, original, replacement.Hash(), chain.Name)
+	}
+
+	action := "Sped up"
+	if cancel {
+		action = "Cancelled"
+	} else if err := s.moveTxHash(ctx, original.Hex(), replacement.Hash().Hex()); err != nil {
+		log.Printf("Failed to update records for replaced transaction %s: %v", original.Hex(), err)
+	}
+	log.Printf("%s transaction %s on %s with %s (nonce %d)", action, original.Hex(), chain.Name, replacement.Hash().Hex(), replacement.Nonce())
+
+	resp := &pb.ReplaceTransactionResponse{
+		OriginalTxHash:    original.Hex(),
+		ReplacementTxHash: replacement.Hash().Hex(),
+		Nonce:             replacement.Nonce(),
+	}
+	if replacement.Type() == types.LegacyTxType {
+		resp.GasPrice = replacement.GasPrice().String()
+	} else {
+		resp.MaxFeePerGas = replacement.GasFeeCap().String()
+		resp.MaxPriorityFeePerGas = replacement.GasTipCap().String()
+	}
+	return resp, nil
+}
+
+// moveTxHash points every record of a sped-up transaction at its replacement.
+// Partitioned tables are searched within the bond the transaction journal
+// names, or in full for a transaction it has no bond for.
+func (s *BondingServiceServer) moveTxHash(ctx context.Context, from, to string) error {
+	var bondIDs []string
+	if err := s.db.WithContext(ctx).Model(&models.TransactionRecord{}).
+		Where("tx_hash = ? AND bond_id <> ''", from).
+		Pluck("bond_id", &bondIDs).Error; err != nil {
+		return fmt.Errorf("failed to look up transaction %s: %w", from, err)
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, c := range txHashColumns {
+			query := tx.Model(c.model).Where(c.column+" = ?", from)
+			if c.byBond && len(bondIDs) > 0 {
+				query = query.Where("bond_id = ?", bondIDs[0])
+			}
+			if err := query.Update(c.column, to).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
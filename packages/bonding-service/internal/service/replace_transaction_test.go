@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestMoveTxHashScopesPartitionedTables(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT "bond_id" FROM "transaction_records" WHERE tx_hash = \$1`).
+		WithArgs("0xold").
+		WillReturnRows(sqlmock.NewRows([]string{"bond_id"}).AddRow("7"))
+	mock.ExpectBegin()
+	for _, c := range txHashColumns {
+		table := db.Model(c.model).Statement
+		if err := table.Parse(c.model); err != nil {
+			t.Fatal(err)
+		}
+		// Only tables partitioned by bond are scoped to it
+		query := `UPDATE "` + table.Table + `" SET .* WHERE ` + c.column + ` = \$\d+`
+		if c.byBond {
+			query += ` AND bond_id = \$\d+`
+		}
+		query += `( AND "` + table.Table + `"."deleted_at" IS NULL)?$`
+		mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	s := &BondingServiceServer{db: db}
+	if err := s.moveTxHash(context.Background(), "0xold", "0xnew"); err != nil {
+		t.Fatalf("moveTxHash() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rbac"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/revenue"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxReviewedRevenueEvents caps the events one ReviewRevenueEvents call reviews
+const MaxReviewedRevenueEvents = 500
+
+// SetRevenue makes revenue events importable and reviewable through the RPCs
+func (s *BondingServiceServer) SetRevenue(store *revenue.Store) {
+	s.revenue = store
+}
+
+// ImportRevenueCSV records the rows of a royalty statement as revenue
+// events pending review. A statement with a bad row records nothing;
+// rows already imported under the same name are skipped, so a statement can
+// be re-imported after fixing it.
+func (s *BondingServiceServer) ImportRevenueCSV(
+	ctx context.Context,
+	req *pb.ImportRevenueCSVRequest,
+) (*pb.ImportRevenueCSVResponse, error) {
+	if s.revenue == nil {
+		return nil, status.Error(codes.Unimplemented, "revenue ingestion is not enabled")
+	}
+	events, err := revenue.ParseCSV(bytes.NewReader(req.Csv))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	origin := revenue.Origin{
+		Source:    models.RevenueSourceCSV,
+		Connector: req.Connector,
+		TenantID:  tenant.FromContext(ctx),
+	}
+	if principal, ok := rbac.FromContext(ctx); ok {
+		origin.RecordedBy = principal.ID
+	}
+	recorded, err := s.revenue.RecordAll(ctx, origin, events)
+	if errors.Is(err, revenue.ErrInvalidEvent) || errors.Is(err, revenue.ErrUnknownBond) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportRevenueCSVResponse{
+		Recorded:   int32(recorded),
+		Duplicates: int32(len(events) - recorded),
+	}, nil
+}
+
+// ReviewRevenueEvents verifies or rejects pending revenue events. Verified
+// events count towards the next scheduled distribution of their bond.
+func (s *BondingServiceServer) ReviewRevenueEvents(
+	ctx context.Context,
+	req *pb.ReviewRevenueEventsRequest,
+) (*pb.ReviewRevenueEventsResponse, error) {
+	if s.revenue == nil {
+		return nil, status.Error(codes.Unimplemented, "revenue ingestion is not enabled")
+	}
+	verified := req.Decision == models.RevenueVerified
+	if !verified && req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to reject revenue events")
+	}
+	ids := make([]uint, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = uint(id)
+	}
+
+	reviewer := ""
+	if principal, ok := rbac.FromContext(ctx); ok {
+		reviewer = principal.ID
+	}
+	reviewed, err := s.revenue.Review(ctx, tenant.FromContext(ctx), ids, verified, reviewer, req.Reason)
+	if errors.Is(err, revenue.ErrSelfReview) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReviewRevenueEventsResponse{Reviewed: int32(reviewed)}, nil
+}
+
+// ListRevenueEvents pages through the tenant's revenue events, most recently
+// occurred first, optionally narrowed to a bond, status and source
+func (s *BondingServiceServer) ListRevenueEvents(
+	ctx context.Context,
+	req *pb.ListRevenueEventsRequest,
+) (*pb.ListRevenueEventsResponse, error) {
+	filter := repository.RevenueEventFilter{
+		TenantID: tenant.FromContext(ctx),
+		BondID:   req.BondId,
+		Status:   req.Status,
+		Source:   req.Source,
+		Limit:    int(req.PageSize),
+	}
+	if req.PageToken != "" {
+		after, err := repository.ParseCursor(req.PageToken)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	var events []models.RevenueEvent
+	var next *repository.Cursor
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		events, next, err = s.bonds.ListRevenueEvents(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListRevenueEventsResponse{}
+	for i := range events {
+		response.Events = append(response.Events, revenueEventInfo(&events[i]))
+	}
+	if next != nil {
+		response.NextPageToken = next.String()
+	}
+	return response, nil
+}
+
+func revenueEventInfo(ev *models.RevenueEvent) *pb.RevenueEvent {
+	info := &pb.RevenueEvent{
+		Id:           uint64(ev.ID),
+		BondId:       ev.BondID,
+		Source:       ev.Source,
+		Connector:    ev.Connector,
+		ExternalId:   ev.ExternalID,
+		Amount:       ev.Amount,
+		OccurredAt:   ev.OccurredAt.Unix(),
+		Reference:    ev.Reference,
+		Status:       ev.Status,
+		RecordedBy:   ev.RecordedBy,
+		ReviewedBy:   ev.ReviewedBy,
+		RejectReason: ev.RejectReason,
+		TxHash:       ev.TxHash,
+	}
+	if ev.ReviewedAt != nil {
+		info.ReviewedAt = ev.ReviewedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRevenueHistory pages through a bond's revenue distributions, newest
+// first, with how each was split across the tranches, so investors can check
+// the cash flows against the DistributeRevenue transactions on-chain
+func (s *BondingServiceServer) GetRevenueHistory(
+	ctx context.Context,
+	req *pb.GetRevenueHistoryRequest,
+) (*pb.GetRevenueHistoryResponse, error) {
+	filter := repository.DistributionFilter{
+		BondID:          req.BondId,
+		IncludeArchived: req.IncludeArchived,
+		Limit:           int(req.PageSize),
+	}
+	if req.PageToken != "" {
+		after, err := repository.ParseCursor(req.PageToken)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	var distributions []models.RevenueDistribution
+	var next *repository.Cursor
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		bond, err := s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		if err := checkArchived(bond, req.IncludeArchived); err != nil {
+			return err
+		}
+		distributions, next, err = s.bonds.ListDistributions(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetRevenueHistoryResponse{BondId: req.BondId}
+	for i := range distributions {
+		response.Distributions = append(response.Distributions, distributionInfo(&distributions[i]))
+	}
+	if next != nil {
+		response.NextPageToken = next.String()
+	}
+	return response, nil
+}
+
+func distributionInfo(d *models.RevenueDistribution) *pb.RevenueDistributionInfo {
+	info := &pb.RevenueDistributionInfo{
+		Id:        uint64(d.ID),
+		Amount:    d.Amount,
+		Shortfall: d.Shortfall,
+		TxHash:    d.TxHash,
+		Timestamp: d.Timestamp.Unix(),
+	}
+	if d.Period != nil {
+		info.Period = d.Period.Unix()
+	}
+	for _, t := range d.Tranches {
+		paid := new(big.Int).Add(parseBigInt(t.ArrearsPaid), parseBigInt(t.CouponPaid))
+		paid.Add(paid, parseBigInt(t.Residual))
+		info.Tranches = append(info.Tranches, &pb.TrancheSplit{
+			TrancheId:         int32(t.TrancheID),
+			CouponDue:         t.CouponDue,
+			ArrearsPaid:       t.ArrearsPaid,
+			CouponPaid:        t.CouponPaid,
+			Residual:          t.Residual,
+			AmountDistributed: paid.String(),
+			Shortfall:         t.Shortfall,
+			Arrears:           t.ArrearsAfter,
+		})
+	}
+	return info
+}
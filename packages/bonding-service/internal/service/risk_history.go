@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRiskAssessmentHistory lists an IP-NFT's stored risk assessments, from
+// issuance and every reassessment since, newest first
+func (s *BondingServiceServer) GetRiskAssessmentHistory(
+	ctx context.Context,
+	req *pb.GetRiskAssessmentHistoryRequest,
+) (*pb.GetRiskAssessmentHistoryResponse, error) {
+	if req.IpnftId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ipnft_id is required")
+	}
+
+	history, err := repository.AssessmentHistory(ctx, s.db, req.IpnftId, int(req.PageSize), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetRiskAssessmentHistoryResponse{IpnftId: req.IpnftId}
+	for i := range history {
+		response.Assessments = append(response.Assessments, s.riskAssessmentInfo(&history[i]))
+	}
+	return response, nil
+}
+
+// ListRiskAssessments pages through the risk assessments of an IP-NFT, or
+// of the IP-NFT behind a bond, newest first, so analysts can follow how its
+// valuation moved over a time window
+func (s *BondingServiceServer) ListRiskAssessments(
+	ctx context.Context,
+	req *pb.ListRiskAssessmentsRequest,
+) (*pb.ListRiskAssessmentsResponse, error) {
+	if (req.IpnftId == "") == (req.BondId == "") {
+		return nil, status.Error(codes.InvalidArgument, "set exactly one of ipnft_id or bond_id")
+	}
+	if req.Since > 0 && req.Until > 0 && req.Until <= req.Since {
+		return nil, status.Error(codes.InvalidArgument, "until must be after since")
+	}
+
+	filter := repository.AssessmentFilter{
+		IPNFTId: req.IpnftId,
+		Limit:   int(req.PageSize),
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		after, err := repository.ParseCursor(req.PageToken)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	var assessments []models.RiskAssessment
+	var next *repository.Cursor
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		if req.BondId != "" {
+			bond, err := s.bonds.GetBond(ctx, req.BondId)
+			if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+				return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+			}
+			filter.IPNFTId = bond.IPNFTId
+		}
+		var err error
+		assessments, next, err = repository.ListAssessments(ctx, s.db, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListRiskAssessmentsResponse{IpnftId: filter.IPNFTId}
+	for i := range assessments {
+		response.Assessments = append(response.Assessments, s.riskAssessmentInfo(&assessments[i]))
+	}
+	if next != nil {
+		response.NextPageToken = next.String()
+	}
+	return response, nil
+}
+
+// SetRiskTiers replaces the default mapping of assessments to risk tiers
+func (s *BondingServiceServer) SetRiskTiers(tiers *risk.Tiers) {
+	s.riskTiers = tiers
+}
+
+// riskAssessmentInfo returns the API view of a stored assessment
+func (s *BondingServiceServer) riskAssessmentInfo(a *models.RiskAssessment) *pb.RiskAssessment {
+	return &pb.RiskAssessment{
+		ValuationUsd:       a.ValuationUSD,
+		ConfidenceScore:    a.ConfidenceScore,
+		RiskRating:         a.RiskRating,
+		DefaultProbability: a.DefaultProbability,
+		RecommendedLtv:     a.RecommendedLTV,
+		RiskFactors:        s.parseRiskFactors(a.RiskFactors),
+		Model:              a.RiskModel,
+		ModelVersion:       a.RiskModelVersion,
+		AssessedAt:         a.AssessedAt.Unix(),
+		RiskTier:           s.riskTiers.Tier(a),
+	}
+}
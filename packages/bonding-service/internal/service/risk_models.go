@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knowton/bonding-service/internal/flags"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/risk"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterRiskModel makes a risk model selectable by name
+func (s *BondingServiceServer) RegisterRiskModel(name string, model risk.RiskModel) {
+	s.riskModels.Register(name, model)
+}
+
+// EnableOracleRiskModel registers the oracle-backed model as risk.OracleModel.
+// It rates risk with the same rules, and category taxonomy, as the default
+// model. client is a single OracleClient or an Aggregator over several.
+func (s *BondingServiceServer) EnableOracleRiskModel(client oracle.Valuer) {
+	s.RegisterRiskModel(risk.OracleModel, risk.NewOracleRiskModel(client, s.riskEngine))
+}
+
+// SetRiskModelRouting selects the default model and the model used for each
+// category listed in categoryModels
+func (s *BondingServiceServer) SetRiskModelRouting(defaultModel string, categoryModels map[string]string) error {
+	if defaultModel != "" {
+		if err := s.riskModels.SetDefault(defaultModel); err != nil {
+			return err
+		}
+	}
+	for category, name := range categoryModels {
+		if err := s.riskModels.SetCategoryModel(category, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListRiskModels returns the registered risk models and how they are selected
+func (s *BondingServiceServer) ListRiskModels(
+	ctx context.Context,
+	req *pb.ListRiskModelsRequest,
+) (*pb.ListRiskModelsResponse, error) {
+	resp := &pb.ListRiskModelsResponse{
+		DefaultModel:   s.riskModels.Default(),
+		CategoryModels: s.riskModels.CategoryModels(),
+	}
+	for _, name := range s.riskModels.Names() {
+		model, _ := s.riskModels.Model(name)
+		resp.Models = append(resp.Models, &pb.RiskModelInfo{
+			Name:                name,
+			Version:             model.Version(),
+			SupportedCategories: model.SupportedCategories(),
+		})
+	}
+	return resp, nil
+}
+
+// assessRisk assesses an IP-NFT with the named model, or the one routed for
+// its category when model is empty. While the oracle model is flagged off for
+// the tenant, assessments routed to it use the heuristic model instead.
+func (s *BondingServiceServer) assessRisk(
+	ctx context.Context,
+	model, ipnftID string,
+	metadata *risk.IPMetadata,
+) (*models.RiskAssessment, error) {
+	if s.riskModels == nil {
+		return s.riskEngine.AssessIPValue(ipnftID, metadata)
+	}
+
+	if selected, _, err := s.riskModels.Select(model, metadata.Category); err == nil && selected == risk.OracleModel {
+		if err := s.checkFeature(ctx, flags.OracleRiskModel); err != nil {
+			if model != "" {
+				return nil, err
+			}
+			model = risk.HeuristicModel
+		}
+	}
+
+	assessment, err := s.riskModels.Assess(ctx, model, ipnftID, metadata)
+	if errors.Is(err, risk.ErrUnknownModel) || errors.Is(err, risk.ErrUnsupportedCategory) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, oracle.ErrBudgetExceeded) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("risk assessment failed: %w", err)
+	}
+	return assessment, nil
+}
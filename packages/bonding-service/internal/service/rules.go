@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rules"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetRules enables operator-configured business rules
+func (s *BondingServiceServer) SetRules(engine *rules.Engine) {
+	s.rules = engine
+}
+
+// checkRules evaluates the business rules at a point. Violations are
+// FailedPrecondition so callers can tell them from invalid input.
+func (s *BondingServiceServer) checkRules(point string, vars map[string]interface{}) error {
+	if s.rules == nil {
+		return nil
+	}
+	return ruleError(s.rules.Check(point, vars))
+}
+
+// ruleError maps a rule violation to FailedPrecondition and passes other
+// errors through
+func ruleError(err error) error {
+	var violation *rules.ViolationError
+	if errors.As(err, &violation) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// issuanceFacts returns the rule variables of a bond issuance
+func issuanceFacts(req *pb.IssueBondRequest, chain, category string, assessment *models.RiskAssessment) map[string]interface{} {
+	tranche := func(t *pb.TrancheConfig) map[string]interface{} {
+		if t == nil {
+			return map[string]interface{}{}
+		}
+		percentage, _ := strconv.ParseFloat(t.AllocationPercentage, 64)
+		return map[string]interface{}{
+			"name":                  t.Name,
+			"priority":              int64(t.Priority),
+			"allocation_percentage": percentage,
+			"apy":                   t.Apy,
+			"risk_level":            t.RiskLevel,
+		}
+	}
+
+	return map[string]interface{}{
+		"bond": map[string]interface{}{
+			"ipnft_id":      req.IpnftId,
+			"issuer":        req.IssuerAddress,
+			"chain":         chain,
+			"category":      category,
+			"total_value":   amountFact(req.TotalValue),
+			"maturity_date": time.Unix(req.MaturityDate, 0),
+			"licensed":      req.License != nil,
+		},
+		"tranches": map[string]interface{}{
+			"senior":    tranche(req.Senior),
+			"mezzanine": tranche(req.Mezzanine),
+			"junior":    tranche(req.Junior),
+		},
+		"risk": map[string]interface{}{
+			"rating":              assessment.RiskRating,
+			"valuation_usd":       assessment.ValuationUSD,
+			"default_probability": assessment.DefaultProbability,
+			"recommended_ltv":     assessment.RecommendedLTV,
+		},
+	}
+}
+
+// bondFacts returns the rule variables of a stored bond
+func bondFacts(bond *models.Bond) map[string]interface{} {
+	return map[string]interface{}{
+		"bond_id":       bond.BondID,
+		"ipnft_id":      bond.IPNFTId,
+		"issuer":        bond.Issuer,
+		"chain":         bond.Chain,
+		"status":        bond.Status,
+		"total_value":   amountFact(bond.TotalValue),
+		"total_revenue": amountFact(bond.TotalRevenue),
+		"maturity_date": bond.MaturityDate,
+	}
+}
+
+// investmentFacts returns the rule variables of an investment
+func investmentFacts(bond *models.Bond, tranche *models.Tranche, investor string, amount *big.Int) map[string]interface{} {
+	return map[string]interface{}{
+		"bond": bondFacts(bond),
+		"tranche": map[string]interface{}{
+			"tranche_id":     int64(tranche.TrancheID),
+			"name":           tranche.Name,
+			"priority":       int64(tranche.Priority),
+			"apy":            tranche.APY,
+			"risk_level":     tranche.RiskLevel,
+			"allocation":     amountFact(tranche.Allocation),
+			"total_invested": amountFact(tranche.TotalInvested),
+		},
+		"investment": map[string]interface{}{
+			"investor": strings.ToLower(investor),
+			"amount":   bigFact(amount),
+		},
+	}
+}
+
+// distributionFacts returns the rule variables of a revenue distribution
+func distributionFacts(bond *models.Bond, revenue *big.Int, licensee string) map[string]interface{} {
+	return map[string]interface{}{
+		"bond": bondFacts(bond),
+		"distribution": map[string]interface{}{
+			"revenue":  bigFact(revenue),
+			"licensee": licensee,
+		},
+	}
+}
+
+// amountFact converts a stored decimal amount to a rule double
+func amountFact(amount string) float64 {
+	return bigFact(parseBigInt(amount))
+}
+
+func bigFact(amount *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	return f
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/rules"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckRules(t *testing.T) {
+	engine, err := rules.New([]rules.Rule{
+		{Name: "junior-premium", Point: rules.Issuance, Expression: "tranches.junior.apy >= tranches.senior.apy + 3.0"},
+		{Name: "investment-grade", Point: rules.Issuance, Expression: `risk.rating in ["AAA", "AA", "A", "BBB"] || bond.category != "software"`},
+		{Name: "senior-ticket", Point: rules.Investment, Expression: `tranche.name != "Senior" || investment.amount >= 1000.0`},
+		{Name: "active", Point: rules.Distribution, Expression: `bond.status == "ACTIVE" && distribution.revenue > 0.0`},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error = %v", err)
+	}
+	s := &BondingServiceServer{}
+	s.SetRules(engine)
+
+	req := &pb.IssueBondRequest{
+		TotalValue: "1000000",
+		Senior:     &pb.TrancheConfig{Name: "Senior", Apy: 5, AllocationPercentage: "50"},
+		Mezzanine:  &pb.TrancheConfig{Name: "Mezzanine", Apy: 8, AllocationPercentage: "30"},
+		Junior:     &pb.TrancheConfig{Name: "Junior", Apy: 7, AllocationPercentage: "20"},
+	}
+	assessment := &models.RiskAssessment{RiskRating: "BB"}
+	bond := &models.Bond{BondID: "7", Status: "ACTIVE", TotalValue: "1000000", TotalRevenue: "0"}
+	senior := &models.Tranche{TrancheID: 0, Name: "Senior", Allocation: "500000"}
+
+	tests := []struct {
+		name  string
+		point string
+		vars  map[string]interface{}
+		want  codes.Code
+	}{
+		{"music issuance with thin premium", rules.Issuance, issuanceFacts(req, "arbitrum", "music", assessment), codes.FailedPrecondition},
+		{"senior ticket too small", rules.Investment, investmentFacts(bond, senior, "0xABC", big.NewInt(500)), codes.FailedPrecondition},
+		{"senior ticket", rules.Investment, investmentFacts(bond, senior, "0xABC", big.NewInt(5000)), codes.OK},
+		{"distribution", rules.Distribution, distributionFacts(bond, big.NewInt(100), ""), codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.checkRules(tt.point, tt.vars); status.Code(err) != tt.want {
+				t.Errorf("checkRules() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// Without an engine nothing is checked
+	if err := (&BondingServiceServer{}).checkRules(rules.Issuance, nil); err != nil {
+		t.Errorf("checkRules() without rules error = %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/database"
+	"github.com/knowton/bonding-service/internal/repository"
+	"gorm.io/gorm"
+)
+
+// readSnapshot runs the reads of a multi-query RPC against one consistent
+// snapshot, so a dashboard never sees a bond's totals from before a write
+// and its detail rows from after it. Network calls don't belong in fn; they
+// would hold the transaction open.
+func (s *BondingServiceServer) readSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return repository.Snapshot(ctx, s.db, fn)
+}
+
+// readReplicaSnapshot is readSnapshot on a read replica, when any are
+// configured, for heavy reads that may lag the primary slightly. Clients
+// that need their own writes present a consistency token, which keeps the
+// read on the primary.
+func (s *BondingServiceServer) readReplicaSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return repository.Snapshot(ctx, database.Reader(ctx, s.db), fn)
+}
+
+// conn returns the database to read in ctx, the snapshot when ctx is inside
+// readSnapshot
+func (s *BondingServiceServer) conn(ctx context.Context) *gorm.DB {
+	return repository.DB(ctx, s.db)
+}
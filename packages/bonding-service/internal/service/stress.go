@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/stress"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStressLookbackMonths bounds the revenue history a stress test averages
+const maxStressLookbackMonths = 36
+
+// StressTest recomputes bond health, tranche coverage and default
+// probabilities across the tenant's active bonds under the requested shocks
+func (s *BondingServiceServer) StressTest(
+	ctx context.Context,
+	req *pb.StressTestRequest,
+) (*pb.StressTestReport, error) {
+	shocks := make([]stress.Shock, len(req.Shocks))
+	for i, shock := range req.Shocks {
+		shocks[i] = stress.Shock{
+			Name:            shock.Name,
+			Category:        s.canonicalCategory(shock.Category),
+			RevenueChange:   shock.RevenueChange,
+			ValuationChange: shock.ValuationChange,
+		}
+	}
+	if err := stress.Validate(shocks); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lookback := stress.DefaultLookback
+	if req.LookbackMonths < 0 || req.LookbackMonths > maxStressLookbackMonths {
+		return nil, status.Errorf(codes.InvalidArgument, "lookback_months must be between 1 and %d", maxStressLookbackMonths)
+	}
+	if req.LookbackMonths > 0 {
+		lookback = time.Duration(req.LookbackMonths) * stress.Period
+	}
+
+	now := time.Now()
+	var inputs []stress.Input
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		inputs, err = stress.Load(ctx, s.conn(ctx), tenant.FromContext(ctx), now, lookback, s.canonicalCategory)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load the bond book: %v", err)
+	}
+	report := stress.Run(inputs, shocks)
+
+	response := &pb.StressTestReport{
+		Healthy:                  int32(report.Healthy),
+		Stressed:                 int32(report.Stressed),
+		Distressed:               int32(report.Distressed),
+		NewlyDistressed:          int32(report.NewlyDistressed),
+		BaselineExpectedDefaults: report.BaselineExpectedDefaults,
+		ExpectedDefaults:         report.ExpectedDefaults,
+		BaselineExpectedLoss:     report.BaselineExpectedLoss.String(),
+		ExpectedLoss:             report.ExpectedLoss.String(),
+		Shortfall:                report.Shortfall.String(),
+		ComputedAt:               now.Unix(),
+	}
+	for _, b := range report.Bonds {
+		bond := &pb.StressBondResult{
+			BondId:                     b.BondID,
+			Category:                   b.Category,
+			Shocks:                     b.Shocks,
+			Principal:                  b.Principal.String(),
+			BaselineRevenue:            b.BaselineRevenue.String(),
+			StressedRevenue:            b.StressedRevenue.String(),
+			BaselineHealth:             b.BaselineHealth,
+			StressedHealth:             b.StressedHealth,
+			Assessed:                   b.Assessed,
+			BaselineDefaultProbability: b.BaselineDefaultProbability,
+			StressedDefaultProbability: b.StressedDefaultProbability,
+		}
+		for _, t := range b.Tranches {
+			bond.Tranches = append(bond.Tranches, &pb.StressTrancheResult{
+				TrancheId:        int32(t.TrancheID),
+				Name:             t.Name,
+				Priority:         int32(t.Priority),
+				CouponDue:        t.CouponDue.String(),
+				Paid:             t.Paid.String(),
+				Shortfall:        t.Shortfall.String(),
+				Coverage:         t.Coverage,
+				BaselineCoverage: t.BaselineCoverage,
+			})
+		}
+		response.Bonds = append(response.Bonds, bond)
+	}
+	return response, nil
+}
+
+// canonicalCategory resolves a category name or alias to its slug, keeping
+// unknown categories as they were named
+func (s *BondingServiceServer) canonicalCategory(category string) string {
+	if slug := s.categoryParams(category).Slug; slug != "" {
+		return slug
+	}
+	return taxonomy.Normalize(category)
+}
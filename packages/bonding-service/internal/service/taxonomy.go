@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/taxonomy"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetTaxonomy makes the category taxonomy manageable through the admin RPCs
+// and has the risk engine value against it
+func (s *BondingServiceServer) SetTaxonomy(store *taxonomy.Store) {
+	s.taxonomy = store
+	s.riskEngine.SetCategories(store)
+}
+
+// UpsertCategory creates or updates an IP category and its risk parameters
+func (s *BondingServiceServer) UpsertCategory(
+	ctx context.Context,
+	req *pb.UpsertCategoryRequest,
+) (*pb.CategoryInfo, error) {
+	if s.taxonomy == nil {
+		return nil, status.Error(codes.Unimplemented, "taxonomy is not configured")
+	}
+	if taxonomy.Normalize(req.Slug) == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+
+	category, err := s.taxonomy.Upsert(ctx, models.Category{
+		Slug:             req.Slug,
+		Parent:           req.Parent,
+		Name:             strings.TrimSpace(req.Name),
+		Multiplier:       req.Multiplier,
+		ObsolescenceRisk: req.ObsolescenceRisk,
+		Aliases:          strings.Join(req.Aliases, ","),
+		ExpiryPolicy:     req.ExpiryPolicy,
+	})
+	if errors.Is(err, taxonomy.ErrInvalidCategory) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.categoryInfo(category), nil
+}
+
+// ListCategories lists the taxonomy, optionally only the children of a category
+func (s *BondingServiceServer) ListCategories(
+	ctx context.Context,
+	req *pb.ListCategoriesRequest,
+) (*pb.ListCategoriesResponse, error) {
+	if s.taxonomy == nil {
+		return nil, status.Error(codes.Unimplemented, "taxonomy is not configured")
+	}
+
+	parent := taxonomy.Normalize(req.Parent)
+	categories := s.taxonomy.Current().Categories()
+	result := make([]*pb.CategoryInfo, 0, len(categories))
+	for i := range categories {
+		if parent != "" && categories[i].Parent != parent {
+			continue
+		}
+		result = append(result, s.categoryInfo(&categories[i]))
+	}
+
+	return &pb.ListCategoriesResponse{Categories: result}, nil
+}
+
+// DeleteCategory removes a category without children
+func (s *BondingServiceServer) DeleteCategory(
+	ctx context.Context,
+	req *pb.DeleteCategoryRequest,
+) (*pb.DeleteCategoryResponse, error) {
+	if s.taxonomy == nil {
+		return nil, status.Error(codes.Unimplemented, "taxonomy is not configured")
+	}
+
+	deleted, err := s.taxonomy.Delete(ctx, req.Slug)
+	if errors.Is(err, taxonomy.ErrCategoryInUse) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategoryResponse{Deleted: deleted}, nil
+}
+
+func (s *BondingServiceServer) categoryInfo(category *models.Category) *pb.CategoryInfo {
+	effective := s.taxonomy.Resolve(category.Slug)
+	return &pb.CategoryInfo{
+		Slug:                      category.Slug,
+		Parent:                    category.Parent,
+		Name:                      category.Name,
+		Multiplier:                category.Multiplier,
+		ObsolescenceRisk:          category.ObsolescenceRisk,
+		Aliases:                   taxonomy.SplitAliases(category.Aliases),
+		EffectiveMultiplier:       effective.Multiplier,
+		EffectiveObsolescenceRisk: effective.ObsolescenceRisk,
+		ExpiryPolicy:              category.ExpiryPolicy,
+		EffectiveExpiryPolicy:     effective.ExpiryPolicy,
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/timeline"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBondTimeline returns a bond's events, oldest first: its issuance,
+// investments, distributions, redemptions, transfers and claims from the
+// indexer, and its rating, covenant and status events
+func (s *BondingServiceServer) GetBondTimeline(
+	ctx context.Context,
+	req *pb.GetBondTimelineRequest,
+) (*pb.GetBondTimelineResponse, error) {
+	var bond *models.Bond
+	var entries []timeline.Entry
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		if err := checkArchived(bond, req.IncludeArchived); err != nil {
+			return err
+		}
+		entries, err = timeline.Load(ctx, s.conn(ctx), bond)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetBondTimelineResponse{BondId: bond.BondID}
+	for _, e := range entries {
+		response.Entries = append(response.Entries, &pb.TimelineEntry{
+			Type:        e.Type,
+			OccurredAt:  e.OccurredAt.Unix(),
+			TxHash:      e.TxHash,
+			BlockNumber: e.BlockNumber,
+			TrancheId:   int32(e.TrancheID),
+			Account:     e.Account,
+			Amount:      e.Amount,
+			Detail:      e.Detail,
+		})
+	}
+	return response, nil
+}
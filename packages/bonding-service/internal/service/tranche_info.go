@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/reconcile"
+	"github.com/knowton/bonding-service/internal/repository"
+	"github.com/knowton/bonding-service/internal/tenant"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTrancheInfo returns a tranche as stored and as the contract records it,
+// with the stored aggregates that differ from the chain. Unlike
+// GetReconciliationReport it reads the chain now, bypassing the view cache.
+func (s *BondingServiceServer) GetTrancheInfo(
+	ctx context.Context,
+	req *pb.GetTrancheInfoRequest,
+) (*pb.GetTrancheInfoResponse, error) {
+	bond, err := s.bonds.GetBond(ctx, req.BondId)
+	if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+		return nil, status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+	}
+	if err := checkArchived(bond, req.IncludeArchived); err != nil {
+		return nil, err
+	}
+	var tranche *models.Tranche
+	for i := range bond.Tranches {
+		if bond.Tranches[i].TrancheID == int(req.TrancheId) {
+			tranche = &bond.Tranches[i]
+		}
+	}
+	if tranche == nil {
+		return nil, status.Errorf(codes.NotFound, "bond %s has no tranche %d", bond.BondID, req.TrancheId)
+	}
+
+	trancheStats, err := s.bonds.StatsLoader(ctx).Load(repository.TrancheKey{BondID: bond.BondID, TrancheID: tranche.TrancheID})
+	if err != nil {
+		return nil, err
+	}
+	onChain, err := s.OnChainTranche(ctx, bond, tranche.TrancheID)
+	if errors.Is(err, reconcile.ErrNotOnChain) {
+		return nil, status.Errorf(codes.FailedPrecondition, "bond %s was not issued on-chain", bond.BondID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read tranche from chain: %v", err)
+	}
+
+	response := &pb.GetTrancheInfoResponse{
+		BondId: bond.BondID,
+		Chain:  bond.Chain,
+		Stored: trancheInfo(bond, tranche, trancheStats.InvestorCount),
+		OnChain: &pb.OnChainTranche{
+			Allocation:    onChain.Allocation.String(),
+			ApyBps:        onChain.APY.Int64(),
+			TotalInvested: onChain.TotalInvested.String(),
+			InvestorCount: onChain.InvestorCount,
+		},
+	}
+	for _, d := range reconcile.CompareTranche(bond, tranche, trancheStats.InvestorCount, onChain) {
+		response.Discrepancies = append(response.Discrepancies, &pb.Discrepancy{
+			BondId:    d.BondID,
+			Chain:     d.Chain,
+			TrancheId: int32(d.TrancheID),
+			Field:     d.Field,
+			Stored:    d.Stored,
+			OnChain:   d.OnChain,
+		})
+	}
+	response.Consistent = len(response.Discrepancies) == 0
+	return response, nil
+}
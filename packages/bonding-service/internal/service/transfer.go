@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TransferInvestment transfers part or all of a tranche position to another address
+func (s *BondingServiceServer) TransferInvestment(
+	ctx context.Context,
+	req *pb.TransferInvestmentRequest,
+) (*pb.TransferInvestmentResponse, error) {
+	if err := s.resolveAddresses(ctx, &req.FromAddress, &req.ToAddress); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.FromAddress) || !common.IsHexAddress(req.ToAddress) {
+		return nil, fmt.Errorf("from_address and to_address must be valid addresses")
+	}
+	if strings.EqualFold(req.FromAddress, req.ToAddress) {
+		return nil, fmt.Errorf("cannot transfer a position to the same address")
+	}
+	if err := checkPositionOwner(ctx, req.FromAddress); err != nil {
+		return nil, err
+	}
+
+	var bond models.Bond
+	if err := s.db.WithContext(ctx).Where("bond_id = ?", req.BondId).First(&bond).Error; err != nil {
+		return nil, fmt.Errorf("bond not found: %w", err)
+	}
+	if bond.Status != models.BondActive && bond.Status != models.BondPaused {
+		return nil, fmt.Errorf("bond is not active (status: %s)", bond.Status)
+	}
+
+	var amount *big.Int
+	if req.Amount != "" {
+		var ok bool
+		amount, ok = new(big.Int).SetString(req.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid transfer amount")
+		}
+	}
+
+	transfer, remaining, err := s.transferPosition(ctx, req.BondId, int(req.TrancheId), req.FromAddress, req.ToAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := s.lookupAddresses(ctx, req.FromAddress, req.ToAddress)
+	return &pb.TransferInvestmentResponse{
+		TransferId:        uint64(transfer.ID),
+		TxHash:            transfer.TxHash,
+		Amount:            transfer.Amount,
+		RemainingPosition: remaining.String(),
+		Status:            "success",
+		From:              labels.counterparty(req.FromAddress),
+		To:                labels.counterparty(req.ToAddress),
+	}, nil
+}
+
+// transferPosition records a position transfer on-chain and splits the
+// Investment rows so future distributions go to the new holder.
+// A nil amount transfers the full position. It returns the sender's remaining position.
+func (s *BondingServiceServer) transferPosition(
+	ctx context.Context,
+	bondID string,
+	trancheID int,
+	from string,
+	to string,
+	amount *big.Int,
+) (*models.InvestmentTransfer, *big.Int, error) {
+	if err := s.checkPositionRecipient(ctx, to, trancheID); err != nil {
+		return nil, nil, err
+	}
+
+	chain, err := s.bondChain(ctx, bondID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkWritable(ctx, chain.Name); err != nil {
+		return nil, nil, err
+	}
+
+	move := &positionMove{
+		bondID:    bondID,
+		trancheID: trancheID,
+		from:      from,
+		to:        to,
+		amount:    amount,
+		send: func(ctx context.Context, amount *big.Int) (string, error) {
+			return s.transferPositionOnChain(ctx, chain, bondID, trancheID, from, to, amount)
+		},
+	}
+
+	var (
+		transfer  *models.InvestmentTransfer
+		remaining *big.Int
+	)
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transfer, remaining, err = s.movePosition(ctx, tx, chain, move)
+		return err
+	})
+	if err != nil {
+		move.alertUnrecorded(err)
+		return nil, nil, err
+	}
+
+	return transfer, remaining, nil
+}
+
+// checkPositionRecipient refuses a transfer to a wallet that couldn't have bought the position
+func (s *BondingServiceServer) checkPositionRecipient(ctx context.Context, to string, trancheID int) error {
+	if err := s.checkInvestorVerified(ctx, to); err != nil {
+		return err
+	}
+	return s.checkEligibility(ctx, to, trancheID)
+}
+
+// positionMove is a position transfer settled by movePosition
+type positionMove struct {
+	bondID    string
+	trancheID int
+	from      string
+	to        string
+	amount    *big.Int // nil moves the full position
+
+	// send submits the on-chain transaction for amount and returns its hash
+	send func(ctx context.Context, amount *big.Int) (string, error)
+
+	txHash string // Set once the transaction has been sent
+}
+
+// movePosition sends a position transfer and splits the Investment rows in tx.
+// The sender's rows are locked and the amount checked before anything is
+// sent, so a concurrent transfer or redemption can't spend the same position
+// twice. It returns the sender's remaining position.
+func (s *BondingServiceServer) movePosition(
+	ctx context.Context,
+	tx *gorm.DB,
+	chain *chains.Chain,
+	m *positionMove,
+) (*models.InvestmentTransfer, *big.Int, error) {
+	from := common.HexToAddress(m.from).Hex()
+	to := common.HexToAddress(m.to).Hex()
+
+	position, err := s.investorPosition(tx.Clauses(clause.Locking{Strength: "UPDATE"}), m.bondID, m.trancheID, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	if position.Sign() == 0 {
+		return nil, nil, fmt.Errorf("investor has no position in this tranche")
+	}
+	if m.amount == nil {
+		m.amount = position
+	}
+	if m.amount.Cmp(position) > 0 {
+		return nil, nil, fmt.Errorf("transfer amount %s exceeds position %s", m.amount, position)
+	}
+
+	m.txHash, err = m.send(ctx, m.amount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to transfer position on-chain: %w", err)
+	}
+	s.transactionSent(ctx, chain.Name, m.txHash, txmonitor.PurposeTransferPosition, m.bondID)
+
+	if err := reducePosition(tx, m.bondID, m.trancheID, from, m.amount); err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	received := &models.Investment{
+		BondID:    m.bondID,
+		TrancheID: m.trancheID,
+		Investor:  to,
+		Amount:    m.amount.String(),
+		TxHash:    m.txHash,
+		Timestamp: now,
+	}
+	if err := tx.Create(received).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to save investment: %w", err)
+	}
+
+	transfer := &models.InvestmentTransfer{
+		BondID:      m.bondID,
+		TrancheID:   m.trancheID,
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      m.amount.String(),
+		TxHash:      m.txHash,
+		Timestamp:   now,
+	}
+	if err := tx.Create(transfer).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to save transfer: %w", err)
+	}
+
+	return transfer, new(big.Int).Sub(position, m.amount), nil
+}
+
+// alertUnrecorded logs a transfer that was sent on-chain but whose
+// transaction failed to commit
+func (m *positionMove) alertUnrecorded(err error) {
+	if m.txHash != "" {
+		log.Printf("ALERT: transfer of %s in bond %s was sent in %s but not recorded: %v", m.amount, m.bondID, m.txHash, err)
+	}
+}
+
+// transferPositionOnChain sends the contract transferPosition call and returns its hash
+func (s *BondingServiceServer) transferPositionOnChain(
+	ctx context.Context,
+	chain *chains.Chain,
+	bondID string,
+	trancheID int,
+	from string,
+	to string,
+	amount *big.Int,
+) (string, error) {
+	onChainID, ok := new(big.Int).SetString(bondID, 10)
+	if !ok {
+		return "", status.Errorf(codes.FailedPrecondition, "bond %s has no on-chain ID", bondID)
+	}
+	contract, err := blockchain.NewIPBondContract(s.chainClient(chain), s.bondContract(chain).Hex(), s.privateKey, chain.ChainID)
+	if err != nil {
+		return "", err
+	}
+
+	chainCtx, cancel := chainContext(ctx)
+	defer cancel()
+	sent, err := contract.TransferPosition(chainCtx, onChainID, uint8(trancheID),
+		common.HexToAddress(from), common.HexToAddress(to), amount)
+	if err != nil {
+		return "", err
+	}
+	return sent.Hash().Hex(), nil
+}
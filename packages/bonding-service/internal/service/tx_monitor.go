@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/txmonitor"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetTransactionMonitor tracks every transaction the service submits
+func (s *BondingServiceServer) SetTransactionMonitor(monitor *txmonitor.Monitor) {
+	s.txMonitor = monitor
+}
+
+// transactionSent runs after every bond contract write: cached views of the
+// contract are dropped and the transaction is watched until it is mined
+func (s *BondingServiceServer) transactionSent(ctx context.Context, chainName, txHash, purpose, bondID string) {
+	s.contractWritten(ctx, chainName)
+	if s.txMonitor == nil {
+		return
+	}
+	if chainName == "" {
+		chainName = s.chainName
+	}
+	s.txMonitor.Track(ctx, chainName, common.HexToHash(txHash), purpose, bondID)
+}
+
+// ListPendingTransactions lists submitted transactions that have not been
+// mined, including stuck and dropped ones
+func (s *BondingServiceServer) ListPendingTransactions(
+	ctx context.Context,
+	req *pb.ListPendingTransactionsRequest,
+) (*pb.ListPendingTransactionsResponse, error) {
+	if s.txMonitor == nil {
+		return nil, status.Error(codes.Unimplemented, "transaction monitor is not configured")
+	}
+
+	filter := strings.ToUpper(req.Status)
+	switch filter {
+	case "", txmonitor.StatusPending, txmonitor.StatusStuck, txmonitor.StatusDropped:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", req.Status)
+	}
+
+	now := time.Now()
+	var result []*pb.PendingTransaction
+	for _, tx := range s.txMonitor.Pending(filter) {
+		if req.Chain != "" && tx.Chain != req.Chain {
+			continue
+		}
+		info := &pb.PendingTransaction{
+			TxHash:         tx.Hash.Hex(),
+			Chain:          tx.Chain,
+			Purpose:        tx.Purpose,
+			BondId:         tx.BondID,
+			Nonce:          tx.Nonce,
+			Status:         tx.Status,
+			SubmittedAt:    tx.SubmittedAt.Unix(),
+			PendingSeconds: int64(now.Sub(tx.SubmittedAt).Seconds()),
+		}
+		if !tx.CheckedAt.IsZero() {
+			info.CheckedAt = tx.CheckedAt.Unix()
+		}
+		result = append(result, info)
+	}
+
+	return &pb.ListPendingTransactionsResponse{Transactions: result}, nil
+}
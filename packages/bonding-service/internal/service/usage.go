@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/usage"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetUsageRecorder sets the recorder whose quotas GetUsage reports
+func (s *BondingServiceServer) SetUsageRecorder(recorder *usage.Recorder) {
+	s.usage = recorder
+}
+
+// SetOracleSpend sets the tracker whose daily oracle limits GetUsage reports
+func (s *BondingServiceServer) SetOracleSpend(tracker *usage.SpendTracker) {
+	s.oracleSpend = tracker
+}
+
+// GetUsage reports the caller's tenant's API usage for a month, by API key
+// and method, with each key's quota, and its oracle spend by day and provider
+func (s *BondingServiceServer) GetUsage(
+	ctx context.Context,
+	req *pb.GetUsageRequest,
+) (*pb.GetUsageResponse, error) {
+	month := req.Month
+	if month == "" {
+		month = usage.Month(time.Now())
+	}
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid month %q, want YYYY-MM", req.Month)
+	}
+
+	// Include this instance's calls that haven't been flushed yet
+	if s.usage != nil {
+		if err := s.usage.Flush(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	var rows []models.APIUsage
+	var spend []models.OracleSpend
+	err := s.readSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		if rows, err = usage.Load(s.conn(ctx), tenantID, month); err != nil {
+			return err
+		}
+		spend, err = usage.LoadSpend(s.conn(ctx), tenantID, month)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetUsageResponse{TenantId: tenantID, Month: month}
+	keys := make(map[string]*pb.KeyUsage)
+	key := func(id string) *pb.KeyUsage {
+		k, ok := keys[id]
+		if !ok {
+			k = &pb.KeyUsage{KeyId: id}
+			keys[id] = k
+			resp.Keys = append(resp.Keys, k)
+		}
+		return k
+	}
+	for _, row := range rows {
+		k := key(row.KeyID)
+		k.Calls += row.Calls
+		k.Errors += row.Errors
+		k.RequestBytes += row.RequestBytes
+		k.ResponseBytes += row.ResponseBytes
+		k.Methods = append(k.Methods, &pb.MethodUsage{
+			Method:        row.Method,
+			Calls:         row.Calls,
+			Errors:        row.Errors,
+			RequestBytes:  row.RequestBytes,
+			ResponseBytes: row.ResponseBytes,
+		})
+	}
+	// Keys with a quota are reported even before their first call
+	if s.usage != nil {
+		for _, q := range s.usage.Quotas(tenantID) {
+			key(q.KeyID)
+		}
+	}
+
+	for _, k := range resp.Keys {
+		if k.Calls > 0 {
+			k.ErrorRate = float64(k.Errors) / float64(k.Calls)
+		}
+		if s.usage != nil {
+			k.MonthlyQuota = s.usage.Quota(tenantID, k.KeyId)
+		}
+		if k.MonthlyQuota > k.Calls {
+			k.RemainingCalls = k.MonthlyQuota - k.Calls
+		}
+	}
+
+	var total int64
+	for _, row := range spend {
+		total += row.CostMicros
+		resp.OracleSpend = append(resp.OracleSpend, &pb.OracleSpend{
+			Day:      row.Day,
+			Provider: row.Provider,
+			Calls:    row.Calls,
+			CostUsd:  usage.FormatUSD(row.CostMicros),
+		})
+	}
+	resp.OracleSpendUsd = usage.FormatUSD(total)
+	if s.oracleSpend != nil {
+		config := s.oracleSpend.Config()
+		resp.OracleDailySoftLimitUsd = usage.FormatUSD(config.DailySoftLimit)
+		resp.OracleDailyHardLimitUsd = usage.FormatUSD(config.DailyHardLimit)
+	}
+	return resp, nil
+}
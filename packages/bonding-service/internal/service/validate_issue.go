@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/chains"
+	"github.com/knowton/bonding-service/internal/hooks"
+	"github.com/knowton/bonding-service/internal/ipmeta"
+	"github.com/knowton/bonding-service/internal/license"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/rules"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// issuance is a validated and assessed bond issuance, ready to send on-chain
+type issuance struct {
+	chain            *chains.Chain
+	registration     *risk.RegisteredIP
+	agreement        *license.Agreement
+	category         string
+	fingerprint      string // Content fingerprint, empty when not fingerprinted
+	expiryPolicy     string
+	licenseExpiresAt time.Time
+	warnings         []string
+	forecasts        []models.RevenueForecast
+	assessment       *models.RiskAssessment
+	totalValue       *big.Int
+}
+
+// prepareIssuance runs every check of an issuance without persisting anything
+// or calling the chain. It keeps going after a failure so all of them are
+// reported; steps that depend on a failed one are skipped. Rule violations are
+// returned as *rules.ViolationError.
+func (s *BondingServiceServer) prepareIssuance(ctx context.Context, req *pb.IssueBondRequest) (*issuance, []error) {
+	var errs []error
+	for _, err := range issueBondRequestErrors(req) {
+		errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+	}
+	plan := &issuance{}
+	plan.totalValue, _ = new(big.Int).SetString(req.TotalValue, 10)
+
+	chain, err := s.chainConfig(req.Chain)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	plan.chain = chain
+	if plan.registration, err = s.registeredIP(ctx, req.Registration); err != nil {
+		errs = append(errs, err)
+	}
+	if plan.agreement, err = licenseAgreement(req.License, req.IssuerAddress); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Read the IP's category and engagement from its metadata document
+	var document *ipmeta.Document
+	var metadataErr error
+	if req.IpnftId != "" && chain != nil {
+		if document, metadataErr = s.ipMetadata(ctx, chain, req.NftContract, req.IpnftId); metadataErr != nil {
+			errs = append(errs, metadataErr)
+		}
+	}
+
+	plan.category = strings.TrimSpace(req.Category)
+	if plan.category == "" && document != nil {
+		plan.category = document.Category
+	}
+	if plan.category == "" && plan.registration != nil {
+		plan.category = strings.ToLower(plan.registration.Kind)
+	}
+	if plan.category == "" {
+		plan.category = "music"
+	}
+	if req.LicenseExpiresAt > 0 {
+		plan.licenseExpiresAt = time.Unix(req.LicenseExpiresAt, 0)
+	}
+	if plan.agreement != nil && !plan.agreement.EndsAt.IsZero() {
+		if req.LicenseExpiresAt > 0 && !plan.licenseExpiresAt.Equal(plan.agreement.EndsAt) {
+			errs = append(errs, status.Error(codes.InvalidArgument, "license_expires_at differs from the license agreement's end"))
+		}
+		plan.licenseExpiresAt = plan.agreement.EndsAt
+	}
+	plan.expiryPolicy = s.categoryParams(plan.category).ExpiryPolicy
+	if plan.warnings, err = checkMaturity(plan.expiryPolicy, time.Unix(req.MaturityDate, 0), plan.registration, plan.licenseExpiresAt); err != nil {
+		errs = append(errs, err)
+	}
+
+	// The same content must not back two bonds
+	if metadataErr == nil {
+		fingerprint, warnings, err := s.contentFingerprint(ctx, req, document, plan.category)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		plan.fingerprint = fingerprint
+		plan.warnings = append(plan.warnings, warnings...)
+	}
+
+	counterparties, err := s.issuanceCounterparties(ctx, plan.agreement)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if plan.forecasts, err = revenueForecasts(req.RevenueForecast); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Risk assessment and the rules need the IP-NFT and its metadata
+	if req.IpnftId == "" || metadataErr != nil {
+		return plan, errs
+	}
+	metadata := &risk.IPMetadata{
+		Category:       plan.category,
+		CreatorAddress: req.IssuerAddress,
+		CreatedAt:      time.Now(),
+		Views:          1000,
+		Likes:          100,
+		Tags:           []string{"original", "popular"},
+		ContentHash:    req.IpnftId,
+		Registration:   plan.registration,
+		License:        licenseTerms(plan.agreement),
+		Counterparties: counterparties,
+	}
+	if document != nil {
+		applyDocument(metadata, document)
+	}
+	if plan.assessment, err = s.assessRisk(ctx, "", req.IpnftId, metadata); err != nil {
+		return plan, append(errs, err)
+	}
+
+	if s.rules != nil || s.hooks != nil {
+		chainName := ""
+		if chain != nil {
+			chainName = chain.Name
+		}
+		facts := issuanceFacts(req, chainName, plan.category, plan.assessment)
+		if s.rules != nil {
+			if err := s.rules.Check(rules.Issuance, facts); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if err := s.runHooks(ctx, hooks.PreIssuance, facts); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return plan, errs
+}
+
+// ValidateIssueBond dry-runs an issuance, returning every error and warning
+// along with the values the bond would be issued with
+func (s *BondingServiceServer) ValidateIssueBond(
+	ctx context.Context,
+	req *pb.IssueBondRequest,
+) (*pb.ValidateIssueBondResponse, error) {
+	var errs []error
+	if err := s.resolveAddresses(ctx, &req.IssuerAddress); err != nil {
+		errs = append(errs, err)
+	}
+	plan, planErrs := s.prepareIssuance(ctx, req)
+	errs = append(errs, planErrs...)
+
+	response := &pb.ValidateIssueBondResponse{
+		Valid:        len(errs) == 0,
+		Warnings:     plan.warnings,
+		Category:     plan.category,
+		ExpiryPolicy: plan.expiryPolicy,
+	}
+	for _, err := range errs {
+		response.Errors = append(response.Errors, issuanceProblems(err)...)
+	}
+	if plan.chain != nil {
+		response.Chain = plan.chain.Name
+	}
+	if !plan.licenseExpiresAt.IsZero() {
+		response.LicenseExpiresAt = plan.licenseExpiresAt.Unix()
+	}
+	if plan.totalValue != nil {
+		for i, t := range []*pb.TrancheConfig{req.Senior, req.Mezzanine, req.Junior} {
+			if t == nil {
+				continue
+			}
+			response.Tranches = append(response.Tranches, &pb.TrancheInfo{
+				TrancheId:     uint32(i),
+				Name:          t.Name,
+				Priority:      t.Priority,
+				Allocation:    s.calculateAllocation(plan.totalValue, t.AllocationPercentage),
+				Apy:           formatAPY(t.Apy),
+				RiskLevel:     t.RiskLevel,
+				TotalInvested: "0",
+			})
+		}
+	}
+	if a := plan.assessment; a != nil {
+		response.RiskAssessment = s.riskAssessmentInfo(a)
+	}
+	return response, nil
+}
+
+// issuanceProblems describes an issuance error, one entry per rule violated
+func issuanceProblems(err error) []*pb.IssuanceProblem {
+	var violation *rules.ViolationError
+	if errors.As(err, &violation) {
+		problems := make([]*pb.IssuanceProblem, len(violation.Violations))
+		for i, v := range violation.Violations {
+			problems[i] = &pb.IssuanceProblem{
+				Code:    codes.FailedPrecondition.String(),
+				Message: v.Message,
+				Rule:    v.Rule,
+			}
+		}
+		return problems
+	}
+
+	st := status.Convert(err)
+	return []*pb.IssuanceProblem{{Code: st.Code().String(), Message: st.Message()}}
+}
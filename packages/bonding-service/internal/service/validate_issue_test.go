@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/risk"
+	"github.com/knowton/bonding-service/internal/rules"
+	pb "github.com/knowton/bonding-service/proto"
+)
+
+func TestValidateIssueBond(t *testing.T) {
+	engine, err := rules.New([]rules.Rule{
+		{Name: "junior-premium", Point: rules.Issuance, Expression: "tranches.junior.apy >= tranches.senior.apy + 3.0", Message: "junior APY must be 3 points above senior"},
+		{Name: "large-issue", Point: rules.Issuance, Expression: "bond.total_value >= 10000000.0"},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error = %v", err)
+	}
+	s := &BondingServiceServer{riskEngine: risk.NewRiskEngine()}
+	s.SetRules(engine)
+
+	valid := func() *pb.IssueBondRequest {
+		return &pb.IssueBondRequest{
+			IpnftId:       "42",
+			IssuerAddress: "0x1234567890123456789012345678901234567890",
+			TotalValue:    "100000000",
+			MaturityDate:  time.Now().AddDate(3, 0, 0).Unix(),
+			Senior:        &pb.TrancheConfig{Name: "Senior", Apy: 5, AllocationPercentage: "50"},
+			Mezzanine:     &pb.TrancheConfig{Name: "Mezzanine", Apy: 8, AllocationPercentage: "30"},
+			Junior:        &pb.TrancheConfig{Name: "Junior", Apy: 12, AllocationPercentage: "20"},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		req        func() *pb.IssueBondRequest
+		wantErrors []string // Codes of the expected problems, in order
+	}{
+		{"valid", valid, nil},
+		{"every problem at once", func() *pb.IssueBondRequest {
+			req := valid()
+			req.MaturityDate = time.Now().Add(-time.Hour).Unix()
+			req.Chain = "solana"
+			req.TotalValue = "5000000"
+			req.Junior.Apy = 6
+			return req
+		}, []string{"InvalidArgument", "InvalidArgument", "FailedPrecondition", "FailedPrecondition"}},
+		{"fractional allocations", func() *pb.IssueBondRequest {
+			req := valid()
+			req.Senior.AllocationPercentage = "33.33"
+			req.Mezzanine.AllocationPercentage = "33.33"
+			req.Junior.AllocationPercentage = "33.34"
+			return req
+		}, nil},
+		{"allocations must add up to 100", func() *pb.IssueBondRequest {
+			req := valid()
+			req.Senior.AllocationPercentage = "50.5"
+			return req
+		}, []string{"InvalidArgument"}},
+		{"allocation more precise than a basis point", func() *pb.IssueBondRequest {
+			req := valid()
+			req.Senior.AllocationPercentage = "49.995"
+			req.Mezzanine.AllocationPercentage = "30.005"
+			return req
+		}, []string{"InvalidArgument", "InvalidArgument"}},
+		{"no ipnft skips risk", func() *pb.IssueBondRequest {
+			req := valid()
+			req.IpnftId = ""
+			return req
+		}, []string{"InvalidArgument"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ValidateIssueBond(context.Background(), tt.req())
+			if err != nil {
+				t.Fatalf("ValidateIssueBond() error = %v", err)
+			}
+			if resp.Valid != (len(tt.wantErrors) == 0) {
+				t.Errorf("Valid = %v with errors %v", resp.Valid, resp.Errors)
+			}
+			if len(resp.Errors) != len(tt.wantErrors) {
+				t.Fatalf("got %d errors %v, want %d", len(resp.Errors), resp.Errors, len(tt.wantErrors))
+			}
+			for i, code := range tt.wantErrors {
+				if resp.Errors[i].Code != code {
+					t.Errorf("error %d = %s %q, want %s", i, resp.Errors[i].Code, resp.Errors[i].Message, code)
+				}
+			}
+		})
+	}
+
+	resp, err := s.ValidateIssueBond(context.Background(), valid())
+	if err != nil {
+		t.Fatalf("ValidateIssueBond() error = %v", err)
+	}
+	if len(resp.Tranches) != 3 || resp.Tranches[0].Allocation != "50000000" {
+		t.Errorf("tranches = %v, want a 50000000 senior allocation", resp.Tranches)
+	}
+	if resp.RiskAssessment == nil || resp.Category != "music" || resp.Chain == "" {
+		t.Errorf("derived values missing: %+v", resp)
+	}
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// TestEmergencyReasonsMatchProto keeps the reason codes in step with the
+// ones bonding.proto lists
+func TestEmergencyReasonsMatchProto(t *testing.T) {
+	spec, err := os.ReadFile("../../proto/bonding.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed := `.string = {in: ["` + strings.Join(models.EmergencyReasons, `", "`) + `"]}`
+	if !strings.Contains(string(spec), listed) {
+		t.Errorf("bonding.proto doesn't list reason codes %v", models.EmergencyReasons)
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/viewcache"
+)
+
+// SetViewCache enables caching of contract view calls
+func (s *BondingServiceServer) SetViewCache(cache *viewcache.Cache) {
+	s.viewCache = cache
+}
+
+// contractWritten drops cached view calls to the bond contract on a chain
+// after the service sends it a transaction
+func (s *BondingServiceServer) contractWritten(ctx context.Context, chainName string) {
+	if s.viewCache == nil {
+		return
+	}
+	chain, err := s.chainConfig(chainName)
+	if err != nil {
+		return
+	}
+	s.viewCache.Invalidate(ctx, chain.Name, s.bondContract(chain))
+}
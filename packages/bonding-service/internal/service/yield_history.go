@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"github.com/knowton/bonding-service/internal/yield"
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetYieldHistory returns the yield each of a bond's tranches realized after
+// each distribution, so it can be charted against the promised APY. The
+// series is computed over every distribution and then cut to from and to, so
+// the cumulative figures always count from issuance.
+func (s *BondingServiceServer) GetYieldHistory(
+	ctx context.Context,
+	req *pb.GetYieldHistoryRequest,
+) (*pb.GetYieldHistoryResponse, error) {
+	var bond *models.Bond
+	var distributions []models.RevenueDistribution
+	err := s.readReplicaSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		bond, err = s.bonds.GetBond(ctx, req.BondId)
+		if err != nil || bond.TenantID != tenant.FromContext(ctx) {
+			return status.Errorf(codes.NotFound, "bond %s not found", req.BondId)
+		}
+		if err := checkArchived(bond, req.IncludeArchived); err != nil {
+			return err
+		}
+		distributions, err = s.allDistributions(ctx, bond.BondID, req.IncludeArchived)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetYieldHistoryResponse{BondId: bond.BondID}
+	for i := range bond.Tranches {
+		t := &bond.Tranches[i]
+		if req.TrancheId >= 0 && int32(t.TrancheID) != req.TrancheId {
+			continue
+		}
+		points := yield.History(t.TrancheID, trancheDayCount(bond, t), bond.CreatedAt, distributions)
+		history := &pb.TrancheYieldHistory{
+			TrancheId: int32(t.TrancheID),
+			Apy:       t.APY,
+			TotalPaid: "0",
+		}
+		if len(points) > 0 {
+			last := points[len(points)-1]
+			history.RealizedApy = last.RealizedAPY
+			history.TotalPaid = last.CumulativePaid.String()
+		}
+		for n := range points {
+			if !inRange(points[n].At, req.From, req.To) {
+				continue
+			}
+			history.Points = append(history.Points, yieldPoint(&points[n]))
+		}
+		response.Tranches = append(response.Tranches, history)
+	}
+	return response, nil
+}
+
+// inRange reports whether at falls within [from, to], either bound being 0
+// for none
+func inRange(at time.Time, from, to int64) bool {
+	if from > 0 && at.Unix() < from {
+		return false
+	}
+	return to <= 0 || at.Unix() <= to
+}
+
+func yieldPoint(p *yield.Point) *pb.YieldPoint {
+	return &pb.YieldPoint{
+		Timestamp:      p.At.Unix(),
+		DistributionId: uint64(p.DistributionID),
+		Invested:       p.Invested.String(),
+		Paid:           p.Paid.String(),
+		CumulativePaid: p.CumulativePaid.String(),
+		PeriodYield:    p.PeriodYield,
+		PeriodApy:      p.PeriodAPY,
+		RealizedApy:    p.RealizedAPY,
+	}
+}
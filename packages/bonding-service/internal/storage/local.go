@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalStore keeps documents on local disk. Signed URLs point at Handler,
+// which must be mounted at BaseURL.
+type LocalStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+}
+
+// NewLocalStore creates a store rooted at dir. secret signs download URLs.
+func NewLocalStore(dir string, baseURL string, secret string) (*LocalStore, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("a signing secret is required for local document storage")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+	return &LocalStore{
+		root:    dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+	}, nil
+}
+
+// Name returns "local"
+func (s *LocalStore) Name() string { return "local" }
+
+// Put writes an object atomically
+func (s *LocalStore) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o640); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	return nil
+}
+
+// Get reads an object
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	body, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return body, nil
+}
+
+// Delete removes an object; deleting a missing object is not an error
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// SignedURL returns a URL served by Handler that is valid for ttl
+func (s *LocalStore) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("signature", s.sign(key, expires))
+	return fmt.Sprintf("%s/%s?%s", s.baseURL, key, q.Encode()), nil
+}
+
+// Handler serves objects for valid, unexpired signed URLs. It expects the
+// object key as the request path after prefix.
+func (s *LocalStore) Handler(prefix string) http.Handler {
+	return http.StripPrefix(prefix, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		expires := r.URL.Query().Get("expires")
+		signature := r.URL.Query().Get("signature")
+
+		exp, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().Unix() > exp ||
+			!hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+			http.Error(w, "invalid or expired link", http.StatusForbidden)
+			return
+		}
+
+		body, err := s.Get(r.Context(), key)
+		if errors.Is(err, ErrNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, "failed to read document", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", http.DetectContentType(body))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(key)))
+		w.Header().Set("Cache-Control", "private, no-store")
+		_, _ = w.Write(body)
+	}))
+}
+
+func (s *LocalStore) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
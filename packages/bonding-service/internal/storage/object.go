@@ -0,0 +1,289 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// requestTTL is the validity of the presigned URLs the store uses for its own requests
+const requestTTL = 5 * time.Minute
+
+// ObjectStoreConfig configures an S3 or GCS bucket accessed with HMAC credentials
+type ObjectStoreConfig struct {
+	Endpoint  string // e.g. https://s3.us-east-1.amazonaws.com or https://storage.googleapis.com
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool // Address the bucket in the path instead of the host name
+}
+
+// signer holds the provider-specific names used by V4 request signing
+type signer struct {
+	algorithm  string
+	keyPrefix  string
+	service    string
+	terminator string
+	param      string // Query parameter prefix
+}
+
+var (
+	s3Signer = signer{
+		algorithm:  "AWS4-HMAC-SHA256",
+		keyPrefix:  "AWS4",
+		service:    "s3",
+		terminator: "aws4_request",
+		param:      "X-Amz-",
+	}
+	gcsSigner = signer{
+		algorithm:  "GOOG4-HMAC-SHA256",
+		keyPrefix:  "GOOG4",
+		service:    "storage",
+		terminator: "goog4_request",
+		param:      "X-Goog-",
+	}
+)
+
+// ObjectStore stores documents in an S3-compatible bucket. Every request,
+// including the store's own reads and writes, uses a V4 presigned URL.
+type ObjectStore struct {
+	name   string
+	config ObjectStoreConfig
+	signer signer
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Store creates a store backed by an Amazon S3 bucket
+func NewS3Store(config ObjectStoreConfig) (*ObjectStore, error) {
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+	return newObjectStore("s3", config, s3Signer)
+}
+
+// NewGCSStore creates a store backed by a Google Cloud Storage bucket using HMAC keys
+func NewGCSStore(config ObjectStoreConfig) (*ObjectStore, error) {
+	if config.Endpoint == "" {
+		config.Endpoint = "https://storage.googleapis.com"
+	}
+	if config.Region == "" {
+		config.Region = "auto"
+	}
+	return newObjectStore("gcs", config, gcsSigner)
+}
+
+func newObjectStore(name string, config ObjectStoreConfig, s signer) (*ObjectStore, error) {
+	if config.Bucket == "" || config.AccessKey == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("%s storage requires a bucket and HMAC credentials", name)
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("%s storage requires a region", name)
+	}
+	return &ObjectStore{
+		name:   name,
+		config: config,
+		signer: s,
+		client: &http.Client{Timeout: 30 * time.Second},
+		now:    time.Now,
+	}, nil
+}
+
+// Name returns "s3" or "gcs"
+func (s *ObjectStore) Name() string { return s.name }
+
+// Put uploads an object
+func (s *ObjectStore) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	resp, err := s.do(ctx, http.MethodPut, key, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkStatus(resp, "upload")
+}
+
+// Get downloads an object
+func (s *ObjectStore) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if err := checkStatus(resp, "download"); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// Delete removes an object; deleting a missing object is not an error
+func (s *ObjectStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return checkStatus(resp, "delete")
+}
+
+// SignedURL returns a presigned GET URL valid for ttl
+func (s *ObjectStore) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return s.presign(http.MethodGet, key, ttl, s.now())
+}
+
+func (s *ObjectStore) do(ctx context.Context, method, key string, body []byte, contentType string) (*http.Response, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	signed, err := s.presign(method, key, requestTTL, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, signed, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s request failed: %w", s.name, err)
+	}
+	return resp, nil
+}
+
+// presign builds a V4 query-string signed URL for method on key
+func (s *ObjectStore) presign(method, key string, ttl time.Duration, now time.Time) (string, error) {
+	endpoint, err := url.Parse(s.config.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid storage endpoint: %w", err)
+	}
+
+	host := endpoint.Host
+	path := "/" + key
+	if s.config.PathStyle {
+		path = "/" + s.config.Bucket + path
+	} else {
+		host = s.config.Bucket + "." + host
+	}
+	canonicalURI := encodePath(path)
+
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := strings.Join([]string{date, s.config.Region, s.signer.service, s.signer.terminator}, "/")
+
+	p := s.signer.param
+	query := map[string]string{
+		p + "Algorithm":     s.signer.algorithm,
+		p + "Credential":    s.config.AccessKey + "/" + scope,
+		p + "Date":          amzDate,
+		p + "Expires":       strconv.FormatInt(int64(ttl.Seconds()), 10),
+		p + "SignedHeaders": "host",
+	}
+	canonicalQuery := encodeQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI,
+		canonicalQuery,
+		"host:" + host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		s.signer.algorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte(s.signer.keyPrefix+s.config.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s.signer.service)
+	signingKey = hmacSHA256(signingKey, s.signer.terminator)
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&%sSignature=%s",
+		endpoint.Scheme, host, canonicalURI, canonicalQuery, p, signature), nil
+}
+
+func checkStatus(resp *http.Response, action string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("failed to %s object: %s: %s", action, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// encodePath URI-encodes each path segment per RFC 3986
+func encodePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		segments[i] = uriEncode(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+func encodeQuery(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = uriEncode(k) + "=" + uriEncode(params[k])
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode encodes everything except unreserved characters
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// ErrInfected is returned when a scanner flags a document
+var ErrInfected = errors.New("document failed virus scan")
+
+// Scanner checks document content before it is stored
+type Scanner interface {
+	Scan(ctx context.Context, name string, content []byte) error
+}
+
+// NoopScanner accepts every document
+type NoopScanner struct{}
+
+// Scan always succeeds
+func (NoopScanner) Scan(ctx context.Context, name string, content []byte) error { return nil }
+
+// ClamAVScanner scans documents with a clamd daemon using the INSTREAM command
+type ClamAVScanner struct {
+	addr    string
+	timeout time.Duration
+}
+
+// clamChunkSize is the largest chunk streamed to clamd at once
+const clamChunkSize = 64 * 1024
+
+// NewClamAVScanner creates a scanner for the clamd TCP address, e.g. "clamav:3310"
+func NewClamAVScanner(addr string, timeout time.Duration) *ClamAVScanner {
+	return &ClamAVScanner{addr: addr, timeout: timeout}
+}
+
+// Scan streams content to clamd and returns ErrInfected if a signature matches
+func (c *ClamAVScanner) Scan(ctx context.Context, name string, content []byte) error {
+	dialer := net.Dialer{Timeout: c.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return fmt.Errorf("failed to start clamd scan: %w", err)
+	}
+
+	size := make([]byte, 4)
+	for offset := 0; offset < len(content); offset += clamChunkSize {
+		end := offset + clamChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		binary.BigEndian.PutUint32(size, uint32(end-offset))
+		if _, err := conn.Write(size); err != nil {
+			return fmt.Errorf("failed to stream to clamd: %w", err)
+		}
+		if _, err := conn.Write(content[offset:end]); err != nil {
+			return fmt.Errorf("failed to stream to clamd: %w", err)
+		}
+	}
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return fmt.Errorf("failed to stream to clamd: %w", err)
+	}
+
+	reply, err := io.ReadAll(conn)
+	if err != nil {
+		return fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	result := strings.TrimSpace(string(bytes.TrimRight(reply, "\x00")))
+
+	switch {
+	case strings.HasSuffix(result, "OK"):
+		return nil
+	case strings.HasSuffix(result, "FOUND"):
+		return fmt.Errorf("%w: %s: %s", ErrInfected, name, strings.TrimPrefix(result, "stream: "))
+	default:
+		return fmt.Errorf("clamd scan failed: %s", result)
+	}
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// Store persists generated documents and issues time-limited download URLs
+type Store interface {
+	// Name identifies the backend, e.g. "local", "s3" or "gcs"
+	Name() string
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// validateKey rejects keys that could escape the store's root or bucket prefix
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return fmt.Errorf("invalid object key: %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid object key: %q", key)
+		}
+	}
+	return nil
+}
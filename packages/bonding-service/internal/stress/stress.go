@@ -0,0 +1,334 @@
+// Package stress recomputes the health of the bond book under hypothetical
+// shocks to revenue and IP valuations. Each bond's average monthly revenue is
+// shocked and run through the waterfall against a month of coupons and its
+// current arrears, and its default probability is scaled with the shocks.
+package stress
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	"gorm.io/gorm"
+)
+
+// Bond health under a scenario
+const (
+	Healthy    = "HEALTHY"    // Every coupon and arrear is paid
+	Stressed   = "STRESSED"   // Junior tranches fall short, the senior tranche is paid
+	Distressed = "DISTRESSED" // The senior tranche falls short
+)
+
+// Period is the coupon period bonds are tested over
+const Period = 30 * 24 * time.Hour
+
+// DefaultLookback is the revenue history averaged into a bond's baseline
+const DefaultLookback = 6 * Period
+
+// Shock is a hypothetical change applied to the bonds in a category
+type Shock struct {
+	Name     string
+	Category string // Canonical category slug; empty applies to every bond
+	// RevenueChange and ValuationChange are relative, e.g. -0.5 halves
+	// revenue. They are at least -1.
+	RevenueChange   float64
+	ValuationChange float64
+}
+
+// Validate checks shocks can be applied
+func Validate(shocks []Shock) error {
+	if len(shocks) == 0 {
+		return fmt.Errorf("at least one shock is required")
+	}
+	for i, shock := range shocks {
+		if shock.RevenueChange < -1 || shock.ValuationChange < -1 {
+			return fmt.Errorf("shock %d lowers revenue or valuation by more than 100%%", i)
+		}
+		if math.IsNaN(shock.RevenueChange) || math.IsNaN(shock.ValuationChange) {
+			return fmt.Errorf("shock %d is not a number", i)
+		}
+	}
+	return nil
+}
+
+// Input is a bond as the scenario starts from
+type Input struct {
+	Bond       models.Bond // With its tranches
+	Category   string      // Canonical category slug of the bond
+	Revenue    *big.Int    // Revenue distributed over the history
+	History    time.Duration
+	Assessment *models.RiskAssessment // Latest assessment of the bond's IP, nil if never assessed
+}
+
+// TrancheResult is a tranche's coupon period under a scenario
+type TrancheResult struct {
+	TrancheID int
+	Name      string
+	Priority  int
+	CouponDue *big.Int // This period's coupon plus arrears carried in
+	Paid      *big.Int
+	Shortfall *big.Int
+	// Coverage is the revenue available over everything due to this tranche
+	// and those senior to it, 0 when nothing is due
+	Coverage         float64
+	BaselineCoverage float64
+}
+
+// BondResult is a bond under the baseline and the scenario
+type BondResult struct {
+	BondID                     string
+	Category                   string
+	Shocks                     []string // Names of the shocks applied
+	Principal                  *big.Int
+	BaselineRevenue            *big.Int // Average revenue per period
+	StressedRevenue            *big.Int
+	BaselineHealth             string
+	StressedHealth             string
+	Assessed                   bool
+	BaselineDefaultProbability float64
+	StressedDefaultProbability float64
+	Tranches                   []TrancheResult
+}
+
+// Report is the book under a scenario
+type Report struct {
+	Bonds                    []BondResult
+	Healthy                  int
+	Stressed                 int
+	Distressed               int
+	NewlyDistressed          int // Distressed under the scenario but not the baseline
+	BaselineExpectedDefaults float64
+	ExpectedDefaults         float64 // Sum of stressed default probabilities
+	// Expected losses are the principal weighted by default probability,
+	// assuming nothing is recovered
+	BaselineExpectedLoss *big.Int
+	ExpectedLoss         *big.Int
+	Shortfall            *big.Int // Coupons left unpaid in the period across the book
+}
+
+// Run applies shocks to every bond
+func Run(inputs []Input, shocks []Shock) *Report {
+	report := &Report{
+		BaselineExpectedLoss: new(big.Int),
+		ExpectedLoss:         new(big.Int),
+		Shortfall:            new(big.Int),
+	}
+	for _, in := range inputs {
+		result := runBond(in, shocks)
+		report.Bonds = append(report.Bonds, result)
+
+		switch result.StressedHealth {
+		case Healthy:
+			report.Healthy++
+		case Stressed:
+			report.Stressed++
+		default:
+			report.Distressed++
+			if result.BaselineHealth != Distressed {
+				report.NewlyDistressed++
+			}
+		}
+		report.BaselineExpectedDefaults += result.BaselineDefaultProbability
+		report.ExpectedDefaults += result.StressedDefaultProbability
+		report.BaselineExpectedLoss.Add(report.BaselineExpectedLoss, scale(result.Principal, result.BaselineDefaultProbability))
+		report.ExpectedLoss.Add(report.ExpectedLoss, scale(result.Principal, result.StressedDefaultProbability))
+		for _, t := range result.Tranches {
+			report.Shortfall.Add(report.Shortfall, t.Shortfall)
+		}
+	}
+	return report
+}
+
+func runBond(in Input, shocks []Shock) BondResult {
+	result := BondResult{BondID: in.Bond.BondID, Category: in.Category, Principal: new(big.Int)}
+
+	revenueFactor, valuationFactor := 1.0, 1.0
+	for _, shock := range shocks {
+		if shock.Category != "" && shock.Category != in.Category {
+			continue
+		}
+		revenueFactor *= 1 + shock.RevenueChange
+		valuationFactor *= 1 + shock.ValuationChange
+		result.Shocks = append(result.Shocks, shock.Name)
+	}
+
+	// Average the history into one period's revenue
+	history := in.History
+	if history < Period {
+		history = Period
+	}
+	result.BaselineRevenue = new(big.Int).Mul(valueOrZero(in.Revenue), big.NewInt(int64(Period)))
+	result.BaselineRevenue.Div(result.BaselineRevenue, big.NewInt(int64(history)))
+	result.StressedRevenue = scale(result.BaselineRevenue, revenueFactor)
+
+	states := make([]waterfall.TrancheState, len(in.Bond.Tranches))
+	for i, t := range in.Bond.Tranches {
+		invested := parseAmount(t.TotalInvested)
+		result.Principal.Add(result.Principal, invested)
+		states[i] = waterfall.TrancheState{
+			TrancheID: t.TrancheID,
+			Priority:  t.Priority,
+			CouponDue: waterfall.PeriodCoupon(invested, int64(math.Round(t.APY*100)), int64(Period.Seconds())),
+			Arrears:   parseAmount(t.Arrears),
+		}
+	}
+	baseline := waterfall.Run(result.BaselineRevenue, states)
+	stressed := waterfall.Run(result.StressedRevenue, states)
+	result.BaselineHealth = health(baseline)
+	result.StressedHealth = health(stressed)
+
+	tranches := make(map[int]models.Tranche, len(in.Bond.Tranches))
+	for _, t := range in.Bond.Tranches {
+		tranches[t.TrancheID] = t
+	}
+	due := new(big.Int) // Owed to this tranche and those senior to it
+	for _, r := range stressed.Tranches {
+		t := tranches[r.TrancheID]
+		owed := new(big.Int).Add(r.CouponDue, parseAmount(t.Arrears))
+		due.Add(due, owed)
+		paid := new(big.Int).Add(r.ArrearsPaid, r.CouponPaid)
+		result.Tranches = append(result.Tranches, TrancheResult{
+			TrancheID:        t.TrancheID,
+			Name:             t.Name,
+			Priority:         t.Priority,
+			CouponDue:        owed,
+			Paid:             paid,
+			Shortfall:        new(big.Int).Sub(owed, paid),
+			Coverage:         ratio(result.StressedRevenue, due),
+			BaselineCoverage: ratio(result.BaselineRevenue, due),
+		})
+	}
+
+	if in.Assessment != nil {
+		result.Assessed = true
+		result.BaselineDefaultProbability = in.Assessment.DefaultProbability
+		result.StressedDefaultProbability = stressedDefaultProbability(in.Assessment.DefaultProbability, revenueFactor*valuationFactor)
+	}
+	return result
+}
+
+// stressedDefaultProbability scales a default probability inversely with
+// the combined revenue and valuation factor: halving either doubles it
+func stressedDefaultProbability(p, factor float64) float64 {
+	if factor <= 0 {
+		return 1
+	}
+	return math.Min(1, p/factor)
+}
+
+// health classifies a waterfall run
+func health(result *waterfall.Result) string {
+	if len(result.Tranches) == 0 || !result.Partial() {
+		return Healthy
+	}
+	if result.Tranches[0].Arrears.Sign() > 0 {
+		return Distressed
+	}
+	return Stressed
+}
+
+// Load reads the tenant's outstanding bonds with the revenue they distributed
+// over lookback before now and their IP's latest assessment. category maps a
+// bond's category to its canonical slug.
+func Load(ctx context.Context, db *gorm.DB, tenantID string, now time.Time, lookback time.Duration, category func(string) string) ([]Input, error) {
+	var bonds []models.Bond
+	if err := db.WithContext(ctx).Preload("Tranches").
+		Where("tenant_id = ? AND status IN ?", tenantID, models.OutstandingBondStatuses).
+		Order("created_at ASC").
+		Find(&bonds).Error; err != nil {
+		return nil, fmt.Errorf("failed to load bonds: %w", err)
+	}
+	if len(bonds) == 0 {
+		return nil, nil
+	}
+
+	bondIDs := make([]string, len(bonds))
+	ipnftIDs := make([]string, len(bonds))
+	for i, b := range bonds {
+		bondIDs[i] = b.BondID
+		ipnftIDs[i] = b.IPNFTId
+	}
+
+	since := now.Add(-lookback)
+	var distributions []models.RevenueDistribution
+	if err := db.WithContext(ctx).
+		Select("bond_id", "amount").
+		Where("bond_id IN ? AND timestamp >= ? AND timestamp < ?", bondIDs, since, now).
+		Find(&distributions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load revenue distributions: %w", err)
+	}
+	revenue := make(map[string]*big.Int, len(bonds))
+	for _, d := range distributions {
+		if revenue[d.BondID] == nil {
+			revenue[d.BondID] = new(big.Int)
+		}
+		revenue[d.BondID].Add(revenue[d.BondID], parseAmount(d.Amount))
+	}
+
+	var assessments []models.RiskAssessment
+	if err := db.WithContext(ctx).
+		Where("ip_nft_id IN ?", ipnftIDs).
+		Order("assessed_at ASC").
+		Find(&assessments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load risk assessments: %w", err)
+	}
+	latest := make(map[string]*models.RiskAssessment, len(assessments))
+	for i := range assessments {
+		latest[assessments[i].IPNFTId] = &assessments[i]
+	}
+
+	inputs := make([]Input, len(bonds))
+	for i, b := range bonds {
+		history := lookback
+		if age := now.Sub(b.CreatedAt); age < history {
+			history = age
+		}
+		inputs[i] = Input{
+			Bond:       b,
+			Category:   category(b.Category),
+			Revenue:    valueOrZero(revenue[b.BondID]),
+			History:    history,
+			Assessment: latest[b.IPNFTId],
+		}
+	}
+	return inputs, nil
+}
+
+// scale multiplies an amount by a non-negative factor, taken to nine
+// decimal places so float noise doesn't cost a base unit, rounding down
+func scale(amount *big.Int, factor float64) *big.Int {
+	if factor <= 0 || amount.Sign() == 0 {
+		return new(big.Int)
+	}
+	const precision = 1_000_000_000
+	scaled := new(big.Int).Mul(amount, big.NewInt(int64(math.Round(factor*precision))))
+	return scaled.Div(scaled, big.NewInt(precision))
+}
+
+// ratio returns a/b, 0 when b is zero
+func ratio(a, b *big.Int) float64 {
+	if b.Sign() == 0 {
+		return 0
+	}
+	r, _ := new(big.Rat).SetFrac(a, b).Float64()
+	return r
+}
+
+func parseAmount(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
+
+func valueOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
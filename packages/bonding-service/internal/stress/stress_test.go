@@ -0,0 +1,134 @@
+package stress
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// testBond has a senior tranche owed 100 a period and a junior one owed 50
+func testBond(bondID string) models.Bond {
+	// 1% a month on the principal, as APY over a 365-day year
+	apy := 1.0 * 365 / 30
+	return models.Bond{
+		BondID: bondID,
+		Tranches: []models.Tranche{
+			{TrancheID: 1, Name: "Junior", Priority: 1, APY: apy, TotalInvested: "5000", Arrears: "0"},
+			{TrancheID: 0, Name: "Senior", Priority: 0, APY: apy, TotalInvested: "10000", Arrears: "0"},
+		},
+	}
+}
+
+func TestRunBond(t *testing.T) {
+	in := Input{
+		Bond:       testBond("1"),
+		Category:   "music",
+		Revenue:    big.NewInt(1200), // 200 a period over six periods
+		History:    6 * Period,
+		Assessment: &models.RiskAssessment{DefaultProbability: 0.1},
+	}
+
+	tests := []struct {
+		name         string
+		shocks       []Shock
+		wantRevenue  int64
+		wantHealth   string
+		wantCoverage []float64 // Senior, then junior
+		wantPD       float64
+		wantShocks   int
+	}{
+		{"other category", []Shock{{Name: "film", Category: "video", RevenueChange: -0.9}}, 200, Healthy, []float64{2, 200.0 / 150}, 0.1, 0},
+		{"junior short", []Shock{{Name: "streaming", Category: "music", RevenueChange: -0.4}}, 120, Stressed, []float64{1.2, 0.8}, 0.1 / 0.6, 1},
+		{"senior short", []Shock{{Name: "market", RevenueChange: -0.5}, {Name: "streaming", Category: "music", RevenueChange: -0.2, ValuationChange: -0.5}}, 80, Distressed, []float64{0.8, 80.0 / 150}, 0.5, 2},
+		{"wiped out", []Shock{{Name: "collapse", ValuationChange: -1}}, 200, Healthy, []float64{2, 200.0 / 150}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runBond(in, tt.shocks)
+			if got.BaselineRevenue.Int64() != 200 || got.BaselineHealth != Healthy {
+				t.Errorf("baseline = %v %s, want 200 HEALTHY", got.BaselineRevenue, got.BaselineHealth)
+			}
+			if got.StressedRevenue.Int64() != tt.wantRevenue {
+				t.Errorf("StressedRevenue = %v, want %d", got.StressedRevenue, tt.wantRevenue)
+			}
+			if got.StressedHealth != tt.wantHealth {
+				t.Errorf("StressedHealth = %s, want %s", got.StressedHealth, tt.wantHealth)
+			}
+			if len(got.Tranches) != 2 || got.Tranches[0].Name != "Senior" {
+				t.Fatalf("Tranches = %+v, want senior first", got.Tranches)
+			}
+			for i, want := range tt.wantCoverage {
+				if math.Abs(got.Tranches[i].Coverage-want) > 1e-9 {
+					t.Errorf("%s coverage = %v, want %v", got.Tranches[i].Name, got.Tranches[i].Coverage, want)
+				}
+			}
+			if math.Abs(got.StressedDefaultProbability-tt.wantPD) > 1e-9 {
+				t.Errorf("StressedDefaultProbability = %v, want %v", got.StressedDefaultProbability, tt.wantPD)
+			}
+			if len(got.Shocks) != tt.wantShocks {
+				t.Errorf("Shocks = %v, want %d", got.Shocks, tt.wantShocks)
+			}
+		})
+	}
+}
+
+func TestRunBondArrearsAndHistory(t *testing.T) {
+	bond := testBond("1")
+	bond.Tranches[1].Arrears = "50" // Senior arrears carried in
+
+	// Less than a period of history counts as one period
+	got := runBond(Input{Bond: bond, Revenue: big.NewInt(140), History: 10 * 24 * time.Hour}, nil)
+	senior := got.Tranches[0]
+	if senior.CouponDue.Int64() != 150 || senior.Paid.Int64() != 140 || senior.Shortfall.Int64() != 10 {
+		t.Errorf("senior = due %v paid %v short %v, want 150 140 10", senior.CouponDue, senior.Paid, senior.Shortfall)
+	}
+	if got.StressedHealth != Distressed || got.Assessed || got.StressedDefaultProbability != 0 {
+		t.Errorf("got %s assessed=%v pd=%v, want DISTRESSED unassessed 0", got.StressedHealth, got.Assessed, got.StressedDefaultProbability)
+	}
+}
+
+func TestRun(t *testing.T) {
+	inputs := []Input{
+		{Bond: testBond("1"), Category: "music", Revenue: big.NewInt(200), History: Period,
+			Assessment: &models.RiskAssessment{DefaultProbability: 0.1}},
+		{Bond: testBond("2"), Category: "video", Revenue: big.NewInt(200), History: Period,
+			Assessment: &models.RiskAssessment{DefaultProbability: 0.2}},
+	}
+	report := Run(inputs, []Shock{{Name: "streaming", Category: "music", RevenueChange: -0.6}})
+
+	if report.Healthy != 1 || report.Distressed != 1 || report.NewlyDistressed != 1 {
+		t.Errorf("healthy/distressed/newly = %d/%d/%d, want 1/1/1", report.Healthy, report.Distressed, report.NewlyDistressed)
+	}
+	if math.Abs(report.BaselineExpectedDefaults-0.3) > 1e-9 || math.Abs(report.ExpectedDefaults-0.45) > 1e-9 {
+		t.Errorf("expected defaults = %v -> %v, want 0.3 -> 0.45", report.BaselineExpectedDefaults, report.ExpectedDefaults)
+	}
+	// 15000 principal per bond at 0.1 + 0.2, then 0.25 + 0.2
+	if report.BaselineExpectedLoss.Int64() != 4500 || report.ExpectedLoss.Int64() != 6750 {
+		t.Errorf("expected loss = %v -> %v, want 4500 -> 6750", report.BaselineExpectedLoss, report.ExpectedLoss)
+	}
+	// The shocked bond pays 80 of the 150 due
+	if report.Shortfall.Int64() != 70 {
+		t.Errorf("Shortfall = %v, want 70", report.Shortfall)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		shocks  []Shock
+		wantErr bool
+	}{
+		{"valid", []Shock{{RevenueChange: -1, ValuationChange: 0.5}}, false},
+		{"none", nil, true},
+		{"below -100%", []Shock{{RevenueChange: -1.5}}, true},
+		{"NaN", []Shock{{ValuationChange: math.NaN()}}, true},
+	}
+	for _, tt := range tests {
+		if err := Validate(tt.shocks); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
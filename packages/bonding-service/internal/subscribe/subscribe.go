@@ -0,0 +1,246 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/knowton/bonding-service/internal/metrics"
+)
+
+// Client is the subset of a websocket ethclient.Client a subscription needs
+type Client interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	Close()
+}
+
+// Dialer connects to a websocket endpoint
+type Dialer func(ctx context.Context) (Client, error)
+
+// Dial returns a dialer that tries each ws:// or wss:// URL in order
+func Dial(urls []string) Dialer {
+	return func(ctx context.Context) (Client, error) {
+		var errs []error
+		for _, url := range urls {
+			client, err := ethclient.DialContext(ctx, url)
+			if err == nil {
+				return client, nil
+			}
+			errs = append(errs, err)
+		}
+		return nil, fmt.Errorf("failed to dial websocket endpoints: %w", errors.Join(errs...))
+	}
+}
+
+// Config controls subscriptions and reconnection
+type Config struct {
+	Addresses   []common.Address // Contracts whose logs are delivered; empty delivers heads only
+	MinBackoff  time.Duration    // First delay before reconnecting
+	MaxBackoff  time.Duration    // Reconnection delays double up to this
+	MaxBackfill uint64           // Maximum blocks per backfill log query
+}
+
+// DefaultConfig returns default subscription configuration
+func DefaultConfig() Config {
+	return Config{
+		MinBackoff:  time.Second,
+		MaxBackoff:  time.Minute,
+		MaxBackfill: 2000,
+	}
+}
+
+// Subscriber streams new heads and contract logs from a chain over
+// eth_subscribe. After a disconnect it resubscribes and backfills the logs of
+// the blocks it missed, so handlers see every log once the stream resumes.
+type Subscriber struct {
+	chain  string
+	dial   Dialer
+	config Config
+
+	headHandlers []func(*types.Header)
+	logHandlers  []func(types.Log)
+
+	lastBlock atomic.Uint64 // Highest block seen, 0 before the first
+	connected atomic.Bool
+}
+
+// New creates a subscriber for a chain
+func New(chain string, dial Dialer, config Config) *Subscriber {
+	defaults := DefaultConfig()
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaults.MinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = max(defaults.MaxBackoff, config.MinBackoff)
+	}
+	if config.MaxBackfill == 0 {
+		config.MaxBackfill = defaults.MaxBackfill
+	}
+	return &Subscriber{chain: chain, dial: dial, config: config}
+}
+
+// OnHead registers a handler for new heads. Handlers must be registered
+// before Start and must not block.
+func (s *Subscriber) OnHead(handler func(*types.Header)) {
+	s.headHandlers = append(s.headHandlers, handler)
+}
+
+// OnLog registers a handler for contract logs, including backfilled and
+// reorg-removed ones. Handlers must be registered before Start.
+func (s *Subscriber) OnLog(handler func(types.Log)) {
+	s.logHandlers = append(s.logHandlers, handler)
+}
+
+// Connected reports whether the subscription is live
+func (s *Subscriber) Connected() bool {
+	return s.connected.Load()
+}
+
+// Start subscribes until the context is cancelled, reconnecting with
+// exponential backoff
+func (s *Subscriber) Start(ctx context.Context) {
+	backoff := s.config.MinBackoff
+	for {
+		started := time.Now()
+		err := s.run(ctx)
+		s.connected.Store(false)
+		metrics.SubscriptionConnected.WithLabelValues(s.chain).Set(0)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// A subscription that stayed up for a while starts over with a short delay
+		if time.Since(started) > s.config.MaxBackoff {
+			backoff = s.config.MinBackoff
+		}
+		log.Printf("Subscription to %s lost: %v; reconnecting in %s", s.chain, err, backoff)
+		metrics.SubscriptionReconnects.WithLabelValues(s.chain).Inc()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, s.config.MaxBackoff)
+	}
+}
+
+// run holds one connection until it fails
+func (s *Subscriber) run(ctx context.Context) error {
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	heads := make(chan *types.Header, 16)
+	headSub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to new heads: %w", err)
+	}
+	defer headSub.Unsubscribe()
+
+	logs := make(chan types.Log, 256)
+	var logErr <-chan error
+	if len(s.config.Addresses) > 0 {
+		logSub, err := client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: s.config.Addresses}, logs)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to logs: %w", err)
+		}
+		defer logSub.Unsubscribe()
+		logErr = logSub.Err()
+	}
+
+	// Subscribing first means nothing between the backfill and the stream is lost
+	backfilled, err := s.backfill(ctx, client)
+	if err != nil {
+		return err
+	}
+	s.connected.Store(true)
+	metrics.SubscriptionConnected.WithLabelValues(s.chain).Set(1)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-headSub.Err():
+			return fmt.Errorf("head subscription failed: %w", err)
+		case err := <-logErr:
+			return fmt.Errorf("log subscription failed: %w", err)
+		case header := <-heads:
+			s.seen(header.Number.Uint64())
+			for _, handler := range s.headHandlers {
+				handler(header)
+			}
+		case l := <-logs:
+			// Backfill already delivered logs up to its head
+			if l.BlockNumber <= backfilled && !l.Removed {
+				continue
+			}
+			s.deliver(l)
+		}
+	}
+}
+
+// backfill delivers the logs of blocks missed since the last one seen and
+// returns the block it backfilled to. The first connection has nothing to
+// backfill.
+func (s *Subscriber) backfill(ctx context.Context, client Client) (uint64, error) {
+	last := s.lastBlock.Load()
+	if last == 0 || len(s.config.Addresses) == 0 {
+		return 0, nil
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read head block: %w", err)
+	}
+	to := head.Number.Uint64()
+	for from := last + 1; from <= to; from += s.config.MaxBackfill {
+		end := min(to, from+s.config.MaxBackfill-1)
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: s.config.Addresses,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to backfill logs %d-%d: %w", from, end, err)
+		}
+		for _, l := range logs {
+			s.deliver(l)
+		}
+	}
+	if to > last {
+		log.Printf("Backfilled %s blocks %d-%d after reconnecting", s.chain, last+1, to)
+	}
+	s.seen(to)
+	return to, nil
+}
+
+func (s *Subscriber) deliver(l types.Log) {
+	s.seen(l.BlockNumber)
+	for _, handler := range s.logHandlers {
+		handler(l)
+	}
+}
+
+// seen records the highest block observed
+func (s *Subscriber) seen(block uint64) {
+	for {
+		last := s.lastBlock.Load()
+		if block <= last || s.lastBlock.CompareAndSwap(last, block) {
+			return
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type fakeSubscription struct {
+	errc chan error
+}
+
+func (s *fakeSubscription) Err() <-chan error { return s.errc }
+func (s *fakeSubscription) Unsubscribe()      {}
+
+// fakeClient hands its subscription channels to the test
+type fakeClient struct {
+	head    uint64
+	history []types.Log // Returned by FilterLogs within the queried range
+
+	ready   chan struct{}
+	heads   chan<- *types.Header
+	logs    chan<- types.Log
+	headSub *fakeSubscription
+	queries []ethereum.FilterQuery
+}
+
+func newFakeClient(head uint64, history ...types.Log) *fakeClient {
+	return &fakeClient{head: head, history: history, ready: make(chan struct{}), headSub: &fakeSubscription{errc: make(chan error, 1)}}
+}
+
+func (c *fakeClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	c.heads = ch
+	return c.headSub, nil
+}
+
+func (c *fakeClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	c.logs = ch
+	return &fakeSubscription{errc: make(chan error)}, nil
+}
+
+func (c *fakeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.head)}, nil
+}
+
+func (c *fakeClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, q)
+	var logs []types.Log
+	for _, l := range c.history {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *fakeClient) Close() {}
+
+func TestSubscriberResubscribesAndBackfills(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	first := newFakeClient(10)
+	second := newFakeClient(15, types.Log{BlockNumber: 12}, types.Log{BlockNumber: 14})
+
+	clients := []*fakeClient{first, second}
+	var dials int
+	dial := func(ctx context.Context) (Client, error) {
+		if dials >= len(clients) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		client := clients[dials]
+		dials++
+		return client, nil
+	}
+
+	var mu sync.Mutex
+	var delivered []uint64
+	logged := make(chan struct{}, 16)
+
+	s := New("arbitrum", dial, Config{Addresses: []common.Address{contract}, MinBackoff: time.Millisecond, MaxBackfill: 3})
+	s.OnLog(func(l types.Log) {
+		mu.Lock()
+		delivered = append(delivered, l.BlockNumber)
+		mu.Unlock()
+		logged <- struct{}{}
+	})
+	heads := make(chan uint64, 16)
+	s.OnHead(func(h *types.Header) { heads <- h.Number.Uint64() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Start(ctx)
+
+	wait := func(ch <-chan struct{}) {
+		t.Helper()
+		select {
+		case <-ch:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for the subscriber")
+		}
+	}
+	waitFor := func(cond func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for !cond() {
+			if time.Now().After(deadline) {
+				t.Fatal("timed out waiting for the subscriber")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// First connection: a head and a log, then the connection drops
+	waitFor(s.Connected)
+	first.heads <- &types.Header{Number: big.NewInt(10)}
+	if got := <-heads; got != 10 {
+		t.Fatalf("head = %d, want 10", got)
+	}
+	first.logs <- types.Log{BlockNumber: 10}
+	wait(logged)
+	first.headSub.errc <- errors.New("connection reset")
+
+	// Second connection backfills blocks 11-15, then streams; a live log the
+	// backfill already delivered is skipped
+	wait(logged)
+	wait(logged)
+	waitFor(s.Connected)
+	second.logs <- types.Log{BlockNumber: 14}
+	second.logs <- types.Log{BlockNumber: 16}
+	wait(logged)
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []uint64{10, 12, 14, 16}
+	if len(delivered) != len(want) {
+		t.Fatalf("delivered blocks %v, want %v", delivered, want)
+	}
+	for i := range want {
+		if delivered[i] != want[i] {
+			t.Fatalf("delivered blocks %v, want %v", delivered, want)
+		}
+	}
+	// Backfill is split into MaxBackfill-sized queries
+	if len(second.queries) != 2 || second.queries[0].FromBlock.Uint64() != 11 || second.queries[1].ToBlock.Uint64() != 15 {
+		t.Errorf("backfill queries = %+v", second.queries)
+	}
+}
@@ -0,0 +1,150 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidCategory wraps validation failures for admin changes
+var ErrInvalidCategory = errors.New("invalid category")
+
+// ErrCategoryInUse is returned when deleting a category that has children
+var ErrCategoryInUse = errors.New("category has child categories")
+
+// Store keeps the taxonomy in the database and serves the latest snapshot.
+// Changes made through another instance are picked up by Start.
+type Store struct {
+	db      *gorm.DB
+	current atomic.Pointer[Taxonomy]
+}
+
+// NewStore creates a store serving the built-in categories until Load is called
+func NewStore(db *gorm.DB) *Store {
+	s := &Store{db: db}
+	s.current.Store(Default())
+	return s
+}
+
+// Load reads the taxonomy from the database, seeding the built-in categories
+// into an empty table
+func (s *Store) Load(ctx context.Context) error {
+	var categories []models.Category
+	if err := s.db.WithContext(ctx).Order("slug ASC").Find(&categories).Error; err != nil {
+		return fmt.Errorf("failed to load categories: %w", err)
+	}
+
+	if len(categories) == 0 {
+		categories = Defaults()
+		if err := s.db.WithContext(ctx).Create(&categories).Error; err != nil {
+			return fmt.Errorf("failed to seed categories: %w", err)
+		}
+	}
+
+	t, err := New(categories)
+	if err != nil {
+		return fmt.Errorf("stored taxonomy is invalid: %w", err)
+	}
+	s.current.Store(t)
+	return nil
+}
+
+// Start reloads the taxonomy periodically until the context is cancelled
+func (s *Store) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Failed to reload taxonomy: %v", err)
+			}
+		}
+	}
+}
+
+// Current returns the latest taxonomy snapshot
+func (s *Store) Current() *Taxonomy {
+	return s.current.Load()
+}
+
+// Resolve returns the parameters for a category in the latest snapshot
+func (s *Store) Resolve(category string) Params {
+	return s.Current().Resolve(category)
+}
+
+// Upsert creates or replaces a category. The whole tree is validated with the
+// change applied before it is saved.
+func (s *Store) Upsert(ctx context.Context, category models.Category) (*models.Category, error) {
+	category.Slug = Normalize(category.Slug)
+	category.Parent = Normalize(category.Parent)
+	category.Aliases = strings.Join(SplitAliases(category.Aliases), ",")
+	category.ExpiryPolicy = strings.ToUpper(strings.TrimSpace(category.ExpiryPolicy))
+	if category.Name == "" {
+		category.Name = category.Slug
+	}
+
+	var saved models.Category
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var categories []models.Category
+		if err := tx.Order("slug ASC").Find(&categories).Error; err != nil {
+			return fmt.Errorf("failed to load categories: %w", err)
+		}
+
+		replaced := false
+		for i := range categories {
+			if categories[i].Slug == category.Slug {
+				category.Model = categories[i].Model
+				categories[i] = category
+				replaced = true
+			}
+		}
+		if !replaced {
+			categories = append(categories, category)
+		}
+		if _, err := New(categories); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+		}
+
+		saved = category
+		if err := tx.Save(&saved).Error; err != nil {
+			return fmt.Errorf("failed to save category: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, s.Load(ctx)
+}
+
+// Delete removes a category that has no children; bonds already assessed
+// under it keep their valuation
+func (s *Store) Delete(ctx context.Context, slug string) (bool, error) {
+	slug = Normalize(slug)
+
+	var children int64
+	if err := s.db.WithContext(ctx).Model(&models.Category{}).Where("parent = ?", slug).Count(&children).Error; err != nil {
+		return false, fmt.Errorf("failed to check child categories: %w", err)
+	}
+	if children > 0 {
+		return false, ErrCategoryInUse
+	}
+
+	result := s.db.WithContext(ctx).Unscoped().Where("slug = ?", slug).Delete(&models.Category{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete category: %w", result.Error)
+	}
+	return result.RowsAffected > 0, s.Load(ctx)
+}
@@ -0,0 +1,159 @@
+package taxonomy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+// DefaultMultiplier applies to unknown categories and to roots without one
+const DefaultMultiplier = 1.0
+
+// DefaultExpiryPolicy applies to unknown categories and to roots without one
+const DefaultExpiryPolicy = models.ExpiryReject
+
+// Params are the risk parameters resolved for a category
+type Params struct {
+	Slug             string // Canonical category, empty when unknown
+	Multiplier       float64
+	ObsolescenceRisk bool
+	ExpiryPolicy     string
+}
+
+// Taxonomy is an immutable, validated snapshot of the category tree with
+// parameters already resolved through parents
+type Taxonomy struct {
+	categories []models.Category
+	params     map[string]Params // By slug and alias
+}
+
+// Defaults returns the built-in categories used before any are configured
+func Defaults() []models.Category {
+	return []models.Category{
+		{Slug: "music", Name: "Music", Multiplier: 1.5},
+		{Slug: "video", Name: "Video", Multiplier: 2.0},
+		{Slug: "ebook", Name: "E-book", Multiplier: 1.2},
+		{Slug: "course", Name: "Course", Multiplier: 1.8},
+		{Slug: "software", Name: "Software", Multiplier: 2.5, ObsolescenceRisk: true},
+		{Slug: "artwork", Name: "Artwork", Multiplier: 3.0},
+		{Slug: "research", Name: "Research", Multiplier: 1.3},
+		{Slug: "patent", Name: "Patent", Multiplier: 2.0},
+		{Slug: "trademark", Name: "Trademark", Multiplier: 1.6},
+	}
+}
+
+// Default returns the taxonomy of the built-in categories
+func Default() *Taxonomy {
+	t, err := New(Defaults())
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// New validates categories and builds a taxonomy. Slugs and aliases must be
+// unique, parents must exist and the tree must not contain cycles.
+func New(categories []models.Category) (*Taxonomy, error) {
+	bySlug := make(map[string]*models.Category, len(categories))
+	for i := range categories {
+		c := &categories[i]
+		if c.Slug == "" || c.Slug != Normalize(c.Slug) {
+			return nil, fmt.Errorf("invalid category slug %q", c.Slug)
+		}
+		if c.Multiplier < 0 {
+			return nil, fmt.Errorf("category %s has a negative multiplier", c.Slug)
+		}
+		switch c.ExpiryPolicy {
+		case "", models.ExpiryReject, models.ExpiryWarn, models.ExpiryIgnore:
+		default:
+			return nil, fmt.Errorf("category %s has unknown expiry policy %s", c.Slug, c.ExpiryPolicy)
+		}
+		if _, ok := bySlug[c.Slug]; ok {
+			return nil, fmt.Errorf("duplicate category %s", c.Slug)
+		}
+		bySlug[c.Slug] = c
+	}
+
+	t := &Taxonomy{categories: categories, params: make(map[string]Params, len(categories))}
+	for _, c := range categories {
+		params, err := resolve(bySlug, c.Slug)
+		if err != nil {
+			return nil, err
+		}
+		t.params[c.Slug] = params
+	}
+
+	for _, c := range categories {
+		for _, alias := range SplitAliases(c.Aliases) {
+			if existing, ok := t.params[alias]; ok {
+				return nil, fmt.Errorf("alias %q of %s is already used by %s", alias, c.Slug, existing.Slug)
+			}
+			t.params[alias] = t.params[c.Slug]
+		}
+	}
+	return t, nil
+}
+
+// resolve walks from a category to the root, taking the nearest multiplier
+// and expiry policy and any obsolescence flag on the way
+func resolve(bySlug map[string]*models.Category, slug string) (Params, error) {
+	params := Params{Slug: slug}
+	visited := make(map[string]bool)
+	for current := slug; current != ""; {
+		if visited[current] {
+			return Params{}, fmt.Errorf("category %s has a cyclic parent chain", slug)
+		}
+		visited[current] = true
+
+		c, ok := bySlug[current]
+		if !ok {
+			return Params{}, fmt.Errorf("category %s has unknown parent %s", slug, current)
+		}
+		if params.Multiplier == 0 {
+			params.Multiplier = c.Multiplier
+		}
+		if params.ExpiryPolicy == "" {
+			params.ExpiryPolicy = c.ExpiryPolicy
+		}
+		params.ObsolescenceRisk = params.ObsolescenceRisk || c.ObsolescenceRisk
+		current = c.Parent
+	}
+	if params.Multiplier == 0 {
+		params.Multiplier = DefaultMultiplier
+	}
+	if params.ExpiryPolicy == "" {
+		params.ExpiryPolicy = DefaultExpiryPolicy
+	}
+	return params, nil
+}
+
+// Resolve returns the parameters for a category name or alias. Unknown
+// categories get the defaults.
+func (t *Taxonomy) Resolve(category string) Params {
+	if params, ok := t.params[Normalize(category)]; ok {
+		return params
+	}
+	return Params{Multiplier: DefaultMultiplier, ExpiryPolicy: DefaultExpiryPolicy}
+}
+
+// Categories returns the categories in the taxonomy
+func (t *Taxonomy) Categories() []models.Category {
+	return t.categories
+}
+
+// Normalize converts a category name to slug form
+func Normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// SplitAliases parses a comma-separated alias list into normalized names
+func SplitAliases(aliases string) []string {
+	var names []string
+	for _, alias := range strings.Split(aliases, ",") {
+		if alias = Normalize(alias); alias != "" {
+			names = append(names, alias)
+		}
+	}
+	return names
+}
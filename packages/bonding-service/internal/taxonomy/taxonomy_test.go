@@ -0,0 +1,82 @@
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/knowton/bonding-service/internal/models"
+)
+
+func TestResolve(t *testing.T) {
+	tx, err := New([]models.Category{
+		{Slug: "software", Multiplier: 2.5, ObsolescenceRisk: true, ExpiryPolicy: models.ExpiryWarn},
+		{Slug: "game-asset", Parent: "software", Aliases: "Game Item, skin"},
+		{Slug: "audio", Multiplier: 1.4},
+		{Slug: "podcast", Parent: "audio", Multiplier: 1.1},
+		{Slug: "patent"},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		category string
+		want     Params
+	}{
+		{"software", Params{Slug: "software", Multiplier: 2.5, ObsolescenceRisk: true, ExpiryPolicy: models.ExpiryWarn}},
+		{"game-asset", Params{Slug: "game-asset", Multiplier: 2.5, ObsolescenceRisk: true, ExpiryPolicy: models.ExpiryWarn}},
+		{"  SKIN ", Params{Slug: "game-asset", Multiplier: 2.5, ObsolescenceRisk: true, ExpiryPolicy: models.ExpiryWarn}},
+		{"game item", Params{Slug: "game-asset", Multiplier: 2.5, ObsolescenceRisk: true, ExpiryPolicy: models.ExpiryWarn}},
+		{"podcast", Params{Slug: "podcast", Multiplier: 1.1, ExpiryPolicy: DefaultExpiryPolicy}},
+		{"patent", Params{Slug: "patent", Multiplier: DefaultMultiplier, ExpiryPolicy: DefaultExpiryPolicy}},
+		{"unknown", Params{Multiplier: DefaultMultiplier, ExpiryPolicy: DefaultExpiryPolicy}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			if got := tx.Resolve(tt.category); got != tt.want {
+				t.Errorf("Resolve(%q) = %+v, want %+v", tt.category, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []models.Category
+	}{
+		{"empty slug", []models.Category{{Slug: ""}}},
+		{"uppercase slug", []models.Category{{Slug: "Music"}}},
+		{"negative multiplier", []models.Category{{Slug: "music", Multiplier: -1}}},
+		{"unknown expiry policy", []models.Category{{Slug: "music", ExpiryPolicy: "MAYBE"}}},
+		{"duplicate slug", []models.Category{{Slug: "music"}, {Slug: "music"}}},
+		{"unknown parent", []models.Category{{Slug: "podcast", Parent: "audio"}}},
+		{"cycle", []models.Category{{Slug: "a", Parent: "b"}, {Slug: "b", Parent: "a"}}},
+		{"alias shadows slug", []models.Category{{Slug: "music"}, {Slug: "audio", Aliases: "music"}}},
+		{"duplicate alias", []models.Category{{Slug: "music", Aliases: "song"}, {Slug: "audio", Aliases: "song"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.categories); err == nil {
+				t.Errorf("New() accepted an invalid taxonomy")
+			}
+		})
+	}
+}
+
+func TestDefaultsMatchBuiltInMultipliers(t *testing.T) {
+	want := map[string]float64{
+		"music": 1.5, "video": 2.0, "ebook": 1.2, "course": 1.8,
+		"software": 2.5, "artwork": 3.0, "research": 1.3,
+	}
+	tx := Default()
+	for category, multiplier := range want {
+		if got := tx.Resolve(category).Multiplier; got != multiplier {
+			t.Errorf("Resolve(%q).Multiplier = %v, want %v", category, got, multiplier)
+		}
+	}
+	if !tx.Resolve("software").ObsolescenceRisk || tx.Resolve("music").ObsolescenceRisk {
+		t.Errorf("only software should carry obsolescence risk by default")
+	}
+}
@@ -0,0 +1,78 @@
+// Package tenant names the tenant a call acts for. Authenticated calls act
+// for the tenant their credentials belong to, bound with NewContext; the
+// x-tenant-id header only names the tenant of calls that never pass through
+// authentication, and may not contradict the credentials when they do.
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata header carrying the caller's tenant
+const MetadataKey = "x-tenant-id"
+
+// Default is the tenant used when a request does not name one
+const Default = "default"
+
+type tenantKey struct{}
+
+// NewContext returns a context acting for tenant id, whatever its metadata
+// says
+func NewContext(ctx context.Context, id string) context.Context {
+	if id = strings.TrimSpace(id); id == "" {
+		id = Default
+	}
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// FromContext returns the tenant bound by NewContext, otherwise the one in
+// incoming gRPC metadata
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(tenantKey{}).(string); ok {
+		return id
+	}
+	if id, ok := Requested(ctx); ok {
+		return id
+	}
+	return Default
+}
+
+// Bound returns the tenant bound by NewContext, ignoring metadata, or
+// Default. Limits and quotas are charged to it, so a caller can't spread
+// its calls over tenants it names.
+func Bound(ctx context.Context) string {
+	if id, ok := ctx.Value(tenantKey{}).(string); ok {
+		return id
+	}
+	return Default
+}
+
+// Requested returns the tenant named in incoming gRPC metadata, if any
+func Requested(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return "", false
+	}
+	id := strings.TrimSpace(values[0])
+	return id, id != ""
+}
+
+// HeaderName is the HTTP header carrying the caller's tenant on the REST gateway
+const HeaderName = "X-Tenant-ID"
+
+// FromRequest returns the tenant ID from an HTTP request
+func FromRequest(r *http.Request) string {
+	id := strings.TrimSpace(r.Header.Get(HeaderName))
+	if id == "" {
+		return Default
+	}
+	return id
+}
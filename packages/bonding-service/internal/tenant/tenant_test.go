@@ -0,0 +1,68 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"no metadata", context.Background(), Default},
+		{"missing header", metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "x")), Default},
+		{"blank header", metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "  ")), Default},
+		{"tenant set", metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "acme")), "acme"},
+		{"bound", NewContext(context.Background(), "acme"), "acme"},
+		{"bound over header", NewContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "globex")), "acme"), "acme"},
+		{"bound blank", NewContext(context.Background(), ""), Default},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromContext(tt.ctx); got != tt.want {
+				t.Errorf("FromContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBound(t *testing.T) {
+	header := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "globex"))
+	if got := Bound(header); got != Default {
+		t.Errorf("Bound() of a header = %q, want %q", got, Default)
+	}
+	if got := Bound(NewContext(header, "acme")); got != "acme" {
+		t.Errorf("Bound() = %q, want acme", got)
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{"missing header", "", Default},
+		{"blank header", "  ", Default},
+		{"tenant set", "acme", "acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v1/bonds", nil)
+			if tt.header != "" {
+				r.Header.Set(HeaderName, tt.header)
+			}
+			if got := FromRequest(r); got != tt.want {
+				t.Errorf("FromRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
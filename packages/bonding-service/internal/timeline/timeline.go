@@ -0,0 +1,155 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/archive"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+)
+
+// Timeline entry types
+const (
+	Issued              = "ISSUED"
+	Investment          = "INVESTMENT"
+	Distribution        = "DISTRIBUTION"
+	Redemption          = "REDEMPTION"
+	Transfer            = "TRANSFER"
+	Claim               = "CLAIM"
+	Rated               = "RATED" // The assessment the bond was issued with
+	RatingChanged       = models.BondEventRatingChanged
+	Covenant            = models.BondEventCovenant
+	StatusChanged       = models.BondEventStatusChanged
+	EmergencyWithdrawal = models.BondEventEmergencyWithdrawal
+)
+
+// chainTypes maps indexed contract events to entry types
+var chainTypes = map[string]string{
+	"BondIssued":          Issued,
+	"Investment":          Investment,
+	"RevenueDistributed":  Distribution,
+	"Redemption":          Redemption,
+	"PositionTransferred": Transfer,
+	"Claimed":             Claim,
+}
+
+// Entry is one event in a bond's timeline
+type Entry struct {
+	Type        string
+	OccurredAt  time.Time
+	TxHash      string // Empty for off-chain events
+	BlockNumber uint64
+	TrancheID   int // -1 when the event isn't about one tranche
+	Account     string
+	Amount      string
+	Detail      string
+}
+
+// Record appends an off-chain event to a bond's timeline
+func Record(db *gorm.DB, bondID, eventType, detail string) error {
+	event := models.BondEvent{BondID: bondID, Type: eventType, Detail: detail, OccurredAt: time.Now()}
+	if err := db.Create(&event).Error; err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// Load assembles a bond's timeline, oldest first, from its indexed contract
+// events, its risk assessment and its off-chain events. Contract events are
+// dated when they were indexed. A bond whose issuance wasn't indexed gets an
+// ISSUED entry from its own record.
+func Load(ctx context.Context, db *gorm.DB, bond *models.Bond) ([]Entry, error) {
+	db = db.WithContext(ctx)
+
+	var chainEvents []models.ChainEvent
+	if err := db.Where("bond_id = ? AND removed = ?", bond.BondID, false).
+		Order("block_number ASC").Order("id ASC").
+		Find(&chainEvents).Error; err != nil {
+		return nil, fmt.Errorf("failed to load chain events: %w", err)
+	}
+	// An archived bond's events were moved to the cold table, apart from any
+	// indexed since
+	if bond.ArchivedAt != nil {
+		var archived []models.ChainEvent
+		if err := db.Table(archive.ColdTable("chain_events")).
+			Where("bond_id = ? AND removed = ?", bond.BondID, false).
+			Order("block_number ASC").Order("id ASC").
+			Find(&archived).Error; err != nil {
+			return nil, fmt.Errorf("failed to load archived chain events: %w", err)
+		}
+		chainEvents = append(archived, chainEvents...)
+	}
+
+	var entries []Entry
+	issued := false
+	for _, e := range chainEvents {
+		entryType, ok := chainTypes[e.Event]
+		if !ok {
+			continue
+		}
+		entry := Entry{
+			Type:        entryType,
+			OccurredAt:  e.CreatedAt,
+			TxHash:      e.TxHash,
+			BlockNumber: e.BlockNumber,
+			TrancheID:   -1,
+			Account:     e.Account,
+			Amount:      e.Amount,
+		}
+		switch entryType {
+		case Investment, Redemption, Transfer:
+			entry.TrancheID = e.TrancheID
+		case Issued:
+			issued = true
+		}
+		entries = append(entries, entry)
+	}
+	if !issued {
+		entries = append(entries, Entry{
+			Type:       Issued,
+			OccurredAt: bond.CreatedAt,
+			TxHash:     bond.TxHash,
+			TrancheID:  -1,
+			Account:    bond.Issuer,
+			Amount:     bond.TotalValue,
+		})
+	}
+
+	var assessment models.RiskAssessment
+	err := db.Where("ip_nft_id = ?", bond.IPNFTId).Order("assessed_at ASC").First(&assessment).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to load risk assessment: %w", err)
+	}
+	if err == nil {
+		entries = append(entries, Entry{
+			Type:       Rated,
+			OccurredAt: assessment.AssessedAt,
+			TrancheID:  -1,
+			Detail:     fmt.Sprintf("rated %s at a valuation of $%.2f", assessment.RiskRating, assessment.ValuationUSD),
+		})
+	}
+
+	var events []models.BondEvent
+	if err := db.Where("bond_id = ?", bond.BondID).Order("occurred_at ASC").Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to load bond events: %w", err)
+	}
+	for _, e := range events {
+		entries = append(entries, Entry{
+			Type:       e.Type,
+			OccurredAt: e.OccurredAt,
+			TrancheID:  -1,
+			Detail:     e.Detail,
+		})
+	}
+
+	// Each source is already in order; the stable sort keeps it for ties
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].OccurredAt.Before(entries[j].OccurredAt)
+	})
+	return entries, nil
+}
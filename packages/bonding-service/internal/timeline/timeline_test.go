@@ -0,0 +1,115 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestLoad(t *testing.T) {
+	issuedAt := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		chainEvents    *sqlmock.Rows
+		archivedEvents *sqlmock.Rows // Nil for a live bond
+		wantTypes      []string
+		wantTranche    []int
+	}{
+		{
+			name: "indexed issuance",
+			chainEvents: sqlmock.NewRows([]string{"event", "tx_hash", "block_number", "tranche_id", "account", "amount", "created_at"}).
+				AddRow("BondIssued", "0xa1", 100, 0, "0xissuer", "1000", issuedAt).
+				AddRow("Investment", "0xa2", 120, 2, "0xinvestor", "50", issuedAt.Add(48*time.Hour)).
+				AddRow("RevenueDistributed", "0xa3", 300, 0, "", "80", issuedAt.Add(30*24*time.Hour)),
+			wantTypes:   []string{Issued, Rated, Investment, Covenant, Distribution},
+			wantTranche: []int{-1, -1, 2, -1, -1},
+		},
+		{
+			name:        "issuance not indexed",
+			chainEvents: sqlmock.NewRows([]string{"event"}),
+			wantTypes:   []string{Issued, Rated, Covenant},
+			wantTranche: []int{-1, -1, -1},
+		},
+		{
+			name: "archived",
+			chainEvents: sqlmock.NewRows([]string{"event", "tx_hash", "block_number", "tranche_id", "account", "amount", "created_at"}).
+				AddRow("Claimed", "0xa4", 900, 0, "0xinvestor", "5", issuedAt.Add(400*24*time.Hour)),
+			archivedEvents: sqlmock.NewRows([]string{"event", "tx_hash", "block_number", "tranche_id", "account", "amount", "created_at"}).
+				AddRow("BondIssued", "0xa1", 100, 0, "0xissuer", "1000", issuedAt).
+				AddRow("Investment", "0xa2", 120, 1, "0xinvestor", "50", issuedAt.Add(48*time.Hour)),
+			wantTypes:   []string{Issued, Rated, Investment, Covenant, Claim},
+			wantTranche: []int{-1, -1, 1, -1, -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			mock.ExpectQuery(`SELECT \* FROM "chain_events" WHERE bond_id = \$1 AND removed = \$2`).
+				WithArgs("bond-1", false).
+				WillReturnRows(tt.chainEvents)
+			if tt.archivedEvents != nil {
+				mock.ExpectQuery(`SELECT \* FROM "archived_chain_events" WHERE bond_id = \$1 AND removed = \$2`).
+					WithArgs("bond-1", false).
+					WillReturnRows(tt.archivedEvents)
+			}
+			mock.ExpectQuery(`SELECT \* FROM "risk_assessments" WHERE ip_nft_id = \$1`).
+				WithArgs("ipnft-1", 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "risk_rating", "valuation_usd", "assessed_at"}).
+					AddRow(1, "A", 250000.0, issuedAt.Add(time.Hour)))
+			mock.ExpectQuery(`SELECT \* FROM "bond_events" WHERE bond_id = \$1`).
+				WithArgs("bond-1").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "bond_id", "type", "detail", "occurred_at"}).
+					AddRow(1, "bond-1", Covenant, "rating review", issuedAt.Add(10*24*time.Hour)))
+
+			bond := &models.Bond{BondID: "bond-1", IPNFTId: "ipnft-1", TxHash: "0xa1", TotalValue: "1000"}
+			bond.CreatedAt = issuedAt
+			if tt.archivedEvents != nil {
+				archivedAt := issuedAt.Add(300 * 24 * time.Hour)
+				bond.ArchivedAt = &archivedAt
+			}
+			entries, err := Load(context.Background(), db, bond)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if len(entries) != len(tt.wantTypes) {
+				t.Fatalf("got %d entries, want %d: %+v", len(entries), len(tt.wantTypes), entries)
+			}
+			for i, e := range entries {
+				if e.Type != tt.wantTypes[i] || e.TrancheID != tt.wantTranche[i] {
+					t.Errorf("entry %d = %s (tranche %d), want %s (tranche %d)",
+						i, e.Type, e.TrancheID, tt.wantTypes[i], tt.wantTranche[i])
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
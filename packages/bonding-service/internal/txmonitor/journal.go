@@ -0,0 +1,100 @@
+package txmonitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/knowton/bonding-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Final statuses recorded in the journal once a transaction stops being tracked
+const (
+	StatusMined    = "MINED"
+	StatusReverted = "REVERTED"
+	StatusReplaced = "REPLACED"
+	StatusExpired  = "EXPIRED" // Dropped and past the retention period
+)
+
+// SetJournal persists tracked transactions so they survive restarts
+func (m *Monitor) SetJournal(db *gorm.DB) {
+	m.db = db
+}
+
+// Resume loads the journal's unfinished transactions and returns how many
+// are tracked again. Dropped transactions past their retention are skipped.
+func (m *Monitor) Resume(ctx context.Context) (int, error) {
+	if m.db == nil {
+		return 0, nil
+	}
+
+	var records []models.TransactionRecord
+	err := m.db.WithContext(ctx).
+		Where("status IN ? OR (status = ? AND submitted_at > ?)",
+			[]string{StatusPending, StatusStuck}, StatusDropped, time.Now().Add(-m.config.DroppedRetention)).
+		Order("submitted_at ASC").
+		Find(&records).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to load transaction journal: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range records {
+		tx := &Transaction{
+			Hash:        common.HexToHash(r.TxHash),
+			Chain:       r.Chain,
+			Purpose:     r.Purpose,
+			BondID:      r.BondID,
+			Nonce:       r.Nonce,
+			Status:      r.Status,
+			SubmittedAt: r.SubmittedAt,
+		}
+		if r.CheckedAt != nil {
+			tx.CheckedAt = *r.CheckedAt
+		}
+		m.txs[tx.Hash] = tx
+	}
+	m.updateGauges()
+	return len(records), nil
+}
+
+// journalCreate records a newly tracked transaction
+func (m *Monitor) journalCreate(ctx context.Context, tx Transaction) {
+	if m.db == nil {
+		return
+	}
+	record := models.TransactionRecord{
+		TxHash:      tx.Hash.Hex(),
+		Chain:       tx.Chain,
+		Nonce:       tx.Nonce,
+		Purpose:     tx.Purpose,
+		BondID:      tx.BondID,
+		Status:      tx.Status,
+		SubmittedAt: tx.SubmittedAt,
+	}
+	err := m.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tx_hash"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "submitted_at", "updated_at"}),
+	}).Create(&record).Error
+	if err != nil {
+		log.Printf("Failed to journal transaction %s: %v", record.TxHash, err)
+	}
+}
+
+// journalUpdate records a change to a transaction; nil updates are skipped
+func (m *Monitor) journalUpdate(ctx context.Context, hash common.Hash, updates map[string]interface{}) {
+	if m.db == nil || updates == nil {
+		return
+	}
+	err := m.db.WithContext(ctx).Model(&models.TransactionRecord{}).
+		Where("tx_hash = ?", hash.Hex()).
+		Updates(updates).Error
+	if err != nil {
+		log.Printf("Failed to journal transaction %s: %v", hash.Hex(), err)
+	}
+}
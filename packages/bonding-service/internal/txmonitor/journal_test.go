@@ -0,0 +1,102 @@
+package txmonitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestResume(t *testing.T) {
+	db, mock := newMockDB(t)
+	submitted := time.Now().Add(-10 * time.Minute)
+	stuck := common.HexToHash("0x01")
+	pending := common.HexToHash("0x02")
+
+	mock.ExpectQuery(`SELECT \* FROM "transaction_records" WHERE status IN`).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_hash", "chain", "nonce", "purpose", "bond_id", "status", "submitted_at"}).
+			AddRow(stuck.Hex(), "arbitrum", 4, PurposeIssueBond, "1", StatusStuck, submitted).
+			AddRow(pending.Hex(), "arbitrum", 0, PurposeDistributeRevenue, "2", StatusPending, submitted))
+
+	m := New(DefaultConfig())
+	m.SetJournal(db)
+	resumed, err := m.Resume(context.Background())
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if resumed != 2 {
+		t.Errorf("Resume() = %d, want 2", resumed)
+	}
+
+	got := m.Pending(StatusStuck)
+	if len(got) != 1 || got[0].Hash != stuck || got[0].Nonce != 4 || got[0].Purpose != PurposeIssueBond || !got[0].SubmittedAt.Equal(submitted) {
+		t.Errorf("Pending(STUCK) = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJournalRecordsLifecycle(t *testing.T) {
+	ctx := context.Background()
+	db, mock := newMockDB(t)
+	chain := newFakeChain()
+	m := New(Config{StuckAfter: time.Hour, DroppedAfter: 3, DroppedRetention: time.Hour})
+	m.AddChain("arbitrum", chain)
+	m.SetJournal(db)
+
+	original := common.HexToHash("0x01")
+	replacement := common.HexToHash("0x02")
+
+	mock.ExpectQuery(`INSERT INTO "transaction_records" .* ON CONFLICT \("tx_hash"\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	m.Track(ctx, "arbitrum", original, PurposeInvest, "7")
+
+	// The node reporting the nonce is recorded; later identical checks are not
+	chain.pending[original] = true
+	mock.ExpectExec(`UPDATE "transaction_records" SET .*"nonce"=.*WHERE tx_hash =`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	m.CheckOnce(ctx)
+	m.CheckOnce(ctx)
+
+	mock.ExpectExec(`UPDATE "transaction_records" SET .*"replaced_by"=.*"status"=.*WHERE tx_hash =`).
+		WithArgs(replacement.Hex(), StatusReplaced, sqlmock.AnyArg(), original.Hex()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "transaction_records"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	m.Replace(ctx, original, replacement, "arbitrum")
+
+	chain.mined[replacement] = true
+	mock.ExpectExec(`UPDATE "transaction_records" SET .*"status"=.*WHERE tx_hash =`).
+		WithArgs(sqlmock.AnyArg(), StatusMined, sqlmock.AnyArg(), replacement.Hex()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	m.CheckOnce(ctx)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,322 @@
+package txmonitor
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"gorm.io/gorm"
+)
+
+// Transaction statuses
+const (
+	StatusPending = "PENDING" // Waiting to be mined
+	StatusStuck   = "STUCK"   // Pending for longer than StuckAfter
+	StatusDropped = "DROPPED" // No longer known to the node and never mined
+)
+
+// Transaction purposes
+const (
+	PurposeIssueBond           = "ISSUE_BOND"
+	PurposeInvest              = "INVEST"
+	PurposeDistributeRevenue   = "DISTRIBUTE_REVENUE"
+	PurposeRedeem              = "REDEEM"
+	PurposeTransferPosition    = "TRANSFER_POSITION"
+	PurposeCommitStateRoot     = "COMMIT_STATE_ROOT"
+	PurposeEmergencyWithdrawal = "EMERGENCY_WITHDRAWAL"
+)
+
+// ChainClient is the subset of ethclient.Client the monitor needs
+type ChainClient interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// Config controls when transactions are reported
+type Config struct {
+	Interval         time.Duration // How often pending transactions are checked
+	StuckAfter       time.Duration // Pending time after which a transaction is stuck
+	DroppedAfter     int           // Consecutive checks the node doesn't know the transaction before it is dropped
+	DroppedRetention time.Duration // How long dropped transactions stay listed
+}
+
+// DefaultConfig returns default monitor configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:         30 * time.Second,
+		StuckAfter:       5 * time.Minute,
+		DroppedAfter:     3,
+		DroppedRetention: 24 * time.Hour,
+	}
+}
+
+// Transaction is a submitted transaction that has not been mined
+type Transaction struct {
+	Hash        common.Hash
+	Chain       string
+	Purpose     string
+	BondID      string
+	Nonce       uint64 // Filled in once the node reports the transaction
+	Status      string
+	SubmittedAt time.Time
+	CheckedAt   time.Time
+	misses      int
+}
+
+// Monitor tracks submitted transactions until they are mined and flags the
+// ones that are stuck or dropped from the mempool
+type Monitor struct {
+	mu      sync.Mutex
+	clients map[string]ChainClient
+	txs     map[common.Hash]*Transaction
+	config  Config
+	db      *gorm.DB // Optional journal
+}
+
+// New creates a transaction monitor
+func New(config Config) *Monitor {
+	return &Monitor{
+		clients: make(map[string]ChainClient),
+		txs:     make(map[common.Hash]*Transaction),
+		config:  config,
+	}
+}
+
+// AddChain registers the client used to check a chain's transactions
+func (m *Monitor) AddChain(name string, client ChainClient) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clients[name] = client
+}
+
+// Track starts watching a submitted transaction
+func (m *Monitor) Track(ctx context.Context, chain string, hash common.Hash, purpose, bondID string) {
+	tx := &Transaction{
+		Hash:        hash,
+		Chain:       chain,
+		Purpose:     purpose,
+		BondID:      bondID,
+		Status:      StatusPending,
+		SubmittedAt: time.Now(),
+	}
+
+	m.mu.Lock()
+	m.txs[hash] = tx
+	m.updateGauges()
+	m.mu.Unlock()
+
+	m.journalCreate(ctx, *tx)
+}
+
+// Replace watches a replacement transaction in place of the original,
+// keeping its purpose; the stuck timer restarts from the replacement
+func (m *Monitor) Replace(ctx context.Context, original, replacement common.Hash, chain string) {
+	m.mu.Lock()
+	tx := &Transaction{Chain: chain}
+	if existing, ok := m.txs[original]; ok {
+		tx = existing
+		delete(m.txs, original)
+	}
+	tx.Hash = replacement
+	tx.SubmittedAt = time.Now()
+	tx.Status = StatusPending
+	tx.misses = 0
+	m.txs[replacement] = tx
+	m.updateGauges()
+	snapshot := *tx
+	m.mu.Unlock()
+
+	m.journalUpdate(ctx, original, map[string]interface{}{"status": StatusReplaced, "replaced_by": replacement.Hex()})
+	m.journalCreate(ctx, snapshot)
+}
+
+// Start checks transactions until the context is cancelled
+func (m *Monitor) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CheckOnce(ctx)
+		}
+	}
+}
+
+// CheckOnce refreshes the status of every tracked transaction
+func (m *Monitor) CheckOnce(ctx context.Context) {
+	m.mu.Lock()
+	txs := make([]Transaction, 0, len(m.txs))
+	for _, tx := range m.txs {
+		txs = append(txs, *tx)
+	}
+	clients := m.clients
+	m.mu.Unlock()
+
+	for _, tx := range txs {
+		client, ok := clients[tx.Chain]
+		if !ok {
+			continue
+		}
+		m.check(ctx, client, tx)
+	}
+
+	m.mu.Lock()
+	m.updateGauges()
+	m.mu.Unlock()
+}
+
+// drainInterval is how often Drain checks the transactions still in flight
+const drainInterval = 2 * time.Second
+
+// Drain checks in-flight transactions until every pending or stuck one is
+// mined or the context is done, and returns how many are left. Those stay
+// in the journal and are resumed on the next start.
+func (m *Monitor) Drain(ctx context.Context) int {
+	for {
+		m.CheckOnce(ctx)
+		left := len(m.Pending(StatusPending)) + len(m.Pending(StatusStuck))
+		if left == 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return left
+		case <-time.After(drainInterval):
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context, client ChainClient, snapshot Transaction) {
+	now := time.Now()
+	receipt, receiptErr := client.TransactionReceipt(ctx, snapshot.Hash)
+	var (
+		onChain *types.Transaction
+		pending bool
+		err     error
+	)
+	if receiptErr != nil {
+		receipt = nil
+		onChain, pending, err = client.TransactionByHash(ctx, snapshot.Hash)
+	}
+
+	m.mu.Lock()
+	updates := m.update(snapshot.Hash, now, receipt, onChain, pending, err)
+	m.mu.Unlock()
+
+	m.journalUpdate(ctx, snapshot.Hash, updates)
+}
+
+// update applies a check's result to a tracked transaction and returns the
+// journal changes, nil when nothing worth recording changed. A nil receipt
+// means the transaction isn't mined. The caller holds m.mu.
+func (m *Monitor) update(
+	hash common.Hash,
+	now time.Time,
+	receipt *types.Receipt,
+	onChain *types.Transaction,
+	pending bool,
+	err error,
+) map[string]interface{} {
+	tx, ok := m.txs[hash]
+	if !ok {
+		return nil // Replaced while checking
+	}
+	tx.CheckedAt = now
+	age := now.Sub(tx.SubmittedAt).Round(time.Second)
+	previous := *tx
+
+	switch {
+	case receipt != nil:
+		status := StatusMined
+		if receipt.Status == types.ReceiptStatusFailed {
+			status = StatusReverted
+			log.Printf("ALERT: %s transaction %s on %s (bond %s) reverted", tx.Purpose, tx.Hash.Hex(), tx.Chain, tx.BondID)
+		} else if tx.Status != StatusPending {
+			log.Printf("%s transaction %s on %s mined after %s", tx.Purpose, tx.Hash.Hex(), tx.Chain, age)
+		}
+		delete(m.txs, tx.Hash)
+		return map[string]interface{}{"status": status, "checked_at": now}
+
+	case errors.Is(err, ethereum.NotFound):
+		if tx.Status == StatusDropped {
+			if now.Sub(tx.SubmittedAt) > m.config.DroppedRetention {
+				delete(m.txs, tx.Hash)
+				return map[string]interface{}{"status": StatusExpired, "checked_at": now}
+			}
+			return nil
+		}
+		tx.misses++
+		if tx.misses >= m.config.DroppedAfter {
+			tx.Status = StatusDropped
+			metrics.DroppedTransactions.WithLabelValues(tx.Chain).Inc()
+			log.Printf("ALERT: %s transaction %s on %s (bond %s) was dropped from the mempool after %s",
+				tx.Purpose, tx.Hash.Hex(), tx.Chain, tx.BondID, age)
+		}
+
+	case err != nil:
+		log.Printf("Failed to check transaction %s on %s: %v", tx.Hash.Hex(), tx.Chain, err)
+
+	default:
+		tx.misses = 0
+		tx.Nonce = onChain.Nonce()
+		if tx.Status == StatusDropped {
+			tx.Status = StatusPending // Rebroadcast by another node
+		}
+		if pending && tx.Status == StatusPending && age > m.config.StuckAfter {
+			tx.Status = StatusStuck
+			metrics.StuckTransactions.WithLabelValues(tx.Chain).Inc()
+			log.Printf("ALERT: %s transaction %s on %s (bond %s, nonce %d) pending for %s",
+				tx.Purpose, tx.Hash.Hex(), tx.Chain, tx.BondID, tx.Nonce, age)
+		}
+	}
+
+	if tx.Status == previous.Status && tx.Nonce == previous.Nonce {
+		return nil
+	}
+	return map[string]interface{}{"status": tx.Status, "nonce": tx.Nonce, "checked_at": now}
+}
+
+// Pending returns the tracked transactions, oldest first. An empty status
+// returns all of them.
+func (m *Monitor) Pending(status string) []Transaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txs := make([]Transaction, 0, len(m.txs))
+	for _, tx := range m.txs {
+		if status == "" || tx.Status == status {
+			txs = append(txs, *tx)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].SubmittedAt.Before(txs[j].SubmittedAt)
+	})
+	return txs
+}
+
+// updateGauges publishes the number of transactions per chain and status;
+// the caller holds m.mu
+func (m *Monitor) updateGauges() {
+	counts := make(map[[2]string]int)
+	for chain := range m.clients {
+		for _, status := range []string{StatusPending, StatusStuck, StatusDropped} {
+			counts[[2]string{chain, status}] = 0
+		}
+	}
+	for _, tx := range m.txs {
+		counts[[2]string{tx.Chain, tx.Status}]++
+	}
+	for key, count := range counts {
+		metrics.PendingTransactions.WithLabelValues(key[0], key[1]).Set(float64(count))
+	}
+}
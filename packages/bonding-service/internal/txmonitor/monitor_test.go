@@ -0,0 +1,126 @@
+package txmonitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakeChain reports transactions as pending, mined or unknown
+type fakeChain struct {
+	pending map[common.Hash]bool
+	mined   map[common.Hash]bool
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{pending: map[common.Hash]bool{}, mined: map[common.Hash]bool{}}
+}
+
+func (c *fakeChain) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if c.pending[hash] {
+		return types.NewTx(&types.LegacyTx{Nonce: 4, GasPrice: big.NewInt(1)}), true, nil
+	}
+	return nil, false, ethereum.NotFound
+}
+
+func (c *fakeChain) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if c.mined[hash] {
+		return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func TestMonitor(t *testing.T) {
+	ctx := context.Background()
+	chain := newFakeChain()
+	m := New(Config{StuckAfter: time.Minute, DroppedAfter: 2, DroppedRetention: time.Hour})
+	m.AddChain("arbitrum", chain)
+
+	stuck := common.HexToHash("0x01")
+	dropped := common.HexToHash("0x02")
+	mined := common.HexToHash("0x03")
+	fresh := common.HexToHash("0x04")
+	for _, hash := range []common.Hash{stuck, dropped, mined, fresh} {
+		m.Track(ctx, "arbitrum", hash, PurposeInvest, "7")
+	}
+	chain.pending[stuck] = true
+	chain.pending[fresh] = true
+	chain.mined[mined] = true
+
+	// Backdate everything except the fresh transaction
+	m.mu.Lock()
+	for hash, tx := range m.txs {
+		if hash != fresh {
+			tx.SubmittedAt = time.Now().Add(-2 * time.Minute)
+		}
+	}
+	m.mu.Unlock()
+
+	m.CheckOnce(ctx)
+	m.CheckOnce(ctx)
+
+	want := map[common.Hash]string{stuck: StatusStuck, dropped: StatusDropped, fresh: StatusPending}
+	got := m.Pending("")
+	if len(got) != len(want) {
+		t.Fatalf("Pending() returned %d transactions, want %d", len(got), len(want))
+	}
+	for _, tx := range got {
+		if tx.Status != want[tx.Hash] {
+			t.Errorf("%s status = %s, want %s", tx.Hash.Hex(), tx.Status, want[tx.Hash])
+		}
+		if tx.Hash == stuck && tx.Nonce != 4 {
+			t.Errorf("stuck nonce = %d, want 4", tx.Nonce)
+		}
+	}
+	if stuckOnly := m.Pending(StatusStuck); len(stuckOnly) != 1 || stuckOnly[0].Hash != stuck {
+		t.Errorf("Pending(STUCK) = %v", stuckOnly)
+	}
+
+	// A speed-up replaces the stuck transaction and is pending again
+	replacement := common.HexToHash("0x05")
+	m.Replace(ctx, stuck, replacement, "arbitrum")
+	chain.pending[replacement] = true
+	m.CheckOnce(ctx)
+	for _, tx := range m.Pending("") {
+		if tx.Hash == stuck {
+			t.Errorf("replaced transaction is still tracked")
+		}
+		if tx.Hash == replacement && (tx.Purpose != PurposeInvest || tx.BondID != "7" || tx.Status != StatusPending) {
+			t.Errorf("replacement lost its purpose: %+v", tx)
+		}
+	}
+}
+
+func TestDrain(t *testing.T) {
+	chain := newFakeChain()
+	m := New(DefaultConfig())
+	m.AddChain("arbitrum", chain)
+
+	mined := common.HexToHash("0x01")
+	inFlight := common.HexToHash("0x02")
+	dropped := common.HexToHash("0x03")
+	for _, hash := range []common.Hash{mined, inFlight, dropped} {
+		m.Track(context.Background(), "arbitrum", hash, PurposeInvest, "7")
+	}
+	chain.mined[mined] = true
+	chain.pending[inFlight] = true
+	m.mu.Lock()
+	m.txs[dropped].Status = StatusDropped // Not worth waiting for
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if left := m.Drain(ctx); left != 1 {
+		t.Errorf("Drain() = %d, want 1 still in flight", left)
+	}
+
+	chain.mined[inFlight] = true
+	if left := m.Drain(context.Background()); left != 0 {
+		t.Errorf("Drain() after mining = %d, want 0", left)
+	}
+}
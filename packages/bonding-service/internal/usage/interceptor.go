@@ -0,0 +1,44 @@
+package usage
+
+import (
+	"context"
+
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor rejects calls from callers over their monthly quota
+// and records every served call with its outcome and payload sizes. Calls
+// are counted against the tenant authentication bound and the ID principal
+// returns for the authenticated caller, "" for an anonymous one, never
+// against what the call's headers claim.
+func UnaryServerInterceptor(r *Recorder, principal func(ctx context.Context) string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		tenantID := tenant.Bound(ctx)
+		keyID := principal(ctx)
+		if err := r.Allow(ctx, tenantID, keyID); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		responseBytes := 0
+		if err == nil {
+			responseBytes = size(resp)
+		}
+		r.Record(tenantID, keyID, info.FullMethod, err != nil, size(req), responseBytes)
+		return resp, err
+	}
+}
+
+func size(v interface{}) int {
+	if m, ok := v.(proto.Message); ok {
+		return proto.Size(m)
+	}
+	return 0
+}
@@ -0,0 +1,228 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/decimal"
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Day returns the spend day containing t, as YYYY-MM-DD in UTC
+func Day(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// ParseUSD parses a USD amount such as "0.002" to millionths of a USD
+func ParseUSD(s string) (int64, error) {
+	micros, err := decimal.Scaled(s, 6)
+	if err != nil {
+		return 0, err
+	}
+	if micros.Sign() < 0 || !micros.IsInt64() {
+		return 0, fmt.Errorf("USD amount %q out of range", s)
+	}
+	return micros.Int64(), nil
+}
+
+// FormatUSD formats millionths of a USD as a decimal string, e.g. "1.25"
+func FormatUSD(micros int64) string {
+	s := fmt.Sprintf("%d.%06d", micros/1e6, micros%1e6)
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
+
+// SpendConfig prices oracle calls and sets each tenant's daily budget.
+// Amounts are in millionths of a USD; a zero limit is no limit.
+type SpendConfig struct {
+	UnitPrice      int64            // Per call
+	ProviderPrices map[string]int64 // Per call to the named provider, overriding UnitPrice
+	DailySoftLimit int64            // Spend past which an alert is raised
+	DailyHardLimit int64            // Spend at which further calls are rejected
+	Refresh        time.Duration    // How long a tenant's loaded total is trusted before it is reloaded
+}
+
+// DefaultSpendConfig returns free, unlimited oracle calls
+func DefaultSpendConfig() SpendConfig {
+	return SpendConfig{Refresh: 30 * time.Second}
+}
+
+type dailySpend struct {
+	day      string
+	micros   int64
+	loadedAt time.Time
+	alerted  bool // The soft limit alert was raised
+}
+
+// SpendTracker records what each tenant spends on oracle calls per day and
+// provider, and enforces the daily budget. It implements oracle.SpendMeter.
+type SpendTracker struct {
+	db *gorm.DB
+
+	mu     sync.Mutex
+	config SpendConfig
+	spent  map[string]*dailySpend // By tenant
+}
+
+// NewSpendTracker creates a spend tracker
+func NewSpendTracker(db *gorm.DB, config SpendConfig) (*SpendTracker, error) {
+	t := &SpendTracker{db: db, spent: make(map[string]*dailySpend)}
+	if err := t.SetConfig(config); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SetConfig replaces the prices and limits, e.g. when the configuration is
+// reloaded. Today's spend so far counts against the new limits.
+func (t *SpendTracker) SetConfig(config SpendConfig) error {
+	if config.DailyHardLimit > 0 && config.DailySoftLimit > config.DailyHardLimit {
+		return fmt.Errorf("oracle soft limit $%s is above the hard limit $%s",
+			FormatUSD(config.DailySoftLimit), FormatUSD(config.DailyHardLimit))
+	}
+	if config.Refresh <= 0 {
+		config.Refresh = DefaultSpendConfig().Refresh
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.config = config
+	return nil
+}
+
+// Config returns the tracker's prices and limits
+func (t *SpendTracker) Config() SpendConfig {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.config
+}
+
+// Price returns what one call to provider costs
+func (t *SpendTracker) Price(provider string) int64 {
+	config := t.Config()
+	if price, ok := config.ProviderPrices[provider]; ok {
+		return price
+	}
+	return config.UnitPrice
+}
+
+// Allow rejects oracle calls once the caller's tenant has reached its daily
+// hard limit. The tenant is the one authentication bound, not a header.
+func (t *SpendTracker) Allow(ctx context.Context) error {
+	limit := t.Config().DailyHardLimit
+	if limit == 0 {
+		return nil
+	}
+	tenantID := tenant.Bound(ctx)
+	spent, err := t.spentToday(ctx, tenantID)
+	if err != nil {
+		// Don't turn a database outage into an oracle outage
+		log.Printf("Failed to check oracle spend of %s: %v", tenantID, err)
+		return nil
+	}
+	if spent >= limit {
+		metrics.OracleSpendLimited.WithLabelValues(tenantID, "hard").Inc()
+		return fmt.Errorf("%w: tenant %s spent $%s of its $%s daily limit",
+			oracle.ErrBudgetExceeded, tenantID, FormatUSD(spent), FormatUSD(limit))
+	}
+	return nil
+}
+
+// Record charges the caller's tenant for one call to provider, alerting the
+// first time the day's spend passes the soft limit
+func (t *SpendTracker) Record(ctx context.Context, provider string) {
+	tenantID := tenant.Bound(ctx)
+	cost := t.Price(provider)
+	day := Day(time.Now())
+
+	err := t.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "day"}, {Name: "provider"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "calls"}, Value: gorm.Expr("oracle_spends.calls + excluded.calls")},
+			{Column: clause.Column{Name: "cost_micros"}, Value: gorm.Expr("oracle_spends.cost_micros + excluded.cost_micros")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("excluded.updated_at")},
+		},
+	}).Create(&models.OracleSpend{
+		TenantID:   tenantID,
+		Day:        day,
+		Provider:   provider,
+		Calls:      1,
+		CostMicros: cost,
+	}).Error
+	if err != nil {
+		log.Printf("Failed to record oracle spend of %s: %v", tenantID, err)
+	}
+
+	t.mu.Lock()
+	if s := t.spent[tenantID]; s != nil && s.day == day && time.Since(s.loadedAt) < t.config.Refresh {
+		s.micros += cost
+	}
+	softLimit := t.config.DailySoftLimit
+	t.mu.Unlock()
+	if softLimit == 0 {
+		return
+	}
+
+	// A stale total is reloaded, this call included
+	spent, err := t.spentToday(ctx, tenantID)
+	if err != nil {
+		log.Printf("Failed to check oracle spend of %s: %v", tenantID, err)
+		return
+	}
+	t.mu.Lock()
+	s := t.spent[tenantID]
+	alert := spent >= softLimit && !s.alerted
+	s.alerted = s.alerted || alert
+	t.mu.Unlock()
+	if alert {
+		metrics.OracleSpendLimited.WithLabelValues(tenantID, "soft").Inc()
+		log.Printf("ALERT: tenant %s has spent $%s on oracle calls today, past its $%s soft limit",
+			tenantID, FormatUSD(spent), FormatUSD(softLimit))
+	}
+}
+
+// spentToday returns a tenant's spend today, reloading the recorded total
+// once it is older than the refresh interval so other instances' calls count
+func (t *SpendTracker) spentToday(ctx context.Context, tenantID string) (int64, error) {
+	now := time.Now()
+	day := Day(now)
+	t.mu.Lock()
+	s := t.spent[tenantID]
+	if s != nil && s.day == day && now.Sub(s.loadedAt) < t.config.Refresh {
+		defer t.mu.Unlock()
+		return s.micros, nil
+	}
+	t.mu.Unlock()
+
+	var recorded int64
+	if err := t.db.WithContext(ctx).Model(&models.OracleSpend{}).
+		Select("COALESCE(SUM(cost_micros), 0)").
+		Where("tenant_id = ? AND day = ?", tenantID, day).
+		Scan(&recorded).Error; err != nil {
+		return 0, fmt.Errorf("failed to load oracle spend: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	alerted := s != nil && s.day == day && s.alerted
+	t.spent[tenantID] = &dailySpend{day: day, micros: recorded, loadedAt: now, alerted: alerted}
+	return recorded, nil
+}
+
+// LoadSpend returns a tenant's oracle spend for a month, by day and provider
+func LoadSpend(db *gorm.DB, tenantID, month string) ([]models.OracleSpend, error) {
+	var rows []models.OracleSpend
+	if err := db.Where("tenant_id = ? AND day LIKE ?", tenantID, month+"-%").
+		Order("day ASC").Order("provider ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load oracle spend: %w", err)
+	}
+	return rows, nil
+}
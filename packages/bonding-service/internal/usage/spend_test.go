@@ -0,0 +1,80 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/oracle"
+	"github.com/knowton/bonding-service/internal/tenant"
+)
+
+func TestUSD(t *testing.T) {
+	tests := []struct {
+		in     string
+		micros int64
+		out    string
+	}{
+		{"0.002", 2000, "0.002"},
+		{"1.25", 1250000, "1.25"},
+		{"40", 40000000, "40"},
+		{"0", 0, "0"},
+	}
+
+	for _, tt := range tests {
+		micros, err := ParseUSD(tt.in)
+		if err != nil || micros != tt.micros {
+			t.Errorf("ParseUSD(%q) = %d, %v, want %d", tt.in, micros, err, tt.micros)
+		}
+		if got := FormatUSD(micros); got != tt.out {
+			t.Errorf("FormatUSD(%d) = %q, want %q", micros, got, tt.out)
+		}
+	}
+	if _, err := ParseUSD("0.0000001"); err == nil {
+		t.Error("ParseUSD() of a fraction of a micro-dollar error = nil")
+	}
+}
+
+func TestSpendTracker(t *testing.T) {
+	db, mock := newMockDB(t)
+	tracker, err := NewSpendTracker(db, SpendConfig{
+		UnitPrice:      500000,
+		ProviderPrices: map[string]int64{"backup": 100000},
+		DailySoftLimit: 1000000,
+		DailyHardLimit: 1500000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := tenant.NewContext(context.Background(), "acme")
+	upsert := `INSERT INTO "oracle_spends" .* ON CONFLICT \("tenant_id","day","provider"\) DO UPDATE SET "calls"=oracle_spends.calls \+ excluded.calls`
+
+	// Loaded once, then kept up to date in memory
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(cost_micros\), 0\) FROM "oracle_spends" WHERE tenant_id = \$1 AND day = \$2`).
+		WithArgs("acme", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(900000))
+	if err := tracker.Allow(ctx); err != nil {
+		t.Fatalf("Allow() under the limit = %v", err)
+	}
+
+	mock.ExpectExec(upsert).
+		WithArgs("acme", sqlmock.AnyArg(), "backup", int64(1), int64(100000), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	tracker.Record(ctx, "backup")
+	if !tracker.spent["acme"].alerted {
+		t.Error("soft limit reached without an alert")
+	}
+
+	mock.ExpectExec(upsert).
+		WithArgs("acme", sqlmock.AnyArg(), "primary", int64(1), int64(500000), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	tracker.Record(ctx, "primary")
+
+	if err := tracker.Allow(ctx); !errors.Is(err, oracle.ErrBudgetExceeded) {
+		t.Errorf("Allow() at the hard limit = %v, want ErrBudgetExceeded", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
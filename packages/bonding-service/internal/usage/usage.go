@@ -0,0 +1,378 @@
+// Package usage records API calls per tenant and caller and enforces the
+// monthly call quotas agreed with external integrators. Calls are counted in
+// memory and flushed to the database periodically, so quotas are enforced
+// against totals at most one flush interval old across instances.
+package usage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"github.com/knowton/bonding-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MetadataKey is the gRPC metadata header carrying the caller's API key
+const MetadataKey = "x-api-key"
+
+// KeyID fingerprints an API key so usage can be stored and reported without
+// the key itself. It is empty for calls without a key.
+func KeyID(key string) string {
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
+
+// KeyFromContext returns the API key from incoming gRPC metadata, or ""
+func KeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+// Month returns the usage month containing t, as YYYY-MM in UTC
+func Month(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// Quota caps the calls one caller of a tenant may make in a month
+type Quota struct {
+	TenantID     string `json:"tenant_id"`
+	KeyID        string `json:"key_id"` // API key fingerprint, wallet address or OIDC subject; empty for anonymous calls
+	MonthlyCalls int64  `json:"monthly_calls"`
+}
+
+// LoadQuotas reads quotas from a JSON file of the form {"quotas": [...]}
+func LoadQuotas(path string) ([]Quota, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API quotas: %w", err)
+	}
+
+	var file struct {
+		Quotas []Quota `json:"quotas"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse API quotas: %w", err)
+	}
+	return file.Quotas, nil
+}
+
+// Config controls usage recording
+type Config struct {
+	FlushInterval time.Duration // How often counted calls are written to the database
+	Quotas        []Quota
+}
+
+// DefaultConfig returns default usage configuration
+func DefaultConfig() Config {
+	return Config{FlushInterval: 30 * time.Second}
+}
+
+// account is an authenticated caller of a tenant
+type account struct {
+	tenantID string
+	keyID    string
+}
+
+// entry is the usage row a call is counted in
+type entry struct {
+	account
+	month  string
+	method string
+}
+
+type counts struct {
+	calls         int64
+	errors        int64
+	requestBytes  int64
+	responseBytes int64
+}
+
+// Recorder counts API calls and checks them against quotas
+type Recorder struct {
+	db     *gorm.DB
+	config Config
+
+	mu      sync.Mutex
+	quotas  map[account]int64
+	month   string
+	pending map[entry]*counts
+	used    map[account]int64 // Calls this month for accounts with a quota, nil until loaded
+}
+
+// New creates a recorder
+func New(db *gorm.DB, config Config) (*Recorder, error) {
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultConfig().FlushInterval
+	}
+
+	quotas, err := quotaMap(config.Quotas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		db:      db,
+		config:  config,
+		quotas:  quotas,
+		month:   Month(time.Now()),
+		pending: make(map[entry]*counts),
+		used:    make(map[account]int64),
+	}, nil
+}
+
+// quotaMap indexes quotas by account, rejecting invalid and duplicate ones
+func quotaMap(list []Quota) (map[account]int64, error) {
+	quotas := make(map[account]int64, len(list))
+	for _, q := range list {
+		if q.TenantID == "" {
+			return nil, fmt.Errorf("API quota for key %q has no tenant", q.KeyID)
+		}
+		if q.MonthlyCalls <= 0 {
+			return nil, fmt.Errorf("API quota for %s/%q must allow at least one call", q.TenantID, q.KeyID)
+		}
+		a := account{q.TenantID, q.KeyID}
+		if _, ok := quotas[a]; ok {
+			return nil, fmt.Errorf("duplicate API quota for %s/%q", q.TenantID, q.KeyID)
+		}
+		quotas[a] = q.MonthlyCalls
+	}
+	return quotas, nil
+}
+
+// SetQuotas replaces the quotas, e.g. when the configuration is reloaded.
+// Calls already made this month count against the new quotas.
+func (r *Recorder) SetQuotas(quotas []Quota) error {
+	indexed, err := quotaMap(quotas)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.quotas = indexed
+	return nil
+}
+
+// Quota returns the monthly call quota of a caller, 0 for unlimited
+func (r *Recorder) Quota(tenantID, keyID string) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.quotas[account{tenantID, keyID}]
+}
+
+// Quotas returns the quotas configured for a tenant
+func (r *Recorder) Quotas(tenantID string) []Quota {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var quotas []Quota
+	for a, calls := range r.quotas {
+		if a.tenantID == tenantID {
+			quotas = append(quotas, Quota{TenantID: a.tenantID, KeyID: a.keyID, MonthlyCalls: calls})
+		}
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].KeyID < quotas[j].KeyID })
+	return quotas
+}
+
+// Allow returns a ResourceExhausted error once a caller has used its
+// monthly quota
+func (r *Recorder) Allow(ctx context.Context, tenantID, keyID string) error {
+	a := account{tenantID, keyID}
+	quota := r.Quota(tenantID, keyID)
+	if quota == 0 {
+		return nil
+	}
+
+	used, err := r.usedCalls(ctx, a)
+	if err != nil {
+		// Don't turn a database outage into an outage of every metered caller
+		log.Printf("Failed to check API quota of %s/%q: %v", tenantID, keyID, err)
+		return nil
+	}
+	if used >= quota {
+		metrics.APIQuotaRejections.WithLabelValues(tenantID).Inc()
+		return status.Errorf(codes.ResourceExhausted, "monthly quota of %d calls exhausted", quota)
+	}
+	return nil
+}
+
+// Record counts a served call
+func (r *Recorder) Record(tenantID, keyID, method string, failed bool, requestBytes, responseBytes int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rollover()
+	a := account{tenantID, keyID}
+	e := entry{account: a, month: r.month, method: method}
+	c := r.pending[e]
+	if c == nil {
+		c = &counts{}
+		r.pending[e] = c
+	}
+	c.calls++
+	if failed {
+		c.errors++
+	}
+	c.requestBytes += int64(requestBytes)
+	c.responseBytes += int64(responseBytes)
+
+	if _, ok := r.used[a]; ok {
+		r.used[a]++
+	}
+}
+
+// Start flushes counted calls on every interval until the context is
+// cancelled, then flushes once more
+func (r *Recorder) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := r.Flush(context.Background()); err != nil {
+				log.Printf("Failed to flush API usage: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				log.Printf("Failed to flush API usage: %v", err)
+			}
+		}
+	}
+}
+
+// Flush adds the calls counted since the last flush to the database. Counts
+// that fail to write are kept for the next flush. Quota totals are reloaded
+// afterwards so they include other instances' calls.
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[entry]*counts)
+	r.mu.Unlock()
+
+	for e, c := range pending {
+		err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "tenant_id"}, {Name: "key_id"}, {Name: "month"}, {Name: "method"}},
+			DoUpdates: clause.Set{
+				{Column: clause.Column{Name: "calls"}, Value: gorm.Expr("api_usages.calls + excluded.calls")},
+				{Column: clause.Column{Name: "errors"}, Value: gorm.Expr("api_usages.errors + excluded.errors")},
+				{Column: clause.Column{Name: "request_bytes"}, Value: gorm.Expr("api_usages.request_bytes + excluded.request_bytes")},
+				{Column: clause.Column{Name: "response_bytes"}, Value: gorm.Expr("api_usages.response_bytes + excluded.response_bytes")},
+				{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("excluded.updated_at")},
+			},
+		}).Create(&models.APIUsage{
+			TenantID:      e.tenantID,
+			KeyID:         e.keyID,
+			Month:         e.month,
+			Method:        e.method,
+			Calls:         c.calls,
+			Errors:        c.errors,
+			RequestBytes:  c.requestBytes,
+			ResponseBytes: c.responseBytes,
+		}).Error
+		if err != nil {
+			r.restore(pending)
+			return fmt.Errorf("failed to save API usage: %w", err)
+		}
+		delete(pending, e)
+	}
+
+	r.mu.Lock()
+	r.used = make(map[account]int64)
+	r.mu.Unlock()
+	return nil
+}
+
+// Load returns a tenant's recorded usage for a month
+func Load(db *gorm.DB, tenantID, month string) ([]models.APIUsage, error) {
+	var rows []models.APIUsage
+	if err := db.Where("tenant_id = ? AND month = ?", tenantID, month).
+		Order("key_id ASC").Order("method ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load API usage: %w", err)
+	}
+	return rows, nil
+}
+
+// usedCalls returns an account's calls this month, loading the recorded total
+// the first time it is needed after a flush
+func (r *Recorder) usedCalls(ctx context.Context, a account) (int64, error) {
+	r.mu.Lock()
+	r.rollover()
+	month := r.month
+	used, ok := r.used[a]
+	r.mu.Unlock()
+	if ok {
+		return used, nil
+	}
+
+	var recorded int64
+	if err := r.db.WithContext(ctx).Model(&models.APIUsage{}).
+		Select("COALESCE(SUM(calls), 0)").
+		Where("tenant_id = ? AND key_id = ? AND month = ?", a.tenantID, a.keyID, month).
+		Scan(&recorded).Error; err != nil {
+		return 0, fmt.Errorf("failed to load API usage: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	used = recorded
+	for e, c := range r.pending {
+		if e.account == a && e.month == month {
+			used += c.calls
+		}
+	}
+	if r.month == month {
+		r.used[a] = used
+	}
+	return used, nil
+}
+
+// restore merges counts that failed to flush back into pending
+func (r *Recorder) restore(counted map[entry]*counts) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for e, c := range counted {
+		p := r.pending[e]
+		if p == nil {
+			r.pending[e] = c
+			continue
+		}
+		p.calls += c.calls
+		p.errors += c.errors
+		p.requestBytes += c.requestBytes
+		p.responseBytes += c.responseBytes
+	}
+}
+
+// rollover resets quota totals when a new month starts. Callers hold mu.
+func (r *Recorder) rollover() {
+	if month := Month(time.Now()); month != r.month {
+		r.month = month
+		r.used = make(map[account]int64)
+	}
+}
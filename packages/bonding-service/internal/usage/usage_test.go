@@ -0,0 +1,165 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/knowton/bonding-service/internal/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db, mock
+}
+
+func TestNewValidatesQuotas(t *testing.T) {
+	tests := []struct {
+		name   string
+		quotas []Quota
+	}{
+		{"no tenant", []Quota{{KeyID: "k", MonthlyCalls: 10}}},
+		{"no calls", []Quota{{TenantID: "acme", KeyID: "k"}}},
+		{"duplicate", []Quota{{TenantID: "acme", KeyID: "k", MonthlyCalls: 10}, {TenantID: "acme", KeyID: "k", MonthlyCalls: 20}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(nil, Config{Quotas: tt.quotas}); err == nil {
+				t.Error("New() error = nil, want an invalid quota error")
+			}
+		})
+	}
+}
+
+func TestSetQuotas(t *testing.T) {
+	r, err := New(nil, Config{Quotas: []Quota{{TenantID: "acme", KeyID: "k", MonthlyCalls: 10}}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := r.SetQuotas([]Quota{{TenantID: "acme", KeyID: "k", MonthlyCalls: 20}, {TenantID: "acme", KeyID: "j", MonthlyCalls: 5}}); err != nil {
+		t.Fatalf("SetQuotas() error = %v", err)
+	}
+	if got := r.Quota("acme", "k"); got != 20 {
+		t.Errorf("Quota() = %d, want the new 20", got)
+	}
+
+	// Invalid quotas leave the current ones in place
+	if err := r.SetQuotas([]Quota{{KeyID: "k", MonthlyCalls: 30}}); err == nil {
+		t.Error("SetQuotas() error = nil, want an invalid quota error")
+	}
+	if got := len(r.Quotas("acme")); got != 2 {
+		t.Errorf("Quotas() returned %d quotas, want 2", got)
+	}
+}
+
+func TestInterceptorEnforcesQuota(t *testing.T) {
+	db, mock := newMockDB(t)
+	keyID := KeyID("secret")
+	recorder, err := New(db, Config{Quotas: []Quota{{TenantID: "acme", KeyID: keyID, MonthlyCalls: 3}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Loaded once; later calls are counted in memory
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(calls\), 0\) FROM "api_usages" WHERE tenant_id = \$1 AND key_id = \$2 AND month = \$3`).
+		WithArgs("acme", keyID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(1))
+
+	// Headers naming another tenant and key don't move the calls elsewhere
+	ctx := tenant.NewContext(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(tenant.MetadataKey, "globex", MetadataKey, "other")), "acme")
+	principal := func(context.Context) string { return keyID }
+	intercept := UnaryServerInterceptor(recorder, principal)
+	info := &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/GetBondInfo"}
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("bond not found")
+		}
+		return "ok", nil
+	}
+
+	for i, wantCode := range []codes.Code{codes.OK, codes.Unknown, codes.ResourceExhausted} {
+		_, err := intercept(ctx, nil, info, handler)
+		if got := status.Code(err); got != wantCode {
+			t.Errorf("call %d: code = %s, want %s", i+1, got, wantCode)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("handler ran %d times, want 2", calls)
+	}
+
+	// Other tenants are unmetered
+	if err := recorder.Allow(context.Background(), "other", keyID); err != nil {
+		t.Errorf("Allow() for an unmetered key = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	for e, c := range recorder.pending {
+		if e.tenantID != "acme" || e.keyID != keyID || e.method != info.FullMethod {
+			t.Errorf("counted %+v", e)
+		}
+		if c.calls != 2 || c.errors != 1 {
+			t.Errorf("counted %d calls and %d errors, want 2 and 1", c.calls, c.errors)
+		}
+	}
+}
+
+func TestFlush(t *testing.T) {
+	db, mock := newMockDB(t)
+	recorder, err := New(db, DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.Record("acme", "k1", "/bonding.BondingService/InvestInBond", false, 120, 40)
+	recorder.Record("acme", "k1", "/bonding.BondingService/InvestInBond", true, 80, 0)
+
+	upsert := `INSERT INTO "api_usages" .* ON CONFLICT \("tenant_id","key_id","month","method"\) DO UPDATE SET "calls"=api_usages.calls \+ excluded.calls`
+	mock.ExpectExec(upsert).
+		WithArgs("acme", "k1", recorder.month, "/bonding.BondingService/InvestInBond", int64(2), int64(1), int64(200), int64(40), sqlmock.AnyArg()).
+		WillReturnError(errors.New("connection reset"))
+	if err := recorder.Flush(context.Background()); err == nil {
+		t.Fatal("Flush() error = nil, want the write error")
+	}
+
+	// Counts that failed to write are kept and merged with new calls
+	recorder.Record("acme", "k1", "/bonding.BondingService/InvestInBond", false, 100, 40)
+	mock.ExpectExec(upsert).
+		WithArgs("acme", "k1", recorder.month, "/bonding.BondingService/InvestInBond", int64(3), int64(1), int64(300), int64(80), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := recorder.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if len(recorder.pending) != 0 {
+		t.Errorf("%d entries pending after flush, want 0", len(recorder.pending))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
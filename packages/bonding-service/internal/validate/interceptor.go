@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/knowton/bonding-service/internal/metrics"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor refuses requests breaking their constraints with
+// INVALID_ARGUMENT, listing every violation with its field path in a
+// BadRequest detail
+func UnaryServerInterceptor(validator *Validator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		violations, err := validator.Violations(req)
+		if err != nil {
+			log.Printf("Failed to validate %s request: %v", info.FullMethod, err)
+			return nil, status.Errorf(codes.Internal, "failed to validate request")
+		}
+		if len(violations) == 0 {
+			return handler(ctx, req)
+		}
+		metrics.InvalidRequests.WithLabelValues(info.FullMethod).Inc()
+		return nil, Error(violations)
+	}
+}
+
+// Error returns the INVALID_ARGUMENT status of violations
+func Error(violations []Violation) error {
+	messages := make([]string, len(violations))
+	details := &errdetails.BadRequest{}
+	for i, v := range violations {
+		messages[i] = v.Error()
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(messages, "; "))
+	if detailed, err := st.WithDetails(details); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
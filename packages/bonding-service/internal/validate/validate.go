@@ -0,0 +1,68 @@
+// Package validate enforces the field constraints annotated on the API's
+// messages with protovalidate (buf.validate) options. The rules are read from
+// the generated descriptors of proto/bonding.proto, so the annotations are the
+// only place they are written, and checked by one interceptor ahead of the
+// handlers.
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/protobuf/proto"
+)
+
+// Violation is a field breaking one of its constraints
+type Violation struct {
+	Field       string // Path of the field, e.g. senior.apy; empty for a message rule
+	Description string
+}
+
+func (v Violation) Error() string {
+	if v.Field == "" {
+		return v.Description
+	}
+	return v.Field + ": " + v.Description
+}
+
+// Validator checks requests against their annotations
+type Validator struct {
+	validator protovalidate.Validator
+}
+
+// New returns a Validator. Each message's rules are compiled the first time
+// a message of its type is checked.
+func New() (*Validator, error) {
+	v, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validator: %w", err)
+	}
+	return &Validator{validator: v}, nil
+}
+
+// Violations returns the violations of a request, none for requests without
+// constraints. The error is set when the rules couldn't be evaluated.
+func (v *Validator) Violations(req interface{}) ([]Violation, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+	err := v.validator.Validate(msg)
+	if err == nil {
+		return nil, nil
+	}
+
+	var invalid *protovalidate.ValidationError
+	if !errors.As(err, &invalid) {
+		return nil, err
+	}
+	violations := make([]Violation, len(invalid.Violations))
+	for i, violation := range invalid.Violations {
+		violations[i] = Violation{
+			Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+			Description: violation.Proto.GetMessage(),
+		}
+	}
+	return violations, nil
+}
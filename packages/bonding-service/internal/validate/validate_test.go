@@ -0,0 +1,130 @@
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/knowton/bonding-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestViolations(t *testing.T) {
+	const investor = "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"
+	issue := func(edit func(*pb.IssueBondRequest)) *pb.IssueBondRequest {
+		req := &pb.IssueBondRequest{
+			IssuerAddress: investor,
+			TotalValue:    "1000000",
+			Senior:        &pb.TrancheConfig{Priority: 1, Apy: 5, AllocationPercentage: "50"},
+			Mezzanine:     &pb.TrancheConfig{Priority: 2, Apy: 10, AllocationPercentage: "33.33"},
+			Junior:        &pb.TrancheConfig{Priority: 3, Apy: 20, AllocationPercentage: "16.67"},
+		}
+		edit(req)
+		return req
+	}
+
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantErrors []string
+	}{
+		{"valid issuance", issue(func(*pb.IssueBondRequest) {}), nil},
+		{"duplicate priority", issue(func(r *pb.IssueBondRequest) { r.Junior.Priority = 1 }), []string{"tranche priorities must be unique"}},
+		{"priority out of range", issue(func(r *pb.IssueBondRequest) { r.Junior.Priority = 4 }), []string{"junior.priority"}},
+		{"apy out of range", issue(func(r *pb.IssueBondRequest) { r.Mezzanine.Apy = -1 }), []string{"mezzanine.apy"}},
+		{"allocation over 100%", issue(func(r *pb.IssueBondRequest) { r.Senior.AllocationPercentage = "100.5" }), []string{"senior.allocation_percentage"}},
+		{"allocation with three decimals", issue(func(r *pb.IssueBondRequest) { r.Junior.AllocationPercentage = "16.667" }), []string{"junior.allocation_percentage"}},
+		{"missing allocation", issue(func(r *pb.IssueBondRequest) { r.Mezzanine.AllocationPercentage = "" }), []string{"mezzanine.allocation_percentage"}},
+		{"missing tranche", issue(func(r *pb.IssueBondRequest) { r.Senior = nil }), []string{"senior"}},
+		{"bad total and issuer", issue(func(r *pb.IssueBondRequest) {
+			r.TotalValue = "1e6"
+			r.IssuerAddress = "0x742d"
+		}), []string{"total_value", "issuer_address"}},
+		{"valid investment", &pb.InvestRequest{Amount: "5", InvestorAddress: "alice.eth"}, nil},
+		{"zero investment", &pb.InvestRequest{Amount: "0", InvestorAddress: investor}, []string{"amount"}},
+		{"leading zero", &pb.InvestRequest{Amount: "0100", InvestorAddress: investor}, []string{"amount"}},
+		{"padded address", &pb.InvestRequest{Amount: "5", InvestorAddress: " alice.eth"}, []string{"investor_address"}},
+		{"valid distribution", &pb.DistributeRevenueRequest{BondId: "1", Revenue: "2500"}, nil},
+		{"distribution without bond", &pb.DistributeRevenueRequest{Revenue: "2500"}, []string{"bond_id"}},
+		{"fractional revenue", &pb.DistributeRevenueRequest{BondId: "1", Revenue: "25.5"}, []string{"revenue"}},
+		{"full transfer", &pb.TransferInvestmentRequest{FromAddress: investor, ToAddress: "bob.eth"}, nil},
+		{"transfer to nobody", &pb.TransferInvestmentRequest{FromAddress: investor}, []string{"to_address"}},
+		{"every investor's claims", &pb.GetClaimableAmountsRequest{BondId: "1"}, nil},
+		{"pause without reason", &pb.ChangeBondStatusRequest{BondId: "1"}, []string{"reason"}},
+		{"unknown reason code", &pb.RequestEmergencyWithdrawalRequest{
+			BondId: "1", RecoveryAddress: investor, ReasonCode: "HACKED", Reason: "keys leaked",
+		}, []string{"reason_code"}},
+		{"unknown feature flag", &pb.SetFeatureFlagRequest{Name: "time_travel", RolloutPercent: 100}, []string{"name"}},
+		{"rollout over 100%", &pb.SetFeatureFlagRequest{Name: "erc20_payments", RolloutPercent: 150}, []string{"rollout_percent"}},
+		{"yield window reversed", &pb.GetYieldHistoryRequest{BondId: "1", From: 200, To: 100}, []string{"to must not be before from"}},
+		{"unconstrained request", &pb.GetBondInfoRequest{}, nil},
+		{"not a message", "nobody", nil},
+	}
+
+	validator, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Violations(tt.req)
+			if err != nil {
+				t.Fatalf("Violations() error = %v", err)
+			}
+			// A field violation is named by its path, a message rule by its message
+			var got []string
+			for _, v := range violations {
+				if v.Field != "" {
+					got = append(got, v.Field)
+				} else {
+					got = append(got, v.Description)
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantErrors, ",") {
+				t.Errorf("Violations() = %v, want violations of %v", violations, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	validator, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	intercept := UnaryServerInterceptor(validator)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	req := &pb.InvestRequest{InvestorAddress: "nobody", Amount: "0"}
+	_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/bonding.BondingService/Invest"}, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %s, want InvalidArgument", st.Code())
+	}
+	if called {
+		t.Error("handler called for an invalid request")
+	}
+	var badRequest *errdetails.BadRequest
+	for _, d := range st.Details() {
+		if b, ok := d.(*errdetails.BadRequest); ok {
+			badRequest = b
+		}
+	}
+	if badRequest == nil {
+		t.Fatal("status has no BadRequest detail")
+	}
+	fields := map[string]bool{}
+	for _, v := range badRequest.FieldViolations {
+		fields[v.Field] = true
+	}
+	if len(fields) != 2 || !fields["investor_address"] || !fields["amount"] {
+		t.Errorf("violated fields = %v, want investor_address and amount", fields)
+	}
+}
@@ -0,0 +1,103 @@
+package viewcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisBackend keeps each contract's cached calls in a Redis hash
+type RedisBackend struct {
+	client *redis.Client
+}
+
+// NewRedisBackend connects to Redis at a redis:// URL
+func NewRedisBackend(ctx context.Context, url string) (*RedisBackend, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	return &RedisBackend{client: client}, nil
+}
+
+// Get reads a cached call
+func (b *RedisBackend) Get(ctx context.Context, key, field string) ([]byte, bool, error) {
+	value, err := b.client.HGet(ctx, key, field).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores a cached call and extends the contract's hash expiry
+func (b *RedisBackend) Set(ctx context.Context, key, field string, value []byte, ttl time.Duration) error {
+	pipe := b.client.TxPipeline()
+	pipe.HSet(ctx, key, field, value)
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Delete drops all cached calls for a contract
+func (b *RedisBackend) Delete(ctx context.Context, key string) error {
+	return b.client.Del(ctx, key).Err()
+}
+
+// Close closes the Redis connection
+func (b *RedisBackend) Close() error {
+	return b.client.Close()
+}
+
+// maxMemoryEntries bounds the cached calls kept per contract in memory
+const maxMemoryEntries = 1024
+
+// MemoryBackend is an in-process backend for single-instance deployments
+type MemoryBackend struct {
+	mu      sync.Mutex
+	entries map[string]map[string][]byte
+}
+
+// NewMemoryBackend creates an empty in-memory backend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{entries: make(map[string]map[string][]byte)}
+}
+
+// Get reads a cached call
+func (b *MemoryBackend) Get(ctx context.Context, key, field string) ([]byte, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	value, ok := b.entries[key][field]
+	return value, ok, nil
+}
+
+// Set stores a cached call; entries carry their own expiry so ttl is unused
+func (b *MemoryBackend) Set(ctx context.Context, key, field string, value []byte, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.entries[key] == nil || len(b.entries[key]) >= maxMemoryEntries {
+		b.entries[key] = make(map[string][]byte)
+	}
+	b.entries[key][field] = value
+	return nil
+}
+
+// Delete drops all cached calls for a contract
+func (b *MemoryBackend) Delete(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, key)
+	return nil
+}
@@ -0,0 +1,124 @@
+package viewcache
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/knowton/bonding-service/internal/consistency"
+)
+
+// Backend stores cached results grouped by contract. Deleting a contract's
+// key drops every cached call to it at once.
+type Backend interface {
+	Get(ctx context.Context, key, field string) ([]byte, bool, error)
+	Set(ctx context.Context, key, field string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// Cache caches contract view call results for a short TTL
+type Cache struct {
+	backend Backend
+	ttl     time.Duration
+	prefix  string
+}
+
+// New creates a view call cache
+func New(backend Backend, ttl time.Duration) *Cache {
+	return &Cache{backend: backend, ttl: ttl, prefix: "viewcache"}
+}
+
+// Caller returns a ContractCaller that serves repeated view calls on a chain
+// from the cache. A nil cache returns next unchanged.
+func (c *Cache) Caller(chain string, next ethereum.ContractCaller) ethereum.ContractCaller {
+	if c == nil {
+		return next
+	}
+	return &caller{cache: c, chain: chain, next: next}
+}
+
+// Invalidate drops every cached call to a contract
+func (c *Cache) Invalidate(ctx context.Context, chain string, contract common.Address) {
+	if c == nil {
+		return
+	}
+	if err := c.backend.Delete(ctx, c.contractKey(chain, contract)); err != nil {
+		log.Printf("Failed to invalidate view cache for %s on %s: %v", contract.Hex(), chain, err)
+	}
+}
+
+// HandleLog invalidates the contract that emitted an indexed event
+func (c *Cache) HandleLog(ctx context.Context, chain string, l types.Log) {
+	c.Invalidate(ctx, chain, l.Address)
+}
+
+func (c *Cache) contractKey(chain string, contract common.Address) string {
+	return fmt.Sprintf("%s:%s:%s", c.prefix, chain, strings.ToLower(contract.Hex()))
+}
+
+type caller struct {
+	cache *Cache
+	chain string
+	next  ethereum.ContractCaller
+}
+
+// CallContract serves calls against the latest block from the cache. Calls
+// pinned to a block, or that send value or set a sender, go straight through.
+// Reads presenting a consistency token call the chain and refresh the cache.
+func (c *caller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.To == nil || blockNumber != nil || msg.Value != nil || msg.From != (common.Address{}) {
+		return c.next.CallContract(ctx, msg, blockNumber)
+	}
+
+	key := c.cache.contractKey(c.chain, *msg.To)
+	field := crypto.Keccak256Hash(msg.Data).Hex()
+
+	if _, consistent := consistency.FromContext(ctx); !consistent {
+		if value, ok, err := c.cache.backend.Get(ctx, key, field); err != nil {
+			log.Printf("View cache read failed, calling chain: %v", err)
+		} else if ok {
+			if result, fresh := decodeEntry(value, time.Now()); fresh {
+				return result, nil
+			}
+		}
+	}
+
+	result, err := c.next.CallContract(ctx, msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := encodeEntry(result, time.Now().Add(c.cache.ttl))
+	if err := c.cache.backend.Set(ctx, key, field, entry, c.cache.ttl); err != nil {
+		log.Printf("View cache write failed: %v", err)
+	}
+	return result, nil
+}
+
+// encodeEntry prefixes a result with its expiry, since backends expire a
+// contract's entries together rather than one by one
+func encodeEntry(result []byte, expiresAt time.Time) []byte {
+	entry := make([]byte, 8+len(result))
+	binary.BigEndian.PutUint64(entry, uint64(expiresAt.UnixNano()))
+	copy(entry[8:], result)
+	return entry
+}
+
+func decodeEntry(entry []byte, now time.Time) ([]byte, bool) {
+	if len(entry) < 8 {
+		return nil, false
+	}
+	expiresAt := time.Unix(0, int64(binary.BigEndian.Uint64(entry)))
+	if !now.Before(expiresAt) {
+		return nil, false
+	}
+	return entry[8:], true
+}
@@ -0,0 +1,158 @@
+package viewcache
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/knowton/bonding-service/internal/consistency"
+)
+
+// countingCaller returns the call count as the result
+type countingCaller struct {
+	calls int
+}
+
+func (c *countingCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	return []byte{byte(c.calls)}, nil
+}
+
+var (
+	bondContract  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tokenContract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func TestCallerCachesViewCalls(t *testing.T) {
+	ctx := context.Background()
+	next := &countingCaller{}
+	caller := New(NewMemoryBackend(), time.Minute).Caller("arbitrum", next)
+
+	msg := ethereum.CallMsg{To: &bondContract, Data: []byte{0x01}}
+	for i := 0; i < 3; i++ {
+		result, err := caller.CallContract(ctx, msg, nil)
+		if err != nil {
+			t.Fatalf("CallContract() error = %v", err)
+		}
+		if result[0] != 1 {
+			t.Errorf("call %d result = %d, want cached 1", i, result[0])
+		}
+	}
+	if next.calls != 1 {
+		t.Errorf("chain calls = %d, want 1", next.calls)
+	}
+
+	// Different calldata is a different entry
+	if _, err := caller.CallContract(ctx, ethereum.CallMsg{To: &bondContract, Data: []byte{0x02}}, nil); err != nil {
+		t.Fatalf("CallContract() error = %v", err)
+	}
+	if next.calls != 2 {
+		t.Errorf("chain calls = %d, want 2", next.calls)
+	}
+}
+
+func TestCallerBypass(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   ethereum.CallMsg
+		block *big.Int
+	}{
+		{"pinned block", ethereum.CallMsg{To: &bondContract}, big.NewInt(100)},
+		{"with value", ethereum.CallMsg{To: &bondContract, Value: big.NewInt(1)}, nil},
+		{"with sender", ethereum.CallMsg{To: &bondContract, From: tokenContract}, nil},
+		{"contract creation", ethereum.CallMsg{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &countingCaller{}
+			caller := New(NewMemoryBackend(), time.Minute).Caller("arbitrum", next)
+			for i := 0; i < 2; i++ {
+				if _, err := caller.CallContract(context.Background(), tt.msg, tt.block); err != nil {
+					t.Fatalf("CallContract() error = %v", err)
+				}
+			}
+			if next.calls != 2 {
+				t.Errorf("chain calls = %d, want 2 (uncached)", next.calls)
+			}
+		})
+	}
+}
+
+func TestCallerConsistencyToken(t *testing.T) {
+	next := &countingCaller{}
+	caller := New(NewMemoryBackend(), time.Minute).Caller("arbitrum", next)
+	msg := ethereum.CallMsg{To: &bondContract, Data: []byte{0x01}}
+
+	caller.CallContract(context.Background(), msg, nil)
+
+	// A read that must reflect a write goes to the chain and refreshes the entry
+	result, err := caller.CallContract(consistency.WithToken(context.Background(), 1), msg, nil)
+	if err != nil {
+		t.Fatalf("CallContract() error = %v", err)
+	}
+	if result[0] != 2 {
+		t.Errorf("result = %d, want fresh 2", result[0])
+	}
+	if result, _ := caller.CallContract(context.Background(), msg, nil); result[0] != 2 {
+		t.Errorf("later result = %d, want refreshed 2", result[0])
+	}
+	if next.calls != 2 {
+		t.Errorf("chain calls = %d, want 2", next.calls)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	ctx := context.Background()
+	cache := New(NewMemoryBackend(), time.Minute)
+	next := &countingCaller{}
+	caller := cache.Caller("arbitrum", next)
+
+	bondCall := ethereum.CallMsg{To: &bondContract, Data: []byte{0x01}}
+	tokenCall := ethereum.CallMsg{To: &tokenContract, Data: []byte{0x01}}
+	caller.CallContract(ctx, bondCall, nil)
+	caller.CallContract(ctx, tokenCall, nil)
+
+	// An event from the bond contract drops only its entries
+	cache.HandleLog(ctx, "arbitrum", types.Log{Address: bondContract})
+	caller.CallContract(ctx, bondCall, nil)
+	caller.CallContract(ctx, tokenCall, nil)
+	if next.calls != 3 {
+		t.Errorf("chain calls = %d, want 3", next.calls)
+	}
+
+	// Invalidation is per chain
+	cache.Invalidate(ctx, "arbitrum-sepolia", tokenContract)
+	caller.CallContract(ctx, tokenCall, nil)
+	if next.calls != 3 {
+		t.Errorf("chain calls = %d after invalidating another chain, want 3", next.calls)
+	}
+}
+
+func TestEntryExpiry(t *testing.T) {
+	now := time.Now()
+	entry := encodeEntry([]byte("result"), now.Add(time.Second))
+
+	if result, ok := decodeEntry(entry, now); !ok || string(result) != "result" {
+		t.Errorf("decodeEntry() before expiry = %q, %v", result, ok)
+	}
+	if _, ok := decodeEntry(entry, now.Add(time.Second)); ok {
+		t.Errorf("decodeEntry() at expiry returned a fresh entry")
+	}
+	if _, ok := decodeEntry([]byte{1, 2}, now); ok {
+		t.Errorf("decodeEntry() accepted a truncated entry")
+	}
+}
+
+func TestNilCache(t *testing.T) {
+	var cache *Cache
+	next := &countingCaller{}
+	if cache.Caller("arbitrum", next) != next {
+		t.Errorf("nil cache did not return the underlying caller")
+	}
+	cache.Invalidate(context.Background(), "arbitrum", bondContract)
+}
@@ -0,0 +1,91 @@
+package waterfall
+
+import (
+	"math/big"
+	"time"
+)
+
+// DayCount is a convention for how much of a year's coupon accrues between
+// two instants
+type DayCount string
+
+// Day-count conventions
+const (
+	Actual365 DayCount = "ACT/365" // Actual time over a 365-day year
+	Actual360 DayCount = "ACT/360" // Actual time over a 360-day year
+	Thirty360 DayCount = "30/360"  // 30-day months over a 360-day year, US bond basis
+)
+
+// DefaultDayCount is the convention of bonds that don't name one
+const DefaultDayCount = Actual365
+
+// DayCounts lists the supported conventions
+var DayCounts = []string{string(Actual365), string(Actual360), string(Thirty360)}
+
+// ParseDayCount returns the convention named name, the default when name is
+// empty
+func ParseDayCount(name string) (DayCount, bool) {
+	switch d := DayCount(name); d {
+	case "":
+		return DefaultDayCount, true
+	case Actual365, Actual360, Thirty360:
+		return d, true
+	}
+	return "", false
+}
+
+// Seconds returns the accrual time from start to end under the convention,
+// zero when end isn't after start. Under 30/360 whole days are counted on
+// 30-day months and the time of day is kept, so a day accrues a day whatever
+// its month, save the 31st, which accrues nothing.
+func (d DayCount) Seconds(start, end time.Time) int64 {
+	if !end.After(start) {
+		return 0
+	}
+	if d != Thirty360 {
+		return int64(end.Sub(start) / time.Second)
+	}
+
+	start, end = start.UTC(), end.UTC()
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	days := 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+	seconds := int64(days)*86400 + secondOfDay(end) - secondOfDay(start)
+	if seconds < 0 {
+		return 0
+	}
+	return seconds
+}
+
+// yearSeconds is the length of the convention's year
+func (d DayCount) yearSeconds() int64 {
+	if d == Actual365 || d == "" {
+		return 365 * 24 * 60 * 60
+	}
+	return 360 * 24 * 60 * 60
+}
+
+// Coupon computes the coupon accrued on principal at apyBps (basis points per
+// year) from start to end under the convention
+func (d DayCount) Coupon(principal *big.Int, apyBps int64, start, end time.Time) *big.Int {
+	seconds := d.Seconds(start, end)
+	if principal == nil || principal.Sign() <= 0 || apyBps <= 0 || seconds <= 0 {
+		return big.NewInt(0)
+	}
+
+	coupon := new(big.Int).Mul(principal, big.NewInt(apyBps))
+	coupon.Mul(coupon, big.NewInt(seconds))
+	coupon.Div(coupon, big.NewInt(10000*d.yearSeconds()))
+	return coupon
+}
+
+func secondOfDay(t time.Time) int64 {
+	h, m, s := t.Clock()
+	return int64(h*3600 + m*60 + s)
+}
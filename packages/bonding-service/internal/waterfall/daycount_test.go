@@ -0,0 +1,70 @@
+package waterfall
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestDayCountSeconds(t *testing.T) {
+	date := func(y int, m time.Month, d, h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, time.UTC) }
+	const day = 86400
+
+	tests := []struct {
+		name       string
+		convention DayCount
+		start, end time.Time
+		want       int64
+	}{
+		{"actual February", Actual365, date(2026, 2, 1, 0), date(2026, 3, 1, 0), 28 * day},
+		{"30/360 February", Thirty360, date(2026, 2, 1, 0), date(2026, 3, 1, 0), 30 * day},
+		{"30/360 end of February", Thirty360, date(2026, 2, 28, 0), date(2026, 3, 1, 0), 3 * day},
+		{"30/360 on the 31st", Thirty360, date(2026, 1, 30, 0), date(2026, 1, 31, 0), 0},
+		{"30/360 from the 31st", Thirty360, date(2026, 1, 31, 0), date(2026, 2, 1, 0), day},
+		{"30/360 year", Thirty360, date(2026, 1, 15, 0), date(2027, 1, 15, 0), 360 * day},
+		{"30/360 keeps the time of day", Thirty360, date(2026, 3, 1, 12), date(2026, 3, 2, 6), 18 * 3600},
+		{"reversed", Actual360, date(2026, 3, 2, 0), date(2026, 3, 1, 0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.convention.Seconds(tt.start, tt.end); got != tt.want {
+				t.Errorf("Seconds() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayCountCoupon(t *testing.T) {
+	principal, _ := new(big.Int).SetString("100000000000000000000", 10) // 100 ETH
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		convention DayCount
+		end        time.Time
+		want       string
+	}{
+		{Actual365, start.AddDate(0, 0, 365), "5000000000000000000"},
+		{Actual360, start.AddDate(0, 0, 360), "5000000000000000000"},
+		{Actual360, start.AddDate(0, 0, 365), "5069444444444444444"},
+		{Thirty360, start.AddDate(1, 0, 0), "5000000000000000000"},
+		{"", start.AddDate(0, 0, 365), "5000000000000000000"},
+	}
+	for _, tt := range tests {
+		got := tt.convention.Coupon(principal, 500, start, tt.end)
+		if got.String() != tt.want {
+			t.Errorf("%q Coupon() = %s, want %s", tt.convention, got, tt.want)
+		}
+	}
+}
+
+func TestParseDayCount(t *testing.T) {
+	if d, ok := ParseDayCount(""); !ok || d != DefaultDayCount {
+		t.Errorf("ParseDayCount(\"\") = %q, %v, want the default", d, ok)
+	}
+	if d, ok := ParseDayCount("30/360"); !ok || d != Thirty360 {
+		t.Errorf("ParseDayCount(30/360) = %q, %v", d, ok)
+	}
+	if _, ok := ParseDayCount("ACT/ACT"); ok {
+		t.Error("ParseDayCount(ACT/ACT) should fail")
+	}
+}
@@ -0,0 +1,134 @@
+package waterfall
+
+import (
+	"math/big"
+	"sort"
+)
+
+// TrancheState is the input state of a tranche for a distribution
+type TrancheState struct {
+	TrancheID int
+	Priority  int
+	CouponDue *big.Int // Coupon accrued for the current period
+	Arrears   *big.Int // Unpaid coupons carried forward from earlier periods
+}
+
+// TrancheResult is the outcome of a distribution for a single tranche
+type TrancheResult struct {
+	TrancheID   int
+	CouponDue   *big.Int
+	ArrearsPaid *big.Int
+	CouponPaid  *big.Int
+	Residual    *big.Int // Surplus revenue allocated after all coupons are met
+	Shortfall   *big.Int // Part of the current coupon that could not be paid
+	Arrears     *big.Int // Cumulative arrears after this distribution
+}
+
+// Paid returns the total amount paid to the tranche
+func (r *TrancheResult) Paid() *big.Int {
+	paid := new(big.Int).Add(r.ArrearsPaid, r.CouponPaid)
+	return paid.Add(paid, r.Residual)
+}
+
+// Result is the outcome of running the waterfall
+type Result struct {
+	Tranches       []*TrancheResult // Ordered by priority
+	Distributed    *big.Int
+	TotalShortfall *big.Int
+	TotalArrears   *big.Int
+}
+
+// Partial reports whether any coupon went unpaid
+func (r *Result) Partial() bool {
+	return r.TotalShortfall.Sign() > 0 || r.TotalArrears.Sign() > 0
+}
+
+// Run allocates revenue across tranches in priority order.
+//
+// Each tranche is settled in full before the next is paid anything: first the
+// arrears carried forward from earlier periods, then its current coupon. A
+// junior tranche's arrears therefore never outrank a senior current coupon.
+// Whatever a tranche cannot receive is recorded as a shortfall and added to
+// its arrears. Revenue left over once every coupon is met goes to the most
+// junior tranche.
+func Run(revenue *big.Int, tranches []TrancheState) *Result {
+	ordered := make([]TrancheState, len(tranches))
+	copy(ordered, tranches)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority < ordered[j].Priority
+	})
+
+	remaining := new(big.Int).Set(revenue)
+	results := make([]*TrancheResult, len(ordered))
+	for i, t := range ordered {
+		results[i] = &TrancheResult{
+			TrancheID:   t.TrancheID,
+			CouponDue:   valueOrZero(t.CouponDue),
+			ArrearsPaid: big.NewInt(0),
+			CouponPaid:  big.NewInt(0),
+			Residual:    big.NewInt(0),
+			Shortfall:   big.NewInt(0),
+			Arrears:     new(big.Int).Set(valueOrZero(t.Arrears)),
+		}
+	}
+
+	// 1. Settle each tranche's arrears and then its current coupon, in priority order
+	for _, r := range results {
+		paid := minBig(remaining, r.Arrears)
+		r.ArrearsPaid.Set(paid)
+		r.Arrears.Sub(r.Arrears, paid)
+		remaining.Sub(remaining, paid)
+
+		paid = minBig(remaining, r.CouponDue)
+		r.CouponPaid.Set(paid)
+		r.Shortfall.Sub(r.CouponDue, paid)
+		r.Arrears.Add(r.Arrears, r.Shortfall)
+		remaining.Sub(remaining, paid)
+	}
+
+	// 2. Surplus goes to the most junior tranche
+	if remaining.Sign() > 0 && len(results) > 0 {
+		results[len(results)-1].Residual.Set(remaining)
+		remaining.SetInt64(0)
+	}
+
+	result := &Result{
+		Tranches:       results,
+		Distributed:    new(big.Int).Sub(revenue, remaining),
+		TotalShortfall: big.NewInt(0),
+		TotalArrears:   big.NewInt(0),
+	}
+	for _, r := range results {
+		result.TotalShortfall.Add(result.TotalShortfall, r.Shortfall)
+		result.TotalArrears.Add(result.TotalArrears, r.Arrears)
+	}
+
+	return result
+}
+
+// PeriodCoupon computes the coupon accrued on principal at apyBps (basis
+// points per year) over elapsedSeconds, using a 365-day year
+func PeriodCoupon(principal *big.Int, apyBps int64, elapsedSeconds int64) *big.Int {
+	if principal == nil || principal.Sign() <= 0 || apyBps <= 0 || elapsedSeconds <= 0 {
+		return big.NewInt(0)
+	}
+
+	coupon := new(big.Int).Mul(principal, big.NewInt(apyBps))
+	coupon.Mul(coupon, big.NewInt(elapsedSeconds))
+	coupon.Div(coupon, big.NewInt(10000*365*24*60*60))
+	return coupon
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return new(big.Int).Set(a)
+	}
+	return new(big.Int).Set(b)
+}
+
+func valueOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+	return v
+}
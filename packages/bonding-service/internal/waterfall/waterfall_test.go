@@ -0,0 +1,101 @@
+package waterfall
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tranches := func(arrears ...int64) []TrancheState {
+		states := []TrancheState{
+			{TrancheID: 0, Priority: 1, CouponDue: big.NewInt(50)},
+			{TrancheID: 1, Priority: 2, CouponDue: big.NewInt(30)},
+			{TrancheID: 2, Priority: 3, CouponDue: big.NewInt(20)},
+		}
+		for i, a := range arrears {
+			states[i].Arrears = big.NewInt(a)
+		}
+		return states
+	}
+
+	tests := []struct {
+		name        string
+		revenue     int64
+		tranches    []TrancheState
+		wantPaid    []int64
+		wantArrears []int64
+		wantPartial bool
+	}{
+		{
+			name:        "full coupon with surplus to junior",
+			revenue:     120,
+			tranches:    tranches(),
+			wantPaid:    []int64{50, 30, 40},
+			wantArrears: []int64{0, 0, 0},
+			wantPartial: false,
+		},
+		{
+			name:        "shortfall hits junior first",
+			revenue:     70,
+			tranches:    tranches(),
+			wantPaid:    []int64{50, 20, 0},
+			wantArrears: []int64{0, 10, 20},
+			wantPartial: true,
+		},
+		{
+			name:        "arrears paid before the tranche's own coupon",
+			revenue:     100,
+			tranches:    tranches(0, 10, 20),
+			wantPaid:    []int64{50, 40, 10},
+			wantArrears: []int64{0, 0, 30},
+			wantPartial: true,
+		},
+		{
+			name:        "junior arrears rank behind senior coupons",
+			revenue:     50,
+			tranches:    tranches(0, 0, 20),
+			wantPaid:    []int64{50, 0, 0},
+			wantArrears: []int64{0, 30, 40},
+			wantPartial: true,
+		},
+		{
+			name:        "no revenue carries everything forward",
+			revenue:     0,
+			tranches:    tranches(5),
+			wantPaid:    []int64{0, 0, 0},
+			wantArrears: []int64{55, 30, 20},
+			wantPartial: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Run(big.NewInt(tt.revenue), tt.tranches)
+			for i, r := range got.Tranches {
+				if r.Paid().Int64() != tt.wantPaid[i] {
+					t.Errorf("tranche %d paid = %v, want %v", r.TrancheID, r.Paid(), tt.wantPaid[i])
+				}
+				if r.Arrears.Int64() != tt.wantArrears[i] {
+					t.Errorf("tranche %d arrears = %v, want %v", r.TrancheID, r.Arrears, tt.wantArrears[i])
+				}
+			}
+			if got.Partial() != tt.wantPartial {
+				t.Errorf("Partial() = %v, want %v", got.Partial(), tt.wantPartial)
+			}
+		})
+	}
+}
+
+func TestPeriodCoupon(t *testing.T) {
+	principal, _ := new(big.Int).SetString("100000000000000000000", 10) // 100 ETH
+
+	got := PeriodCoupon(principal, 500, 365*24*60*60)
+	want, _ := new(big.Int).SetString("5000000000000000000", 10) // 5 ETH
+	if got.Cmp(want) != 0 {
+		t.Errorf("PeriodCoupon() = %v, want %v", got, want)
+	}
+
+	if PeriodCoupon(principal, 500, 0).Sign() != 0 {
+		t.Errorf("PeriodCoupon() with no elapsed time should be zero")
+	}
+}
@@ -0,0 +1,90 @@
+// Package yield measures the yield a tranche has realized: what its
+// distributions paid relative to the capital invested in it when they were
+// made. Each period runs from the previous distribution (or issuance) to the
+// next and is annualized under the tranche's day count, so a tranche paid its
+// coupons in full realizes its promised APY.
+package yield
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+)
+
+// fullRate is an APY of 100% in basis points. A period's coupon at it is the
+// capital-time the period's payments are annualized over.
+const fullRate = 10000
+
+// Point is a tranche's yield as of a distribution
+type Point struct {
+	At             time.Time
+	DistributionID uint
+	Invested       *big.Int // Capital the distribution paid on
+	Paid           *big.Int // Arrears, coupon and residual paid by the distribution
+	CumulativePaid *big.Int
+	PeriodYield    float64 // Paid over invested, in percent
+	PeriodAPY      float64 // PeriodYield annualized over the time since the previous distribution
+	RealizedAPY    float64 // Everything paid so far annualized over the capital held since issuance
+}
+
+// History returns a tranche's yield after each of a bond's distributions,
+// oldest first. start is when its coupons began accruing, normally issuance.
+func History(trancheID int, dayCount waterfall.DayCount, start time.Time, distributions []models.RevenueDistribution) []Point {
+	ordered := make([]*models.RevenueDistribution, len(distributions))
+	for i := range distributions {
+		ordered[i] = &distributions[i]
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Timestamp.Before(ordered[j].Timestamp)
+	})
+
+	var points []Point
+	previous := start
+	paid, base := new(big.Int), new(big.Int)
+	for _, d := range ordered {
+		for _, split := range d.Tranches {
+			if split.TrancheID != trancheID {
+				continue
+			}
+			invested := parseAmount(split.Invested)
+			amount := new(big.Int).Add(parseAmount(split.ArrearsPaid), parseAmount(split.CouponPaid))
+			amount.Add(amount, parseAmount(split.Residual))
+			period := dayCount.Coupon(invested, fullRate, previous, d.Timestamp)
+			paid.Add(paid, amount)
+			base.Add(base, period)
+
+			points = append(points, Point{
+				At:             d.Timestamp,
+				DistributionID: d.ID,
+				Invested:       invested,
+				Paid:           amount,
+				CumulativePaid: new(big.Int).Set(paid),
+				PeriodYield:    percent(amount, invested),
+				PeriodAPY:      percent(amount, period),
+				RealizedAPY:    percent(paid, base),
+			})
+		}
+		previous = d.Timestamp
+	}
+	return points
+}
+
+// percent returns n as a percentage of of, zero when of is
+func percent(n, of *big.Int) float64 {
+	if of.Sign() <= 0 {
+		return 0
+	}
+	p, _ := new(big.Rat).SetFrac(new(big.Int).Mul(n, big.NewInt(100)), of).Float64()
+	return p
+}
+
+func parseAmount(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
@@ -0,0 +1,80 @@
+package yield
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/knowton/bonding-service/internal/models"
+	"github.com/knowton/bonding-service/internal/waterfall"
+	"gorm.io/gorm"
+)
+
+func TestHistory(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := 73 * 24 * time.Hour // A fifth of a 365-day year
+	distributions := []models.RevenueDistribution{
+		// Newest first, as they are listed
+		{
+			Model:     gorm.Model{ID: 2},
+			Timestamp: start.Add(2 * period),
+			Tranches: []models.TrancheDistribution{
+				{TrancheID: 0, Invested: "2000000000000000000", ArrearsPaid: "0", CouponPaid: "20000000000000000", Residual: "0"},
+			},
+		},
+		{
+			Model:     gorm.Model{ID: 1},
+			Timestamp: start.Add(period),
+			Tranches: []models.TrancheDistribution{
+				{TrancheID: 0, Invested: "1000000000000000000", ArrearsPaid: "0", CouponPaid: "20000000000000000", Residual: "0"},
+				{TrancheID: 1, Invested: "1000000000000000000", CouponPaid: "5"},
+			},
+		},
+	}
+
+	points := History(0, waterfall.Actual365, start, distributions)
+	if len(points) != 2 {
+		t.Fatalf("History() returned %d points, want 2", len(points))
+	}
+
+	// The coupon at 10% paid in full
+	first := points[0]
+	if first.DistributionID != 1 || !first.At.Equal(start.Add(period)) {
+		t.Errorf("points[0] is distribution %d at %v, want 1 at %v", first.DistributionID, first.At, start.Add(period))
+	}
+	assertPercent(t, "points[0].PeriodYield", first.PeriodYield, 2)
+	assertPercent(t, "points[0].PeriodAPY", first.PeriodAPY, 10)
+	assertPercent(t, "points[0].RealizedAPY", first.RealizedAPY, 10)
+
+	// Half the coupon on twice the capital
+	second := points[1]
+	if got := second.CumulativePaid.String(); got != "40000000000000000" {
+		t.Errorf("points[1].CumulativePaid = %s, want 40000000000000000", got)
+	}
+	assertPercent(t, "points[1].PeriodYield", second.PeriodYield, 1)
+	assertPercent(t, "points[1].PeriodAPY", second.PeriodAPY, 5)
+	assertPercent(t, "points[1].RealizedAPY", second.RealizedAPY, 20.0/3)
+}
+
+func TestHistoryWithoutCapital(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	distributions := []models.RevenueDistribution{{
+		Timestamp: start.Add(24 * time.Hour),
+		Tranches:  []models.TrancheDistribution{{TrancheID: 2, Invested: "0", Residual: "100"}},
+	}}
+
+	points := History(2, waterfall.Actual365, start, distributions)
+	if len(points) != 1 {
+		t.Fatalf("History() returned %d points, want 1", len(points))
+	}
+	if p := points[0]; p.Paid.String() != "100" || p.PeriodYield != 0 || p.PeriodAPY != 0 || p.RealizedAPY != 0 {
+		t.Errorf("points[0] = %+v, want 100 paid and no yield", p)
+	}
+}
+
+func assertPercent(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
@@ -1,51 +1,13006 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
 // source: proto/bonding.proto
 
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
+	// Verify that this generated code is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
 type IssueBondRequest struct {
-	state         protoimpl.MessageState
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	IpnftId          string                   `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	NftContract      string                   `protobuf:"bytes,2,opt,name=nft_contract,json=nftContract,proto3" json:"nft_contract,omitempty"`
+	TotalValue       string                   `protobuf:"bytes,3,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Senior           *TrancheConfig           `protobuf:"bytes,4,opt,name=senior,proto3" json:"senior,omitempty"`
+	Mezzanine        *TrancheConfig           `protobuf:"bytes,5,opt,name=mezzanine,proto3" json:"mezzanine,omitempty"`
+	Junior           *TrancheConfig           `protobuf:"bytes,6,opt,name=junior,proto3" json:"junior,omitempty"`
+	MaturityDate     int64                    `protobuf:"varint,7,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	Chain            string                   `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`                                                   // Chain registry name, empty for the default chain
+	Registration     *RegisteredIP            `protobuf:"bytes,9,opt,name=registration,proto3" json:"registration,omitempty"`                                     // Set when the IP is a patent or trademark
+	Category         string                   `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`                                            // Taxonomy slug or alias; defaults to the registration kind, else music
+	LicenseExpiresAt int64                    `protobuf:"varint,11,opt,name=license_expires_at,json=licenseExpiresAt,proto3" json:"license_expires_at,omitempty"` // End of the license the revenue depends on, 0 if perpetual
+	License          *LicenseAgreement        `protobuf:"bytes,12,opt,name=license,proto3" json:"license,omitempty"`                                              // The agreement generating the revenue; its end sets license_expires_at
+	RevenueForecast  []*RevenueForecastPeriod `protobuf:"bytes,13,rep,name=revenue_forecast,json=revenueForecast,proto3" json:"revenue_forecast,omitempty"`       // Expected revenue per period, in order
+	DryRun           bool                     `protobuf:"varint,14,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                 // Validate, assess and simulate the contract call with eth_call; nothing is saved or sent
+	ContentUrl       string                   `protobuf:"bytes,15,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`                      // The IP's content, fingerprinted to refuse content already backing a bond; defaults to the metadata's animation_url
+	IssuerAddress    string                   `protobuf:"bytes,16,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
+	DayCount         string                   `protobuf:"bytes,17,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"` // Coupon day count; defaults to ACT/365
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IssueBondRequest) Reset() {
+	*x = IssueBondRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueBondRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueBondRequest) ProtoMessage() {}
+
+func (x *IssueBondRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueBondRequest.ProtoReflect.Descriptor instead.
+func (*IssueBondRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IssueBondRequest) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetNftContract() string {
+	if x != nil {
+		return x.NftContract
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetSenior() *TrancheConfig {
+	if x != nil {
+		return x.Senior
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetMezzanine() *TrancheConfig {
+	if x != nil {
+		return x.Mezzanine
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetJunior() *TrancheConfig {
+	if x != nil {
+		return x.Junior
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetMaturityDate() int64 {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return 0
+}
+
+func (x *IssueBondRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetRegistration() *RegisteredIP {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetLicenseExpiresAt() int64 {
+	if x != nil {
+		return x.LicenseExpiresAt
+	}
+	return 0
+}
+
+func (x *IssueBondRequest) GetLicense() *LicenseAgreement {
+	if x != nil {
+		return x.License
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetRevenueForecast() []*RevenueForecastPeriod {
+	if x != nil {
+		return x.RevenueForecast
+	}
+	return nil
+}
+
+func (x *IssueBondRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *IssueBondRequest) GetContentUrl() string {
+	if x != nil {
+		return x.ContentUrl
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetIssuerAddress() string {
+	if x != nil {
+		return x.IssuerAddress
+	}
+	return ""
+}
+
+func (x *IssueBondRequest) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+type TrancheConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority             int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`                                                    // 1 is paid first
+	AllocationPercentage string                 `protobuf:"bytes,3,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"` // Share of total_value, 0 to 100 with at most two decimal places
+	Apy                  float64                `protobuf:"fixed64,4,opt,name=apy,proto3" json:"apy,omitempty"`                                                             // Percent
+	RiskLevel            string                 `protobuf:"bytes,5,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	DayCount             string                 `protobuf:"bytes,6,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"` // Overrides the bond's day count
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TrancheConfig) Reset() {
+	*x = TrancheConfig{}
+	mi := &file_proto_bonding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheConfig) ProtoMessage() {}
+
+func (x *TrancheConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheConfig.ProtoReflect.Descriptor instead.
+func (*TrancheConfig) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrancheConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrancheConfig) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TrancheConfig) GetAllocationPercentage() string {
+	if x != nil {
+		return x.AllocationPercentage
+	}
+	return ""
+}
+
+func (x *TrancheConfig) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *TrancheConfig) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *TrancheConfig) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+type RevenueForecastPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   int64                  `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueForecastPeriod) Reset() {
+	*x = RevenueForecastPeriod{}
+	mi := &file_proto_bonding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueForecastPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueForecastPeriod) ProtoMessage() {}
+
+func (x *RevenueForecastPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueForecastPeriod.ProtoReflect.Descriptor instead.
+func (*RevenueForecastPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevenueForecastPeriod) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *RevenueForecastPeriod) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *RevenueForecastPeriod) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type LicenseAgreement struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Licensor                  string                 `protobuf:"bytes,1,opt,name=licensor,proto3" json:"licensor,omitempty"` // Address of the rights holder granting the license
+	Licensee                  string                 `protobuf:"bytes,2,opt,name=licensee,proto3" json:"licensee,omitempty"`
+	RoyaltyBps                int64                  `protobuf:"varint,3,opt,name=royalty_bps,json=royaltyBps,proto3" json:"royalty_bps,omitempty"` // Royalty as basis points of the licensee's revenue
+	StartsAt                  int64                  `protobuf:"varint,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt                    int64                  `protobuf:"varint,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // 0 for a perpetual license
+	Territories               []string               `protobuf:"bytes,6,rep,name=territories,proto3" json:"territories,omitempty"`      // ISO 3166 alpha-2 codes, or WORLDWIDE
+	Exclusive                 bool                   `protobuf:"varint,7,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	DocumentHash              string                 `protobuf:"bytes,8,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`                                          // Optional SHA-256 of the signed agreement
+	RightsAssignmentSignature string                 `protobuf:"bytes,9,opt,name=rights_assignment_signature,json=rightsAssignmentSignature,proto3" json:"rights_assignment_signature,omitempty"` // Licensor's personal_sign of the assignment to the issuer; not needed when the issuer is the licensor
+	TermsHash                 string                 `protobuf:"bytes,10,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty"`                                                  // Output only: hash of the terms the assignment covers
+	RightsAssigned            bool                   `protobuf:"varint,11,opt,name=rights_assigned,json=rightsAssigned,proto3" json:"rights_assigned,omitempty"`                                  // Output only: the revenue rights were assigned by signature
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *LicenseAgreement) Reset() {
+	*x = LicenseAgreement{}
+	mi := &file_proto_bonding_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseAgreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseAgreement) ProtoMessage() {}
+
+func (x *LicenseAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseAgreement.ProtoReflect.Descriptor instead.
+func (*LicenseAgreement) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LicenseAgreement) GetLicensor() string {
+	if x != nil {
+		return x.Licensor
+	}
+	return ""
+}
+
+func (x *LicenseAgreement) GetLicensee() string {
+	if x != nil {
+		return x.Licensee
+	}
+	return ""
+}
+
+func (x *LicenseAgreement) GetRoyaltyBps() int64 {
+	if x != nil {
+		return x.RoyaltyBps
+	}
+	return 0
+}
+
+func (x *LicenseAgreement) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *LicenseAgreement) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *LicenseAgreement) GetTerritories() []string {
+	if x != nil {
+		return x.Territories
+	}
+	return nil
+}
+
+func (x *LicenseAgreement) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *LicenseAgreement) GetDocumentHash() string {
+	if x != nil {
+		return x.DocumentHash
+	}
+	return ""
+}
+
+func (x *LicenseAgreement) GetRightsAssignmentSignature() string {
+	if x != nil {
+		return x.RightsAssignmentSignature
+	}
+	return ""
+}
+
+func (x *LicenseAgreement) GetTermsHash() string {
+	if x != nil {
+		return x.TermsHash
+	}
+	return ""
+}
+
+func (x *LicenseAgreement) GetRightsAssigned() bool {
+	if x != nil {
+		return x.RightsAssigned
+	}
+	return false
+}
+
+type RegisteredIP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // PATENT or TRADEMARK
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Jurisdiction  string                 `protobuf:"bytes,3,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`             // Registry jurisdiction, e.g. US or EP
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Claimed expiry; replaced by the registry's when verified
+	Verified      bool                   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`                    // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisteredIP) Reset() {
+	*x = RegisteredIP{}
+	mi := &file_proto_bonding_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisteredIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredIP) ProtoMessage() {}
+
+func (x *RegisteredIP) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredIP.ProtoReflect.Descriptor instead.
+func (*RegisteredIP) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegisteredIP) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RegisteredIP) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *RegisteredIP) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *RegisteredIP) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RegisteredIP) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type IssueBondResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BondId         string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TxHash         string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`     // success, or dry_run with the bond ID the contract would assign and no tx_hash
+	Warnings       []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"` // Set when the category's expiry policy is WARN
+	Tranches       []*TrancheInfo         `protobuf:"bytes,5,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	RiskAssessment *RiskAssessment        `protobuf:"bytes,6,opt,name=risk_assessment,json=riskAssessment,proto3" json:"risk_assessment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IssueBondResponse) Reset() {
+	*x = IssueBondResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueBondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueBondResponse) ProtoMessage() {}
+
+func (x *IssueBondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueBondResponse.ProtoReflect.Descriptor instead.
+func (*IssueBondResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssueBondResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *IssueBondResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *IssueBondResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IssueBondResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *IssueBondResponse) GetTranches() []*TrancheInfo {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (x *IssueBondResponse) GetRiskAssessment() *RiskAssessment {
+	if x != nil {
+		return x.RiskAssessment
+	}
+	return nil
+}
+
+type InvestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       uint32                 `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,4,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InvestRequest) Reset() {
+	*x = InvestRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestRequest) ProtoMessage() {}
+
+func (x *InvestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestRequest.ProtoReflect.Descriptor instead.
+func (*InvestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InvestRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *InvestRequest) GetTrancheId() uint32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *InvestRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *InvestRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+type InvestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxHash         string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	InvestedAmount string                 `protobuf:"bytes,3,opt,name=invested_amount,json=investedAmount,proto3" json:"invested_amount,omitempty"`
+	ExpectedReturn float64                `protobuf:"fixed64,4,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"` // Principal multiple after one year at the tranche's APY
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvestResponse) Reset() {
+	*x = InvestResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestResponse) ProtoMessage() {}
+
+func (x *InvestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestResponse.ProtoReflect.Descriptor instead.
+func (*InvestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvestResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *InvestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InvestResponse) GetInvestedAmount() string {
+	if x != nil {
+		return x.InvestedAmount
+	}
+	return ""
+}
+
+func (x *InvestResponse) GetExpectedReturn() float64 {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return 0
+}
+
+type GetBondInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBondInfoRequest) Reset() {
+	*x = GetBondInfoRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondInfoRequest) ProtoMessage() {}
+
+func (x *GetBondInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetBondInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBondInfoRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetBondInfoRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetBondInfoResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BondId             string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	IpnftId            string                 `protobuf:"bytes,2,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	Issuer             string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	TotalValue         string                 `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	MaturityDate       int64                  `protobuf:"varint,5,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Tranches           []*TrancheInfo         `protobuf:"bytes,7,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	TotalArrears       string                 `protobuf:"bytes,8,opt,name=total_arrears,json=totalArrears,proto3" json:"total_arrears,omitempty"`
+	IssuerInfo         *Counterparty          `protobuf:"bytes,9,opt,name=issuer_info,json=issuerInfo,proto3" json:"issuer_info,omitempty"`
+	Chain              string                 `protobuf:"bytes,10,opt,name=chain,proto3" json:"chain,omitempty"`
+	NftContract        string                 `protobuf:"bytes,11,opt,name=nft_contract,json=nftContract,proto3" json:"nft_contract,omitempty"`
+	TotalRevenue       string                 `protobuf:"bytes,12,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	CreatedAt          int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Registration       *RegisteredIP          `protobuf:"bytes,14,opt,name=registration,proto3" json:"registration,omitempty"`
+	LicenseExpiresAt   int64                  `protobuf:"varint,15,opt,name=license_expires_at,json=licenseExpiresAt,proto3" json:"license_expires_at,omitempty"`
+	License            *LicenseAgreement      `protobuf:"bytes,16,opt,name=license,proto3" json:"license,omitempty"`                                        // Set by GetBondInfo only
+	RatingReviewAt     int64                  `protobuf:"varint,17,opt,name=rating_review_at,json=ratingReviewAt,proto3" json:"rating_review_at,omitempty"` // When revenue variance flagged the rating for review, 0 if never
+	RatingReviewReason string                 `protobuf:"bytes,18,opt,name=rating_review_reason,json=ratingReviewReason,proto3" json:"rating_review_reason,omitempty"`
+	StatusChangedAt    int64                  `protobuf:"varint,19,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"` // When an operator last changed the status, 0 if never
+	StatusReason       string                 `protobuf:"bytes,20,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	ArchivedAt         int64                  `protobuf:"varint,21,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // When the closed bond's detail was archived, 0 if it is live
+	DayCount           string                 `protobuf:"bytes,22,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`        // ACT/365, ACT/360 or 30/360
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetBondInfoResponse) Reset() {
+	*x = GetBondInfoResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondInfoResponse) ProtoMessage() {}
+
+func (x *GetBondInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetBondInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBondInfoResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetMaturityDate() int64 {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetTranches() []*TrancheInfo {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (x *GetBondInfoResponse) GetTotalArrears() string {
+	if x != nil {
+		return x.TotalArrears
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetIssuerInfo() *Counterparty {
+	if x != nil {
+		return x.IssuerInfo
+	}
+	return nil
+}
+
+func (x *GetBondInfoResponse) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetNftContract() string {
+	if x != nil {
+		return x.NftContract
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetTotalRevenue() string {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetRegistration() *RegisteredIP {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *GetBondInfoResponse) GetLicenseExpiresAt() int64 {
+	if x != nil {
+		return x.LicenseExpiresAt
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetLicense() *LicenseAgreement {
+	if x != nil {
+		return x.License
+	}
+	return nil
+}
+
+func (x *GetBondInfoResponse) GetRatingReviewAt() int64 {
+	if x != nil {
+		return x.RatingReviewAt
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetRatingReviewReason() string {
+	if x != nil {
+		return x.RatingReviewReason
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetStatusChangedAt() int64 {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *GetBondInfoResponse) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+func (x *GetBondInfoResponse) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+type ListBondsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                      // Optional, e.g. ACTIVE
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, capped at 200
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are left out otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListBondsRequest) Reset() {
+	*x = ListBondsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBondsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBondsRequest) ProtoMessage() {}
+
+func (x *ListBondsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBondsRequest.ProtoReflect.Descriptor instead.
+func (*ListBondsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBondsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListBondsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBondsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListBondsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListBondsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bonds         []*GetBondInfoResponse `protobuf:"bytes,1,rep,name=bonds,proto3" json:"bonds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBondsResponse) Reset() {
+	*x = ListBondsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBondsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBondsResponse) ProtoMessage() {}
+
+func (x *ListBondsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBondsResponse.ProtoReflect.Descriptor instead.
+func (*ListBondsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBondsResponse) GetBonds() []*GetBondInfoResponse {
+	if x != nil {
+		return x.Bonds
+	}
+	return nil
+}
+
+type ListInvestmentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`                 // Optional; naming the bond makes the listing cheaper
+	TrancheId       *int32                 `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3,oneof" json:"tranche_id,omitempty"` // Unset lists every tranche
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Since           int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`                                            // Optional Unix timestamp, inclusive
+	Until           int64                  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`                                            // Optional Unix timestamp, exclusive
+	PageSize        int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // Defaults to 50, capped at 200
+	PageToken       string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // next_page_token of the previous page, empty for the first
+	IncludeArchived bool                   `protobuf:"varint,8,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Investments in archived bonds are left out otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInvestmentsRequest) Reset() {
+	*x = ListInvestmentsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestmentsRequest) ProtoMessage() {}
+
+func (x *ListInvestmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvestmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListInvestmentsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListInvestmentsRequest) GetTrancheId() int32 {
+	if x != nil && x.TrancheId != nil {
+		return *x.TrancheId
+	}
+	return 0
+}
+
+func (x *ListInvestmentsRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *ListInvestmentsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListInvestmentsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListInvestmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvestmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInvestmentsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListInvestmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investments   []*InvestmentInfo      `protobuf:"bytes,1,rep,name=investments,proto3" json:"investments,omitempty"`                            // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvestmentsResponse) Reset() {
+	*x = ListInvestmentsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestmentsResponse) ProtoMessage() {}
+
+func (x *ListInvestmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvestmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListInvestmentsResponse) GetInvestments() []*InvestmentInfo {
+	if x != nil {
+		return x.Investments
+	}
+	return nil
+}
+
+func (x *ListInvestmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type InvestmentInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BondId          string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,3,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,4,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxHash          string                 `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Timestamp       int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AccruedInterest string                 `protobuf:"bytes,8,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"` // At the tranche's APY since the investment was made, to date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InvestmentInfo) Reset() {
+	*x = InvestmentInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestmentInfo) ProtoMessage() {}
+
+func (x *InvestmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestmentInfo.ProtoReflect.Descriptor instead.
+func (*InvestmentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InvestmentInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvestmentInfo) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *InvestmentInfo) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *InvestmentInfo) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *InvestmentInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *InvestmentInfo) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *InvestmentInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InvestmentInfo) GetAccruedInterest() string {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return ""
+}
+
+type TrancheInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId     uint32                 `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Allocation    string                 `protobuf:"bytes,3,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	Apy           string                 `protobuf:"bytes,4,opt,name=apy,proto3" json:"apy,omitempty"`
+	TotalInvested string                 `protobuf:"bytes,5,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"`
+	Arrears       string                 `protobuf:"bytes,6,opt,name=arrears,proto3" json:"arrears,omitempty"`
+	MinInvestment string                 `protobuf:"bytes,7,opt,name=min_investment,json=minInvestment,proto3" json:"min_investment,omitempty"`
+	MaxInvestment string                 `protobuf:"bytes,8,opt,name=max_investment,json=maxInvestment,proto3" json:"max_investment,omitempty"`
+	Priority      int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"` // 1 is paid first
+	RiskLevel     string                 `protobuf:"bytes,10,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	InvestorCount int32                  `protobuf:"varint,11,opt,name=investor_count,json=investorCount,proto3" json:"investor_count,omitempty"`
+	DayCount      string                 `protobuf:"bytes,12,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"` // The tranche's own, else the bond's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrancheInfo) Reset() {
+	*x = TrancheInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheInfo) ProtoMessage() {}
+
+func (x *TrancheInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheInfo.ProtoReflect.Descriptor instead.
+func (*TrancheInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrancheInfo) GetTrancheId() uint32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TrancheInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetAllocation() string {
+	if x != nil {
+		return x.Allocation
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetApy() string {
+	if x != nil {
+		return x.Apy
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetTotalInvested() string {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetArrears() string {
+	if x != nil {
+		return x.Arrears
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetMinInvestment() string {
+	if x != nil {
+		return x.MinInvestment
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetMaxInvestment() string {
+	if x != nil {
+		return x.MaxInvestment
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TrancheInfo) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *TrancheInfo) GetInvestorCount() int32 {
+	if x != nil {
+		return x.InvestorCount
+	}
+	return 0
+}
+
+func (x *TrancheInfo) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+type GetTrancheInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTrancheInfoRequest) Reset() {
+	*x = GetTrancheInfoRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrancheInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrancheInfoRequest) ProtoMessage() {}
+
+func (x *GetTrancheInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrancheInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetTrancheInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTrancheInfoRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetTrancheInfoRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetTrancheInfoRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetTrancheInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Stored        *TrancheInfo           `protobuf:"bytes,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	OnChain       *OnChainTranche        `protobuf:"bytes,4,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"` // Read from the contract's getTrancheInfo
+	Discrepancies []*Discrepancy         `protobuf:"bytes,5,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`    // Stored allocation, total_invested or investor_count differing from on_chain
+	Consistent    bool                   `protobuf:"varint,6,opt,name=consistent,proto3" json:"consistent,omitempty"`         // No discrepancies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrancheInfoResponse) Reset() {
+	*x = GetTrancheInfoResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrancheInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrancheInfoResponse) ProtoMessage() {}
+
+func (x *GetTrancheInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrancheInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetTrancheInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTrancheInfoResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetTrancheInfoResponse) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetTrancheInfoResponse) GetStored() *TrancheInfo {
+	if x != nil {
+		return x.Stored
+	}
+	return nil
+}
+
+func (x *GetTrancheInfoResponse) GetOnChain() *OnChainTranche {
+	if x != nil {
+		return x.OnChain
+	}
+	return nil
+}
+
+func (x *GetTrancheInfoResponse) GetDiscrepancies() []*Discrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *GetTrancheInfoResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+type OnChainTranche struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocation    string                 `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	ApyBps        int64                  `protobuf:"varint,2,opt,name=apy_bps,json=apyBps,proto3" json:"apy_bps,omitempty"`
+	TotalInvested string                 `protobuf:"bytes,3,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"`
+	InvestorCount int64                  `protobuf:"varint,4,opt,name=investor_count,json=investorCount,proto3" json:"investor_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainTranche) Reset() {
+	*x = OnChainTranche{}
+	mi := &file_proto_bonding_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainTranche) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainTranche) ProtoMessage() {}
+
+func (x *OnChainTranche) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainTranche.ProtoReflect.Descriptor instead.
+func (*OnChainTranche) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OnChainTranche) GetAllocation() string {
+	if x != nil {
+		return x.Allocation
+	}
+	return ""
+}
+
+func (x *OnChainTranche) GetApyBps() int64 {
+	if x != nil {
+		return x.ApyBps
+	}
+	return 0
+}
+
+func (x *OnChainTranche) GetTotalInvested() string {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return ""
+}
+
+func (x *OnChainTranche) GetInvestorCount() int64 {
+	if x != nil {
+		return x.InvestorCount
+	}
+	return 0
+}
+
+type DistributeRevenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Revenue       string                 `protobuf:"bytes,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Licensee      string                 `protobuf:"bytes,3,opt,name=licensee,proto3" json:"licensee,omitempty"`                        // Licensee paying the royalties, defaults to the license agreement's
+	DueAt         int64                  `protobuf:"varint,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                // When the payment was due, scores the licensee's punctuality and names the period it pays
+	ReceivedAt    int64                  `protobuf:"varint,5,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"` // When the payment arrived, defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributeRevenueRequest) Reset() {
+	*x = DistributeRevenueRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributeRevenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributeRevenueRequest) ProtoMessage() {}
+
+func (x *DistributeRevenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributeRevenueRequest.ProtoReflect.Descriptor instead.
+func (*DistributeRevenueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DistributeRevenueRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *DistributeRevenueRequest) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *DistributeRevenueRequest) GetLicensee() string {
+	if x != nil {
+		return x.Licensee
+	}
+	return ""
+}
+
+func (x *DistributeRevenueRequest) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *DistributeRevenueRequest) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+type DistributeRevenueResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxHash         string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Distributions  []*TrancheDistribution `protobuf:"bytes,3,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	TotalShortfall string                 `protobuf:"bytes,4,opt,name=total_shortfall,json=totalShortfall,proto3" json:"total_shortfall,omitempty"`
+	TotalArrears   string                 `protobuf:"bytes,5,opt,name=total_arrears,json=totalArrears,proto3" json:"total_arrears,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DistributeRevenueResponse) Reset() {
+	*x = DistributeRevenueResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributeRevenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributeRevenueResponse) ProtoMessage() {}
+
+func (x *DistributeRevenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributeRevenueResponse.ProtoReflect.Descriptor instead.
+func (*DistributeRevenueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DistributeRevenueResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *DistributeRevenueResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DistributeRevenueResponse) GetDistributions() []*TrancheDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *DistributeRevenueResponse) GetTotalShortfall() string {
+	if x != nil {
+		return x.TotalShortfall
+	}
+	return ""
+}
+
+func (x *DistributeRevenueResponse) GetTotalArrears() string {
+	if x != nil {
+		return x.TotalArrears
+	}
+	return ""
+}
+
+type TrancheDistribution struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId         int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AmountDistributed string                 `protobuf:"bytes,3,opt,name=amount_distributed,json=amountDistributed,proto3" json:"amount_distributed,omitempty"`
+	InvestorCount     int32                  `protobuf:"varint,4,opt,name=investor_count,json=investorCount,proto3" json:"investor_count,omitempty"`
+	ArrearsPaid       string                 `protobuf:"bytes,5,opt,name=arrears_paid,json=arrearsPaid,proto3" json:"arrears_paid,omitempty"`
+	Shortfall         string                 `protobuf:"bytes,6,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	Arrears           string                 `protobuf:"bytes,7,opt,name=arrears,proto3" json:"arrears,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TrancheDistribution) Reset() {
+	*x = TrancheDistribution{}
+	mi := &file_proto_bonding_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheDistribution) ProtoMessage() {}
+
+func (x *TrancheDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheDistribution.ProtoReflect.Descriptor instead.
+func (*TrancheDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TrancheDistribution) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TrancheDistribution) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrancheDistribution) GetAmountDistributed() string {
+	if x != nil {
+		return x.AmountDistributed
+	}
+	return ""
+}
+
+func (x *TrancheDistribution) GetInvestorCount() int32 {
+	if x != nil {
+		return x.InvestorCount
+	}
+	return 0
+}
+
+func (x *TrancheDistribution) GetArrearsPaid() string {
+	if x != nil {
+		return x.ArrearsPaid
+	}
+	return ""
+}
+
+func (x *TrancheDistribution) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+func (x *TrancheDistribution) GetArrears() string {
+	if x != nil {
+		return x.Arrears
+	}
+	return ""
+}
+
+type GetRevenueHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // Defaults to 50, capped at 200
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // next_page_token of the previous page, empty for the first
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRevenueHistoryRequest) Reset() {
+	*x = GetRevenueHistoryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueHistoryRequest) ProtoMessage() {}
+
+func (x *GetRevenueHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRevenueHistoryRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetRevenueHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRevenueHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRevenueHistoryRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetRevenueHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	BondId        string                     `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Distributions []*RevenueDistributionInfo `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions,omitempty"`                        // Newest first
+	NextPageToken string                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueHistoryResponse) Reset() {
+	*x = GetRevenueHistoryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueHistoryResponse) ProtoMessage() {}
+
+func (x *GetRevenueHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRevenueHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRevenueHistoryResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetRevenueHistoryResponse) GetDistributions() []*RevenueDistributionInfo {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *GetRevenueHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPaymentScheduleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`                   // -1 for every tranche
+	IncludeArchived bool                   `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPaymentScheduleRequest) Reset() {
+	*x = GetPaymentScheduleRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentScheduleRequest) ProtoMessage() {}
+
+func (x *GetPaymentScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPaymentScheduleRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetPaymentScheduleRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetPaymentScheduleRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// Payments expected since issuance against the distributions actually made
+type GetPaymentScheduleResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	BondId        string                    `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Tranches      []*TranchePaymentSchedule `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentScheduleResponse) Reset() {
+	*x = GetPaymentScheduleResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentScheduleResponse) ProtoMessage() {}
+
+func (x *GetPaymentScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPaymentScheduleResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetPaymentScheduleResponse) GetTranches() []*TranchePaymentSchedule {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+type TranchePaymentSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId       int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Allocation      string                 `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation,omitempty"`                                  // Principal the schedule was generated on
+	TotalInvested   string                 `protobuf:"bytes,3,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"`       // Principal expected coupons are scaled to
+	Payments        []*ScheduledPayment    `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty"`                                      // By due date
+	ExpectedCoupons string                 `protobuf:"bytes,5,opt,name=expected_coupons,json=expectedCoupons,proto3" json:"expected_coupons,omitempty"` // Sum of the payments' expected_coupon
+	Paid            string                 `protobuf:"bytes,6,opt,name=paid,proto3" json:"paid,omitempty"`                                              // Sum of the payments' paid
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TranchePaymentSchedule) Reset() {
+	*x = TranchePaymentSchedule{}
+	mi := &file_proto_bonding_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranchePaymentSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranchePaymentSchedule) ProtoMessage() {}
+
+func (x *TranchePaymentSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranchePaymentSchedule.ProtoReflect.Descriptor instead.
+func (*TranchePaymentSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TranchePaymentSchedule) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TranchePaymentSchedule) GetAllocation() string {
+	if x != nil {
+		return x.Allocation
+	}
+	return ""
+}
+
+func (x *TranchePaymentSchedule) GetTotalInvested() string {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return ""
+}
+
+func (x *TranchePaymentSchedule) GetPayments() []*ScheduledPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *TranchePaymentSchedule) GetExpectedCoupons() string {
+	if x != nil {
+		return x.ExpectedCoupons
+	}
+	return ""
+}
+
+func (x *TranchePaymentSchedule) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+type ScheduledPayment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sequence       int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // 1 for the first payment
+	DueDate        int64                  `protobuf:"varint,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Coupon         string                 `protobuf:"bytes,3,opt,name=coupon,proto3" json:"coupon,omitempty"`                                       // On the full allocation, as generated at issuance
+	Principal      string                 `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`                                 // Repaid with the last coupon
+	ExpectedCoupon string                 `protobuf:"bytes,5,opt,name=expected_coupon,json=expectedCoupon,proto3" json:"expected_coupon,omitempty"` // coupon scaled to the tranche's total invested
+	Paid           string                 `protobuf:"bytes,6,opt,name=paid,proto3" json:"paid,omitempty"`                                           // Arrears and coupons distributed after the previous due date and up to this one
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                       // UPCOMING, PAID, PARTIAL or MISSED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduledPayment) Reset() {
+	*x = ScheduledPayment{}
+	mi := &file_proto_bonding_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPayment) ProtoMessage() {}
+
+func (x *ScheduledPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPayment.ProtoReflect.Descriptor instead.
+func (*ScheduledPayment) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ScheduledPayment) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ScheduledPayment) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *ScheduledPayment) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetExpectedCoupon() string {
+	if x != nil {
+		return x.ExpectedCoupon
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetYieldHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`                   // -1 for every tranche
+	From            int64                  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`                                              // Unix seconds, 0 for since issuance
+	To              int64                  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`                                                  // Unix seconds, 0 for up to now
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetYieldHistoryRequest) Reset() {
+	*x = GetYieldHistoryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetYieldHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetYieldHistoryRequest) ProtoMessage() {}
+
+func (x *GetYieldHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetYieldHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetYieldHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetYieldHistoryRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetYieldHistoryRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetYieldHistoryRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetYieldHistoryRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetYieldHistoryRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// The yield each tranche realized after each distribution, for charting
+// against its promised APY
+type GetYieldHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Tranches      []*TrancheYieldHistory `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetYieldHistoryResponse) Reset() {
+	*x = GetYieldHistoryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetYieldHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetYieldHistoryResponse) ProtoMessage() {}
+
+func (x *GetYieldHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetYieldHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetYieldHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetYieldHistoryResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetYieldHistoryResponse) GetTranches() []*TrancheYieldHistory {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+type TrancheYieldHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId     int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Apy           float64                `protobuf:"fixed64,2,opt,name=apy,proto3" json:"apy,omitempty"`                                    // Promised, in percent
+	RealizedApy   float64                `protobuf:"fixed64,3,opt,name=realized_apy,json=realizedApy,proto3" json:"realized_apy,omitempty"` // Since issuance, in percent
+	TotalPaid     string                 `protobuf:"bytes,4,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+	Points        []*YieldPoint          `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrancheYieldHistory) Reset() {
+	*x = TrancheYieldHistory{}
+	mi := &file_proto_bonding_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheYieldHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheYieldHistory) ProtoMessage() {}
+
+func (x *TrancheYieldHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheYieldHistory.ProtoReflect.Descriptor instead.
+func (*TrancheYieldHistory) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TrancheYieldHistory) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TrancheYieldHistory) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *TrancheYieldHistory) GetRealizedApy() float64 {
+	if x != nil {
+		return x.RealizedApy
+	}
+	return 0
+}
+
+func (x *TrancheYieldHistory) GetTotalPaid() string {
+	if x != nil {
+		return x.TotalPaid
+	}
+	return ""
+}
+
+func (x *TrancheYieldHistory) GetPoints() []*YieldPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type YieldPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp      int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DistributionId uint64                 `protobuf:"varint,2,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Invested       string                 `protobuf:"bytes,3,opt,name=invested,proto3" json:"invested,omitempty"` // Capital the distribution paid on
+	Paid           string                 `protobuf:"bytes,4,opt,name=paid,proto3" json:"paid,omitempty"`         // Arrears, coupon and residual paid
+	CumulativePaid string                 `protobuf:"bytes,5,opt,name=cumulative_paid,json=cumulativePaid,proto3" json:"cumulative_paid,omitempty"`
+	PeriodYield    float64                `protobuf:"fixed64,6,opt,name=period_yield,json=periodYield,proto3" json:"period_yield,omitempty"` // paid over invested, in percent
+	PeriodApy      float64                `protobuf:"fixed64,7,opt,name=period_apy,json=periodApy,proto3" json:"period_apy,omitempty"`       // period_yield annualized over the time since the previous distribution
+	RealizedApy    float64                `protobuf:"fixed64,8,opt,name=realized_apy,json=realizedApy,proto3" json:"realized_apy,omitempty"` // cumulative_paid annualized over the capital held since issuance
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *YieldPoint) Reset() {
+	*x = YieldPoint{}
+	mi := &file_proto_bonding_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YieldPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YieldPoint) ProtoMessage() {}
+
+func (x *YieldPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YieldPoint.ProtoReflect.Descriptor instead.
+func (*YieldPoint) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *YieldPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *YieldPoint) GetDistributionId() uint64 {
+	if x != nil {
+		return x.DistributionId
+	}
+	return 0
+}
+
+func (x *YieldPoint) GetInvested() string {
+	if x != nil {
+		return x.Invested
+	}
+	return ""
+}
+
+func (x *YieldPoint) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *YieldPoint) GetCumulativePaid() string {
+	if x != nil {
+		return x.CumulativePaid
+	}
+	return ""
+}
+
+func (x *YieldPoint) GetPeriodYield() float64 {
+	if x != nil {
+		return x.PeriodYield
+	}
+	return 0
+}
+
+func (x *YieldPoint) GetPeriodApy() float64 {
+	if x != nil {
+		return x.PeriodApy
+	}
+	return 0
+}
+
+func (x *YieldPoint) GetRealizedApy() float64 {
+	if x != nil {
+		return x.RealizedApy
+	}
+	return 0
+}
+
+// A recorded revenue distribution, to check against the DistributeRevenue
+// transaction on-chain
+type RevenueDistributionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`       // Paid out across the tranches
+	Shortfall     string                 `protobuf:"bytes,3,opt,name=shortfall,proto3" json:"shortfall,omitempty"` // Coupons left unpaid
+	TxHash        string                 `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Period        int64                  `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`    // Due date of the revenue paid out, 0 when unnamed
+	Tranches      []*TrancheSplit        `protobuf:"bytes,7,rep,name=tranches,proto3" json:"tranches,omitempty"` // By tranche ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueDistributionInfo) Reset() {
+	*x = RevenueDistributionInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueDistributionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueDistributionInfo) ProtoMessage() {}
+
+func (x *RevenueDistributionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueDistributionInfo.ProtoReflect.Descriptor instead.
+func (*RevenueDistributionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevenueDistributionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevenueDistributionInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RevenueDistributionInfo) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+func (x *RevenueDistributionInfo) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *RevenueDistributionInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RevenueDistributionInfo) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *RevenueDistributionInfo) GetTranches() []*TrancheSplit {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+// How a distribution was split for one tranche
+type TrancheSplit struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId         int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	CouponDue         string                 `protobuf:"bytes,2,opt,name=coupon_due,json=couponDue,proto3" json:"coupon_due,omitempty"`
+	ArrearsPaid       string                 `protobuf:"bytes,3,opt,name=arrears_paid,json=arrearsPaid,proto3" json:"arrears_paid,omitempty"`
+	CouponPaid        string                 `protobuf:"bytes,4,opt,name=coupon_paid,json=couponPaid,proto3" json:"coupon_paid,omitempty"`
+	Residual          string                 `protobuf:"bytes,5,opt,name=residual,proto3" json:"residual,omitempty"`
+	AmountDistributed string                 `protobuf:"bytes,6,opt,name=amount_distributed,json=amountDistributed,proto3" json:"amount_distributed,omitempty"` // arrears_paid + coupon_paid + residual
+	Shortfall         string                 `protobuf:"bytes,7,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	Arrears           string                 `protobuf:"bytes,8,opt,name=arrears,proto3" json:"arrears,omitempty"` // Arrears carried forward after the distribution
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TrancheSplit) Reset() {
+	*x = TrancheSplit{}
+	mi := &file_proto_bonding_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheSplit) ProtoMessage() {}
+
+func (x *TrancheSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheSplit.ProtoReflect.Descriptor instead.
+func (*TrancheSplit) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TrancheSplit) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TrancheSplit) GetCouponDue() string {
+	if x != nil {
+		return x.CouponDue
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetArrearsPaid() string {
+	if x != nil {
+		return x.ArrearsPaid
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetCouponPaid() string {
+	if x != nil {
+		return x.CouponPaid
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetResidual() string {
+	if x != nil {
+		return x.Residual
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetAmountDistributed() string {
+	if x != nil {
+		return x.AmountDistributed
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+func (x *TrancheSplit) GetArrears() string {
+	if x != nil {
+		return x.Arrears
+	}
+	return ""
+}
+
+type RequestEarlyRedemptionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // Empty redeems the full position
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestEarlyRedemptionRequest) Reset() {
+	*x = RequestEarlyRedemptionRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEarlyRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEarlyRedemptionRequest) ProtoMessage() {}
+
+func (x *RequestEarlyRedemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEarlyRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*RequestEarlyRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestEarlyRedemptionRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *RequestEarlyRedemptionRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *RequestEarlyRedemptionRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *RequestEarlyRedemptionRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ApproveRedemptionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RedemptionId    uint64                 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	ApproverAddress string                 `protobuf:"bytes,2,opt,name=approver_address,json=approverAddress,proto3" json:"approver_address,omitempty"` // Optional; the approver is the signed-in issuer wallet
+	Approve         bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveRedemptionRequest) Reset() {
+	*x = ApproveRedemptionRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRedemptionRequest) ProtoMessage() {}
+
+func (x *ApproveRedemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApproveRedemptionRequest) GetRedemptionId() uint64 {
+	if x != nil {
+		return x.RedemptionId
+	}
+	return 0
+}
+
+func (x *ApproveRedemptionRequest) GetApproverAddress() string {
+	if x != nil {
+		return x.ApproverAddress
+	}
+	return ""
+}
+
+func (x *ApproveRedemptionRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ApproveRedemptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RedemptionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RedemptionId     uint64                 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	BondId           string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId        int32                  `protobuf:"varint,3,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress  string                 `protobuf:"bytes,4,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Amount           string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Penalty          string                 `protobuf:"bytes,6,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Payout           string                 `protobuf:"bytes,7,opt,name=payout,proto3" json:"payout,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	TxHash           string                 `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,10,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	Investor         *Counterparty          `protobuf:"bytes,11,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RedemptionResponse) Reset() {
+	*x = RedemptionResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedemptionResponse) ProtoMessage() {}
+
+func (x *RedemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedemptionResponse.ProtoReflect.Descriptor instead.
+func (*RedemptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RedemptionResponse) GetRedemptionId() uint64 {
+	if x != nil {
+		return x.RedemptionId
+	}
+	return 0
+}
+
+func (x *RedemptionResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *RedemptionResponse) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetPenalty() string {
+	if x != nil {
+		return x.Penalty
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetPayout() string {
+	if x != nil {
+		return x.Payout
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *RedemptionResponse) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *RedemptionResponse) GetInvestor() *Counterparty {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+type QueueDistributionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distributions []*QueuedDistribution  `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueDistributionsRequest) Reset() {
+	*x = QueueDistributionsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueDistributionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueDistributionsRequest) ProtoMessage() {}
+
+func (x *QueueDistributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueDistributionsRequest.ProtoReflect.Descriptor instead.
+func (*QueueDistributionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueueDistributionsRequest) GetDistributions() []*QueuedDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+type QueueDistributionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distributions []*QueuedDistribution  `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueDistributionsResponse) Reset() {
+	*x = QueueDistributionsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueDistributionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueDistributionsResponse) ProtoMessage() {}
+
+func (x *QueueDistributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueDistributionsResponse.ProtoReflect.Descriptor instead.
+func (*QueueDistributionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueueDistributionsResponse) GetDistributions() []*QueuedDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+type QueuedDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	DueAt         int64                  `protobuf:"varint,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // Unix timestamp, 0 means as soon as possible
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedDistribution) Reset() {
+	*x = QueuedDistribution{}
+	mi := &file_proto_bonding_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedDistribution) ProtoMessage() {}
+
+func (x *QueuedDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedDistribution.ProtoReflect.Descriptor instead.
+func (*QueuedDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueuedDistribution) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedDistribution) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *QueuedDistribution) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *QueuedDistribution) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *QueuedDistribution) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetDistributionScheduleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BondId     string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Cadence    string                 `protobuf:"bytes,2,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	FirstRunAt int64                  `protobuf:"varint,3,opt,name=first_run_at,json=firstRunAt,proto3" json:"first_run_at,omitempty"` // Unix timestamp later runs are counted from, 0 means now
+	// Optional fixed amount per run; empty distributes the revenue accrued
+	// since the previous run
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDistributionScheduleRequest) Reset() {
+	*x = SetDistributionScheduleRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDistributionScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDistributionScheduleRequest) ProtoMessage() {}
+
+func (x *SetDistributionScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDistributionScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetDistributionScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetDistributionScheduleRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *SetDistributionScheduleRequest) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
+func (x *SetDistributionScheduleRequest) GetFirstRunAt() int64 {
+	if x != nil {
+		return x.FirstRunAt
+	}
+	return 0
+}
+
+func (x *SetDistributionScheduleRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type DistributionScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributionScheduleRequest) Reset() {
+	*x = DistributionScheduleRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributionScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionScheduleRequest) ProtoMessage() {}
+
+func (x *DistributionScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DistributionScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DistributionScheduleRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+type SkipDistributionRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Runs          int32                  `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"` // Upcoming runs to skip, 0 clears earlier skips
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipDistributionRunsRequest) Reset() {
+	*x = SkipDistributionRunsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipDistributionRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipDistributionRunsRequest) ProtoMessage() {}
+
+func (x *SkipDistributionRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipDistributionRunsRequest.ProtoReflect.Descriptor instead.
+func (*SkipDistributionRunsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SkipDistributionRunsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *SkipDistributionRunsRequest) GetRuns() int32 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+type DistributionSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Cadence       string                 `protobuf:"bytes,2,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	FirstRunAt    int64                  `protobuf:"varint,3,opt,name=first_run_at,json=firstRunAt,proto3" json:"first_run_at,omitempty"`
+	NextRunAt     int64                  `protobuf:"varint,4,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // Empty when runs distribute accrued revenue
+	Paused        bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	SkipRuns      int32                  `protobuf:"varint,7,opt,name=skip_runs,json=skipRuns,proto3" json:"skip_runs,omitempty"`
+	AccruedSince  int64                  `protobuf:"varint,8,opt,name=accrued_since,json=accruedSince,proto3" json:"accrued_since,omitempty"` // Start of the revenue window the next run pays
+	LastRunAt     int64                  `protobuf:"varint,9,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`        // 0 before the first run
+	LastStatus    string                 `protobuf:"bytes,10,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`       // QUEUED, SKIPPED, NO_REVENUE or FAILED
+	LastError     string                 `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastQueuedId  uint64                 `protobuf:"varint,12,opt,name=last_queued_id,json=lastQueuedId,proto3" json:"last_queued_id,omitempty"` // QueuedDistribution created by the last queued run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributionSchedule) Reset() {
+	*x = DistributionSchedule{}
+	mi := &file_proto_bonding_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionSchedule) ProtoMessage() {}
+
+func (x *DistributionSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionSchedule.ProtoReflect.Descriptor instead.
+func (*DistributionSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DistributionSchedule) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *DistributionSchedule) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
+func (x *DistributionSchedule) GetFirstRunAt() int64 {
+	if x != nil {
+		return x.FirstRunAt
+	}
+	return 0
+}
+
+func (x *DistributionSchedule) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *DistributionSchedule) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DistributionSchedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *DistributionSchedule) GetSkipRuns() int32 {
+	if x != nil {
+		return x.SkipRuns
+	}
+	return 0
+}
+
+func (x *DistributionSchedule) GetAccruedSince() int64 {
+	if x != nil {
+		return x.AccruedSince
+	}
+	return 0
+}
+
+func (x *DistributionSchedule) GetLastRunAt() int64 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
+}
+
+func (x *DistributionSchedule) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+func (x *DistributionSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DistributionSchedule) GetLastQueuedId() uint64 {
+	if x != nil {
+		return x.LastQueuedId
+	}
+	return 0
+}
+
+type DeleteDistributionScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDistributionScheduleResponse) Reset() {
+	*x = DeleteDistributionScheduleResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDistributionScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDistributionScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteDistributionScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDistributionScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDistributionScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteDistributionScheduleResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ImportRevenueCSVRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name the rows' external_ids are unique under, e.g. the licensee whose
+	// statements are imported
+	Connector string `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	// Header row with bond_id, external_id, amount, occurred_at and optionally
+	// reference; occurred_at is RFC 3339 or YYYY-MM-DD
+	Csv           []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRevenueCSVRequest) Reset() {
+	*x = ImportRevenueCSVRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRevenueCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRevenueCSVRequest) ProtoMessage() {}
+
+func (x *ImportRevenueCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRevenueCSVRequest.ProtoReflect.Descriptor instead.
+func (*ImportRevenueCSVRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportRevenueCSVRequest) GetConnector() string {
+	if x != nil {
+		return x.Connector
+	}
+	return ""
+}
+
+func (x *ImportRevenueCSVRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportRevenueCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      int32                  `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // Rows already imported under the connector
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRevenueCSVResponse) Reset() {
+	*x = ImportRevenueCSVResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRevenueCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRevenueCSVResponse) ProtoMessage() {}
+
+func (x *ImportRevenueCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRevenueCSVResponse.ProtoReflect.Descriptor instead.
+func (*ImportRevenueCSVResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportRevenueCSVResponse) GetRecorded() int32 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+func (x *ImportRevenueCSVResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type ReviewRevenueEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Required to reject
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRevenueEventsRequest) Reset() {
+	*x = ReviewRevenueEventsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRevenueEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRevenueEventsRequest) ProtoMessage() {}
+
+func (x *ReviewRevenueEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRevenueEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRevenueEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReviewRevenueEventsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReviewRevenueEventsRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewRevenueEventsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewRevenueEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewed      int32                  `protobuf:"varint,1,opt,name=reviewed,proto3" json:"reviewed,omitempty"` // Events that were pending; others are left as they were
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRevenueEventsResponse) Reset() {
+	*x = ReviewRevenueEventsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRevenueEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRevenueEventsResponse) ProtoMessage() {}
+
+func (x *ReviewRevenueEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRevenueEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReviewRevenueEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReviewRevenueEventsResponse) GetReviewed() int32 {
+	if x != nil {
+		return x.Reviewed
+	}
+	return 0
+}
+
+type ListRevenueEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`          // Optional
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                        // Optional
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // Optional
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Defaults to 50, capped at 200
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, empty for the first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevenueEventsRequest) Reset() {
+	*x = ListRevenueEventsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevenueEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevenueEventsRequest) ProtoMessage() {}
+
+func (x *ListRevenueEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevenueEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevenueEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListRevenueEventsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListRevenueEventsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRevenueEventsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ListRevenueEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRevenueEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRevenueEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*RevenueEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevenueEventsResponse) Reset() {
+	*x = ListRevenueEventsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevenueEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevenueEventsResponse) ProtoMessage() {}
+
+func (x *ListRevenueEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevenueEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevenueEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListRevenueEventsResponse) GetEvents() []*RevenueEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListRevenueEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RevenueEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`       // WEBHOOK, CSV or CHAIN
+	Connector     string                 `protobuf:"bytes,4,opt,name=connector,proto3" json:"connector,omitempty"` // Webhook name, import connector or chain
+	ExternalId    string                 `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Reference     string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // PENDING, VERIFIED or REJECTED
+	RecordedBy    string                 `protobuf:"bytes,10,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,11,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`  // Empty when verified on arrival
+	ReviewedAt    int64                  `protobuf:"varint,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"` // Verified revenue is distributed by the first scheduled run after this
+	RejectReason  string                 `protobuf:"bytes,13,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	TxHash        string                 `protobuf:"bytes,14,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"` // Set for CHAIN events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueEvent) Reset() {
+	*x = RevenueEvent{}
+	mi := &file_proto_bonding_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueEvent) ProtoMessage() {}
+
+func (x *RevenueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueEvent.ProtoReflect.Descriptor instead.
+func (*RevenueEvent) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RevenueEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevenueEvent) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetConnector() string {
+	if x != nil {
+		return x.Connector
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *RevenueEvent) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *RevenueEvent) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *RevenueEvent) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type ListAccrualSnapshotsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,2,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"` // Optional
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Defaults to 50, capped at 200
+	PageToken       string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // next_page_token of the previous page, empty for the first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAccrualSnapshotsRequest) Reset() {
+	*x = ListAccrualSnapshotsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccrualSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccrualSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListAccrualSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccrualSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccrualSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAccrualSnapshotsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListAccrualSnapshotsRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *ListAccrualSnapshotsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccrualSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAccrualSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*AccrualSnapshot     `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`                                // Latest day first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccrualSnapshotsResponse) Reset() {
+	*x = ListAccrualSnapshotsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccrualSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccrualSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListAccrualSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccrualSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccrualSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAccrualSnapshotsResponse) GetSnapshots() []*AccrualSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListAccrualSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Interest an investment accrued over one UTC day
+type AccrualSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InvestmentId    uint64                 `protobuf:"varint,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	Day             int64                  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"` // Start of the day
+	TrancheId       int32                  `protobuf:"varint,3,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,4,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Principal       string                 `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	ApyBps          int64                  `protobuf:"varint,6,opt,name=apy_bps,json=apyBps,proto3" json:"apy_bps,omitempty"`
+	Seconds         int64                  `protobuf:"varint,7,opt,name=seconds,proto3" json:"seconds,omitempty"` // Part of the day the investment was held before maturity
+	Interest        string                 `protobuf:"bytes,8,opt,name=interest,proto3" json:"interest,omitempty"`
+	Accrued         string                 `protobuf:"bytes,9,opt,name=accrued,proto3" json:"accrued,omitempty"`                    // Since the investment was made, through the day
+	DayCount        string                 `protobuf:"bytes,10,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"` // Convention the interest accrued under
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccrualSnapshot) Reset() {
+	*x = AccrualSnapshot{}
+	mi := &file_proto_bonding_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccrualSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccrualSnapshot) ProtoMessage() {}
+
+func (x *AccrualSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccrualSnapshot.ProtoReflect.Descriptor instead.
+func (*AccrualSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AccrualSnapshot) GetInvestmentId() uint64 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *AccrualSnapshot) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *AccrualSnapshot) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *AccrualSnapshot) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *AccrualSnapshot) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AccrualSnapshot) GetApyBps() int64 {
+	if x != nil {
+		return x.ApyBps
+	}
+	return 0
+}
+
+func (x *AccrualSnapshot) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *AccrualSnapshot) GetInterest() string {
+	if x != nil {
+		return x.Interest
+	}
+	return ""
+}
+
+func (x *AccrualSnapshot) GetAccrued() string {
+	if x != nil {
+		return x.Accrued
+	}
+	return ""
+}
+
+func (x *AccrualSnapshot) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+type TransferInvestmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	FromAddress   string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // Empty transfers the full position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferInvestmentRequest) Reset() {
+	*x = TransferInvestmentRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferInvestmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferInvestmentRequest) ProtoMessage() {}
+
+func (x *TransferInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*TransferInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TransferInvestmentRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *TransferInvestmentRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TransferInvestmentRequest) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *TransferInvestmentRequest) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *TransferInvestmentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type TransferInvestmentResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransferId        uint64                 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	TxHash            string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Amount            string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RemainingPosition string                 `protobuf:"bytes,4,opt,name=remaining_position,json=remainingPosition,proto3" json:"remaining_position,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	From              *Counterparty          `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To                *Counterparty          `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferInvestmentResponse) Reset() {
+	*x = TransferInvestmentResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferInvestmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferInvestmentResponse) ProtoMessage() {}
+
+func (x *TransferInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*TransferInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TransferInvestmentResponse) GetTransferId() uint64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *TransferInvestmentResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransferInvestmentResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransferInvestmentResponse) GetRemainingPosition() string {
+	if x != nil {
+		return x.RemainingPosition
+	}
+	return ""
+}
+
+func (x *TransferInvestmentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransferInvestmentResponse) GetFrom() *Counterparty {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransferInvestmentResponse) GetTo() *Counterparty {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetChainStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"` // Empty returns every watched chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainStatusRequest) Reset() {
+	*x = GetChainStatusRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusRequest) ProtoMessage() {}
+
+func (x *GetChainStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetChainStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetChainStatusRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type GetChainStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chains        []*ChainStatus         `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainStatusResponse) Reset() {
+	*x = GetChainStatusResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusResponse) ProtoMessage() {}
+
+func (x *GetChainStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetChainStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetChainStatusResponse) GetChains() []*ChainStatus {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type ChainStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Chain            string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	HeadBlock        uint64                 `protobuf:"varint,2,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	HeadTimestamp    int64                  `protobuf:"varint,3,opt,name=head_timestamp,json=headTimestamp,proto3" json:"head_timestamp,omitempty"`
+	FinalizedBlock   uint64                 `protobuf:"varint,4,opt,name=finalized_block,json=finalizedBlock,proto3" json:"finalized_block,omitempty"`
+	IndexedBlock     uint64                 `protobuf:"varint,5,opt,name=indexed_block,json=indexedBlock,proto3" json:"indexed_block,omitempty"`
+	IndexerLagBlocks uint64                 `protobuf:"varint,6,opt,name=indexer_lag_blocks,json=indexerLagBlocks,proto3" json:"indexer_lag_blocks,omitempty"`
+	Syncing          bool                   `protobuf:"varint,7,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	WritesPaused     bool                   `protobuf:"varint,8,opt,name=writes_paused,json=writesPaused,proto3" json:"writes_paused,omitempty"`
+	PauseReason      string                 `protobuf:"bytes,9,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChainId          int64                  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Confirmations    uint64                 `protobuf:"varint,12,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChainStatus) Reset() {
+	*x = ChainStatus{}
+	mi := &file_proto_bonding_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainStatus) ProtoMessage() {}
+
+func (x *ChainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainStatus.ProtoReflect.Descriptor instead.
+func (*ChainStatus) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ChainStatus) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ChainStatus) GetHeadBlock() uint64 {
+	if x != nil {
+		return x.HeadBlock
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetHeadTimestamp() int64 {
+	if x != nil {
+		return x.HeadTimestamp
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetFinalizedBlock() uint64 {
+	if x != nil {
+		return x.FinalizedBlock
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetIndexedBlock() uint64 {
+	if x != nil {
+		return x.IndexedBlock
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetIndexerLagBlocks() uint64 {
+	if x != nil {
+		return x.IndexerLagBlocks
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetSyncing() bool {
+	if x != nil {
+		return x.Syncing
+	}
+	return false
+}
+
+func (x *ChainStatus) GetWritesPaused() bool {
+	if x != nil {
+		return x.WritesPaused
+	}
+	return false
+}
+
+func (x *ChainStatus) GetPauseReason() string {
+	if x != nil {
+		return x.PauseReason
+	}
+	return ""
+}
+
+func (x *ChainStatus) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type PreparePermitInvestmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	TokenAddress    string                 `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Deadline        int64                  `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"` // Optional Unix timestamp
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreparePermitInvestmentRequest) Reset() {
+	*x = PreparePermitInvestmentRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreparePermitInvestmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparePermitInvestmentRequest) ProtoMessage() {}
+
+func (x *PreparePermitInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparePermitInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*PreparePermitInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PreparePermitInvestmentRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *PreparePermitInvestmentRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentRequest) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type PreparePermitInvestmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TypedData     string                 `protobuf:"bytes,1,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"` // EIP-712 typed data JSON for eth_signTypedData_v4
+	Spender       string                 `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Deadline      int64                  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreparePermitInvestmentResponse) Reset() {
+	*x = PreparePermitInvestmentResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreparePermitInvestmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparePermitInvestmentResponse) ProtoMessage() {}
+
+func (x *PreparePermitInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparePermitInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*PreparePermitInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PreparePermitInvestmentResponse) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentResponse) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentResponse) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *PreparePermitInvestmentResponse) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type InvestWithPermitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	TokenAddress    string                 `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Deadline        int64                  `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signature       string                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"` // 0x-prefixed 65-byte signature
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InvestWithPermitRequest) Reset() {
+	*x = InvestWithPermitRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestWithPermitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestWithPermitRequest) ProtoMessage() {}
+
+func (x *InvestWithPermitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestWithPermitRequest.ProtoReflect.Descriptor instead.
+func (*InvestWithPermitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InvestWithPermitRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *InvestWithPermitRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *InvestWithPermitRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *InvestWithPermitRequest) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+func (x *InvestWithPermitRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *InvestWithPermitRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *InvestWithPermitRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type InvestWithPermitResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxHash         string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	InvestedAmount string                 `protobuf:"bytes,3,opt,name=invested_amount,json=investedAmount,proto3" json:"invested_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvestWithPermitResponse) Reset() {
+	*x = InvestWithPermitResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestWithPermitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestWithPermitResponse) ProtoMessage() {}
+
+func (x *InvestWithPermitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestWithPermitResponse.ProtoReflect.Descriptor instead.
+func (*InvestWithPermitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InvestWithPermitResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *InvestWithPermitResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InvestWithPermitResponse) GetInvestedAmount() string {
+	if x != nil {
+		return x.InvestedAmount
+	}
+	return ""
+}
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	SellerAddress string                 `protobuf:"bytes,3,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PriceBps      int64                  `protobuf:"varint,5,opt,name=price_bps,json=priceBps,proto3" json:"price_bps,omitempty"`            // Basis points of principal, 10000 = par
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`         // Optional Unix timestamp
+	TokenAddress  string                 `protobuf:"bytes,7,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"` // ERC-2612 token the seller is paid in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PlaceOrderRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetSellerAddress() string {
+	if x != nil {
+		return x.SellerAddress
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetPriceBps() int64 {
+	if x != nil {
+		return x.PriceBps
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+type OrderInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     int32                  `protobuf:"varint,3,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	SellerAddress string                 `protobuf:"bytes,4,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Remaining     string                 `protobuf:"bytes,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	PriceBps      int64                  `protobuf:"varint,7,opt,name=price_bps,json=priceBps,proto3" json:"price_bps,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Seller        *Counterparty          `protobuf:"bytes,11,opt,name=seller,proto3" json:"seller,omitempty"`
+	TokenAddress  string                 `protobuf:"bytes,12,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderInfo) Reset() {
+	*x = OrderInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderInfo) ProtoMessage() {}
+
+func (x *OrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderInfo.ProtoReflect.Descriptor instead.
+func (*OrderInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *OrderInfo) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetSellerAddress() string {
+	if x != nil {
+		return x.SellerAddress
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetRemaining() string {
+	if x != nil {
+		return x.Remaining
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetPriceBps() int64 {
+	if x != nil {
+		return x.PriceBps
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetSeller() *Counterparty {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *OrderInfo) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     *int32                 `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3,oneof" json:"tranche_id,omitempty"` // Unset lists every tranche
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                               // Empty lists open orders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListOrdersRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetTrancheId() int32 {
+	if x != nil && x.TrancheId != nil {
+		return *x.TrancheId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*OrderInfo           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Market        *TrancheMarket         `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*OrderInfo {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetMarket() *TrancheMarket {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type TrancheMarket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId     int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	BestAskBps    int64                  `protobuf:"varint,2,opt,name=best_ask_bps,json=bestAskBps,proto3" json:"best_ask_bps,omitempty"`
+	LastPriceBps  int64                  `protobuf:"varint,3,opt,name=last_price_bps,json=lastPriceBps,proto3" json:"last_price_bps,omitempty"`
+	VwapBps       int64                  `protobuf:"varint,4,opt,name=vwap_bps,json=vwapBps,proto3" json:"vwap_bps,omitempty"`
+	Volume        string                 `protobuf:"bytes,5,opt,name=volume,proto3" json:"volume,omitempty"`
+	TradeCount    int32                  `protobuf:"varint,6,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	LastTradeAt   int64                  `protobuf:"varint,7,opt,name=last_trade_at,json=lastTradeAt,proto3" json:"last_trade_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrancheMarket) Reset() {
+	*x = TrancheMarket{}
+	mi := &file_proto_bonding_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrancheMarket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheMarket) ProtoMessage() {}
+
+func (x *TrancheMarket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheMarket.ProtoReflect.Descriptor instead.
+func (*TrancheMarket) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TrancheMarket) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TrancheMarket) GetBestAskBps() int64 {
+	if x != nil {
+		return x.BestAskBps
+	}
+	return 0
+}
+
+func (x *TrancheMarket) GetLastPriceBps() int64 {
+	if x != nil {
+		return x.LastPriceBps
+	}
+	return 0
+}
+
+func (x *TrancheMarket) GetVwapBps() int64 {
+	if x != nil {
+		return x.VwapBps
+	}
+	return 0
+}
+
+func (x *TrancheMarket) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *TrancheMarket) GetTradeCount() int32 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *TrancheMarket) GetLastTradeAt() int64 {
+	if x != nil {
+		return x.LastTradeAt
+	}
+	return 0
+}
+
+type PrepareFillOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BuyerAddress  string                 `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress,proto3" json:"buyer_address,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`      // Empty fills the remaining amount
+	Deadline      int64                  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"` // Optional Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareFillOrderRequest) Reset() {
+	*x = PrepareFillOrderRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareFillOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareFillOrderRequest) ProtoMessage() {}
+
+func (x *PrepareFillOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareFillOrderRequest.ProtoReflect.Descriptor instead.
+func (*PrepareFillOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PrepareFillOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PrepareFillOrderRequest) GetBuyerAddress() string {
+	if x != nil {
+		return x.BuyerAddress
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// The buyer signs typed_data to let the bond contract pull value of the
+// order's token, which is paid to the seller in the same transaction that
+// moves the position
+type PrepareFillOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TypedData     string                 `protobuf:"bytes,1,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"` // EIP-712 typed data JSON for eth_signTypedData_v4
+	Spender       string                 `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Deadline      int64                  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareFillOrderResponse) Reset() {
+	*x = PrepareFillOrderResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareFillOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareFillOrderResponse) ProtoMessage() {}
+
+func (x *PrepareFillOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareFillOrderResponse.ProtoReflect.Descriptor instead.
+func (*PrepareFillOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PrepareFillOrderResponse) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderResponse) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderResponse) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *PrepareFillOrderResponse) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type FillOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BuyerAddress  string                 `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress,proto3" json:"buyer_address,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`       // Empty fills the remaining amount
+	Deadline      int64                  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`  // From PrepareFillOrder
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"` // 0x-prefixed 65-byte signature of the PrepareFillOrder typed data
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillOrderRequest) Reset() {
+	*x = FillOrderRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillOrderRequest) ProtoMessage() {}
+
+func (x *FillOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillOrderRequest.ProtoReflect.Descriptor instead.
+func (*FillOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FillOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *FillOrderRequest) GetBuyerAddress() string {
+	if x != nil {
+		return x.BuyerAddress
+	}
+	return ""
+}
+
+func (x *FillOrderRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FillOrderRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *FillOrderRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type FillOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TxHash        string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Order         *OrderInfo             `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillOrderResponse) Reset() {
+	*x = FillOrderResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillOrderResponse) ProtoMessage() {}
+
+func (x *FillOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillOrderResponse.ProtoReflect.Descriptor instead.
+func (*FillOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FillOrderResponse) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *FillOrderResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *FillOrderResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FillOrderResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FillOrderResponse) GetOrder() *OrderInfo {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CancelOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// Address book entries are scoped to the caller's tenant
+type Counterparty struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label              string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	VerificationStatus string                 `protobuf:"bytes,4,opt,name=verification_status,json=verificationStatus,proto3" json:"verification_status,omitempty"`
+	EnsName            string                 `protobuf:"bytes,5,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_proto_bonding_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Counterparty) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Counterparty) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Counterparty) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Counterparty) GetVerificationStatus() string {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return ""
+}
+
+func (x *Counterparty) GetEnsName() string {
+	if x != nil {
+		return x.EnsName
+	}
+	return ""
+}
+
+type AddressBookEntry struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label              string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                                       // ISSUER, INVESTOR or OTHER
+	VerificationStatus string                 `protobuf:"bytes,4,opt,name=verification_status,json=verificationStatus,proto3" json:"verification_status,omitempty"` // UNVERIFIED, VERIFIED or REJECTED
+	UpdatedAt          int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddressBookEntry) Reset() {
+	*x = AddressBookEntry{}
+	mi := &file_proto_bonding_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressBookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookEntry) ProtoMessage() {}
+
+func (x *AddressBookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookEntry.ProtoReflect.Descriptor instead.
+func (*AddressBookEntry) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddressBookEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetVerificationStatus() string {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type UpsertAddressBookEntryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label              string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	VerificationStatus string                 `protobuf:"bytes,4,opt,name=verification_status,json=verificationStatus,proto3" json:"verification_status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpsertAddressBookEntryRequest) Reset() {
+	*x = UpsertAddressBookEntryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertAddressBookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertAddressBookEntryRequest) ProtoMessage() {}
+
+func (x *UpsertAddressBookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertAddressBookEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpsertAddressBookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpsertAddressBookEntryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpsertAddressBookEntryRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpsertAddressBookEntryRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpsertAddressBookEntryRequest) GetVerificationStatus() string {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return ""
+}
+
+type ListAddressBookEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressBookEntriesRequest) Reset() {
+	*x = ListAddressBookEntriesRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressBookEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressBookEntriesRequest) ProtoMessage() {}
+
+func (x *ListAddressBookEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressBookEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressBookEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAddressBookEntriesRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListAddressBookEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AddressBookEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressBookEntriesResponse) Reset() {
+	*x = ListAddressBookEntriesResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressBookEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressBookEntriesResponse) ProtoMessage() {}
+
+func (x *ListAddressBookEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressBookEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressBookEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAddressBookEntriesResponse) GetEntries() []*AddressBookEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DeleteAddressBookEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressBookEntryRequest) Reset() {
+	*x = DeleteAddressBookEntryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressBookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressBookEntryRequest) ProtoMessage() {}
+
+func (x *DeleteAddressBookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressBookEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressBookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteAddressBookEntryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DeleteAddressBookEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressBookEntryResponse) Reset() {
+	*x = DeleteAddressBookEntryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressBookEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressBookEntryResponse) ProtoMessage() {}
+
+func (x *DeleteAddressBookEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressBookEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressBookEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteAddressBookEntryResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type SetTrancheLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	IssuerAddress string                 `protobuf:"bytes,3,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"` // Optional; limits are set by the signed-in issuer wallet
+	MinInvestment string                 `protobuf:"bytes,4,opt,name=min_investment,json=minInvestment,proto3" json:"min_investment,omitempty"` // "0" or empty for no minimum
+	MaxInvestment string                 `protobuf:"bytes,5,opt,name=max_investment,json=maxInvestment,proto3" json:"max_investment,omitempty"` // "0" or empty for no maximum
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrancheLimitsRequest) Reset() {
+	*x = SetTrancheLimitsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrancheLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrancheLimitsRequest) ProtoMessage() {}
+
+func (x *SetTrancheLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrancheLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTrancheLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetTrancheLimitsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *SetTrancheLimitsRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *SetTrancheLimitsRequest) GetIssuerAddress() string {
+	if x != nil {
+		return x.IssuerAddress
+	}
+	return ""
+}
+
+func (x *SetTrancheLimitsRequest) GetMinInvestment() string {
+	if x != nil {
+		return x.MinInvestment
+	}
+	return ""
+}
+
+func (x *SetTrancheLimitsRequest) GetMaxInvestment() string {
+	if x != nil {
+		return x.MaxInvestment
+	}
+	return ""
+}
+
+// Ledger exports are scoped to the caller's tenant
+type ExportLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   int64                  `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Unix timestamp, inclusive
+	PeriodEnd     int64                  `protobuf:"varint,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Unix timestamp, exclusive
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                               // CSV, OFX or IIF (QuickBooks)
+	BondId        string                 `protobuf:"bytes,4,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`                 // Optional
+	Decimals      int32                  `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`                          // Token decimals, defaults to 18
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                           // Currency written to OFX statements, defaults to USD
+	Store         bool                   `protobuf:"varint,7,opt,name=store,proto3" json:"store,omitempty"`                                // Keep the export in document storage and return a download link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLedgerRequest) Reset() {
+	*x = ExportLedgerRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLedgerRequest) ProtoMessage() {}
+
+func (x *ExportLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ExportLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ExportLedgerRequest) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *ExportLedgerRequest) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *ExportLedgerRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportLedgerRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ExportLedgerRequest) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *ExportLedgerRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExportLedgerRequest) GetStore() bool {
+	if x != nil {
+		return x.Store
+	}
+	return false
+}
+
+type ExportLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,4,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	DocumentId    uint64                 `protobuf:"varint,5,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // Set when store is requested; content is then empty
+	DownloadUrl   string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLedgerResponse) Reset() {
+	*x = ExportLedgerResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLedgerResponse) ProtoMessage() {}
+
+func (x *ExportLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ExportLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExportLedgerResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportLedgerResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportLedgerResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportLedgerResponse) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *ExportLedgerResponse) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *ExportLedgerResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type GetDocumentURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    uint64                 `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Defaults to 15 minutes, capped at 7 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentURLRequest) Reset() {
+	*x = GetDocumentURLRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentURLRequest) ProtoMessage() {}
+
+func (x *GetDocumentURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentURLRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetDocumentURLRequest) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *GetDocumentURLRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type GetDocumentURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    uint64                 `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	UrlExpiresAt  int64                  `protobuf:"varint,8,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentURLResponse) Reset() {
+	*x = GetDocumentURLResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentURLResponse) ProtoMessage() {}
+
+func (x *GetDocumentURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentURLResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetDocumentURLResponse) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *GetDocumentURLResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDocumentURLResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetDocumentURLResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetDocumentURLResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetDocumentURLResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *GetDocumentURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetDocumentURLResponse) GetUrlExpiresAt() int64 {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return 0
+}
+
+type CategoryInfo struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Slug                      string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Parent                    string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"` // Empty for a root category
+	Name                      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier                float64                `protobuf:"fixed64,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 0 inherits the parent's multiplier
+	ObsolescenceRisk          bool                   `protobuf:"varint,5,opt,name=obsolescence_risk,json=obsolescenceRisk,proto3" json:"obsolescence_risk,omitempty"`
+	Aliases                   []string               `protobuf:"bytes,6,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	EffectiveMultiplier       float64                `protobuf:"fixed64,7,opt,name=effective_multiplier,json=effectiveMultiplier,proto3" json:"effective_multiplier,omitempty"`                    // Multiplier after inheritance
+	EffectiveObsolescenceRisk bool                   `protobuf:"varint,8,opt,name=effective_obsolescence_risk,json=effectiveObsolescenceRisk,proto3" json:"effective_obsolescence_risk,omitempty"` // Set when this category or an ancestor flags the risk
+	ExpiryPolicy              string                 `protobuf:"bytes,9,opt,name=expiry_policy,json=expiryPolicy,proto3" json:"expiry_policy,omitempty"`                                           // REJECT, WARN or IGNORE; empty inherits the parent's policy
+	EffectiveExpiryPolicy     string                 `protobuf:"bytes,10,opt,name=effective_expiry_policy,json=effectiveExpiryPolicy,proto3" json:"effective_expiry_policy,omitempty"`             // Policy after inheritance
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CategoryInfo) Reset() {
+	*x = CategoryInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfo) ProtoMessage() {}
+
+func (x *CategoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfo.ProtoReflect.Descriptor instead.
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CategoryInfo) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetObsolescenceRisk() bool {
+	if x != nil {
+		return x.ObsolescenceRisk
+	}
+	return false
+}
+
+func (x *CategoryInfo) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CategoryInfo) GetEffectiveMultiplier() float64 {
+	if x != nil {
+		return x.EffectiveMultiplier
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetEffectiveObsolescenceRisk() bool {
+	if x != nil {
+		return x.EffectiveObsolescenceRisk
+	}
+	return false
+}
+
+func (x *CategoryInfo) GetExpiryPolicy() string {
+	if x != nil {
+		return x.ExpiryPolicy
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetEffectiveExpiryPolicy() string {
+	if x != nil {
+		return x.EffectiveExpiryPolicy
+	}
+	return ""
+}
+
+type UpsertCategoryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Slug             string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Parent           string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier       float64                `protobuf:"fixed64,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	ObsolescenceRisk bool                   `protobuf:"varint,5,opt,name=obsolescence_risk,json=obsolescenceRisk,proto3" json:"obsolescence_risk,omitempty"`
+	Aliases          []string               `protobuf:"bytes,6,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	ExpiryPolicy     string                 `protobuf:"bytes,7,opt,name=expiry_policy,json=expiryPolicy,proto3" json:"expiry_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpsertCategoryRequest) Reset() {
+	*x = UpsertCategoryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertCategoryRequest) ProtoMessage() {}
+
+func (x *UpsertCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpsertCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpsertCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpsertCategoryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *UpsertCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertCategoryRequest) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *UpsertCategoryRequest) GetObsolescenceRisk() bool {
+	if x != nil {
+		return x.ObsolescenceRisk
+	}
+	return false
+}
+
+func (x *UpsertCategoryRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *UpsertCategoryRequest) GetExpiryPolicy() string {
+	if x != nil {
+		return x.ExpiryPolicy
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"` // Optional; lists only the children of this category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListCategoriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryInfo        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryInfo {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteCategoryResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ReplaceTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                // Pending transaction sent by the operator wallet
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`                                // Optional; defaults to the default chain
+	FeeBumpBps    int32                  `protobuf:"varint,3,opt,name=fee_bump_bps,json=feeBumpBps,proto3" json:"fee_bump_bps,omitempty"` // Fee increase in basis points, at least 1000 (10%)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceTransactionRequest) Reset() {
+	*x = ReplaceTransactionRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceTransactionRequest) ProtoMessage() {}
+
+func (x *ReplaceTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ReplaceTransactionRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionRequest) GetFeeBumpBps() int32 {
+	if x != nil {
+		return x.FeeBumpBps
+	}
+	return 0
+}
+
+type ReplaceTransactionResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OriginalTxHash       string                 `protobuf:"bytes,1,opt,name=original_tx_hash,json=originalTxHash,proto3" json:"original_tx_hash,omitempty"`
+	ReplacementTxHash    string                 `protobuf:"bytes,2,opt,name=replacement_tx_hash,json=replacementTxHash,proto3" json:"replacement_tx_hash,omitempty"`
+	Nonce                uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasPrice             string                 `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`                                           // Legacy transactions
+	MaxFeePerGas         string                 `protobuf:"bytes,5,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`                           // EIP-1559 transactions
+	MaxPriorityFeePerGas string                 `protobuf:"bytes,6,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"` // EIP-1559 transactions
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReplaceTransactionResponse) Reset() {
+	*x = ReplaceTransactionResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceTransactionResponse) ProtoMessage() {}
+
+func (x *ReplaceTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ReplaceTransactionResponse) GetOriginalTxHash() string {
+	if x != nil {
+		return x.OriginalTxHash
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionResponse) GetReplacementTxHash() string {
+	if x != nil {
+		return x.ReplacementTxHash
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionResponse) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ReplaceTransactionResponse) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionResponse) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *ReplaceTransactionResponse) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+type ListPendingTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Optional: PENDING, STUCK or DROPPED
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`   // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingTransactionsRequest) Reset() {
+	*x = ListPendingTransactionsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingTransactionsRequest) ProtoMessage() {}
+
+func (x *ListPendingTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListPendingTransactionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPendingTransactionsRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type ListPendingTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*PendingTransaction  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingTransactionsResponse) Reset() {
+	*x = ListPendingTransactionsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingTransactionsResponse) ProtoMessage() {}
+
+func (x *ListPendingTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListPendingTransactionsResponse) GetTransactions() []*PendingTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type PendingTransaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxHash         string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Chain          string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Purpose        string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"` // ISSUE_BOND, INVEST, DISTRIBUTE_REVENUE, REDEEM or TRANSFER_POSITION
+	BondId         string                 `protobuf:"bytes,4,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Nonce          uint64                 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`  // 0 until the node has reported the transaction
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // PENDING, STUCK or DROPPED
+	SubmittedAt    int64                  `protobuf:"varint,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	CheckedAt      int64                  `protobuf:"varint,8,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	PendingSeconds int64                  `protobuf:"varint,9,opt,name=pending_seconds,json=pendingSeconds,proto3" json:"pending_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PendingTransaction) Reset() {
+	*x = PendingTransaction{}
+	mi := &file_proto_bonding_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTransaction) ProtoMessage() {}
+
+func (x *PendingTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTransaction.ProtoReflect.Descriptor instead.
+func (*PendingTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PendingTransaction) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *PendingTransaction) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PendingTransaction) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *PendingTransaction) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *PendingTransaction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *PendingTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PendingTransaction) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *PendingTransaction) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *PendingTransaction) GetPendingSeconds() int64 {
+	if x != nil {
+		return x.PendingSeconds
+	}
+	return 0
+}
+
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           bool                   `protobuf:"varint,1,opt,name=run,proto3" json:"run,omitempty"`                    // Reconcile now instead of returning the last scheduled run
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"` // Optional; only this bond's discrepancies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetReconciliationReportRequest) GetRun() bool {
+	if x != nil {
+		return x.Run
+	}
+	return false
+}
+
+func (x *GetReconciliationReportRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+type ReconciliationReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt     int64                  `protobuf:"varint,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    int64                  `protobuf:"varint,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	BondsChecked  int32                  `protobuf:"varint,3,opt,name=bonds_checked,json=bondsChecked,proto3" json:"bonds_checked,omitempty"`
+	BondsFailed   int32                  `protobuf:"varint,4,opt,name=bonds_failed,json=bondsFailed,proto3" json:"bonds_failed,omitempty"` // Bonds whose on-chain state couldn't be read
+	AutoCorrect   bool                   `protobuf:"varint,5,opt,name=auto_correct,json=autoCorrect,proto3" json:"auto_correct,omitempty"`
+	Discrepancies []*Discrepancy         `protobuf:"bytes,6,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	mi := &file_proto_bonding_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReconciliationReport) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetBondsChecked() int32 {
+	if x != nil {
+		return x.BondsChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetBondsFailed() int32 {
+	if x != nil {
+		return x.BondsFailed
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetAutoCorrect() bool {
+	if x != nil {
+		return x.AutoCorrect
+	}
+	return false
+}
+
+func (x *ReconciliationReport) GetDiscrepancies() []*Discrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type Discrepancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	TrancheId     int32                  `protobuf:"varint,3,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"` // -1 for bond fields
+	Field         string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`                           // total_value, total_revenue, status, allocation, total_invested or investor_count
+	Stored        string                 `protobuf:"bytes,5,opt,name=stored,proto3" json:"stored,omitempty"`
+	OnChain       string                 `protobuf:"bytes,6,opt,name=on_chain,json=onChain,proto3" json:"on_chain,omitempty"`
+	Corrected     bool                   `protobuf:"varint,7,opt,name=corrected,proto3" json:"corrected,omitempty"` // Overwritten with the on-chain value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discrepancy) Reset() {
+	*x = Discrepancy{}
+	mi := &file_proto_bonding_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discrepancy) ProtoMessage() {}
+
+func (x *Discrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discrepancy.ProtoReflect.Descriptor instead.
+func (*Discrepancy) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Discrepancy) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *Discrepancy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetStored() string {
+	if x != nil {
+		return x.Stored
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetOnChain() string {
+	if x != nil {
+		return x.OnChain
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetCorrected() bool {
+	if x != nil {
+		return x.Corrected
+	}
+	return false
+}
+
+type GenerateProspectusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Store         bool                   `protobuf:"varint,2,opt,name=store,proto3" json:"store,omitempty"` // Keep the prospectus in document storage and return a download link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateProspectusRequest) Reset() {
+	*x = GenerateProspectusRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateProspectusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateProspectusRequest) ProtoMessage() {}
+
+func (x *GenerateProspectusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateProspectusRequest.ProtoReflect.Descriptor instead.
+func (*GenerateProspectusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GenerateProspectusRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GenerateProspectusRequest) GetStore() bool {
+	if x != nil {
+		return x.Store
+	}
+	return false
+}
+
+type GenerateProspectusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // Markdown
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	DocumentId    uint64                 `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // Set when store is requested; content is then empty
+	DownloadUrl   string                 `protobuf:"bytes,5,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateProspectusResponse) Reset() {
+	*x = GenerateProspectusResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateProspectusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateProspectusResponse) ProtoMessage() {}
+
+func (x *GenerateProspectusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateProspectusResponse.ProtoReflect.Descriptor instead.
+func (*GenerateProspectusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GenerateProspectusResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GenerateProspectusResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GenerateProspectusResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GenerateProspectusResponse) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *GenerateProspectusResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type GetCounterpartyRiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterpartyRiskRequest) Reset() {
+	*x = GetCounterpartyRiskRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterpartyRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterpartyRiskRequest) ProtoMessage() {}
+
+func (x *GetCounterpartyRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterpartyRiskRequest.ProtoReflect.Descriptor instead.
+func (*GetCounterpartyRiskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetCounterpartyRiskRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+type GetCounterpartyRiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Licensees     []*LicenseeCredit      `protobuf:"bytes,2,rep,name=licensees,proto3" json:"licensees,omitempty"`           // Largest share first
+	Concentration float64                `protobuf:"fixed64,3,opt,name=concentration,proto3" json:"concentration,omitempty"` // Herfindahl-Hirschman index of licensee shares, 0-1
+	TopShare      float64                `protobuf:"fixed64,4,opt,name=top_share,json=topShare,proto3" json:"top_share,omitempty"`
+	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"` // Revenue-weighted licensee credit score, 0-100
+	Rated         bool                   `protobuf:"varint,6,opt,name=rated,proto3" json:"rated,omitempty"`
+	RiskFactors   []string               `protobuf:"bytes,7,rep,name=risk_factors,json=riskFactors,proto3" json:"risk_factors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterpartyRiskResponse) Reset() {
+	*x = GetCounterpartyRiskResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterpartyRiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterpartyRiskResponse) ProtoMessage() {}
+
+func (x *GetCounterpartyRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterpartyRiskResponse.ProtoReflect.Descriptor instead.
+func (*GetCounterpartyRiskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetCounterpartyRiskResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetCounterpartyRiskResponse) GetLicensees() []*LicenseeCredit {
+	if x != nil {
+		return x.Licensees
+	}
+	return nil
+}
+
+func (x *GetCounterpartyRiskResponse) GetConcentration() float64 {
+	if x != nil {
+		return x.Concentration
+	}
+	return 0
+}
+
+func (x *GetCounterpartyRiskResponse) GetTopShare() float64 {
+	if x != nil {
+		return x.TopShare
+	}
+	return 0
+}
+
+func (x *GetCounterpartyRiskResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetCounterpartyRiskResponse) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *GetCounterpartyRiskResponse) GetRiskFactors() []string {
+	if x != nil {
+		return x.RiskFactors
+	}
+	return nil
+}
+
+type LicenseeCredit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Licensee        string                 `protobuf:"bytes,1,opt,name=licensee,proto3" json:"licensee,omitempty"`
+	AmountPaid      string                 `protobuf:"bytes,2,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"` // Royalties paid towards this bond
+	Share           float64                `protobuf:"fixed64,3,opt,name=share,proto3" json:"share,omitempty"`
+	Score           int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"` // Credit score from payments across all bonds, 0-100
+	Rated           bool                   `protobuf:"varint,5,opt,name=rated,proto3" json:"rated,omitempty"`
+	Payments        int32                  `protobuf:"varint,6,opt,name=payments,proto3" json:"payments,omitempty"`
+	OnTime          int32                  `protobuf:"varint,7,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`
+	Late            int32                  `protobuf:"varint,8,opt,name=late,proto3" json:"late,omitempty"`
+	AverageDaysLate float64                `protobuf:"fixed64,9,opt,name=average_days_late,json=averageDaysLate,proto3" json:"average_days_late,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LicenseeCredit) Reset() {
+	*x = LicenseeCredit{}
+	mi := &file_proto_bonding_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseeCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseeCredit) ProtoMessage() {}
+
+func (x *LicenseeCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseeCredit.ProtoReflect.Descriptor instead.
+func (*LicenseeCredit) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *LicenseeCredit) GetLicensee() string {
+	if x != nil {
+		return x.Licensee
+	}
+	return ""
+}
+
+func (x *LicenseeCredit) GetAmountPaid() string {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return ""
+}
+
+func (x *LicenseeCredit) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *LicenseeCredit) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LicenseeCredit) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *LicenseeCredit) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *LicenseeCredit) GetOnTime() int32 {
+	if x != nil {
+		return x.OnTime
+	}
+	return 0
+}
+
+func (x *LicenseeCredit) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *LicenseeCredit) GetAverageDaysLate() float64 {
+	if x != nil {
+		return x.AverageDaysLate
+	}
+	return 0
+}
+
+type GetRevenueVarianceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueVarianceRequest) Reset() {
+	*x = GetRevenueVarianceRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueVarianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueVarianceRequest) ProtoMessage() {}
+
+func (x *GetRevenueVarianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueVarianceRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueVarianceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetRevenueVarianceRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+type GetRevenueVarianceResponse struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	BondId              string                   `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Periods             []*RevenueVariancePeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	Threshold           float64                  `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`                                               // Relative variance, either way, that breaches a period
+	ConsecutiveBreaches int32                    `protobuf:"varint,4,opt,name=consecutive_breaches,json=consecutiveBreaches,proto3" json:"consecutive_breaches,omitempty"` // Trailing closed periods that breached
+	ReviewRequired      bool                     `protobuf:"varint,5,opt,name=review_required,json=reviewRequired,proto3" json:"review_required,omitempty"`
+	RatingReviewAt      int64                    `protobuf:"varint,6,opt,name=rating_review_at,json=ratingReviewAt,proto3" json:"rating_review_at,omitempty"`
+	RatingReviewReason  string                   `protobuf:"bytes,7,opt,name=rating_review_reason,json=ratingReviewReason,proto3" json:"rating_review_reason,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetRevenueVarianceResponse) Reset() {
+	*x = GetRevenueVarianceResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueVarianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueVarianceResponse) ProtoMessage() {}
+
+func (x *GetRevenueVarianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueVarianceResponse.ProtoReflect.Descriptor instead.
+func (*GetRevenueVarianceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetRevenueVarianceResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetRevenueVarianceResponse) GetPeriods() []*RevenueVariancePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *GetRevenueVarianceResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetRevenueVarianceResponse) GetConsecutiveBreaches() int32 {
+	if x != nil {
+		return x.ConsecutiveBreaches
+	}
+	return 0
+}
+
+func (x *GetRevenueVarianceResponse) GetReviewRequired() bool {
+	if x != nil {
+		return x.ReviewRequired
+	}
+	return false
+}
+
+func (x *GetRevenueVarianceResponse) GetRatingReviewAt() int64 {
+	if x != nil {
+		return x.RatingReviewAt
+	}
+	return 0
+}
+
+func (x *GetRevenueVarianceResponse) GetRatingReviewReason() string {
+	if x != nil {
+		return x.RatingReviewReason
+	}
+	return ""
+}
+
+type RevenueVariancePeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   int64                  `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Forecast      string                 `protobuf:"bytes,3,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	Actual        string                 `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	Variance      float64                `protobuf:"fixed64,5,opt,name=variance,proto3" json:"variance,omitempty"` // (actual - forecast) / forecast
+	Closed        bool                   `protobuf:"varint,6,opt,name=closed,proto3" json:"closed,omitempty"`
+	Breached      bool                   `protobuf:"varint,7,opt,name=breached,proto3" json:"breached,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueVariancePeriod) Reset() {
+	*x = RevenueVariancePeriod{}
+	mi := &file_proto_bonding_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueVariancePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueVariancePeriod) ProtoMessage() {}
+
+func (x *RevenueVariancePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueVariancePeriod.ProtoReflect.Descriptor instead.
+func (*RevenueVariancePeriod) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RevenueVariancePeriod) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *RevenueVariancePeriod) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *RevenueVariancePeriod) GetForecast() string {
+	if x != nil {
+		return x.Forecast
+	}
+	return ""
+}
+
+func (x *RevenueVariancePeriod) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *RevenueVariancePeriod) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *RevenueVariancePeriod) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *RevenueVariancePeriod) GetBreached() bool {
+	if x != nil {
+		return x.Breached
+	}
+	return false
+}
+
+// Dry run of IssueBond; nothing is saved or sent on-chain
+type ValidateIssueBondResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Valid            bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors           []*IssuanceProblem     `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // Every check that failed, not just the first
+	Warnings         []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Chain            string                 `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	Category         string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"` // Resolved from the request or the registration kind
+	ExpiryPolicy     string                 `protobuf:"bytes,6,opt,name=expiry_policy,json=expiryPolicy,proto3" json:"expiry_policy,omitempty"`
+	LicenseExpiresAt int64                  `protobuf:"varint,7,opt,name=license_expires_at,json=licenseExpiresAt,proto3" json:"license_expires_at,omitempty"`
+	Tranches         []*TrancheInfo         `protobuf:"bytes,8,rep,name=tranches,proto3" json:"tranches,omitempty"` // Allocations the bond would be issued with
+	RiskAssessment   *RiskAssessment        `protobuf:"bytes,9,opt,name=risk_assessment,json=riskAssessment,proto3" json:"risk_assessment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateIssueBondResponse) Reset() {
+	*x = ValidateIssueBondResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateIssueBondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateIssueBondResponse) ProtoMessage() {}
+
+func (x *ValidateIssueBondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateIssueBondResponse.ProtoReflect.Descriptor instead.
+func (*ValidateIssueBondResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ValidateIssueBondResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateIssueBondResponse) GetErrors() []*IssuanceProblem {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateIssueBondResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ValidateIssueBondResponse) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ValidateIssueBondResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ValidateIssueBondResponse) GetExpiryPolicy() string {
+	if x != nil {
+		return x.ExpiryPolicy
+	}
+	return ""
+}
+
+func (x *ValidateIssueBondResponse) GetLicenseExpiresAt() int64 {
+	if x != nil {
+		return x.LicenseExpiresAt
+	}
+	return 0
+}
+
+func (x *ValidateIssueBondResponse) GetTranches() []*TrancheInfo {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (x *ValidateIssueBondResponse) GetRiskAssessment() *RiskAssessment {
+	if x != nil {
+		return x.RiskAssessment
+	}
+	return nil
+}
+
+type IssuanceProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // gRPC status code, e.g. InvalidArgument
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"` // Set for business rule violations
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuanceProblem) Reset() {
+	*x = IssuanceProblem{}
+	mi := &file_proto_bonding_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuanceProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuanceProblem) ProtoMessage() {}
+
+func (x *IssuanceProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuanceProblem.ProtoReflect.Descriptor instead.
+func (*IssuanceProblem) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *IssuanceProblem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *IssuanceProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IssuanceProblem) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type RiskAssessment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ValuationUsd       float64                `protobuf:"fixed64,1,opt,name=valuation_usd,json=valuationUsd,proto3" json:"valuation_usd,omitempty"`
+	ConfidenceScore    float64                `protobuf:"fixed64,2,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	RiskRating         string                 `protobuf:"bytes,3,opt,name=risk_rating,json=riskRating,proto3" json:"risk_rating,omitempty"`
+	DefaultProbability float64                `protobuf:"fixed64,4,opt,name=default_probability,json=defaultProbability,proto3" json:"default_probability,omitempty"`
+	RecommendedLtv     float64                `protobuf:"fixed64,5,opt,name=recommended_ltv,json=recommendedLtv,proto3" json:"recommended_ltv,omitempty"`
+	RiskFactors        []string               `protobuf:"bytes,6,rep,name=risk_factors,json=riskFactors,proto3" json:"risk_factors,omitempty"`
+	Model              string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"` // Risk model that produced the assessment
+	ModelVersion       string                 `protobuf:"bytes,8,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	AssessedAt         int64                  `protobuf:"varint,9,opt,name=assessed_at,json=assessedAt,proto3" json:"assessed_at,omitempty"`
+	RiskTier           string                 `protobuf:"bytes,10,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"` // CONSERVATIVE, BALANCED or AGGRESSIVE under the default mapping
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RiskAssessment) Reset() {
+	*x = RiskAssessment{}
+	mi := &file_proto_bonding_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskAssessment) ProtoMessage() {}
+
+func (x *RiskAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskAssessment.ProtoReflect.Descriptor instead.
+func (*RiskAssessment) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RiskAssessment) GetValuationUsd() float64 {
+	if x != nil {
+		return x.ValuationUsd
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetRiskRating() string {
+	if x != nil {
+		return x.RiskRating
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetDefaultProbability() float64 {
+	if x != nil {
+		return x.DefaultProbability
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetRecommendedLtv() float64 {
+	if x != nil {
+		return x.RecommendedLtv
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetRiskFactors() []string {
+	if x != nil {
+		return x.RiskFactors
+	}
+	return nil
+}
+
+func (x *RiskAssessment) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetAssessedAt() int64 {
+	if x != nil {
+		return x.AssessedAt
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+// Set exactly one of issuance, investment or distribution
+type EstimateIssuanceCostRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Issuance      *IssueBondRequest         `protobuf:"bytes,1,opt,name=issuance,proto3" json:"issuance,omitempty"`
+	Distribution  *DistributeRevenueRequest `protobuf:"bytes,2,opt,name=distribution,proto3" json:"distribution,omitempty"`
+	Investment    *InvestRequest            `protobuf:"bytes,3,opt,name=investment,proto3" json:"investment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateIssuanceCostRequest) Reset() {
+	*x = EstimateIssuanceCostRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateIssuanceCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateIssuanceCostRequest) ProtoMessage() {}
+
+func (x *EstimateIssuanceCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateIssuanceCostRequest.ProtoReflect.Descriptor instead.
+func (*EstimateIssuanceCostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *EstimateIssuanceCostRequest) GetIssuance() *IssueBondRequest {
+	if x != nil {
+		return x.Issuance
+	}
+	return nil
+}
+
+func (x *EstimateIssuanceCostRequest) GetDistribution() *DistributeRevenueRequest {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *EstimateIssuanceCostRequest) GetInvestment() *InvestRequest {
+	if x != nil {
+		return x.Investment
+	}
+	return nil
+}
+
+type EstimateIssuanceCostResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Chain            string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Method           string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"` // Contract function simulated: issueBond, invest or distributeRevenue
+	GasLimit         uint64                 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice         string                 `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`      // Wei per gas; the fee cap on EIP-1559 chains
+	Fee              string                 `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`                                // gas_limit * gas_price in wei
+	FeeUsd           float64                `protobuf:"fixed64,6,opt,name=fee_usd,json=feeUsd,proto3" json:"fee_usd,omitempty"`          // Zero when the chain has no price feed
+	NativeUsd        float64                `protobuf:"fixed64,7,opt,name=native_usd,json=nativeUsd,proto3" json:"native_usd,omitempty"` // Price feed answer fee_usd was converted with
+	PriceUpdatedAt   int64                  `protobuf:"varint,8,opt,name=price_updated_at,json=priceUpdatedAt,proto3" json:"price_updated_at,omitempty"`
+	QuotedAt         int64                  `protobuf:"varint,9,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+	ValidUntil       int64                  `protobuf:"varint,10,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // Re-quote after this
+	Reverted         bool                   `protobuf:"varint,11,opt,name=reverted,proto3" json:"reverted,omitempty"`                       // The call would revert; gas and fee are left unset
+	RevertReason     string                 `protobuf:"bytes,12,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty"`
+	Sender           string                 `protobuf:"bytes,13,opt,name=sender,proto3" json:"sender,omitempty"`                                             // Address the transaction would be sent from
+	BalanceShortfall string                 `protobuf:"bytes,14,opt,name=balance_shortfall,json=balanceShortfall,proto3" json:"balance_shortfall,omitempty"` // Wei the sender lacks to cover the value sent plus the fee
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EstimateIssuanceCostResponse) Reset() {
+	*x = EstimateIssuanceCostResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateIssuanceCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateIssuanceCostResponse) ProtoMessage() {}
+
+func (x *EstimateIssuanceCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateIssuanceCostResponse.ProtoReflect.Descriptor instead.
+func (*EstimateIssuanceCostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *EstimateIssuanceCostResponse) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetFeeUsd() float64 {
+	if x != nil {
+		return x.FeeUsd
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetNativeUsd() float64 {
+	if x != nil {
+		return x.NativeUsd
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetPriceUpdatedAt() int64 {
+	if x != nil {
+		return x.PriceUpdatedAt
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetQuotedAt() int64 {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *EstimateIssuanceCostResponse) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+func (x *EstimateIssuanceCostResponse) GetRevertReason() string {
+	if x != nil {
+		return x.RevertReason
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EstimateIssuanceCostResponse) GetBalanceShortfall() string {
+	if x != nil {
+		return x.BalanceShortfall
+	}
+	return ""
+}
+
+type GetInvestmentQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId     uint32                 `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestmentQuoteRequest) Reset() {
+	*x = GetInvestmentQuoteRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestmentQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentQuoteRequest) ProtoMessage() {}
+
+func (x *GetInvestmentQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestmentQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetInvestmentQuoteRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteRequest) GetTrancheId() uint32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Quote for an investment; tranche capacity is not reserved
+type GetInvestmentQuoteResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BondId            string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId         uint32                 `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Amount            string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RemainingCapacity string                 `protobuf:"bytes,4,opt,name=remaining_capacity,json=remainingCapacity,proto3" json:"remaining_capacity,omitempty"` // Before this investment
+	Apy               float64                `protobuf:"fixed64,5,opt,name=apy,proto3" json:"apy,omitempty"`
+	EffectiveApy      float64                `protobuf:"fixed64,6,opt,name=effective_apy,json=effectiveApy,proto3" json:"effective_apy,omitempty"`     // With coupons reinvested at the schedule's frequency
+	CouponSchedule    []*CouponPayment       `protobuf:"bytes,7,rep,name=coupon_schedule,json=couponSchedule,proto3" json:"coupon_schedule,omitempty"` // Expected coupons; the last is paid at maturity
+	Fees              string                 `protobuf:"bytes,8,opt,name=fees,proto3" json:"fees,omitempty"`                                           // Charged on top of amount; the platform currently charges none
+	ProjectedCoupons  string                 `protobuf:"bytes,9,opt,name=projected_coupons,json=projectedCoupons,proto3" json:"projected_coupons,omitempty"`
+	ProjectedReturn   string                 `protobuf:"bytes,10,opt,name=projected_return,json=projectedReturn,proto3" json:"projected_return,omitempty"` // amount plus projected_coupons, if every coupon is paid
+	Arrears           string                 `protobuf:"bytes,11,opt,name=arrears,proto3" json:"arrears,omitempty"`                                        // Coupons the tranche is already owed, paid before new ones
+	MaturityDate      int64                  `protobuf:"varint,12,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	QuotedAt          int64                  `protobuf:"varint,13,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+	ValidUntil        int64                  `protobuf:"varint,14,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	AccruedInterest   string                 `protobuf:"bytes,15,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"` // By the tranche's existing investments at its APY, to date
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetInvestmentQuoteResponse) Reset() {
+	*x = GetInvestmentQuoteResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestmentQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentQuoteResponse) ProtoMessage() {}
+
+func (x *GetInvestmentQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestmentQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetInvestmentQuoteResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetTrancheId() uint32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetRemainingCapacity() string {
+	if x != nil {
+		return x.RemainingCapacity
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetEffectiveApy() float64 {
+	if x != nil {
+		return x.EffectiveApy
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetCouponSchedule() []*CouponPayment {
+	if x != nil {
+		return x.CouponSchedule
+	}
+	return nil
+}
+
+func (x *GetInvestmentQuoteResponse) GetFees() string {
+	if x != nil {
+		return x.Fees
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetProjectedCoupons() string {
+	if x != nil {
+		return x.ProjectedCoupons
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetProjectedReturn() string {
+	if x != nil {
+		return x.ProjectedReturn
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetArrears() string {
+	if x != nil {
+		return x.Arrears
+	}
+	return ""
+}
+
+func (x *GetInvestmentQuoteResponse) GetMaturityDate() int64 {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetQuotedAt() int64 {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *GetInvestmentQuoteResponse) GetAccruedInterest() string {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return ""
+}
+
+type CouponPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          int64                  `protobuf:"varint,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponPayment) Reset() {
+	*x = CouponPayment{}
+	mi := &file_proto_bonding_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponPayment) ProtoMessage() {}
+
+func (x *CouponPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponPayment.ProtoReflect.Descriptor instead.
+func (*CouponPayment) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CouponPayment) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *CouponPayment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM in UTC; the current month if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetUsageRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+// API usage of the caller's tenant, by caller
+type GetUsageResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TenantId                string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Month                   string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	Keys                    []*KeyUsage            `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	OracleSpend             []*OracleSpend         `protobuf:"bytes,4,rep,name=oracle_spend,json=oracleSpend,proto3" json:"oracle_spend,omitempty"`                                           // By day and provider
+	OracleSpendUsd          string                 `protobuf:"bytes,5,opt,name=oracle_spend_usd,json=oracleSpendUsd,proto3" json:"oracle_spend_usd,omitempty"`                                // Month total
+	OracleDailySoftLimitUsd string                 `protobuf:"bytes,6,opt,name=oracle_daily_soft_limit_usd,json=oracleDailySoftLimitUsd,proto3" json:"oracle_daily_soft_limit_usd,omitempty"` // 0 for none
+	OracleDailyHardLimitUsd string                 `protobuf:"bytes,7,opt,name=oracle_daily_hard_limit_usd,json=oracleDailyHardLimitUsd,proto3" json:"oracle_daily_hard_limit_usd,omitempty"` // 0 for none
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetUsageResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetKeys() []*KeyUsage {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetOracleSpend() []*OracleSpend {
+	if x != nil {
+		return x.OracleSpend
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetOracleSpendUsd() string {
+	if x != nil {
+		return x.OracleSpendUsd
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetOracleDailySoftLimitUsd() string {
+	if x != nil {
+		return x.OracleDailySoftLimitUsd
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetOracleDailyHardLimitUsd() string {
+	if x != nil {
+		return x.OracleDailyHardLimitUsd
+	}
+	return ""
+}
+
+type KeyUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeyId          string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // API key fingerprint, wallet address or OIDC subject; empty for anonymous calls
+	Calls          int64                  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	Errors         int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	ErrorRate      float64                `protobuf:"fixed64,4,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	RequestBytes   int64                  `protobuf:"varint,5,opt,name=request_bytes,json=requestBytes,proto3" json:"request_bytes,omitempty"`
+	ResponseBytes  int64                  `protobuf:"varint,6,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+	MonthlyQuota   int64                  `protobuf:"varint,7,opt,name=monthly_quota,json=monthlyQuota,proto3" json:"monthly_quota,omitempty"`       // 0 for unlimited
+	RemainingCalls int64                  `protobuf:"varint,8,opt,name=remaining_calls,json=remainingCalls,proto3" json:"remaining_calls,omitempty"` // 0 when unlimited or exhausted
+	Methods        []*MethodUsage         `protobuf:"bytes,9,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KeyUsage) Reset() {
+	*x = KeyUsage{}
+	mi := &file_proto_bonding_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyUsage) ProtoMessage() {}
+
+func (x *KeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyUsage.ProtoReflect.Descriptor instead.
+func (*KeyUsage) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *KeyUsage) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetRequestBytes() int64 {
+	if x != nil {
+		return x.RequestBytes
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetResponseBytes() int64 {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetMonthlyQuota() int64 {
+	if x != nil {
+		return x.MonthlyQuota
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetRemainingCalls() int64 {
+	if x != nil {
+		return x.RemainingCalls
+	}
+	return 0
+}
+
+func (x *KeyUsage) GetMethods() []*MethodUsage {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type MethodUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Calls         int64                  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	RequestBytes  int64                  `protobuf:"varint,4,opt,name=request_bytes,json=requestBytes,proto3" json:"request_bytes,omitempty"`
+	ResponseBytes int64                  `protobuf:"varint,5,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodUsage) Reset() {
+	*x = MethodUsage{}
+	mi := &file_proto_bonding_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodUsage) ProtoMessage() {}
+
+func (x *MethodUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodUsage.ProtoReflect.Descriptor instead.
+func (*MethodUsage) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *MethodUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetRequestBytes() int64 {
+	if x != nil {
+		return x.RequestBytes
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetResponseBytes() int64 {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return 0
+}
+
+type OracleSpend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD in UTC
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Calls         int64                  `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	CostUsd       string                 `protobuf:"bytes,4,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OracleSpend) Reset() {
+	*x = OracleSpend{}
+	mi := &file_proto_bonding_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleSpend) ProtoMessage() {}
+
+func (x *OracleSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleSpend.ProtoReflect.Descriptor instead.
+func (*OracleSpend) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *OracleSpend) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *OracleSpend) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OracleSpend) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *OracleSpend) GetCostUsd() string {
+	if x != nil {
+		return x.CostUsd
+	}
+	return ""
+}
+
+type ScheduleMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      int64                  `protobuf:"varint,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Shown to callers whose writes are rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMaintenanceRequest) Reset() {
+	*x = ScheduleMaintenanceRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ScheduleMaintenanceRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Period during which write RPCs fail with UNAVAILABLE and a RetryInfo detail
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	AnnouncedAt   int64                  `protobuf:"varint,5,opt,name=announced_at,json=announcedAt,proto3" json:"announced_at,omitempty"` // 0 until subscribers are notified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_proto_bonding_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MaintenanceWindow) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetAnnouncedAt() int64 {
+	if x != nil {
+		return x.AnnouncedAt
+	}
+	return 0
+}
+
+type CancelMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMaintenanceRequest) Reset() {
+	*x = CancelMaintenanceRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CancelMaintenanceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMaintenanceResponse) Reset() {
+	*x = CancelMaintenanceResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceResponse) ProtoMessage() {}
+
+func (x *CancelMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{124}
+}
+
+type GetMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceRequest) Reset() {
+	*x = GetMaintenanceRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{125}
+}
+
+type GetMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=active,proto3" json:"active,omitempty"` // Unset outside maintenance
+	Upcoming      []*MaintenanceWindow   `protobuf:"bytes,2,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceResponse) Reset() {
+	*x = GetMaintenanceResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetMaintenanceResponse) GetActive() *MaintenanceWindow {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *GetMaintenanceResponse) GetUpcoming() []*MaintenanceWindow {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+type AssessIPRiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	Metadata      *IPMetadata            `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"` // Resolved from the IP-NFT's token URI when unset and IPFS gateways are configured
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`       // Empty selects the model routed for the category, else the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessIPRiskRequest) Reset() {
+	*x = AssessIPRiskRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessIPRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessIPRiskRequest) ProtoMessage() {}
+
+func (x *AssessIPRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessIPRiskRequest.ProtoReflect.Descriptor instead.
+func (*AssessIPRiskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *AssessIPRiskRequest) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *AssessIPRiskRequest) GetMetadata() *IPMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AssessIPRiskRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type IPMetadata struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Category       string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	CreatorAddress string                 `protobuf:"bytes,2,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Views          int32                  `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	Likes          int32                  `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	Tags           []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ContentHash    string                 `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IPMetadata) Reset() {
+	*x = IPMetadata{}
+	mi := &file_proto_bonding_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPMetadata) ProtoMessage() {}
+
+func (x *IPMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPMetadata.ProtoReflect.Descriptor instead.
+func (*IPMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *IPMetadata) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IPMetadata) GetCreatorAddress() string {
+	if x != nil {
+		return x.CreatorAddress
+	}
+	return ""
+}
+
+func (x *IPMetadata) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *IPMetadata) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *IPMetadata) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *IPMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *IPMetadata) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+type AssessIPRiskResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Assessment      *RiskAssessment        `protobuf:"bytes,1,opt,name=assessment,proto3" json:"assessment,omitempty"`
+	ComparableSales []*ComparableSale      `protobuf:"bytes,2,rep,name=comparable_sales,json=comparableSales,proto3" json:"comparable_sales,omitempty"`
+	MarketAnalysis  *MarketAnalysis        `protobuf:"bytes,3,opt,name=market_analysis,json=marketAnalysis,proto3" json:"market_analysis,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssessIPRiskResponse) Reset() {
+	*x = AssessIPRiskResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessIPRiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessIPRiskResponse) ProtoMessage() {}
+
+func (x *AssessIPRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessIPRiskResponse.ProtoReflect.Descriptor instead.
+func (*AssessIPRiskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *AssessIPRiskResponse) GetAssessment() *RiskAssessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+func (x *AssessIPRiskResponse) GetComparableSales() []*ComparableSale {
+	if x != nil {
+		return x.ComparableSales
+	}
+	return nil
+}
+
+func (x *AssessIPRiskResponse) GetMarketAnalysis() *MarketAnalysis {
+	if x != nil {
+		return x.MarketAnalysis
+	}
+	return nil
+}
+
+type AssessIPRiskBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AssessIPRiskRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Concurrency   int32                  `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"` // Optional; lowers the server's ORACLE_BATCH_CONCURRENCY for this call
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessIPRiskBatchRequest) Reset() {
+	*x = AssessIPRiskBatchRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessIPRiskBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessIPRiskBatchRequest) ProtoMessage() {}
+
+func (x *AssessIPRiskBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessIPRiskBatchRequest.ProtoReflect.Descriptor instead.
+func (*AssessIPRiskBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *AssessIPRiskBatchRequest) GetItems() []*AssessIPRiskRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AssessIPRiskBatchRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type AssessIPRiskBatchResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*AssessIPRiskBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In request order
+	Succeeded     int32                      `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                      `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessIPRiskBatchResponse) Reset() {
+	*x = AssessIPRiskBatchResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessIPRiskBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessIPRiskBatchResponse) ProtoMessage() {}
+
+func (x *AssessIPRiskBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessIPRiskBatchResponse.ProtoReflect.Descriptor instead.
+func (*AssessIPRiskBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AssessIPRiskBatchResponse) GetResults() []*AssessIPRiskBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AssessIPRiskBatchResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AssessIPRiskBatchResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type AssessIPRiskBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	Result        *AssessIPRiskResponse  `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // Unset when the item failed
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`     // gRPC status code of the failure, e.g. Unavailable; empty on success
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessIPRiskBatchResult) Reset() {
+	*x = AssessIPRiskBatchResult{}
+	mi := &file_proto_bonding_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessIPRiskBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessIPRiskBatchResult) ProtoMessage() {}
+
+func (x *AssessIPRiskBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessIPRiskBatchResult.ProtoReflect.Descriptor instead.
+func (*AssessIPRiskBatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *AssessIPRiskBatchResult) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *AssessIPRiskBatchResult) GetResult() *AssessIPRiskResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AssessIPRiskBatchResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AssessIPRiskBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ComparableSale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // "manual" or "oracle"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparableSale) Reset() {
+	*x = ComparableSale{}
+	mi := &file_proto_bonding_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparableSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparableSale) ProtoMessage() {}
+
+func (x *ComparableSale) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparableSale.ProtoReflect.Descriptor instead.
+func (*ComparableSale) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ComparableSale) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ComparableSale) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ComparableSale) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ComparableSale) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type MarketAnalysis struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AvgPrice       float64                `protobuf:"fixed64,1,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	MedianPrice    float64                `protobuf:"fixed64,2,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	PriceTrend     float64                `protobuf:"fixed64,3,opt,name=price_trend,json=priceTrend,proto3" json:"price_trend,omitempty"`
+	TotalSales     int32                  `protobuf:"varint,4,opt,name=total_sales,json=totalSales,proto3" json:"total_sales,omitempty"`
+	LiquidityScore float64                `protobuf:"fixed64,5,opt,name=liquidity_score,json=liquidityScore,proto3" json:"liquidity_score,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarketAnalysis) Reset() {
+	*x = MarketAnalysis{}
+	mi := &file_proto_bonding_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAnalysis) ProtoMessage() {}
+
+func (x *MarketAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketAnalysis.ProtoReflect.Descriptor instead.
+func (*MarketAnalysis) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *MarketAnalysis) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *MarketAnalysis) GetMedianPrice() float64 {
+	if x != nil {
+		return x.MedianPrice
+	}
+	return 0
+}
+
+func (x *MarketAnalysis) GetPriceTrend() float64 {
+	if x != nil {
+		return x.PriceTrend
+	}
+	return 0
+}
+
+func (x *MarketAnalysis) GetTotalSales() int32 {
+	if x != nil {
+		return x.TotalSales
+	}
+	return 0
+}
+
+func (x *MarketAnalysis) GetLiquidityScore() float64 {
+	if x != nil {
+		return x.LiquidityScore
+	}
+	return 0
+}
+
+type ListRiskModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskModelsRequest) Reset() {
+	*x = ListRiskModelsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskModelsRequest) ProtoMessage() {}
+
+func (x *ListRiskModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskModelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{135}
+}
+
+type ListRiskModelsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Models         []*RiskModelInfo       `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	DefaultModel   string                 `protobuf:"bytes,2,opt,name=default_model,json=defaultModel,proto3" json:"default_model,omitempty"`
+	CategoryModels map[string]string      `protobuf:"bytes,3,rep,name=category_models,json=categoryModels,proto3" json:"category_models,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Category to the model it is routed to
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRiskModelsResponse) Reset() {
+	*x = ListRiskModelsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskModelsResponse) ProtoMessage() {}
+
+func (x *ListRiskModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskModelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListRiskModelsResponse) GetModels() []*RiskModelInfo {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *ListRiskModelsResponse) GetDefaultModel() string {
+	if x != nil {
+		return x.DefaultModel
+	}
+	return ""
+}
+
+func (x *ListRiskModelsResponse) GetCategoryModels() map[string]string {
+	if x != nil {
+		return x.CategoryModels
+	}
+	return nil
+}
+
+type RiskModelInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version             string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	SupportedCategories []string               `protobuf:"bytes,3,rep,name=supported_categories,json=supportedCategories,proto3" json:"supported_categories,omitempty"` // Empty when every category is supported
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RiskModelInfo) Reset() {
+	*x = RiskModelInfo{}
+	mi := &file_proto_bonding_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskModelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskModelInfo) ProtoMessage() {}
+
+func (x *RiskModelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskModelInfo.ProtoReflect.Descriptor instead.
+func (*RiskModelInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RiskModelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RiskModelInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RiskModelInfo) GetSupportedCategories() []string {
+	if x != nil {
+		return x.SupportedCategories
+	}
+	return nil
+}
+
+type GetBondTimelineRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived bonds are NOT_FOUND otherwise
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBondTimelineRequest) Reset() {
+	*x = GetBondTimelineRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondTimelineRequest) ProtoMessage() {}
+
+func (x *GetBondTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetBondTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetBondTimelineRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetBondTimelineRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetBondTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBondTimelineResponse) Reset() {
+	*x = GetBondTimelineResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondTimelineResponse) ProtoMessage() {}
+
+func (x *GetBondTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetBondTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetBondTimelineResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetBondTimelineResponse) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TimelineEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISSUED, INVESTMENT, DISTRIBUTION, REDEMPTION, TRANSFER, CLAIM, RATED,
+	// RATING_CHANGED, COVENANT or STATUS_CHANGED
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	OccurredAt    int64  `protobuf:"varint,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	TxHash        string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"` // Empty for off-chain events
+	BlockNumber   uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TrancheId     int32  `protobuf:"varint,5,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"` // -1 when the event isn't about one tranche
+	Account       string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Detail        string `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_proto_bonding_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *TimelineEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type GetClaimableAmountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,2,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"` // Empty for every investor
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetClaimableAmountsRequest) Reset() {
+	*x = GetClaimableAmountsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimableAmountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimableAmountsRequest) ProtoMessage() {}
+
+func (x *GetClaimableAmountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimableAmountsRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimableAmountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetClaimableAmountsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetClaimableAmountsRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+type GetClaimableAmountsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BondId         string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Amounts        []*ClaimableAmount     `protobuf:"bytes,2,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	TotalClaimable string                 `protobuf:"bytes,3,opt,name=total_claimable,json=totalClaimable,proto3" json:"total_claimable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetClaimableAmountsResponse) Reset() {
+	*x = GetClaimableAmountsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimableAmountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimableAmountsResponse) ProtoMessage() {}
+
+func (x *GetClaimableAmountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimableAmountsResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimableAmountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetClaimableAmountsResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetClaimableAmountsResponse) GetAmounts() []*ClaimableAmount {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *GetClaimableAmountsResponse) GetTotalClaimable() string {
+	if x != nil {
+		return x.TotalClaimable
+	}
+	return ""
+}
+
+type ClaimableAmount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InvestorAddress string                 `protobuf:"bytes,1,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Entitled        string                 `protobuf:"bytes,3,opt,name=entitled,proto3" json:"entitled,omitempty"` // Credited by every distribution so far
+	Claimed         string                 `protobuf:"bytes,4,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Claimable       string                 `protobuf:"bytes,5,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	AccruedInterest string                 `protobuf:"bytes,6,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"` // By the investor's tranche position at its APY, to date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClaimableAmount) Reset() {
+	*x = ClaimableAmount{}
+	mi := &file_proto_bonding_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimableAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableAmount) ProtoMessage() {}
+
+func (x *ClaimableAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableAmount.ProtoReflect.Descriptor instead.
+func (*ClaimableAmount) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ClaimableAmount) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *ClaimableAmount) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *ClaimableAmount) GetEntitled() string {
+	if x != nil {
+		return x.Entitled
+	}
+	return ""
+}
+
+func (x *ClaimableAmount) GetClaimed() string {
+	if x != nil {
+		return x.Claimed
+	}
+	return ""
+}
+
+func (x *ClaimableAmount) GetClaimable() string {
+	if x != nil {
+		return x.Claimable
+	}
+	return ""
+}
+
+func (x *ClaimableAmount) GetAccruedInterest() string {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return ""
+}
+
+type PrepareClaimRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PrepareClaimRequest) Reset() {
+	*x = PrepareClaimRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareClaimRequest) ProtoMessage() {}
+
+func (x *PrepareClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareClaimRequest.ProtoReflect.Descriptor instead.
+func (*PrepareClaimRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *PrepareClaimRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *PrepareClaimRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *PrepareClaimRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+// An unsigned claim transaction for the investor's wallet to send
+type PrepareClaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	To            string                 `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`         // Bond contract
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`     // Hex-encoded calldata
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // What the claim will withdraw
+	ChainId       int64                  `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareClaimResponse) Reset() {
+	*x = PrepareClaimResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareClaimResponse) ProtoMessage() {}
+
+func (x *PrepareClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareClaimResponse.ProtoReflect.Descriptor instead.
+func (*PrepareClaimResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *PrepareClaimResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PrepareClaimResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *PrepareClaimResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PrepareClaimResponse) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type GetRiskAssessmentHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskAssessmentHistoryRequest) Reset() {
+	*x = GetRiskAssessmentHistoryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskAssessmentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskAssessmentHistoryRequest) ProtoMessage() {}
+
+func (x *GetRiskAssessmentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskAssessmentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskAssessmentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetRiskAssessmentHistoryRequest) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *GetRiskAssessmentHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRiskAssessmentHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetRiskAssessmentHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	Assessments   []*RiskAssessment      `protobuf:"bytes,2,rep,name=assessments,proto3" json:"assessments,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskAssessmentHistoryResponse) Reset() {
+	*x = GetRiskAssessmentHistoryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskAssessmentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskAssessmentHistoryResponse) ProtoMessage() {}
+
+func (x *GetRiskAssessmentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskAssessmentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskAssessmentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetRiskAssessmentHistoryResponse) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *GetRiskAssessmentHistoryResponse) GetAssessments() []*RiskAssessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+// Set exactly one of ipnft_id or bond_id
+type ListRiskAssessmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`          // Lists the assessments of the bond's IP-NFT
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`                         // Optional Unix timestamp, inclusive
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                         // Optional Unix timestamp, exclusive
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Defaults to 50, capped at 200
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, empty for the first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsRequest) Reset() {
+	*x = ListRiskAssessmentsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsRequest) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ListRiskAssessmentsRequest) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *ListRiskAssessmentsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListRiskAssessmentsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListRiskAssessmentsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListRiskAssessmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRiskAssessmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRiskAssessmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpnftId       string                 `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
+	Assessments   []*RiskAssessment      `protobuf:"bytes,2,rep,name=assessments,proto3" json:"assessments,omitempty"`                            // Newest first
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsResponse) Reset() {
+	*x = ListRiskAssessmentsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsResponse) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListRiskAssessmentsResponse) GetIpnftId() string {
+	if x != nil {
+		return x.IpnftId
+	}
+	return ""
+}
+
+func (x *ListRiskAssessmentsResponse) GetAssessments() []*RiskAssessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+func (x *ListRiskAssessmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Sales of IP-NFTs to value similar IP against. Sales already recorded are skipped.
+type RecordComparableSalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sales         []*ComparableSale      `protobuf:"bytes,1,rep,name=sales,proto3" json:"sales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordComparableSalesRequest) Reset() {
+	*x = RecordComparableSalesRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordComparableSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordComparableSalesRequest) ProtoMessage() {}
+
+func (x *RecordComparableSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordComparableSalesRequest.ProtoReflect.Descriptor instead.
+func (*RecordComparableSalesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RecordComparableSalesRequest) GetSales() []*ComparableSale {
+	if x != nil {
+		return x.Sales
+	}
+	return nil
+}
+
+type RecordComparableSalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      int32                  `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordComparableSalesResponse) Reset() {
+	*x = RecordComparableSalesResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordComparableSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordComparableSalesResponse) ProtoMessage() {}
+
+func (x *RecordComparableSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordComparableSalesResponse.ProtoReflect.Descriptor instead.
+func (*RecordComparableSalesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RecordComparableSalesResponse) GetRecorded() int32 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+func (x *RecordComparableSalesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+// A hypothetical change to the revenue and IP valuations of a category's bonds
+type StressShock struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                                        // Taxonomy slug or alias; empty shocks every bond
+	RevenueChange   float64                `protobuf:"fixed64,3,opt,name=revenue_change,json=revenueChange,proto3" json:"revenue_change,omitempty"`       // Relative, e.g. -0.5 halves revenue; at least -1
+	ValuationChange float64                `protobuf:"fixed64,4,opt,name=valuation_change,json=valuationChange,proto3" json:"valuation_change,omitempty"` // Relative; at least -1
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StressShock) Reset() {
+	*x = StressShock{}
+	mi := &file_proto_bonding_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressShock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressShock) ProtoMessage() {}
+
+func (x *StressShock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressShock.ProtoReflect.Descriptor instead.
+func (*StressShock) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *StressShock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StressShock) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StressShock) GetRevenueChange() float64 {
+	if x != nil {
+		return x.RevenueChange
+	}
+	return 0
+}
+
+func (x *StressShock) GetValuationChange() float64 {
+	if x != nil {
+		return x.ValuationChange
+	}
+	return 0
+}
+
+// Recomputes the tenant's active bonds under the combined shocks
+type StressTestRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Shocks         []*StressShock         `protobuf:"bytes,1,rep,name=shocks,proto3" json:"shocks,omitempty"`
+	LookbackMonths int32                  `protobuf:"varint,2,opt,name=lookback_months,json=lookbackMonths,proto3" json:"lookback_months,omitempty"` // Revenue history averaged into the baseline, defaults to 6
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StressTestRequest) Reset() {
+	*x = StressTestRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressTestRequest) ProtoMessage() {}
+
+func (x *StressTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressTestRequest.ProtoReflect.Descriptor instead.
+func (*StressTestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *StressTestRequest) GetShocks() []*StressShock {
+	if x != nil {
+		return x.Shocks
+	}
+	return nil
+}
+
+func (x *StressTestRequest) GetLookbackMonths() int32 {
+	if x != nil {
+		return x.LookbackMonths
+	}
+	return 0
+}
+
+// A tranche's coupon period under the scenario
+type StressTrancheResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId        int32                  `protobuf:"varint,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority         int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	CouponDue        string                 `protobuf:"bytes,4,opt,name=coupon_due,json=couponDue,proto3" json:"coupon_due,omitempty"` // The period's coupon plus arrears carried in
+	Paid             string                 `protobuf:"bytes,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	Shortfall        string                 `protobuf:"bytes,6,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	Coverage         float64                `protobuf:"fixed64,7,opt,name=coverage,proto3" json:"coverage,omitempty"` // Revenue over what is due to this and more senior tranches
+	BaselineCoverage float64                `protobuf:"fixed64,8,opt,name=baseline_coverage,json=baselineCoverage,proto3" json:"baseline_coverage,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StressTrancheResult) Reset() {
+	*x = StressTrancheResult{}
+	mi := &file_proto_bonding_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressTrancheResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressTrancheResult) ProtoMessage() {}
+
+func (x *StressTrancheResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressTrancheResult.ProtoReflect.Descriptor instead.
+func (*StressTrancheResult) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *StressTrancheResult) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *StressTrancheResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StressTrancheResult) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *StressTrancheResult) GetCouponDue() string {
+	if x != nil {
+		return x.CouponDue
+	}
+	return ""
+}
+
+func (x *StressTrancheResult) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *StressTrancheResult) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+func (x *StressTrancheResult) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+func (x *StressTrancheResult) GetBaselineCoverage() float64 {
+	if x != nil {
+		return x.BaselineCoverage
+	}
+	return 0
+}
+
+type StressBondResult struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	BondId                     string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Category                   string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Shocks                     []string               `protobuf:"bytes,3,rep,name=shocks,proto3" json:"shocks,omitempty"` // Names of the shocks applied to the bond
+	Principal                  string                 `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	BaselineRevenue            string                 `protobuf:"bytes,5,opt,name=baseline_revenue,json=baselineRevenue,proto3" json:"baseline_revenue,omitempty"` // Average revenue per 30-day period
+	StressedRevenue            string                 `protobuf:"bytes,6,opt,name=stressed_revenue,json=stressedRevenue,proto3" json:"stressed_revenue,omitempty"`
+	BaselineHealth             string                 `protobuf:"bytes,7,opt,name=baseline_health,json=baselineHealth,proto3" json:"baseline_health,omitempty"` // HEALTHY, STRESSED or DISTRESSED
+	StressedHealth             string                 `protobuf:"bytes,8,opt,name=stressed_health,json=stressedHealth,proto3" json:"stressed_health,omitempty"`
+	Assessed                   bool                   `protobuf:"varint,9,opt,name=assessed,proto3" json:"assessed,omitempty"` // False when the IP was never assessed and default probabilities are 0
+	BaselineDefaultProbability float64                `protobuf:"fixed64,10,opt,name=baseline_default_probability,json=baselineDefaultProbability,proto3" json:"baseline_default_probability,omitempty"`
+	StressedDefaultProbability float64                `protobuf:"fixed64,11,opt,name=stressed_default_probability,json=stressedDefaultProbability,proto3" json:"stressed_default_probability,omitempty"`
+	Tranches                   []*StressTrancheResult `protobuf:"bytes,12,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *StressBondResult) Reset() {
+	*x = StressBondResult{}
+	mi := &file_proto_bonding_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressBondResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressBondResult) ProtoMessage() {}
+
+func (x *StressBondResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressBondResult.ProtoReflect.Descriptor instead.
+func (*StressBondResult) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *StressBondResult) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetShocks() []string {
+	if x != nil {
+		return x.Shocks
+	}
+	return nil
+}
+
+func (x *StressBondResult) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetBaselineRevenue() string {
+	if x != nil {
+		return x.BaselineRevenue
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetStressedRevenue() string {
+	if x != nil {
+		return x.StressedRevenue
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetBaselineHealth() string {
+	if x != nil {
+		return x.BaselineHealth
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetStressedHealth() string {
+	if x != nil {
+		return x.StressedHealth
+	}
+	return ""
+}
+
+func (x *StressBondResult) GetAssessed() bool {
+	if x != nil {
+		return x.Assessed
+	}
+	return false
+}
+
+func (x *StressBondResult) GetBaselineDefaultProbability() float64 {
+	if x != nil {
+		return x.BaselineDefaultProbability
+	}
+	return 0
+}
+
+func (x *StressBondResult) GetStressedDefaultProbability() float64 {
+	if x != nil {
+		return x.StressedDefaultProbability
+	}
+	return 0
+}
+
+func (x *StressBondResult) GetTranches() []*StressTrancheResult {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+type StressTestReport struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Bonds                    []*StressBondResult    `protobuf:"bytes,1,rep,name=bonds,proto3" json:"bonds,omitempty"`
+	Healthy                  int32                  `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Stressed                 int32                  `protobuf:"varint,3,opt,name=stressed,proto3" json:"stressed,omitempty"`
+	Distressed               int32                  `protobuf:"varint,4,opt,name=distressed,proto3" json:"distressed,omitempty"`
+	NewlyDistressed          int32                  `protobuf:"varint,5,opt,name=newly_distressed,json=newlyDistressed,proto3" json:"newly_distressed,omitempty"` // Distressed under the scenario but not the baseline
+	BaselineExpectedDefaults float64                `protobuf:"fixed64,6,opt,name=baseline_expected_defaults,json=baselineExpectedDefaults,proto3" json:"baseline_expected_defaults,omitempty"`
+	ExpectedDefaults         float64                `protobuf:"fixed64,7,opt,name=expected_defaults,json=expectedDefaults,proto3" json:"expected_defaults,omitempty"`             // Sum of stressed default probabilities
+	BaselineExpectedLoss     string                 `protobuf:"bytes,8,opt,name=baseline_expected_loss,json=baselineExpectedLoss,proto3" json:"baseline_expected_loss,omitempty"` // Principal weighted by default probability, without recovery
+	ExpectedLoss             string                 `protobuf:"bytes,9,opt,name=expected_loss,json=expectedLoss,proto3" json:"expected_loss,omitempty"`
+	Shortfall                string                 `protobuf:"bytes,10,opt,name=shortfall,proto3" json:"shortfall,omitempty"` // Coupons left unpaid across the book in one period
+	ComputedAt               int64                  `protobuf:"varint,11,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *StressTestReport) Reset() {
+	*x = StressTestReport{}
+	mi := &file_proto_bonding_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressTestReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressTestReport) ProtoMessage() {}
+
+func (x *StressTestReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressTestReport.ProtoReflect.Descriptor instead.
+func (*StressTestReport) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *StressTestReport) GetBonds() []*StressBondResult {
+	if x != nil {
+		return x.Bonds
+	}
+	return nil
+}
+
+func (x *StressTestReport) GetHealthy() int32 {
+	if x != nil {
+		return x.Healthy
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetStressed() int32 {
+	if x != nil {
+		return x.Stressed
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetDistressed() int32 {
+	if x != nil {
+		return x.Distressed
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetNewlyDistressed() int32 {
+	if x != nil {
+		return x.NewlyDistressed
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetBaselineExpectedDefaults() float64 {
+	if x != nil {
+		return x.BaselineExpectedDefaults
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetExpectedDefaults() float64 {
+	if x != nil {
+		return x.ExpectedDefaults
+	}
+	return 0
+}
+
+func (x *StressTestReport) GetBaselineExpectedLoss() string {
+	if x != nil {
+		return x.BaselineExpectedLoss
+	}
+	return ""
+}
+
+func (x *StressTestReport) GetExpectedLoss() string {
+	if x != nil {
+		return x.ExpectedLoss
+	}
+	return ""
+}
+
+func (x *StressTestReport) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+func (x *StressTestReport) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+type GetPositionProofRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,2,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,3,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPositionProofRequest) Reset() {
+	*x = GetPositionProofRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPositionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionProofRequest) ProtoMessage() {}
+
+func (x *GetPositionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionProofRequest.ProtoReflect.Descriptor instead.
+func (*GetPositionProofRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetPositionProofRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *GetPositionProofRequest) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *GetPositionProofRequest) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+// Proves a position was included in a bond's published commitment: hashing
+// leaf with each proof hash in turn, the pair in sorted order, gives root
+type PositionProof struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Epoch           uint64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root            string                 `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"` // Published with the bond contract's commitStateRoot
+	TxHash          string                 `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CommittedAt     int64                  `protobuf:"varint,5,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
+	TrancheId       int32                  `protobuf:"varint,6,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	InvestorAddress string                 `protobuf:"bytes,7,opt,name=investor_address,json=investorAddress,proto3" json:"investor_address,omitempty"`
+	Amount          string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"` // The position when committed, which may differ from the current one
+	Leaf            string                 `protobuf:"bytes,9,opt,name=leaf,proto3" json:"leaf,omitempty"`     // keccak256(keccak256(abi.encode(1, bond_id, tranche_id, investor_address, amount)))
+	Proof           []string               `protobuf:"bytes,10,rep,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PositionProof) Reset() {
+	*x = PositionProof{}
+	mi := &file_proto_bonding_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionProof) ProtoMessage() {}
+
+func (x *PositionProof) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionProof.ProtoReflect.Descriptor instead.
+func (*PositionProof) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *PositionProof) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *PositionProof) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *PositionProof) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *PositionProof) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *PositionProof) GetCommittedAt() int64 {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return 0
+}
+
+func (x *PositionProof) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *PositionProof) GetInvestorAddress() string {
+	if x != nil {
+		return x.InvestorAddress
+	}
+	return ""
+}
+
+func (x *PositionProof) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PositionProof) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *PositionProof) GetProof() []string {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// An address on an allow or deny list. Write calls involving a denied
+// address are refused; once an allow list has entries, so are calls
+// involving any address not on it.
+type AccessListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BondId        string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"` // Empty for all the tenant's bonds
+	List          string                 `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`                   // ALLOW or DENY
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessListEntry) Reset() {
+	*x = AccessListEntry{}
+	mi := &file_proto_bonding_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessListEntry) ProtoMessage() {}
+
+func (x *AccessListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessListEntry.ProtoReflect.Descriptor instead.
+func (*AccessListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *AccessListEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccessListEntry) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *AccessListEntry) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *AccessListEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccessListEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccessListEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AddAccessListEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"` // Empty for all the tenant's bonds
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`                   // ALLOW or DENY
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccessListEntryRequest) Reset() {
+	*x = AddAccessListEntryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccessListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccessListEntryRequest) ProtoMessage() {}
+
+func (x *AddAccessListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccessListEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddAccessListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AddAccessListEntryRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *AddAccessListEntryRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *AddAccessListEntryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddAccessListEntryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RemoveAccessListEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccessListEntryRequest) Reset() {
+	*x = RemoveAccessListEntryRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccessListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccessListEntryRequest) ProtoMessage() {}
+
+func (x *RemoveAccessListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccessListEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAccessListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RemoveAccessListEntryRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *RemoveAccessListEntryRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *RemoveAccessListEntryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RemoveAccessListEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccessListEntryResponse) Reset() {
+	*x = RemoveAccessListEntryResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccessListEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccessListEntryResponse) ProtoMessage() {}
+
+func (x *RemoveAccessListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccessListEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAccessListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{162}
+}
+
+type ListAccessListEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"` // Empty for the tenant's global lists
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`                   // Optional: ALLOW or DENY
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessListEntriesRequest) Reset() {
+	*x = ListAccessListEntriesRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessListEntriesRequest) ProtoMessage() {}
+
+func (x *ListAccessListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListAccessListEntriesRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListAccessListEntriesRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+type ListAccessListEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AccessListEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessListEntriesResponse) Reset() {
+	*x = ListAccessListEntriesResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessListEntriesResponse) ProtoMessage() {}
+
+func (x *ListAccessListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ListAccessListEntriesResponse) GetEntries() []*AccessListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Audit entries are scoped to the caller's tenant
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Principal     string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`                // Optional, e.g. a wallet address or OIDC subject
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                      // Optional RPC name, e.g. Invest
+	BondId        string                 `protobuf:"bytes,3,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`        // Optional
+	Since         int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`                       // Optional Unix timestamp, inclusive
+	Until         int64                  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`                       // Optional Unix timestamp, exclusive
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, capped at 200
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *QueryAuditLogRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *QueryAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A call to a mutating RPC
+type AuditLogEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Principal        string                 `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`                     // Empty for anonymous calls
+	AuthMethod       string                 `protobuf:"bytes,4,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"` // api_key, wallet or oidc
+	Roles            []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	Method           string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	RequestDigest    string                 `protobuf:"bytes,7,opt,name=request_digest,json=requestDigest,proto3" json:"request_digest,omitempty"` // Hex SHA-256 of the JSON-encoded request
+	Code             string                 `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"`                                        // gRPC status code, e.g. OK
+	Error            string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	TxHashes         []string               `protobuf:"bytes,10,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	BondId           string                 `protobuf:"bytes,11,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	BondStatusBefore string                 `protobuf:"bytes,12,opt,name=bond_status_before,json=bondStatusBefore,proto3" json:"bond_status_before,omitempty"` // Empty while the bond didn't exist
+	BondStatusAfter  string                 `protobuf:"bytes,13,opt,name=bond_status_after,json=bondStatusAfter,proto3" json:"bond_status_after,omitempty"`
+	TrancheId        int32                  `protobuf:"varint,14,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`                                                                                            // -1 when the call isn't about one tranche
+	PositionsBefore  map[string]string      `protobuf:"bytes,15,rep,name=positions_before,json=positionsBefore,proto3" json:"positions_before,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Investor address to position in the tranche
+	PositionsAfter   map[string]string      `protobuf:"bytes,16,rep,name=positions_after,json=positionsAfter,proto3" json:"positions_after,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_proto_bonding_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *AuditLogEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetRequestDigest() string {
+	if x != nil {
+		return x.RequestDigest
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetBondStatusBefore() string {
+	if x != nil {
+		return x.BondStatusBefore
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetBondStatusAfter() string {
+	if x != nil {
+		return x.BondStatusAfter
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTrancheId() int32 {
+	if x != nil {
+		return x.TrancheId
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetPositionsBefore() map[string]string {
+	if x != nil {
+		return x.PositionsBefore
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetPositionsAfter() map[string]string {
+	if x != nil {
+		return x.PositionsAfter
+	}
+	return nil
+}
+
+// Pausing closes a bond to new investments, freezing suspends its
+// distributions, cancelling withdraws it before anyone invested, and resuming
+// returns a paused or frozen bond to ACTIVE
+type ChangeBondStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Recorded on the bond's timeline
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBondStatusRequest) Reset() {
+	*x = ChangeBondStatusRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBondStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBondStatusRequest) ProtoMessage() {}
+
+func (x *ChangeBondStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBondStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBondStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ChangeBondStatusRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ChangeBondStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChangeBondStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BondId         string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,2,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeBondStatusResponse) Reset() {
+	*x = ChangeBondStatusResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBondStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBondStatusResponse) ProtoMessage() {}
+
+func (x *ChangeBondStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBondStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBondStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ChangeBondStatusResponse) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ChangeBondStatusResponse) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *ChangeBondStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Sweeps a frozen bond's escrowed funds to a recovery address once another
+// operator confirms
+type RequestEmergencyWithdrawalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BondId          string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	RecoveryAddress string                 `protobuf:"bytes,2,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	ReasonCode      string                 `protobuf:"bytes,3,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestEmergencyWithdrawalRequest) Reset() {
+	*x = RequestEmergencyWithdrawalRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmergencyWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmergencyWithdrawalRequest) ProtoMessage() {}
+
+func (x *RequestEmergencyWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmergencyWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmergencyWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *RequestEmergencyWithdrawalRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *RequestEmergencyWithdrawalRequest) GetRecoveryAddress() string {
+	if x != nil {
+		return x.RecoveryAddress
+	}
+	return ""
+}
+
+func (x *RequestEmergencyWithdrawalRequest) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *RequestEmergencyWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The confirming operator repeats the bond and recovery address
+type ConfirmEmergencyWithdrawalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WithdrawalId    uint64                 `protobuf:"varint,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	BondId          string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	RecoveryAddress string                 `protobuf:"bytes,3,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfirmEmergencyWithdrawalRequest) Reset() {
+	*x = ConfirmEmergencyWithdrawalRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmergencyWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmergencyWithdrawalRequest) ProtoMessage() {}
+
+func (x *ConfirmEmergencyWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmergencyWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmergencyWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ConfirmEmergencyWithdrawalRequest) GetWithdrawalId() uint64 {
+	if x != nil {
+		return x.WithdrawalId
+	}
+	return 0
+}
+
+func (x *ConfirmEmergencyWithdrawalRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ConfirmEmergencyWithdrawalRequest) GetRecoveryAddress() string {
+	if x != nil {
+		return x.RecoveryAddress
+	}
+	return ""
+}
+
+type CancelEmergencyWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithdrawalId  uint64                 `protobuf:"varint,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEmergencyWithdrawalRequest) Reset() {
+	*x = CancelEmergencyWithdrawalRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEmergencyWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEmergencyWithdrawalRequest) ProtoMessage() {}
+
+func (x *CancelEmergencyWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEmergencyWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*CancelEmergencyWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CancelEmergencyWithdrawalRequest) GetWithdrawalId() uint64 {
+	if x != nil {
+		return x.WithdrawalId
+	}
+	return 0
+}
+
+func (x *CancelEmergencyWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListEmergencyWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BondId        string                 `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"` // Optional
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // Optional: PENDING, EXECUTING, EXECUTED, CANCELLED or EXPIRED
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergencyWithdrawalsRequest) Reset() {
+	*x = ListEmergencyWithdrawalsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergencyWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyWithdrawalsRequest) ProtoMessage() {}
+
+func (x *ListEmergencyWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergencyWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmergencyWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListEmergencyWithdrawalsRequest) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *ListEmergencyWithdrawalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListEmergencyWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*EmergencyWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	IpnftId              string `protobuf:"bytes,1,opt,name=ipnft_id,json=ipnftId,proto3" json:"ipnft_id,omitempty"`
-	NftContract          string `protobuf:"bytes,2,opt,name=nft_contract,json=nftContract,proto3" json:"nft_contract,omitempty"`
-	TotalValue           string `protobuf:"bytes,3,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
-	SeniorAllocation     string `protobuf:"bytes,4,opt,name=senior_allocation,json=seniorAllocation,proto3" json:"senior_allocation,omitempty"`
-	MezzanineAllocation  string `protobuf:"bytes,5,opt,name=mezzanine_allocation,json=mezzanineAllocation,proto3" json:"mezzanine_allocation,omitempty"`
-	JuniorAllocation     string `protobuf:"bytes,6,opt,name=junior_allocation,json=juniorAllocation,proto3" json:"junior_allocation,omitempty"`
-	MaturityDate         int64  `protobuf:"varint,7,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+func (x *ListEmergencyWithdrawalsResponse) Reset() {
+	*x = ListEmergencyWithdrawalsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueBondRequest) Reset() {
-	*x = IssueBondRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_bonding_proto_msgTypes[0]
+func (x *ListEmergencyWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergencyWithdrawalsResponse) ProtoMessage() {}
+
+func (x *ListEmergencyWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[174]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *IssueBondRequest) String() string {
+// Deprecated: Use ListEmergencyWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmergencyWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ListEmergencyWithdrawalsResponse) GetWithdrawals() []*EmergencyWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type EmergencyWithdrawal struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BondId          string                 `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	RecoveryAddress string                 `protobuf:"bytes,3,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	ReasonCode      string                 `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt     int64                  `protobuf:"varint,8,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // Confirmation deadline
+	ResolvedBy      string                 `protobuf:"bytes,10,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // Who confirmed or cancelled it
+	ResolvedAt      int64                  `protobuf:"varint,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	CancelReason    string                 `protobuf:"bytes,12,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	TxHash          string                 `protobuf:"bytes,13,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EmergencyWithdrawal) Reset() {
+	*x = EmergencyWithdrawal{}
+	mi := &file_proto_bonding_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueBondRequest) ProtoMessage() {}
+func (*EmergencyWithdrawal) ProtoMessage() {}
 
-func (x *IssueBondRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bonding_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *EmergencyWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[175]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -55,36 +13010,282 @@ func (x *IssueBondRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *IssueBondRequest) GetIpnftId() string {
+// Deprecated: Use EmergencyWithdrawal.ProtoReflect.Descriptor instead.
+func (*EmergencyWithdrawal) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *EmergencyWithdrawal) GetId() uint64 {
 	if x != nil {
-		return x.IpnftId
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmergencyWithdrawal) GetBondId() string {
+	if x != nil {
+		return x.BondId
 	}
 	return ""
 }
 
-type IssueBondResponse struct {
-	state         protoimpl.MessageState
+func (x *EmergencyWithdrawal) GetRecoveryAddress() string {
+	if x != nil {
+		return x.RecoveryAddress
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *EmergencyWithdrawal) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *EmergencyWithdrawal) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *EmergencyWithdrawal) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *EmergencyWithdrawal) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// Replaces a flag's setting. A disabled flag is off for every tenant; an
+// enabled one is on for the listed tenants and rollout_percent of the rest.
+type SetFeatureFlagRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled        bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RolloutPercent uint32                 `protobuf:"varint,3,opt,name=rollout_percent,json=rolloutPercent,proto3" json:"rollout_percent,omitempty"`
+	Tenants        []string               `protobuf:"bytes,4,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetFeatureFlagRequest) Reset() {
+	*x = SetFeatureFlagRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagRequest) ProtoMessage() {}
+
+func (x *SetFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *SetFeatureFlagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetFeatureFlagRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetFeatureFlagRequest) GetRolloutPercent() uint32 {
+	if x != nil {
+		return x.RolloutPercent
+	}
+	return 0
+}
+
+func (x *SetFeatureFlagRequest) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type ListFeatureFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsRequest) Reset() {
+	*x = ListFeatureFlagsRequest{}
+	mi := &file_proto_bonding_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsRequest) ProtoMessage() {}
+
+func (x *ListFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{177}
+}
+
+type ListFeatureFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*FeatureFlag         `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	BondId string `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
-	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+func (x *ListFeatureFlagsResponse) Reset() {
+	*x = ListFeatureFlagsResponse{}
+	mi := &file_proto_bonding_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueBondResponse) Reset() {
-	*x = IssueBondResponse{}
+func (x *ListFeatureFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *IssueBondResponse) String() string {
+func (*ListFeatureFlagsResponse) ProtoMessage() {}
+
+func (x *ListFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListFeatureFlagsResponse) GetFlags() []*FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type FeatureFlag struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled          bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RolloutPercent   uint32                 `protobuf:"varint,4,opt,name=rollout_percent,json=rolloutPercent,proto3" json:"rollout_percent,omitempty"`
+	Tenants          []string               `protobuf:"bytes,5,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	EnabledForCaller bool                   `protobuf:"varint,6,opt,name=enabled_for_caller,json=enabledForCaller,proto3" json:"enabled_for_caller,omitempty"` // Whether the flag is on for the calling tenant
+	UpdatedBy        string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`                         // Empty until an operator first sets the flag
+	UpdatedAt        int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	mi := &file_proto_bonding_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueBondResponse) ProtoMessage() {}
+func (*FeatureFlag) ProtoMessage() {}
 
-func (x *IssueBondResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_bonding_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bonding_proto_msgTypes[179]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -94,20 +13295,1817 @@ func (x *IssueBondResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var file_proto_bonding_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_proto_bonding_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercent() uint32 {
+	if x != nil {
+		return x.RolloutPercent
+	}
+	return 0
+}
+
+func (x *FeatureFlag) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *FeatureFlag) GetEnabledForCaller() bool {
+	if x != nil {
+		return x.EnabledForCaller
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_proto_bonding_proto protoreflect.FileDescriptor
+
+const file_proto_bonding_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/bonding.proto\x12\abonding\x1a\x1bbuf/validate/validate.proto\"\xdb\b\n" +
+	"\x10IssueBondRequest\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x12V\n" +
+	"\fnft_contract\x18\x02 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\vnftContract\x125\n" +
+	"\vtotal_value\x18\x03 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\n" +
+	"totalValue\x126\n" +
+	"\x06senior\x18\x04 \x01(\v2\x16.bonding.TrancheConfigB\x06\xbaH\x03\xc8\x01\x01R\x06senior\x12<\n" +
+	"\tmezzanine\x18\x05 \x01(\v2\x16.bonding.TrancheConfigB\x06\xbaH\x03\xc8\x01\x01R\tmezzanine\x126\n" +
+	"\x06junior\x18\x06 \x01(\v2\x16.bonding.TrancheConfigB\x06\xbaH\x03\xc8\x01\x01R\x06junior\x12#\n" +
+	"\rmaturity_date\x18\a \x01(\x03R\fmaturityDate\x12\x14\n" +
+	"\x05chain\x18\b \x01(\tR\x05chain\x129\n" +
+	"\fregistration\x18\t \x01(\v2\x15.bonding.RegisteredIPR\fregistration\x12\x1a\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tR\bcategory\x12,\n" +
+	"\x12license_expires_at\x18\v \x01(\x03R\x10licenseExpiresAt\x123\n" +
+	"\alicense\x18\f \x01(\v2\x19.bonding.LicenseAgreementR\alicense\x12I\n" +
+	"\x10revenue_forecast\x18\r \x03(\v2\x1e.bonding.RevenueForecastPeriodR\x0frevenueForecast\x12\x17\n" +
+	"\adry_run\x18\x0e \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vcontent_url\x18\x0f \x01(\tR\n" +
+	"contentUrl\x12W\n" +
+	"\x0eissuer_address\x18\x10 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\rissuerAddress\x12?\n" +
+	"\tday_count\x18\x11 \x01(\tB\"\xbaH\x1f\xd8\x01\x01r\x1aR\aACT/365R\aACT/360R\x0630/360R\bdayCount:\xda\x01\xbaH\xd6\x01\x1a\xd3\x01\n" +
+	"\x19tranche_priorities_unique\x12!tranche priorities must be unique\x1a\x92\x01this.senior.priority != this.mezzanine.priority && this.senior.priority != this.junior.priority && this.mezzanine.priority != this.junior.priority\"\xbd\x02\n" +
+	"\rTrancheConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\bpriority\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x03(\x01R\bpriority\x12f\n" +
+	"\x15allocation_percentage\x18\x03 \x01(\tB1\xbaH.r,2*^(100(\\.00?)?|[1-9]?[0-9](\\.[0-9]{1,2})?)$R\x14allocationPercentage\x12)\n" +
+	"\x03apy\x18\x04 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x03apy\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x05 \x01(\tR\triskLevel\x12?\n" +
+	"\tday_count\x18\x06 \x01(\tB\"\xbaH\x1f\xd8\x01\x01r\x1aR\aACT/365R\aACT/360R\x0630/360R\bdayCount\"q\n" +
+	"\x15RevenueForecastPeriod\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\x03R\tperiodEnd\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x8e\x03\n" +
+	"\x10LicenseAgreement\x12\x1a\n" +
+	"\blicensor\x18\x01 \x01(\tR\blicensor\x12\x1a\n" +
+	"\blicensee\x18\x02 \x01(\tR\blicensee\x12\x1f\n" +
+	"\vroyalty_bps\x18\x03 \x01(\x03R\n" +
+	"royaltyBps\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\x03R\x06endsAt\x12 \n" +
+	"\vterritories\x18\x06 \x03(\tR\vterritories\x12\x1c\n" +
+	"\texclusive\x18\a \x01(\bR\texclusive\x12#\n" +
+	"\rdocument_hash\x18\b \x01(\tR\fdocumentHash\x12>\n" +
+	"\x1brights_assignment_signature\x18\t \x01(\tR\x19rightsAssignmentSignature\x12\x1d\n" +
+	"\n" +
+	"terms_hash\x18\n" +
+	" \x01(\tR\ttermsHash\x12'\n" +
+	"\x0frights_assigned\x18\v \x01(\bR\x0erightsAssigned\"\x99\x01\n" +
+	"\fRegisteredIP\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\"\n" +
+	"\fjurisdiction\x18\x03 \x01(\tR\fjurisdiction\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\bverified\x18\x05 \x01(\bR\bverified\"\xed\x01\n" +
+	"\x11IssueBondResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x120\n" +
+	"\btranches\x18\x05 \x03(\v2\x14.bonding.TrancheInfoR\btranches\x12@\n" +
+	"\x0frisk_assessment\x18\x06 \x01(\v2\x17.bonding.RiskAssessmentR\x0eriskAssessment\"\xd2\x01\n" +
+	"\rInvestRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\rR\ttrancheId\x12,\n" +
+	"\x06amount\x18\x03 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\x06amount\x12[\n" +
+	"\x10investor_address\x18\x04 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\"\x93\x01\n" +
+	"\x0eInvestResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0finvested_amount\x18\x03 \x01(\tR\x0einvestedAmount\x12'\n" +
+	"\x0fexpected_return\x18\x04 \x01(\x01R\x0eexpectedReturn\"X\n" +
+	"\x12GetBondInfoRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"\xd4\x06\n" +
+	"\x13GetBondInfoResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x19\n" +
+	"\bipnft_id\x18\x02 \x01(\tR\aipnftId\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\tR\n" +
+	"totalValue\x12#\n" +
+	"\rmaturity_date\x18\x05 \x01(\x03R\fmaturityDate\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x120\n" +
+	"\btranches\x18\a \x03(\v2\x14.bonding.TrancheInfoR\btranches\x12#\n" +
+	"\rtotal_arrears\x18\b \x01(\tR\ftotalArrears\x126\n" +
+	"\vissuer_info\x18\t \x01(\v2\x15.bonding.CounterpartyR\n" +
+	"issuerInfo\x12\x14\n" +
+	"\x05chain\x18\n" +
+	" \x01(\tR\x05chain\x12!\n" +
+	"\fnft_contract\x18\v \x01(\tR\vnftContract\x12#\n" +
+	"\rtotal_revenue\x18\f \x01(\tR\ftotalRevenue\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x129\n" +
+	"\fregistration\x18\x0e \x01(\v2\x15.bonding.RegisteredIPR\fregistration\x12,\n" +
+	"\x12license_expires_at\x18\x0f \x01(\x03R\x10licenseExpiresAt\x123\n" +
+	"\alicense\x18\x10 \x01(\v2\x19.bonding.LicenseAgreementR\alicense\x12(\n" +
+	"\x10rating_review_at\x18\x11 \x01(\x03R\x0eratingReviewAt\x120\n" +
+	"\x14rating_review_reason\x18\x12 \x01(\tR\x12ratingReviewReason\x12*\n" +
+	"\x11status_changed_at\x18\x13 \x01(\x03R\x0fstatusChangedAt\x12#\n" +
+	"\rstatus_reason\x18\x14 \x01(\tR\fstatusReason\x12\x1f\n" +
+	"\varchived_at\x18\x15 \x01(\x03R\n" +
+	"archivedAt\x12\x1b\n" +
+	"\tday_count\x18\x16 \x01(\tR\bdayCount\"\x8a\x01\n" +
+	"\x10ListBondsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"G\n" +
+	"\x11ListBondsResponse\x122\n" +
+	"\x05bonds\x18\x01 \x03(\v2\x1c.bonding.GetBondInfoResponseR\x05bonds\"\xd7\x02\n" +
+	"\x16ListInvestmentsRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\"\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05H\x00R\ttrancheId\x88\x01\x01\x12^\n" +
+	"\x10investor_address\x18\x03 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\b \x01(\bR\x0fincludeArchivedB\r\n" +
+	"\v_tranche_id\"|\n" +
+	"\x17ListInvestmentsResponse\x129\n" +
+	"\vinvestments\x18\x01 \x03(\v2\x17.bonding.InvestmentInfoR\vinvestments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfd\x01\n" +
+	"\x0eInvestmentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x03 \x01(\x05R\ttrancheId\x12)\n" +
+	"\x10investor_address\x18\x04 \x01(\tR\x0finvestorAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x17\n" +
+	"\atx_hash\x18\x06 \x01(\tR\x06txHash\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\x12)\n" +
+	"\x10accrued_interest\x18\b \x01(\tR\x0faccruedInterest\"\x80\x03\n" +
+	"\vTrancheInfo\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\rR\ttrancheId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"allocation\x18\x03 \x01(\tR\n" +
+	"allocation\x12\x10\n" +
+	"\x03apy\x18\x04 \x01(\tR\x03apy\x12%\n" +
+	"\x0etotal_invested\x18\x05 \x01(\tR\rtotalInvested\x12\x18\n" +
+	"\aarrears\x18\x06 \x01(\tR\aarrears\x12%\n" +
+	"\x0emin_investment\x18\a \x01(\tR\rminInvestment\x12%\n" +
+	"\x0emax_investment\x18\b \x01(\tR\rmaxInvestment\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\n" +
+	" \x01(\tR\triskLevel\x12%\n" +
+	"\x0einvestor_count\x18\v \x01(\x05R\rinvestorCount\x12\x1b\n" +
+	"\tday_count\x18\f \x01(\tR\bdayCount\"\x8e\x01\n" +
+	"\x15GetTrancheInfoRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x12(\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x02(\x00R\ttrancheId\x12)\n" +
+	"\x10include_archived\x18\x03 \x01(\bR\x0fincludeArchived\"\x85\x02\n" +
+	"\x16GetTrancheInfoResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12,\n" +
+	"\x06stored\x18\x03 \x01(\v2\x14.bonding.TrancheInfoR\x06stored\x122\n" +
+	"\bon_chain\x18\x04 \x01(\v2\x17.bonding.OnChainTrancheR\aonChain\x12:\n" +
+	"\rdiscrepancies\x18\x05 \x03(\v2\x14.bonding.DiscrepancyR\rdiscrepancies\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x06 \x01(\bR\n" +
+	"consistent\"\x97\x01\n" +
+	"\x0eOnChainTranche\x12\x1e\n" +
+	"\n" +
+	"allocation\x18\x01 \x01(\tR\n" +
+	"allocation\x12\x17\n" +
+	"\aapy_bps\x18\x02 \x01(\x03R\x06apyBps\x12%\n" +
+	"\x0etotal_invested\x18\x03 \x01(\tR\rtotalInvested\x12%\n" +
+	"\x0einvestor_count\x18\x04 \x01(\x03R\rinvestorCount\"\xbf\x01\n" +
+	"\x18DistributeRevenueRequest\x12\x1f\n" +
+	"\abond_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06bondId\x12.\n" +
+	"\arevenue\x18\x02 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\arevenue\x12\x1a\n" +
+	"\blicensee\x18\x03 \x01(\tR\blicensee\x12\x15\n" +
+	"\x06due_at\x18\x04 \x01(\x03R\x05dueAt\x12\x1f\n" +
+	"\vreceived_at\x18\x05 \x01(\x03R\n" +
+	"receivedAt\"\xde\x01\n" +
+	"\x19DistributeRevenueResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12B\n" +
+	"\rdistributions\x18\x03 \x03(\v2\x1c.bonding.TrancheDistributionR\rdistributions\x12'\n" +
+	"\x0ftotal_shortfall\x18\x04 \x01(\tR\x0etotalShortfall\x12#\n" +
+	"\rtotal_arrears\x18\x05 \x01(\tR\ftotalArrears\"\xf9\x01\n" +
+	"\x13TrancheDistribution\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x12amount_distributed\x18\x03 \x01(\tR\x11amountDistributed\x12%\n" +
+	"\x0einvestor_count\x18\x04 \x01(\x05R\rinvestorCount\x12!\n" +
+	"\farrears_paid\x18\x05 \x01(\tR\varrearsPaid\x12\x1c\n" +
+	"\tshortfall\x18\x06 \x01(\tR\tshortfall\x12\x18\n" +
+	"\aarrears\x18\a \x01(\tR\aarrears\"\xa3\x01\n" +
+	"\x18GetRevenueHistoryRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"\xa4\x01\n" +
+	"\x19GetRevenueHistoryResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12F\n" +
+	"\rdistributions\x18\x02 \x03(\v2 .bonding.RevenueDistributionInfoR\rdistributions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x9b\x01\n" +
+	"\x19GetPaymentScheduleRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x121\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05B\x12\xbaH\x0f\x1a\r\x18\x02(\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01R\ttrancheId\x12)\n" +
+	"\x10include_archived\x18\x03 \x01(\bR\x0fincludeArchived\"r\n" +
+	"\x1aGetPaymentScheduleResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12;\n" +
+	"\btranches\x18\x02 \x03(\v2\x1f.bonding.TranchePaymentScheduleR\btranches\"\xf4\x01\n" +
+	"\x16TranchePaymentSchedule\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12\x1e\n" +
+	"\n" +
+	"allocation\x18\x02 \x01(\tR\n" +
+	"allocation\x12%\n" +
+	"\x0etotal_invested\x18\x03 \x01(\tR\rtotalInvested\x125\n" +
+	"\bpayments\x18\x04 \x03(\v2\x19.bonding.ScheduledPaymentR\bpayments\x12)\n" +
+	"\x10expected_coupons\x18\x05 \x01(\tR\x0fexpectedCoupons\x12\x12\n" +
+	"\x04paid\x18\x06 \x01(\tR\x04paid\"\xd4\x01\n" +
+	"\x10ScheduledPayment\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x05R\bsequence\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\x03R\adueDate\x12\x16\n" +
+	"\x06coupon\x18\x03 \x01(\tR\x06coupon\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\tR\tprincipal\x12'\n" +
+	"\x0fexpected_coupon\x18\x05 \x01(\tR\x0eexpectedCoupon\x12\x12\n" +
+	"\x04paid\x18\x06 \x01(\tR\x04paid\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xa6\x02\n" +
+	"\x16GetYieldHistoryRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x121\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05B\x12\xbaH\x0f\x1a\r\x18\x02(\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01R\ttrancheId\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived:h\xbaHe\x1ac\n" +
+	"\rto_after_from\x12\x1ato must not be before from\x1a6this.from == 0 || this.to == 0 || this.to >= this.from\"l\n" +
+	"\x17GetYieldHistoryResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x128\n" +
+	"\btranches\x18\x02 \x03(\v2\x1c.bonding.TrancheYieldHistoryR\btranches\"\xb5\x01\n" +
+	"\x13TrancheYieldHistory\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12\x10\n" +
+	"\x03apy\x18\x02 \x01(\x01R\x03apy\x12!\n" +
+	"\frealized_apy\x18\x03 \x01(\x01R\vrealizedApy\x12\x1d\n" +
+	"\n" +
+	"total_paid\x18\x04 \x01(\tR\ttotalPaid\x12+\n" +
+	"\x06points\x18\x05 \x03(\v2\x13.bonding.YieldPointR\x06points\"\x91\x02\n" +
+	"\n" +
+	"YieldPoint\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12'\n" +
+	"\x0fdistribution_id\x18\x02 \x01(\x04R\x0edistributionId\x12\x1a\n" +
+	"\binvested\x18\x03 \x01(\tR\binvested\x12\x12\n" +
+	"\x04paid\x18\x04 \x01(\tR\x04paid\x12'\n" +
+	"\x0fcumulative_paid\x18\x05 \x01(\tR\x0ecumulativePaid\x12!\n" +
+	"\fperiod_yield\x18\x06 \x01(\x01R\vperiodYield\x12\x1d\n" +
+	"\n" +
+	"period_apy\x18\a \x01(\x01R\tperiodApy\x12!\n" +
+	"\frealized_apy\x18\b \x01(\x01R\vrealizedApy\"\xe1\x01\n" +
+	"\x17RevenueDistributionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tshortfall\x18\x03 \x01(\tR\tshortfall\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\tR\x06txHash\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06period\x18\x06 \x01(\x03R\x06period\x121\n" +
+	"\btranches\x18\a \x03(\v2\x15.bonding.TrancheSplitR\btranches\"\x93\x02\n" +
+	"\fTrancheSplit\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12\x1d\n" +
+	"\n" +
+	"coupon_due\x18\x02 \x01(\tR\tcouponDue\x12!\n" +
+	"\farrears_paid\x18\x03 \x01(\tR\varrearsPaid\x12\x1f\n" +
+	"\vcoupon_paid\x18\x04 \x01(\tR\n" +
+	"couponPaid\x12\x1a\n" +
+	"\bresidual\x18\x05 \x01(\tR\bresidual\x12-\n" +
+	"\x12amount_distributed\x18\x06 \x01(\tR\x11amountDistributed\x12\x1c\n" +
+	"\tshortfall\x18\a \x01(\tR\tshortfall\x12\x18\n" +
+	"\aarrears\x18\b \x01(\tR\aarrears\"\xe5\x01\n" +
+	"\x1dRequestEarlyRedemptionRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12[\n" +
+	"\x10investor_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\x12/\n" +
+	"\x06amount\x18\x04 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[1-9][0-9]*$R\x06amount\"\xd1\x01\n" +
+	"\x18ApproveRedemptionRequest\x12#\n" +
+	"\rredemption_id\x18\x01 \x01(\x04R\fredemptionId\x12^\n" +
+	"\x10approver_address\x18\x02 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0fapproverAddress\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xf7\x02\n" +
+	"\x12RedemptionResponse\x12#\n" +
+	"\rredemption_id\x18\x01 \x01(\x04R\fredemptionId\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x03 \x01(\x05R\ttrancheId\x12)\n" +
+	"\x10investor_address\x18\x04 \x01(\tR\x0finvestorAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x18\n" +
+	"\apenalty\x18\x06 \x01(\tR\apenalty\x12\x16\n" +
+	"\x06payout\x18\a \x01(\tR\x06payout\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x17\n" +
+	"\atx_hash\x18\t \x01(\tR\x06txHash\x12+\n" +
+	"\x11requires_approval\x18\n" +
+	" \x01(\bR\x10requiresApproval\x121\n" +
+	"\binvestor\x18\v \x01(\v2\x15.bonding.CounterpartyR\binvestor\"^\n" +
+	"\x19QueueDistributionsRequest\x12A\n" +
+	"\rdistributions\x18\x01 \x03(\v2\x1b.bonding.QueuedDistributionR\rdistributions\"_\n" +
+	"\x1aQueueDistributionsResponse\x12A\n" +
+	"\rdistributions\x18\x01 \x03(\v2\x1b.bonding.QueuedDistributionR\rdistributions\"\x84\x01\n" +
+	"\x12QueuedDistribution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06due_at\x18\x04 \x01(\x03R\x05dueAt\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xd9\x01\n" +
+	"\x1eSetDistributionScheduleRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x12B\n" +
+	"\acadence\x18\x02 \x01(\tB(\xbaH%r#R\x05DAILYR\x06WEEKLYR\aMONTHLYR\tQUARTERLYR\acadence\x12 \n" +
+	"\ffirst_run_at\x18\x03 \x01(\x03R\n" +
+	"firstRunAt\x12/\n" +
+	"\x06amount\x18\x04 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[1-9][0-9]*$R\x06amount\"?\n" +
+	"\x1bDistributionScheduleRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\"^\n" +
+	"\x1bSkipDistributionRunsRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x12\x1d\n" +
+	"\x04runs\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x184(\x00R\x04runs\"\x83\x03\n" +
+	"\x14DistributionSchedule\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x18\n" +
+	"\acadence\x18\x02 \x01(\tR\acadence\x12 \n" +
+	"\ffirst_run_at\x18\x03 \x01(\x03R\n" +
+	"firstRunAt\x12\x1e\n" +
+	"\vnext_run_at\x18\x04 \x01(\x03R\tnextRunAt\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12\x1b\n" +
+	"\tskip_runs\x18\a \x01(\x05R\bskipRuns\x12#\n" +
+	"\raccrued_since\x18\b \x01(\x03R\faccruedSince\x12\x1e\n" +
+	"\vlast_run_at\x18\t \x01(\x03R\tlastRunAt\x12\x1f\n" +
+	"\vlast_status\x18\n" +
+	" \x01(\tR\n" +
+	"lastStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\v \x01(\tR\tlastError\x12$\n" +
+	"\x0elast_queued_id\x18\f \x01(\x04R\flastQueuedId\">\n" +
+	"\"DeleteDistributionScheduleResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"[\n" +
+	"\x17ImportRevenueCSVRequest\x12%\n" +
+	"\tconnector\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tconnector\x12\x19\n" +
+	"\x03csv\x18\x02 \x01(\fB\a\xbaH\x04z\x02\x10\x01R\x03csv\"V\n" +
+	"\x18ImportRevenueCSVResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\x05R\brecorded\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x05R\n" +
+	"duplicates\"\x8a\x01\n" +
+	"\x1aReviewRevenueEventsRequest\x12\x1d\n" +
+	"\x03ids\x18\x01 \x03(\x04B\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\x03ids\x125\n" +
+	"\bdecision\x18\x02 \x01(\tB\x19\xbaH\x16r\x14R\bVERIFIEDR\bREJECTEDR\bdecision\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"9\n" +
+	"\x1bReviewRevenueEventsResponse\x12\x1a\n" +
+	"\breviewed\x18\x01 \x01(\x05R\breviewed\"\xe5\x01\n" +
+	"\x18ListRevenueEventsRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12=\n" +
+	"\x06status\x18\x02 \x01(\tB%\xbaH\"\xd8\x01\x01r\x1dR\aPENDINGR\bVERIFIEDR\bREJECTEDR\x06status\x125\n" +
+	"\x06source\x18\x03 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15R\aWEBHOOKR\x03CSVR\x05CHAINR\x06source\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"r\n" +
+	"\x19ListRevenueEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.bonding.RevenueEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9e\x03\n" +
+	"\fRevenueEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1c\n" +
+	"\tconnector\x18\x04 \x01(\tR\tconnector\x12\x1f\n" +
+	"\vexternal_id\x18\x05 \x01(\tR\n" +
+	"externalId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\x03R\n" +
+	"occurredAt\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1f\n" +
+	"\vrecorded_by\x18\n" +
+	" \x01(\tR\n" +
+	"recordedBy\x12\x1f\n" +
+	"\vreviewed_by\x18\v \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\f \x01(\x03R\n" +
+	"reviewedAt\x12#\n" +
+	"\rreject_reason\x18\r \x01(\tR\frejectReason\x12\x17\n" +
+	"\atx_hash\x18\x0e \x01(\tR\x06txHash\"\xdb\x01\n" +
+	"\x1bListAccrualSnapshotsRequest\x12 \n" +
+	"\abond_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06bondId\x12^\n" +
+	"\x10investor_address\x18\x02 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"~\n" +
+	"\x1cListAccrualSnapshotsResponse\x126\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x18.bonding.AccrualSnapshotR\tsnapshots\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb6\x02\n" +
+	"\x0fAccrualSnapshot\x12#\n" +
+	"\rinvestment_id\x18\x01 \x01(\x04R\finvestmentId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\x03R\x03day\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x03 \x01(\x05R\ttrancheId\x12)\n" +
+	"\x10investor_address\x18\x04 \x01(\tR\x0finvestorAddress\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\tR\tprincipal\x12\x17\n" +
+	"\aapy_bps\x18\x06 \x01(\x03R\x06apyBps\x12\x18\n" +
+	"\aseconds\x18\a \x01(\x03R\aseconds\x12\x1a\n" +
+	"\binterest\x18\b \x01(\tR\binterest\x12\x18\n" +
+	"\aaccrued\x18\t \x01(\tR\aaccrued\x12\x1b\n" +
+	"\tday_count\x18\n" +
+	" \x01(\tR\bdayCount\"\xaa\x02\n" +
+	"\x19TransferInvestmentRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12S\n" +
+	"\ffrom_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\vfromAddress\x12O\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\ttoAddress\x12/\n" +
+	"\x06amount\x18\x05 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[1-9][0-9]*$R\x06amount\"\x87\x02\n" +
+	"\x1aTransferInvestmentResponse\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x04R\n" +
+	"transferId\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12-\n" +
+	"\x12remaining_position\x18\x04 \x01(\tR\x11remainingPosition\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12)\n" +
+	"\x04from\x18\x06 \x01(\v2\x15.bonding.CounterpartyR\x04from\x12%\n" +
+	"\x02to\x18\a \x01(\v2\x15.bonding.CounterpartyR\x02to\"-\n" +
+	"\x15GetChainStatusRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\"F\n" +
+	"\x16GetChainStatusResponse\x12,\n" +
+	"\x06chains\x18\x01 \x03(\v2\x14.bonding.ChainStatusR\x06chains\"\xa7\x03\n" +
+	"\vChainStatus\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x1d\n" +
+	"\n" +
+	"head_block\x18\x02 \x01(\x04R\theadBlock\x12%\n" +
+	"\x0ehead_timestamp\x18\x03 \x01(\x03R\rheadTimestamp\x12'\n" +
+	"\x0ffinalized_block\x18\x04 \x01(\x04R\x0efinalizedBlock\x12#\n" +
+	"\rindexed_block\x18\x05 \x01(\x04R\findexedBlock\x12,\n" +
+	"\x12indexer_lag_blocks\x18\x06 \x01(\x04R\x10indexerLagBlocks\x12\x18\n" +
+	"\asyncing\x18\a \x01(\bR\asyncing\x12#\n" +
+	"\rwrites_paused\x18\b \x01(\bR\fwritesPaused\x12!\n" +
+	"\fpause_reason\x18\t \x01(\tR\vpauseReason\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12\x19\n" +
+	"\bchain_id\x18\v \x01(\x03R\achainId\x12$\n" +
+	"\rconfirmations\x18\f \x01(\x04R\rconfirmations\"\xd6\x02\n" +
+	"\x1ePreparePermitInvestmentRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12[\n" +
+	"\x10investor_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\x12U\n" +
+	"\rtoken_address\x18\x04 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\ftokenAddress\x12,\n" +
+	"\x06amount\x18\x05 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\x06amount\x12\x1a\n" +
+	"\bdeadline\x18\x06 \x01(\x03R\bdeadline\"\xa2\x01\n" +
+	"\x1fPreparePermitInvestmentResponse\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\x01 \x01(\tR\ttypedData\x12\x18\n" +
+	"\aspender\x18\x02 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x03R\bdeadline\"\xed\x02\n" +
+	"\x17InvestWithPermitRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12[\n" +
+	"\x10investor_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\x12U\n" +
+	"\rtoken_address\x18\x04 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\ftokenAddress\x12,\n" +
+	"\x06amount\x18\x05 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\x06amount\x12\x1a\n" +
+	"\bdeadline\x18\x06 \x01(\x03R\bdeadline\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\tR\tsignature\"t\n" +
+	"\x18InvestWithPermitResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0finvested_amount\x18\x03 \x01(\tR\x0einvestedAmount\"\xcf\x02\n" +
+	"\x11PlaceOrderRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12W\n" +
+	"\x0eseller_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\rsellerAddress\x12,\n" +
+	"\x06amount\x18\x04 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\x06amount\x12\x1b\n" +
+	"\tprice_bps\x18\x05 \x01(\x03R\bpriceBps\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12?\n" +
+	"\rtoken_address\x18\a \x01(\tB\x1a\xbaH\x17r\x152\x13^0x[0-9a-fA-F]{40}$R\ftokenAddress\"\x82\x03\n" +
+	"\tOrderInfo\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x03 \x01(\x05R\ttrancheId\x12%\n" +
+	"\x0eseller_address\x18\x04 \x01(\tR\rsellerAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\tR\tremaining\x12\x1b\n" +
+	"\tprice_bps\x18\a \x01(\x03R\bpriceBps\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\x03R\texpiresAt\x12-\n" +
+	"\x06seller\x18\v \x01(\v2\x15.bonding.CounterpartyR\x06seller\x12#\n" +
+	"\rtoken_address\x18\f \x01(\tR\ftokenAddress\"w\n" +
+	"\x11ListOrdersRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\"\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05H\x00R\ttrancheId\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06statusB\r\n" +
+	"\v_tranche_id\"p\n" +
+	"\x12ListOrdersResponse\x12*\n" +
+	"\x06orders\x18\x01 \x03(\v2\x12.bonding.OrderInfoR\x06orders\x12.\n" +
+	"\x06market\x18\x02 \x01(\v2\x16.bonding.TrancheMarketR\x06market\"\xee\x01\n" +
+	"\rTrancheMarket\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12 \n" +
+	"\fbest_ask_bps\x18\x02 \x01(\x03R\n" +
+	"bestAskBps\x12$\n" +
+	"\x0elast_price_bps\x18\x03 \x01(\x03R\flastPriceBps\x12\x19\n" +
+	"\bvwap_bps\x18\x04 \x01(\x03R\avwapBps\x12\x16\n" +
+	"\x06volume\x18\x05 \x01(\tR\x06volume\x12\x1f\n" +
+	"\vtrade_count\x18\x06 \x01(\x05R\n" +
+	"tradeCount\x12\"\n" +
+	"\rlast_trade_at\x18\a \x01(\x03R\vlastTradeAt\"\xd8\x01\n" +
+	"\x17PrepareFillOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12U\n" +
+	"\rbuyer_address\x18\x02 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\fbuyerAddress\x12/\n" +
+	"\x06amount\x18\x03 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[1-9][0-9]*$R\x06amount\x12\x1a\n" +
+	"\bdeadline\x18\x04 \x01(\x03R\bdeadline\"\x9b\x01\n" +
+	"\x18PrepareFillOrderResponse\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\x01 \x01(\tR\ttypedData\x12\x18\n" +
+	"\aspender\x18\x02 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x03R\bdeadline\"\xef\x01\n" +
+	"\x10FillOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12U\n" +
+	"\rbuyer_address\x18\x02 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\fbuyerAddress\x12/\n" +
+	"\x06amount\x18\x03 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[1-9][0-9]*$R\x06amount\x12\x1a\n" +
+	"\bdeadline\x18\x04 \x01(\x03R\bdeadline\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"\x9f\x01\n" +
+	"\x11FillOrderResponse\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12(\n" +
+	"\x05order\x18\x05 \x01(\v2\x12.bonding.OrderInfoR\x05order\"/\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\"\x9e\x01\n" +
+	"\fCounterparty\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12/\n" +
+	"\x13verification_status\x18\x04 \x01(\tR\x12verificationStatus\x12\x19\n" +
+	"\bens_name\x18\x05 \x01(\tR\aensName\"\xa6\x01\n" +
+	"\x10AddressBookEntry\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12/\n" +
+	"\x13verification_status\x18\x04 \x01(\tR\x12verificationStatus\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\xc6\x01\n" +
+	"\x1dUpsertAddressBookEntryRequest\x12J\n" +
+	"\aaddress\x18\x01 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\aaddress\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12/\n" +
+	"\x13verification_status\x18\x04 \x01(\tR\x12verificationStatus\"3\n" +
+	"\x1dListAddressBookEntriesRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"U\n" +
+	"\x1eListAddressBookEntriesResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.bonding.AddressBookEntryR\aentries\"k\n" +
+	"\x1dDeleteAddressBookEntryRequest\x12J\n" +
+	"\aaddress\x18\x01 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\aaddress\":\n" +
+	"\x1eDeleteAddressBookEntryResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\xfb\x01\n" +
+	"\x17SetTrancheLimitsRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12Z\n" +
+	"\x0eissuer_address\x18\x03 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\rissuerAddress\x12%\n" +
+	"\x0emin_investment\x18\x04 \x01(\tR\rminInvestment\x12%\n" +
+	"\x0emax_investment\x18\x05 \x01(\tR\rmaxInvestment\"\xd6\x01\n" +
+	"\x13ExportLedgerRequest\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\x03R\tperiodEnd\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x17\n" +
+	"\abond_id\x18\x04 \x01(\tR\x06bondId\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\x05R\bdecimals\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05store\x18\a \x01(\bR\x05store\"\xd4\x01\n" +
+	"\x14ExportLedgerResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1f\n" +
+	"\ventry_count\x18\x04 \x01(\x05R\n" +
+	"entryCount\x12\x1f\n" +
+	"\vdocument_id\x18\x05 \x01(\x04R\n" +
+	"documentId\x12!\n" +
+	"\fdownload_url\x18\x06 \x01(\tR\vdownloadUrl\"Y\n" +
+	"\x15GetDocumentURLRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x04R\n" +
+	"documentId\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"\xe8\x01\n" +
+	"\x16GetDocumentURLResponse\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x04R\n" +
+	"documentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12$\n" +
+	"\x0eurl_expires_at\x18\b \x01(\x03R\furlExpiresAt\"\x85\x03\n" +
+	"\fCategoryInfo\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x04 \x01(\x01R\n" +
+	"multiplier\x12+\n" +
+	"\x11obsolescence_risk\x18\x05 \x01(\bR\x10obsolescenceRisk\x12\x18\n" +
+	"\aaliases\x18\x06 \x03(\tR\aaliases\x121\n" +
+	"\x14effective_multiplier\x18\a \x01(\x01R\x13effectiveMultiplier\x12>\n" +
+	"\x1beffective_obsolescence_risk\x18\b \x01(\bR\x19effectiveObsolescenceRisk\x12#\n" +
+	"\rexpiry_policy\x18\t \x01(\tR\fexpiryPolicy\x126\n" +
+	"\x17effective_expiry_policy\x18\n" +
+	" \x01(\tR\x15effectiveExpiryPolicy\"\xe3\x01\n" +
+	"\x15UpsertCategoryRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x04 \x01(\x01R\n" +
+	"multiplier\x12+\n" +
+	"\x11obsolescence_risk\x18\x05 \x01(\bR\x10obsolescenceRisk\x12\x18\n" +
+	"\aaliases\x18\x06 \x03(\tR\aaliases\x12#\n" +
+	"\rexpiry_policy\x18\a \x01(\tR\fexpiryPolicy\"/\n" +
+	"\x15ListCategoriesRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"O\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.bonding.CategoryInfoR\n" +
+	"categories\"+\n" +
+	"\x15DeleteCategoryRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"l\n" +
+	"\x19ReplaceTransactionRequest\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12 \n" +
+	"\ffee_bump_bps\x18\x03 \x01(\x05R\n" +
+	"feeBumpBps\"\x88\x02\n" +
+	"\x1aReplaceTransactionResponse\x12(\n" +
+	"\x10original_tx_hash\x18\x01 \x01(\tR\x0eoriginalTxHash\x12.\n" +
+	"\x13replacement_tx_hash\x18\x02 \x01(\tR\x11replacementTxHash\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\x12\x1b\n" +
+	"\tgas_price\x18\x04 \x01(\tR\bgasPrice\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\x05 \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\x06 \x01(\tR\x14maxPriorityFeePerGas\"N\n" +
+	"\x1eListPendingTransactionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\"b\n" +
+	"\x1fListPendingTransactionsResponse\x12?\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1b.bonding.PendingTransactionR\ftransactions\"\x8f\x02\n" +
+	"\x12PendingTransaction\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x17\n" +
+	"\abond_id\x18\x04 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\x04R\x05nonce\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\fsubmitted_at\x18\a \x01(\x03R\vsubmittedAt\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\b \x01(\x03R\tcheckedAt\x12'\n" +
+	"\x0fpending_seconds\x18\t \x01(\x03R\x0ependingSeconds\"K\n" +
+	"\x1eGetReconciliationReportRequest\x12\x10\n" +
+	"\x03run\x18\x01 \x01(\bR\x03run\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\"\xfd\x01\n" +
+	"\x14ReconciliationReport\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x02 \x01(\x03R\n" +
+	"finishedAt\x12#\n" +
+	"\rbonds_checked\x18\x03 \x01(\x05R\fbondsChecked\x12!\n" +
+	"\fbonds_failed\x18\x04 \x01(\x05R\vbondsFailed\x12!\n" +
+	"\fauto_correct\x18\x05 \x01(\bR\vautoCorrect\x12:\n" +
+	"\rdiscrepancies\x18\x06 \x03(\v2\x14.bonding.DiscrepancyR\rdiscrepancies\"\xc2\x01\n" +
+	"\vDiscrepancy\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x03 \x01(\x05R\ttrancheId\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x16\n" +
+	"\x06stored\x18\x05 \x01(\tR\x06stored\x12\x19\n" +
+	"\bon_chain\x18\x06 \x01(\tR\aonChain\x12\x1c\n" +
+	"\tcorrected\x18\a \x01(\bR\tcorrected\"J\n" +
+	"\x19GenerateProspectusRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05store\x18\x02 \x01(\bR\x05store\"\xb9\x01\n" +
+	"\x1aGenerateProspectusResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1f\n" +
+	"\vdocument_id\x18\x04 \x01(\x04R\n" +
+	"documentId\x12!\n" +
+	"\fdownload_url\x18\x05 \x01(\tR\vdownloadUrl\"5\n" +
+	"\x1aGetCounterpartyRiskRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\"\xff\x01\n" +
+	"\x1bGetCounterpartyRiskResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x125\n" +
+	"\tlicensees\x18\x02 \x03(\v2\x17.bonding.LicenseeCreditR\tlicensees\x12$\n" +
+	"\rconcentration\x18\x03 \x01(\x01R\rconcentration\x12\x1b\n" +
+	"\ttop_share\x18\x04 \x01(\x01R\btopShare\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05rated\x18\x06 \x01(\bR\x05rated\x12!\n" +
+	"\frisk_factors\x18\a \x03(\tR\vriskFactors\"\x84\x02\n" +
+	"\x0eLicenseeCredit\x12\x1a\n" +
+	"\blicensee\x18\x01 \x01(\tR\blicensee\x12\x1f\n" +
+	"\vamount_paid\x18\x02 \x01(\tR\n" +
+	"amountPaid\x12\x14\n" +
+	"\x05share\x18\x03 \x01(\x01R\x05share\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05rated\x18\x05 \x01(\bR\x05rated\x12\x1a\n" +
+	"\bpayments\x18\x06 \x01(\x05R\bpayments\x12\x17\n" +
+	"\aon_time\x18\a \x01(\x05R\x06onTime\x12\x12\n" +
+	"\x04late\x18\b \x01(\x05R\x04late\x12*\n" +
+	"\x11average_days_late\x18\t \x01(\x01R\x0faverageDaysLate\"4\n" +
+	"\x19GetRevenueVarianceRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\"\xc5\x02\n" +
+	"\x1aGetRevenueVarianceResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x128\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1e.bonding.RevenueVariancePeriodR\aperiods\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\x121\n" +
+	"\x14consecutive_breaches\x18\x04 \x01(\x05R\x13consecutiveBreaches\x12'\n" +
+	"\x0freview_required\x18\x05 \x01(\bR\x0ereviewRequired\x12(\n" +
+	"\x10rating_review_at\x18\x06 \x01(\x03R\x0eratingReviewAt\x120\n" +
+	"\x14rating_review_reason\x18\a \x01(\tR\x12ratingReviewReason\"\xdd\x01\n" +
+	"\x15RevenueVariancePeriod\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\x03R\tperiodEnd\x12\x1a\n" +
+	"\bforecast\x18\x03 \x01(\tR\bforecast\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\tR\x06actual\x12\x1a\n" +
+	"\bvariance\x18\x05 \x01(\x01R\bvariance\x12\x16\n" +
+	"\x06closed\x18\x06 \x01(\bR\x06closed\x12\x1a\n" +
+	"\bbreached\x18\a \x01(\bR\bbreached\"\xf8\x02\n" +
+	"\x19ValidateIssueBondResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x120\n" +
+	"\x06errors\x18\x02 \x03(\v2\x18.bonding.IssuanceProblemR\x06errors\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05chain\x18\x04 \x01(\tR\x05chain\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12#\n" +
+	"\rexpiry_policy\x18\x06 \x01(\tR\fexpiryPolicy\x12,\n" +
+	"\x12license_expires_at\x18\a \x01(\x03R\x10licenseExpiresAt\x120\n" +
+	"\btranches\x18\b \x03(\v2\x14.bonding.TrancheInfoR\btranches\x12@\n" +
+	"\x0frisk_assessment\x18\t \x01(\v2\x17.bonding.RiskAssessmentR\x0eriskAssessment\"S\n" +
+	"\x0fIssuanceProblem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\"\xf7\x02\n" +
+	"\x0eRiskAssessment\x12#\n" +
+	"\rvaluation_usd\x18\x01 \x01(\x01R\fvaluationUsd\x12)\n" +
+	"\x10confidence_score\x18\x02 \x01(\x01R\x0fconfidenceScore\x12\x1f\n" +
+	"\vrisk_rating\x18\x03 \x01(\tR\n" +
+	"riskRating\x12/\n" +
+	"\x13default_probability\x18\x04 \x01(\x01R\x12defaultProbability\x12'\n" +
+	"\x0frecommended_ltv\x18\x05 \x01(\x01R\x0erecommendedLtv\x12!\n" +
+	"\frisk_factors\x18\x06 \x03(\tR\vriskFactors\x12\x14\n" +
+	"\x05model\x18\a \x01(\tR\x05model\x12#\n" +
+	"\rmodel_version\x18\b \x01(\tR\fmodelVersion\x12\x1f\n" +
+	"\vassessed_at\x18\t \x01(\x03R\n" +
+	"assessedAt\x12\x1b\n" +
+	"\trisk_tier\x18\n" +
+	" \x01(\tR\briskTier\"\xd3\x01\n" +
+	"\x1bEstimateIssuanceCostRequest\x125\n" +
+	"\bissuance\x18\x01 \x01(\v2\x19.bonding.IssueBondRequestR\bissuance\x12E\n" +
+	"\fdistribution\x18\x02 \x01(\v2!.bonding.DistributeRevenueRequestR\fdistribution\x126\n" +
+	"\n" +
+	"investment\x18\x03 \x01(\v2\x16.bonding.InvestRequestR\n" +
+	"investment\"\xbe\x03\n" +
+	"\x1cEstimateIssuanceCostResponse\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1b\n" +
+	"\tgas_limit\x18\x03 \x01(\x04R\bgasLimit\x12\x1b\n" +
+	"\tgas_price\x18\x04 \x01(\tR\bgasPrice\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\tR\x03fee\x12\x17\n" +
+	"\afee_usd\x18\x06 \x01(\x01R\x06feeUsd\x12\x1d\n" +
+	"\n" +
+	"native_usd\x18\a \x01(\x01R\tnativeUsd\x12(\n" +
+	"\x10price_updated_at\x18\b \x01(\x03R\x0epriceUpdatedAt\x12\x1b\n" +
+	"\tquoted_at\x18\t \x01(\x03R\bquotedAt\x12\x1f\n" +
+	"\vvalid_until\x18\n" +
+	" \x01(\x03R\n" +
+	"validUntil\x12\x1a\n" +
+	"\breverted\x18\v \x01(\bR\breverted\x12#\n" +
+	"\rrevert_reason\x18\f \x01(\tR\frevertReason\x12\x16\n" +
+	"\x06sender\x18\r \x01(\tR\x06sender\x12+\n" +
+	"\x11balance_shortfall\x18\x0e \x01(\tR\x10balanceShortfall\"\x81\x01\n" +
+	"\x19GetInvestmentQuoteRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\rR\ttrancheId\x12,\n" +
+	"\x06amount\x18\x03 \x01(\tB\x14\xbaH\x11r\x0f2\r^[1-9][0-9]*$R\x06amount\"\xa7\x04\n" +
+	"\x1aGetInvestmentQuoteResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\rR\ttrancheId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12-\n" +
+	"\x12remaining_capacity\x18\x04 \x01(\tR\x11remainingCapacity\x12\x10\n" +
+	"\x03apy\x18\x05 \x01(\x01R\x03apy\x12#\n" +
+	"\reffective_apy\x18\x06 \x01(\x01R\feffectiveApy\x12?\n" +
+	"\x0fcoupon_schedule\x18\a \x03(\v2\x16.bonding.CouponPaymentR\x0ecouponSchedule\x12\x12\n" +
+	"\x04fees\x18\b \x01(\tR\x04fees\x12+\n" +
+	"\x11projected_coupons\x18\t \x01(\tR\x10projectedCoupons\x12)\n" +
+	"\x10projected_return\x18\n" +
+	" \x01(\tR\x0fprojectedReturn\x12\x18\n" +
+	"\aarrears\x18\v \x01(\tR\aarrears\x12#\n" +
+	"\rmaturity_date\x18\f \x01(\x03R\fmaturityDate\x12\x1b\n" +
+	"\tquoted_at\x18\r \x01(\x03R\bquotedAt\x12\x1f\n" +
+	"\vvalid_until\x18\x0e \x01(\x03R\n" +
+	"validUntil\x12)\n" +
+	"\x10accrued_interest\x18\x0f \x01(\tR\x0faccruedInterest\";\n" +
+	"\rCouponPayment\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\x03R\x04date\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"'\n" +
+	"\x0fGetUsageRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\"\xcb\x02\n" +
+	"\x10GetUsageResponse\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12%\n" +
+	"\x04keys\x18\x03 \x03(\v2\x11.bonding.KeyUsageR\x04keys\x127\n" +
+	"\foracle_spend\x18\x04 \x03(\v2\x14.bonding.OracleSpendR\voracleSpend\x12(\n" +
+	"\x10oracle_spend_usd\x18\x05 \x01(\tR\x0eoracleSpendUsd\x12<\n" +
+	"\x1boracle_daily_soft_limit_usd\x18\x06 \x01(\tR\x17oracleDailySoftLimitUsd\x12<\n" +
+	"\x1boracle_daily_hard_limit_usd\x18\a \x01(\tR\x17oracleDailyHardLimitUsd\"\xb8\x02\n" +
+	"\bKeyUsage\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\x04 \x01(\x01R\terrorRate\x12#\n" +
+	"\rrequest_bytes\x18\x05 \x01(\x03R\frequestBytes\x12%\n" +
+	"\x0eresponse_bytes\x18\x06 \x01(\x03R\rresponseBytes\x12#\n" +
+	"\rmonthly_quota\x18\a \x01(\x03R\fmonthlyQuota\x12'\n" +
+	"\x0fremaining_calls\x18\b \x01(\x03R\x0eremainingCalls\x12.\n" +
+	"\amethods\x18\t \x03(\v2\x14.bonding.MethodUsageR\amethods\"\x9f\x01\n" +
+	"\vMethodUsage\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12#\n" +
+	"\rrequest_bytes\x18\x04 \x01(\x03R\frequestBytes\x12%\n" +
+	"\x0eresponse_bytes\x18\x05 \x01(\x03R\rresponseBytes\"l\n" +
+	"\vOracleSpend\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05calls\x18\x03 \x01(\x03R\x05calls\x12\x19\n" +
+	"\bcost_usd\x18\x04 \x01(\tR\acostUsd\"j\n" +
+	"\x1aScheduleMaintenanceRequest\x12\x1b\n" +
+	"\tstarts_at\x18\x01 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x02 \x01(\x03R\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x11MaintenanceWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x03 \x01(\x03R\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\fannounced_at\x18\x05 \x01(\x03R\vannouncedAt\"*\n" +
+	"\x18CancelMaintenanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x1b\n" +
+	"\x19CancelMaintenanceResponse\"\x17\n" +
+	"\x15GetMaintenanceRequest\"\x84\x01\n" +
+	"\x16GetMaintenanceResponse\x122\n" +
+	"\x06active\x18\x01 \x01(\v2\x1a.bonding.MaintenanceWindowR\x06active\x126\n" +
+	"\bupcoming\x18\x02 \x03(\v2\x1a.bonding.MaintenanceWindowR\bupcoming\"w\n" +
+	"\x13AssessIPRiskRequest\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.bonding.IPMetadataR\bmetadata\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\"\x88\x02\n" +
+	"\n" +
+	"IPMetadata\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\\\n" +
+	"\x0fcreator_address\x18\x02 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0ecreatorAddress\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x14\n" +
+	"\x05views\x18\x04 \x01(\x05R\x05views\x12\x14\n" +
+	"\x05likes\x18\x05 \x01(\x05R\x05likes\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12!\n" +
+	"\fcontent_hash\x18\a \x01(\tR\vcontentHash\"\xd5\x01\n" +
+	"\x14AssessIPRiskResponse\x127\n" +
+	"\n" +
+	"assessment\x18\x01 \x01(\v2\x17.bonding.RiskAssessmentR\n" +
+	"assessment\x12B\n" +
+	"\x10comparable_sales\x18\x02 \x03(\v2\x17.bonding.ComparableSaleR\x0fcomparableSales\x12@\n" +
+	"\x0fmarket_analysis\x18\x03 \x01(\v2\x17.bonding.MarketAnalysisR\x0emarketAnalysis\"}\n" +
+	"\x18AssessIPRiskBatchRequest\x12?\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.bonding.AssessIPRiskRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\x05items\x12 \n" +
+	"\vconcurrency\x18\x02 \x01(\x05R\vconcurrency\"\x8d\x01\n" +
+	"\x19AssessIPRiskBatchResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .bonding.AssessIPRiskBatchResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x95\x01\n" +
+	"\x17AssessIPRiskBatchResult\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x125\n" +
+	"\x06result\x18\x02 \x01(\v2\x1d.bonding.AssessIPRiskResponseR\x06result\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa7\x01\n" +
+	"\x0eComparableSale\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\"\xbb\x01\n" +
+	"\x0eMarketAnalysis\x12\x1b\n" +
+	"\tavg_price\x18\x01 \x01(\x01R\bavgPrice\x12!\n" +
+	"\fmedian_price\x18\x02 \x01(\x01R\vmedianPrice\x12\x1f\n" +
+	"\vprice_trend\x18\x03 \x01(\x01R\n" +
+	"priceTrend\x12\x1f\n" +
+	"\vtotal_sales\x18\x04 \x01(\x05R\n" +
+	"totalSales\x12'\n" +
+	"\x0fliquidity_score\x18\x05 \x01(\x01R\x0eliquidityScore\"\x17\n" +
+	"\x15ListRiskModelsRequest\"\x8e\x02\n" +
+	"\x16ListRiskModelsResponse\x12.\n" +
+	"\x06models\x18\x01 \x03(\v2\x16.bonding.RiskModelInfoR\x06models\x12#\n" +
+	"\rdefault_model\x18\x02 \x01(\tR\fdefaultModel\x12\\\n" +
+	"\x0fcategory_models\x18\x03 \x03(\v23.bonding.ListRiskModelsResponse.CategoryModelsEntryR\x0ecategoryModels\x1aA\n" +
+	"\x13CategoryModelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
+	"\rRiskModelInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x121\n" +
+	"\x14supported_categories\x18\x03 \x03(\tR\x13supportedCategories\"\\\n" +
+	"\x16GetBondTimelineRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"d\n" +
+	"\x17GetBondTimelineResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x120\n" +
+	"\aentries\x18\x02 \x03(\v2\x16.bonding.TimelineEntryR\aentries\"\xe9\x01\n" +
+	"\rTimelineEntry\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\x03R\n" +
+	"occurredAt\x12\x17\n" +
+	"\atx_hash\x18\x03 \x01(\tR\x06txHash\x12!\n" +
+	"\fblock_number\x18\x04 \x01(\x04R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x05 \x01(\x05R\ttrancheId\x12\x18\n" +
+	"\aaccount\x18\x06 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\"\x95\x01\n" +
+	"\x1aGetClaimableAmountsRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12^\n" +
+	"\x10investor_address\x18\x02 \x01(\tB3\xbaH0\xd8\x01\x01r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\"\x93\x01\n" +
+	"\x1bGetClaimableAmountsResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x122\n" +
+	"\aamounts\x18\x02 \x03(\v2\x18.bonding.ClaimableAmountR\aamounts\x12'\n" +
+	"\x0ftotal_claimable\x18\x03 \x01(\tR\x0etotalClaimable\"\xda\x01\n" +
+	"\x0fClaimableAmount\x12)\n" +
+	"\x10investor_address\x18\x01 \x01(\tR\x0finvestorAddress\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12\x1a\n" +
+	"\bentitled\x18\x03 \x01(\tR\bentitled\x12\x18\n" +
+	"\aclaimed\x18\x04 \x01(\tR\aclaimed\x12\x1c\n" +
+	"\tclaimable\x18\x05 \x01(\tR\tclaimable\x12)\n" +
+	"\x10accrued_interest\x18\x06 \x01(\tR\x0faccruedInterest\"\xaa\x01\n" +
+	"\x13PrepareClaimRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12[\n" +
+	"\x10investor_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\"m\n" +
+	"\x14PrepareClaimResponse\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\x03R\achainId\"q\n" +
+	"\x1fGetRiskAssessmentHistoryRequest\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"x\n" +
+	" GetRiskAssessmentHistoryResponse\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x129\n" +
+	"\vassessments\x18\x02 \x03(\v2\x17.bonding.RiskAssessmentR\vassessments\"\xb8\x01\n" +
+	"\x1aListRiskAssessmentsRequest\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x9b\x01\n" +
+	"\x1bListRiskAssessmentsResponse\x12\x19\n" +
+	"\bipnft_id\x18\x01 \x01(\tR\aipnftId\x129\n" +
+	"\vassessments\x18\x02 \x03(\v2\x17.bonding.RiskAssessmentR\vassessments\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"M\n" +
+	"\x1cRecordComparableSalesRequest\x12-\n" +
+	"\x05sales\x18\x01 \x03(\v2\x17.bonding.ComparableSaleR\x05sales\"[\n" +
+	"\x1dRecordComparableSalesResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\x05R\brecorded\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x05R\n" +
+	"duplicates\"\x8f\x01\n" +
+	"\vStressShock\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12%\n" +
+	"\x0erevenue_change\x18\x03 \x01(\x01R\rrevenueChange\x12)\n" +
+	"\x10valuation_change\x18\x04 \x01(\x01R\x0fvaluationChange\"j\n" +
+	"\x11StressTestRequest\x12,\n" +
+	"\x06shocks\x18\x01 \x03(\v2\x14.bonding.StressShockR\x06shocks\x12'\n" +
+	"\x0flookback_months\x18\x02 \x01(\x05R\x0elookbackMonths\"\xfe\x01\n" +
+	"\x13StressTrancheResult\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\x05R\ttrancheId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"coupon_due\x18\x04 \x01(\tR\tcouponDue\x12\x12\n" +
+	"\x04paid\x18\x05 \x01(\tR\x04paid\x12\x1c\n" +
+	"\tshortfall\x18\x06 \x01(\tR\tshortfall\x12\x1a\n" +
+	"\bcoverage\x18\a \x01(\x01R\bcoverage\x12+\n" +
+	"\x11baseline_coverage\x18\b \x01(\x01R\x10baselineCoverage\"\xff\x03\n" +
+	"\x10StressBondResult\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06shocks\x18\x03 \x03(\tR\x06shocks\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\tR\tprincipal\x12)\n" +
+	"\x10baseline_revenue\x18\x05 \x01(\tR\x0fbaselineRevenue\x12)\n" +
+	"\x10stressed_revenue\x18\x06 \x01(\tR\x0fstressedRevenue\x12'\n" +
+	"\x0fbaseline_health\x18\a \x01(\tR\x0ebaselineHealth\x12'\n" +
+	"\x0fstressed_health\x18\b \x01(\tR\x0estressedHealth\x12\x1a\n" +
+	"\bassessed\x18\t \x01(\bR\bassessed\x12@\n" +
+	"\x1cbaseline_default_probability\x18\n" +
+	" \x01(\x01R\x1abaselineDefaultProbability\x12@\n" +
+	"\x1cstressed_default_probability\x18\v \x01(\x01R\x1astressedDefaultProbability\x128\n" +
+	"\btranches\x18\f \x03(\v2\x1c.bonding.StressTrancheResultR\btranches\"\xc9\x03\n" +
+	"\x10StressTestReport\x12/\n" +
+	"\x05bonds\x18\x01 \x03(\v2\x19.bonding.StressBondResultR\x05bonds\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\x05R\ahealthy\x12\x1a\n" +
+	"\bstressed\x18\x03 \x01(\x05R\bstressed\x12\x1e\n" +
+	"\n" +
+	"distressed\x18\x04 \x01(\x05R\n" +
+	"distressed\x12)\n" +
+	"\x10newly_distressed\x18\x05 \x01(\x05R\x0fnewlyDistressed\x12<\n" +
+	"\x1abaseline_expected_defaults\x18\x06 \x01(\x01R\x18baselineExpectedDefaults\x12+\n" +
+	"\x11expected_defaults\x18\a \x01(\x01R\x10expectedDefaults\x124\n" +
+	"\x16baseline_expected_loss\x18\b \x01(\tR\x14baselineExpectedLoss\x12#\n" +
+	"\rexpected_loss\x18\t \x01(\tR\fexpectedLoss\x12\x1c\n" +
+	"\tshortfall\x18\n" +
+	" \x01(\tR\tshortfall\x12\x1f\n" +
+	"\vcomputed_at\x18\v \x01(\x03R\n" +
+	"computedAt\"\xae\x01\n" +
+	"\x17GetPositionProofRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x02 \x01(\x05R\ttrancheId\x12[\n" +
+	"\x10investor_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0finvestorAddress\"\x9a\x02\n" +
+	"\rPositionProof\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12\x12\n" +
+	"\x04root\x18\x03 \x01(\tR\x04root\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\tR\x06txHash\x12!\n" +
+	"\fcommitted_at\x18\x05 \x01(\x03R\vcommittedAt\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x06 \x01(\x05R\ttrancheId\x12)\n" +
+	"\x10investor_address\x18\a \x01(\tR\x0finvestorAddress\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12\x12\n" +
+	"\x04leaf\x18\t \x01(\tR\x04leaf\x12\x14\n" +
+	"\x05proof\x18\n" +
+	" \x03(\tR\x05proof\"\x9f\x01\n" +
+	"\x0fAccessListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12\x12\n" +
+	"\x04list\x18\x03 \x01(\tR\x04list\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xac\x01\n" +
+	"\x19AddAccessListEntryRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\x12J\n" +
+	"\aaddress\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\aaddress\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x97\x01\n" +
+	"\x1cRemoveAccessListEntryRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\x12J\n" +
+	"\aaddress\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\aaddress\"\x1f\n" +
+	"\x1dRemoveAccessListEntryResponse\"K\n" +
+	"\x1cListAccessListEntriesRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\"S\n" +
+	"\x1dListAccessListEntriesResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.bonding.AccessListEntryR\aentries\"\xc6\x01\n" +
+	"\x14QueryAuditLogRequest\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x17\n" +
+	"\abond_id\x18\x03 \x01(\tR\x06bondId\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\"I\n" +
+	"\x15QueryAuditLogResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.bonding.AuditLogEntryR\aentries\"\xdf\x05\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\tR\tprincipal\x12\x1f\n" +
+	"\vauth_method\x18\x04 \x01(\tR\n" +
+	"authMethod\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12%\n" +
+	"\x0erequest_digest\x18\a \x01(\tR\rrequestDigest\x12\x12\n" +
+	"\x04code\x18\b \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1b\n" +
+	"\ttx_hashes\x18\n" +
+	" \x03(\tR\btxHashes\x12\x17\n" +
+	"\abond_id\x18\v \x01(\tR\x06bondId\x12,\n" +
+	"\x12bond_status_before\x18\f \x01(\tR\x10bondStatusBefore\x12*\n" +
+	"\x11bond_status_after\x18\r \x01(\tR\x0fbondStatusAfter\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x0e \x01(\x05R\ttrancheId\x12V\n" +
+	"\x10positions_before\x18\x0f \x03(\v2+.bonding.AuditLogEntry.PositionsBeforeEntryR\x0fpositionsBefore\x12S\n" +
+	"\x0fpositions_after\x18\x10 \x03(\v2*.bonding.AuditLogEntry.PositionsAfterEntryR\x0epositionsAfter\x1aB\n" +
+	"\x14PositionsBeforeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13PositionsAfterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x17ChangeBondStatusRequest\x12\x1f\n" +
+	"\abond_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06bondId\x12\x1e\n" +
+	"\x06reason\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06reason\"t\n" +
+	"\x18ChangeBondStatusResponse\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12'\n" +
+	"\x0fprevious_status\x18\x02 \x01(\tR\x0epreviousStatus\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\xa0\x02\n" +
+	"!RequestEmergencyWithdrawalRequest\x12\x1f\n" +
+	"\abond_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06bondId\x12[\n" +
+	"\x10recovery_address\x18\x02 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0frecoveryAddress\x12]\n" +
+	"\vreason_code\x18\x03 \x01(\tB<\xbaH9r7R\x10COMPROMISED_KEYSR\n" +
+	"LITIGATIONR\x10REGULATORY_ORDERR\x05FRAUDR\n" +
+	"reasonCode\x12\x1e\n" +
+	"\x06reason\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06reason\"\xc6\x01\n" +
+	"!ConfirmEmergencyWithdrawalRequest\x12#\n" +
+	"\rwithdrawal_id\x18\x01 \x01(\x04R\fwithdrawalId\x12\x1f\n" +
+	"\abond_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06bondId\x12[\n" +
+	"\x10recovery_address\x18\x03 \x01(\tB0\xbaH-r+2)^(0x[0-9a-fA-F]{40}|[^.\\s]+(\\.[^.\\s]+)+)$R\x0frecoveryAddress\"g\n" +
+	" CancelEmergencyWithdrawalRequest\x12#\n" +
+	"\rwithdrawal_id\x18\x01 \x01(\x04R\fwithdrawalId\x12\x1e\n" +
+	"\x06reason\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06reason\"R\n" +
+	"\x1fListEmergencyWithdrawalsRequest\x12\x17\n" +
+	"\abond_id\x18\x01 \x01(\tR\x06bondId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"b\n" +
+	" ListEmergencyWithdrawalsResponse\x12>\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x1c.bonding.EmergencyWithdrawalR\vwithdrawals\"\x9f\x03\n" +
+	"\x13EmergencyWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\abond_id\x18\x02 \x01(\tR\x06bondId\x12)\n" +
+	"\x10recovery_address\x18\x03 \x01(\tR\x0frecoveryAddress\x12\x1f\n" +
+	"\vreason_code\x18\x04 \x01(\tR\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\x12!\n" +
+	"\frequested_at\x18\b \x01(\x03R\vrequestedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vresolved_by\x18\n" +
+	" \x01(\tR\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\v \x01(\x03R\n" +
+	"resolvedAt\x12#\n" +
+	"\rcancel_reason\x18\f \x01(\tR\fcancelReason\x12\x17\n" +
+	"\atx_hash\x18\r \x01(\tR\x06txHash\"\xcb\x01\n" +
+	"\x15SetFeatureFlagRequest\x12L\n" +
+	"\x04name\x18\x01 \x01(\tB8\xbaH5r3R\x0econtract_callsR\x0eerc20_paymentsR\x11oracle_risk_modelR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x120\n" +
+	"\x0frollout_percent\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dR\x0erolloutPercent\x12\x18\n" +
+	"\atenants\x18\x04 \x03(\tR\atenants\"\x19\n" +
+	"\x17ListFeatureFlagsRequest\"F\n" +
+	"\x18ListFeatureFlagsResponse\x12*\n" +
+	"\x05flags\x18\x01 \x03(\v2\x14.bonding.FeatureFlagR\x05flags\"\x8c\x02\n" +
+	"\vFeatureFlag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12'\n" +
+	"\x0frollout_percent\x18\x04 \x01(\rR\x0erolloutPercent\x12\x18\n" +
+	"\atenants\x18\x05 \x03(\tR\atenants\x12,\n" +
+	"\x12enabled_for_caller\x18\x06 \x01(\bR\x10enabledForCaller\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt2\xe57\n" +
+	"\x0eBondingService\x12B\n" +
+	"\tIssueBond\x12\x19.bonding.IssueBondRequest\x1a\x1a.bonding.IssueBondResponse\x129\n" +
+	"\x06Invest\x12\x16.bonding.InvestRequest\x1a\x17.bonding.InvestResponse\x12H\n" +
+	"\vGetBondInfo\x12\x1b.bonding.GetBondInfoRequest\x1a\x1c.bonding.GetBondInfoResponse\x12Q\n" +
+	"\x0eGetTrancheInfo\x12\x1e.bonding.GetTrancheInfoRequest\x1a\x1f.bonding.GetTrancheInfoResponse\x12B\n" +
+	"\tListBonds\x12\x19.bonding.ListBondsRequest\x1a\x1a.bonding.ListBondsResponse\x12T\n" +
+	"\x0fListInvestments\x12\x1f.bonding.ListInvestmentsRequest\x1a .bonding.ListInvestmentsResponse\x12Z\n" +
+	"\x11DistributeRevenue\x12!.bonding.DistributeRevenueRequest\x1a\".bonding.DistributeRevenueResponse\x12Z\n" +
+	"\x11GetRevenueHistory\x12!.bonding.GetRevenueHistoryRequest\x1a\".bonding.GetRevenueHistoryResponse\x12]\n" +
+	"\x12GetPaymentSchedule\x12\".bonding.GetPaymentScheduleRequest\x1a#.bonding.GetPaymentScheduleResponse\x12T\n" +
+	"\x0fGetYieldHistory\x12\x1f.bonding.GetYieldHistoryRequest\x1a .bonding.GetYieldHistoryResponse\x12]\n" +
+	"\x16RequestEarlyRedemption\x12&.bonding.RequestEarlyRedemptionRequest\x1a\x1b.bonding.RedemptionResponse\x12S\n" +
+	"\x11ApproveRedemption\x12!.bonding.ApproveRedemptionRequest\x1a\x1b.bonding.RedemptionResponse\x12]\n" +
+	"\x12QueueDistributions\x12\".bonding.QueueDistributionsRequest\x1a#.bonding.QueueDistributionsResponse\x12a\n" +
+	"\x17SetDistributionSchedule\x12'.bonding.SetDistributionScheduleRequest\x1a\x1d.bonding.DistributionSchedule\x12^\n" +
+	"\x17GetDistributionSchedule\x12$.bonding.DistributionScheduleRequest\x1a\x1d.bonding.DistributionSchedule\x12`\n" +
+	"\x19PauseDistributionSchedule\x12$.bonding.DistributionScheduleRequest\x1a\x1d.bonding.DistributionSchedule\x12a\n" +
+	"\x1aResumeDistributionSchedule\x12$.bonding.DistributionScheduleRequest\x1a\x1d.bonding.DistributionSchedule\x12[\n" +
+	"\x14SkipDistributionRuns\x12$.bonding.SkipDistributionRunsRequest\x1a\x1d.bonding.DistributionSchedule\x12o\n" +
+	"\x1aDeleteDistributionSchedule\x12$.bonding.DistributionScheduleRequest\x1a+.bonding.DeleteDistributionScheduleResponse\x12W\n" +
+	"\x10ImportRevenueCSV\x12 .bonding.ImportRevenueCSVRequest\x1a!.bonding.ImportRevenueCSVResponse\x12`\n" +
+	"\x13ReviewRevenueEvents\x12#.bonding.ReviewRevenueEventsRequest\x1a$.bonding.ReviewRevenueEventsResponse\x12Z\n" +
+	"\x11ListRevenueEvents\x12!.bonding.ListRevenueEventsRequest\x1a\".bonding.ListRevenueEventsResponse\x12c\n" +
+	"\x14ListAccrualSnapshots\x12$.bonding.ListAccrualSnapshotsRequest\x1a%.bonding.ListAccrualSnapshotsResponse\x12]\n" +
+	"\x12TransferInvestment\x12\".bonding.TransferInvestmentRequest\x1a#.bonding.TransferInvestmentResponse\x12Q\n" +
+	"\x0eGetChainStatus\x12\x1e.bonding.GetChainStatusRequest\x1a\x1f.bonding.GetChainStatusResponse\x12l\n" +
+	"\x17PreparePermitInvestment\x12'.bonding.PreparePermitInvestmentRequest\x1a(.bonding.PreparePermitInvestmentResponse\x12W\n" +
+	"\x10InvestWithPermit\x12 .bonding.InvestWithPermitRequest\x1a!.bonding.InvestWithPermitResponse\x12<\n" +
+	"\n" +
+	"PlaceOrder\x12\x1a.bonding.PlaceOrderRequest\x1a\x12.bonding.OrderInfo\x12E\n" +
+	"\n" +
+	"ListOrders\x12\x1a.bonding.ListOrdersRequest\x1a\x1b.bonding.ListOrdersResponse\x12W\n" +
+	"\x10PrepareFillOrder\x12 .bonding.PrepareFillOrderRequest\x1a!.bonding.PrepareFillOrderResponse\x12B\n" +
+	"\tFillOrder\x12\x19.bonding.FillOrderRequest\x1a\x1a.bonding.FillOrderResponse\x12>\n" +
+	"\vCancelOrder\x12\x1b.bonding.CancelOrderRequest\x1a\x12.bonding.OrderInfo\x12[\n" +
+	"\x16UpsertAddressBookEntry\x12&.bonding.UpsertAddressBookEntryRequest\x1a\x19.bonding.AddressBookEntry\x12i\n" +
+	"\x16ListAddressBookEntries\x12&.bonding.ListAddressBookEntriesRequest\x1a'.bonding.ListAddressBookEntriesResponse\x12i\n" +
+	"\x16DeleteAddressBookEntry\x12&.bonding.DeleteAddressBookEntryRequest\x1a'.bonding.DeleteAddressBookEntryResponse\x12J\n" +
+	"\x10SetTrancheLimits\x12 .bonding.SetTrancheLimitsRequest\x1a\x14.bonding.TrancheInfo\x12K\n" +
+	"\fExportLedger\x12\x1c.bonding.ExportLedgerRequest\x1a\x1d.bonding.ExportLedgerResponse\x12Q\n" +
+	"\x0eGetDocumentURL\x12\x1e.bonding.GetDocumentURLRequest\x1a\x1f.bonding.GetDocumentURLResponse\x12G\n" +
+	"\x0eUpsertCategory\x12\x1e.bonding.UpsertCategoryRequest\x1a\x15.bonding.CategoryInfo\x12Q\n" +
+	"\x0eListCategories\x12\x1e.bonding.ListCategoriesRequest\x1a\x1f.bonding.ListCategoriesResponse\x12Q\n" +
+	"\x0eDeleteCategory\x12\x1e.bonding.DeleteCategoryRequest\x1a\x1f.bonding.DeleteCategoryResponse\x12]\n" +
+	"\x12SpeedUpTransaction\x12\".bonding.ReplaceTransactionRequest\x1a#.bonding.ReplaceTransactionResponse\x12\\\n" +
+	"\x11CancelTransaction\x12\".bonding.ReplaceTransactionRequest\x1a#.bonding.ReplaceTransactionResponse\x12l\n" +
+	"\x17ListPendingTransactions\x12'.bonding.ListPendingTransactionsRequest\x1a(.bonding.ListPendingTransactionsResponse\x12a\n" +
+	"\x17GetReconciliationReport\x12'.bonding.GetReconciliationReportRequest\x1a\x1d.bonding.ReconciliationReport\x12]\n" +
+	"\x12GenerateProspectus\x12\".bonding.GenerateProspectusRequest\x1a#.bonding.GenerateProspectusResponse\x12`\n" +
+	"\x13GetCounterpartyRisk\x12#.bonding.GetCounterpartyRiskRequest\x1a$.bonding.GetCounterpartyRiskResponse\x12]\n" +
+	"\x12GetRevenueVariance\x12\".bonding.GetRevenueVarianceRequest\x1a#.bonding.GetRevenueVarianceResponse\x12R\n" +
+	"\x11ValidateIssueBond\x12\x19.bonding.IssueBondRequest\x1a\".bonding.ValidateIssueBondResponse\x12c\n" +
+	"\x14EstimateIssuanceCost\x12$.bonding.EstimateIssuanceCostRequest\x1a%.bonding.EstimateIssuanceCostResponse\x12]\n" +
+	"\x12GetInvestmentQuote\x12\".bonding.GetInvestmentQuoteRequest\x1a#.bonding.GetInvestmentQuoteResponse\x12?\n" +
+	"\bGetUsage\x12\x18.bonding.GetUsageRequest\x1a\x19.bonding.GetUsageResponse\x12V\n" +
+	"\x13ScheduleMaintenance\x12#.bonding.ScheduleMaintenanceRequest\x1a\x1a.bonding.MaintenanceWindow\x12Z\n" +
+	"\x11CancelMaintenance\x12!.bonding.CancelMaintenanceRequest\x1a\".bonding.CancelMaintenanceResponse\x12Q\n" +
+	"\x0eGetMaintenance\x12\x1e.bonding.GetMaintenanceRequest\x1a\x1f.bonding.GetMaintenanceResponse\x12K\n" +
+	"\fAssessIPRisk\x12\x1c.bonding.AssessIPRiskRequest\x1a\x1d.bonding.AssessIPRiskResponse\x12Z\n" +
+	"\x11AssessIPRiskBatch\x12!.bonding.AssessIPRiskBatchRequest\x1a\".bonding.AssessIPRiskBatchResponse\x12Q\n" +
+	"\x0eListRiskModels\x12\x1e.bonding.ListRiskModelsRequest\x1a\x1f.bonding.ListRiskModelsResponse\x12T\n" +
+	"\x0fGetBondTimeline\x12\x1f.bonding.GetBondTimelineRequest\x1a .bonding.GetBondTimelineResponse\x12`\n" +
+	"\x13GetClaimableAmounts\x12#.bonding.GetClaimableAmountsRequest\x1a$.bonding.GetClaimableAmountsResponse\x12K\n" +
+	"\fPrepareClaim\x12\x1c.bonding.PrepareClaimRequest\x1a\x1d.bonding.PrepareClaimResponse\x12o\n" +
+	"\x18GetRiskAssessmentHistory\x12(.bonding.GetRiskAssessmentHistoryRequest\x1a).bonding.GetRiskAssessmentHistoryResponse\x12`\n" +
+	"\x13ListRiskAssessments\x12#.bonding.ListRiskAssessmentsRequest\x1a$.bonding.ListRiskAssessmentsResponse\x12f\n" +
+	"\x15RecordComparableSales\x12%.bonding.RecordComparableSalesRequest\x1a&.bonding.RecordComparableSalesResponse\x12C\n" +
+	"\n" +
+	"StressTest\x12\x1a.bonding.StressTestRequest\x1a\x19.bonding.StressTestReport\x12L\n" +
+	"\x10GetPositionProof\x12 .bonding.GetPositionProofRequest\x1a\x16.bonding.PositionProof\x12R\n" +
+	"\x12AddAccessListEntry\x12\".bonding.AddAccessListEntryRequest\x1a\x18.bonding.AccessListEntry\x12f\n" +
+	"\x15RemoveAccessListEntry\x12%.bonding.RemoveAccessListEntryRequest\x1a&.bonding.RemoveAccessListEntryResponse\x12f\n" +
+	"\x15ListAccessListEntries\x12%.bonding.ListAccessListEntriesRequest\x1a&.bonding.ListAccessListEntriesResponse\x12N\n" +
+	"\rQueryAuditLog\x12\x1d.bonding.QueryAuditLogRequest\x1a\x1e.bonding.QueryAuditLogResponse\x12P\n" +
+	"\tPauseBond\x12 .bonding.ChangeBondStatusRequest\x1a!.bonding.ChangeBondStatusResponse\x12Q\n" +
+	"\n" +
+	"FreezeBond\x12 .bonding.ChangeBondStatusRequest\x1a!.bonding.ChangeBondStatusResponse\x12Q\n" +
+	"\n" +
+	"CancelBond\x12 .bonding.ChangeBondStatusRequest\x1a!.bonding.ChangeBondStatusResponse\x12Q\n" +
+	"\n" +
+	"ResumeBond\x12 .bonding.ChangeBondStatusRequest\x1a!.bonding.ChangeBondStatusResponse\x12f\n" +
+	"\x1aRequestEmergencyWithdrawal\x12*.bonding.RequestEmergencyWithdrawalRequest\x1a\x1c.bonding.EmergencyWithdrawal\x12f\n" +
+	"\x1aConfirmEmergencyWithdrawal\x12*.bonding.ConfirmEmergencyWithdrawalRequest\x1a\x1c.bonding.EmergencyWithdrawal\x12d\n" +
+	"\x19CancelEmergencyWithdrawal\x12).bonding.CancelEmergencyWithdrawalRequest\x1a\x1c.bonding.EmergencyWithdrawal\x12o\n" +
+	"\x18ListEmergencyWithdrawals\x12(.bonding.ListEmergencyWithdrawalsRequest\x1a).bonding.ListEmergencyWithdrawalsResponse\x12F\n" +
+	"\x0eSetFeatureFlag\x12\x1e.bonding.SetFeatureFlagRequest\x1a\x14.bonding.FeatureFlag\x12W\n" +
+	"\x10ListFeatureFlags\x12 .bonding.ListFeatureFlagsRequest\x1a!.bonding.ListFeatureFlagsResponseB*Z(github.com/knowton/bonding-service/protob\x06proto3"
+
+var (
+	file_proto_bonding_proto_rawDescOnce sync.Once
+	file_proto_bonding_proto_rawDescData []byte
+)
+
+func file_proto_bonding_proto_rawDescGZIP() []byte {
+	file_proto_bonding_proto_rawDescOnce.Do(func() {
+		file_proto_bonding_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_bonding_proto_rawDesc), len(file_proto_bonding_proto_rawDesc)))
+	})
+	return file_proto_bonding_proto_rawDescData
+}
+
+var file_proto_bonding_proto_msgTypes = make([]protoimpl.MessageInfo, 183)
+var file_proto_bonding_proto_goTypes = []any{
+	(*IssueBondRequest)(nil),                   // 0: bonding.IssueBondRequest
+	(*TrancheConfig)(nil),                      // 1: bonding.TrancheConfig
+	(*RevenueForecastPeriod)(nil),              // 2: bonding.RevenueForecastPeriod
+	(*LicenseAgreement)(nil),                   // 3: bonding.LicenseAgreement
+	(*RegisteredIP)(nil),                       // 4: bonding.RegisteredIP
+	(*IssueBondResponse)(nil),                  // 5: bonding.IssueBondResponse
+	(*InvestRequest)(nil),                      // 6: bonding.InvestRequest
+	(*InvestResponse)(nil),                     // 7: bonding.InvestResponse
+	(*GetBondInfoRequest)(nil),                 // 8: bonding.GetBondInfoRequest
+	(*GetBondInfoResponse)(nil),                // 9: bonding.GetBondInfoResponse
+	(*ListBondsRequest)(nil),                   // 10: bonding.ListBondsRequest
+	(*ListBondsResponse)(nil),                  // 11: bonding.ListBondsResponse
+	(*ListInvestmentsRequest)(nil),             // 12: bonding.ListInvestmentsRequest
+	(*ListInvestmentsResponse)(nil),            // 13: bonding.ListInvestmentsResponse
+	(*InvestmentInfo)(nil),                     // 14: bonding.InvestmentInfo
+	(*TrancheInfo)(nil),                        // 15: bonding.TrancheInfo
+	(*GetTrancheInfoRequest)(nil),              // 16: bonding.GetTrancheInfoRequest
+	(*GetTrancheInfoResponse)(nil),             // 17: bonding.GetTrancheInfoResponse
+	(*OnChainTranche)(nil),                     // 18: bonding.OnChainTranche
+	(*DistributeRevenueRequest)(nil),           // 19: bonding.DistributeRevenueRequest
+	(*DistributeRevenueResponse)(nil),          // 20: bonding.DistributeRevenueResponse
+	(*TrancheDistribution)(nil),                // 21: bonding.TrancheDistribution
+	(*GetRevenueHistoryRequest)(nil),           // 22: bonding.GetRevenueHistoryRequest
+	(*GetRevenueHistoryResponse)(nil),          // 23: bonding.GetRevenueHistoryResponse
+	(*GetPaymentScheduleRequest)(nil),          // 24: bonding.GetPaymentScheduleRequest
+	(*GetPaymentScheduleResponse)(nil),         // 25: bonding.GetPaymentScheduleResponse
+	(*TranchePaymentSchedule)(nil),             // 26: bonding.TranchePaymentSchedule
+	(*ScheduledPayment)(nil),                   // 27: bonding.ScheduledPayment
+	(*GetYieldHistoryRequest)(nil),             // 28: bonding.GetYieldHistoryRequest
+	(*GetYieldHistoryResponse)(nil),            // 29: bonding.GetYieldHistoryResponse
+	(*TrancheYieldHistory)(nil),                // 30: bonding.TrancheYieldHistory
+	(*YieldPoint)(nil),                         // 31: bonding.YieldPoint
+	(*RevenueDistributionInfo)(nil),            // 32: bonding.RevenueDistributionInfo
+	(*TrancheSplit)(nil),                       // 33: bonding.TrancheSplit
+	(*RequestEarlyRedemptionRequest)(nil),      // 34: bonding.RequestEarlyRedemptionRequest
+	(*ApproveRedemptionRequest)(nil),           // 35: bonding.ApproveRedemptionRequest
+	(*RedemptionResponse)(nil),                 // 36: bonding.RedemptionResponse
+	(*QueueDistributionsRequest)(nil),          // 37: bonding.QueueDistributionsRequest
+	(*QueueDistributionsResponse)(nil),         // 38: bonding.QueueDistributionsResponse
+	(*QueuedDistribution)(nil),                 // 39: bonding.QueuedDistribution
+	(*SetDistributionScheduleRequest)(nil),     // 40: bonding.SetDistributionScheduleRequest
+	(*DistributionScheduleRequest)(nil),        // 41: bonding.DistributionScheduleRequest
+	(*SkipDistributionRunsRequest)(nil),        // 42: bonding.SkipDistributionRunsRequest
+	(*DistributionSchedule)(nil),               // 43: bonding.DistributionSchedule
+	(*DeleteDistributionScheduleResponse)(nil), // 44: bonding.DeleteDistributionScheduleResponse
+	(*ImportRevenueCSVRequest)(nil),            // 45: bonding.ImportRevenueCSVRequest
+	(*ImportRevenueCSVResponse)(nil),           // 46: bonding.ImportRevenueCSVResponse
+	(*ReviewRevenueEventsRequest)(nil),         // 47: bonding.ReviewRevenueEventsRequest
+	(*ReviewRevenueEventsResponse)(nil),        // 48: bonding.ReviewRevenueEventsResponse
+	(*ListRevenueEventsRequest)(nil),           // 49: bonding.ListRevenueEventsRequest
+	(*ListRevenueEventsResponse)(nil),          // 50: bonding.ListRevenueEventsResponse
+	(*RevenueEvent)(nil),                       // 51: bonding.RevenueEvent
+	(*ListAccrualSnapshotsRequest)(nil),        // 52: bonding.ListAccrualSnapshotsRequest
+	(*ListAccrualSnapshotsResponse)(nil),       // 53: bonding.ListAccrualSnapshotsResponse
+	(*AccrualSnapshot)(nil),                    // 54: bonding.AccrualSnapshot
+	(*TransferInvestmentRequest)(nil),          // 55: bonding.TransferInvestmentRequest
+	(*TransferInvestmentResponse)(nil),         // 56: bonding.TransferInvestmentResponse
+	(*GetChainStatusRequest)(nil),              // 57: bonding.GetChainStatusRequest
+	(*GetChainStatusResponse)(nil),             // 58: bonding.GetChainStatusResponse
+	(*ChainStatus)(nil),                        // 59: bonding.ChainStatus
+	(*PreparePermitInvestmentRequest)(nil),     // 60: bonding.PreparePermitInvestmentRequest
+	(*PreparePermitInvestmentResponse)(nil),    // 61: bonding.PreparePermitInvestmentResponse
+	(*InvestWithPermitRequest)(nil),            // 62: bonding.InvestWithPermitRequest
+	(*InvestWithPermitResponse)(nil),           // 63: bonding.InvestWithPermitResponse
+	(*PlaceOrderRequest)(nil),                  // 64: bonding.PlaceOrderRequest
+	(*OrderInfo)(nil),                          // 65: bonding.OrderInfo
+	(*ListOrdersRequest)(nil),                  // 66: bonding.ListOrdersRequest
+	(*ListOrdersResponse)(nil),                 // 67: bonding.ListOrdersResponse
+	(*TrancheMarket)(nil),                      // 68: bonding.TrancheMarket
+	(*PrepareFillOrderRequest)(nil),            // 69: bonding.PrepareFillOrderRequest
+	(*PrepareFillOrderResponse)(nil),           // 70: bonding.PrepareFillOrderResponse
+	(*FillOrderRequest)(nil),                   // 71: bonding.FillOrderRequest
+	(*FillOrderResponse)(nil),                  // 72: bonding.FillOrderResponse
+	(*CancelOrderRequest)(nil),                 // 73: bonding.CancelOrderRequest
+	(*Counterparty)(nil),                       // 74: bonding.Counterparty
+	(*AddressBookEntry)(nil),                   // 75: bonding.AddressBookEntry
+	(*UpsertAddressBookEntryRequest)(nil),      // 76: bonding.UpsertAddressBookEntryRequest
+	(*ListAddressBookEntriesRequest)(nil),      // 77: bonding.ListAddressBookEntriesRequest
+	(*ListAddressBookEntriesResponse)(nil),     // 78: bonding.ListAddressBookEntriesResponse
+	(*DeleteAddressBookEntryRequest)(nil),      // 79: bonding.DeleteAddressBookEntryRequest
+	(*DeleteAddressBookEntryResponse)(nil),     // 80: bonding.DeleteAddressBookEntryResponse
+	(*SetTrancheLimitsRequest)(nil),            // 81: bonding.SetTrancheLimitsRequest
+	(*ExportLedgerRequest)(nil),                // 82: bonding.ExportLedgerRequest
+	(*ExportLedgerResponse)(nil),               // 83: bonding.ExportLedgerResponse
+	(*GetDocumentURLRequest)(nil),              // 84: bonding.GetDocumentURLRequest
+	(*GetDocumentURLResponse)(nil),             // 85: bonding.GetDocumentURLResponse
+	(*CategoryInfo)(nil),                       // 86: bonding.CategoryInfo
+	(*UpsertCategoryRequest)(nil),              // 87: bonding.UpsertCategoryRequest
+	(*ListCategoriesRequest)(nil),              // 88: bonding.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),             // 89: bonding.ListCategoriesResponse
+	(*DeleteCategoryRequest)(nil),              // 90: bonding.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),             // 91: bonding.DeleteCategoryResponse
+	(*ReplaceTransactionRequest)(nil),          // 92: bonding.ReplaceTransactionRequest
+	(*ReplaceTransactionResponse)(nil),         // 93: bonding.ReplaceTransactionResponse
+	(*ListPendingTransactionsRequest)(nil),     // 94: bonding.ListPendingTransactionsRequest
+	(*ListPendingTransactionsResponse)(nil),    // 95: bonding.ListPendingTransactionsResponse
+	(*PendingTransaction)(nil),                 // 96: bonding.PendingTransaction
+	(*GetReconciliationReportRequest)(nil),     // 97: bonding.GetReconciliationReportRequest
+	(*ReconciliationReport)(nil),               // 98: bonding.ReconciliationReport
+	(*Discrepancy)(nil),                        // 99: bonding.Discrepancy
+	(*GenerateProspectusRequest)(nil),          // 100: bonding.GenerateProspectusRequest
+	(*GenerateProspectusResponse)(nil),         // 101: bonding.GenerateProspectusResponse
+	(*GetCounterpartyRiskRequest)(nil),         // 102: bonding.GetCounterpartyRiskRequest
+	(*GetCounterpartyRiskResponse)(nil),        // 103: bonding.GetCounterpartyRiskResponse
+	(*LicenseeCredit)(nil),                     // 104: bonding.LicenseeCredit
+	(*GetRevenueVarianceRequest)(nil),          // 105: bonding.GetRevenueVarianceRequest
+	(*GetRevenueVarianceResponse)(nil),         // 106: bonding.GetRevenueVarianceResponse
+	(*RevenueVariancePeriod)(nil),              // 107: bonding.RevenueVariancePeriod
+	(*ValidateIssueBondResponse)(nil),          // 108: bonding.ValidateIssueBondResponse
+	(*IssuanceProblem)(nil),                    // 109: bonding.IssuanceProblem
+	(*RiskAssessment)(nil),                     // 110: bonding.RiskAssessment
+	(*EstimateIssuanceCostRequest)(nil),        // 111: bonding.EstimateIssuanceCostRequest
+	(*EstimateIssuanceCostResponse)(nil),       // 112: bonding.EstimateIssuanceCostResponse
+	(*GetInvestmentQuoteRequest)(nil),          // 113: bonding.GetInvestmentQuoteRequest
+	(*GetInvestmentQuoteResponse)(nil),         // 114: bonding.GetInvestmentQuoteResponse
+	(*CouponPayment)(nil),                      // 115: bonding.CouponPayment
+	(*GetUsageRequest)(nil),                    // 116: bonding.GetUsageRequest
+	(*GetUsageResponse)(nil),                   // 117: bonding.GetUsageResponse
+	(*KeyUsage)(nil),                           // 118: bonding.KeyUsage
+	(*MethodUsage)(nil),                        // 119: bonding.MethodUsage
+	(*OracleSpend)(nil),                        // 120: bonding.OracleSpend
+	(*ScheduleMaintenanceRequest)(nil),         // 121: bonding.ScheduleMaintenanceRequest
+	(*MaintenanceWindow)(nil),                  // 122: bonding.MaintenanceWindow
+	(*CancelMaintenanceRequest)(nil),           // 123: bonding.CancelMaintenanceRequest
+	(*CancelMaintenanceResponse)(nil),          // 124: bonding.CancelMaintenanceResponse
+	(*GetMaintenanceRequest)(nil),              // 125: bonding.GetMaintenanceRequest
+	(*GetMaintenanceResponse)(nil),             // 126: bonding.GetMaintenanceResponse
+	(*AssessIPRiskRequest)(nil),                // 127: bonding.AssessIPRiskRequest
+	(*IPMetadata)(nil),                         // 128: bonding.IPMetadata
+	(*AssessIPRiskResponse)(nil),               // 129: bonding.AssessIPRiskResponse
+	(*AssessIPRiskBatchRequest)(nil),           // 130: bonding.AssessIPRiskBatchRequest
+	(*AssessIPRiskBatchResponse)(nil),          // 131: bonding.AssessIPRiskBatchResponse
+	(*AssessIPRiskBatchResult)(nil),            // 132: bonding.AssessIPRiskBatchResult
+	(*ComparableSale)(nil),                     // 133: bonding.ComparableSale
+	(*MarketAnalysis)(nil),                     // 134: bonding.MarketAnalysis
+	(*ListRiskModelsRequest)(nil),              // 135: bonding.ListRiskModelsRequest
+	(*ListRiskModelsResponse)(nil),             // 136: bonding.ListRiskModelsResponse
+	(*RiskModelInfo)(nil),                      // 137: bonding.RiskModelInfo
+	(*GetBondTimelineRequest)(nil),             // 138: bonding.GetBondTimelineRequest
+	(*GetBondTimelineResponse)(nil),            // 139: bonding.GetBondTimelineResponse
+	(*TimelineEntry)(nil),                      // 140: bonding.TimelineEntry
+	(*GetClaimableAmountsRequest)(nil),         // 141: bonding.GetClaimableAmountsRequest
+	(*GetClaimableAmountsResponse)(nil),        // 142: bonding.GetClaimableAmountsResponse
+	(*ClaimableAmount)(nil),                    // 143: bonding.ClaimableAmount
+	(*PrepareClaimRequest)(nil),                // 144: bonding.PrepareClaimRequest
+	(*PrepareClaimResponse)(nil),               // 145: bonding.PrepareClaimResponse
+	(*GetRiskAssessmentHistoryRequest)(nil),    // 146: bonding.GetRiskAssessmentHistoryRequest
+	(*GetRiskAssessmentHistoryResponse)(nil),   // 147: bonding.GetRiskAssessmentHistoryResponse
+	(*ListRiskAssessmentsRequest)(nil),         // 148: bonding.ListRiskAssessmentsRequest
+	(*ListRiskAssessmentsResponse)(nil),        // 149: bonding.ListRiskAssessmentsResponse
+	(*RecordComparableSalesRequest)(nil),       // 150: bonding.RecordComparableSalesRequest
+	(*RecordComparableSalesResponse)(nil),      // 151: bonding.RecordComparableSalesResponse
+	(*StressShock)(nil),                        // 152: bonding.StressShock
+	(*StressTestRequest)(nil),                  // 153: bonding.StressTestRequest
+	(*StressTrancheResult)(nil),                // 154: bonding.StressTrancheResult
+	(*StressBondResult)(nil),                   // 155: bonding.StressBondResult
+	(*StressTestReport)(nil),                   // 156: bonding.StressTestReport
+	(*GetPositionProofRequest)(nil),            // 157: bonding.GetPositionProofRequest
+	(*PositionProof)(nil),                      // 158: bonding.PositionProof
+	(*AccessListEntry)(nil),                    // 159: bonding.AccessListEntry
+	(*AddAccessListEntryRequest)(nil),          // 160: bonding.AddAccessListEntryRequest
+	(*RemoveAccessListEntryRequest)(nil),       // 161: bonding.RemoveAccessListEntryRequest
+	(*RemoveAccessListEntryResponse)(nil),      // 162: bonding.RemoveAccessListEntryResponse
+	(*ListAccessListEntriesRequest)(nil),       // 163: bonding.ListAccessListEntriesRequest
+	(*ListAccessListEntriesResponse)(nil),      // 164: bonding.ListAccessListEntriesResponse
+	(*QueryAuditLogRequest)(nil),               // 165: bonding.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),              // 166: bonding.QueryAuditLogResponse
+	(*AuditLogEntry)(nil),                      // 167: bonding.AuditLogEntry
+	(*ChangeBondStatusRequest)(nil),            // 168: bonding.ChangeBondStatusRequest
+	(*ChangeBondStatusResponse)(nil),           // 169: bonding.ChangeBondStatusResponse
+	(*RequestEmergencyWithdrawalRequest)(nil),  // 170: bonding.RequestEmergencyWithdrawalRequest
+	(*ConfirmEmergencyWithdrawalRequest)(nil),  // 171: bonding.ConfirmEmergencyWithdrawalRequest
+	(*CancelEmergencyWithdrawalRequest)(nil),   // 172: bonding.CancelEmergencyWithdrawalRequest
+	(*ListEmergencyWithdrawalsRequest)(nil),    // 173: bonding.ListEmergencyWithdrawalsRequest
+	(*ListEmergencyWithdrawalsResponse)(nil),   // 174: bonding.ListEmergencyWithdrawalsResponse
+	(*EmergencyWithdrawal)(nil),                // 175: bonding.EmergencyWithdrawal
+	(*SetFeatureFlagRequest)(nil),              // 176: bonding.SetFeatureFlagRequest
+	(*ListFeatureFlagsRequest)(nil),            // 177: bonding.ListFeatureFlagsRequest
+	(*ListFeatureFlagsResponse)(nil),           // 178: bonding.ListFeatureFlagsResponse
+	(*FeatureFlag)(nil),                        // 179: bonding.FeatureFlag
+	nil,                                        // 180: bonding.ListRiskModelsResponse.CategoryModelsEntry
+	nil,                                        // 181: bonding.AuditLogEntry.PositionsBeforeEntry
+	nil,                                        // 182: bonding.AuditLogEntry.PositionsAfterEntry
+}
+var file_proto_bonding_proto_depIdxs = []int32{
+	1,   // 0: bonding.IssueBondRequest.senior:type_name -> bonding.TrancheConfig
+	1,   // 1: bonding.IssueBondRequest.mezzanine:type_name -> bonding.TrancheConfig
+	1,   // 2: bonding.IssueBondRequest.junior:type_name -> bonding.TrancheConfig
+	4,   // 3: bonding.IssueBondRequest.registration:type_name -> bonding.RegisteredIP
+	3,   // 4: bonding.IssueBondRequest.license:type_name -> bonding.LicenseAgreement
+	2,   // 5: bonding.IssueBondRequest.revenue_forecast:type_name -> bonding.RevenueForecastPeriod
+	15,  // 6: bonding.IssueBondResponse.tranches:type_name -> bonding.TrancheInfo
+	110, // 7: bonding.IssueBondResponse.risk_assessment:type_name -> bonding.RiskAssessment
+	15,  // 8: bonding.GetBondInfoResponse.tranches:type_name -> bonding.TrancheInfo
+	74,  // 9: bonding.GetBondInfoResponse.issuer_info:type_name -> bonding.Counterparty
+	4,   // 10: bonding.GetBondInfoResponse.registration:type_name -> bonding.RegisteredIP
+	3,   // 11: bonding.GetBondInfoResponse.license:type_name -> bonding.LicenseAgreement
+	9,   // 12: bonding.ListBondsResponse.bonds:type_name -> bonding.GetBondInfoResponse
+	14,  // 13: bonding.ListInvestmentsResponse.investments:type_name -> bonding.InvestmentInfo
+	15,  // 14: bonding.GetTrancheInfoResponse.stored:type_name -> bonding.TrancheInfo
+	18,  // 15: bonding.GetTrancheInfoResponse.on_chain:type_name -> bonding.OnChainTranche
+	99,  // 16: bonding.GetTrancheInfoResponse.discrepancies:type_name -> bonding.Discrepancy
+	21,  // 17: bonding.DistributeRevenueResponse.distributions:type_name -> bonding.TrancheDistribution
+	32,  // 18: bonding.GetRevenueHistoryResponse.distributions:type_name -> bonding.RevenueDistributionInfo
+	26,  // 19: bonding.GetPaymentScheduleResponse.tranches:type_name -> bonding.TranchePaymentSchedule
+	27,  // 20: bonding.TranchePaymentSchedule.payments:type_name -> bonding.ScheduledPayment
+	30,  // 21: bonding.GetYieldHistoryResponse.tranches:type_name -> bonding.TrancheYieldHistory
+	31,  // 22: bonding.TrancheYieldHistory.points:type_name -> bonding.YieldPoint
+	33,  // 23: bonding.RevenueDistributionInfo.tranches:type_name -> bonding.TrancheSplit
+	74,  // 24: bonding.RedemptionResponse.investor:type_name -> bonding.Counterparty
+	39,  // 25: bonding.QueueDistributionsRequest.distributions:type_name -> bonding.QueuedDistribution
+	39,  // 26: bonding.QueueDistributionsResponse.distributions:type_name -> bonding.QueuedDistribution
+	51,  // 27: bonding.ListRevenueEventsResponse.events:type_name -> bonding.RevenueEvent
+	54,  // 28: bonding.ListAccrualSnapshotsResponse.snapshots:type_name -> bonding.AccrualSnapshot
+	74,  // 29: bonding.TransferInvestmentResponse.from:type_name -> bonding.Counterparty
+	74,  // 30: bonding.TransferInvestmentResponse.to:type_name -> bonding.Counterparty
+	59,  // 31: bonding.GetChainStatusResponse.chains:type_name -> bonding.ChainStatus
+	74,  // 32: bonding.OrderInfo.seller:type_name -> bonding.Counterparty
+	65,  // 33: bonding.ListOrdersResponse.orders:type_name -> bonding.OrderInfo
+	68,  // 34: bonding.ListOrdersResponse.market:type_name -> bonding.TrancheMarket
+	65,  // 35: bonding.FillOrderResponse.order:type_name -> bonding.OrderInfo
+	75,  // 36: bonding.ListAddressBookEntriesResponse.entries:type_name -> bonding.AddressBookEntry
+	86,  // 37: bonding.ListCategoriesResponse.categories:type_name -> bonding.CategoryInfo
+	96,  // 38: bonding.ListPendingTransactionsResponse.transactions:type_name -> bonding.PendingTransaction
+	99,  // 39: bonding.ReconciliationReport.discrepancies:type_name -> bonding.Discrepancy
+	104, // 40: bonding.GetCounterpartyRiskResponse.licensees:type_name -> bonding.LicenseeCredit
+	107, // 41: bonding.GetRevenueVarianceResponse.periods:type_name -> bonding.RevenueVariancePeriod
+	109, // 42: bonding.ValidateIssueBondResponse.errors:type_name -> bonding.IssuanceProblem
+	15,  // 43: bonding.ValidateIssueBondResponse.tranches:type_name -> bonding.TrancheInfo
+	110, // 44: bonding.ValidateIssueBondResponse.risk_assessment:type_name -> bonding.RiskAssessment
+	0,   // 45: bonding.EstimateIssuanceCostRequest.issuance:type_name -> bonding.IssueBondRequest
+	19,  // 46: bonding.EstimateIssuanceCostRequest.distribution:type_name -> bonding.DistributeRevenueRequest
+	6,   // 47: bonding.EstimateIssuanceCostRequest.investment:type_name -> bonding.InvestRequest
+	115, // 48: bonding.GetInvestmentQuoteResponse.coupon_schedule:type_name -> bonding.CouponPayment
+	118, // 49: bonding.GetUsageResponse.keys:type_name -> bonding.KeyUsage
+	120, // 50: bonding.GetUsageResponse.oracle_spend:type_name -> bonding.OracleSpend
+	119, // 51: bonding.KeyUsage.methods:type_name -> bonding.MethodUsage
+	122, // 52: bonding.GetMaintenanceResponse.active:type_name -> bonding.MaintenanceWindow
+	122, // 53: bonding.GetMaintenanceResponse.upcoming:type_name -> bonding.MaintenanceWindow
+	128, // 54: bonding.AssessIPRiskRequest.metadata:type_name -> bonding.IPMetadata
+	110, // 55: bonding.AssessIPRiskResponse.assessment:type_name -> bonding.RiskAssessment
+	133, // 56: bonding.AssessIPRiskResponse.comparable_sales:type_name -> bonding.ComparableSale
+	134, // 57: bonding.AssessIPRiskResponse.market_analysis:type_name -> bonding.MarketAnalysis
+	127, // 58: bonding.AssessIPRiskBatchRequest.items:type_name -> bonding.AssessIPRiskRequest
+	132, // 59: bonding.AssessIPRiskBatchResponse.results:type_name -> bonding.AssessIPRiskBatchResult
+	129, // 60: bonding.AssessIPRiskBatchResult.result:type_name -> bonding.AssessIPRiskResponse
+	137, // 61: bonding.ListRiskModelsResponse.models:type_name -> bonding.RiskModelInfo
+	180, // 62: bonding.ListRiskModelsResponse.category_models:type_name -> bonding.ListRiskModelsResponse.CategoryModelsEntry
+	140, // 63: bonding.GetBondTimelineResponse.entries:type_name -> bonding.TimelineEntry
+	143, // 64: bonding.GetClaimableAmountsResponse.amounts:type_name -> bonding.ClaimableAmount
+	110, // 65: bonding.GetRiskAssessmentHistoryResponse.assessments:type_name -> bonding.RiskAssessment
+	110, // 66: bonding.ListRiskAssessmentsResponse.assessments:type_name -> bonding.RiskAssessment
+	133, // 67: bonding.RecordComparableSalesRequest.sales:type_name -> bonding.ComparableSale
+	152, // 68: bonding.StressTestRequest.shocks:type_name -> bonding.StressShock
+	154, // 69: bonding.StressBondResult.tranches:type_name -> bonding.StressTrancheResult
+	155, // 70: bonding.StressTestReport.bonds:type_name -> bonding.StressBondResult
+	159, // 71: bonding.ListAccessListEntriesResponse.entries:type_name -> bonding.AccessListEntry
+	167, // 72: bonding.QueryAuditLogResponse.entries:type_name -> bonding.AuditLogEntry
+	181, // 73: bonding.AuditLogEntry.positions_before:type_name -> bonding.AuditLogEntry.PositionsBeforeEntry
+	182, // 74: bonding.AuditLogEntry.positions_after:type_name -> bonding.AuditLogEntry.PositionsAfterEntry
+	175, // 75: bonding.ListEmergencyWithdrawalsResponse.withdrawals:type_name -> bonding.EmergencyWithdrawal
+	179, // 76: bonding.ListFeatureFlagsResponse.flags:type_name -> bonding.FeatureFlag
+	0,   // 77: bonding.BondingService.IssueBond:input_type -> bonding.IssueBondRequest
+	6,   // 78: bonding.BondingService.Invest:input_type -> bonding.InvestRequest
+	8,   // 79: bonding.BondingService.GetBondInfo:input_type -> bonding.GetBondInfoRequest
+	16,  // 80: bonding.BondingService.GetTrancheInfo:input_type -> bonding.GetTrancheInfoRequest
+	10,  // 81: bonding.BondingService.ListBonds:input_type -> bonding.ListBondsRequest
+	12,  // 82: bonding.BondingService.ListInvestments:input_type -> bonding.ListInvestmentsRequest
+	19,  // 83: bonding.BondingService.DistributeRevenue:input_type -> bonding.DistributeRevenueRequest
+	22,  // 84: bonding.BondingService.GetRevenueHistory:input_type -> bonding.GetRevenueHistoryRequest
+	24,  // 85: bonding.BondingService.GetPaymentSchedule:input_type -> bonding.GetPaymentScheduleRequest
+	28,  // 86: bonding.BondingService.GetYieldHistory:input_type -> bonding.GetYieldHistoryRequest
+	34,  // 87: bonding.BondingService.RequestEarlyRedemption:input_type -> bonding.RequestEarlyRedemptionRequest
+	35,  // 88: bonding.BondingService.ApproveRedemption:input_type -> bonding.ApproveRedemptionRequest
+	37,  // 89: bonding.BondingService.QueueDistributions:input_type -> bonding.QueueDistributionsRequest
+	40,  // 90: bonding.BondingService.SetDistributionSchedule:input_type -> bonding.SetDistributionScheduleRequest
+	41,  // 91: bonding.BondingService.GetDistributionSchedule:input_type -> bonding.DistributionScheduleRequest
+	41,  // 92: bonding.BondingService.PauseDistributionSchedule:input_type -> bonding.DistributionScheduleRequest
+	41,  // 93: bonding.BondingService.ResumeDistributionSchedule:input_type -> bonding.DistributionScheduleRequest
+	42,  // 94: bonding.BondingService.SkipDistributionRuns:input_type -> bonding.SkipDistributionRunsRequest
+	41,  // 95: bonding.BondingService.DeleteDistributionSchedule:input_type -> bonding.DistributionScheduleRequest
+	45,  // 96: bonding.BondingService.ImportRevenueCSV:input_type -> bonding.ImportRevenueCSVRequest
+	47,  // 97: bonding.BondingService.ReviewRevenueEvents:input_type -> bonding.ReviewRevenueEventsRequest
+	49,  // 98: bonding.BondingService.ListRevenueEvents:input_type -> bonding.ListRevenueEventsRequest
+	52,  // 99: bonding.BondingService.ListAccrualSnapshots:input_type -> bonding.ListAccrualSnapshotsRequest
+	55,  // 100: bonding.BondingService.TransferInvestment:input_type -> bonding.TransferInvestmentRequest
+	57,  // 101: bonding.BondingService.GetChainStatus:input_type -> bonding.GetChainStatusRequest
+	60,  // 102: bonding.BondingService.PreparePermitInvestment:input_type -> bonding.PreparePermitInvestmentRequest
+	62,  // 103: bonding.BondingService.InvestWithPermit:input_type -> bonding.InvestWithPermitRequest
+	64,  // 104: bonding.BondingService.PlaceOrder:input_type -> bonding.PlaceOrderRequest
+	66,  // 105: bonding.BondingService.ListOrders:input_type -> bonding.ListOrdersRequest
+	69,  // 106: bonding.BondingService.PrepareFillOrder:input_type -> bonding.PrepareFillOrderRequest
+	71,  // 107: bonding.BondingService.FillOrder:input_type -> bonding.FillOrderRequest
+	73,  // 108: bonding.BondingService.CancelOrder:input_type -> bonding.CancelOrderRequest
+	76,  // 109: bonding.BondingService.UpsertAddressBookEntry:input_type -> bonding.UpsertAddressBookEntryRequest
+	77,  // 110: bonding.BondingService.ListAddressBookEntries:input_type -> bonding.ListAddressBookEntriesRequest
+	79,  // 111: bonding.BondingService.DeleteAddressBookEntry:input_type -> bonding.DeleteAddressBookEntryRequest
+	81,  // 112: bonding.BondingService.SetTrancheLimits:input_type -> bonding.SetTrancheLimitsRequest
+	82,  // 113: bonding.BondingService.ExportLedger:input_type -> bonding.ExportLedgerRequest
+	84,  // 114: bonding.BondingService.GetDocumentURL:input_type -> bonding.GetDocumentURLRequest
+	87,  // 115: bonding.BondingService.UpsertCategory:input_type -> bonding.UpsertCategoryRequest
+	88,  // 116: bonding.BondingService.ListCategories:input_type -> bonding.ListCategoriesRequest
+	90,  // 117: bonding.BondingService.DeleteCategory:input_type -> bonding.DeleteCategoryRequest
+	92,  // 118: bonding.BondingService.SpeedUpTransaction:input_type -> bonding.ReplaceTransactionRequest
+	92,  // 119: bonding.BondingService.CancelTransaction:input_type -> bonding.ReplaceTransactionRequest
+	94,  // 120: bonding.BondingService.ListPendingTransactions:input_type -> bonding.ListPendingTransactionsRequest
+	97,  // 121: bonding.BondingService.GetReconciliationReport:input_type -> bonding.GetReconciliationReportRequest
+	100, // 122: bonding.BondingService.GenerateProspectus:input_type -> bonding.GenerateProspectusRequest
+	102, // 123: bonding.BondingService.GetCounterpartyRisk:input_type -> bonding.GetCounterpartyRiskRequest
+	105, // 124: bonding.BondingService.GetRevenueVariance:input_type -> bonding.GetRevenueVarianceRequest
+	0,   // 125: bonding.BondingService.ValidateIssueBond:input_type -> bonding.IssueBondRequest
+	111, // 126: bonding.BondingService.EstimateIssuanceCost:input_type -> bonding.EstimateIssuanceCostRequest
+	113, // 127: bonding.BondingService.GetInvestmentQuote:input_type -> bonding.GetInvestmentQuoteRequest
+	116, // 128: bonding.BondingService.GetUsage:input_type -> bonding.GetUsageRequest
+	121, // 129: bonding.BondingService.ScheduleMaintenance:input_type -> bonding.ScheduleMaintenanceRequest
+	123, // 130: bonding.BondingService.CancelMaintenance:input_type -> bonding.CancelMaintenanceRequest
+	125, // 131: bonding.BondingService.GetMaintenance:input_type -> bonding.GetMaintenanceRequest
+	127, // 132: bonding.BondingService.AssessIPRisk:input_type -> bonding.AssessIPRiskRequest
+	130, // 133: bonding.BondingService.AssessIPRiskBatch:input_type -> bonding.AssessIPRiskBatchRequest
+	135, // 134: bonding.BondingService.ListRiskModels:input_type -> bonding.ListRiskModelsRequest
+	138, // 135: bonding.BondingService.GetBondTimeline:input_type -> bonding.GetBondTimelineRequest
+	141, // 136: bonding.BondingService.GetClaimableAmounts:input_type -> bonding.GetClaimableAmountsRequest
+	144, // 137: bonding.BondingService.PrepareClaim:input_type -> bonding.PrepareClaimRequest
+	146, // 138: bonding.BondingService.GetRiskAssessmentHistory:input_type -> bonding.GetRiskAssessmentHistoryRequest
+	148, // 139: bonding.BondingService.ListRiskAssessments:input_type -> bonding.ListRiskAssessmentsRequest
+	150, // 140: bonding.BondingService.RecordComparableSales:input_type -> bonding.RecordComparableSalesRequest
+	153, // 141: bonding.BondingService.StressTest:input_type -> bonding.StressTestRequest
+	157, // 142: bonding.BondingService.GetPositionProof:input_type -> bonding.GetPositionProofRequest
+	160, // 143: bonding.BondingService.AddAccessListEntry:input_type -> bonding.AddAccessListEntryRequest
+	161, // 144: bonding.BondingService.RemoveAccessListEntry:input_type -> bonding.RemoveAccessListEntryRequest
+	163, // 145: bonding.BondingService.ListAccessListEntries:input_type -> bonding.ListAccessListEntriesRequest
+	165, // 146: bonding.BondingService.QueryAuditLog:input_type -> bonding.QueryAuditLogRequest
+	168, // 147: bonding.BondingService.PauseBond:input_type -> bonding.ChangeBondStatusRequest
+	168, // 148: bonding.BondingService.FreezeBond:input_type -> bonding.ChangeBondStatusRequest
+	168, // 149: bonding.BondingService.CancelBond:input_type -> bonding.ChangeBondStatusRequest
+	168, // 150: bonding.BondingService.ResumeBond:input_type -> bonding.ChangeBondStatusRequest
+	170, // 151: bonding.BondingService.RequestEmergencyWithdrawal:input_type -> bonding.RequestEmergencyWithdrawalRequest
+	171, // 152: bonding.BondingService.ConfirmEmergencyWithdrawal:input_type -> bonding.ConfirmEmergencyWithdrawalRequest
+	172, // 153: bonding.BondingService.CancelEmergencyWithdrawal:input_type -> bonding.CancelEmergencyWithdrawalRequest
+	173, // 154: bonding.BondingService.ListEmergencyWithdrawals:input_type -> bonding.ListEmergencyWithdrawalsRequest
+	176, // 155: bonding.BondingService.SetFeatureFlag:input_type -> bonding.SetFeatureFlagRequest
+	177, // 156: bonding.BondingService.ListFeatureFlags:input_type -> bonding.ListFeatureFlagsRequest
+	5,   // 157: bonding.BondingService.IssueBond:output_type -> bonding.IssueBondResponse
+	7,   // 158: bonding.BondingService.Invest:output_type -> bonding.InvestResponse
+	9,   // 159: bonding.BondingService.GetBondInfo:output_type -> bonding.GetBondInfoResponse
+	17,  // 160: bonding.BondingService.GetTrancheInfo:output_type -> bonding.GetTrancheInfoResponse
+	11,  // 161: bonding.BondingService.ListBonds:output_type -> bonding.ListBondsResponse
+	13,  // 162: bonding.BondingService.ListInvestments:output_type -> bonding.ListInvestmentsResponse
+	20,  // 163: bonding.BondingService.DistributeRevenue:output_type -> bonding.DistributeRevenueResponse
+	23,  // 164: bonding.BondingService.GetRevenueHistory:output_type -> bonding.GetRevenueHistoryResponse
+	25,  // 165: bonding.BondingService.GetPaymentSchedule:output_type -> bonding.GetPaymentScheduleResponse
+	29,  // 166: bonding.BondingService.GetYieldHistory:output_type -> bonding.GetYieldHistoryResponse
+	36,  // 167: bonding.BondingService.RequestEarlyRedemption:output_type -> bonding.RedemptionResponse
+	36,  // 168: bonding.BondingService.ApproveRedemption:output_type -> bonding.RedemptionResponse
+	38,  // 169: bonding.BondingService.QueueDistributions:output_type -> bonding.QueueDistributionsResponse
+	43,  // 170: bonding.BondingService.SetDistributionSchedule:output_type -> bonding.DistributionSchedule
+	43,  // 171: bonding.BondingService.GetDistributionSchedule:output_type -> bonding.DistributionSchedule
+	43,  // 172: bonding.BondingService.PauseDistributionSchedule:output_type -> bonding.DistributionSchedule
+	43,  // 173: bonding.BondingService.ResumeDistributionSchedule:output_type -> bonding.DistributionSchedule
+	43,  // 174: bonding.BondingService.SkipDistributionRuns:output_type -> bonding.DistributionSchedule
+	44,  // 175: bonding.BondingService.DeleteDistributionSchedule:output_type -> bonding.DeleteDistributionScheduleResponse
+	46,  // 176: bonding.BondingService.ImportRevenueCSV:output_type -> bonding.ImportRevenueCSVResponse
+	48,  // 177: bonding.BondingService.ReviewRevenueEvents:output_type -> bonding.ReviewRevenueEventsResponse
+	50,  // 178: bonding.BondingService.ListRevenueEvents:output_type -> bonding.ListRevenueEventsResponse
+	53,  // 179: bonding.BondingService.ListAccrualSnapshots:output_type -> bonding.ListAccrualSnapshotsResponse
+	56,  // 180: bonding.BondingService.TransferInvestment:output_type -> bonding.TransferInvestmentResponse
+	58,  // 181: bonding.BondingService.GetChainStatus:output_type -> bonding.GetChainStatusResponse
+	61,  // 182: bonding.BondingService.PreparePermitInvestment:output_type -> bonding.PreparePermitInvestmentResponse
+	63,  // 183: bonding.BondingService.InvestWithPermit:output_type -> bonding.InvestWithPermitResponse
+	65,  // 184: bonding.BondingService.PlaceOrder:output_type -> bonding.OrderInfo
+	67,  // 185: bonding.BondingService.ListOrders:output_type -> bonding.ListOrdersResponse
+	70,  // 186: bonding.BondingService.PrepareFillOrder:output_type -> bonding.PrepareFillOrderResponse
+	72,  // 187: bonding.BondingService.FillOrder:output_type -> bonding.FillOrderResponse
+	65,  // 188: bonding.BondingService.CancelOrder:output_type -> bonding.OrderInfo
+	75,  // 189: bonding.BondingService.UpsertAddressBookEntry:output_type -> bonding.AddressBookEntry
+	78,  // 190: bonding.BondingService.ListAddressBookEntries:output_type -> bonding.ListAddressBookEntriesResponse
+	80,  // 191: bonding.BondingService.DeleteAddressBookEntry:output_type -> bonding.DeleteAddressBookEntryResponse
+	15,  // 192: bonding.BondingService.SetTrancheLimits:output_type -> bonding.TrancheInfo
+	83,  // 193: bonding.BondingService.ExportLedger:output_type -> bonding.ExportLedgerResponse
+	85,  // 194: bonding.BondingService.GetDocumentURL:output_type -> bonding.GetDocumentURLResponse
+	86,  // 195: bonding.BondingService.UpsertCategory:output_type -> bonding.CategoryInfo
+	89,  // 196: bonding.BondingService.ListCategories:output_type -> bonding.ListCategoriesResponse
+	91,  // 197: bonding.BondingService.DeleteCategory:output_type -> bonding.DeleteCategoryResponse
+	93,  // 198: bonding.BondingService.SpeedUpTransaction:output_type -> bonding.ReplaceTransactionResponse
+	93,  // 199: bonding.BondingService.CancelTransaction:output_type -> bonding.ReplaceTransactionResponse
+	95,  // 200: bonding.BondingService.ListPendingTransactions:output_type -> bonding.ListPendingTransactionsResponse
+	98,  // 201: bonding.BondingService.GetReconciliationReport:output_type -> bonding.ReconciliationReport
+	101, // 202: bonding.BondingService.GenerateProspectus:output_type -> bonding.GenerateProspectusResponse
+	103, // 203: bonding.BondingService.GetCounterpartyRisk:output_type -> bonding.GetCounterpartyRiskResponse
+	106, // 204: bonding.BondingService.GetRevenueVariance:output_type -> bonding.GetRevenueVarianceResponse
+	108, // 205: bonding.BondingService.ValidateIssueBond:output_type -> bonding.ValidateIssueBondResponse
+	112, // 206: bonding.BondingService.EstimateIssuanceCost:output_type -> bonding.EstimateIssuanceCostResponse
+	114, // 207: bonding.BondingService.GetInvestmentQuote:output_type -> bonding.GetInvestmentQuoteResponse
+	117, // 208: bonding.BondingService.GetUsage:output_type -> bonding.GetUsageResponse
+	122, // 209: bonding.BondingService.ScheduleMaintenance:output_type -> bonding.MaintenanceWindow
+	124, // 210: bonding.BondingService.CancelMaintenance:output_type -> bonding.CancelMaintenanceResponse
+	126, // 211: bonding.BondingService.GetMaintenance:output_type -> bonding.GetMaintenanceResponse
+	129, // 212: bonding.BondingService.AssessIPRisk:output_type -> bonding.AssessIPRiskResponse
+	131, // 213: bonding.BondingService.AssessIPRiskBatch:output_type -> bonding.AssessIPRiskBatchResponse
+	136, // 214: bonding.BondingService.ListRiskModels:output_type -> bonding.ListRiskModelsResponse
+	139, // 215: bonding.BondingService.GetBondTimeline:output_type -> bonding.GetBondTimelineResponse
+	142, // 216: bonding.BondingService.GetClaimableAmounts:output_type -> bonding.GetClaimableAmountsResponse
+	145, // 217: bonding.BondingService.PrepareClaim:output_type -> bonding.PrepareClaimResponse
+	147, // 218: bonding.BondingService.GetRiskAssessmentHistory:output_type -> bonding.GetRiskAssessmentHistoryResponse
+	149, // 219: bonding.BondingService.ListRiskAssessments:output_type -> bonding.ListRiskAssessmentsResponse
+	151, // 220: bonding.BondingService.RecordComparableSales:output_type -> bonding.RecordComparableSalesResponse
+	156, // 221: bonding.BondingService.StressTest:output_type -> bonding.StressTestReport
+	158, // 222: bonding.BondingService.GetPositionProof:output_type -> bonding.PositionProof
+	159, // 223: bonding.BondingService.AddAccessListEntry:output_type -> bonding.AccessListEntry
+	162, // 224: bonding.BondingService.RemoveAccessListEntry:output_type -> bonding.RemoveAccessListEntryResponse
+	164, // 225: bonding.BondingService.ListAccessListEntries:output_type -> bonding.ListAccessListEntriesResponse
+	166, // 226: bonding.BondingService.QueryAuditLog:output_type -> bonding.QueryAuditLogResponse
+	169, // 227: bonding.BondingService.PauseBond:output_type -> bonding.ChangeBondStatusResponse
+	169, // 228: bonding.BondingService.FreezeBond:output_type -> bonding.ChangeBondStatusResponse
+	169, // 229: bonding.BondingService.CancelBond:output_type -> bonding.ChangeBondStatusResponse
+	169, // 230: bonding.BondingService.ResumeBond:output_type -> bonding.ChangeBondStatusResponse
+	175, // 231: bonding.BondingService.RequestEmergencyWithdrawal:output_type -> bonding.EmergencyWithdrawal
+	175, // 232: bonding.BondingService.ConfirmEmergencyWithdrawal:output_type -> bonding.EmergencyWithdrawal
+	175, // 233: bonding.BondingService.CancelEmergencyWithdrawal:output_type -> bonding.EmergencyWithdrawal
+	174, // 234: bonding.BondingService.ListEmergencyWithdrawals:output_type -> bonding.ListEmergencyWithdrawalsResponse
+	179, // 235: bonding.BondingService.SetFeatureFlag:output_type -> bonding.FeatureFlag
+	178, // 236: bonding.BondingService.ListFeatureFlags:output_type -> bonding.ListFeatureFlagsResponse
+	157, // [157:237] is the sub-list for method output_type
+	77,  // [77:157] is the sub-list for method input_type
+	77,  // [77:77] is the sub-list for extension type_name
+	77,  // [77:77] is the sub-list for extension extendee
+	0,   // [0:77] is the sub-list for field type_name
+}
 
 func init() { file_proto_bonding_proto_init() }
 func file_proto_bonding_proto_init() {
 	if File_proto_bonding_proto != nil {
 		return
 	}
+	file_proto_bonding_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_bonding_proto_msgTypes[66].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_bonding_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_bonding_proto_rawDesc), len(file_proto_bonding_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   183,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -116,14 +15114,6 @@ func file_proto_bonding_proto_init() {
 		MessageInfos:      file_proto_bonding_proto_msgTypes,
 	}.Build()
 	File_proto_bonding_proto = out.File
-	file_proto_bonding_proto_rawDesc = nil
 	file_proto_bonding_proto_goTypes = nil
 	file_proto_bonding_proto_depIdxs = nil
 }
-
-var (
-	file_proto_bonding_proto_rawDesc = []byte{}
-	file_proto_bonding_proto_goTypes = []interface{}{}
-	file_proto_bonding_proto_depIdxs = []int32{}
-	File_proto_bonding_proto protoreflect.FileDescriptor
-)
@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/bonding.proto
 
 package proto
 
@@ -9,68 +13,2786 @@ import (
 	status "google.golang.org/grpc/status"
 )
 
-const _ = grpc.SupportPackageIsVersion7
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
+const (
+	BondingService_IssueBond_FullMethodName                  = "/bonding.BondingService/IssueBond"
+	BondingService_Invest_FullMethodName                     = "/bonding.BondingService/Invest"
+	BondingService_GetBondInfo_FullMethodName                = "/bonding.BondingService/GetBondInfo"
+	BondingService_GetTrancheInfo_FullMethodName             = "/bonding.BondingService/GetTrancheInfo"
+	BondingService_ListBonds_FullMethodName                  = "/bonding.BondingService/ListBonds"
+	BondingService_ListInvestments_FullMethodName            = "/bonding.BondingService/ListInvestments"
+	BondingService_DistributeRevenue_FullMethodName          = "/bonding.BondingService/DistributeRevenue"
+	BondingService_GetRevenueHistory_FullMethodName          = "/bonding.BondingService/GetRevenueHistory"
+	BondingService_GetPaymentSchedule_FullMethodName         = "/bonding.BondingService/GetPaymentSchedule"
+	BondingService_GetYieldHistory_FullMethodName            = "/bonding.BondingService/GetYieldHistory"
+	BondingService_RequestEarlyRedemption_FullMethodName     = "/bonding.BondingService/RequestEarlyRedemption"
+	BondingService_ApproveRedemption_FullMethodName          = "/bonding.BondingService/ApproveRedemption"
+	BondingService_QueueDistributions_FullMethodName         = "/bonding.BondingService/QueueDistributions"
+	BondingService_SetDistributionSchedule_FullMethodName    = "/bonding.BondingService/SetDistributionSchedule"
+	BondingService_GetDistributionSchedule_FullMethodName    = "/bonding.BondingService/GetDistributionSchedule"
+	BondingService_PauseDistributionSchedule_FullMethodName  = "/bonding.BondingService/PauseDistributionSchedule"
+	BondingService_ResumeDistributionSchedule_FullMethodName = "/bonding.BondingService/ResumeDistributionSchedule"
+	BondingService_SkipDistributionRuns_FullMethodName       = "/bonding.BondingService/SkipDistributionRuns"
+	BondingService_DeleteDistributionSchedule_FullMethodName = "/bonding.BondingService/DeleteDistributionSchedule"
+	BondingService_ImportRevenueCSV_FullMethodName           = "/bonding.BondingService/ImportRevenueCSV"
+	BondingService_ReviewRevenueEvents_FullMethodName        = "/bonding.BondingService/ReviewRevenueEvents"
+	BondingService_ListRevenueEvents_FullMethodName          = "/bonding.BondingService/ListRevenueEvents"
+	BondingService_ListAccrualSnapshots_FullMethodName       = "/bonding.BondingService/ListAccrualSnapshots"
+	BondingService_TransferInvestment_FullMethodName         = "/bonding.BondingService/TransferInvestment"
+	BondingService_GetChainStatus_FullMethodName             = "/bonding.BondingService/GetChainStatus"
+	BondingService_PreparePermitInvestment_FullMethodName    = "/bonding.BondingService/PreparePermitInvestment"
+	BondingService_InvestWithPermit_FullMethodName           = "/bonding.BondingService/InvestWithPermit"
+	BondingService_PlaceOrder_FullMethodName                 = "/bonding.BondingService/PlaceOrder"
+	BondingService_ListOrders_FullMethodName                 = "/bonding.BondingService/ListOrders"
+	BondingService_PrepareFillOrder_FullMethodName           = "/bonding.BondingService/PrepareFillOrder"
+	BondingService_FillOrder_FullMethodName                  = "/bonding.BondingService/FillOrder"
+	BondingService_CancelOrder_FullMethodName                = "/bonding.BondingService/CancelOrder"
+	BondingService_UpsertAddressBookEntry_FullMethodName     = "/bonding.BondingService/UpsertAddressBookEntry"
+	BondingService_ListAddressBookEntries_FullMethodName     = "/bonding.BondingService/ListAddressBookEntries"
+	BondingService_DeleteAddressBookEntry_FullMethodName     = "/bonding.BondingService/DeleteAddressBookEntry"
+	BondingService_SetTrancheLimits_FullMethodName           = "/bonding.BondingService/SetTrancheLimits"
+	BondingService_ExportLedger_FullMethodName               = "/bonding.BondingService/ExportLedger"
+	BondingService_GetDocumentURL_FullMethodName             = "/bonding.BondingService/GetDocumentURL"
+	BondingService_UpsertCategory_FullMethodName             = "/bonding.BondingService/UpsertCategory"
+	BondingService_ListCategories_FullMethodName             = "/bonding.BondingService/ListCategories"
+	BondingService_DeleteCategory_FullMethodName             = "/bonding.BondingService/DeleteCategory"
+	BondingService_SpeedUpTransaction_FullMethodName         = "/bonding.BondingService/SpeedUpTransaction"
+	BondingService_CancelTransaction_FullMethodName          = "/bonding.BondingService/CancelTransaction"
+	BondingService_ListPendingTransactions_FullMethodName    = "/bonding.BondingService/ListPendingTransactions"
+	BondingService_GetReconciliationReport_FullMethodName    = "/bonding.BondingService/GetReconciliationReport"
+	BondingService_GenerateProspectus_FullMethodName         = "/bonding.BondingService/GenerateProspectus"
+	BondingService_GetCounterpartyRisk_FullMethodName        = "/bonding.BondingService/GetCounterpartyRisk"
+	BondingService_GetRevenueVariance_FullMethodName         = "/bonding.BondingService/GetRevenueVariance"
+	BondingService_ValidateIssueBond_FullMethodName          = "/bonding.BondingService/ValidateIssueBond"
+	BondingService_EstimateIssuanceCost_FullMethodName       = "/bonding.BondingService/EstimateIssuanceCost"
+	BondingService_GetInvestmentQuote_FullMethodName         = "/bonding.BondingService/GetInvestmentQuote"
+	BondingService_GetUsage_FullMethodName                   = "/bonding.BondingService/GetUsage"
+	BondingService_ScheduleMaintenance_FullMethodName        = "/bonding.BondingService/ScheduleMaintenance"
+	BondingService_CancelMaintenance_FullMethodName          = "/bonding.BondingService/CancelMaintenance"
+	BondingService_GetMaintenance_FullMethodName             = "/bonding.BondingService/GetMaintenance"
+	BondingService_AssessIPRisk_FullMethodName               = "/bonding.BondingService/AssessIPRisk"
+	BondingService_AssessIPRiskBatch_FullMethodName          = "/bonding.BondingService/AssessIPRiskBatch"
+	BondingService_ListRiskModels_FullMethodName             = "/bonding.BondingService/ListRiskModels"
+	BondingService_GetBondTimeline_FullMethodName            = "/bonding.BondingService/GetBondTimeline"
+	BondingService_GetClaimableAmounts_FullMethodName        = "/bonding.BondingService/GetClaimableAmounts"
+	BondingService_PrepareClaim_FullMethodName               = "/bonding.BondingService/PrepareClaim"
+	BondingService_GetRiskAssessmentHistory_FullMethodName   = "/bonding.BondingService/GetRiskAssessmentHistory"
+	BondingService_ListRiskAssessments_FullMethodName        = "/bonding.BondingService/ListRiskAssessments"
+	BondingService_RecordComparableSales_FullMethodName      = "/bonding.BondingService/RecordComparableSales"
+	BondingService_StressTest_FullMethodName                 = "/bonding.BondingService/StressTest"
+	BondingService_GetPositionProof_FullMethodName           = "/bonding.BondingService/GetPositionProof"
+	BondingService_AddAccessListEntry_FullMethodName         = "/bonding.BondingService/AddAccessListEntry"
+	BondingService_RemoveAccessListEntry_FullMethodName      = "/bonding.BondingService/RemoveAccessListEntry"
+	BondingService_ListAccessListEntries_FullMethodName      = "/bonding.BondingService/ListAccessListEntries"
+	BondingService_QueryAuditLog_FullMethodName              = "/bonding.BondingService/QueryAuditLog"
+	BondingService_PauseBond_FullMethodName                  = "/bonding.BondingService/PauseBond"
+	BondingService_FreezeBond_FullMethodName                 = "/bonding.BondingService/FreezeBond"
+	BondingService_CancelBond_FullMethodName                 = "/bonding.BondingService/CancelBond"
+	BondingService_ResumeBond_FullMethodName                 = "/bonding.BondingService/ResumeBond"
+	BondingService_RequestEmergencyWithdrawal_FullMethodName = "/bonding.BondingService/RequestEmergencyWithdrawal"
+	BondingService_ConfirmEmergencyWithdrawal_FullMethodName = "/bonding.BondingService/ConfirmEmergencyWithdrawal"
+	BondingService_CancelEmergencyWithdrawal_FullMethodName  = "/bonding.BondingService/CancelEmergencyWithdrawal"
+	BondingService_ListEmergencyWithdrawals_FullMethodName   = "/bonding.BondingService/ListEmergencyWithdrawals"
+	BondingService_SetFeatureFlag_FullMethodName             = "/bonding.BondingService/SetFeatureFlag"
+	BondingService_ListFeatureFlags_FullMethodName           = "/bonding.BondingService/ListFeatureFlags"
+)
+
+// BondingServiceClient is the client API for BondingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BondingServiceClient interface {
 	IssueBond(ctx context.Context, in *IssueBondRequest, opts ...grpc.CallOption) (*IssueBondResponse, error)
+	Invest(ctx context.Context, in *InvestRequest, opts ...grpc.CallOption) (*InvestResponse, error)
+	GetBondInfo(ctx context.Context, in *GetBondInfoRequest, opts ...grpc.CallOption) (*GetBondInfoResponse, error)
+	GetTrancheInfo(ctx context.Context, in *GetTrancheInfoRequest, opts ...grpc.CallOption) (*GetTrancheInfoResponse, error)
+	ListBonds(ctx context.Context, in *ListBondsRequest, opts ...grpc.CallOption) (*ListBondsResponse, error)
+	ListInvestments(ctx context.Context, in *ListInvestmentsRequest, opts ...grpc.CallOption) (*ListInvestmentsResponse, error)
+	DistributeRevenue(ctx context.Context, in *DistributeRevenueRequest, opts ...grpc.CallOption) (*DistributeRevenueResponse, error)
+	GetRevenueHistory(ctx context.Context, in *GetRevenueHistoryRequest, opts ...grpc.CallOption) (*GetRevenueHistoryResponse, error)
+	GetPaymentSchedule(ctx context.Context, in *GetPaymentScheduleRequest, opts ...grpc.CallOption) (*GetPaymentScheduleResponse, error)
+	GetYieldHistory(ctx context.Context, in *GetYieldHistoryRequest, opts ...grpc.CallOption) (*GetYieldHistoryResponse, error)
+	RequestEarlyRedemption(ctx context.Context, in *RequestEarlyRedemptionRequest, opts ...grpc.CallOption) (*RedemptionResponse, error)
+	ApproveRedemption(ctx context.Context, in *ApproveRedemptionRequest, opts ...grpc.CallOption) (*RedemptionResponse, error)
+	QueueDistributions(ctx context.Context, in *QueueDistributionsRequest, opts ...grpc.CallOption) (*QueueDistributionsResponse, error)
+	SetDistributionSchedule(ctx context.Context, in *SetDistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error)
+	GetDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error)
+	PauseDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error)
+	ResumeDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error)
+	SkipDistributionRuns(ctx context.Context, in *SkipDistributionRunsRequest, opts ...grpc.CallOption) (*DistributionSchedule, error)
+	DeleteDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DeleteDistributionScheduleResponse, error)
+	ImportRevenueCSV(ctx context.Context, in *ImportRevenueCSVRequest, opts ...grpc.CallOption) (*ImportRevenueCSVResponse, error)
+	ReviewRevenueEvents(ctx context.Context, in *ReviewRevenueEventsRequest, opts ...grpc.CallOption) (*ReviewRevenueEventsResponse, error)
+	ListRevenueEvents(ctx context.Context, in *ListRevenueEventsRequest, opts ...grpc.CallOption) (*ListRevenueEventsResponse, error)
+	ListAccrualSnapshots(ctx context.Context, in *ListAccrualSnapshotsRequest, opts ...grpc.CallOption) (*ListAccrualSnapshotsResponse, error)
+	TransferInvestment(ctx context.Context, in *TransferInvestmentRequest, opts ...grpc.CallOption) (*TransferInvestmentResponse, error)
+	GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error)
+	PreparePermitInvestment(ctx context.Context, in *PreparePermitInvestmentRequest, opts ...grpc.CallOption) (*PreparePermitInvestmentResponse, error)
+	InvestWithPermit(ctx context.Context, in *InvestWithPermitRequest, opts ...grpc.CallOption) (*InvestWithPermitResponse, error)
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*OrderInfo, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	PrepareFillOrder(ctx context.Context, in *PrepareFillOrderRequest, opts ...grpc.CallOption) (*PrepareFillOrderResponse, error)
+	FillOrder(ctx context.Context, in *FillOrderRequest, opts ...grpc.CallOption) (*FillOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderInfo, error)
+	UpsertAddressBookEntry(ctx context.Context, in *UpsertAddressBookEntryRequest, opts ...grpc.CallOption) (*AddressBookEntry, error)
+	ListAddressBookEntries(ctx context.Context, in *ListAddressBookEntriesRequest, opts ...grpc.CallOption) (*ListAddressBookEntriesResponse, error)
+	DeleteAddressBookEntry(ctx context.Context, in *DeleteAddressBookEntryRequest, opts ...grpc.CallOption) (*DeleteAddressBookEntryResponse, error)
+	SetTrancheLimits(ctx context.Context, in *SetTrancheLimitsRequest, opts ...grpc.CallOption) (*TrancheInfo, error)
+	ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (*ExportLedgerResponse, error)
+	GetDocumentURL(ctx context.Context, in *GetDocumentURLRequest, opts ...grpc.CallOption) (*GetDocumentURLResponse, error)
+	UpsertCategory(ctx context.Context, in *UpsertCategoryRequest, opts ...grpc.CallOption) (*CategoryInfo, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	SpeedUpTransaction(ctx context.Context, in *ReplaceTransactionRequest, opts ...grpc.CallOption) (*ReplaceTransactionResponse, error)
+	CancelTransaction(ctx context.Context, in *ReplaceTransactionRequest, opts ...grpc.CallOption) (*ReplaceTransactionResponse, error)
+	ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
+	GenerateProspectus(ctx context.Context, in *GenerateProspectusRequest, opts ...grpc.CallOption) (*GenerateProspectusResponse, error)
+	GetCounterpartyRisk(ctx context.Context, in *GetCounterpartyRiskRequest, opts ...grpc.CallOption) (*GetCounterpartyRiskResponse, error)
+	GetRevenueVariance(ctx context.Context, in *GetRevenueVarianceRequest, opts ...grpc.CallOption) (*GetRevenueVarianceResponse, error)
+	ValidateIssueBond(ctx context.Context, in *IssueBondRequest, opts ...grpc.CallOption) (*ValidateIssueBondResponse, error)
+	EstimateIssuanceCost(ctx context.Context, in *EstimateIssuanceCostRequest, opts ...grpc.CallOption) (*EstimateIssuanceCostResponse, error)
+	GetInvestmentQuote(ctx context.Context, in *GetInvestmentQuoteRequest, opts ...grpc.CallOption) (*GetInvestmentQuoteResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*CancelMaintenanceResponse, error)
+	GetMaintenance(ctx context.Context, in *GetMaintenanceRequest, opts ...grpc.CallOption) (*GetMaintenanceResponse, error)
+	AssessIPRisk(ctx context.Context, in *AssessIPRiskRequest, opts ...grpc.CallOption) (*AssessIPRiskResponse, error)
+	AssessIPRiskBatch(ctx context.Context, in *AssessIPRiskBatchRequest, opts ...grpc.CallOption) (*AssessIPRiskBatchResponse, error)
+	ListRiskModels(ctx context.Context, in *ListRiskModelsRequest, opts ...grpc.CallOption) (*ListRiskModelsResponse, error)
+	GetBondTimeline(ctx context.Context, in *GetBondTimelineRequest, opts ...grpc.CallOption) (*GetBondTimelineResponse, error)
+	GetClaimableAmounts(ctx context.Context, in *GetClaimableAmountsRequest, opts ...grpc.CallOption) (*GetClaimableAmountsResponse, error)
+	PrepareClaim(ctx context.Context, in *PrepareClaimRequest, opts ...grpc.CallOption) (*PrepareClaimResponse, error)
+	GetRiskAssessmentHistory(ctx context.Context, in *GetRiskAssessmentHistoryRequest, opts ...grpc.CallOption) (*GetRiskAssessmentHistoryResponse, error)
+	ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error)
+	RecordComparableSales(ctx context.Context, in *RecordComparableSalesRequest, opts ...grpc.CallOption) (*RecordComparableSalesResponse, error)
+	StressTest(ctx context.Context, in *StressTestRequest, opts ...grpc.CallOption) (*StressTestReport, error)
+	GetPositionProof(ctx context.Context, in *GetPositionProofRequest, opts ...grpc.CallOption) (*PositionProof, error)
+	AddAccessListEntry(ctx context.Context, in *AddAccessListEntryRequest, opts ...grpc.CallOption) (*AccessListEntry, error)
+	RemoveAccessListEntry(ctx context.Context, in *RemoveAccessListEntryRequest, opts ...grpc.CallOption) (*RemoveAccessListEntryResponse, error)
+	ListAccessListEntries(ctx context.Context, in *ListAccessListEntriesRequest, opts ...grpc.CallOption) (*ListAccessListEntriesResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	PauseBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error)
+	FreezeBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error)
+	CancelBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error)
+	ResumeBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error)
+	RequestEmergencyWithdrawal(ctx context.Context, in *RequestEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error)
+	ConfirmEmergencyWithdrawal(ctx context.Context, in *ConfirmEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error)
+	CancelEmergencyWithdrawal(ctx context.Context, in *CancelEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error)
+	ListEmergencyWithdrawals(ctx context.Context, in *ListEmergencyWithdrawalsRequest, opts ...grpc.CallOption) (*ListEmergencyWithdrawalsResponse, error)
+	SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*FeatureFlag, error)
+	ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error)
 }
 
 type bondingServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewBondingServiceClient(cc grpc.ClientConnInterface) BondingServiceClient {
-	return &bondingServiceClient{cc}
+func NewBondingServiceClient(cc grpc.ClientConnInterface) BondingServiceClient {
+	return &bondingServiceClient{cc}
+}
+
+func (c *bondingServiceClient) IssueBond(ctx context.Context, in *IssueBondRequest, opts ...grpc.CallOption) (*IssueBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueBondResponse)
+	err := c.cc.Invoke(ctx, BondingService_IssueBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) Invest(ctx context.Context, in *InvestRequest, opts ...grpc.CallOption) (*InvestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestResponse)
+	err := c.cc.Invoke(ctx, BondingService_Invest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetBondInfo(ctx context.Context, in *GetBondInfoRequest, opts ...grpc.CallOption) (*GetBondInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBondInfoResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetBondInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetTrancheInfo(ctx context.Context, in *GetTrancheInfoRequest, opts ...grpc.CallOption) (*GetTrancheInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrancheInfoResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetTrancheInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListBonds(ctx context.Context, in *ListBondsRequest, opts ...grpc.CallOption) (*ListBondsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBondsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListBonds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListInvestments(ctx context.Context, in *ListInvestmentsRequest, opts ...grpc.CallOption) (*ListInvestmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvestmentsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListInvestments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) DistributeRevenue(ctx context.Context, in *DistributeRevenueRequest, opts ...grpc.CallOption) (*DistributeRevenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributeRevenueResponse)
+	err := c.cc.Invoke(ctx, BondingService_DistributeRevenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetRevenueHistory(ctx context.Context, in *GetRevenueHistoryRequest, opts ...grpc.CallOption) (*GetRevenueHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueHistoryResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetRevenueHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetPaymentSchedule(ctx context.Context, in *GetPaymentScheduleRequest, opts ...grpc.CallOption) (*GetPaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetPaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetYieldHistory(ctx context.Context, in *GetYieldHistoryRequest, opts ...grpc.CallOption) (*GetYieldHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetYieldHistoryResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetYieldHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) RequestEarlyRedemption(ctx context.Context, in *RequestEarlyRedemptionRequest, opts ...grpc.CallOption) (*RedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedemptionResponse)
+	err := c.cc.Invoke(ctx, BondingService_RequestEarlyRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ApproveRedemption(ctx context.Context, in *ApproveRedemptionRequest, opts ...grpc.CallOption) (*RedemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedemptionResponse)
+	err := c.cc.Invoke(ctx, BondingService_ApproveRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) QueueDistributions(ctx context.Context, in *QueueDistributionsRequest, opts ...grpc.CallOption) (*QueueDistributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueDistributionsResponse)
+	err := c.cc.Invoke(ctx, BondingService_QueueDistributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) SetDistributionSchedule(ctx context.Context, in *SetDistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributionSchedule)
+	err := c.cc.Invoke(ctx, BondingService_SetDistributionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributionSchedule)
+	err := c.cc.Invoke(ctx, BondingService_GetDistributionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PauseDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributionSchedule)
+	err := c.cc.Invoke(ctx, BondingService_PauseDistributionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ResumeDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DistributionSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributionSchedule)
+	err := c.cc.Invoke(ctx, BondingService_ResumeDistributionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) SkipDistributionRuns(ctx context.Context, in *SkipDistributionRunsRequest, opts ...grpc.CallOption) (*DistributionSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistributionSchedule)
+	err := c.cc.Invoke(ctx, BondingService_SkipDistributionRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) DeleteDistributionSchedule(ctx context.Context, in *DistributionScheduleRequest, opts ...grpc.CallOption) (*DeleteDistributionScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDistributionScheduleResponse)
+	err := c.cc.Invoke(ctx, BondingService_DeleteDistributionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ImportRevenueCSV(ctx context.Context, in *ImportRevenueCSVRequest, opts ...grpc.CallOption) (*ImportRevenueCSVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRevenueCSVResponse)
+	err := c.cc.Invoke(ctx, BondingService_ImportRevenueCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ReviewRevenueEvents(ctx context.Context, in *ReviewRevenueEventsRequest, opts ...grpc.CallOption) (*ReviewRevenueEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewRevenueEventsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ReviewRevenueEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListRevenueEvents(ctx context.Context, in *ListRevenueEventsRequest, opts ...grpc.CallOption) (*ListRevenueEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevenueEventsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListRevenueEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListAccrualSnapshots(ctx context.Context, in *ListAccrualSnapshotsRequest, opts ...grpc.CallOption) (*ListAccrualSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccrualSnapshotsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListAccrualSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) TransferInvestment(ctx context.Context, in *TransferInvestmentRequest, opts ...grpc.CallOption) (*TransferInvestmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferInvestmentResponse)
+	err := c.cc.Invoke(ctx, BondingService_TransferInvestment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChainStatusResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetChainStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PreparePermitInvestment(ctx context.Context, in *PreparePermitInvestmentRequest, opts ...grpc.CallOption) (*PreparePermitInvestmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreparePermitInvestmentResponse)
+	err := c.cc.Invoke(ctx, BondingService_PreparePermitInvestment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) InvestWithPermit(ctx context.Context, in *InvestWithPermitRequest, opts ...grpc.CallOption) (*InvestWithPermitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestWithPermitResponse)
+	err := c.cc.Invoke(ctx, BondingService_InvestWithPermit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*OrderInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderInfo)
+	err := c.cc.Invoke(ctx, BondingService_PlaceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PrepareFillOrder(ctx context.Context, in *PrepareFillOrderRequest, opts ...grpc.CallOption) (*PrepareFillOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareFillOrderResponse)
+	err := c.cc.Invoke(ctx, BondingService_PrepareFillOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) FillOrder(ctx context.Context, in *FillOrderRequest, opts ...grpc.CallOption) (*FillOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FillOrderResponse)
+	err := c.cc.Invoke(ctx, BondingService_FillOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderInfo)
+	err := c.cc.Invoke(ctx, BondingService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) UpsertAddressBookEntry(ctx context.Context, in *UpsertAddressBookEntryRequest, opts ...grpc.CallOption) (*AddressBookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressBookEntry)
+	err := c.cc.Invoke(ctx, BondingService_UpsertAddressBookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListAddressBookEntries(ctx context.Context, in *ListAddressBookEntriesRequest, opts ...grpc.CallOption) (*ListAddressBookEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressBookEntriesResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListAddressBookEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) DeleteAddressBookEntry(ctx context.Context, in *DeleteAddressBookEntryRequest, opts ...grpc.CallOption) (*DeleteAddressBookEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAddressBookEntryResponse)
+	err := c.cc.Invoke(ctx, BondingService_DeleteAddressBookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) SetTrancheLimits(ctx context.Context, in *SetTrancheLimitsRequest, opts ...grpc.CallOption) (*TrancheInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrancheInfo)
+	err := c.cc.Invoke(ctx, BondingService_SetTrancheLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (*ExportLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportLedgerResponse)
+	err := c.cc.Invoke(ctx, BondingService_ExportLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetDocumentURL(ctx context.Context, in *GetDocumentURLRequest, opts ...grpc.CallOption) (*GetDocumentURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentURLResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetDocumentURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) UpsertCategory(ctx context.Context, in *UpsertCategoryRequest, opts ...grpc.CallOption) (*CategoryInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryInfo)
+	err := c.cc.Invoke(ctx, BondingService_UpsertCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, BondingService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) SpeedUpTransaction(ctx context.Context, in *ReplaceTransactionRequest, opts ...grpc.CallOption) (*ReplaceTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceTransactionResponse)
+	err := c.cc.Invoke(ctx, BondingService_SpeedUpTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) CancelTransaction(ctx context.Context, in *ReplaceTransactionRequest, opts ...grpc.CallOption) (*ReplaceTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceTransactionResponse)
+	err := c.cc.Invoke(ctx, BondingService_CancelTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingTransactionsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListPendingTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, BondingService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GenerateProspectus(ctx context.Context, in *GenerateProspectusRequest, opts ...grpc.CallOption) (*GenerateProspectusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateProspectusResponse)
+	err := c.cc.Invoke(ctx, BondingService_GenerateProspectus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetCounterpartyRisk(ctx context.Context, in *GetCounterpartyRiskRequest, opts ...grpc.CallOption) (*GetCounterpartyRiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCounterpartyRiskResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetCounterpartyRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetRevenueVariance(ctx context.Context, in *GetRevenueVarianceRequest, opts ...grpc.CallOption) (*GetRevenueVarianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueVarianceResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetRevenueVariance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ValidateIssueBond(ctx context.Context, in *IssueBondRequest, opts ...grpc.CallOption) (*ValidateIssueBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateIssueBondResponse)
+	err := c.cc.Invoke(ctx, BondingService_ValidateIssueBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) EstimateIssuanceCost(ctx context.Context, in *EstimateIssuanceCostRequest, opts ...grpc.CallOption) (*EstimateIssuanceCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimateIssuanceCostResponse)
+	err := c.cc.Invoke(ctx, BondingService_EstimateIssuanceCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetInvestmentQuote(ctx context.Context, in *GetInvestmentQuoteRequest, opts ...grpc.CallOption) (*GetInvestmentQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestmentQuoteResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetInvestmentQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, BondingService_ScheduleMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*CancelMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelMaintenanceResponse)
+	err := c.cc.Invoke(ctx, BondingService_CancelMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetMaintenance(ctx context.Context, in *GetMaintenanceRequest, opts ...grpc.CallOption) (*GetMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) AssessIPRisk(ctx context.Context, in *AssessIPRiskRequest, opts ...grpc.CallOption) (*AssessIPRiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssessIPRiskResponse)
+	err := c.cc.Invoke(ctx, BondingService_AssessIPRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) AssessIPRiskBatch(ctx context.Context, in *AssessIPRiskBatchRequest, opts ...grpc.CallOption) (*AssessIPRiskBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssessIPRiskBatchResponse)
+	err := c.cc.Invoke(ctx, BondingService_AssessIPRiskBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListRiskModels(ctx context.Context, in *ListRiskModelsRequest, opts ...grpc.CallOption) (*ListRiskModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskModelsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListRiskModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetBondTimeline(ctx context.Context, in *GetBondTimelineRequest, opts ...grpc.CallOption) (*GetBondTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBondTimelineResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetBondTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetClaimableAmounts(ctx context.Context, in *GetClaimableAmountsRequest, opts ...grpc.CallOption) (*GetClaimableAmountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClaimableAmountsResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetClaimableAmounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PrepareClaim(ctx context.Context, in *PrepareClaimRequest, opts ...grpc.CallOption) (*PrepareClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareClaimResponse)
+	err := c.cc.Invoke(ctx, BondingService_PrepareClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetRiskAssessmentHistory(ctx context.Context, in *GetRiskAssessmentHistoryRequest, opts ...grpc.CallOption) (*GetRiskAssessmentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiskAssessmentHistoryResponse)
+	err := c.cc.Invoke(ctx, BondingService_GetRiskAssessmentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskAssessmentsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListRiskAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) RecordComparableSales(ctx context.Context, in *RecordComparableSalesRequest, opts ...grpc.CallOption) (*RecordComparableSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordComparableSalesResponse)
+	err := c.cc.Invoke(ctx, BondingService_RecordComparableSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) StressTest(ctx context.Context, in *StressTestRequest, opts ...grpc.CallOption) (*StressTestReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StressTestReport)
+	err := c.cc.Invoke(ctx, BondingService_StressTest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) GetPositionProof(ctx context.Context, in *GetPositionProofRequest, opts ...grpc.CallOption) (*PositionProof, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PositionProof)
+	err := c.cc.Invoke(ctx, BondingService_GetPositionProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) AddAccessListEntry(ctx context.Context, in *AddAccessListEntryRequest, opts ...grpc.CallOption) (*AccessListEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessListEntry)
+	err := c.cc.Invoke(ctx, BondingService_AddAccessListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) RemoveAccessListEntry(ctx context.Context, in *RemoveAccessListEntryRequest, opts ...grpc.CallOption) (*RemoveAccessListEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveAccessListEntryResponse)
+	err := c.cc.Invoke(ctx, BondingService_RemoveAccessListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListAccessListEntries(ctx context.Context, in *ListAccessListEntriesRequest, opts ...grpc.CallOption) (*ListAccessListEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessListEntriesResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListAccessListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, BondingService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) PauseBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBondStatusResponse)
+	err := c.cc.Invoke(ctx, BondingService_PauseBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) FreezeBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBondStatusResponse)
+	err := c.cc.Invoke(ctx, BondingService_FreezeBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) CancelBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBondStatusResponse)
+	err := c.cc.Invoke(ctx, BondingService_CancelBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ResumeBond(ctx context.Context, in *ChangeBondStatusRequest, opts ...grpc.CallOption) (*ChangeBondStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBondStatusResponse)
+	err := c.cc.Invoke(ctx, BondingService_ResumeBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) RequestEmergencyWithdrawal(ctx context.Context, in *RequestEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyWithdrawal)
+	err := c.cc.Invoke(ctx, BondingService_RequestEmergencyWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ConfirmEmergencyWithdrawal(ctx context.Context, in *ConfirmEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyWithdrawal)
+	err := c.cc.Invoke(ctx, BondingService_ConfirmEmergencyWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) CancelEmergencyWithdrawal(ctx context.Context, in *CancelEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*EmergencyWithdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyWithdrawal)
+	err := c.cc.Invoke(ctx, BondingService_CancelEmergencyWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListEmergencyWithdrawals(ctx context.Context, in *ListEmergencyWithdrawalsRequest, opts ...grpc.CallOption) (*ListEmergencyWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergencyWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListEmergencyWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*FeatureFlag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureFlag)
+	err := c.cc.Invoke(ctx, BondingService_SetFeatureFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bondingServiceClient) ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, BondingService_ListFeatureFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BondingServiceServer is the server API for BondingService service.
+// All implementations must embed UnimplementedBondingServiceServer
+// for forward compatibility.
+type BondingServiceServer interface {
+	IssueBond(context.Context, *IssueBondRequest) (*IssueBondResponse, error)
+	Invest(context.Context, *InvestRequest) (*InvestResponse, error)
+	GetBondInfo(context.Context, *GetBondInfoRequest) (*GetBondInfoResponse, error)
+	GetTrancheInfo(context.Context, *GetTrancheInfoRequest) (*GetTrancheInfoResponse, error)
+	ListBonds(context.Context, *ListBondsRequest) (*ListBondsResponse, error)
+	ListInvestments(context.Context, *ListInvestmentsRequest) (*ListInvestmentsResponse, error)
+	DistributeRevenue(context.Context, *DistributeRevenueRequest) (*DistributeRevenueResponse, error)
+	GetRevenueHistory(context.Context, *GetRevenueHistoryRequest) (*GetRevenueHistoryResponse, error)
+	GetPaymentSchedule(context.Context, *GetPaymentScheduleRequest) (*GetPaymentScheduleResponse, error)
+	GetYieldHistory(context.Context, *GetYieldHistoryRequest) (*GetYieldHistoryResponse, error)
+	RequestEarlyRedemption(context.Context, *RequestEarlyRedemptionRequest) (*RedemptionResponse, error)
+	ApproveRedemption(context.Context, *ApproveRedemptionRequest) (*RedemptionResponse, error)
+	QueueDistributions(context.Context, *QueueDistributionsRequest) (*QueueDistributionsResponse, error)
+	SetDistributionSchedule(context.Context, *SetDistributionScheduleRequest) (*DistributionSchedule, error)
+	GetDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error)
+	PauseDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error)
+	ResumeDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error)
+	SkipDistributionRuns(context.Context, *SkipDistributionRunsRequest) (*DistributionSchedule, error)
+	DeleteDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DeleteDistributionScheduleResponse, error)
+	ImportRevenueCSV(context.Context, *ImportRevenueCSVRequest) (*ImportRevenueCSVResponse, error)
+	ReviewRevenueEvents(context.Context, *ReviewRevenueEventsRequest) (*ReviewRevenueEventsResponse, error)
+	ListRevenueEvents(context.Context, *ListRevenueEventsRequest) (*ListRevenueEventsResponse, error)
+	ListAccrualSnapshots(context.Context, *ListAccrualSnapshotsRequest) (*ListAccrualSnapshotsResponse, error)
+	TransferInvestment(context.Context, *TransferInvestmentRequest) (*TransferInvestmentResponse, error)
+	GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error)
+	PreparePermitInvestment(context.Context, *PreparePermitInvestmentRequest) (*PreparePermitInvestmentResponse, error)
+	InvestWithPermit(context.Context, *InvestWithPermitRequest) (*InvestWithPermitResponse, error)
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*OrderInfo, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	PrepareFillOrder(context.Context, *PrepareFillOrderRequest) (*PrepareFillOrderResponse, error)
+	FillOrder(context.Context, *FillOrderRequest) (*FillOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*OrderInfo, error)
+	UpsertAddressBookEntry(context.Context, *UpsertAddressBookEntryRequest) (*AddressBookEntry, error)
+	ListAddressBookEntries(context.Context, *ListAddressBookEntriesRequest) (*ListAddressBookEntriesResponse, error)
+	DeleteAddressBookEntry(context.Context, *DeleteAddressBookEntryRequest) (*DeleteAddressBookEntryResponse, error)
+	SetTrancheLimits(context.Context, *SetTrancheLimitsRequest) (*TrancheInfo, error)
+	ExportLedger(context.Context, *ExportLedgerRequest) (*ExportLedgerResponse, error)
+	GetDocumentURL(context.Context, *GetDocumentURLRequest) (*GetDocumentURLResponse, error)
+	UpsertCategory(context.Context, *UpsertCategoryRequest) (*CategoryInfo, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	SpeedUpTransaction(context.Context, *ReplaceTransactionRequest) (*ReplaceTransactionResponse, error)
+	CancelTransaction(context.Context, *ReplaceTransactionRequest) (*ReplaceTransactionResponse, error)
+	ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error)
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error)
+	GenerateProspectus(context.Context, *GenerateProspectusRequest) (*GenerateProspectusResponse, error)
+	GetCounterpartyRisk(context.Context, *GetCounterpartyRiskRequest) (*GetCounterpartyRiskResponse, error)
+	GetRevenueVariance(context.Context, *GetRevenueVarianceRequest) (*GetRevenueVarianceResponse, error)
+	ValidateIssueBond(context.Context, *IssueBondRequest) (*ValidateIssueBondResponse, error)
+	EstimateIssuanceCost(context.Context, *EstimateIssuanceCostRequest) (*EstimateIssuanceCostResponse, error)
+	GetInvestmentQuote(context.Context, *GetInvestmentQuoteRequest) (*GetInvestmentQuoteResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceWindow, error)
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+	GetMaintenance(context.Context, *GetMaintenanceRequest) (*GetMaintenanceResponse, error)
+	AssessIPRisk(context.Context, *AssessIPRiskRequest) (*AssessIPRiskResponse, error)
+	AssessIPRiskBatch(context.Context, *AssessIPRiskBatchRequest) (*AssessIPRiskBatchResponse, error)
+	ListRiskModels(context.Context, *ListRiskModelsRequest) (*ListRiskModelsResponse, error)
+	GetBondTimeline(context.Context, *GetBondTimelineRequest) (*GetBondTimelineResponse, error)
+	GetClaimableAmounts(context.Context, *GetClaimableAmountsRequest) (*GetClaimableAmountsResponse, error)
+	PrepareClaim(context.Context, *PrepareClaimRequest) (*PrepareClaimResponse, error)
+	GetRiskAssessmentHistory(context.Context, *GetRiskAssessmentHistoryRequest) (*GetRiskAssessmentHistoryResponse, error)
+	ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error)
+	RecordComparableSales(context.Context, *RecordComparableSalesRequest) (*RecordComparableSalesResponse, error)
+	StressTest(context.Context, *StressTestRequest) (*StressTestReport, error)
+	GetPositionProof(context.Context, *GetPositionProofRequest) (*PositionProof, error)
+	AddAccessListEntry(context.Context, *AddAccessListEntryRequest) (*AccessListEntry, error)
+	RemoveAccessListEntry(context.Context, *RemoveAccessListEntryRequest) (*RemoveAccessListEntryResponse, error)
+	ListAccessListEntries(context.Context, *ListAccessListEntriesRequest) (*ListAccessListEntriesResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	PauseBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error)
+	FreezeBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error)
+	CancelBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error)
+	ResumeBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error)
+	RequestEmergencyWithdrawal(context.Context, *RequestEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error)
+	ConfirmEmergencyWithdrawal(context.Context, *ConfirmEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error)
+	CancelEmergencyWithdrawal(context.Context, *CancelEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error)
+	ListEmergencyWithdrawals(context.Context, *ListEmergencyWithdrawalsRequest) (*ListEmergencyWithdrawalsResponse, error)
+	SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*FeatureFlag, error)
+	ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error)
+	mustEmbedUnimplementedBondingServiceServer()
+}
+
+// UnimplementedBondingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBondingServiceServer struct{}
+
+func (UnimplementedBondingServiceServer) IssueBond(context.Context, *IssueBondRequest) (*IssueBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueBond not implemented")
+}
+func (UnimplementedBondingServiceServer) Invest(context.Context, *InvestRequest) (*InvestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invest not implemented")
+}
+func (UnimplementedBondingServiceServer) GetBondInfo(context.Context, *GetBondInfoRequest) (*GetBondInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBondInfo not implemented")
+}
+func (UnimplementedBondingServiceServer) GetTrancheInfo(context.Context, *GetTrancheInfoRequest) (*GetTrancheInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrancheInfo not implemented")
+}
+func (UnimplementedBondingServiceServer) ListBonds(context.Context, *ListBondsRequest) (*ListBondsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBonds not implemented")
+}
+func (UnimplementedBondingServiceServer) ListInvestments(context.Context, *ListInvestmentsRequest) (*ListInvestmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestments not implemented")
+}
+func (UnimplementedBondingServiceServer) DistributeRevenue(context.Context, *DistributeRevenueRequest) (*DistributeRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeRevenue not implemented")
+}
+func (UnimplementedBondingServiceServer) GetRevenueHistory(context.Context, *GetRevenueHistoryRequest) (*GetRevenueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenueHistory not implemented")
+}
+func (UnimplementedBondingServiceServer) GetPaymentSchedule(context.Context, *GetPaymentScheduleRequest) (*GetPaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) GetYieldHistory(context.Context, *GetYieldHistoryRequest) (*GetYieldHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldHistory not implemented")
+}
+func (UnimplementedBondingServiceServer) RequestEarlyRedemption(context.Context, *RequestEarlyRedemptionRequest) (*RedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEarlyRedemption not implemented")
+}
+func (UnimplementedBondingServiceServer) ApproveRedemption(context.Context, *ApproveRedemptionRequest) (*RedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRedemption not implemented")
+}
+func (UnimplementedBondingServiceServer) QueueDistributions(context.Context, *QueueDistributionsRequest) (*QueueDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueDistributions not implemented")
+}
+func (UnimplementedBondingServiceServer) SetDistributionSchedule(context.Context, *SetDistributionScheduleRequest) (*DistributionSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDistributionSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) GetDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDistributionSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) PauseDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseDistributionSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) ResumeDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DistributionSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeDistributionSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) SkipDistributionRuns(context.Context, *SkipDistributionRunsRequest) (*DistributionSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipDistributionRuns not implemented")
+}
+func (UnimplementedBondingServiceServer) DeleteDistributionSchedule(context.Context, *DistributionScheduleRequest) (*DeleteDistributionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDistributionSchedule not implemented")
+}
+func (UnimplementedBondingServiceServer) ImportRevenueCSV(context.Context, *ImportRevenueCSVRequest) (*ImportRevenueCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRevenueCSV not implemented")
+}
+func (UnimplementedBondingServiceServer) ReviewRevenueEvents(context.Context, *ReviewRevenueEventsRequest) (*ReviewRevenueEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewRevenueEvents not implemented")
+}
+func (UnimplementedBondingServiceServer) ListRevenueEvents(context.Context, *ListRevenueEventsRequest) (*ListRevenueEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevenueEvents not implemented")
+}
+func (UnimplementedBondingServiceServer) ListAccrualSnapshots(context.Context, *ListAccrualSnapshotsRequest) (*ListAccrualSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccrualSnapshots not implemented")
+}
+func (UnimplementedBondingServiceServer) TransferInvestment(context.Context, *TransferInvestmentRequest) (*TransferInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferInvestment not implemented")
+}
+func (UnimplementedBondingServiceServer) GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStatus not implemented")
+}
+func (UnimplementedBondingServiceServer) PreparePermitInvestment(context.Context, *PreparePermitInvestmentRequest) (*PreparePermitInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreparePermitInvestment not implemented")
+}
+func (UnimplementedBondingServiceServer) InvestWithPermit(context.Context, *InvestWithPermitRequest) (*InvestWithPermitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvestWithPermit not implemented")
+}
+func (UnimplementedBondingServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*OrderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedBondingServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedBondingServiceServer) PrepareFillOrder(context.Context, *PrepareFillOrderRequest) (*PrepareFillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareFillOrder not implemented")
+}
+func (UnimplementedBondingServiceServer) FillOrder(context.Context, *FillOrderRequest) (*FillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillOrder not implemented")
+}
+func (UnimplementedBondingServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*OrderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedBondingServiceServer) UpsertAddressBookEntry(context.Context, *UpsertAddressBookEntryRequest) (*AddressBookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAddressBookEntry not implemented")
+}
+func (UnimplementedBondingServiceServer) ListAddressBookEntries(context.Context, *ListAddressBookEntriesRequest) (*ListAddressBookEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressBookEntries not implemented")
+}
+func (UnimplementedBondingServiceServer) DeleteAddressBookEntry(context.Context, *DeleteAddressBookEntryRequest) (*DeleteAddressBookEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddressBookEntry not implemented")
+}
+func (UnimplementedBondingServiceServer) SetTrancheLimits(context.Context, *SetTrancheLimitsRequest) (*TrancheInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrancheLimits not implemented")
+}
+func (UnimplementedBondingServiceServer) ExportLedger(context.Context, *ExportLedgerRequest) (*ExportLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportLedger not implemented")
+}
+func (UnimplementedBondingServiceServer) GetDocumentURL(context.Context, *GetDocumentURLRequest) (*GetDocumentURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentURL not implemented")
+}
+func (UnimplementedBondingServiceServer) UpsertCategory(context.Context, *UpsertCategoryRequest) (*CategoryInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertCategory not implemented")
+}
+func (UnimplementedBondingServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedBondingServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedBondingServiceServer) SpeedUpTransaction(context.Context, *ReplaceTransactionRequest) (*ReplaceTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpeedUpTransaction not implemented")
+}
+func (UnimplementedBondingServiceServer) CancelTransaction(context.Context, *ReplaceTransactionRequest) (*ReplaceTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransaction not implemented")
+}
+func (UnimplementedBondingServiceServer) ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransactions not implemented")
+}
+func (UnimplementedBondingServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedBondingServiceServer) GenerateProspectus(context.Context, *GenerateProspectusRequest) (*GenerateProspectusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateProspectus not implemented")
+}
+func (UnimplementedBondingServiceServer) GetCounterpartyRisk(context.Context, *GetCounterpartyRiskRequest) (*GetCounterpartyRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounterpartyRisk not implemented")
+}
+func (UnimplementedBondingServiceServer) GetRevenueVariance(context.Context, *GetRevenueVarianceRequest) (*GetRevenueVarianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenueVariance not implemented")
+}
+func (UnimplementedBondingServiceServer) ValidateIssueBond(context.Context, *IssueBondRequest) (*ValidateIssueBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateIssueBond not implemented")
+}
+func (UnimplementedBondingServiceServer) EstimateIssuanceCost(context.Context, *EstimateIssuanceCostRequest) (*EstimateIssuanceCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateIssuanceCost not implemented")
+}
+func (UnimplementedBondingServiceServer) GetInvestmentQuote(context.Context, *GetInvestmentQuoteRequest) (*GetInvestmentQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvestmentQuote not implemented")
+}
+func (UnimplementedBondingServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedBondingServiceServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedBondingServiceServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenance not implemented")
+}
+func (UnimplementedBondingServiceServer) GetMaintenance(context.Context, *GetMaintenanceRequest) (*GetMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenance not implemented")
+}
+func (UnimplementedBondingServiceServer) AssessIPRisk(context.Context, *AssessIPRiskRequest) (*AssessIPRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssessIPRisk not implemented")
+}
+func (UnimplementedBondingServiceServer) AssessIPRiskBatch(context.Context, *AssessIPRiskBatchRequest) (*AssessIPRiskBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssessIPRiskBatch not implemented")
+}
+func (UnimplementedBondingServiceServer) ListRiskModels(context.Context, *ListRiskModelsRequest) (*ListRiskModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskModels not implemented")
+}
+func (UnimplementedBondingServiceServer) GetBondTimeline(context.Context, *GetBondTimelineRequest) (*GetBondTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBondTimeline not implemented")
+}
+func (UnimplementedBondingServiceServer) GetClaimableAmounts(context.Context, *GetClaimableAmountsRequest) (*GetClaimableAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimableAmounts not implemented")
+}
+func (UnimplementedBondingServiceServer) PrepareClaim(context.Context, *PrepareClaimRequest) (*PrepareClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareClaim not implemented")
+}
+func (UnimplementedBondingServiceServer) GetRiskAssessmentHistory(context.Context, *GetRiskAssessmentHistoryRequest) (*GetRiskAssessmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiskAssessmentHistory not implemented")
+}
+func (UnimplementedBondingServiceServer) ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskAssessments not implemented")
+}
+func (UnimplementedBondingServiceServer) RecordComparableSales(context.Context, *RecordComparableSalesRequest) (*RecordComparableSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordComparableSales not implemented")
+}
+func (UnimplementedBondingServiceServer) StressTest(context.Context, *StressTestRequest) (*StressTestReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StressTest not implemented")
+}
+func (UnimplementedBondingServiceServer) GetPositionProof(context.Context, *GetPositionProofRequest) (*PositionProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionProof not implemented")
+}
+func (UnimplementedBondingServiceServer) AddAccessListEntry(context.Context, *AddAccessListEntryRequest) (*AccessListEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccessListEntry not implemented")
+}
+func (UnimplementedBondingServiceServer) RemoveAccessListEntry(context.Context, *RemoveAccessListEntryRequest) (*RemoveAccessListEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccessListEntry not implemented")
+}
+func (UnimplementedBondingServiceServer) ListAccessListEntries(context.Context, *ListAccessListEntriesRequest) (*ListAccessListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessListEntries not implemented")
+}
+func (UnimplementedBondingServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedBondingServiceServer) PauseBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBond not implemented")
+}
+func (UnimplementedBondingServiceServer) FreezeBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeBond not implemented")
+}
+func (UnimplementedBondingServiceServer) CancelBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBond not implemented")
+}
+func (UnimplementedBondingServiceServer) ResumeBond(context.Context, *ChangeBondStatusRequest) (*ChangeBondStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBond not implemented")
+}
+func (UnimplementedBondingServiceServer) RequestEmergencyWithdrawal(context.Context, *RequestEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmergencyWithdrawal not implemented")
+}
+func (UnimplementedBondingServiceServer) ConfirmEmergencyWithdrawal(context.Context, *ConfirmEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmergencyWithdrawal not implemented")
+}
+func (UnimplementedBondingServiceServer) CancelEmergencyWithdrawal(context.Context, *CancelEmergencyWithdrawalRequest) (*EmergencyWithdrawal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEmergencyWithdrawal not implemented")
+}
+func (UnimplementedBondingServiceServer) ListEmergencyWithdrawals(context.Context, *ListEmergencyWithdrawalsRequest) (*ListEmergencyWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyWithdrawals not implemented")
+}
+func (UnimplementedBondingServiceServer) SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*FeatureFlag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureFlag not implemented")
+}
+func (UnimplementedBondingServiceServer) ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlags not implemented")
+}
+func (UnimplementedBondingServiceServer) mustEmbedUnimplementedBondingServiceServer() {}
+func (UnimplementedBondingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBondingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BondingServiceServer will
+// result in compilation errors.
+type UnsafeBondingServiceServer interface {
+	mustEmbedUnimplementedBondingServiceServer()
+}
+
+func RegisterBondingServiceServer(s grpc.ServiceRegistrar, srv BondingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBondingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BondingService_ServiceDesc, srv)
+}
+
+func _BondingService_IssueBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).IssueBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_IssueBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).IssueBond(ctx, req.(*IssueBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_Invest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).Invest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_Invest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).Invest(ctx, req.(*InvestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetBondInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBondInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetBondInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetBondInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetBondInfo(ctx, req.(*GetBondInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetTrancheInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrancheInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetTrancheInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetTrancheInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetTrancheInfo(ctx, req.(*GetTrancheInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListBonds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBondsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListBonds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListBonds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListBonds(ctx, req.(*ListBondsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListInvestments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListInvestments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListInvestments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListInvestments(ctx, req.(*ListInvestmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_DistributeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributeRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).DistributeRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_DistributeRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).DistributeRevenue(ctx, req.(*DistributeRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetRevenueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetRevenueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetRevenueHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetRevenueHistory(ctx, req.(*GetRevenueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetPaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetPaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetPaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetPaymentSchedule(ctx, req.(*GetPaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetYieldHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetYieldHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetYieldHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetYieldHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetYieldHistory(ctx, req.(*GetYieldHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_RequestEarlyRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEarlyRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).RequestEarlyRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_RequestEarlyRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).RequestEarlyRedemption(ctx, req.(*RequestEarlyRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ApproveRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ApproveRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ApproveRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ApproveRedemption(ctx, req.(*ApproveRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_QueueDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).QueueDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_QueueDistributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).QueueDistributions(ctx, req.(*QueueDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_SetDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDistributionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).SetDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_SetDistributionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).SetDistributionSchedule(ctx, req.(*SetDistributionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetDistributionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetDistributionSchedule(ctx, req.(*DistributionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PauseDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PauseDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PauseDistributionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PauseDistributionSchedule(ctx, req.(*DistributionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ResumeDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ResumeDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ResumeDistributionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ResumeDistributionSchedule(ctx, req.(*DistributionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_SkipDistributionRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipDistributionRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).SkipDistributionRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_SkipDistributionRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).SkipDistributionRuns(ctx, req.(*SkipDistributionRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_DeleteDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).DeleteDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_DeleteDistributionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).DeleteDistributionSchedule(ctx, req.(*DistributionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ImportRevenueCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRevenueCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ImportRevenueCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ImportRevenueCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ImportRevenueCSV(ctx, req.(*ImportRevenueCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ReviewRevenueEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRevenueEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ReviewRevenueEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ReviewRevenueEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ReviewRevenueEvents(ctx, req.(*ReviewRevenueEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListRevenueEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevenueEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListRevenueEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListRevenueEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListRevenueEvents(ctx, req.(*ListRevenueEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListAccrualSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccrualSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListAccrualSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListAccrualSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListAccrualSnapshots(ctx, req.(*ListAccrualSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_TransferInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).TransferInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_TransferInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).TransferInvestment(ctx, req.(*TransferInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetChainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetChainStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetChainStatus(ctx, req.(*GetChainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PreparePermitInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreparePermitInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PreparePermitInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PreparePermitInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PreparePermitInvestment(ctx, req.(*PreparePermitInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_InvestWithPermit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvestWithPermitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).InvestWithPermit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_InvestWithPermit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).InvestWithPermit(ctx, req.(*InvestWithPermitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PrepareFillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareFillOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PrepareFillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PrepareFillOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PrepareFillOrder(ctx, req.(*PrepareFillOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_FillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).FillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_FillOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).FillOrder(ctx, req.(*FillOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_UpsertAddressBookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAddressBookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).UpsertAddressBookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_UpsertAddressBookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).UpsertAddressBookEntry(ctx, req.(*UpsertAddressBookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListAddressBookEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressBookEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListAddressBookEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListAddressBookEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListAddressBookEntries(ctx, req.(*ListAddressBookEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_DeleteAddressBookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressBookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).DeleteAddressBookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_DeleteAddressBookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).DeleteAddressBookEntry(ctx, req.(*DeleteAddressBookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_SetTrancheLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrancheLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).SetTrancheLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_SetTrancheLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).SetTrancheLimits(ctx, req.(*SetTrancheLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ExportLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ExportLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ExportLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ExportLedger(ctx, req.(*ExportLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetDocumentURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetDocumentURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetDocumentURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetDocumentURL(ctx, req.(*GetDocumentURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_UpsertCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).UpsertCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_UpsertCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).UpsertCategory(ctx, req.(*UpsertCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_SpeedUpTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).SpeedUpTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_SpeedUpTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).SpeedUpTransaction(ctx, req.(*ReplaceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_CancelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).CancelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_CancelTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).CancelTransaction(ctx, req.(*ReplaceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListPendingTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListPendingTransactions(ctx, req.(*ListPendingTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetReconciliationReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GenerateProspectus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateProspectusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GenerateProspectus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GenerateProspectus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GenerateProspectus(ctx, req.(*GenerateProspectusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetCounterpartyRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCounterpartyRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetCounterpartyRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetCounterpartyRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetCounterpartyRisk(ctx, req.(*GetCounterpartyRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetRevenueVariance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueVarianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetRevenueVariance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetRevenueVariance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetRevenueVariance(ctx, req.(*GetRevenueVarianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ValidateIssueBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ValidateIssueBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ValidateIssueBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ValidateIssueBond(ctx, req.(*IssueBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_EstimateIssuanceCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateIssuanceCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).EstimateIssuanceCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_EstimateIssuanceCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).EstimateIssuanceCost(ctx, req.(*EstimateIssuanceCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetInvestmentQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestmentQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetInvestmentQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetInvestmentQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetInvestmentQuote(ctx, req.(*GetInvestmentQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_CancelMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).CancelMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_CancelMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).CancelMaintenance(ctx, req.(*CancelMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetMaintenance(ctx, req.(*GetMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_AssessIPRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessIPRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).AssessIPRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_AssessIPRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).AssessIPRisk(ctx, req.(*AssessIPRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_AssessIPRiskBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessIPRiskBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).AssessIPRiskBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_AssessIPRiskBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).AssessIPRiskBatch(ctx, req.(*AssessIPRiskBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListRiskModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListRiskModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListRiskModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListRiskModels(ctx, req.(*ListRiskModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetBondTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBondTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetBondTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetBondTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetBondTimeline(ctx, req.(*GetBondTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetClaimableAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimableAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetClaimableAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetClaimableAmounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetClaimableAmounts(ctx, req.(*GetClaimableAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PrepareClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PrepareClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PrepareClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PrepareClaim(ctx, req.(*PrepareClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetRiskAssessmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskAssessmentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetRiskAssessmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetRiskAssessmentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetRiskAssessmentHistory(ctx, req.(*GetRiskAssessmentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListRiskAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListRiskAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListRiskAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListRiskAssessments(ctx, req.(*ListRiskAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_RecordComparableSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordComparableSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).RecordComparableSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_RecordComparableSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).RecordComparableSales(ctx, req.(*RecordComparableSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_StressTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StressTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).StressTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_StressTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).StressTest(ctx, req.(*StressTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_GetPositionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).GetPositionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_GetPositionProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).GetPositionProof(ctx, req.(*GetPositionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_AddAccessListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAccessListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).AddAccessListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_AddAccessListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).AddAccessListEntry(ctx, req.(*AddAccessListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_RemoveAccessListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAccessListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).RemoveAccessListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_RemoveAccessListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).RemoveAccessListEntry(ctx, req.(*RemoveAccessListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_ListAccessListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListAccessListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListAccessListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListAccessListEntries(ctx, req.(*ListAccessListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_PauseBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBondStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).PauseBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_PauseBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).PauseBond(ctx, req.(*ChangeBondStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_FreezeBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBondStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).FreezeBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_FreezeBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).FreezeBond(ctx, req.(*ChangeBondStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BondingService_CancelBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBondStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).CancelBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_CancelBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).CancelBond(ctx, req.(*ChangeBondStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bondingServiceClient) IssueBond(ctx context.Context, in *IssueBondRequest, opts ...grpc.CallOption) (*IssueBondResponse, error) {
-	out := new(IssueBondResponse)
-	err := c.cc.Invoke(ctx, "/bonding.BondingService/IssueBond", in, out, opts...)
-	if err != nil {
+func _BondingService_ResumeBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBondStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ResumeBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ResumeBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ResumeBond(ctx, req.(*ChangeBondStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type BondingServiceServer interface {
-	IssueBond(context.Context, *IssueBondRequest) (*IssueBondResponse, error)
-	mustEmbedUnimplementedBondingServiceServer()
+func _BondingService_RequestEmergencyWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmergencyWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).RequestEmergencyWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_RequestEmergencyWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).RequestEmergencyWithdrawal(ctx, req.(*RequestEmergencyWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type UnimplementedBondingServiceServer struct {
+func _BondingService_ConfirmEmergencyWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmergencyWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ConfirmEmergencyWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ConfirmEmergencyWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ConfirmEmergencyWithdrawal(ctx, req.(*ConfirmEmergencyWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedBondingServiceServer) IssueBond(context.Context, *IssueBondRequest) (*IssueBondResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssueBond not implemented")
+func _BondingService_CancelEmergencyWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEmergencyWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).CancelEmergencyWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_CancelEmergencyWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).CancelEmergencyWithdrawal(ctx, req.(*CancelEmergencyWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBondingServiceServer) mustEmbedUnimplementedBondingServiceServer() {}
 
-type UnsafeBondingServiceServer interface {
-	mustEmbedUnimplementedBondingServiceServer()
+func _BondingService_ListEmergencyWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).ListEmergencyWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_ListEmergencyWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).ListEmergencyWithdrawals(ctx, req.(*ListEmergencyWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBondingServiceServer(s grpc.ServiceRegistrar, srv BondingServiceServer) {
-	s.RegisterService(&BondingService_ServiceDesc, srv)
+func _BondingService_SetFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BondingServiceServer).SetFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BondingService_SetFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BondingServiceServer).SetFeatureFlag(ctx, req.(*SetFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BondingService_IssueBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IssueBondRequest)
+func _BondingService_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureFlagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BondingServiceServer).IssueBond(ctx, in)
+		return srv.(BondingServiceServer).ListFeatureFlags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bonding.BondingService/IssueBond",
+		FullMethod: BondingService_ListFeatureFlags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BondingServiceServer).IssueBond(ctx, req.(*IssueBondRequest))
+		return srv.(BondingServiceServer).ListFeatureFlags(ctx, req.(*ListFeatureFlagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+// BondingService_ServiceDesc is the grpc.ServiceDesc for BondingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
 var BondingService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "bonding.BondingService",
 	HandlerType: (*BondingServiceServer)(nil),
@@ -79,6 +2801,322 @@ var BondingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueBond",
 			Handler:    _BondingService_IssueBond_Handler,
 		},
+		{
+			MethodName: "Invest",
+			Handler:    _BondingService_Invest_Handler,
+		},
+		{
+			MethodName: "GetBondInfo",
+			Handler:    _BondingService_GetBondInfo_Handler,
+		},
+		{
+			MethodName: "GetTrancheInfo",
+			Handler:    _BondingService_GetTrancheInfo_Handler,
+		},
+		{
+			MethodName: "ListBonds",
+			Handler:    _BondingService_ListBonds_Handler,
+		},
+		{
+			MethodName: "ListInvestments",
+			Handler:    _BondingService_ListInvestments_Handler,
+		},
+		{
+			MethodName: "DistributeRevenue",
+			Handler:    _BondingService_DistributeRevenue_Handler,
+		},
+		{
+			MethodName: "GetRevenueHistory",
+			Handler:    _BondingService_GetRevenueHistory_Handler,
+		},
+		{
+			MethodName: "GetPaymentSchedule",
+			Handler:    _BondingService_GetPaymentSchedule_Handler,
+		},
+		{
+			MethodName: "GetYieldHistory",
+			Handler:    _BondingService_GetYieldHistory_Handler,
+		},
+		{
+			MethodName: "RequestEarlyRedemption",
+			Handler:    _BondingService_RequestEarlyRedemption_Handler,
+		},
+		{
+			MethodName: "ApproveRedemption",
+			Handler:    _BondingService_ApproveRedemption_Handler,
+		},
+		{
+			MethodName: "QueueDistributions",
+			Handler:    _BondingService_QueueDistributions_Handler,
+		},
+		{
+			MethodName: "SetDistributionSchedule",
+			Handler:    _BondingService_SetDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "GetDistributionSchedule",
+			Handler:    _BondingService_GetDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "PauseDistributionSchedule",
+			Handler:    _BondingService_PauseDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeDistributionSchedule",
+			Handler:    _BondingService_ResumeDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "SkipDistributionRuns",
+			Handler:    _BondingService_SkipDistributionRuns_Handler,
+		},
+		{
+			MethodName: "DeleteDistributionSchedule",
+			Handler:    _BondingService_DeleteDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "ImportRevenueCSV",
+			Handler:    _BondingService_ImportRevenueCSV_Handler,
+		},
+		{
+			MethodName: "ReviewRevenueEvents",
+			Handler:    _BondingService_ReviewRevenueEvents_Handler,
+		},
+		{
+			MethodName: "ListRevenueEvents",
+			Handler:    _BondingService_ListRevenueEvents_Handler,
+		},
+		{
+			MethodName: "ListAccrualSnapshots",
+			Handler:    _BondingService_ListAccrualSnapshots_Handler,
+		},
+		{
+			MethodName: "TransferInvestment",
+			Handler:    _BondingService_TransferInvestment_Handler,
+		},
+		{
+			MethodName: "GetChainStatus",
+			Handler:    _BondingService_GetChainStatus_Handler,
+		},
+		{
+			MethodName: "PreparePermitInvestment",
+			Handler:    _BondingService_PreparePermitInvestment_Handler,
+		},
+		{
+			MethodName: "InvestWithPermit",
+			Handler:    _BondingService_InvestWithPermit_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _BondingService_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _BondingService_ListOrders_Handler,
+		},
+		{
+			MethodName: "PrepareFillOrder",
+			Handler:    _BondingService_PrepareFillOrder_Handler,
+		},
+		{
+			MethodName: "FillOrder",
+			Handler:    _BondingService_FillOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _BondingService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "UpsertAddressBookEntry",
+			Handler:    _BondingService_UpsertAddressBookEntry_Handler,
+		},
+		{
+			MethodName: "ListAddressBookEntries",
+			Handler:    _BondingService_ListAddressBookEntries_Handler,
+		},
+		{
+			MethodName: "DeleteAddressBookEntry",
+			Handler:    _BondingService_DeleteAddressBookEntry_Handler,
+		},
+		{
+			MethodName: "SetTrancheLimits",
+			Handler:    _BondingService_SetTrancheLimits_Handler,
+		},
+		{
+			MethodName: "ExportLedger",
+			Handler:    _BondingService_ExportLedger_Handler,
+		},
+		{
+			MethodName: "GetDocumentURL",
+			Handler:    _BondingService_GetDocumentURL_Handler,
+		},
+		{
+			MethodName: "UpsertCategory",
+			Handler:    _BondingService_UpsertCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _BondingService_ListCategories_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _BondingService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "SpeedUpTransaction",
+			Handler:    _BondingService_SpeedUpTransaction_Handler,
+		},
+		{
+			MethodName: "CancelTransaction",
+			Handler:    _BondingService_CancelTransaction_Handler,
+		},
+		{
+			MethodName: "ListPendingTransactions",
+			Handler:    _BondingService_ListPendingTransactions_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _BondingService_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "GenerateProspectus",
+			Handler:    _BondingService_GenerateProspectus_Handler,
+		},
+		{
+			MethodName: "GetCounterpartyRisk",
+			Handler:    _BondingService_GetCounterpartyRisk_Handler,
+		},
+		{
+			MethodName: "GetRevenueVariance",
+			Handler:    _BondingService_GetRevenueVariance_Handler,
+		},
+		{
+			MethodName: "ValidateIssueBond",
+			Handler:    _BondingService_ValidateIssueBond_Handler,
+		},
+		{
+			MethodName: "EstimateIssuanceCost",
+			Handler:    _BondingService_EstimateIssuanceCost_Handler,
+		},
+		{
+			MethodName: "GetInvestmentQuote",
+			Handler:    _BondingService_GetInvestmentQuote_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _BondingService_GetUsage_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _BondingService_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelMaintenance",
+			Handler:    _BondingService_CancelMaintenance_Handler,
+		},
+		{
+			MethodName: "GetMaintenance",
+			Handler:    _BondingService_GetMaintenance_Handler,
+		},
+		{
+			MethodName: "AssessIPRisk",
+			Handler:    _BondingService_AssessIPRisk_Handler,
+		},
+		{
+			MethodName: "AssessIPRiskBatch",
+			Handler:    _BondingService_AssessIPRiskBatch_Handler,
+		},
+		{
+			MethodName: "ListRiskModels",
+			Handler:    _BondingService_ListRiskModels_Handler,
+		},
+		{
+			MethodName: "GetBondTimeline",
+			Handler:    _BondingService_GetBondTimeline_Handler,
+		},
+		{
+			MethodName: "GetClaimableAmounts",
+			Handler:    _BondingService_GetClaimableAmounts_Handler,
+		},
+		{
+			MethodName: "PrepareClaim",
+			Handler:    _BondingService_PrepareClaim_Handler,
+		},
+		{
+			MethodName: "GetRiskAssessmentHistory",
+			Handler:    _BondingService_GetRiskAssessmentHistory_Handler,
+		},
+		{
+			MethodName: "ListRiskAssessments",
+			Handler:    _BondingService_ListRiskAssessments_Handler,
+		},
+		{
+			MethodName: "RecordComparableSales",
+			Handler:    _BondingService_RecordComparableSales_Handler,
+		},
+		{
+			MethodName: "StressTest",
+			Handler:    _BondingService_StressTest_Handler,
+		},
+		{
+			MethodName: "GetPositionProof",
+			Handler:    _BondingService_GetPositionProof_Handler,
+		},
+		{
+			MethodName: "AddAccessListEntry",
+			Handler:    _BondingService_AddAccessListEntry_Handler,
+		},
+		{
+			MethodName: "RemoveAccessListEntry",
+			Handler:    _BondingService_RemoveAccessListEntry_Handler,
+		},
+		{
+			MethodName: "ListAccessListEntries",
+			Handler:    _BondingService_ListAccessListEntries_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _BondingService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "PauseBond",
+			Handler:    _BondingService_PauseBond_Handler,
+		},
+		{
+			MethodName: "FreezeBond",
+			Handler:    _BondingService_FreezeBond_Handler,
+		},
+		{
+			MethodName: "CancelBond",
+			Handler:    _BondingService_CancelBond_Handler,
+		},
+		{
+			MethodName: "ResumeBond",
+			Handler:    _BondingService_ResumeBond_Handler,
+		},
+		{
+			MethodName: "RequestEmergencyWithdrawal",
+			Handler:    _BondingService_RequestEmergencyWithdrawal_Handler,
+		},
+		{
+			MethodName: "ConfirmEmergencyWithdrawal",
+			Handler:    _BondingService_ConfirmEmergencyWithdrawal_Handler,
+		},
+		{
+			MethodName: "CancelEmergencyWithdrawal",
+			Handler:    _BondingService_CancelEmergencyWithdrawal_Handler,
+		},
+		{
+			MethodName: "ListEmergencyWithdrawals",
+			Handler:    _BondingService_ListEmergencyWithdrawals_Handler,
+		},
+		{
+			MethodName: "SetFeatureFlag",
+			Handler:    _BondingService_SetFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _BondingService_ListFeatureFlags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/bonding.proto",
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/knowton/bonding-service/internal/blockchain"
+	"github.com/knowton/bonding-service/internal/chains"
 	"github.com/knowton/bonding-service/internal/oracle"
 	"github.com/knowton/bonding-service/internal/risk"
 	"github.com/stretchr/testify/assert"
@@ -396,11 +397,18 @@ type TestConfig struct {
 
 // loadTestConfig loads configuration from environment variables
 func loadTestConfig(t *testing.T) *TestConfig {
+	chainName := os.Getenv("TEST_CHAIN")
+	if chainName == "" {
+		chainName = chains.ArbitrumSepolia
+	}
+	chain, err := chains.Builtin().Get(chainName)
+	require.NoError(t, err, "Unknown test chain")
+
 	config := &TestConfig{
 		RPCURL:          os.Getenv("ARBITRUM_RPC_URL"),
 		ContractAddress: os.Getenv("IPBOND_CONTRACT_ADDRESS"),
 		PrivateKey:      os.Getenv("PRIVATE_KEY"),
-		ChainID:         421614, // Arbitrum Sepolia
+		ChainID:         chain.ChainID,
 		OracleURL:       os.Getenv("AI_ORACLE_URL"),
 	}
 